@@ -74,6 +74,7 @@ const (
 	CreateRPCExit           = 10
 	WaitCubeStartExit       = 11
 	StartMOExit             = 12
+	RecoverExit             = 13
 )
 
 var (
@@ -94,6 +95,9 @@ func createMOServer(callback *frontend.PDCallbackImpl) {
 	address := fmt.Sprintf("%s:%d", config.GlobalSystemVariables.GetHost(), config.GlobalSystemVariables.GetPort())
 	pu := config.NewParameterUnit(&config.GlobalSystemVariables, config.HostMmu, config.Mempool, config.StorageEngine, config.ClusterNodes, config.ClusterCatalog)
 	mo = frontend.NewMOServer(address, pu, callback)
+	if port := config.GlobalSystemVariables.GetPgPort(); port != 0 {
+		mo.EnablePostgres(fmt.Sprintf("%s:%d", config.GlobalSystemVariables.GetHost(), port))
+	}
 }
 
 func runMOServer() error {
@@ -223,6 +227,16 @@ func main() {
 		os.Exit(CreateAoeExit)
 	}
 
+	if *recoverDBFlag != "" {
+		err = recoverDatabase(aoeDataStorage.DB)
+		aoeDataStorage.Close()
+		if err != nil {
+			logutil.Infof("Recover error:%v\n", err)
+			os.Exit(RecoverExit)
+		}
+		os.Exit(NormalExit)
+	}
+
 	cfg := dConfig.Config{}
 	_, err = toml.DecodeFile(configFilePath, &cfg.CubeConfig)
 	if err != nil {
@@ -318,11 +332,15 @@ func main() {
 		logutil.Infof("Start MOServer failed, %v", err)
 		os.Exit(StartMOExit)
 	}
+	ms := startMetricServer(a)
 	//registerSignalHandlers()
 
 	waitSignal()
 	//srv.Stop()
 	serverShutdown(true)
+	if ms != nil {
+		ms.Close()
+	}
 	a.Close()
 	aoeDataStorage.Close()
 	pebbleDataStorage.Close()
@@ -0,0 +1,93 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
+	"github.com/matrixorigin/matrixone/pkg/vm/driver"
+	"github.com/matrixorigin/matrixone/pkg/vm/driver/pb"
+
+	"github.com/matrixorigin/matrixcube/pb/meta"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// shardCollector collects the number of the shards of every group of the cube driver
+// and the number of the shards whose leader is on this node.
+type shardCollector struct {
+	d    driver.CubeDriver
+	desc *prometheus.Desc
+}
+
+func newShardCollector(d driver.CubeDriver) *shardCollector {
+	return &shardCollector{
+		d: d,
+		desc: prometheus.NewDesc("mo_cube_shards", "Number of the raft shards by group and role.",
+			[]string{"group", "role"}, nil),
+	}
+}
+
+func (c *shardCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *shardCollector) Collect(ch chan<- prometheus.Metric) {
+	store := c.d.RaftStore()
+	router := store.GetRouter()
+	if router == nil {
+		return
+	}
+	id := store.Meta().ID
+	for _, g := range []pb.Group{pb.KVGroup, pb.AOEGroup} {
+		var total, leaders int
+		router.ForeachShards(uint64(g), func(shard meta.Shard) bool {
+			total++
+			if router.LeaderReplicaStore(shard.ID).ID == id {
+				leaders++
+			}
+			return true
+		})
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(total), g.String(), "all")
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(leaders), g.String(), "leader")
+	}
+}
+
+// startMetricServer registers the metrics of the node and serves them at the metric port,
+// nil is returned if the server is disabled or cannot be started.
+func startMetricServer(d driver.CubeDriver) *metric.Server {
+	metric.MustRegister(
+		metric.NewGaugeFunc("mheap", "usage_bytes", "Memory allocated by the queries of the node.", func() float64 {
+			return float64(config.HostMmu.Size())
+		}),
+		metric.NewGaugeFunc("mheap", "limit_bytes", "Memory limitation of the queries of the node.", func() float64 {
+			return float64(config.GlobalSystemVariables.GetHostMmuLimitation())
+		}),
+		newShardCollector(d),
+	)
+	port := config.GlobalSystemVariables.GetMetricPort()
+	if port == 0 {
+		return nil
+	}
+	srv, err := metric.Serve(fmt.Sprintf("%s:%d", config.GlobalSystemVariables.GetHost(), port))
+	if err != nil {
+		logutil.Infof("Start metric server failed, %v", err)
+		return nil
+	}
+	logutil.Infof("Metric server listens on %s%s", srv.Addr(), metric.Path)
+	return srv
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	aoedb "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
+)
+
+var (
+	recoverDBFlag       = flag.String("recover-db", "", "restore the AOE database from -recover-snapshot, replay the archived log up to -recover-index or -recover-time and exit, archive-cfg must be configured")
+	recoverSnapshotFlag = flag.String("recover-snapshot", "", "the snapshot directory of the AOE database to recover")
+	recoverIndexFlag    = flag.Uint64("recover-index", 0, "the last shard log index replayed by -recover-db, 0 is unlimited")
+	recoverTimeFlag     = flag.String("recover-time", "", "replay the entries logged until the time in RFC3339 by -recover-db, empty is unlimited")
+)
+
+// recoverDatabase runs the point-in-time recovery of the database of -recover-db.
+// The server must be stopped, only the AOE storage is opened.
+func recoverDatabase(db *aoedb.DB) error {
+	ctx := &aoedb.RecoverCtx{
+		DB:          *recoverDBFlag,
+		Path:        *recoverSnapshotFlag,
+		TargetIndex: *recoverIndexFlag,
+	}
+	if *recoverTimeFlag != "" {
+		target, err := time.Parse(time.RFC3339, *recoverTimeFlag)
+		if err != nil {
+			return err
+		}
+		ctx.TargetTime = target
+	}
+	last, err := db.Recover(ctx)
+	if err != nil {
+		return err
+	}
+	logutil.Infof("Recovered %s to the log index %d", ctx.DB, last)
+	return nil
+}
@@ -0,0 +1,401 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// AddColumn adds a column to the table. A new version of the table is created
+// for the rows written later, and the rows of the old versions are read with
+// the default value the column has now.
+func (c *Catalog) AddColumn(epoch, dbId uint64, tableName string, col aoe.ColumnInfo) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("AddColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	if len(tbl.Partitions) > 0 {
+		return ErrPartitionedTable
+	}
+	if columnIndex(tbl, col.Name) >= 0 {
+		return ErrColumnExists
+	}
+	tbl.Versions = Versions(tbl)
+	col.SchemaId = dbId
+	col.TableID = tbl.Id
+	col.Id = nextColumnId(tbl)
+	col.Epoch = epoch
+	col.PrimaryKey = false
+	col.Fill = col.Default
+	tbl.Columns = append(tbl.Columns, col)
+	empty, err := c.addVersion(tbl)
+	if err != nil {
+		return err
+	}
+	tbl.Epoch = epoch
+	if err = c.updateTableInfo(dbId, tbl); err != nil {
+		return err
+	}
+	c.dropVersions(empty)
+	return nil
+}
+
+// DropColumn drops a column of the table. A new version of the table is
+// created for the rows written later, the column of the old versions is
+// skipped when they are read.
+func (c *Catalog) DropColumn(epoch, dbId uint64, tableName, name string) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("DropColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	if len(tbl.Partitions) > 0 {
+		return ErrPartitionedTable
+	}
+	i := columnIndex(tbl, name)
+	if i < 0 {
+		return ErrColumnNotExist
+	}
+	if len(tbl.Columns) == 1 {
+		return ErrDropAllColumns
+	}
+	for _, idx := range tbl.Indices {
+		for _, id := range idx.Columns {
+			if id == tbl.Columns[i].Id {
+				return ErrColumnIndexed
+			}
+		}
+	}
+	tbl.Versions = Versions(tbl)
+	tbl.Columns = append(tbl.Columns[:i], tbl.Columns[i+1:]...)
+	empty, err := c.addVersion(tbl)
+	if err != nil {
+		return err
+	}
+	tbl.Epoch = epoch
+	if err = c.updateTableInfo(dbId, tbl); err != nil {
+		return err
+	}
+	c.dropVersions(empty)
+	return nil
+}
+
+// RenameColumn renames a column of the table, the data is not changed.
+func (c *Catalog) RenameColumn(epoch, dbId uint64, tableName, name, newName string) (err error) {
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	if len(tbl.Partitions) > 0 {
+		return ErrPartitionedTable
+	}
+	i := columnIndex(tbl, name)
+	if i < 0 {
+		return ErrColumnNotExist
+	}
+	if columnIndex(tbl, newName) >= 0 {
+		return ErrColumnExists
+	}
+	// the versions keep the names of the physical tables
+	tbl.Versions = Versions(tbl)
+	tbl.Columns[i].Name = newName
+	for j := range tbl.Indices {
+		for k, colName := range tbl.Indices[j].ColumnNames {
+			if colName == name {
+				tbl.Indices[j].ColumnNames[k] = newName
+			}
+		}
+	}
+	tbl.Epoch = epoch
+	return c.updateTableInfo(dbId, tbl)
+}
+
+// SetColumnDefault changes the default value of a column, the rows written
+// before are not changed.
+func (c *Catalog) SetColumnDefault(epoch, dbId uint64, tableName, name string, def engine.DefaultExpr) (err error) {
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	i := columnIndex(tbl, name)
+	if i < 0 {
+		return ErrColumnNotExist
+	}
+	tbl.Columns[i].Default = def
+	tbl.Epoch = epoch
+	return c.updateTableInfo(dbId, tbl)
+}
+
+// RenameTable renames a table in the database.
+func (c *Catalog) RenameTable(epoch, dbId uint64, tableName, newName string) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("RenameTable cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	if err = c.Driver.SetIfNotExist(c.tableIDKey(dbId, newName), Uint642Bytes(tbl.Id)); err != nil {
+		return ErrTableCreateExists
+	}
+	tbl.Name = newName
+	tbl.Epoch = epoch
+	if err = c.updateTableInfo(dbId, tbl); err != nil {
+		if serr := c.Driver.Delete(c.tableIDKey(dbId, newName)); serr != nil {
+			logutil.Errorf("delete meta for unrenamed table, %v, %v, %v", dbId, newName, serr)
+		}
+		return err
+	}
+	return c.Driver.Delete(c.tableIDKey(dbId, tableName))
+}
+
+// Versions returns the versions of the table, a table which has never been
+// altered is stored by one version whose id is the id of the table. A view
+// has no versions, and a partitioned table has a version for each partition.
+func Versions(tbl *aoe.TableInfo) []aoe.TableVersion {
+	if len(tbl.View) > 0 {
+		return nil
+	}
+	if len(tbl.Partitions) > 0 {
+		vs := make([]aoe.TableVersion, len(tbl.Partitions))
+		for i, p := range tbl.Partitions {
+			vs[i] = newVersion(tbl, p.Id)
+		}
+		return vs
+	}
+	if len(tbl.Versions) > 0 {
+		return tbl.Versions
+	}
+	return []aoe.TableVersion{newVersion(tbl, tbl.Id)}
+}
+
+// newVersion returns the version stored by the physical table id with the
+// columns of the table.
+func newVersion(tbl *aoe.TableInfo, id uint64) aoe.TableVersion {
+	v := aoe.TableVersion{Id: id}
+	for _, col := range tbl.Columns {
+		v.Columns = append(v.Columns, col.Id)
+		v.Names = append(v.Names, col.Name)
+	}
+	return v
+}
+
+// VersionTable returns the physical table of a version which has the columns
+// of the table, the ids of the columns and the indices are the positions of
+// the columns in the version.
+func VersionTable(tbl aoe.TableInfo, v aoe.TableVersion) aoe.TableInfo {
+	pos := make(map[uint64]uint64, len(v.Columns))
+	cols := make([]aoe.ColumnInfo, 0, len(v.Columns))
+	for i, id := range v.Columns {
+		pos[id] = uint64(i)
+		for _, col := range tbl.Columns {
+			if col.Id == id {
+				col.Id = uint64(i)
+				col.TableID = v.Id
+				col.Name = v.Names[i]
+				cols = append(cols, col)
+				break
+			}
+		}
+	}
+	idxs := make([]aoe.IndexInfo, 0, len(tbl.Indices))
+	for _, idx := range tbl.Indices {
+		if idx, ok := versionIndex(idx, pos); ok {
+			idx.TableId = v.Id
+			idxs = append(idxs, idx)
+		}
+	}
+	tbl.Id = v.Id
+	tbl.Columns = cols
+	tbl.Indices = idxs
+	tbl.Versions = nil
+	tbl.Partition = nil
+	tbl.Partitions = nil
+	return tbl
+}
+
+// versionIndex maps the columns of the index to their positions in a version,
+// it returns false if a column of the index isn't stored by the version.
+func versionIndex(idx aoe.IndexInfo, pos map[uint64]uint64) (aoe.IndexInfo, bool) {
+	cols := make([]uint64, len(idx.Columns))
+	for i, id := range idx.Columns {
+		p, ok := pos[id]
+		if !ok {
+			return idx, false
+		}
+		cols[i] = p
+	}
+	idx.Columns = cols
+	return idx, true
+}
+
+// maxVersions is the maximum number of versions of a table, the table can't
+// be altered any more if its versions storing rows reach the limit.
+const maxVersions = 16
+
+// addVersion creates the tablets of a new version with the columns of the
+// table, it has as many tablets as the last version. The old versions which
+// store no rows are removed from the table and returned, their tablets are
+// dropped after the table is updated. The last version is always kept since
+// the rows being written are still stored by it.
+func (c *Catalog) addVersion(tbl *aoe.TableInfo) ([]aoe.TableVersion, error) {
+	var vs, empty []aoe.TableVersion
+
+	last := tbl.Versions[len(tbl.Versions)-1]
+	sids, err := c.getShardidsWithTimeout(last.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range tbl.Versions[:len(tbl.Versions)-1] {
+		ok, err := c.isEmptyVersion(v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			empty = append(empty, v)
+		} else {
+			vs = append(vs, v)
+		}
+	}
+	if len(vs)+2 > maxVersions {
+		return nil, ErrTooManyVersions
+	}
+	bucket := len(sids)
+	if bucket < 1 {
+		bucket = 1
+	}
+	v, err := c.createVersion(tbl, bucket)
+	if err != nil {
+		return nil, err
+	}
+	tbl.Versions = append(append(vs, last), v)
+	return empty, nil
+}
+
+// isEmptyVersion returns true if none of the tablets of the version has a segment.
+func (c *Catalog) isEmptyVersion(v aoe.TableVersion) (bool, error) {
+	sids, err := c.getShardidsWithTimeout(v.Id)
+	if err != nil {
+		return false, err
+	}
+	for _, sid := range sids {
+		ids, err := c.Driver.GetSegmentIds(c.encodeTabletName(sid, v.Id), sid)
+		if err != nil {
+			return false, err
+		}
+		if len(ids.Ids) > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// dropVersions drops the tablets and the routes of the versions removed from
+// a table, the failures are only logged since the table doesn't use them.
+func (c *Catalog) dropVersions(vs []aoe.TableVersion) {
+	for _, v := range vs {
+		sids, err := c.getShardidsWithTimeout(v.Id)
+		if err != nil {
+			logutil.Errorf("get shardid of version %d failed, %v", v.Id, err)
+			continue
+		}
+		for _, sid := range sids {
+			if _, err = c.Driver.DropTablet(c.encodeTabletName(sid, v.Id), sid); err != nil && err != metadata.TableNotFoundErr {
+				logutil.Errorf("call local drop table failed %d, %d, %v", sid, v.Id, err)
+				continue
+			}
+			if err = c.Driver.Delete(c.routeKey(v.Id, sid)); err != nil {
+				logutil.Errorf("remove route of version failed %d, %d, %v", sid, v.Id, err)
+			}
+		}
+	}
+}
+
+// createVersion creates bucket tablets of a new physical table with the
+// columns of the table, the shards are labelled and routed by the id of the
+// table which doesn't change when the table is renamed.
+func (c *Catalog) createVersion(tbl *aoe.TableInfo, bucket int) (aoe.TableVersion, error) {
+	tid, err := c.allocId(cTableIDPrefix)
+	if err != nil {
+		return aoe.TableVersion{}, err
+	}
+	v := newVersion(tbl, tid)
+	label := strconv.FormatUint(tbl.Id, 10)
+	for i := 0; i < bucket; i++ {
+		catalogSid, err := c.allocId(cCatalogShardIDPrefix)
+		if err != nil {
+			return v, err
+		}
+		shardId, err := c.getAvailableShard(catalogSid)
+		if err != nil {
+			return v, ErrNoAvailableShard
+		}
+		vtbl := VersionTable(*tbl, v)
+		if err := c.Driver.CreateTablet(c.encodeTabletName(shardId, tid), shardId, &vtbl); err != nil {
+			logutil.Errorf("ErrTableCreateFailed, %v, %v, %v", shardId, vtbl, err)
+			return v, ErrTabletCreateFailed
+		}
+		if err := c.Driver.AddLabelToShard(shardId, cLabelName, label); err != nil {
+			logutil.Errorf("ErrAddLabelFailed, %v, %v, %v", shardId, tid, err)
+			return v, ErrTabletCreateFailed
+		}
+		if err := c.Driver.Set(c.routeKey(tid, shardId), []byte(label)); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+func columnIndex(tbl *aoe.TableInfo, name string) int {
+	for i, col := range tbl.Columns {
+		if col.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextColumnId returns an id which has never been used by a column of the
+// table, so that an added column never reads the data of a dropped one.
+func nextColumnId(tbl *aoe.TableInfo) uint64 {
+	var id uint64
+	for _, col := range tbl.Columns {
+		if col.Id >= id {
+			id = col.Id + 1
+		}
+	}
+	for _, v := range tbl.Versions {
+		for _, cid := range v.Columns {
+			if cid >= id {
+				id = cid + 1
+			}
+		}
+	}
+	return id
+}
@@ -0,0 +1,440 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+const (
+	cUserPrefix     = "User"
+	cRolePrefix     = "Role"
+	cAuthLockPrefix = "AuthLock"
+)
+
+const (
+	// authLockLease is how long the lock of the accounts is held at most, the
+	// lock left by a node which crashed is taken over once it has expired
+	authLockLease = 30 * time.Second
+	// authLockTimeout is how long a change of the accounts waits for the lock
+	authLockTimeout = 10 * time.Second
+	authLockRetry   = 10 * time.Millisecond
+)
+
+const (
+	// sha2SaltLen is the length of the salt of a caching_sha2_password hash
+	sha2SaltLen = 20
+	// sha2Rounds is the number of SHA256 rounds of a caching_sha2_password hash
+	sha2Rounds = 5000
+)
+
+// UserInfo is an account stored in the catalog. Accounts are identified
+// by their name, Plugin is the authentication plugin of the account and
+// decides how the password is kept: SHA1(SHA1(password)) for
+// mysql_native_password and a salted SHA256 hash for caching_sha2_password.
+// An empty Plugin is mysql_native_password.
+// MaxExecutionTime (milliseconds) and QueryMemoryLimit (bytes) are the
+// defaults of the statements of the account, 0 means the server default.
+type UserInfo struct {
+	Name             string            `json:"name"`
+	Host             string            `json:"host"`
+	Plugin           string            `json:"plugin,omitempty"`
+	Password         []byte            `json:"password,omitempty"`
+	Roles            []string          `json:"roles,omitempty"`
+	Grants           []privilege.Grant `json:"grants,omitempty"`
+	MaxExecutionTime int64             `json:"max_execution_time,omitempty"`
+	QueryMemoryLimit int64             `json:"query_memory_limit,omitempty"`
+}
+
+// RoleInfo is a named set of privileges which can be granted to users.
+type RoleInfo struct {
+	Name   string            `json:"name"`
+	Grants []privilege.Grant `json:"grants,omitempty"`
+}
+
+// HashPassword returns SHA1(SHA1(password)), an empty password has no hash.
+func HashPassword(password string) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+	h1 := sha1.Sum([]byte(password))
+	h2 := sha1.Sum(h1[:])
+	return h2[:]
+}
+
+// HashSha2Password returns salt + SHA256^n(salt + password) with a random salt,
+// an empty password has no hash.
+func HashSha2Password(password string) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+	salt := make([]byte, sha2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil
+	}
+	return append(salt, sha2Digest(salt, password)...)
+}
+
+// CheckSha2Password returns true if the password matches the hash made
+// by HashSha2Password.
+func CheckSha2Password(hash []byte, password string) bool {
+	if len(hash) == 0 || len(password) == 0 {
+		return len(hash) == 0 && len(password) == 0
+	}
+	if len(hash) != sha2SaltLen+sha256.Size {
+		return false
+	}
+	return bytes.Equal(hash[sha2SaltLen:], sha2Digest(hash[:sha2SaltLen], password))
+}
+
+func sha2Digest(salt []byte, password string) []byte {
+	h := sha256.Sum256(append(append([]byte{}, salt...), password...))
+	for i := 1; i < sha2Rounds; i++ {
+		h = sha256.Sum256(h[:])
+	}
+	return h[:]
+}
+
+// authLockInfo is the holder of the lock of the accounts
+type authLockInfo struct {
+	Token   string `json:"token"`
+	Expires int64  `json:"expires"`
+}
+
+// lockAuth takes the lock of the accounts and roles in the catalog and returns
+// the function releasing it. The changes of them read, change and write back a
+// record, so they are serialized among all the nodes sharing the catalog. The
+// lock is a key set if it does not exist, an expired lock is taken over by the
+// node which first sets the takeover key of its holder.
+func (c *Catalog) lockAuth() (func(), error) {
+	c.authLock.Lock()
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		c.authLock.Unlock()
+		return nil, err
+	}
+	holder := authLockInfo{Token: hex.EncodeToString(token)}
+	deadline := time.Now().Add(authLockTimeout)
+	for {
+		holder.Expires = time.Now().Add(authLockLease).UnixNano()
+		value, _ := json.Marshal(holder)
+		if err := c.Driver.SetIfNotExist(c.authLockKey(""), value); err == nil {
+			break
+		}
+		if c.takeOverAuthLock(value) {
+			break
+		}
+		if time.Now().After(deadline) {
+			c.authLock.Unlock()
+			return nil, ErrAuthLockTimeout
+		}
+		time.Sleep(authLockRetry)
+	}
+	return func() {
+		c.unlockAuth(holder.Token)
+		c.authLock.Unlock()
+	}, nil
+}
+
+// takeOverAuthLock replaces the expired lock of the accounts by value
+func (c *Catalog) takeOverAuthLock(value []byte) bool {
+	data, err := c.Driver.Get(c.authLockKey(""))
+	if err != nil || data == nil {
+		return false
+	}
+	var expired authLockInfo
+	if err = json.Unmarshal(data, &expired); err != nil || time.Now().UnixNano() < expired.Expires {
+		return false
+	}
+	if err = c.Driver.SetIfNotExist(c.authLockKey(expired.Token), value); err != nil {
+		return false
+	}
+	defer c.Driver.Delete(c.authLockKey(expired.Token))
+	logutil.Warnf("the expired lock of the accounts held by %s is taken over", expired.Token)
+	return c.Driver.Set(c.authLockKey(""), value) == nil
+}
+
+// unlockAuth releases the lock of the accounts if it is still held by token
+func (c *Catalog) unlockAuth(token string) {
+	data, err := c.Driver.Get(c.authLockKey(""))
+	if err != nil || data == nil {
+		return
+	}
+	var holder authLockInfo
+	if err = json.Unmarshal(data, &holder); err != nil || holder.Token != token {
+		logutil.Warnf("the lock of the accounts held by %s has been taken over", token)
+		return
+	}
+	if err = c.Driver.Delete(c.authLockKey("")); err != nil {
+		logutil.Errorf("release the lock of the accounts failed, %v", err)
+	}
+}
+
+// CreateUser creates the account user.
+func (c *Catalog) CreateUser(user UserInfo) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if _, err := c.GetRole(user.Name); err == nil {
+		return ErrRoleExists
+	}
+	if _, err := c.GetUser(user.Name); err == nil {
+		return ErrUserExists
+	}
+	value, _ := json.Marshal(user)
+	return c.Driver.SetIfNotExist(c.userKey(user.Name), value)
+}
+
+// DropUser drops the account name.
+func (c *Catalog) DropUser(name string) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if _, err := c.GetUser(name); err != nil {
+		return err
+	}
+	return c.Driver.Delete(c.userKey(name))
+}
+
+// GetUser gets the account name.
+func (c *Catalog) GetUser(name string) (*UserInfo, error) {
+	value, err := c.Driver.Get(c.userKey(name))
+	if err != nil || value == nil {
+		return nil, ErrUserNotExists
+	}
+	user := new(UserInfo)
+	if err = json.Unmarshal(value, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// SetPassword replaces the authentication plugin and the password hash of
+// the account name.
+func (c *Catalog) SetPassword(name, plugin string, password []byte) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	user, err := c.GetUser(name)
+	if err != nil {
+		return err
+	}
+	user.Plugin = plugin
+	user.Password = password
+	return c.updateUser(user)
+}
+
+// SetQueryLimits replaces the default max_execution_time and query_memory_limit
+// of the statements of the account name.
+func (c *Catalog) SetQueryLimits(name string, maxExecutionTime, queryMemoryLimit int64) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	user, err := c.GetUser(name)
+	if err != nil {
+		return err
+	}
+	user.MaxExecutionTime = maxExecutionTime
+	user.QueryMemoryLimit = queryMemoryLimit
+	return c.updateUser(user)
+}
+
+// CreateRole creates the role name.
+func (c *Catalog) CreateRole(name string) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if _, err := c.GetUser(name); err == nil {
+		return ErrUserExists
+	}
+	if _, err := c.GetRole(name); err == nil {
+		return ErrRoleExists
+	}
+	value, _ := json.Marshal(RoleInfo{Name: name})
+	return c.Driver.SetIfNotExist(c.roleKey(name), value)
+}
+
+// DropRole drops the role name, users which hold the role lose its
+// privileges immediately.
+func (c *Catalog) DropRole(name string) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if _, err := c.GetRole(name); err != nil {
+		return err
+	}
+	return c.Driver.Delete(c.roleKey(name))
+}
+
+// GetRole gets the role name.
+func (c *Catalog) GetRole(name string) (*RoleInfo, error) {
+	value, err := c.Driver.Get(c.roleKey(name))
+	if err != nil || value == nil {
+		return nil, ErrRoleNotExists
+	}
+	role := new(RoleInfo)
+	if err = json.Unmarshal(value, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// GrantPrivileges grants the privileges of g to the user or the role grantee.
+func (c *Catalog) GrantPrivileges(grantee string, g privilege.Grant) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if user, err := c.GetUser(grantee); err == nil {
+		user.Grants = privilege.Add(user.Grants, g)
+		return c.updateUser(user)
+	}
+	role, err := c.GetRole(grantee)
+	if err != nil {
+		return ErrUserNotExists
+	}
+	role.Grants = privilege.Add(role.Grants, g)
+	return c.updateRole(role)
+}
+
+// RevokePrivileges revokes the privileges of g from the user or the role grantee.
+func (c *Catalog) RevokePrivileges(grantee string, g privilege.Grant) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if user, err := c.GetUser(grantee); err == nil {
+		user.Grants = privilege.Remove(user.Grants, g)
+		return c.updateUser(user)
+	}
+	role, err := c.GetRole(grantee)
+	if err != nil {
+		return ErrUserNotExists
+	}
+	role.Grants = privilege.Remove(role.Grants, g)
+	return c.updateRole(role)
+}
+
+// GrantRole grants the role to the account user.
+func (c *Catalog) GrantRole(role, user string) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if _, err := c.GetRole(role); err != nil {
+		return err
+	}
+	u, err := c.GetUser(user)
+	if err != nil {
+		return err
+	}
+	for _, r := range u.Roles {
+		if r == role {
+			return nil
+		}
+	}
+	u.Roles = append(u.Roles, role)
+	return c.updateUser(u)
+}
+
+// RevokeRole revokes the role from the account user.
+func (c *Catalog) RevokeRole(role, user string) error {
+	unlock, err := c.lockAuth()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	u, err := c.GetUser(user)
+	if err != nil {
+		return err
+	}
+	for i, r := range u.Roles {
+		if r == role {
+			u.Roles = append(u.Roles[:i], u.Roles[i+1:]...)
+			return c.updateUser(u)
+		}
+	}
+	return nil
+}
+
+// GetPrivileges returns the grants of the account name and of all its roles.
+func (c *Catalog) GetPrivileges(name string) ([]privilege.Grant, error) {
+	user, err := c.GetUser(name)
+	if err != nil {
+		return nil, err
+	}
+	grants := user.Grants
+	for _, r := range user.Roles {
+		role, err := c.GetRole(r)
+		if err == ErrRoleNotExists {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, role.Grants...)
+	}
+	return grants, nil
+}
+
+func (c *Catalog) updateUser(user *UserInfo) error {
+	value, _ := json.Marshal(user)
+	return c.Driver.Set(c.userKey(user.Name), value)
+}
+
+func (c *Catalog) updateRole(role *RoleInfo) error {
+	value, _ := json.Marshal(role)
+	return c.Driver.Set(c.roleKey(role.Name), value)
+}
+
+// userKey returns the encoded name with prefix "meta1User"
+func (c *Catalog) userKey(name string) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cUserPrefix, name)
+}
+
+// authLockKey returns the key of the lock of the accounts, or the takeover key
+// of the expired lock held by token, with prefix "meta1AuthLock"
+func (c *Catalog) authLockKey(token string) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cAuthLockPrefix, token)
+}
+
+// roleKey returns the encoded name with prefix "meta1Role"
+func (c *Catalog) roleKey(name string) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cRolePrefix, name)
+}
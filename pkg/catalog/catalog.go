@@ -64,6 +64,9 @@ type Catalog struct {
 	sidStart  uint64
 	sidEnd    uint64
 	pLock     int32
+	// authLock serializes the changes of the accounts and roles made by this
+	// catalog, the ones of all the nodes are serialized by lockAuth
+	authLock sync.Mutex
 }
 type CatalogListener struct {
 	event.NoopListener
@@ -220,6 +223,15 @@ func NewCatalog(store driver.CubeDriver) *Catalog {
 
 // CreateDatabase creates a database with db info.
 func (c *Catalog) CreateDatabase(epoch uint64, dbName string, typ int) (dbid uint64, err error) {
+	return c.createDatabase(epoch, dbName, typ, false)
+}
+
+// CreateEncryptedDatabase creates a database whose tables are encrypted at rest.
+func (c *Catalog) CreateEncryptedDatabase(epoch uint64, dbName string, typ int) (dbid uint64, err error) {
+	return c.createDatabase(epoch, dbName, typ, true)
+}
+
+func (c *Catalog) createDatabase(epoch uint64, dbName string, typ int, encrypted bool) (dbid uint64, err error) {
 	t0 := time.Now()
 	defer func() {
 		logutil.Debugf("CreateDatabase finished, dbname is %v, db id is %d, cost %d ms", dbName, dbid, time.Since(t0).Milliseconds())
@@ -240,6 +252,7 @@ func (c *Catalog) CreateDatabase(epoch uint64, dbName string, typ int) (dbid uin
 		Id:        dbid,
 		CatalogId: 1,
 		Type:      typ,
+		Encrypted: encrypted,
 	}
 	value, _ := json.Marshal(info)
 	if err = c.Driver.Set(c.dbKey(dbid), value); err != nil {
@@ -377,6 +390,12 @@ func (c *Catalog) updateTableInfo(dbId uint64, tbl *aoe.TableInfo) (err error) {
 
 // CreateTable creates a table with tableInfo in database.
 func (c *Catalog) CreateTable(epoch, dbId uint64, tbl aoe.TableInfo) (tid uint64, err error) {
+	if _, _, err = aoe.CacheProperties(tbl.Properties); err != nil {
+		return tid, err
+	}
+	if err = aoe.CheckTTL(&tbl); err != nil {
+		return tid, err
+	}
 	t0 := time.Now()
 	defer func() {
 		if err != nil && err != ErrTableCreateExists {
@@ -387,10 +406,16 @@ func (c *Catalog) CreateTable(epoch, dbId uint64, tbl aoe.TableInfo) (tid uint64
 		}
 		logutil.Debugf("CreateTable finished, table name is %v, table id is %d, cost %d ms", tbl.Name, tid, time.Since(t0).Milliseconds())
 	}()
-	_, err = c.checkDBExists(dbId)
+	db, err := c.checkDBExists(dbId)
 	if err != nil {
 		return tid, err
 	}
+	if db.Encrypted {
+		tbl.Properties = append(tbl.Properties, aoe.Property{
+			Key:   aoe.EncryptionProperty,
+			Value: "Y",
+		})
+	}
 	tid, err = c.allocId(cTableIDPrefix)
 	if err != nil {
 		return tid, err
@@ -416,6 +441,17 @@ func (c *Catalog) CreateTable(epoch, dbId uint64, tbl aoe.TableInfo) (tid uint64
 	if bucket < uint64(1) {
 		bucket = uint64(1)
 	}
+	if len(tbl.Partition) > 0 {
+		if err = c.createPartitions(&tbl, int(bucket)); err != nil {
+			return tid, err
+		}
+		tbl.State = aoe.StatePublic
+		if err = c.updateTableInfo(dbId, &tbl); err != nil {
+			return tid, err
+		}
+		// the rows are stored by the tablets of the partitions only
+		bucket = 0
+	}
 	for i := uint64(0); i < bucket; i++ {
 		catalogSid, err := c.allocId(cCatalogShardIDPrefix)
 		if err != nil {
@@ -558,25 +594,32 @@ func (c *Catalog) CreateIndex(epoch uint64, idxInfo aoe.IndexInfo) error {
 	if idxInfo.Type == aoe.Bsi {
 		idxInfo.Type = aoe.NumBsi
 	}
-	shardIds, err := c.Driver.PrefixKeys(c.routePrefix(tbl.Id), 0)
-	if err != nil {
-		return err
-	}
-	for _, shardId := range shardIds {
-		sid, err := Bytes2Uint64(shardId[len(c.routePrefix(tbl.Id)):])
-		if err != nil {
-			logutil.Errorf("convert shardid failed, %v", err)
-			break
+	for _, v := range Versions(tbl) {
+		pos := make(map[uint64]uint64, len(v.Columns))
+		for i, id := range v.Columns {
+			pos[id] = uint64(i)
 		}
-		aoeTableName := c.encodeTabletName(sid, tbl.Id)
-		err = c.Driver.CreateIndex(aoeTableName, &idxInfo, sid)
+		vidx, ok := versionIndex(idxInfo, pos)
+		if !ok {
+			continue
+		}
+		shardIds, err := c.Driver.PrefixKeys(c.routePrefix(v.Id), 0)
 		if err != nil {
-			logutil.Errorf("call local create index failed %d, %d, %v", sid, tbl.Id, err)
-			break
+			return err
+		}
+		for _, shardId := range shardIds {
+			sid, err := Bytes2Uint64(shardId[len(c.routePrefix(v.Id)):])
+			if err != nil {
+				logutil.Errorf("convert shardid failed, %v", err)
+				return err
+			}
+			aoeTableName := c.encodeTabletName(sid, v.Id)
+			err = c.Driver.CreateIndex(aoeTableName, &vidx, sid)
+			if err != nil {
+				logutil.Errorf("call local create index failed %d, %d, %v", sid, v.Id, err)
+				return err
+			}
 		}
-	}
-	if err != nil {
-		return err
 	}
 	tbl.Epoch = epoch
 	tbl.Indices = append(tbl.Indices, idxInfo)
@@ -598,35 +641,45 @@ func (c *Catalog) DropIndex(epoch, tid, dbid uint64, idxName string) error {
 	if err != nil {
 		return err
 	}
-	shardIds, err := c.Driver.PrefixKeys(c.routePrefix(tbl.Id), 0)
-	if err != nil {
-		return err
-	}
-	for _, shardId := range shardIds {
-		sid, err := Bytes2Uint64(shardId[len(c.routePrefix(tbl.Id)):])
-		if err != nil {
-			logutil.Errorf("convert shardid failed, %v", err)
-			break
-		}
-		aoeTableName := c.encodeTabletName(sid, tbl.Id)
-		err = c.Driver.DropIndex(aoeTableName, idxName, sid)
-		if err != nil {
-			logutil.Errorf("call local drop index failed %d, %d, %v", sid, tbl.Id, err)
+	pos := -1
+	for i, indice := range tbl.Indices {
+		if indice.Name == idxName {
+			pos = i
 			break
 		}
 	}
-	if err != nil {
-		return err
+	if pos < 0 {
+		return ErrIndexNotExist
 	}
-	for i, indice := range tbl.Indices {
-		if indice.Name == idxName {
-			tbl.Epoch = epoch
-			tbl.Indices = append(tbl.Indices[:i], tbl.Indices[i+1:]...)
-			err = c.updateTableInfo(dbid, tbl)
+	for _, v := range Versions(tbl) {
+		var shardIds [][]byte
+
+		shardIds, err = c.Driver.PrefixKeys(c.routePrefix(v.Id), 0)
+		if err != nil {
+			return err
+		}
+		for _, shardId := range shardIds {
+			var sid uint64
+
+			sid, err = Bytes2Uint64(shardId[len(c.routePrefix(v.Id)):])
+			if err != nil {
+				logutil.Errorf("convert shardid failed, %v", err)
+				break
+			}
+			aoeTableName := c.encodeTabletName(sid, v.Id)
+			err = c.Driver.DropIndex(aoeTableName, idxName, sid)
+			if err != nil {
+				logutil.Errorf("call local drop index failed %d, %d, %v", sid, v.Id, err)
+				break
+			}
+		}
+		if err != nil {
 			return err
 		}
 	}
-	return ErrIndexNotExist
+	tbl.Epoch = epoch
+	tbl.Indices = append(tbl.Indices[:pos], tbl.Indices[pos+1:]...)
+	return c.updateTableInfo(dbid, tbl)
 }
 
 // ListTables returns all tables meta in database.
@@ -693,16 +746,23 @@ func (c *Catalog) GetTablets(dbId uint64, tableName string) (tablets []aoe.Table
 		if tb.State != aoe.StatePublic {
 			return nil, ErrTableNotExists
 		}
-		sids, err := c.getShardidsWithTimeout(tb.Id)
-		if err != nil {
-			return nil, err
-		}
-		for _, sid := range sids {
-			tablets = append(tablets, aoe.TabletInfo{
-				Name:    c.encodeTabletName(sid, tb.Id),
-				ShardId: sid,
-				Table:   *tb,
-			})
+		for i, v := range Versions(tb) {
+			sids, err := c.getShardidsWithTimeout(v.Id)
+			if err != nil {
+				return nil, err
+			}
+			for _, sid := range sids {
+				tablet := aoe.TabletInfo{
+					Name:    c.encodeTabletName(sid, v.Id),
+					ShardId: sid,
+					Table:   *tb,
+					Version: i,
+				}
+				if len(tb.Partitions) > 0 {
+					tablet.Partition = i
+				}
+				tablets = append(tablets, tablet)
+			}
 		}
 		return tablets, nil
 	}
@@ -791,21 +851,30 @@ func (c *Catalog) RemoveDeletedTable(epoch uint64) (cnt int, err error) {
 			logutil.Errorf("Decode err for table info, %v, %v", err, rsp[i])
 			continue
 		}
-		shardIds, err := c.getShardidsWithTimeout(tbl.Id)
-		if err != nil {
-			logutil.Errorf("get shardid failed, %v", err)
-			continue
-		}
 		success := true
-		for _, sid := range shardIds {
-			_, err = c.Driver.DropTablet(c.encodeTabletName(sid, tbl.Id), sid)
-			if err != nil && err != metadata.TableNotFoundErr {
-				logutil.Errorf("call local drop table failed %d, %d, %v", sid, tbl.Id, err)
+		for _, v := range Versions(&tbl) {
+			shardIds, err := c.getShardidsWithTimeout(v.Id)
+			if err != nil {
+				logutil.Errorf("get shardid failed, %v", err)
 				success = false
 				break
 			}
+			for _, sid := range shardIds {
+				_, err = c.Driver.DropTablet(c.encodeTabletName(sid, v.Id), sid)
+				if err != nil && err != metadata.TableNotFoundErr {
+					logutil.Errorf("call local drop table failed %d, %d, %v", sid, v.Id, err)
+					success = false
+					break
+				}
+			}
+			if !success {
+				break
+			}
 		}
 		if success {
+			if err = c.Driver.Delete(c.statisticsKey(tbl.Id)); err != nil {
+				logutil.Errorf("remove statistics of table failed, %v, %v", err, tbl)
+			}
 			if c.Driver.Delete(c.deletedTableKey(tbl.Epoch, tbl.SchemaId, tbl.Id)) != nil {
 				logutil.Errorf("remove marked deleted tableinfo failed, %v, %v", err, tbl)
 			} else {
@@ -15,10 +15,12 @@
 package catalog
 
 import (
+	"encoding/json"
 	"fmt"
 	stdLog "log"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,10 +29,13 @@ import (
 
 	cconfig "github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	// "github.com/matrixorigin/matrixone/pkg/logutil"
 	aoe3 "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/config"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
 	// "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
@@ -86,6 +91,24 @@ func MockTableInfo(colCnt int, i int) *aoe.TableInfo {
 	}
 	return tblInfo
 }
+// mockPartitionDef returns a LIST partition for each name, the i-th one
+// holds the value from+i of the column.
+func mockPartitionDef(t *testing.T, col aoe.ColumnInfo, from int, names ...string) *engine.PartitionByDef {
+	def := &engine.PartitionByDef{Fields: []string{col.Name}}
+	for i, name := range names {
+		vec, err := engine.DefaultVector(engine.Attribute{
+			Name:    col.Name,
+			Type:    col.Type,
+			Default: engine.MakeDefaultExpr(true, int64(from+i), false),
+		}, 1)
+		require.NoError(t, err)
+		def.List = append(def.List, engine.ListPartition{
+			Name:    name,
+			Extends: []extend.Extend{&extend.ValueExtend{V: vec}},
+		})
+	}
+	return def
+}
 func MockTableInfoWithProperties(colCnt int, i, bucket int) *aoe.TableInfo {
 	tblInfo := &aoe.TableInfo{
 		Name:    "test_table" + strconv.Itoa(i),
@@ -341,6 +364,118 @@ func TestCatalogWithUtil(t *testing.T) {
 		require.Equal(t, tablets[i].Table.Name, testTables[0].Name, "GetTablets: Wrong Name")
 	}
 
+	//test AddColumn
+	colName := testTables[0].Columns[1].Name
+	err = catalog.AddColumn(0, dbids[0], testTables[0].Name, aoe.ColumnInfo{Name: "mock_new", Type: testTables[0].Columns[0].Type})
+	require.NoError(t, err, "AddColumn Fail")
+	err = catalog.AddColumn(0, dbids[0], testTables[0].Name, aoe.ColumnInfo{Name: "mock_new", Type: testTables[0].Columns[0].Type})
+	require.Equal(t, ErrColumnExists, err, "AddColumn: wrong err")
+	table, err = catalog.GetTable(dbids[0], testTables[0].Name)
+	require.NoError(t, err, "GetTable Fail")
+	require.Equal(t, len(testTables[0].Columns)+1, len(table.Columns), "AddColumn: wrong columns")
+	require.Equal(t, 2, len(table.Versions), "AddColumn: wrong versions")
+	require.Equal(t, table.Id, table.Versions[0].Id, "AddColumn: wrong first version")
+
+	//test RenameColumn and DropColumn
+	err = catalog.RenameColumn(0, dbids[0], testTables[0].Name, colName, "mock_renamed")
+	require.NoError(t, err, "RenameColumn Fail")
+	err = catalog.RenameColumn(0, dbids[0], testTables[0].Name, colName, "mock_renamed")
+	require.Equal(t, ErrColumnNotExist, err, "RenameColumn: wrong err")
+	err = catalog.DropColumn(0, dbids[0], testTables[0].Name, "mock_renamed")
+	require.NoError(t, err, "DropColumn Fail")
+	table, err = catalog.GetTable(dbids[0], testTables[0].Name)
+	require.NoError(t, err, "GetTable Fail")
+	require.Equal(t, len(testTables[0].Columns), len(table.Columns), "DropColumn: wrong columns")
+	// the first version stores no rows and is dropped
+	require.Equal(t, 2, len(table.Versions), "DropColumn: wrong versions")
+	require.NotEqual(t, table.Id, table.Versions[0].Id, "DropColumn: empty version not dropped")
+	require.Equal(t, colName, table.Versions[0].Names[1], "DropColumn: wrong physical names")
+	sids, err := catalog.getShardidsWithTimeout(table.Id)
+	require.NoError(t, err, "getShardidsWithTimeout Fail")
+	require.Equal(t, 0, len(sids), "DropColumn: routes of the empty version not removed")
+	tablets, err = catalog.GetTablets(dbids[0], testTables[0].Name)
+	require.NoError(t, err, "GetTablets Fail")
+	for i := range tablets {
+		require.Equal(t, catalog.encodeTabletName(tablets[i].ShardId, table.Versions[tablets[i].Version].Id), tablets[i].Name, "GetTablets: wrong version")
+	}
+
+	//test RenameTable
+	err = catalog.RenameTable(0, dbids[0], testTables[0].Name, testTables[1].Name)
+	require.Equal(t, ErrTableCreateExists, err, "RenameTable: wrong err")
+	err = catalog.RenameTable(0, dbids[0], testTables[0].Name, "mock_renamed_table")
+	require.NoError(t, err, "RenameTable Fail")
+	_, err = catalog.GetTable(dbids[0], testTables[0].Name)
+	require.Equal(t, ErrTableNotExists, err, "RenameTable: GetTable wrong err")
+	err = catalog.RenameTable(0, dbids[0], "mock_renamed_table", testTables[0].Name)
+	require.NoError(t, err, "RenameTable Fail")
+	table, err = catalog.GetTable(dbids[0], testTables[0].Name)
+	require.NoError(t, err, "GetTable Fail")
+	require.Equal(t, createIds[0], table.Id, "RenameTable: Wrong id")
+
+	//test CreateView
+	view := aoe.TableInfo{Name: "mock_view", Columns: testTables[0].Columns, View: "select * from mock"}
+	vid, err := catalog.CreateView(0, dbids[0], view)
+	require.NoError(t, err, "CreateView Fail")
+	_, err = catalog.CreateView(0, dbids[0], view)
+	require.Equal(t, ErrTableCreateExists, err, "CreateView: wrong err")
+	table, err = catalog.GetTable(dbids[0], view.Name)
+	require.NoError(t, err, "GetTable Fail")
+	require.Equal(t, vid, table.Id, "CreateView: wrong id")
+	require.Equal(t, view.View, table.View, "CreateView: wrong query")
+	require.Equal(t, 0, len(Versions(table)), "CreateView: wrong versions")
+
+	//test partitions
+	ptbl := MockTableInfo(colCnt, 100)
+	ptbl.Name = "mock_partitioned"
+	ptbl.Partition, err = encodePartition(mockPartitionDef(t, ptbl.Columns[0], 0, "p0", "p1"))
+	require.NoError(t, err, "encodePartition Fail")
+	_, err = catalog.CreateTable(0, dbids[0], *ptbl)
+	require.NoError(t, err, "CreateTable Fail")
+	table, err = catalog.GetTable(dbids[0], ptbl.Name)
+	require.NoError(t, err, "GetTable Fail")
+	require.Equal(t, 2, len(table.Partitions), "CreateTable: wrong partitions")
+	tablets, err = catalog.GetTablets(dbids[0], ptbl.Name)
+	require.NoError(t, err, "GetTablets Fail")
+	require.Equal(t, 2, len(tablets), "GetTablets: wrong tablets")
+	for i := range tablets {
+		require.Equal(t, catalog.encodeTabletName(tablets[i].ShardId, table.Partitions[tablets[i].Partition].Id), tablets[i].Name, "GetTablets: wrong partition")
+	}
+	err = catalog.AddColumn(1, dbids[0], ptbl.Name, aoe.ColumnInfo{Name: "mock_new", Type: ptbl.Columns[0].Type})
+	require.Equal(t, ErrPartitionedTable, err, "AddColumn: wrong err")
+	err = catalog.AddPartitions(1, dbids[0], testTables[1].Name, mockPartitionDef(t, ptbl.Columns[0], 2, "p2"))
+	require.Equal(t, ErrNotPartitioned, err, "AddPartitions: wrong err")
+	err = catalog.AddPartitions(1, dbids[0], ptbl.Name, mockPartitionDef(t, ptbl.Columns[0], 1, "p1"))
+	require.Equal(t, ErrPartitionExists, err, "AddPartitions: wrong err")
+	err = catalog.AddPartitions(1, dbids[0], ptbl.Name, mockPartitionDef(t, ptbl.Columns[0], 2, "p2"))
+	require.NoError(t, err, "AddPartitions Fail")
+	pid := table.Partitions[0].Id
+	err = catalog.TruncatePartition(1, dbids[0], ptbl.Name, "p0")
+	require.NoError(t, err, "TruncatePartition Fail")
+	err = catalog.DropPartition(1, dbids[0], ptbl.Name, "p3")
+	require.Equal(t, ErrPartitionNotExist, err, "DropPartition: wrong err")
+	err = catalog.DropPartition(1, dbids[0], ptbl.Name, "p1")
+	require.NoError(t, err, "DropPartition Fail")
+	table, err = catalog.GetTable(dbids[0], ptbl.Name)
+	require.NoError(t, err, "GetTable Fail")
+	require.Equal(t, 2, len(table.Partitions), "DropPartition: wrong partitions")
+	require.Equal(t, "p0", table.Partitions[0].Name, "DropPartition: wrong partitions")
+	require.Equal(t, "p2", table.Partitions[1].Name, "DropPartition: wrong partitions")
+	require.NotEqual(t, pid, table.Partitions[0].Id, "TruncatePartition: partition not replaced")
+
+	//test table statistics
+	stats, err := catalog.GetTableStatistics(createIds[0])
+	require.NoError(t, err, "GetTableStatistics Fail")
+	require.Nil(t, stats, "GetTableStatistics: statistics before analyzed")
+	err = catalog.SetTableStatistics(createIds[0], &engine.StatisticsDef{
+		Rows:    10,
+		Columns: []engine.ColumnStatistics{{Name: "mock_0", NDV: 5, Max: 9, Buckets: []engine.Bucket{{Upper: 9, Count: 10}}}},
+	})
+	require.NoError(t, err, "SetTableStatistics Fail")
+	stats, err = catalog.GetTableStatistics(createIds[0])
+	require.NoError(t, err, "GetTableStatistics Fail")
+	require.Equal(t, int64(10), stats.Rows, "GetTableStatistics: wrong rows")
+	require.Equal(t, int64(5), stats.Columns[0].NDV, "GetTableStatistics: wrong ndv")
+
 	//test DropTable
 	dropId, err := catalog.DropTable(0, dbids[0], testTables[0].Name)
 	require.NoError(t, err, "DropTable Fail")
@@ -362,6 +497,12 @@ func TestCatalogWithUtil(t *testing.T) {
 	cnt, err := catalog.RemoveDeletedTable(0)
 	require.NoError(t, err, "RemoveDeletedTable Fail")
 	require.Equal(t, cnt, 1, "RemoveDeletedTable: Wrong id")
+	stats, err = catalog.GetTableStatistics(createIds[0])
+	require.NoError(t, err, "GetTableStatistics Fail")
+	require.Nil(t, stats, "RemoveDeletedTable: statistics not removed")
+	cnt, err = catalog.RemoveDeletedTable(1)
+	require.NoError(t, err, "RemoveDeletedTable Fail")
+	require.Equal(t, 2, cnt, "RemoveDeletedTable: partitions not removed")
 
 	//test DropDatabase
 	for i := 0; i < databaseCount; i++ {
@@ -393,4 +534,90 @@ func TestCatalogWithUtil(t *testing.T) {
 	err = catalog.DropDatabase(0, testDatabaceName+strconv.Itoa(0))
 	require.Equal(t, ErrDBNotExists, err, "DropDatabase: DropDatabase wrong err")
 
+	//test users and roles
+	err = catalog.CreateUser(UserInfo{Name: "u1", Host: "%", Password: HashPassword("111")})
+	require.NoError(t, err, "CreateUser Fail")
+	err = catalog.CreateUser(UserInfo{Name: "u1", Host: "%"})
+	require.Equal(t, ErrUserExists, err, "CreateUser: wrong err")
+	err = catalog.CreateRole("r1")
+	require.NoError(t, err, "CreateRole Fail")
+	err = catalog.CreateRole("u1")
+	require.Equal(t, ErrUserExists, err, "CreateRole: wrong err")
+
+	user, err := catalog.GetUser("u1")
+	require.NoError(t, err, "GetUser Fail")
+	require.Equal(t, HashPassword("111"), user.Password, "GetUser: wrong password")
+	err = catalog.SetPassword("u1", "caching_sha2_password", HashSha2Password("222"))
+	require.NoError(t, err, "SetPassword Fail")
+	user, err = catalog.GetUser("u1")
+	require.NoError(t, err, "GetUser Fail")
+	require.Equal(t, "caching_sha2_password", user.Plugin, "GetUser: wrong plugin")
+	require.True(t, CheckSha2Password(user.Password, "222"), "GetUser: wrong password")
+
+	err = catalog.SetQueryLimits("u1", 1000, 1<<20)
+	require.NoError(t, err, "SetQueryLimits Fail")
+	user, err = catalog.GetUser("u1")
+	require.NoError(t, err, "GetUser Fail")
+	require.Equal(t, int64(1000), user.MaxExecutionTime, "GetUser: wrong max_execution_time")
+	require.Equal(t, int64(1<<20), user.QueryMemoryLimit, "GetUser: wrong query_memory_limit")
+	require.Equal(t, "caching_sha2_password", user.Plugin, "SetQueryLimits: plugin changed")
+
+	err = catalog.GrantPrivileges("u1", privilege.Grant{Database: "db", Table: "t", Privileges: privilege.Insert})
+	require.NoError(t, err, "GrantPrivileges Fail")
+	err = catalog.GrantPrivileges("r1", privilege.Grant{Database: "db", Privileges: privilege.Select})
+	require.NoError(t, err, "GrantPrivileges Fail")
+	err = catalog.GrantRole("r1", "u1")
+	require.NoError(t, err, "GrantRole Fail")
+	grants, err := catalog.GetPrivileges("u1")
+	require.NoError(t, err, "GetPrivileges Fail")
+	require.True(t, privilege.Allowed(grants, "db", "t", privilege.Select|privilege.Insert))
+
+	err = catalog.RevokePrivileges("u1", privilege.Grant{Database: "db", Table: "t", Privileges: privilege.Insert})
+	require.NoError(t, err, "RevokePrivileges Fail")
+	err = catalog.DropRole("r1")
+	require.NoError(t, err, "DropRole Fail")
+	grants, err = catalog.GetPrivileges("u1")
+	require.NoError(t, err, "GetPrivileges Fail")
+	require.Equal(t, 0, len(grants), "GetPrivileges: wrong grants")
+
+	// the concurrent grants of all the nodes are all kept
+	var wg sync.WaitGroup
+	catalogs := []*Catalog{catalog, NewCatalog(c.CubeDrivers[1]), NewCatalog(c.CubeDrivers[2])}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(catalog *Catalog, tbl string) {
+			defer wg.Done()
+			_ = catalog.GrantPrivileges("u1", privilege.Grant{Database: "db", Table: tbl, Privileges: privilege.Select})
+		}(catalogs[i%len(catalogs)], fmt.Sprintf("t%d", i))
+	}
+	wg.Wait()
+	grants, err = catalog.GetPrivileges("u1")
+	require.NoError(t, err, "GetPrivileges Fail")
+	require.Equal(t, 8, len(grants), "GrantPrivileges: lost concurrent grants")
+
+	// the expired lock of a node which crashed is taken over
+	expired, _ := json.Marshal(authLockInfo{Token: "crashed", Expires: time.Now().Add(-time.Second).UnixNano()})
+	require.NoError(t, driver.Set(catalog.authLockKey(""), expired))
+	err = catalog.SetQueryLimits("u1", 0, 0)
+	require.NoError(t, err, "SetQueryLimits: expired lock not taken over")
+	value, err := driver.Get(catalog.authLockKey(""))
+	require.NoError(t, err)
+	require.Nil(t, value, "the lock of the accounts is not released")
+
+	err = catalog.DropUser("u1")
+	require.NoError(t, err, "DropUser Fail")
+	_, err = catalog.GetUser("u1")
+	require.Equal(t, ErrUserNotExists, err, "DropUser: GetUser wrong err")
+}
+
+func TestSha2Password(t *testing.T) {
+	hash := HashSha2Password("111")
+	require.True(t, CheckSha2Password(hash, "111"))
+	require.False(t, CheckSha2Password(hash, "112"))
+	require.False(t, CheckSha2Password(hash, ""))
+	require.NotEqual(t, hash, HashSha2Password("111"))
+
+	require.Nil(t, HashSha2Password(""))
+	require.True(t, CheckSha2Password(nil, ""))
+	require.False(t, CheckSha2Password(nil, "111"))
 }
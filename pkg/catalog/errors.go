@@ -45,6 +45,24 @@ var (
 	ErrInvalidIndexType = errors.New("index type invalid")
 	//ErrColumnNotExist is the error that the column required by index or primary key doesn't exist in the table.
 	ErrColumnNotExist = errors.New("column not exist")
+	//ErrColumnExists is the error for adding a column or renaming a column to a name used by another column.
+	ErrColumnExists = errors.New("column already exists")
+	//ErrColumnIndexed is the error for dropping a column used by an index.
+	ErrColumnIndexed = errors.New("column is used by an index")
+	//ErrDropAllColumns is the error for dropping the last column of a table.
+	ErrDropAllColumns = errors.New("can't drop all columns of a table")
+	//ErrTooManyVersions is the error for adding or dropping a column of a table whose versions storing rows reach the limit.
+	ErrTooManyVersions = errors.New("too many versions of the table")
+	//ErrPartitionedTable is the error for adding, dropping or renaming a column of a partitioned table.
+	ErrPartitionedTable = errors.New("can't change the columns of a partitioned table")
+	//ErrNotPartitioned is the error for altering the partitions of a table which is not partitioned.
+	ErrNotPartitioned = errors.New("table is not partitioned")
+	//ErrPartitionExists is the error for adding a partition whose name is used by another partition.
+	ErrPartitionExists = errors.New("partition already exists")
+	//ErrPartitionNotExist is the error for dropping or truncating a partition that doesn't exist.
+	ErrPartitionNotExist = errors.New("partition not exist")
+	//ErrDropAllPartitions is the error for dropping the last partition of a table.
+	ErrDropAllPartitions = errors.New("can't drop all partitions of a table")
 	//ErrPrimaryKeyNotExist is the error for primary key not exist.
 	ErrPrimaryKeyNotExist = errors.New("primary key not exist")
 	//ErrIndexExist is the error for duplicated index name.
@@ -53,4 +71,14 @@ var (
 	ErrIndexNotExist = errors.New("index not exist")
 	//ErrShardPending is for pending shards
 	ErrShardPending = errors.New("shard is pending")
+	//ErrUserExists is the error for creating a user or a role whose name is used.
+	ErrUserExists = errors.New("user already exists")
+	//ErrUserNotExists is the error for user not exist.
+	ErrUserNotExists = errors.New("user not exist")
+	//ErrRoleExists is the error for creating a role or a user whose name is used by a role.
+	ErrRoleExists = errors.New("role already exists")
+	//ErrRoleNotExists is the error for role not exist.
+	ErrRoleNotExists = errors.New("role not exist")
+	//ErrAuthLockTimeout is the error for timeout when waiting for the lock of the accounts and roles.
+	ErrAuthLockTimeout = errors.New("lock accounts timeout")
 )
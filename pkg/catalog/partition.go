@@ -0,0 +1,228 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/protocol"
+)
+
+// createPartitions creates bucket tablets for every partition of the table,
+// a partitioned table has no tablets itself.
+func (c *Catalog) createPartitions(tbl *aoe.TableInfo, bucket int) error {
+	def, _, err := protocol.DecodePartition(tbl.Partition)
+	if err != nil {
+		return err
+	}
+	if def.Len() == 0 {
+		return ErrPartitionNotExist
+	}
+	if err = checkPartitionNames(tbl, def.Names()); err != nil {
+		return err
+	}
+	for _, name := range def.Names() {
+		v, err := c.createVersion(tbl, bucket)
+		if err != nil {
+			return err
+		}
+		tbl.Partitions = append(tbl.Partitions, aoe.TablePartition{Name: name, Id: v.Id})
+	}
+	return nil
+}
+
+// AddPartitions appends the partitions of the def to the partitions of the
+// table, they are empty.
+func (c *Catalog) AddPartitions(epoch, dbId uint64, tableName string, def *engine.PartitionByDef) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("AddPartitions cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	if len(tbl.Partitions) == 0 {
+		return ErrNotPartitioned
+	}
+	if err = checkPartitionNames(tbl, def.Names()); err != nil {
+		return err
+	}
+	pdef, _, err := protocol.DecodePartition(tbl.Partition)
+	if err != nil {
+		return err
+	}
+	pdef.List = append(pdef.List, def.List...)
+	pdef.Range = append(pdef.Range, def.Range...)
+	if err = pdef.Check(); err != nil {
+		return err
+	}
+	if tbl.Partition, err = encodePartition(pdef); err != nil {
+		return err
+	}
+	bucket, err := c.partitionBucket(tbl.Partitions[0])
+	if err != nil {
+		return err
+	}
+	for _, name := range def.Names() {
+		v, err := c.createVersion(tbl, bucket)
+		if err != nil {
+			return err
+		}
+		tbl.Partitions = append(tbl.Partitions, aoe.TablePartition{Name: name, Id: v.Id})
+	}
+	tbl.Epoch = epoch
+	return c.updateTableInfo(dbId, tbl)
+}
+
+// DropPartition drops a partition of the table and its rows. The rows of a
+// dropped RANGE partition are held by the next partition if written again.
+func (c *Catalog) DropPartition(epoch, dbId uint64, tableName, name string) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("DropPartition cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	if len(tbl.Partitions) == 0 {
+		return ErrNotPartitioned
+	}
+	i := partitionIndex(tbl, name)
+	if i < 0 {
+		return ErrPartitionNotExist
+	}
+	if len(tbl.Partitions) == 1 {
+		return ErrDropAllPartitions
+	}
+	pdef, _, err := protocol.DecodePartition(tbl.Partition)
+	if err != nil {
+		return err
+	}
+	if i < len(pdef.List) {
+		pdef.List = append(pdef.List[:i], pdef.List[i+1:]...)
+	} else {
+		j := i - len(pdef.List)
+		if j+1 < len(pdef.Range) {
+			pdef.Range[j+1].From = pdef.Range[j].From
+		}
+		pdef.Range = append(pdef.Range[:j], pdef.Range[j+1:]...)
+	}
+	if tbl.Partition, err = encodePartition(pdef); err != nil {
+		return err
+	}
+	p := tbl.Partitions[i]
+	tbl.Partitions = append(tbl.Partitions[:i], tbl.Partitions[i+1:]...)
+	tbl.Epoch = epoch
+	if err = c.updateTableInfo(dbId, tbl); err != nil {
+		return err
+	}
+	return c.removePartition(epoch, dbId, tbl, p)
+}
+
+// TruncatePartition removes all the rows of a partition of the table, the
+// partition is stored by a new physical table afterwards.
+func (c *Catalog) TruncatePartition(epoch, dbId uint64, tableName, name string) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("TruncatePartition cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	if len(tbl.Partitions) == 0 {
+		return ErrNotPartitioned
+	}
+	i := partitionIndex(tbl, name)
+	if i < 0 {
+		return ErrPartitionNotExist
+	}
+	p := tbl.Partitions[i]
+	bucket, err := c.partitionBucket(p)
+	if err != nil {
+		return err
+	}
+	v, err := c.createVersion(tbl, bucket)
+	if err != nil {
+		return err
+	}
+	tbl.Partitions[i].Id = v.Id
+	tbl.Epoch = epoch
+	if err = c.updateTableInfo(dbId, tbl); err != nil {
+		return err
+	}
+	return c.removePartition(epoch, dbId, tbl, p)
+}
+
+// removePartition queues the physical table of a dropped or truncated
+// partition, its tablets are dropped with the tables dropped in the epoch.
+func (c *Catalog) removePartition(epoch, dbId uint64, tbl *aoe.TableInfo, p aoe.TablePartition) error {
+	ptbl := VersionTable(*tbl, newVersion(tbl, p.Id))
+	ptbl.State = aoe.StateDeleteOnly
+	ptbl.Epoch = epoch
+	value, err := EncodeTable(ptbl)
+	if err != nil {
+		return err
+	}
+	return c.Driver.Set(c.deletedTableKey(epoch, dbId, p.Id), value)
+}
+
+// partitionBucket returns the number of tablets of the partition.
+func (c *Catalog) partitionBucket(p aoe.TablePartition) (int, error) {
+	sids, err := c.getShardidsWithTimeout(p.Id)
+	if err != nil {
+		return 0, err
+	}
+	if len(sids) < 1 {
+		return 1, nil
+	}
+	return len(sids), nil
+}
+
+// checkPartitionNames checks that the names of new partitions are used by
+// neither the partitions of the table nor each other.
+func checkPartitionNames(tbl *aoe.TableInfo, names []string) error {
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok || partitionIndex(tbl, name) >= 0 {
+			return ErrPartitionExists
+		}
+		seen[name] = struct{}{}
+	}
+	return nil
+}
+
+func partitionIndex(tbl *aoe.TableInfo, name string) int {
+	for i, p := range tbl.Partitions {
+		if p.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func encodePartition(def *engine.PartitionByDef) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := protocol.EncodePartition(def, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
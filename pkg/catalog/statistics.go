@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const cStatisticsPrefix = "Statistics"
+
+// SetTableStatistics replaces the statistics of the table tid collected by ANALYZE TABLE.
+func (c *Catalog) SetTableStatistics(tid uint64, stats *engine.StatisticsDef) error {
+	value, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return c.Driver.Set(c.statisticsKey(tid), value)
+}
+
+// GetTableStatistics returns the statistics of the table tid, it returns nil if
+// the table has not been analyzed.
+func (c *Catalog) GetTableStatistics(tid uint64) (*engine.StatisticsDef, error) {
+	value, err := c.Driver.Get(c.statisticsKey(tid))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	stats := new(engine.StatisticsDef)
+	if err = json.Unmarshal(value, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// statisticsKey returns the encoded table id with prefix "meta1Statistics"
+func (c *Catalog) statisticsKey(tid uint64) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cStatisticsPrefix, tid)
+}
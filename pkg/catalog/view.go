@@ -0,0 +1,58 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+)
+
+// CreateView creates a view in the database. A view is stored as the meta of
+// a table whose View is its query, it has no tablets.
+func (c *Catalog) CreateView(epoch, dbId uint64, tbl aoe.TableInfo) (tid uint64, err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("CreateView finished, view name is %v, id is %d, cost %d ms", tbl.Name, tid, time.Since(t0).Milliseconds())
+	}()
+	if _, err = c.checkDBExists(dbId); err != nil {
+		return tid, err
+	}
+	if len(tbl.View) == 0 {
+		return tid, ErrTabletCreateFailed
+	}
+	if tid, err = c.allocId(cTableIDPrefix); err != nil {
+		return tid, err
+	}
+	if err = c.Driver.SetIfNotExist(c.tableIDKey(dbId, tbl.Name), Uint642Bytes(tid)); err != nil {
+		return tid, ErrTableCreateExists
+	}
+	tbl.Id = tid
+	tbl.Epoch = epoch
+	tbl.SchemaId = dbId
+	tbl.State = aoe.StatePublic
+	meta, err := EncodeTable(tbl)
+	if err == nil {
+		err = c.Driver.Set(c.tableKey(dbId, tid), meta)
+	}
+	if err != nil {
+		if serr := c.Driver.Delete(c.tableIDKey(dbId, tbl.Name)); serr != nil {
+			logutil.Errorf("delete meta for uncreated view, %v, %v, %v", dbId, tbl.Name, serr)
+		}
+		return tid, err
+	}
+	return tid, nil
+}
@@ -0,0 +1,432 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/snappy"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/pierrec/lz4"
+)
+
+var (
+	// days between 0001-01-01 and 1970-01-01
+	unixEpochDays = int64(types.FromCalendar(1970, 1, 1))
+)
+
+func NewWriter(w io.Writer, cols []Column, codec Codec) (*Writer, error) {
+	for _, col := range cols {
+		if _, _, err := physicalType(col.Typ); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := w.Write([]byte(Magic)); err != nil {
+		return nil, err
+	}
+	return &Writer{
+		w:      w,
+		cols:   cols,
+		codec:  codec,
+		offset: int64(len(Magic)),
+	}, nil
+}
+
+// Rows returns the number of rows written
+func (w *Writer) Rows() int64 {
+	return w.rows
+}
+
+// Write appends the batch as a new row group, rows are expanded
+// by the selection list and the ring counts of the batch.
+func (w *Writer) Write(bat *batch.Batch) error {
+	if len(bat.Vecs) != len(w.cols) {
+		return fmt.Errorf("batch has %v columns, file has %v columns", len(bat.Vecs), len(w.cols))
+	}
+	if len(bat.Vecs) == 0 {
+		return nil
+	}
+	rows := rowIndexes(bat)
+	if len(rows) == 0 {
+		return nil
+	}
+	rg := rowGroup{
+		rows:    int64(len(rows)),
+		columns: make([]columnChunk, len(w.cols)),
+	}
+	for i, vec := range bat.Vecs {
+		if vec.Typ.Oid != w.cols[i].Typ {
+			return fmt.Errorf("column '%s' has type %s, got %s", w.cols[i].Name, w.cols[i].Typ, vec.Typ.Oid)
+		}
+		chunk, err := w.writeColumn(vec, rows)
+		if err != nil {
+			return err
+		}
+		rg.size += chunk.uncompressedSize
+		rg.columns[i] = chunk
+	}
+	w.rows += rg.rows
+	w.groups = append(w.groups, rg)
+	return nil
+}
+
+// Close writes the file footer, the underlying writer is not closed.
+func (w *Writer) Close() error {
+	meta := w.fileMetaData()
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(meta)))
+	for _, data := range [][]byte{meta, size[:], []byte(Magic)} {
+		if err := w.write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.offset += int64(n)
+	return err
+}
+
+func (w *Writer) writeColumn(vec *vector.Vector, rows []int64) (columnChunk, error) {
+	var data bytes.Buffer
+
+	levels, values := make([]bool, len(rows)), make([]int64, 0, len(rows))
+	for i, row := range rows {
+		if !nulls.Contains(vec.Nsp, uint64(row)) {
+			levels[i] = true
+			values = append(values, row)
+		}
+	}
+	encodeLevels(&data, levels)
+	if err := encodeValues(&data, vec, values); err != nil {
+		return columnChunk{}, err
+	}
+	page, err := compress(data.Bytes(), w.codec)
+	if err != nil {
+		return columnChunk{}, err
+	}
+
+	cw := newCompactWriter()
+	cw.I32(1, pageTypeData)
+	cw.I32(2, int32(data.Len()))
+	cw.I32(3, int32(len(page)))
+	cw.Struct(5, func() {
+		cw.I32(1, int32(len(rows)))
+		cw.I32(2, encodingPlain)
+		cw.I32(3, encodingRle)
+		cw.I32(4, encodingRle)
+	})
+	cw.Stop()
+	header := cw.Bytes()
+
+	chunk := columnChunk{
+		offset:           w.offset,
+		numValues:        int64(len(rows)),
+		uncompressedSize: int64(len(header) + data.Len()),
+		compressedSize:   int64(len(header) + len(page)),
+	}
+	if err := w.write(header); err != nil {
+		return chunk, err
+	}
+	if err := w.write(page); err != nil {
+		return chunk, err
+	}
+	return chunk, nil
+}
+
+func (w *Writer) fileMetaData() []byte {
+	cw := newCompactWriter()
+	cw.I32(1, 1)
+	cw.StructList(2, len(w.cols)+1, func(i int) {
+		if i == 0 {
+			cw.String(4, "schema")
+			cw.I32(5, int32(len(w.cols)))
+			return
+		}
+		col := w.cols[i-1]
+		typ, converted, _ := physicalType(col.Typ)
+		cw.I32(1, typ)
+		cw.I32(3, repetitionOptional)
+		cw.String(4, col.Name)
+		if converted >= 0 {
+			cw.I32(6, converted)
+		}
+	})
+	cw.I64(3, w.rows)
+	cw.StructList(4, len(w.groups), func(i int) {
+		rg := w.groups[i]
+		cw.StructList(1, len(rg.columns), func(j int) {
+			chunk := rg.columns[j]
+			typ, _, _ := physicalType(w.cols[j].Typ)
+			cw.I64(2, chunk.offset)
+			cw.Struct(3, func() {
+				cw.I32(1, typ)
+				cw.I32List(2, []int32{encodingPlain, encodingRle})
+				cw.StringList(3, []string{w.cols[j].Name})
+				cw.I32(4, int32(w.codec))
+				cw.I64(5, chunk.numValues)
+				cw.I64(6, chunk.uncompressedSize)
+				cw.I64(7, chunk.compressedSize)
+				cw.I64(9, chunk.offset)
+			})
+		})
+		cw.I64(2, rg.size)
+		cw.I64(3, rg.rows)
+	})
+	cw.String(6, CreatedBy)
+	cw.Stop()
+	return cw.Bytes()
+}
+
+// physicalType returns the physical type and the converted type
+// (-1 if none) used to store the column type.
+func physicalType(typ types.T) (int32, int32, error) {
+	switch typ {
+	case types.T_int8:
+		return typeInt32, convertedInt8, nil
+	case types.T_int16:
+		return typeInt32, convertedInt16, nil
+	case types.T_int32:
+		return typeInt32, convertedInt32, nil
+	case types.T_int64:
+		return typeInt64, convertedInt64, nil
+	case types.T_uint8:
+		return typeInt32, convertedUint8, nil
+	case types.T_uint16:
+		return typeInt32, convertedUint16, nil
+	case types.T_uint32:
+		return typeInt32, convertedUint32, nil
+	case types.T_uint64:
+		return typeInt64, convertedUint64, nil
+	case types.T_float32:
+		return typeFloat, -1, nil
+	case types.T_float64:
+		return typeDouble, -1, nil
+	case types.T_char, types.T_varchar:
+		return typeByteArray, convertedUTF8, nil
+	case types.T_date:
+		return typeInt32, convertedDate, nil
+	case types.T_datetime:
+		return typeInt64, convertedTimestampMicros, nil
+	}
+	return 0, 0, fmt.Errorf("parquet: unsupported column type %s", typ)
+}
+
+func rowIndexes(bat *batch.Batch) []int64 {
+	var rows []int64
+
+	n := vector.Length(bat.Vecs[0])
+	if len(bat.Sels) > 0 {
+		n = len(bat.Sels)
+	}
+	for i := 0; i < n; i++ {
+		row := int64(i)
+		if len(bat.Sels) > 0 {
+			row = bat.Sels[i]
+		}
+		if len(bat.Zs) == 0 {
+			rows = append(rows, row)
+			continue
+		}
+		for j := int64(0); j < bat.Zs[i]; j++ {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// encodeLevels writes the definition levels as runs of the
+// RLE/bit-packing hybrid encoding prefixed by their length.
+func encodeLevels(buf *bytes.Buffer, levels []bool) {
+	var tmp [binary.MaxVarintLen64]byte
+	var runs bytes.Buffer
+
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		n := binary.PutUvarint(tmp[:], uint64(j-i)<<1)
+		runs.Write(tmp[:n])
+		if levels[i] {
+			runs.WriteByte(1)
+		} else {
+			runs.WriteByte(0)
+		}
+		i = j
+	}
+	binary.Write(buf, binary.LittleEndian, uint32(runs.Len()))
+	buf.Write(runs.Bytes())
+}
+
+// encodeValues writes the not null values with the plain encoding.
+func encodeValues(buf *bytes.Buffer, vec *vector.Vector, rows []int64) error {
+	var b [8]byte
+
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		vs := vec.Col.([]int8)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], uint32(int32(vs[row])))
+			buf.Write(b[:4])
+		}
+	case types.T_int16:
+		vs := vec.Col.([]int16)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], uint32(int32(vs[row])))
+			buf.Write(b[:4])
+		}
+	case types.T_int32:
+		vs := vec.Col.([]int32)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], uint32(vs[row]))
+			buf.Write(b[:4])
+		}
+	case types.T_int64:
+		vs := vec.Col.([]int64)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint64(b[:], uint64(vs[row]))
+			buf.Write(b[:8])
+		}
+	case types.T_uint8:
+		vs := vec.Col.([]uint8)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], uint32(vs[row]))
+			buf.Write(b[:4])
+		}
+	case types.T_uint16:
+		vs := vec.Col.([]uint16)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], uint32(vs[row]))
+			buf.Write(b[:4])
+		}
+	case types.T_uint32:
+		vs := vec.Col.([]uint32)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], vs[row])
+			buf.Write(b[:4])
+		}
+	case types.T_uint64:
+		vs := vec.Col.([]uint64)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint64(b[:], vs[row])
+			buf.Write(b[:8])
+		}
+	case types.T_float32:
+		vs := vec.Col.([]float32)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], math.Float32bits(vs[row]))
+			buf.Write(b[:4])
+		}
+	case types.T_float64:
+		vs := vec.Col.([]float64)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(vs[row]))
+			buf.Write(b[:8])
+		}
+	case types.T_char, types.T_varchar:
+		vs := vec.Col.(*types.Bytes)
+		for _, row := range rows {
+			v := vs.Get(row)
+			binary.LittleEndian.PutUint32(b[:], uint32(len(v)))
+			buf.Write(b[:4])
+			buf.Write(v)
+		}
+	case types.T_date:
+		vs := vec.Col.([]types.Date)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint32(b[:], uint32(int32(int64(vs[row])-unixEpochDays)))
+			buf.Write(b[:4])
+		}
+	case types.T_datetime:
+		vs := vec.Col.([]types.Datetime)
+		for _, row := range rows {
+			binary.LittleEndian.PutUint64(b[:], uint64(datetimeToUnixMicros(vs[row])))
+			buf.Write(b[:8])
+		}
+	default:
+		return fmt.Errorf("parquet: unsupported column type %s", vec.Typ.Oid)
+	}
+	return nil
+}
+
+// datetimeToUnixMicros converts the datetime, which holds the seconds since
+// 0001-01-01 in the higher 44 bits and the microseconds in the lower 20 bits,
+// to the microseconds since the unix epoch.
+func datetimeToUnixMicros(dt types.Datetime) int64 {
+	secs := int64(dt)>>20 - unixEpochDays*24*60*60
+	return secs*1000000 + int64(dt)&0xfffff
+}
+
+func compress(data []byte, codec Codec) ([]byte, error) {
+	switch codec {
+	case Uncompressed:
+		return data, nil
+	case Snappy:
+		return snappy.Encode(nil, data), nil
+	case Gzip:
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case Lz4Raw:
+		dst := make([]byte, lz4.CompressBlockBound(len(data)))
+		var ht [1 << 16]int
+		n, err := lz4.CompressBlock(data, dst, ht[:])
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			// the data is incompressible, the block is stored
+			// as a single literal sequence.
+			return lz4Literals(data), nil
+		}
+		return dst[:n], nil
+	}
+	return nil, fmt.Errorf("parquet: unsupported codec %v", codec)
+}
+
+// lz4Literals encodes the data as a lz4 block made of one literal sequence.
+func lz4Literals(data []byte) []byte {
+	var buf bytes.Buffer
+
+	n := len(data)
+	if n < 15 {
+		buf.WriteByte(byte(n) << 4)
+	} else {
+		buf.WriteByte(0xf0)
+		for n -= 15; n >= 255; n -= 255 {
+			buf.WriteByte(255)
+		}
+		buf.WriteByte(byte(n))
+	}
+	buf.Write(data)
+	return buf.Bytes()
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// element types of the thrift compact protocol
+const (
+	compactI32    byte = 5
+	compactI64    byte = 6
+	compactBinary byte = 8
+	compactList   byte = 9
+	compactStruct byte = 12
+)
+
+// compactWriter is a minimal encoder of the thrift compact protocol,
+// it only supports the subset used by the parquet metadata.
+type compactWriter struct {
+	buf bytes.Buffer
+	// last field id of every nested struct
+	last []int16
+	tmp  [binary.MaxVarintLen64]byte
+}
+
+func newCompactWriter() *compactWriter {
+	return &compactWriter{last: []int16{0}}
+}
+
+func (w *compactWriter) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+func (w *compactWriter) uvarint(v uint64) {
+	n := binary.PutUvarint(w.tmp[:], v)
+	w.buf.Write(w.tmp[:n])
+}
+
+func (w *compactWriter) varint(v int64) {
+	w.uvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *compactWriter) fieldHeader(id int16, typ byte) {
+	last := w.last[len(w.last)-1]
+	if delta := id - last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.varint(int64(id))
+	}
+	w.last[len(w.last)-1] = id
+}
+
+func (w *compactWriter) listHeader(typ byte, n int) {
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | typ)
+	} else {
+		w.buf.WriteByte(0xf0 | typ)
+		w.uvarint(uint64(n))
+	}
+}
+
+func (w *compactWriter) I32(id int16, v int32) {
+	w.fieldHeader(id, compactI32)
+	w.varint(int64(v))
+}
+
+func (w *compactWriter) I64(id int16, v int64) {
+	w.fieldHeader(id, compactI64)
+	w.varint(v)
+}
+
+func (w *compactWriter) String(id int16, v string) {
+	w.fieldHeader(id, compactBinary)
+	w.uvarint(uint64(len(v)))
+	w.buf.WriteString(v)
+}
+
+func (w *compactWriter) I32List(id int16, vs []int32) {
+	w.fieldHeader(id, compactList)
+	w.listHeader(compactI32, len(vs))
+	for _, v := range vs {
+		w.varint(int64(v))
+	}
+}
+
+func (w *compactWriter) StringList(id int16, vs []string) {
+	w.fieldHeader(id, compactList)
+	w.listHeader(compactBinary, len(vs))
+	for _, v := range vs {
+		w.uvarint(uint64(len(v)))
+		w.buf.WriteString(v)
+	}
+}
+
+// StructList writes a list of n structs, fn is called to fill the fields of
+// the i-th struct.
+func (w *compactWriter) StructList(id int16, n int, fn func(int)) {
+	w.fieldHeader(id, compactList)
+	w.listHeader(compactStruct, n)
+	for i := 0; i < n; i++ {
+		w.last = append(w.last, 0)
+		fn(i)
+		w.Stop()
+	}
+}
+
+// Struct writes a nested struct field, fn is called to fill its fields.
+func (w *compactWriter) Struct(id int16, fn func()) {
+	w.fieldHeader(id, compactStruct)
+	w.last = append(w.last, 0)
+	fn()
+	w.Stop()
+}
+
+// Stop ends the current struct.
+func (w *compactWriter) Stop() {
+	w.buf.WriteByte(0)
+	if len(w.last) > 1 {
+		w.last = w.last[:len(w.last)-1]
+	}
+}
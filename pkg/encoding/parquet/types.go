@@ -0,0 +1,109 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	Magic = "PAR1"
+
+	CreatedBy = "matrixone"
+)
+
+// Codec is the compression codec of the column chunks, the values
+// are the same as the CompressionCodec enum of parquet.thrift
+type Codec int32
+
+const (
+	Uncompressed Codec = 0
+	Snappy       Codec = 1
+	Gzip         Codec = 2
+	Lz4Raw       Codec = 7
+)
+
+var Codecs map[string]Codec = map[string]Codec{
+	"none":   Uncompressed,
+	"snappy": Snappy,
+	"gzip":   Gzip,
+	"lz4":    Lz4Raw,
+}
+
+// physical types of parquet.thrift
+const (
+	typeBoolean   int32 = 0
+	typeInt32     int32 = 1
+	typeInt64     int32 = 2
+	typeFloat     int32 = 4
+	typeDouble    int32 = 5
+	typeByteArray int32 = 6
+)
+
+// converted types of parquet.thrift
+const (
+	convertedUTF8            int32 = 0
+	convertedDate            int32 = 6
+	convertedTimestampMicros int32 = 10
+	convertedUint8           int32 = 11
+	convertedUint16          int32 = 12
+	convertedUint32          int32 = 13
+	convertedUint64          int32 = 14
+	convertedInt8            int32 = 15
+	convertedInt16           int32 = 16
+	convertedInt32           int32 = 17
+	convertedInt64           int32 = 18
+)
+
+const (
+	repetitionOptional int32 = 1
+
+	encodingPlain int32 = 0
+	encodingRle   int32 = 3
+
+	pageTypeData int32 = 0
+)
+
+// Column describes a column of the written file
+type Column struct {
+	Name string
+	Typ  types.T
+}
+
+type columnChunk struct {
+	offset           int64
+	numValues        int64
+	uncompressedSize int64
+	compressedSize   int64
+}
+
+type rowGroup struct {
+	rows    int64
+	size    int64
+	columns []columnChunk
+}
+
+// Writer writes batches into a parquet file, every batch
+// is written as a row group with one data page per column.
+type Writer struct {
+	w      io.Writer
+	codec  Codec
+	offset int64
+	rows   int64
+	cols   []Column
+	groups []rowGroup
+}
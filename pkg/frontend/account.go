@@ -0,0 +1,559 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+// accountStore is the part of the catalog which keeps the accounts
+type accountStore interface {
+	GetUser(name string) (*catalog.UserInfo, error)
+}
+
+// the root and the dump users are configured by the system variables,
+// they are not stored in the catalog and hold all privileges.
+func (mce *MysqlCmdExecutor) isSuperUser(name string) bool {
+	sv := mce.GetSession().Pu.SV
+	return name == sv.GetRootname() || name == sv.GetDumpuser()
+}
+
+// accountChecker checks the privileges of an account, and of the definers
+// of the views read by the account.
+type accountChecker struct {
+	*privilege.Checker // nil if the account holds all privileges
+	mce                *MysqlCmdExecutor
+}
+
+func (c *accountChecker) CheckPrivilege(db, tbl string, p privilege.Privilege) error {
+	if c.Checker == nil {
+		return nil
+	}
+	return c.Checker.CheckPrivilege(db, tbl, p)
+}
+
+// Definer returns the checker of the privileges of the definer of a view,
+// the grants of the definer are read when the view is read.
+func (c *accountChecker) Definer(user string) (plan.PrivilegeChecker, error) {
+	dc, err := c.mce.accountChecker(user)
+	if err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+// privilegeChecker returns the checker of the privileges of the user of the session.
+// nil is returned when the user holds all privileges or there are no accounts
+// without the cluster catalog.
+func (mce *MysqlCmdExecutor) privilegeChecker() (plan.PrivilegeChecker, error) {
+	ses := mce.GetSession()
+	name := ses.GetMysqlProtocol().GetUserName()
+	if ses.Pu.ClusterCatalog == nil || mce.isSuperUser(name) {
+		return nil, nil
+	}
+	c, err := mce.accountChecker(name)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// accountChecker returns the checker of the privileges of the account name
+func (mce *MysqlCmdExecutor) accountChecker(name string) (*accountChecker, error) {
+	ses := mce.GetSession()
+	c := &accountChecker{mce: mce}
+	if ses.Pu.ClusterCatalog == nil || mce.isSuperUser(name) {
+		return c, nil
+	}
+	grants, err := ses.Pu.ClusterCatalog.GetPrivileges(name)
+	if err != nil {
+		return nil, err
+	}
+	c.Checker = privilege.NewChecker(name, "%", grants)
+	return c, nil
+}
+
+func (mce *MysqlCmdExecutor) accountCatalog() (*catalog.Catalog, error) {
+	ses := mce.GetSession()
+	if ses.Pu.ClusterCatalog == nil {
+		return nil, fmt.Errorf("need cluster catalog")
+	}
+	return ses.Pu.ClusterCatalog, nil
+}
+
+// checkSuperUser returns an error if the user of the session is not a super user
+func (mce *MysqlCmdExecutor) checkSuperUser(op string) error {
+	if !mce.isSuperUser(mce.GetSession().GetMysqlProtocol().GetUserName()) {
+		return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, op)
+	}
+	return nil
+}
+
+func (mce *MysqlCmdExecutor) sendAccountOk() error {
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), nil)
+	if err := mce.GetSession().GetMysqlProtocol().SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+func accountName(name, host string) string {
+	if host == "" {
+		host = "%"
+	}
+	return fmt.Sprintf("'%s'@'%s'", name, host)
+}
+
+// userPassword returns the authentication plugin and the password hash given by
+// IDENTIFIED [WITH plugin] BY 'password' or IDENTIFIED [WITH plugin] AS '*hash',
+// plugin is used when the statement does not name one.
+func userPassword(u *tree.User, plugin string) (string, []byte, error) {
+	if u.AuthPlugin != "" {
+		plugin = strings.ToLower(u.AuthPlugin)
+	}
+	if plugin == "" {
+		plugin = AuthNativePassword
+	}
+	if plugin != AuthNativePassword && plugin != AuthCachingSha2Password {
+		return "", nil, NewMysqlError(ER_PLUGIN_IS_NOT_LOADED, u.AuthPlugin)
+	}
+	if u.HashString != "" {
+		//only the hash of mysql_native_password can be given
+		data, err := hex.DecodeString(strings.TrimPrefix(u.HashString, "*"))
+		if err != nil || len(data) != 20 || plugin != AuthNativePassword {
+			return "", nil, NewMysqlError(ER_PASSWORD_FORMAT)
+		}
+		return plugin, data, nil
+	}
+	return plugin, passwordHash(plugin, u.AuthString), nil
+}
+
+// queryLimitOptions returns max_execution_time and query_memory_limit given by
+// WITH MAX_EXECUTION_TIME n QUERY_MEMORY_LIMIT n, the values are kept if the
+// options are missing.
+func queryLimitOptions(opts []tree.ResourceOption, maxTime, memory int64) (int64, int64) {
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case *tree.ResourceOptionMaxExecutionTime:
+			maxTime = o.Millis
+		case *tree.ResourceOptionQueryMemoryLimit:
+			memory = o.Size
+		}
+	}
+	return maxTime, memory
+}
+
+// identified returns true if the user is given with IDENTIFIED
+func identified(u *tree.User) bool {
+	return u.ByAuth || u.AuthPlugin != "" || u.HashString != ""
+}
+
+// passwordHash returns the hash of the password kept for the plugin
+func passwordHash(plugin, password string) []byte {
+	if plugin == AuthCachingSha2Password {
+		return catalog.HashSha2Password(password)
+	}
+	return catalog.HashPassword(password)
+}
+
+/*
+handle CREATE USER
+*/
+func (mce *MysqlCmdExecutor) handleCreateUser(cu *tree.CreateUser) error {
+	if err := mce.checkSuperUser("CREATE USER"); err != nil {
+		return err
+	}
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	for _, u := range cu.Users {
+		plugin, password, err := userPassword(u, mce.GetSession().Pu.SV.GetDefaultAuthenticationPlugin())
+		if err != nil {
+			return err
+		}
+		if mce.isSuperUser(u.Username) {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE USER", accountName(u.Username, u.Hostname))
+		}
+		maxTime, memory := queryLimitOptions(cu.ResOpts, 0, 0)
+		err = c.CreateUser(catalog.UserInfo{
+			Name:             u.Username,
+			Host:             u.Hostname,
+			Plugin:           plugin,
+			Password:         password,
+			MaxExecutionTime: maxTime,
+			QueryMemoryLimit: memory,
+		})
+		if err == catalog.ErrUserExists && cu.IfNotExists {
+			continue
+		}
+		if err != nil {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE USER", accountName(u.Username, u.Hostname))
+		}
+		for _, r := range cu.Roles {
+			if err = c.GrantRole(r.UserName, u.Username); err != nil {
+				return err
+			}
+		}
+	}
+	return mce.sendAccountOk()
+}
+
+/*
+handle DROP USER
+*/
+func (mce *MysqlCmdExecutor) handleDropUser(du *tree.DropUser) error {
+	if err := mce.checkSuperUser("CREATE USER"); err != nil {
+		return err
+	}
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	for _, u := range du.Users {
+		err = c.DropUser(u.Username)
+		if err == catalog.ErrUserNotExists && du.IfExists {
+			continue
+		}
+		if err != nil {
+			return NewMysqlError(ER_CANNOT_USER, "DROP USER", accountName(u.Username, u.Hostname))
+		}
+	}
+	return mce.sendAccountOk()
+}
+
+/*
+handle ALTER USER ... IDENTIFIED BY and ALTER USER ... WITH MAX_EXECUTION_TIME n QUERY_MEMORY_LIMIT n
+*/
+func (mce *MysqlCmdExecutor) handleAlterUser(au *tree.AlterUser) error {
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	users := au.Users
+	if au.IsUserFunc {
+		u := *au.UserFunc
+		u.Username = mce.GetSession().GetMysqlProtocol().GetUserName()
+		users = []*tree.User{&u}
+	}
+	for _, u := range users {
+		if u.Username != mce.GetSession().GetMysqlProtocol().GetUserName() {
+			if err = mce.checkSuperUser("CREATE USER"); err != nil {
+				return err
+			}
+		}
+		user, err := c.GetUser(u.Username)
+		if err == catalog.ErrUserNotExists && au.IfExists {
+			continue
+		}
+		if err != nil {
+			return NewMysqlError(ER_CANNOT_USER, "ALTER USER", accountName(u.Username, u.Hostname))
+		}
+		if au.IsUserFunc || identified(u) {
+			plugin, password, err := userPassword(u, user.Plugin)
+			if err != nil {
+				return err
+			}
+			if err = c.SetPassword(u.Username, plugin, password); err != nil {
+				return NewMysqlError(ER_CANNOT_USER, "ALTER USER", accountName(u.Username, u.Hostname))
+			}
+		}
+		if len(au.ResOpts) > 0 {
+			//the limits of the accounts are changed by the super users only
+			if err = mce.checkSuperUser("CREATE USER"); err != nil {
+				return err
+			}
+			maxTime, memory := queryLimitOptions(au.ResOpts, user.MaxExecutionTime, user.QueryMemoryLimit)
+			if err = c.SetQueryLimits(u.Username, maxTime, memory); err != nil {
+				return NewMysqlError(ER_CANNOT_USER, "ALTER USER", accountName(u.Username, u.Hostname))
+			}
+		}
+	}
+	return mce.sendAccountOk()
+}
+
+/*
+handle SET PASSWORD [FOR user] = 'password'
+*/
+func (mce *MysqlCmdExecutor) handleSetPassword(sp *tree.SetPassword) error {
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	name := mce.GetSession().GetMysqlProtocol().GetUserName()
+	if sp.User != nil && sp.User.Username != name {
+		if err = mce.checkSuperUser("CREATE USER"); err != nil {
+			return err
+		}
+		name = sp.User.Username
+	}
+	user, err := c.GetUser(name)
+	if err == nil {
+		err = c.SetPassword(name, user.Plugin, passwordHash(user.Plugin, sp.Password))
+	}
+	if err != nil {
+		return NewMysqlError(ER_CANNOT_USER, "SET PASSWORD", accountName(name, ""))
+	}
+	return mce.sendAccountOk()
+}
+
+/*
+handle CREATE ROLE
+*/
+func (mce *MysqlCmdExecutor) handleCreateRole(cr *tree.CreateRole) error {
+	if err := mce.checkSuperUser("CREATE ROLE"); err != nil {
+		return err
+	}
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	for _, r := range cr.Roles {
+		err = c.CreateRole(r.UserName)
+		if err == catalog.ErrRoleExists && cr.IfNotExists {
+			continue
+		}
+		if err != nil {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE ROLE", accountName(r.UserName, r.HostName))
+		}
+	}
+	return mce.sendAccountOk()
+}
+
+/*
+handle DROP ROLE
+*/
+func (mce *MysqlCmdExecutor) handleDropRole(dr *tree.DropRole) error {
+	if err := mce.checkSuperUser("DROP ROLE"); err != nil {
+		return err
+	}
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	for _, r := range dr.Roles {
+		err = c.DropRole(r.UserName)
+		if err == catalog.ErrRoleNotExists && dr.IfExists {
+			continue
+		}
+		if err != nil {
+			return NewMysqlError(ER_CANNOT_USER, "DROP ROLE", accountName(r.UserName, r.HostName))
+		}
+	}
+	return mce.sendAccountOk()
+}
+
+// privilegeGrant converts the privileges and the level of GRANT and REVOKE
+func (mce *MysqlCmdExecutor) privilegeGrant(privs []*tree.Privilege, level *tree.PrivilegeLevel) (privilege.Grant, error) {
+	var g privilege.Grant
+
+	for _, p := range privs {
+		if p.ColumnList != nil {
+			return g, NewMysqlError(ER_NOT_SUPPORTED_YET, "column privileges")
+		}
+		priv, err := privilege.FromTree(p.Type)
+		if err != nil {
+			return g, err
+		}
+		if p.Type == tree.PRIVILEGE_TYPE_STATIC_ALL && level.Level == tree.PRIVILEGE_LEVEL_TYPE_GLOBAL {
+			priv |= privilege.File
+		}
+		g.Privileges |= priv
+	}
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		//FILE is a global privilege
+		if g.Privileges&privilege.File != 0 {
+			return g, NewMysqlError(ER_WRONG_USAGE, "DB GRANT", "GLOBAL PRIVILEGES")
+		}
+		g.Database, g.Table = level.DbName, level.TabName
+		if g.Database == "" {
+			g.Database = mce.GetSession().GetMysqlProtocol().GetDatabaseName()
+		}
+		if g.Database == "" {
+			return g, NewMysqlError(ER_NO_DB_ERROR)
+		}
+	default:
+		return g, NewMysqlError(ER_NOT_SUPPORTED_YET, "privilege level")
+	}
+	return g, nil
+}
+
+// checkGrantOption checks that the user of the session may grant or revoke g
+func (mce *MysqlCmdExecutor) checkGrantOption(g privilege.Grant) error {
+	pc, err := mce.privilegeChecker()
+	if err != nil || pc == nil {
+		return err
+	}
+	return pc.CheckPrivilege(g.Database, g.Table, g.Privileges|privilege.GrantOption)
+}
+
+/*
+handle GRANT privileges ON level TO users and GRANT roles TO users
+*/
+func (mce *MysqlCmdExecutor) handleGrant(gr *tree.Grant) error {
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	switch {
+	case gr.IsProxy:
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "GRANT PROXY")
+	case gr.IsGrantRole:
+		if err = mce.checkSuperUser("ROLE_ADMIN"); err != nil {
+			return err
+		}
+		for _, u := range gr.Users {
+			for _, r := range gr.RolesInGrantRole {
+				if err = c.GrantRole(r.UserName, u.Username); err != nil {
+					return NewMysqlError(ER_CANNOT_USER, "GRANT", accountName(u.Username, u.Hostname))
+				}
+			}
+		}
+	default:
+		g, err := mce.privilegeGrant(gr.Privileges, gr.Level)
+		if err != nil {
+			return err
+		}
+		if gr.GrantOption {
+			g.Privileges |= privilege.GrantOption
+		}
+		if err = mce.checkGrantOption(g); err != nil {
+			return err
+		}
+		for _, u := range gr.Users {
+			if err = c.GrantPrivileges(u.Username, g); err != nil {
+				return NewMysqlError(ER_CANNOT_USER, "GRANT", accountName(u.Username, u.Hostname))
+			}
+		}
+	}
+	return mce.sendAccountOk()
+}
+
+/*
+handle REVOKE privileges ON level FROM users and REVOKE roles FROM users
+*/
+func (mce *MysqlCmdExecutor) handleRevoke(rv *tree.Revoke) error {
+	c, err := mce.accountCatalog()
+	if err != nil {
+		return err
+	}
+	if rv.IsRevokeRole {
+		if err = mce.checkSuperUser("ROLE_ADMIN"); err != nil {
+			return err
+		}
+		for _, u := range rv.Users {
+			for _, r := range rv.RolesInRevokeRole {
+				if err = c.RevokeRole(r.UserName, u.Username); err != nil {
+					return NewMysqlError(ER_CANNOT_USER, "REVOKE", accountName(u.Username, u.Hostname))
+				}
+			}
+		}
+		return mce.sendAccountOk()
+	}
+	g, err := mce.privilegeGrant(rv.Privileges, rv.Level)
+	if err != nil {
+		return err
+	}
+	if err = mce.checkGrantOption(g); err != nil {
+		return err
+	}
+	for _, u := range rv.Users {
+		if err = c.RevokePrivileges(u.Username, g); err != nil {
+			return NewMysqlError(ER_CANNOT_USER, "REVOKE", accountName(u.Username, u.Hostname))
+		}
+	}
+	return mce.sendAccountOk()
+}
+
+// showGrants returns the GRANT statements of the user or the role name
+func showGrants(c *catalog.Catalog, name string) ([]string, error) {
+	var rows []string
+
+	if user, err := c.GetUser(name); err == nil {
+		grantee := fmt.Sprintf("`%s`@`%s`", user.Name, user.Host)
+		rows = append(rows, fmt.Sprintf("GRANT USAGE ON *.* TO %s", grantee))
+		for _, g := range user.Grants {
+			rows = append(rows, g.Statement(grantee))
+		}
+		for _, r := range user.Roles {
+			rows = append(rows, fmt.Sprintf("GRANT `%s`@`%%` TO %s", r, grantee))
+		}
+		return rows, nil
+	}
+	role, err := c.GetRole(name)
+	if err != nil {
+		return nil, NewMysqlError(ER_NONEXISTING_GRANT, name, "%")
+	}
+	grantee := fmt.Sprintf("`%s`@`%%`", role.Name)
+	rows = append(rows, fmt.Sprintf("GRANT USAGE ON *.* TO %s", grantee))
+	for _, g := range role.Grants {
+		rows = append(rows, g.Statement(grantee))
+	}
+	return rows, nil
+}
+
+/*
+handle SHOW GRANTS [FOR user]
+*/
+func (mce *MysqlCmdExecutor) handleShowGrants(sg *tree.ShowGrants) error {
+	var rows []string
+
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	name := proto.GetUserName()
+	if sg.Username != "" && sg.Username != name {
+		if err := mce.checkSuperUser("SELECT"); err != nil {
+			return err
+		}
+		name = sg.Username
+	}
+	if mce.isSuperUser(name) {
+		rows = []string{fmt.Sprintf("GRANT ALL PRIVILEGES ON *.* TO `%s`@`%%` WITH GRANT OPTION", name)}
+	} else {
+		c, err := mce.accountCatalog()
+		if err != nil {
+			return err
+		}
+		if rows, err = showGrants(c, name); err != nil {
+			return err
+		}
+	}
+
+	col := new(MysqlColumn)
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col.SetName(fmt.Sprintf("Grants for %s@%%", name))
+	ses.Mrs.AddColumn(col)
+	for _, row := range rows {
+		ses.Mrs.AddRow([]interface{}{row})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/sql/statistics"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+handle ANALYZE TABLE statement, the statistics of the columns are collected and
+saved with the table. All the columns are analyzed if no column is given.
+*/
+func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt, proc *process.Process, pc plan.PrivilegeChecker, epoch uint64) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	dbName, tblName := string(stmt.Table.SchemaName), string(stmt.Table.ObjectName)
+	if dbName == "" {
+		if dbName = proto.GetDatabaseName(); dbName == "" {
+			return NewMysqlError(ER_NO_DB_ERROR)
+		}
+	}
+	if pc != nil {
+		if err := pc.CheckPrivilege(dbName, tblName, privilege.Select|privilege.Insert); err != nil {
+			return err
+		}
+	}
+	db, err := ses.Pu.StorageEngine.Database(dbName)
+	if err != nil {
+		return NewMysqlError(ER_BAD_DB_ERROR, dbName)
+	}
+	rel, err := db.Relation(tblName)
+	if err != nil {
+		return NewMysqlError(ER_NO_SUCH_TABLE, dbName, tblName)
+	}
+	defer rel.Close()
+	attrs, err := analyzeAttributes(rel, stmt.Cols)
+	if err != nil {
+		return err
+	}
+	stats, err := statistics.Collect(rel, attrs, proc)
+	if err != nil {
+		return err
+	}
+	if err = rel.AddTableDef(epoch, stats); err != nil {
+		return err
+	}
+	logutil.Infof("analyze table %s.%s: %d rows, %d columns", dbName, tblName, stats.Rows, len(stats.Columns))
+
+	for _, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+	ses.Mrs.AddRow([]interface{}{dbName + "." + tblName, "analyze", "status", "OK"})
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// analyzeAttributes returns the attributes of the columns to be analyzed
+func analyzeAttributes(rel engine.Relation, cols tree.IdentifierList) ([]engine.Attribute, error) {
+	var attrs []engine.Attribute
+
+	mp := make(map[string]engine.Attribute)
+	for _, def := range rel.TableDefs() {
+		if v, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, v.Attr)
+			mp[v.Attr.Name] = v.Attr
+		}
+	}
+	if len(cols) == 0 {
+		return attrs, nil
+	}
+	attrs = attrs[:0]
+	for _, col := range cols {
+		attr, ok := mp[string(col)]
+		if !ok {
+			return nil, NewMysqlError(ER_BAD_FIELD_ERROR, string(col), rel.ID())
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+/*
+handle ADMIN COMPACT TABLE statement, the closed segments of the tables are
+merged right away instead of waiting for the background compaction. A row
+is returned for each merge, or a single row if there is nothing to merge.
+*/
+func (mce *MysqlCmdExecutor) handleCompactTable(stmt *tree.CompactTable, pc plan.PrivilegeChecker) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	for _, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+	for _, tbl := range stmt.Tables {
+		dbName, tblName := string(tbl.SchemaName), string(tbl.ObjectName)
+		if dbName == "" {
+			if dbName = proto.GetDatabaseName(); dbName == "" {
+				return NewMysqlError(ER_NO_DB_ERROR)
+			}
+		}
+		if pc != nil {
+			if err := pc.CheckPrivilege(dbName, tblName, privilege.Select|privilege.Insert); err != nil {
+				return err
+			}
+		}
+		msgs, err := compactTable(ses.Pu.StorageEngine, dbName, tblName)
+		if err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			ses.Mrs.AddRow([]interface{}{dbName + "." + tblName, "compact", msg[0], msg[1]})
+		}
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// compactTable returns the Msg_type and Msg_text pairs of the table
+func compactTable(e engine.Engine, dbName, tblName string) ([][2]string, error) {
+	db, err := e.Database(dbName)
+	if err != nil {
+		return nil, NewMysqlError(ER_BAD_DB_ERROR, dbName)
+	}
+	rel, err := db.Relation(tblName)
+	if err != nil {
+		return nil, NewMysqlError(ER_NO_SUCH_TABLE, dbName, tblName)
+	}
+	defer rel.Close()
+	compactor, ok := rel.(engine.Compactor)
+	if !ok {
+		return [][2]string{{"note", "The storage engine for the table doesn't support compact"}}, nil
+	}
+	merges, err := compactor.Compact()
+	if err != nil {
+		return nil, err
+	}
+	if len(merges) == 0 {
+		return [][2]string{{"status", "Table is already up to date"}}, nil
+	}
+	msgs := make([][2]string, len(merges))
+	for i, m := range merges {
+		msgs[i] = [2]string{"status", m}
+	}
+	return msgs, nil
+}
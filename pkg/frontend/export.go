@@ -8,12 +8,28 @@ import (
 	"os"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encoding/parquet"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 var OpenFile = os.OpenFile
 
+// errExportStopped is returned for the batches that arrive after the export is
+// stopped, the file misses their rows.
+var errExportStopped = errors.New("the export is stopped before all the rows are written")
+
+// parquetExport holds the parquet file of the SELECT ... INTO OUTFILE statement.
+// The batches of all the pipelines are written as row groups of the same file.
+type parquetExport struct {
+	sync.Mutex
+	file   *os.File
+	buf    *bufio.Writer
+	writer *parquet.Writer
+}
+
 type CloseExportData struct {
 	stopExportData chan interface{}
 	onceClose    sync.Once
@@ -99,8 +115,78 @@ func getExportFilePath(filename string, fileCnt uint) string {
 	}
 }
 
+/*
+getNullSymbol returns the output of NULL. It is the escape character followed by 'N',
+\N when no escape character is specified and NULL for ESCAPED BY ''.
+*/
+func getNullSymbol(ep *tree.ExportParam) []byte {
+	if ep.Fields.EscapedBy != 0 {
+		return []byte{ep.Fields.EscapedBy, 'N'}
+	}
+	if ep.Fields.Escaped {
+		return []byte("NULL")
+	}
+	return []byte{'\\', 'N'}
+}
+
+/*
+isEnclosed returns whether the i-th field is enclosed. ENCLOSED BY encloses every
+field, OPTIONALLY ENCLOSED BY only the strings, and FORCE_QUOTE the columns listed.
+*/
+func isEnclosed(ep *tree.ExportParam, i uint64, isString bool) bool {
+	if ep.ColumnFlag[i] {
+		return true
+	}
+	return ep.Fields.Enclosed && (!ep.Fields.Optionally || isString)
+}
+
+/*
+escapeField escapes the escape character, the enclosing character and the NUL
+in the string value like LOAD DATA expects. The first characters of the field and
+line terminators are escaped too when the value is not enclosed.
+*/
+func escapeField(ep *tree.ExportParam, value []byte, enclosed bool) []byte {
+	esc := ep.Fields.EscapedBy
+	if esc == 0 {
+		return value
+	}
+	var fieldTerm, lineTerm byte
+	if !enclosed {
+		if len(ep.Fields.Terminated) > 0 {
+			fieldTerm = ep.Fields.Terminated[0]
+		}
+		if len(ep.Lines.TerminatedBy) > 0 {
+			lineTerm = ep.Lines.TerminatedBy[0]
+		}
+	}
+	var res []byte
+	for i, c := range value {
+		switch {
+		case c == 0:
+			if res == nil {
+				res = append(make([]byte, 0, len(value)+8), value[:i]...)
+			}
+			res = append(res, esc, '0')
+			continue
+		case c == esc, enclosed && c == ep.Fields.EnclosedBy, fieldTerm != 0 && c == fieldTerm, lineTerm != 0 && c == lineTerm:
+			if res == nil {
+				res = append(make([]byte, 0, len(value)+8), value[:i]...)
+			}
+			res = append(res, esc)
+		}
+		if res != nil {
+			res = append(res, c)
+		}
+	}
+	if res == nil {
+		return value
+	}
+	return res
+}
+
 var formatOutputString = func(oq *outputQueue, tmp, symbol []byte, enclosed byte, flag bool) error {
 	var err error
+	flag = flag && enclosed != 0
 	if flag {
 		err = writeToCSVFile(oq, []byte{enclosed})
 		if err != nil {
@@ -204,6 +290,11 @@ var writeDataToCSVFile = func(oq *outputQueue, output []byte) error {
 func exportDataToCSVFile(oq *outputQueue) error {
 	oq.ep.LineSize = 0
 
+	if oq.ep.Lines.StartingBy != "" {
+		if err := writeToCSVFile(oq, []byte(oq.ep.Lines.StartingBy)); err != nil {
+			return err
+		}
+	}
 	for i := uint64(0); i < oq.mrs.GetColumnCount(); i++ {
 		column, err := oq.mrs.GetColumn(i)
 		if err != nil {
@@ -217,7 +308,9 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			return err1
 		} else if isNil {
 			//NULL is output as \N
-			formatOutputString(oq, []byte{'\\', 'N'}, []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, false)
+			if err := formatOutputString(oq, getNullSymbol(oq.ep), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, false); err != nil {
+				return err
+			}
 			continue
 		}
 		switch mysqlColumn.ColumnType() {
@@ -229,16 +322,16 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			} else {
 				if mysqlColumn.ColumnType() == defines.MYSQL_TYPE_YEAR {
 					if value == 0 {
-						if err := formatOutputString(oq, []byte("0000"), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+						if err := formatOutputString(oq, []byte("0000"), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 							return err
 						}
 					} else {
-						if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+						if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 							return err
 						}
 					}
 				} else {
-					if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 						return err
 					}
 				}
@@ -247,7 +340,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			if value, err2 := oq.mrs.GetFloat64(0, i); err2 != nil {
 				return err2
 			} else {
-				if err := formatOutputString(oq, []byte(fmt.Sprintf("%v", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+				if err := formatOutputString(oq, []byte(fmt.Sprintf("%v", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 					return err
 				}
 			}
@@ -256,7 +349,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 				if value, err2 := oq.mrs.GetUint64(0, i); err2 != nil {
 					return err2
 				} else {
-					if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 						return err
 					}
 				}
@@ -264,7 +357,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 				if value, err2 := oq.mrs.GetInt64(0, i); err2 != nil {
 					return  err2
 				} else {
-					if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					if err := formatOutputString(oq, []byte(fmt.Sprintf("%d", value)), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 						return err
 					}
 				}
@@ -273,7 +366,8 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			if value, err2 := oq.mrs.GetString(0, i); err2 != nil {
 				return err2
 			} else {
-				if err := formatOutputString(oq, []byte(value), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+				enclosed := isEnclosed(oq.ep, i, true)
+				if err := formatOutputString(oq, escapeField(oq.ep, []byte(value), enclosed), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, enclosed); err != nil {
 					return err
 				}
 			}
@@ -281,7 +375,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			if value, err2 := oq.mrs.GetValue(0, i); err2 != nil {
 				return err2
 			} else {
-				if err := formatOutputString(oq, []byte(value.(types.Date).String()), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+				if err := formatOutputString(oq, []byte(value.(types.Date).String()), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 					return err
 				}
 			}
@@ -289,7 +383,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			if value, err2 := oq.mrs.GetValue(0, i); err2 != nil {
 				return err2
 			} else {
-				if err := formatOutputString(oq, []byte(value.(types.Datetime).String()), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+				if err := formatOutputString(oq, []byte(value.(types.Datetime).String()), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, isEnclosed(oq.ep, i, false)); err != nil {
 					return err
 				}
 			}
@@ -301,4 +395,81 @@ func exportDataToCSVFile(oq *outputQueue) error {
 	}
 	oq.ep.Rows++
 	return nil
-} 
\ No newline at end of file
+} 
+/*
+openParquetFile creates the parquet file with the schema of the result set.
+*/
+var openParquetFile = func(ses *Session) error {
+	codec := parquet.Snappy
+	if ses.ep.Compression != "" {
+		c, ok := parquet.Codecs[ses.ep.Compression]
+		if !ok {
+			return fmt.Errorf("unsupported parquet compression '%s'", ses.ep.Compression)
+		}
+		codec = c
+	}
+	cols := make([]parquet.Column, len(ses.Mrs.Columns))
+	for i, column := range ses.Mrs.Columns {
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return fmt.Errorf("openParquetFile need MysqlColumn")
+		}
+		typ, err := convertMysqlTypeToEngineType(mysqlColumn)
+		if err != nil {
+			return err
+		}
+		cols[i] = parquet.Column{Name: mysqlColumn.Name(), Typ: typ}
+	}
+	file, err := OpenFile(ses.ep.FilePath, os.O_RDWR|os.O_EXCL|os.O_CREATE, 0o666)
+	if err != nil {
+		return err
+	}
+	pe := &parquetExport{
+		file: file,
+		buf:  bufio.NewWriterSize(file, int(ses.Pu.SV.GetExportDataDefaultFlushSize())*1024*1024),
+	}
+	if pe.writer, err = parquet.NewWriter(pe.buf, cols, codec); err != nil {
+		file.Close()
+		return err
+	}
+	ses.pqExport = pe
+	return nil
+}
+
+/*
+exportDataToParquetFile writes the batch into the parquet file as a row group.
+*/
+func exportDataToParquetFile(ses *Session, bat *batch.Batch) error {
+	pe := ses.pqExport
+	if pe == nil {
+		return errors.New("the parquet file has not been opened")
+	}
+	select {
+	case <-ses.closeRef.stopExportData:
+		return errExportStopped
+	default:
+	}
+	pe.Lock()
+	defer pe.Unlock()
+	return pe.writer.Write(bat)
+}
+
+/*
+closeParquetFile writes the footer of the parquet file and closes it.
+*/
+func closeParquetFile(ses *Session) error {
+	pe := ses.pqExport
+	if pe == nil {
+		return nil
+	}
+	ses.pqExport = nil
+	if err := pe.writer.Close(); err != nil {
+		pe.file.Close()
+		return err
+	}
+	if err := pe.buf.Flush(); err != nil {
+		pe.file.Close()
+		return err
+	}
+	return pe.file.Close()
+}
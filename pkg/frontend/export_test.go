@@ -2,12 +2,18 @@ package frontend
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encoding/parquet"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
@@ -275,4 +281,108 @@ func Test_exportDataToCSVFile(t *testing.T) {
 
 		convey.So(exportDataToCSVFile(oq), convey.ShouldNotBeNil)
 	})
-} 
\ No newline at end of file
+} 
+func Test_escapeField(t *testing.T) {
+	convey.Convey("escapeField", t, func() {
+		ep := &tree.ExportParam{
+			Lines:  &tree.Lines{TerminatedBy: "\n"},
+			Fields: &tree.Fields{Terminated: ",", EnclosedBy: '"'},
+		}
+		convey.So(escapeField(ep, []byte("a,\"b\""), false), convey.ShouldResemble, []byte("a,\"b\""))
+		convey.So(getNullSymbol(ep), convey.ShouldResemble, []byte("\\N"))
+
+		ep.Fields.EscapedBy = '\\'
+		convey.So(escapeField(ep, []byte("abc"), false), convey.ShouldResemble, []byte("abc"))
+		convey.So(escapeField(ep, []byte("a,b\nc\\"), false), convey.ShouldResemble, []byte("a\\,b\\\nc\\\\"))
+		convey.So(escapeField(ep, []byte("a,\"b\"\x00"), true), convey.ShouldResemble, []byte("a,\\\"b\\\"\\0"))
+
+		ep.Fields.EscapedBy = '#'
+		convey.So(getNullSymbol(ep), convey.ShouldResemble, []byte("#N"))
+	})
+}
+
+func Test_exportDataToCSVFileOptions(t *testing.T) {
+	convey.Convey("exportDataToCSVFile writes the fields like MySQL", t, func() {
+		export := func(fields *tree.Fields) string {
+			var buf bytes.Buffer
+			oq := &outputQueue{
+				mrs: &MysqlResultSet{},
+				ep: &tree.ExportParam{
+					Lines:      &tree.Lines{TerminatedBy: "\n"},
+					Fields:     fields,
+					Symbol:     []string{",", ",", ",", "\n"},
+					ColumnFlag: make([]bool, 4),
+				},
+				writer: bufio.NewWriter(&buf),
+			}
+			colType := []uint8{defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_VARCHAR}
+			for _, typ := range colType {
+				col := new(MysqlColumn)
+				col.SetColumnType(typ)
+				oq.mrs.AddColumn(col)
+			}
+			oq.mrs.AddRow([]interface{}{int64(1), "a\"b", types.FromCalendar(2021, 1, 2), nil})
+			convey.So(exportDataToCSVFile(oq), convey.ShouldBeNil)
+			convey.So(oq.writer.Flush(), convey.ShouldBeNil)
+			return buf.String()
+		}
+
+		// FIELDS ENCLOSED BY '"' ESCAPED BY '\\'
+		convey.So(export(&tree.Fields{Terminated: ",", EnclosedBy: '"', Enclosed: true, EscapedBy: '\\', Escaped: true}),
+			convey.ShouldEqual, "\"1\",\"a\\\"b\",\"2021-01-02\",\\N\n")
+		// FIELDS OPTIONALLY ENCLOSED BY '"' ESCAPED BY '\\'
+		convey.So(export(&tree.Fields{Terminated: ",", Optionally: true, EnclosedBy: '"', Enclosed: true, EscapedBy: '\\', Escaped: true}),
+			convey.ShouldEqual, "1,\"a\\\"b\",2021-01-02,\\N\n")
+		// FIELDS ENCLOSED BY '' ESCAPED BY ''
+		convey.So(export(&tree.Fields{Terminated: ",", Enclosed: true, Escaped: true}),
+			convey.ShouldEqual, "1,a\"b,2021-01-02,NULL\n")
+		// no ENCLOSED BY, the '"' only quotes the FORCE_QUOTE columns
+		convey.So(export(&tree.Fields{Terminated: ",", EnclosedBy: '"'}),
+			convey.ShouldEqual, "1,a\"b,2021-01-02,\\N\n")
+	})
+}
+
+func Test_exportDataToParquetFile(t *testing.T) {
+	convey.Convey("export parquet file", t, func() {
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		ses := NewSession(nil, nil, nil, nil, pu)
+		ses.closeRef = NewCloseExportData()
+		ses.ep = &tree.ExportParam{
+			Outfile:    true,
+			FilePath:   filepath.Join(t.TempDir(), "export.parquet"),
+			FileFormat: tree.ExportFormatParquet,
+			Fields:     &tree.Fields{},
+			Lines:      &tree.Lines{},
+		}
+		ses.Mrs = &MysqlResultSet{}
+		col := new(MysqlColumn)
+		col.SetName("a")
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		ses.Mrs.AddColumn(col)
+
+		bat := batch.New(true, []string{"a"})
+		bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		bat.Vecs[0].Col = []int64{1, 2, 3}
+		bat.Zs = []int64{1, 1, 1}
+
+		convey.So(exportDataToParquetFile(ses, bat), convey.ShouldNotBeNil)
+
+		convey.So(openParquetFile(ses), convey.ShouldBeNil)
+		convey.So(getDataFromPipeline(ses, bat), convey.ShouldBeNil)
+		convey.So(closeParquetFile(ses), convey.ShouldBeNil)
+		convey.So(ses.pqExport, convey.ShouldBeNil)
+
+		data, err := ioutil.ReadFile(ses.ep.FilePath)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data[:4]), convey.ShouldEqual, parquet.Magic)
+		convey.So(string(data[len(data)-4:]), convey.ShouldEqual, parquet.Magic)
+
+		// the file exists already
+		convey.So(openParquetFile(ses), convey.ShouldNotBeNil)
+
+		ses.ep.Compression = "brotli"
+		convey.So(openParquetFile(ses), convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/infoschema"
+)
+
+/*
+infoSchemaSession decides what the user of the session sees in information_schema:
+the objects which the user holds any privilege on and, like SHOW PROCESSLIST,
+its own connections unless it is a super user.
+*/
+type infoSchemaSession struct {
+	mce *MysqlCmdExecutor
+
+	//the checker of the privileges of the user, nil when the user can see everything
+	checker *privilege.Checker
+}
+
+func (is *infoSchemaSession) Visible(db, tbl string) bool {
+	return is.checker == nil || is.checker.Visible(db, tbl)
+}
+
+func (is *infoSchemaSession) Processes() []infoschema.Process {
+	name := is.mce.GetSession().GetMysqlProtocol().GetUserName()
+	all := is.mce.isSuperUser(name)
+
+	var ps []infoschema.Process
+	for _, r := range is.mce.GetRoutineManager().processList() {
+		if !all && r.user != name {
+			continue
+		}
+		ps = append(ps, infoschema.Process{
+			Id:      r.id,
+			User:    r.user,
+			Host:    r.host,
+			Db:      r.db,
+			Command: r.command,
+			Time:    r.time,
+			State:   r.state,
+			Info:    r.info,
+		})
+	}
+	return ps
+}
+
+func (is *infoSchemaSession) Digests() []infoschema.Digest {
+	rm := is.mce.GetRoutineManager()
+	if rm == nil || rm.digests == nil {
+		return nil
+	}
+	var ds []infoschema.Digest
+	for _, d := range rm.digests.list() {
+		//the overflow row has no database
+		if d.Schema != "" && !is.Visible(d.Schema, "") {
+			continue
+		}
+		ds = append(ds, d)
+	}
+	return ds
+}
+
+// storageEngine returns the storage engine with information_schema for the user
+// whose privileges are checked by pc.
+func (mce *MysqlCmdExecutor) storageEngine(pc plan.PrivilegeChecker) engine.Engine {
+	var checker *privilege.Checker
+	if c, ok := pc.(*accountChecker); ok {
+		checker = c.Checker
+	}
+	return infoschema.New(mce.GetSession().Pu.StorageEngine, engine.Node{Addr: compile.Address},
+		&infoSchemaSession{mce: mce, checker: checker})
+}
@@ -17,6 +17,8 @@ package frontend
 import (
 	"encoding/csv"
 	"fmt"
+	"go/constant"
+	"io"
 	"os"
 	"runtime"
 	"strconv"
@@ -30,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
@@ -38,6 +41,198 @@ import (
 	"github.com/matrixorigin/simdcsv"
 )
 
+/*
+localInfile is the file of LOAD DATA LOCAL INFILE in transfer.
+The IO goroutine of the connection queues the packets sent by the client
+without waiting for the loader, so it is never blocked by a slow load.
+*/
+type localInfile struct {
+	sync.Mutex
+	cond *sync.Cond
+	bufs [][]byte
+
+	//the client has sent the empty packet ending the file
+	eof bool
+
+	//the connection is closed before the end of the file
+	aborted bool
+
+	//the reader is closed, the rest of the file is dropped
+	discard bool
+}
+
+func newLocalInfile() *localInfile {
+	file := &localInfile{}
+	file.cond = sync.NewCond(&file.Mutex)
+	return file
+}
+
+//push queues a packet of the file, the empty packet ends the file.
+func (file *localInfile) push(payload []byte) {
+	file.Lock()
+	if len(payload) == 0 {
+		file.eof = true
+	} else if !file.discard {
+		//the payload may be reused by the connection
+		file.bufs = append(file.bufs, append([]byte(nil), payload...))
+	}
+	file.Unlock()
+	file.cond.Broadcast()
+}
+
+//abort wakes up the reader when the connection is closed.
+func (file *localInfile) abort() {
+	file.Lock()
+	file.aborted = true
+	file.Unlock()
+	file.cond.Broadcast()
+}
+
+//next returns the next packet of the file, or io.EOF at the end of the file.
+func (file *localInfile) next() ([]byte, error) {
+	file.Lock()
+	defer file.Unlock()
+	for len(file.bufs) == 0 {
+		switch {
+		case file.eof:
+			return nil, io.EOF
+		case file.aborted:
+			return nil, io.ErrUnexpectedEOF
+		}
+		file.cond.Wait()
+	}
+	buf := file.bufs[0]
+	file.bufs[0] = nil
+	file.bufs = file.bufs[1:]
+	return buf, nil
+}
+
+//skip drops the rest of the file and waits for the end of the transfer.
+func (file *localInfile) skip() {
+	file.Lock()
+	defer file.Unlock()
+	file.discard = true
+	file.bufs = nil
+	for !file.eof && !file.aborted {
+		file.cond.Wait()
+	}
+}
+
+/*
+localInfileReader reads the content of the file sent by the client
+for LOAD DATA LOCAL INFILE.
+*/
+type localInfileReader struct {
+	file *localInfile
+	buf  []byte
+}
+
+func (r *localInfileReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		buf, err := r.file.next()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = buf
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+//Close discards the rest of the file until the client ends the transfer,
+//so that the next packet of the connection is a request.
+func (r *localInfileReader) Close() error {
+	r.file.skip()
+	r.buf = nil
+	return nil
+}
+
+const varLocalInfile = "local_infile"
+
+//localInfileEnabled returns true if the session allows LOAD DATA LOCAL INFILE.
+func (ses *Session) localInfileEnabled() bool {
+	if ses.vars != nil && ses.vars.hasLocalInfile {
+		return ses.vars.localInfile
+	}
+	return ses.Pu.SV.GetLocalInfile()
+}
+
+/*
+setLocalInfileVariable handles SET [SESSION | GLOBAL] local_infile = ON | OFF.
+DEFAULT restores the value of the server. SET GLOBAL changes the value of
+the server, which needs the super user.
+*/
+func (mce *MysqlCmdExecutor) setLocalInfileVariable(va *tree.VarAssignmentExpr) error {
+	ses := mce.GetSession()
+	_, isDefault := va.Value.(*tree.DefaultVal)
+	var value bool
+	if !isDefault {
+		var ok bool
+		if value, ok = boolVariableValue(va.Value); !ok {
+			return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, varLocalInfile, tree.String(va.Value, dialect.MYSQL))
+		}
+	}
+
+	if va.Global {
+		if !mce.isSuperUser(ses.GetMysqlProtocol().GetUserName()) {
+			return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, "SUPER or SYSTEM_VARIABLES_ADMIN")
+		}
+		if isDefault {
+			return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, varLocalInfile, "DEFAULT")
+		}
+		return ses.Pu.SV.SetLocalInfile(value)
+	}
+	if ses.vars != nil {
+		ses.vars.localInfile, ses.vars.hasLocalInfile = value, !isDefault
+	}
+	return nil
+}
+
+//boolVariableValue returns the value of ON, OFF, TRUE, FALSE, 1 or 0.
+func boolVariableValue(expr tree.Expr) (bool, bool) {
+	switch v := expr.(type) {
+	case *tree.NumVal:
+		switch v.Value.Kind() {
+		case constant.Bool:
+			return constant.BoolVal(v.Value), true
+		case constant.Int:
+			if n, ok := constant.Int64Val(v.Value); ok && (n == 0 || n == 1) {
+				return n == 1, true
+			}
+		case constant.String:
+			return onOffValue(constant.StringVal(v.Value))
+		}
+	case *tree.UnresolvedName:
+		if v.NumParts == 1 {
+			return onOffValue(v.Parts[0])
+		}
+	}
+	return false, false
+}
+
+func onOffValue(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "on":
+		return true, true
+	case "off":
+		return false, true
+	}
+	return false, false
+}
+
+//openLoadDataFile opens the file of the LOAD DATA on the server or
+//requests it from the client if LOCAL.
+var openLoadDataFile = func(ses *Session, load *tree.Load) (io.ReadCloser, error) {
+	if load.Local {
+		if !ses.localInfileEnabled() {
+			return nil, NewMysqlError(ER_CLIENT_LOCAL_FILES_DISABLED)
+		}
+		return ses.GetMysqlProtocol().requestLocalInfile(load.File)
+	}
+	return os.Open(load.File)
+}
+
 type LoadResult struct {
 	Records, Deleted, Skipped, Warnings, WriteTimeout uint64
 }
@@ -1488,7 +1683,7 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	/*
 		step1 : read block from file
 	*/
-	dataFile, err := os.Open(load.File)
+	dataFile, err := openLoadDataFile(ses, load)
 	if err != nil {
 		logutil.Errorf("open file failed. err:%v", err)
 		return nil, err
@@ -17,6 +17,7 @@ package frontend
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 	"time"
@@ -158,6 +159,7 @@ func Test_load(t *testing.T) {
 			select_2.EXPECT().GetAst().Return(stmts[0]).AnyTimes()
 			select_2.EXPECT().SetDatabaseName(gomock.Any()).Return(nil).AnyTimes()
 			select_2.EXPECT().Compile(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			select_2.EXPECT().GetPlanDigest().Return("").AnyTimes()
 			select_2.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
 
 			cws = append(cws, select_2)
@@ -505,3 +507,121 @@ func Test_PrintThreadInfo(t *testing.T) {
 
 	})
 }
+
+func Test_localInfile(t *testing.T) {
+	convey.Convey("local infile succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var sent []byte
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).DoAndReturn(func(msg interface{}) error {
+			sent = append([]byte(nil), msg.([]byte)...)
+			return nil
+		}).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		convey.So(proto.dispatchLocalInfile([]byte("select 1")), convey.ShouldBeFalse)
+
+		reader, err := proto.requestLocalInfile("a.csv")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(sent[4:], convey.ShouldResemble, []byte("\xfba.csv"))
+
+		go func() {
+			proto.dispatchLocalInfile([]byte("1,2\n"))
+			proto.dispatchLocalInfile([]byte("3,4\n"))
+			proto.dispatchLocalInfile(nil)
+		}()
+
+		data, err := io.ReadAll(reader)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data), convey.ShouldEqual, "1,2\n3,4\n")
+		convey.So(reader.Close(), convey.ShouldBeNil)
+		convey.So(proto.dispatchLocalInfile([]byte("select 1")), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("local infile close", t, func() {
+		file := newLocalInfile()
+		file.push([]byte("1,2\n"))
+		file.push([]byte("3,4\n"))
+
+		reader := &localInfileReader{file: file}
+		p := make([]byte, 2)
+		n, err := reader.Read(p)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(p[:n]), convey.ShouldEqual, "1,")
+
+		closed := make(chan error)
+		go func() {
+			closed <- reader.Close()
+		}()
+		//the packets after Close are dropped without blocking the sender
+		for i := 0; i < 1000; i++ {
+			file.push([]byte("5,6\n"))
+		}
+		file.push(nil)
+		convey.So(<-closed, convey.ShouldBeNil)
+		_, err = reader.Read(p)
+		convey.So(err, convey.ShouldEqual, io.EOF)
+	})
+
+	convey.Convey("local infile quit", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().Close().Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		reader, err := proto.requestLocalInfile("a.csv")
+		convey.So(err, convey.ShouldBeNil)
+
+		//the IO goroutine is not blocked by the reader
+		for i := 0; i < 1000; i++ {
+			convey.So(proto.dispatchLocalInfile([]byte("1,2\n")), convey.ShouldBeTrue)
+		}
+
+		done := make(chan error)
+		go func() {
+			_, err := io.ReadAll(reader)
+			done <- err
+		}()
+		proto.Quit()
+		convey.So(<-done, convey.ShouldEqual, io.ErrUnexpectedEOF)
+		convey.So(proto.dispatchLocalInfile([]byte("select 1")), convey.ShouldBeFalse)
+		convey.So(reader.Close(), convey.ShouldBeNil)
+	})
+
+	convey.Convey("local infile variable", t, func() {
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+		ses := &Session{Pu: pu, vars: newSessionVariables()}
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		set := func(sql string) error {
+			stmts, err := parsers.Parse(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			return mce.setLocalInfileVariable(stmts[0].(*tree.SetVar).Assignments[0])
+		}
+
+		convey.So(pu.SV.SetLocalInfile(false), convey.ShouldBeNil)
+		convey.So(ses.localInfileEnabled(), convey.ShouldBeFalse)
+		convey.So(set("set local_infile = on"), convey.ShouldBeNil)
+		convey.So(ses.localInfileEnabled(), convey.ShouldBeTrue)
+		convey.So(set("set session local_infile = 0"), convey.ShouldBeNil)
+		convey.So(ses.localInfileEnabled(), convey.ShouldBeFalse)
+		convey.So(set("set local_infile = 2"), convey.ShouldNotBeNil)
+		convey.So(pu.SV.SetLocalInfile(true), convey.ShouldBeNil)
+		convey.So(ses.localInfileEnabled(), convey.ShouldBeFalse)
+		convey.So(set("set local_infile = default"), convey.ShouldBeNil)
+		convey.So(ses.localInfileEnabled(), convey.ShouldBeTrue)
+	})
+}
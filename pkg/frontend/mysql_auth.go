@@ -0,0 +1,321 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+const (
+	//the header of the AuthMoreData packet
+	authMoreDataHeader uint8 = 0x01
+
+	//the status of caching_sha2_password sent in the AuthMoreData packet
+	cachingSha2RequestPublicKey uint8 = 0x02
+	cachingSha2FastAuthSuccess  uint8 = 0x03
+	cachingSha2PerformFullAuth  uint8 = 0x04
+	cachingSha2RSAKeyBits             = 2048
+)
+
+// authAccount is the credential of the user of the connection
+type authAccount struct {
+	//the authentication plugin of the account.
+	//it is empty for the users configured by the system variables,
+	//they can use any plugin because their passwords are known.
+	plugin string
+
+	//the password of the users configured by the system variables
+	password string
+
+	//the password hash of the accounts stored in the catalog
+	hash []byte
+}
+
+// nativeHash returns SHA1(SHA1(password)) of the account
+func (a *authAccount) nativeHash() []byte {
+	if a.plugin == "" {
+		return catalog.HashPassword(a.password)
+	}
+	return a.hash
+}
+
+// emptyPassword returns true if the account has no password
+func (a *authAccount) emptyPassword() bool {
+	if a.plugin == "" {
+		return len(a.password) == 0
+	}
+	return len(a.hash) == 0
+}
+
+// checkPassword returns true if the cleartext password is the password of the account
+func (a *authAccount) checkPassword(password string) bool {
+	switch a.plugin {
+	case "":
+		return a.password == password
+	case AuthNativePassword:
+		return bytes.Equal(a.hash, catalog.HashPassword(password))
+	}
+	return catalog.CheckSha2Password(a.hash, password)
+}
+
+/*
+sha2Cache is the fast authentication cache of caching_sha2_password.
+It keeps SHA256(SHA256(password)) of the accounts which passed the full
+authentication, so that the next connections of the account can be
+checked with the scramble only.
+The entry is bound to the password hash in the catalog, it is dropped
+as soon as the password is changed.
+*/
+type sha2Cache struct {
+	sync.Mutex
+	entries map[string]sha2CacheEntry
+}
+
+type sha2CacheEntry struct {
+	hash   []byte
+	stage2 []byte
+}
+
+var fastAuthCache = &sha2Cache{entries: make(map[string]sha2CacheEntry)}
+
+func (c *sha2Cache) get(name string, hash []byte) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.entries[name]
+	if !ok {
+		return nil, false
+	}
+	if !bytes.Equal(e.hash, hash) {
+		delete(c.entries, name)
+		return nil, false
+	}
+	return e.stage2, true
+}
+
+func (c *sha2Cache) put(name string, hash []byte, password string) {
+	c.Lock()
+	defer c.Unlock()
+	c.entries[name] = sha2CacheEntry{hash: hash, stage2: sha2Stage2(password)}
+}
+
+// the rsa key pair for exchanging the password of caching_sha2_password
+// without a secure connection. it is generated once for the process.
+var (
+	rsaKeyOnce sync.Once
+	rsaKey     *rsa.PrivateKey
+	rsaKeyPEM  []byte
+	rsaKeyErr  error
+)
+
+func getRSAKey() (*rsa.PrivateKey, []byte, error) {
+	rsaKeyOnce.Do(func() {
+		rsaKey, rsaKeyErr = rsa.GenerateKey(rand.Reader, cachingSha2RSAKeyBits)
+		if rsaKeyErr != nil {
+			return
+		}
+		var der []byte
+		der, rsaKeyErr = x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+		if rsaKeyErr != nil {
+			return
+		}
+		rsaKeyPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	})
+	return rsaKey, rsaKeyPEM, rsaKeyErr
+}
+
+// sha2Stage2 returns SHA256(SHA256(password))
+func sha2Stage2(password string) []byte {
+	stage1 := sha256.Sum256([]byte(password))
+	stage2 := sha256.Sum256(stage1[:])
+	return stage2[:]
+}
+
+// the server checks the scramble of caching_sha2_password with SHA256(SHA256(password)).
+// Algorithm: SHA256( SHA256( password ) ) == SHA256( auth XOR SHA256( SHA256( SHA256( password ) ) + salt ) )
+func checkSha2Scramble(stage2, salt, auth []byte) bool {
+	if len(auth) != sha256.Size {
+		return false
+	}
+	sha := sha256.New()
+	sha.Write(stage2)
+	sha.Write(salt)
+	hash3 := sha.Sum(nil)
+
+	//SHA256(password) = auth XOR hash3
+	stage1 := make([]byte, sha256.Size)
+	for i := range stage1 {
+		stage1[i] = auth[i] ^ hash3[i]
+	}
+	hash := sha256.Sum256(stage1)
+	return bytes.Equal(hash[:], stage2)
+}
+
+// getAuthAccount returns the credential of the user of the connection
+func (mp *MysqlProtocolImpl) getAuthAccount() (*authAccount, bool) {
+	return lookupAuthAccount(mp.username, mp.SV, mp.accounts)
+}
+
+// lookupAuthAccount returns the credential of the user
+func lookupAuthAccount(name string, sv *config.SystemVariables, accounts accountStore) (*authAccount, bool) {
+	//anonymous accounts are not supported
+	if name == "" {
+		return nil, false
+	}
+	switch name {
+	case sv.GetDumpuser(): //the user dump for test
+		return &authAccount{password: sv.GetDumppassword()}, true
+	case sv.GetRootname():
+		return &authAccount{password: sv.GetRootpassword()}, true
+	}
+	//the accounts created by CREATE USER
+	if accounts == nil {
+		return nil, false
+	}
+	user, err := accounts.GetUser(name)
+	if err != nil {
+		return nil, false
+	}
+	plugin := user.Plugin
+	if plugin == "" {
+		plugin = AuthNativePassword
+	}
+	return &authAccount{plugin: plugin, hash: user.Password}, true
+}
+
+// defaultAuthPlugin returns the plugin announced in the handshake
+func (mp *MysqlProtocolImpl) defaultAuthPlugin() string {
+	if mp.SV != nil && mp.SV.GetDefaultAuthenticationPlugin() == AuthCachingSha2Password {
+		return AuthCachingSha2Password
+	}
+	return AuthNativePassword
+}
+
+// isSecureConnection returns true if the password can be sent in cleartext,
+// that is the connection is over TLS or a unix socket.
+func (mp *MysqlProtocolImpl) isSecureConnection() bool {
+	if mp.tcpConn == nil {
+		return false
+	}
+	conn, err := mp.tcpConn.RawConn()
+	if err != nil {
+		return false
+	}
+	switch conn.(type) {
+	case *tls.Conn, *net.UnixConn:
+		return true
+	}
+	return false
+}
+
+// the server makes a AuthMoreData packet
+func (mp *MysqlProtocolImpl) makeAuthMoreDataPayload(data []byte) []byte {
+	payload := make([]byte, HeaderOffset+1+len(data))
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(payload, pos, authMoreDataHeader)
+	pos = mp.writeCountOfBytes(payload, pos, data)
+	return payload[:pos]
+}
+
+/*
+the server authenticates the client with caching_sha2_password.
+1. the client sends XOR( SHA256( password ), SHA256( SHA256( SHA256( password ) ) + salt ) ).
+2. fast authentication: if SHA256(SHA256(password)) is known from the cache, the
+scramble is checked and the server sends fast_auth_success.
+3. full authentication: otherwise the server sends perform_full_authentication and
+the client sends the password in cleartext over a secure connection, or encrypted by
+the rsa public key of the server which the client may ask for.
+*/
+func (mp *MysqlProtocolImpl) authenticateCachingSha2(acct *authAccount, authResponse []byte) (bool, error) {
+	if len(authResponse) == 0 {
+		return acct.emptyPassword(), nil
+	}
+
+	var stage2 []byte
+	var cached bool
+	if acct.plugin == "" {
+		stage2, cached = sha2Stage2(acct.password), true
+	} else {
+		stage2, cached = fastAuthCache.get(mp.username, acct.hash)
+	}
+	if cached {
+		if !checkSha2Scramble(stage2, mp.salt, authResponse) {
+			return false, nil
+		}
+		if err := mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2FastAuthSuccess})); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if err := mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2PerformFullAuth})); err != nil {
+		return false, err
+	}
+	password, err := mp.readFullAuthPassword()
+	if err != nil {
+		return false, err
+	}
+	if !acct.checkPassword(password) {
+		return false, nil
+	}
+	fastAuthCache.put(mp.username, acct.hash, password)
+	return true, nil
+}
+
+// readFullAuthPassword reads the cleartext password of the full authentication
+func (mp *MysqlProtocolImpl) readFullAuthPassword() (string, error) {
+	data, err := mp.readAuthPacket()
+	if err != nil {
+		return "", err
+	}
+	if mp.isSecureConnection() {
+		return string(bytes.TrimRight(data, "\x00")), nil
+	}
+
+	key, pub, err := getRSAKey()
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 1 && data[0] == cachingSha2RequestPublicKey {
+		if err = mp.writePackets(mp.makeAuthMoreDataPayload(pub)); err != nil {
+			return "", err
+		}
+		if data, err = mp.readAuthPacket(); err != nil {
+			return "", err
+		}
+	}
+
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, data, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt password failed. error:%v", err)
+	}
+	//the password is XORed with the salt before the encryption
+	for i := range plain {
+		plain[i] ^= mp.salt[i%len(mp.salt)]
+	}
+	return string(bytes.TrimRight(plain, "\x00")), nil
+}
@@ -16,25 +16,33 @@ package frontend
 
 import (
 	"bufio"
+	"encoding/binary"
 	"fmt"
 	"os"
+	"reflect"
 	"runtime/pprof"
-	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/backup"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -63,6 +71,9 @@ type MysqlCmdExecutor struct {
 	ses *Session
 
 	routineMgr *RoutineManager
+
+	//the dialect of the sql from the client
+	dialect dialect.DialectType
 }
 
 func (cei *MysqlCmdExecutor) PrepareSessionBeforeExecRequest(ses *Session) {
@@ -188,12 +199,20 @@ Warning: The pipeline is the multi-thread environment. The getDataFromPipeline w
 */
 func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	ses := obj.(*Session)
-	var exitFlag bool = false
 
 	if bat == nil {
 		return nil
 	}
 
+	//stop the pipeline killed by KILL QUERY
+	if err := ses.process.checkKilled(); err != nil {
+		return err
+	}
+
+	if ses.ep.Outfile && ses.ep.FileFormat == tree.ExportFormatParquet {
+		return exportDataToParquetFile(ses, bat)
+	}
+
 	goID := GetRoutineId()
 
 	logutil.Infof("goid %d \n", goID)
@@ -241,6 +260,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	procBatchBegin := time.Now()
 
 	n := vector.Length(bat.Vecs[0])
+	atomic.AddInt64(&ses.sentRows, int64(n))
 
 	if enableProfile {
 		pprof.StartCPUProfile(cpuf)
@@ -248,16 +268,11 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	for j := 0; j < n; j++ { //row index
 		if oq.ep.Outfile {
 			select {
-				case <- ses.closeRef.stopExportData: {
-					exitFlag = true
-					break
-				}
-				default:{}
+			case <-ses.closeRef.stopExportData:
+				return errExportStopped
+			default:
 			}
 		}
-		if exitFlag {
-			break
-		}
 
 		if bat.Zs[j] <= 0 {
 			continue
@@ -507,7 +522,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 func (mce *MysqlCmdExecutor) handleChangeDB(db string) error {
 	ses := mce.GetSession()
 	//TODO: check meta data
-	if _, err := ses.Pu.StorageEngine.Database(db); err != nil {
+	if _, err := mce.storageEngine(nil).Database(db); err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, db)
 	}
@@ -656,19 +671,12 @@ func (mce *MysqlCmdExecutor) handleSelectVariables(v string) error {
 /*
 handle Load DataSource statement
 */
-func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
+func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load, pc plan.PrivilegeChecker) error {
 	var err error = nil
 	ses := mce.GetSession()
 	proto := ses.protocol
 
 	logutil.Infof("+++++load data")
-	/*
-		TODO:support LOCAL
-	*/
-	if load.Local {
-		return fmt.Errorf("LOCAL is unsupported now")
-	}
-
 	if load.Fields == nil || len(load.Fields.Terminated) == 0 {
 		return fmt.Errorf("load need FIELDS TERMINATED BY ")
 	}
@@ -677,18 +685,6 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		return fmt.Errorf("EscapedBy field is unsupported now")
 	}
 
-	/*
-		check file
-	*/
-	exist, isfile, err := PathExists(load.File)
-	if err != nil || !exist {
-		return fmt.Errorf("file %s does exist. err:%v", load.File, err)
-	}
-
-	if !isfile {
-		return fmt.Errorf("file %s is a directory.", load.File)
-	}
-
 	/*
 		check database
 	*/
@@ -703,6 +699,35 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		loadDb = ses.protocol.GetDatabaseName()
 	}
 
+	/*
+		check privileges. the file of LOCAL is read by the client, the other files
+		are read by the server and need the FILE privilege.
+	*/
+	if pc != nil {
+		if err = pc.CheckPrivilege(loadDb, loadTable, privilege.Insert); err != nil {
+			return err
+		}
+		if !load.Local {
+			if err = pc.CheckPrivilege("", "", privilege.File); err != nil {
+				return err
+			}
+		}
+	}
+
+	/*
+		check file. the file of LOCAL is on the client.
+	*/
+	if !load.Local {
+		exist, isfile, err := PathExists(load.File)
+		if err != nil || !exist {
+			return fmt.Errorf("file %s does exist. err:%v", load.File, err)
+		}
+
+		if !isfile {
+			return fmt.Errorf("file %s is a directory.", load.File)
+		}
+	}
+
 	dbHandler, err := ses.Pu.StorageEngine.Database(loadDb)
 	if err != nil {
 		//echo client. no such database
@@ -744,6 +769,69 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	return nil
 }
 
+/*
+checkFilePrivilege checks that the user holds the privileges p on the database db and
+the FILE privilege, which is needed by the statements reading or writing files on the server.
+*/
+func checkFilePrivilege(pc plan.PrivilegeChecker, db string, p privilege.Privilege) error {
+	if pc == nil {
+		return nil
+	}
+	if err := pc.CheckPrivilege(db, "", p); err != nil {
+		return err
+	}
+	return pc.CheckPrivilege("", "", privilege.File)
+}
+
+/*
+handle BACKUP DATABASE statement
+*/
+func (mce *MysqlCmdExecutor) handleBackupDatabase(st *tree.BackupDatabase, pc plan.PrivilegeChecker) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	dbName := string(st.Name)
+	if err := checkFilePrivilege(pc, dbName, privilege.Select); err != nil {
+		return err
+	}
+	if _, err := ses.Pu.StorageEngine.Database(dbName); err != nil {
+		return NewMysqlError(ER_BAD_DB_ERROR, dbName)
+	}
+	summary, err := backup.Backup(ses.Pu.StorageEngine, dbName, st.Path)
+	if err != nil {
+		return err
+	}
+	logutil.Infof("backup database %s to %s: %d tables, %d rows", dbName, st.Path, summary.Tables, summary.Rows)
+	resp := NewOkResponse(uint64(summary.Rows), 0, 0, 0, int(COM_QUERY), nil)
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle RESTORE DATABASE statement
+*/
+func (mce *MysqlCmdExecutor) handleRestoreDatabase(st *tree.RestoreDatabase, pc plan.PrivilegeChecker, epoch uint64) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	dbName := string(st.Name)
+	if err := checkFilePrivilege(pc, dbName, privilege.Create); err != nil {
+		return err
+	}
+	summary, err := backup.Restore(ses.Pu.StorageEngine, dbName, st.Path, epoch)
+	if err != nil {
+		return err
+	}
+	logutil.Infof("restore database %s from %s: %d tables, %d rows", dbName, st.Path, summary.Tables, summary.Rows)
+	resp := NewOkResponse(uint64(summary.Rows), 0, 0, 0, int(COM_QUERY), nil)
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 /*
 handle cmd CMD_FIELD_LIST
 */
@@ -819,10 +907,22 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
 	proto := mce.GetSession().protocol
 
+	for _, va := range sv.Assignments {
+		if va.System && isQueryLimitVariable(va.Name) {
+			if err = mce.setQueryLimitVariable(va); err != nil {
+				return err
+			}
+		} else if va.System && strings.ToLower(va.Name) == varLocalInfile {
+			if err = mce.setLocalInfileVariable(va); err != nil {
+				return err
+			}
+		}
+	}
+
 	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -858,27 +958,6 @@ func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
 	return err
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
-	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.doComQuery(sql)
-}
-
 type ComputationWrapperImpl struct {
 	exec *compile.Exec
 }
@@ -926,11 +1005,21 @@ func (cw *ComputationWrapperImpl) Run(ts uint64) error {
 	return cw.exec.Run(ts)
 }
 
+func (cw *ComputationWrapperImpl) Cancel() {
+	cw.exec.Cancel()
+}
+
+func (cw *ComputationWrapperImpl) GetPlanDigest() string {
+	return cw.exec.PlanDigest()
+}
+
 /*
 GetComputationWrapper gets the execs from the computation engine
 */
-var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
+var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *process.Process, pc plan.PrivilegeChecker, dt dialect.DialectType) ([]ComputationWrapper, error) {
 	comp := compile.New(db, sql, user, eng, proc)
+	comp.SetPrivilegeChecker(pc)
+	comp.SetDialect(dt)
 	execs, err := comp.Build()
 	if err != nil {
 		return nil, err
@@ -943,8 +1032,67 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 	return cw, err
 }
 
+// statementMetric records the metrics, the digest summary and the slow query log
+// of the statement which is running
+type statementMetric struct {
+	mce *MysqlCmdExecutor
+
+	//the statistic of the pipelines and the memory quota of the query
+	stat  *process.Statistic
+	quota *host.Mmu
+
+	//the type of the statement, empty when there is no statement running
+	typ   string
+	start time.Time
+	stmt  tree.Statement
+	db    string
+
+	//the fingerprint of the plan, set when the statement is compiled
+	plan string
+
+	//the rows examined and sent by the query before the statement began
+	scanned int64
+	sent    int64
+}
+
+func (sm *statementMetric) begin(stmt tree.Statement) {
+	ses := sm.mce.GetSession()
+	sm.typ = reflect.TypeOf(stmt).Elem().Name()
+	sm.start = time.Now()
+	sm.stmt = stmt
+	sm.db = ses.GetMysqlProtocol().GetDatabaseName()
+	sm.plan = ""
+	sm.scanned = atomic.LoadInt64(&sm.stat.ScanRows)
+	sm.sent = atomic.LoadInt64(&ses.sentRows)
+	// the quota is shared by the statements of the query, the peak of
+	// every statement is counted from the memory held when it begins
+	sm.quota.ResetPeak()
+}
+
+func (sm *statementMetric) end(err error) {
+	if sm.typ == "" {
+		return
+	}
+	latency := time.Since(sm.start)
+	metric.StatementDone(sm.typ, err, latency)
+	st := &statementStat{
+		db:           sm.db,
+		plan:         sm.plan,
+		latency:      latency,
+		err:          err,
+		rowsExamined: atomic.LoadInt64(&sm.stat.ScanRows) - sm.scanned,
+		rowsSent:     atomic.LoadInt64(&sm.mce.GetSession().sentRows) - sm.sent,
+		memory:       sm.quota.Peak(),
+	}
+	logutil.Infof("connection id %d , the peak memory of the statement %d bytes",
+		sm.mce.GetSession().GetMysqlProtocol().ConnectionID(), st.memory)
+	st.digestText, st.digest = statementDigest(sm.stmt)
+	sm.mce.recordStatement(st)
+	sm.typ = ""
+}
+
 //execute query
-func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
+func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	ses := mce.GetSession()
 	proto := ses.GetMysqlProtocol()
 	pdHook := ses.GetEpochgc()
@@ -956,17 +1104,32 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		pdHook.DecQueryCountAtEpoch(epoch, statementCount)
 	}()
 
-	proc := process.New(mheap.New(ses.GuestMmu))
+	quota := mce.prepareQueryLimits()
+	defer func() {
+		quota.Release()
+	}()
+
+	proc := process.New(mheap.New(guest.New(ses.GuestMmu.Limit, quota)))
 	proc.Id = mce.getNextProcessId()
-	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
+	proc.Lim.Size = ses.limits.memory
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Lim.SpillDir = ses.Pu.SV.GetSpillDir()
+	proc.Lim.SpillCompress = ses.Pu.SV.GetSpillCompression()
+	proc.Stat = &process.Statistic{}
+
+	pc, err := mce.privilegeChecker()
+	if err != nil {
+		return err
+	}
 
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
 		proto.GetUserName(),
-		ses.Pu.StorageEngine,
-		proc)
+		mce.storageEngine(pc),
+		proc,
+		pc,
+		mce.dialect)
 	if err != nil {
 		return NewMysqlError(ER_PARSE_ERROR, err,
 			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
@@ -976,16 +1139,34 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		ses.Mrs = nil
 	}()
 
+	sm := statementMetric{mce: mce, stat: proc.Stat, quota: quota}
+	defer func() {
+		sm.end(retErr)
+	}()
+
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
+		sm.end(nil)
+		sm.begin(stmt)
+		if so, ok := proto.(statementObserver); ok {
+			so.beginStatement(stmt)
+		}
 		//temp try 0 epoch
 		pdHook.IncQueryCountAtEpoch(epoch, 1)
 		statementCount++
 
+		ses.ep = newDefaultExportParam()
 		switch st := stmt.(type) {
 		case *tree.Select:
 			if st.Ep != nil {
+				// the rows are written to a file on the server, the SELECT
+				// privileges are checked when the statement is compiled
+				if pc != nil {
+					if err = pc.CheckPrivilege("", "", privilege.File); err != nil {
+						return err
+					}
+				}
 				mce.exportDataClose = NewCloseExportData()
 				ses.ep = st.Ep
 				ses.closeRef = mce.exportDataClose
@@ -1027,6 +1208,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 								return err
 							}
 
+							//next statement
+							continue
+						} else if isQueryLimitVariable(ve.Name) {
+							err = mce.handleSelectQueryLimit(ve.Name)
+							if err != nil {
+								return err
+							}
+
 							//next statement
 							continue
 						}
@@ -1041,6 +1230,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
 				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
+				*tree.BackupDatabase, *tree.RestoreDatabase,
+				*tree.CreateUser, *tree.DropUser, *tree.AlterUser, *tree.SetPassword,
+				*tree.CreateRole, *tree.DropRole, *tree.Grant, *tree.Revoke, *tree.ShowGrants,
+				*tree.SetDefaultRole, *tree.SetRole,
+				*tree.ShowProcessList, *tree.Kill,
 				*tree.Use, *tree.SetVar:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
@@ -1075,7 +1269,19 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		case *tree.Load:
 			selfHandle = true
-			err = mce.handleLoadData(st)
+			err = mce.handleLoadData(st, pc)
+			if err != nil {
+				return err
+			}
+		case *tree.BackupDatabase:
+			selfHandle = true
+			err = mce.handleBackupDatabase(st, pc)
+			if err != nil {
+				return err
+			}
+		case *tree.RestoreDatabase:
+			selfHandle = true
+			err = mce.handleRestoreDatabase(st, pc, epoch)
 			if err != nil {
 				return err
 			}
@@ -1093,9 +1299,83 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
-			if err = mce.handleAnalyzeStmt(st); err != nil {
+			if err = mce.handleAnalyzeStmt(st, proc, pc, epoch); err != nil {
+				return err
+			}
+		case *tree.CheckTable:
+			selfHandle = true
+			if err = mce.handleCheckTable(st, pc); err != nil {
+				return err
+			}
+		case *tree.CompactTable:
+			selfHandle = true
+			if err = mce.handleCompactTable(st, pc); err != nil {
+				return err
+			}
+		case *tree.RotateKey:
+			selfHandle = true
+			if err = mce.handleRotateKey(); err != nil {
+				return err
+			}
+		case *tree.CreateUser:
+			selfHandle = true
+			if err = mce.handleCreateUser(st); err != nil {
+				return err
+			}
+		case *tree.DropUser:
+			selfHandle = true
+			if err = mce.handleDropUser(st); err != nil {
+				return err
+			}
+		case *tree.AlterUser:
+			selfHandle = true
+			if err = mce.handleAlterUser(st); err != nil {
+				return err
+			}
+		case *tree.SetPassword:
+			selfHandle = true
+			if err = mce.handleSetPassword(st); err != nil {
 				return err
 			}
+		case *tree.CreateRole:
+			selfHandle = true
+			if err = mce.handleCreateRole(st); err != nil {
+				return err
+			}
+		case *tree.DropRole:
+			selfHandle = true
+			if err = mce.handleDropRole(st); err != nil {
+				return err
+			}
+		case *tree.Grant:
+			selfHandle = true
+			if err = mce.handleGrant(st); err != nil {
+				return err
+			}
+		case *tree.Revoke:
+			selfHandle = true
+			if err = mce.handleRevoke(st); err != nil {
+				return err
+			}
+		case *tree.ShowGrants:
+			selfHandle = true
+			if err = mce.handleShowGrants(st); err != nil {
+				return err
+			}
+		case *tree.ShowProcessList:
+			selfHandle = true
+			if err = mce.handleShowProcessList(st); err != nil {
+				return err
+			}
+		case *tree.Kill:
+			selfHandle = true
+			if err = mce.handleKill(st); err != nil {
+				return err
+			}
+			//the statements after it are not run on the closed connection
+			if mce.killsItself(st) {
+				return nil
+			}
 
 		}
 
@@ -1107,9 +1387,10 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		}
 
 		cmpBegin := time.Now()
-		if err = cw.Compile(ses, getDataFromPipeline); err != nil {
+		if err = mce.compileComputation(cw); err != nil {
 			return err
 		}
+		sm.plan = cw.GetPlanDigest()
 
 		if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 			logutil.Infof("time of Exec.Build : %s", time.Since(cmpBegin).String())
@@ -1119,7 +1400,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		switch cw.GetAst().(type) {
 		//produce result set
 		case *tree.Select,
-			*tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
+			*tree.ShowCreateTable, *tree.ShowCreateView, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
 			*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus,
 			*tree.ShowIndex,
 			*tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
@@ -1167,7 +1448,16 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				Step 2: Start pipeline
 				Producing the data row and sending the data row
 			*/
-			if er := cw.Run(epoch); er != nil {
+			if ses.ep.Outfile && ses.ep.FileFormat == tree.ExportFormatParquet {
+				if err = openParquetFile(ses); err != nil {
+					return err
+				}
+			}
+			er := mce.runComputation(cw, epoch)
+			if err = closeParquetFile(ses); er == nil {
+				er = err
+			}
+			if er != nil {
 				return er
 			}
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
@@ -1185,19 +1475,18 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
 			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateView, *tree.DropView,
+			*tree.AlterTable, *tree.RenameTable,
 			*tree.Insert, *tree.Delete, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
 			*tree.Load,
-			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
-			*tree.CreateRole, *tree.DropRole,
-			*tree.Revoke, *tree.Grant,
-			*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
+			*tree.SetDefaultRole, *tree.SetRole:
 			runBegin := time.Now()
 			/*
 				Step 1: Start
 			*/
-			if er := cw.Run(epoch); er != nil {
+			if er := mce.runComputation(cw, epoch); er != nil {
 				return er
 			}
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
@@ -1207,7 +1496,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			//record ddl drop xxx after the success
 			switch stmt.(type) {
 			case *tree.DropTable, *tree.DropDatabase,
-				*tree.DropIndex, *tree.DropUser, *tree.DropRole:
+				*tree.DropIndex, *tree.DropView:
 				//test ddl
 				pdHook.IncDDLCountAtEpoch(epoch, 1)
 			}
@@ -1263,34 +1552,25 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 		var query = string(req.GetData().([]byte))
 		mce.addSqlCount(1)
 		logutil.Infof("query:%s", SubStringFromBegin(query, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
-		seps := strings.Split(query, " ")
-		if len(seps) <= 0 {
-			resp = NewGeneralErrorResponse(COM_QUERY, fmt.Errorf("invalid query"))
-			return resp, nil
-		}
-
-		if strings.ToLower(seps[0]) == "kill" {
-			//last one is processID
-			procIdStr := seps[len(seps)-1]
-			procID, err := strconv.ParseUint(procIdStr, 10, 64)
-			if err != nil {
-				resp = NewGeneralErrorResponse(COM_QUERY, err)
-				return resp, nil
-			}
-			err = mce.GetRoutineManager().killStatement(procID)
-			if err != nil {
-				resp = NewGeneralErrorResponse(COM_QUERY, err)
-				return resp, err
-			}
-			resp = NewGeneralOkResponse(COM_QUERY)
-			return resp, nil
-		}
+		ses.process.beginQuery(ses.GetMysqlProtocol().GetDatabaseName(), query)
+		defer ses.process.endQuery(ses.GetMysqlProtocol().GetDatabaseName())
 
 		err := mce.doComQuery(query)
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_QUERY, err)
 		}
 		return resp, nil
+	case COM_PROCESS_KILL:
+		data := req.GetData().([]byte)
+		if len(data) < 4 {
+			resp = NewGeneralErrorResponse(COM_PROCESS_KILL, fmt.Errorf("invalid connection id"))
+			return resp, nil
+		}
+		id := binary.LittleEndian.Uint32(data)
+		if err := mce.handleKill(tree.NewKill(false, uint64(id))); err != nil {
+			resp = NewGeneralErrorResponse(COM_PROCESS_KILL, err)
+		}
+		return resp, nil
 	case COM_INIT_DB:
 		var dbname = string(req.GetData().([]byte))
 		err := mce.handleChangeDB(dbname)
@@ -1343,7 +1623,7 @@ func (mce *MysqlCmdExecutor) Close() {
 }
 
 func NewMysqlCmdExecutor() *MysqlCmdExecutor {
-	return &MysqlCmdExecutor{}
+	return &MysqlCmdExecutor{dialect: dialect.MYSQL}
 }
 
 /*
@@ -1388,3 +1668,45 @@ func convertEngineTypeToMysqlType(engineType uint8, col *MysqlColumn) error {
 	}
 	return nil
 }
+
+/*
+convert the type in mysql to the type in computation engine.
+*/
+func convertMysqlTypeToEngineType(col *MysqlColumn) (types.T, error) {
+	unsigned := uint32(col.Flag())&defines.UNSIGNED_FLAG != 0
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_TINY:
+		if unsigned {
+			return types.T_uint8, nil
+		}
+		return types.T_int8, nil
+	case defines.MYSQL_TYPE_SHORT:
+		if unsigned {
+			return types.T_uint16, nil
+		}
+		return types.T_int16, nil
+	case defines.MYSQL_TYPE_LONG:
+		if unsigned {
+			return types.T_uint32, nil
+		}
+		return types.T_int32, nil
+	case defines.MYSQL_TYPE_LONGLONG:
+		if unsigned {
+			return types.T_uint64, nil
+		}
+		return types.T_int64, nil
+	case defines.MYSQL_TYPE_FLOAT:
+		return types.T_float32, nil
+	case defines.MYSQL_TYPE_DOUBLE:
+		return types.T_float64, nil
+	case defines.MYSQL_TYPE_STRING:
+		return types.T_char, nil
+	case defines.MYSQL_TYPE_VARCHAR:
+		return types.T_varchar, nil
+	case defines.MYSQL_TYPE_DATE:
+		return types.T_date, nil
+	case defines.MYSQL_TYPE_DATETIME:
+		return types.T_datetime, nil
+	}
+	return types.T_any, fmt.Errorf("unsupported mysql type %d", col.ColumnType())
+}
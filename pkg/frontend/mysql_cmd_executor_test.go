@@ -15,6 +15,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -399,6 +400,7 @@ func Test_mce(t *testing.T) {
 		create_1.EXPECT().GetAst().Return(stmts[0]).AnyTimes()
 		create_1.EXPECT().SetDatabaseName(gomock.Any()).Return(nil).AnyTimes()
 		create_1.EXPECT().Compile(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		create_1.EXPECT().GetPlanDigest().Return("").AnyTimes()
 		create_1.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
 		create_1.EXPECT().GetAffectedRows().Return(uint64(0)).AnyTimes()
 
@@ -410,6 +412,7 @@ func Test_mce(t *testing.T) {
 		select_1.EXPECT().GetAst().Return(stmts[0]).AnyTimes()
 		select_1.EXPECT().SetDatabaseName(gomock.Any()).Return(nil).AnyTimes()
 		select_1.EXPECT().Compile(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		select_1.EXPECT().GetPlanDigest().Return("").AnyTimes()
 		select_1.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
 
 		cola := &MysqlColumn{}
@@ -452,6 +455,7 @@ func Test_mce(t *testing.T) {
 			select_2.EXPECT().GetAst().Return(stmts[0]).AnyTimes()
 			select_2.EXPECT().SetDatabaseName(gomock.Any()).Return(nil).AnyTimes()
 			select_2.EXPECT().Compile(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			select_2.EXPECT().GetPlanDigest().Return("").AnyTimes()
 			select_2.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
 			select_2.EXPECT().GetAffectedRows().Return(uint64(0)).AnyTimes()
 			cws = append(cws, select_2)
@@ -488,16 +492,16 @@ func Test_mce(t *testing.T) {
 		convey.So(resp, convey.ShouldBeNil)
 
 		req = &Request{
-			cmd:  int(COM_QUERY),
-			data: []byte("kill"),
+			cmd:  int(COM_PROCESS_KILL),
+			data: []byte{10},
 		}
 		resp, err = mce.ExecRequest(req)
 		convey.So(err, convey.ShouldBeNil)
 		convey.So(resp, convey.ShouldNotBeNil)
 
 		req = &Request{
-			cmd:  int(COM_QUERY),
-			data: []byte("kill 10"),
+			cmd:  int(COM_PROCESS_KILL),
+			data: []byte{10, 0, 0, 0},
 		}
 		mce.SetRoutineManager(&RoutineManager{})
 		resp, err = mce.ExecRequest(req)
@@ -636,7 +640,7 @@ func Test_mce_selfhandle(t *testing.T) {
 		err = mce.handleCmdFieldList("A")
 		convey.So(err, convey.ShouldBeNil)
 
-		err = mce.handleSetVar(nil)
+		err = mce.handleSetVar(tree.NewSetVar(nil))
 		convey.So(err, convey.ShouldBeNil)
 
 		req := &Request{
@@ -978,7 +982,7 @@ func Test_GetComputationWrapper(t *testing.T) {
 		db, sql, user := "T", "SHOW TABLES", "root"
 		var eng engine.Engine
 		proc := &process.Process{}
-		cw, err := GetComputationWrapper(db, sql, user, eng, proc)
+		cw, err := GetComputationWrapper(db, sql, user, eng, proc, nil, dialect.MYSQL)
 		convey.So(cw, convey.ShouldNotBeEmpty)
 		convey.So(err, convey.ShouldBeNil)
 	})
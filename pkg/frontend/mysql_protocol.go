@@ -19,8 +19,10 @@ import (
 	"crypto/sha1"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"math/rand"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/fagongzi/goetty"
@@ -101,6 +103,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -144,6 +148,9 @@ type MysqlProtocol interface {
 	PrepareBeforeProcessingResultSet()
 
 	GetStats() string
+
+	//the server asks the client to send the file for LOAD DATA LOCAL INFILE
+	requestLocalInfile(filename string) (io.ReadCloser, error)
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -259,6 +266,14 @@ type MysqlProtocolImpl struct {
 
 	rowHandler
 
+	//the file content sent by the client for LOAD DATA LOCAL INFILE.
+	//it is not nil during the transfer.
+	localInfileLock sync.Mutex
+	localInfile     *localInfile
+
+	//the accounts created by CREATE USER, nil without the cluster catalog
+	accounts accountStore
+
 	SV *config.SystemVariables
 }
 
@@ -290,9 +305,66 @@ func (mp *MysqlProtocolImpl) PrepareBeforeProcessingResultSet() {
 }
 
 func (mp *MysqlProtocolImpl) Quit() {
+	mp.localInfileLock.Lock()
+	if mp.localInfile != nil {
+		mp.localInfile.abort()
+		mp.localInfile = nil
+	}
+	mp.localInfileLock.Unlock()
 	mp.ProtocolImpl.Quit()
 }
 
+/*
+requestLocalInfile sends the LOCAL INFILE request packet to the client.
+The client sends the content of the file in packets and an empty packet
+at the end. The packets are dispatched to the returned reader by the
+dispatchLocalInfile.
+*/
+func (mp *MysqlProtocolImpl) requestLocalInfile(filename string) (io.ReadCloser, error) {
+	if mp.capability&CLIENT_LOCAL_FILES == 0 {
+		return nil, NewMysqlError(ER_CLIENT_LOCAL_FILES_DISABLED)
+	}
+
+	file := newLocalInfile()
+	mp.localInfileLock.Lock()
+	mp.localInfile = file
+	mp.localInfileLock.Unlock()
+
+	//int<1> 0xfb
+	//string<EOF> filename
+	payload := make([]byte, HeaderOffset, HeaderOffset+1+len(filename))
+	payload = append(payload, defines.LocalInFileHeader)
+	payload = append(payload, filename...)
+	if err := mp.writePackets(payload); err != nil {
+		mp.localInfileLock.Lock()
+		mp.localInfile = nil
+		mp.localInfileLock.Unlock()
+		return nil, err
+	}
+	return &localInfileReader{file: file}, nil
+}
+
+/*
+dispatchLocalInfile hands the payload to the reader of the LOCAL INFILE.
+It returns false when there is no LOCAL INFILE in transfer, and the payload
+is a request. It never blocks the IO goroutine.
+*/
+func (mp *MysqlProtocolImpl) dispatchLocalInfile(payload []byte) bool {
+	mp.localInfileLock.Lock()
+	defer mp.localInfileLock.Unlock()
+	file := mp.localInfile
+	if file == nil {
+		return false
+	}
+
+	//the empty packet ends the file
+	if len(payload) == 0 {
+		mp.localInfile = nil
+	}
+	file.push(payload)
+	return true
+}
+
 //handshake response 41
 type response41 struct {
 	capabilities     uint32
@@ -588,17 +660,67 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 	return bytes.Equal(hash1, auth)
 }
 
-//the server authenticate that the client can connect and use the database
-func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
-	var psw []byte
-	if mp.username == mp.SV.GetDumpuser() { //the user dump for test
-		psw = []byte(mp.SV.GetDumppassword())
+//the server checks the authentication data from the client with the stored
+//hash of the password instead of the password.
+//Algorithm: SHA1( SHA1( password ) ) == SHA1( auth XOR SHA1( salt + SHA1( SHA1( password ) ) ) )
+func (mp *MysqlProtocolImpl) checkPasswordHash(hash2, salt, auth []byte) bool {
+	if len(hash2) == 0 {
+		return len(auth) == 0
+	}
+	if len(auth) != sha1.Size {
+		return false
+	}
+	//hash3 = SHA1(salt + SHA1(SHA1(password)))
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(hash2)
+	hash3 := sha.Sum(nil)
+
+	//SHA1(password) = auth XOR hash3
+	hash1 := make([]byte, sha1.Size)
+	for i := range hash1 {
+		hash1[i] = auth[i] ^ hash3[i]
+	}
+	hash := sha1.Sum(hash1)
+	return bytes.Equal(hash[:], hash2)
+}
+
+//the server authenticate that the client can connect and use the database.
+//plugin is the authentication method of the authResponse. if the account uses
+//another method, the server asks the client to switch to it.
+func (mp *MysqlProtocolImpl) authenticateUser(plugin string, authResponse []byte) error {
+	var ok bool
+	var err error
+	if acct, found := mp.getAuthAccount(); found {
+		method := acct.plugin
+		if method == "" {
+			//the users configured by the system variables accept both methods
+			method = plugin
+			if method != AuthNativePassword && method != AuthCachingSha2Password {
+				method = mp.defaultAuthPlugin()
+			}
+		}
+
+		if method != plugin {
+			if mp.capability&CLIENT_PLUGIN_AUTH == 0 {
+				return fmt.Errorf("the client does not support the authentication method %s", method)
+			}
+			if authResponse, err = mp.negotiateAuthenticationMethod(method); err != nil {
+				return fmt.Errorf("negotiate authentication method failed. error:%v", err)
+			}
+		}
+
+		switch method {
+		case AuthCachingSha2Password:
+			if ok, err = mp.authenticateCachingSha2(acct, authResponse); err != nil {
+				return err
+			}
+		default:
+			ok = mp.checkPasswordHash(acct.nativeHash(), mp.salt, authResponse)
+		}
 	}
 
-	//TO Check password
-	if mp.checkPassword(psw, mp.salt, authResponse) {
+	if ok {
 		logutil.Infof("check password succeeded\n")
 	} else {
 		return fmt.Errorf("check password failed\n")
@@ -616,6 +738,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 	}
 
 	var authResponse []byte
+	var plugin = AuthNativePassword
 	if capabilities, _, ok := mp.io.ReadUint16(payload, 0); !ok {
 		return fmt.Errorf("read capabilities from response packet failed")
 	} else if uint32(capabilities)&CLIENT_PROTOCOL_41 != 0 {
@@ -627,6 +750,9 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp41.authResponse
+		if resp41.clientPluginName != "" {
+			plugin = resp41.clientPluginName
+		}
 		mp.capability = DefaultCapability & resp41.capabilities
 
 		if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
@@ -659,7 +785,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		mp.database = resp320.database
 	}
 
-	if err := mp.authenticateUser(authResponse); err != nil {
+	if err := mp.authenticateUser(plugin, authResponse); err != nil {
 		fail := errorMsgRefer[ER_ACCESS_DENIED_ERROR]
 		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], "Access denied for user")
 		return err
@@ -725,7 +851,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
-		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
+		pos = mp.writeStringNUL(data, pos, mp.defaultAuthPlugin())
 	}
 
 	return data[:pos]
@@ -827,15 +953,6 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(data []byte) (bool, resp
 		if !ok {
 			return false, info, fmt.Errorf("get auth plugin name failed")
 		}
-
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
-			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(); err != nil {
-				return false, info, fmt.Errorf("negotiate authentication method failed. error:%v", err)
-			}
-			info.clientPluginName = AuthNativePassword
-		}
 	}
 
 	//drop client connection attributes
@@ -973,14 +1090,18 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 //the server can send AuthSwitchRequest to ask client to use designated authentication method,
 //if both server and client support CLIENT_PLUGIN_AUTH capability.
 //return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod() ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
 	}
+	return mp.readAuthPacket()
+}
 
+//the server reads a packet from the client during the authentication
+func (mp *MysqlProtocolImpl) readAuthPacket() ([]byte, error) {
 	read, err := mp.tcpConn.Read()
 	if err != nil {
 		return nil, err
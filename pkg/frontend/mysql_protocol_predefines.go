@@ -6241,8 +6241,8 @@ var errorMsgRefer = map[uint16]errorMsgItem{
 	ER_CANT_DROP_FIELD_OR_KEY:        {1091, []string{"42000"}, "Can't DROP '%-.192s'; check that column/key exists"},
 	ER_INSERT_INFO:                   {1092, []string{"HY000"}, "Records: %ld  Duplicates: %ld  Warnings: %ld"},
 	ER_UPDATE_TABLE_USED:             {1093, []string{"HY000"}, "You can't specify target table '%-.192s' for update in FROM clause"},
-	ER_NO_SUCH_THREAD:                {1094, []string{"HY000"}, "Unknown thread id: %lu"},
-	ER_KILL_DENIED_ERROR:             {1095, []string{"HY000"}, "You are not owner of thread %lu"},
+	ER_NO_SUCH_THREAD:                {1094, []string{"HY000"}, "Unknown thread id: %d"},
+	ER_KILL_DENIED_ERROR:             {1095, []string{"HY000"}, "You are not owner of thread %d"},
 	ER_NO_TABLES_USED:                {1096, []string{"HY000"}, "No tables used"},
 	ER_TOO_BIG_SET:                   {1097, []string{"HY000"}, "Too many strings for column %-.192s and SET"},
 	ER_NO_UNIQUE_LOGFILE:             {1098, []string{"HY000"}, "Can't generate a unique log-filename %-.200s.(1-999)\n"},
@@ -7205,7 +7205,7 @@ var errorMsgRefer = map[uint16]errorMsgItem{
 	ER_FEATURE_DISABLED_SEE_DOC:                                     {3167, []string{"HY000"}, "The '%s' feature is disabled; see the documentation for '%s'"},
 	ER_SERVER_ISNT_AVAILABLE:                                        {3168, []string{"HY000"}, "Server isn't available"},
 	ER_SESSION_WAS_KILLED:                                           {3169, []string{"HY000"}, "Session was killed"},
-	ER_CAPACITY_EXCEEDED:                                            {3170, []string{"HY000"}, "Memory capacity of %llu bytes for '%s' exceeded. %s"},
+	ER_CAPACITY_EXCEEDED:                                            {3170, []string{"HY000"}, "Memory capacity of %d bytes for '%s' exceeded. %s"},
 	ER_CAPACITY_EXCEEDED_IN_RANGE_OPTIMIZER:                         {3171, []string{"HY000"}, "Range optimization was not done for this query."},
 	//OBSOLETE_ER_TABLE_NEEDS_UPG_PART : {0000,[]string{""},"Partitioning upgrade required. Please dump/reload to fix it or do: ALTER TABLE `%-.192s`.`%-.192s` UPGRADE PARTITIONING"},
 	ER_CANT_WAIT_FOR_EXECUTED_GTID_SET_WHILE_OWNING_A_GTID: {3173, []string{"HY000"}, "The client holds ownership of the GTID %s. Therefore, WAIT_FOR_EXECUTED_GTID_SET cannot wait for this GTID."},
@@ -16,6 +16,10 @@ package frontend
 
 import (
 	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -28,6 +32,7 @@ import (
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/stretchr/testify/require"
@@ -2192,3 +2197,165 @@ func Test_handleHandshake(t *testing.T) {
 		convey.So(err, convey.ShouldNotBeNil)
 	})
 }
+
+type testAccounts map[string]*catalog.UserInfo
+
+func (ta testAccounts) GetUser(name string) (*catalog.UserInfo, error) {
+	if user, ok := ta[name]; ok {
+		return user, nil
+	}
+	return nil, catalog.ErrUserNotExists
+}
+
+//scramble computes the authentication data of mysql_native_password on the client
+func scramble(password string, salt []byte) []byte {
+	if password == "" {
+		return nil
+	}
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+//scrambleSha2 computes the authentication data of caching_sha2_password on the client
+func scrambleSha2(password string, salt []byte) []byte {
+	if password == "" {
+		return nil
+	}
+	stage1 := sha256.Sum256([]byte(password))
+	stage2 := sha256.Sum256(stage1[:])
+	hash3 := sha256.Sum256(append(stage2[:], salt...))
+	for i := range stage1 {
+		stage1[i] ^= hash3[i]
+	}
+	return stage1[:]
+}
+
+func Test_authenticateUser(t *testing.T) {
+	sv := &config.SystemVariables{}
+	require.NoError(t, sv.LoadInitialValues())
+	salt := []byte("01234567890123456789")
+	mp := &MysqlProtocolImpl{SV: sv}
+	mp.salt = salt
+
+	mp.username = sv.GetDumpuser()
+	require.NoError(t, mp.authenticateUser(AuthNativePassword, scramble(sv.GetDumppassword(), salt)))
+	require.Error(t, mp.authenticateUser(AuthNativePassword, scramble("wrong", salt)))
+
+	mp.username = "u1"
+	require.Error(t, mp.authenticateUser(AuthNativePassword, scramble("111", salt)))
+
+	mp.accounts = testAccounts{
+		"u1": {Name: "u1", Host: "%", Password: catalog.HashPassword("111")},
+		"u2": {Name: "u2", Host: "%"},
+	}
+	require.NoError(t, mp.authenticateUser(AuthNativePassword, scramble("111", salt)))
+	require.Error(t, mp.authenticateUser(AuthNativePassword, scramble("222", salt)))
+	require.Error(t, mp.authenticateUser(AuthNativePassword, nil))
+
+	mp.username = "u2"
+	require.NoError(t, mp.authenticateUser(AuthNativePassword, nil))
+	require.Error(t, mp.authenticateUser(AuthNativePassword, scramble("111", salt)))
+
+	mp.username = "u3"
+	require.Error(t, mp.authenticateUser(AuthNativePassword, nil))
+}
+
+func Test_authenticateCachingSha2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+
+	var written [][]byte
+	var toRead [][]byte
+	ioses.EXPECT().WriteAndFlush(gomock.Any()).DoAndReturn(func(msg interface{}) error {
+		written = append(written, msg.([]byte))
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().Read().DoAndReturn(func() (interface{}, error) {
+		require.NotEmpty(t, toRead)
+		data := toRead[0]
+		toRead = toRead[1:]
+		return &Packet{Payload: data}, nil
+	}).AnyTimes()
+
+	sv := &config.SystemVariables{}
+	require.NoError(t, sv.LoadInitialValues())
+	require.Equal(t, AuthCachingSha2Password, sv.GetDefaultAuthenticationPlugin())
+	salt := []byte("01234567890123456789")
+	mp := &MysqlProtocolImpl{SV: sv}
+	mp.io = NewIOPackage(true)
+	mp.tcpConn = ioses
+	mp.salt = salt
+	mp.capability = CLIENT_PLUGIN_AUTH
+	mp.accounts = testAccounts{
+		"u1": {Name: "u1", Host: "%", Plugin: AuthCachingSha2Password, Password: catalog.HashSha2Password("111")},
+		"u2": {Name: "u2", Host: "%", Password: catalog.HashPassword("222")},
+	}
+
+	//the status of the AuthMoreData packet written last
+	lastStatus := func() []byte {
+		require.NotEmpty(t, written)
+		pkt := written[len(written)-1]
+		return pkt[HeaderLengthOfTheProtocol:]
+	}
+	//the client encrypts the password with the public key
+	encrypt := func(password string) []byte {
+		key, _, err := getRSAKey()
+		require.NoError(t, err)
+		plain := append([]byte(password), 0)
+		for i := range plain {
+			plain[i] ^= salt[i%len(salt)]
+		}
+		data, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &key.PublicKey, plain, nil)
+		require.NoError(t, err)
+		return data
+	}
+
+	//the configured users pass the fast authentication
+	mp.username = sv.GetDumpuser()
+	require.NoError(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2(sv.GetDumppassword(), salt)))
+	require.Equal(t, []byte{authMoreDataHeader, cachingSha2FastAuthSuccess}, lastStatus())
+	require.Error(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("wrong", salt)))
+
+	//the full authentication with the public key requested by the client
+	mp.username = "u1"
+	toRead = [][]byte{{cachingSha2RequestPublicKey}, encrypt("111")}
+	require.NoError(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("111", salt)))
+	require.Empty(t, toRead)
+	_, pub, err := getRSAKey()
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{authMoreDataHeader}, pub...), lastStatus())
+
+	//the fast authentication with the cache
+	require.NoError(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("111", salt)))
+	require.Equal(t, []byte{authMoreDataHeader, cachingSha2FastAuthSuccess}, lastStatus())
+	require.Error(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("222", salt)))
+
+	//the cache is dropped when the password is changed
+	mp.accounts.(testAccounts)["u1"].Password = catalog.HashSha2Password("333")
+	toRead = [][]byte{encrypt("111")}
+	require.Error(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("111", salt)))
+	require.Equal(t, []byte{authMoreDataHeader, cachingSha2PerformFullAuth}, lastStatus())
+	toRead = [][]byte{encrypt("333")}
+	require.NoError(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("333", salt)))
+
+	//the client of mysql_native_password switches to caching_sha2_password
+	toRead = [][]byte{scrambleSha2("333", salt)}
+	require.NoError(t, mp.authenticateUser(AuthNativePassword, scramble("333", salt)))
+	require.Empty(t, toRead)
+
+	//the client of caching_sha2_password switches to mysql_native_password
+	mp.username = "u2"
+	toRead = [][]byte{scramble("222", salt)}
+	require.NoError(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("222", salt)))
+	require.Empty(t, toRead)
+
+	//the client does not support the switch
+	mp.capability = 0
+	require.Error(t, mp.authenticateUser(AuthCachingSha2Password, scrambleSha2("222", salt)))
+}
@@ -0,0 +1,450 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// pgStatement is the statement prepared by Parse
+type pgStatement struct {
+	sql string
+
+	//the type oids of the parameters, 0 if the type is not specified
+	types []uint32
+}
+
+// pgPortal is the statement bound with the parameters by Bind
+type pgPortal struct {
+	sql string
+
+	//the columns of the portal have been sent by Describe
+	described bool
+
+	//the rows left by an Execute with a maximum number of the rows, nil before it runs
+	result *pgPortalResult
+}
+
+/*
+PgCmdExecutor executes the messages of the PostgreSQL protocol.
+The sql is executed by the MysqlCmdExecutor in the dialect of PostgreSQL.
+The parameters of the extended query are replaced with the constants before
+the execution. A portal executed with a maximum number of the rows runs to the
+completion at its first Execute, its rows are kept and sent by the Executes.
+*/
+type PgCmdExecutor struct {
+	*MysqlCmdExecutor
+
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+
+	//an error happened in the extended query, the messages are discarded until Sync
+	failed bool
+}
+
+func NewPgCmdExecutor() *PgCmdExecutor {
+	return &PgCmdExecutor{
+		MysqlCmdExecutor: &MysqlCmdExecutor{dialect: dialect.POSTGRESQL},
+		statements:       make(map[string]*pgStatement),
+		portals:          make(map[string]*pgPortal),
+	}
+}
+
+func (pce *PgCmdExecutor) getProtocol() *PgProtocolImpl {
+	return pce.GetSession().protocol.(*PgProtocolImpl)
+}
+
+// ExecRequest the server executes the message from the client, the responses are sent by the executor
+func (pce *PgCmdExecutor) ExecRequest(req *Request) (*Response, error) {
+	proto := pce.getProtocol()
+	typ := byte(req.GetCmd())
+	r := &pgReader{data: req.GetData().([]byte)}
+	if pce.failed && typ != pgMsgSync {
+		return nil, nil
+	}
+
+	var err error
+	switch typ {
+	case pgMsgQuery:
+		sql := r.readString()
+		if r.err != nil {
+			return nil, proto.sendError(r.err)
+		}
+		if err = pce.execute(sql, false); err != nil {
+			if err = proto.sendError(err); err != nil {
+				return nil, err
+			}
+		}
+		return nil, proto.sendReadyForQuery()
+	case pgMsgParse:
+		err = pce.handleParse(r)
+	case pgMsgBind:
+		err = pce.handleBind(r)
+	case pgMsgDescribe:
+		err = pce.handleDescribe(r)
+	case pgMsgExecute:
+		err = pce.handleExecute(r)
+	case pgMsgClose:
+		err = pce.handleClose(r)
+	case pgMsgSync:
+		pce.failed = false
+		return nil, proto.sendReadyForQuery()
+	case pgMsgFlush:
+		//the messages are flushed as soon as they are written
+	default:
+		err = newPgError(pgStateProtocolViolation, "invalid frontend message type %d", typ)
+	}
+	if err != nil {
+		pce.failed = true
+		return nil, proto.sendError(err)
+	}
+	return nil, nil
+}
+
+// execute runs the sql, the columns are not sent again if the portal has been described
+func (pce *PgCmdExecutor) execute(sql string, described bool) error {
+	proto := pce.getProtocol()
+	proto.beginStatement(nil)
+	proto.described = described
+	defer func() {
+		proto.described = false
+	}()
+	if strings.Trim(sql, " \t\r\n;") == "" {
+		return proto.sendMessage(pgMsgEmptyQueryResponse)
+	}
+	resp, err := pce.MysqlCmdExecutor.ExecRequest(&Request{cmd: int(COM_QUERY), data: []byte(sql)})
+	if err != nil {
+		return err
+	}
+	if resp != nil && resp.GetCategory() == ErrorResponse {
+		if err, ok := resp.GetData().(error); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pce *PgCmdExecutor) handleParse(r *pgReader) error {
+	name := r.readString()
+	stmt := &pgStatement{sql: r.readString()}
+	n := r.readCount()
+	for i := 0; i < n && r.err == nil; i++ {
+		stmt.types = append(stmt.types, uint32(r.readInt32()))
+	}
+	if r.err != nil {
+		return r.err
+	}
+	if _, ok := pce.statements[name]; ok && name != "" {
+		return newPgError(pgStateDuplicateStatement, "prepared statement \"%s\" already exists", name)
+	}
+	//the parameters which are not declared
+	_, cnt, err := pgReplaceParameters(stmt.sql, func(int) (string, error) {
+		return "NULL", nil
+	})
+	if err != nil {
+		return err
+	}
+	for len(stmt.types) < cnt {
+		stmt.types = append(stmt.types, 0)
+	}
+	pce.statements[name] = stmt
+	return pce.getProtocol().sendMessage(pgMsgParseComplete)
+}
+
+func (pce *PgCmdExecutor) handleBind(r *pgReader) error {
+	portal := r.readString()
+	name := r.readString()
+	formats := make([]int16, r.readCount())
+	for i := range formats {
+		formats[i] = r.readInt16()
+	}
+	params := make([][]byte, r.readCount())
+	for i := range params {
+		params[i] = r.readBytes()
+	}
+	resultFormats := make([]int16, r.readCount())
+	for i := range resultFormats {
+		resultFormats[i] = r.readInt16()
+	}
+	if r.err != nil {
+		return r.err
+	}
+	for _, f := range append(formats, resultFormats...) {
+		if f != pgFormatText {
+			return newPgError(pgStateFeatureNotSupported, "binary format is not supported")
+		}
+	}
+
+	stmt, ok := pce.statements[name]
+	if !ok {
+		return newPgError(pgStateUndefinedStatement, "prepared statement \"%s\" does not exist", name)
+	}
+	if len(params) != len(stmt.types) {
+		return newPgError(pgStateProtocolViolation, "bind message supplies %d parameters, but prepared statement \"%s\" requires %d",
+			len(params), name, len(stmt.types))
+	}
+	sql, _, err := pgReplaceParameters(stmt.sql, func(i int) (string, error) {
+		return pgParameterConstant(params[i-1], stmt.types[i-1]), nil
+	})
+	if err != nil {
+		return err
+	}
+	pce.portals[portal] = &pgPortal{sql: sql}
+	return pce.getProtocol().sendMessage(pgMsgBindComplete)
+}
+
+func (pce *PgCmdExecutor) handleDescribe(r *pgReader) error {
+	kind := r.readByte()
+	name := r.readString()
+	if r.err != nil {
+		return r.err
+	}
+	proto := pce.getProtocol()
+
+	var sql string
+	var portal *pgPortal
+	switch kind {
+	case 'S':
+		stmt, ok := pce.statements[name]
+		if !ok {
+			return newPgError(pgStateUndefinedStatement, "prepared statement \"%s\" does not exist", name)
+		}
+		data := pgAppendInt16(pgNewMessage(pgMsgParameterDescription), int16(len(stmt.types)))
+		for _, oid := range stmt.types {
+			if oid == 0 {
+				oid = pgTypeText
+			}
+			data = pgAppendInt32(data, int32(oid))
+		}
+		if err := proto.writeMessage(data); err != nil {
+			return err
+		}
+		sql, _, _ = pgReplaceParameters(stmt.sql, func(int) (string, error) {
+			return "NULL", nil
+		})
+	case 'P':
+		var ok bool
+		if portal, ok = pce.portals[name]; !ok {
+			return newPgError(pgStateUndefinedCursor, "portal \"%s\" does not exist", name)
+		}
+		sql = portal.sql
+	default:
+		return newPgError(pgStateProtocolViolation, "invalid DESCRIBE message subtype %d", kind)
+	}
+
+	columns := pce.describe(sql)
+	if portal != nil {
+		portal.described = len(columns) != 0
+	}
+	if len(columns) == 0 {
+		return proto.sendMessage(pgMsgNoData)
+	}
+	return proto.sendRowDescription(columns)
+}
+
+/*
+describe returns the columns of the result set of the sql without running it.
+The statements which are not SELECT or EXPLAIN have no columns, so do the
+statements which fail to compile, the error is reported when they are executed.
+*/
+func (pce *PgCmdExecutor) describe(sql string) []*MysqlColumn {
+	ses := pce.GetSession()
+	proto := pce.getProtocol()
+
+	quota := pce.prepareQueryLimits()
+	defer quota.Release()
+	proc := process.New(mheap.New(guest.New(ses.GuestMmu.Limit, quota)))
+	proc.Id = pce.getNextProcessId()
+	proc.Lim.Size = ses.limits.memory
+	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
+	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Stat = &process.Statistic{}
+
+	pc, err := pce.privilegeChecker()
+	if err != nil {
+		return nil
+	}
+	cws, err := GetComputationWrapper(proto.GetDatabaseName(), sql, proto.GetUserName(), pce.storageEngine(pc), proc, pc, pce.dialect)
+	if err != nil || len(cws) != 1 {
+		return nil
+	}
+	cw := cws[0]
+	switch cw.GetAst().(type) {
+	case *tree.Select, *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
+	default:
+		return nil
+	}
+	if err = cw.SetDatabaseName(proto.GetDatabaseName()); err != nil {
+		return nil
+	}
+	if err = cw.Compile(ses, getDataFromPipeline); err != nil {
+		logutil.Infof("describe %s failed. error:%v", sql, err)
+		return nil
+	}
+	columns, err := cw.GetColumns()
+	if err != nil {
+		return nil
+	}
+	cols := make([]*MysqlColumn, 0, len(columns))
+	for _, c := range columns {
+		cols = append(cols, c.(*MysqlColumn))
+	}
+	return cols
+}
+
+func (pce *PgCmdExecutor) handleExecute(r *pgReader) error {
+	name := r.readString()
+	maxRows := int(r.readInt32())
+	if r.err != nil {
+		return r.err
+	}
+	portal, ok := pce.portals[name]
+	if !ok {
+		return newPgError(pgStateUndefinedCursor, "portal \"%s\" does not exist", name)
+	}
+	proto := pce.getProtocol()
+	if portal.result == nil {
+		if maxRows <= 0 {
+			return pce.execute(portal.sql, portal.described)
+		}
+		portal.result = &pgPortalResult{}
+		proto.result = portal.result
+		err := pce.execute(portal.sql, portal.described)
+		proto.result = nil
+		if err != nil {
+			portal.result = nil
+			return err
+		}
+	}
+	return proto.sendPortalRows(portal.result, maxRows)
+}
+
+func (pce *PgCmdExecutor) handleClose(r *pgReader) error {
+	kind := r.readByte()
+	name := r.readString()
+	if r.err != nil {
+		return r.err
+	}
+	switch kind {
+	case 'S':
+		delete(pce.statements, name)
+	case 'P':
+		delete(pce.portals, name)
+	default:
+		return newPgError(pgStateProtocolViolation, "invalid CLOSE message subtype %d", kind)
+	}
+	return pce.getProtocol().sendMessage(pgMsgCloseComplete)
+}
+
+/*
+pgReplaceParameters replaces the parameters $n in the sql with the constants made by the f.
+The strings, the quoted identifiers and the comments are skipped.
+It returns the sql and the maximum number of the parameters.
+*/
+func pgReplaceParameters(sql string, f func(i int) (string, error)) (string, int, error) {
+	var buf strings.Builder
+	var cnt int
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		j := i + 1
+		switch {
+		case c == '\'' || c == '"':
+			escape := c == '\'' && i > 0 && (sql[i-1] == 'E' || sql[i-1] == 'e')
+			for j < len(sql) {
+				if escape && sql[j] == '\\' {
+					j += 2
+					continue
+				}
+				if sql[j] == c {
+					if j+1 < len(sql) && sql[j+1] == c {
+						j += 2
+						continue
+					}
+					j++
+					break
+				}
+				j++
+			}
+		case c == '-' && j < len(sql) && sql[j] == '-':
+			for j < len(sql) && sql[j] != '\n' {
+				j++
+			}
+		case c == '/' && j < len(sql) && sql[j] == '*':
+			if k := strings.Index(sql[j+1:], "*/"); k < 0 {
+				j = len(sql)
+			} else {
+				j += k + 3
+			}
+		case c == '$' && j < len(sql) && sql[j] >= '0' && sql[j] <= '9' && (i == 0 || !isPgIdentChar(sql[i-1])):
+			for j < len(sql) && sql[j] >= '0' && sql[j] <= '9' {
+				j++
+			}
+			n, err := strconv.Atoi(sql[i+1 : j])
+			if err != nil || n == 0 {
+				return "", 0, newPgError(pgStateUndefinedParameter, "there is no parameter %s", sql[i:j])
+			}
+			if n > cnt {
+				cnt = n
+			}
+			s, err := f(n)
+			if err != nil {
+				return "", 0, err
+			}
+			buf.WriteString(s)
+			i = j
+			continue
+		}
+		if j > len(sql) {
+			j = len(sql)
+		}
+		buf.WriteString(sql[i:j])
+		i = j
+	}
+	return buf.String(), cnt, nil
+}
+
+var pgNumberRegexp = regexp.MustCompile(`^[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?$`)
+
+func isPgIdentChar(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+/*
+pgParameterConstant returns the constant of the parameter in the text format.
+The numbers are not quoted if the type of the parameter is a number, or the type
+is not specified and the value looks like a number.
+*/
+func pgParameterConstant(value []byte, oid uint32) string {
+	if value == nil {
+		return "NULL"
+	}
+	s := string(value)
+	switch oid {
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8, pgTypeFloat4, pgTypeFloat8, pgTypeNumeric, 0:
+		if pgNumberRegexp.MatchString(s) {
+			return s
+		}
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pgExecuteMaxRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var out [][]byte
+	pp := newTestPgProtocol(t, ctrl, &out)
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+	ses := NewSession(pp, getPCI(), guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu), pu.Mempool, pu)
+	pce := NewPgCmdExecutor()
+	pce.PrepareSessionBeforeExecRequest(ses)
+
+	execute := func(portal string, maxRows int32) {
+		out = nil
+		data := pgAppendInt32(pgAppendString(nil, portal), maxRows)
+		_, err := pce.ExecRequest(&Request{cmd: int(pgMsgExecute), data: data})
+		require.NoError(t, err)
+	}
+
+	// the rows kept by the first Execute of the portal
+	result := &pgPortalResult{}
+	pp.result = result
+	pp.beginStatement(&tree.Select{})
+	err = pp.SendResponse(NewResponse(ResultResponse, 0, int(COM_QUERY), makeMysqlLongResult(false)))
+	require.NoError(t, err)
+	pp.result = nil
+	pce.portals["p1"] = &pgPortal{sql: "select a from t", described: true, result: result}
+
+	execute("p1", 2)
+	require.Equal(t, "DDs", pgMessageTypes(out))
+	execute("p1", 2)
+	require.Equal(t, "DC", pgMessageTypes(out))
+	require.Equal(t, "SELECT 1\x00", string(pgSplitMessages(out)[1][5:]))
+
+	// the portal runs at its first Execute with the maximum number of the rows
+	pce.portals["p2"] = &pgPortal{sql: " "}
+	execute("p2", 5)
+	require.Equal(t, "I", pgMessageTypes(out))
+	require.NotNil(t, pce.portals["p2"].result)
+	require.Nil(t, pp.result)
+
+	execute("p3", 1)
+	require.Equal(t, "E", pgMessageTypes(out))
+}
@@ -0,0 +1,905 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/goetty/codec"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// the codes of the messages without the type in the startup phase
+const (
+	pgProtocolVersion   uint32 = 3 << 16
+	pgCancelRequestCode uint32 = 80877102
+	pgSSLRequestCode    uint32 = 80877103
+	pgGSSENCRequestCode uint32 = 80877104
+)
+
+// the types of the messages from the client
+const (
+	pgMsgStartup   byte = 0
+	pgMsgQuery     byte = 'Q'
+	pgMsgParse     byte = 'P'
+	pgMsgBind      byte = 'B'
+	pgMsgDescribe  byte = 'D'
+	pgMsgExecute   byte = 'E'
+	pgMsgClose     byte = 'C'
+	pgMsgSync      byte = 'S'
+	pgMsgFlush     byte = 'H'
+	pgMsgTerminate byte = 'X'
+	pgMsgPassword  byte = 'p'
+)
+
+// the types of the messages from the server
+const (
+	pgMsgAuthentication       byte = 'R'
+	pgMsgParameterStatus      byte = 'S'
+	pgMsgBackendKeyData       byte = 'K'
+	pgMsgReadyForQuery        byte = 'Z'
+	pgMsgRowDescription       byte = 'T'
+	pgMsgDataRow              byte = 'D'
+	pgMsgCommandComplete      byte = 'C'
+	pgMsgEmptyQueryResponse   byte = 'I'
+	pgMsgErrorResponse        byte = 'E'
+	pgMsgParseComplete        byte = '1'
+	pgMsgBindComplete         byte = '2'
+	pgMsgCloseComplete        byte = '3'
+	pgMsgNoData               byte = 'n'
+	pgMsgParameterDescription byte = 't'
+	pgMsgPortalSuspended      byte = 's'
+)
+
+// the authentication requests
+const (
+	pgAuthOk                int32 = 0
+	pgAuthCleartextPassword int32 = 3
+	pgAuthMD5Password       int32 = 5
+)
+
+// the type oids of PostgreSQL
+const (
+	pgTypeInt8      uint32 = 20
+	pgTypeInt2      uint32 = 21
+	pgTypeInt4      uint32 = 23
+	pgTypeText      uint32 = 25
+	pgTypeJson      uint32 = 114
+	pgTypeFloat4    uint32 = 700
+	pgTypeFloat8    uint32 = 701
+	pgTypeBpchar    uint32 = 1042
+	pgTypeVarchar   uint32 = 1043
+	pgTypeDate      uint32 = 1082
+	pgTypeTimestamp uint32 = 1114
+	pgTypeNumeric   uint32 = 1700
+)
+
+// the SQLSTATE of the errors raised by the protocol
+const (
+	pgStateInternalError       = "XX000"
+	pgStateProtocolViolation   = "08P01"
+	pgStateFeatureNotSupported = "0A000"
+	pgStateInvalidPassword     = "28P01"
+	pgStateUndefinedParameter  = "42P02"
+	pgStateDuplicateStatement  = "42P05"
+	pgStateUndefinedStatement  = "26000"
+	pgStateUndefinedCursor     = "34000"
+
+	//the general error of MySQL which is not a SQLSTATE of PostgreSQL
+	mysqlStateGeneralError = "HY000"
+)
+
+const (
+	pgSeverityError = "ERROR"
+	pgSeverityFatal = "FATAL"
+
+	//the version told to the client
+	pgServerVersion = "9.6.0"
+
+	//the transaction status in ReadyForQuery
+	pgTransactionIdle byte = 'I'
+
+	pgMD5SaltLength            = 4
+	pgStartupMessageHeaderSize = 4
+	pgMessageHeaderSize        = 5
+
+	//the values are sent and received in the text format only
+	pgFormatText int16 = 0
+)
+
+// pgError is the error with the SQLSTATE
+type pgError struct {
+	code    string
+	message string
+}
+
+func (e *pgError) Error() string {
+	return e.message
+}
+
+func newPgError(code, format string, args ...interface{}) *pgError {
+	return &pgError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// pgErrorFields returns the SQLSTATE and the message of the error
+func pgErrorFields(err error) (string, string) {
+	switch e := err.(type) {
+	case *pgError:
+		return e.code, e.message
+	case *MysqlError:
+		if e.SqlState == mysqlStateGeneralError {
+			return pgStateInternalError, e.Error()
+		}
+		return e.SqlState, e.Error()
+	case interface{ Code() string }:
+		code := e.Code()
+		return code, strings.TrimPrefix(err.Error(), "["+code+"]")
+	}
+	return pgStateInternalError, err.Error()
+}
+
+// pgMessage is the message from the client, the type of the messages in the startup phase is 0
+type pgMessage struct {
+	typ     byte
+	payload []byte
+}
+
+func NewPgCodec() (codec.Encoder, codec.Decoder) {
+	c := &pgCodec{}
+	return c, c
+}
+
+// pgCodec decodes the messages of the PostgreSQL protocol, the data sent are bytes already
+type pgCodec struct {
+	sqlCodec
+}
+
+func (c *pgCodec) Decode(in *buf.ByteBuf) (bool, interface{}, error) {
+	header, err := in.PeekN(0, pgMessageHeaderSize)
+	if err != nil {
+		return false, nil, nil
+	}
+
+	//the messages in the startup phase have no type. their length is less than 16MB,
+	//so the first byte is 0 which is never a type.
+	var msg pgMessage
+	var length, headerSize int
+	if header[0] == pgMsgStartup {
+		headerSize = pgStartupMessageHeaderSize
+		length = int(binary.BigEndian.Uint32(header))
+	} else {
+		msg.typ = header[0]
+		headerSize = pgMessageHeaderSize
+		length = int(binary.BigEndian.Uint32(header[1:])) + 1
+	}
+	if length < headerSize {
+		return false, nil, fmt.Errorf("invalid length %d of the message %q", length, msg.typ)
+	}
+	if in.Readable() < length {
+		return false, nil, nil
+	}
+
+	if err = in.Skip(headerSize); err != nil {
+		return false, nil, err
+	}
+	if _, msg.payload, err = in.ReadBytes(length - headerSize); err != nil {
+		return false, nil, err
+	}
+	return true, &msg, nil
+}
+
+// pgReader reads the fields of the message from the client
+type pgReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *pgReader) fail() {
+	if r.err == nil {
+		r.err = newPgError(pgStateProtocolViolation, "invalid message format")
+	}
+}
+
+func (r *pgReader) readByte() byte {
+	if r.pos+1 > len(r.data) {
+		r.fail()
+		return 0
+	}
+	r.pos++
+	return r.data[r.pos-1]
+}
+
+func (r *pgReader) readInt16() int16 {
+	if r.pos+2 > len(r.data) {
+		r.fail()
+		return 0
+	}
+	r.pos += 2
+	return int16(binary.BigEndian.Uint16(r.data[r.pos-2:]))
+}
+
+func (r *pgReader) readInt32() int32 {
+	if r.pos+4 > len(r.data) {
+		r.fail()
+		return 0
+	}
+	r.pos += 4
+	return int32(binary.BigEndian.Uint32(r.data[r.pos-4:]))
+}
+
+// readCount reads the number of the fields which follow
+func (r *pgReader) readCount() int {
+	n := int(r.readInt16())
+	if n < 0 {
+		r.fail()
+		return 0
+	}
+	return n
+}
+
+func (r *pgReader) readString() string {
+	i := bytes.IndexByte(r.data[r.pos:], 0)
+	if i < 0 {
+		r.fail()
+		return ""
+	}
+	r.pos += i + 1
+	return string(r.data[r.pos-i-1 : r.pos-1])
+}
+
+// readBytes reads the value with the length, nil is returned for the length -1
+func (r *pgReader) readBytes() []byte {
+	n := int(r.readInt32())
+	if n < 0 || r.err != nil {
+		return nil
+	}
+	if r.pos+n > len(r.data) {
+		r.fail()
+		return nil
+	}
+	r.pos += n
+	return r.data[r.pos-n : r.pos]
+}
+
+// the functions append the fields to the message from the server
+func pgNewMessage(typ byte) []byte {
+	return append(make([]byte, 0, 64), typ, 0, 0, 0, 0)
+}
+
+func pgAppendInt16(data []byte, v int16) []byte {
+	return append(data, byte(v>>8), byte(v))
+}
+
+func pgAppendInt32(data []byte, v int32) []byte {
+	return append(data, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func pgAppendString(data []byte, s string) []byte {
+	return append(append(data, s...), 0)
+}
+
+// pgFinishMessage fills the length of the message
+func pgFinishMessage(data []byte) []byte {
+	binary.BigEndian.PutUint32(data[1:], uint32(len(data)-1))
+	return data
+}
+
+// pgTypeOid returns the type oid of PostgreSQL and the size of the type, -1 for the variable size
+func pgTypeOid(t types.T) (uint32, int16) {
+	switch t {
+	case types.T_int8, types.T_uint8, types.T_int16:
+		return pgTypeInt2, 2
+	case types.T_uint16, types.T_int32:
+		return pgTypeInt4, 4
+	case types.T_uint32, types.T_int64:
+		return pgTypeInt8, 8
+	case types.T_uint64, types.T_decimal:
+		return pgTypeNumeric, -1
+	case types.T_float32:
+		return pgTypeFloat4, 4
+	case types.T_float64:
+		return pgTypeFloat8, 8
+	case types.T_char:
+		return pgTypeBpchar, -1
+	case types.T_varchar:
+		return pgTypeVarchar, -1
+	case types.T_json:
+		return pgTypeJson, -1
+	case types.T_date:
+		return pgTypeDate, 4
+	case types.T_datetime:
+		return pgTypeTimestamp, 8
+	}
+	return pgTypeText, -1
+}
+
+// pgColumnType returns the type oid and the size of the column in the result set
+func pgColumnType(col *MysqlColumn) (uint32, int16) {
+	t, err := convertMysqlTypeToEngineType(col)
+	if err != nil {
+		return pgTypeText, -1
+	}
+	return pgTypeOid(t)
+}
+
+// statementObserver is implemented by the protocols which tell the client
+// the statement that the result belongs to.
+type statementObserver interface {
+	beginStatement(stmt tree.Statement)
+}
+
+/*
+pgCommandTag returns the tag of CommandComplete for the statement.
+The tag is made of the name of the statement, like CREATE TABLE for CreateTable,
+and the number of the rows for the statements changing or returning rows.
+*/
+func pgCommandTag(stmt tree.Statement, rows uint64) string {
+	switch stmt.(type) {
+	case nil:
+		return ""
+	case *tree.Insert:
+		return fmt.Sprintf("INSERT 0 %d", rows)
+	case *tree.Update:
+		return fmt.Sprintf("UPDATE %d", rows)
+	case *tree.Delete:
+		return fmt.Sprintf("DELETE %d", rows)
+	case *tree.Load:
+		return fmt.Sprintf("COPY %d", rows)
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	case *tree.SetVar:
+		return "SET"
+	}
+	name := reflect.TypeOf(stmt).Elem().Name()
+	var tag strings.Builder
+	for i, c := range name {
+		if i > 0 && unicode.IsUpper(c) {
+			tag.WriteByte(' ')
+		}
+		tag.WriteRune(unicode.ToUpper(c))
+	}
+	return tag.String()
+}
+
+/*
+PgProtocolImpl is the PostgreSQL protocol v3.
+It implements the MysqlProtocol so that the statements are executed by the MysqlCmdExecutor,
+the result sets and the status of the statements are translated into the messages of PostgreSQL.
+*/
+type PgProtocolImpl struct {
+	ProtocolImpl
+
+	//the user of the client
+	username string
+
+	//the default database for the client
+	database string
+
+	//the parameters in the startup message
+	params map[string]string
+
+	//the key of CancelRequest
+	secret int32
+
+	//the authentication request sent, 0 before the startup message
+	authMethod int32
+
+	//the statement running, the columns and the count of the rows sent
+	stmt    tree.Statement
+	columns []*MysqlColumn
+	rows    uint64
+
+	//the columns have been described by the Describe of the extended query
+	described bool
+
+	//the rows and the completion are kept for the portal instead of sent, nil if they are sent
+	result *pgPortalResult
+
+	//the accounts created by CREATE USER, nil without the cluster catalog
+	accounts accountStore
+
+	SV *config.SystemVariables
+}
+
+var _ MysqlProtocol = &PgProtocolImpl{}
+
+func NewPgProtocol(connectionID uint32, tcp goetty.IOSession, SV *config.SystemVariables) *PgProtocolImpl {
+	salt := make([]byte, pgMD5SaltLength+4)
+	if _, err := rand.Read(salt); err != nil {
+		logutil.Errorf("generate the salt failed. error:%v", err)
+	}
+	return &PgProtocolImpl{
+		ProtocolImpl: ProtocolImpl{
+			tcpConn:      tcp,
+			salt:         salt[:pgMD5SaltLength],
+			connectionID: connectionID,
+			established:  false,
+		},
+		secret: int32(binary.BigEndian.Uint32(salt[pgMD5SaltLength:])),
+		SV:     SV,
+	}
+}
+
+func (pp *PgProtocolImpl) GetDatabaseName() string {
+	return pp.database
+}
+
+func (pp *PgProtocolImpl) SetDatabaseName(s string) {
+	pp.database = s
+}
+
+func (pp *PgProtocolImpl) GetUserName() string {
+	return pp.username
+}
+
+func (pp *PgProtocolImpl) SetUserName(s string) {
+	pp.username = s
+}
+
+func (pp *PgProtocolImpl) GetRequest(payload []byte) *Request {
+	return &Request{
+		cmd:  int(pgMsgQuery),
+		data: payload,
+	}
+}
+
+func (pp *PgProtocolImpl) GetStats() string {
+	return ""
+}
+
+func (pp *PgProtocolImpl) PrepareBeforeProcessingResultSet() {
+}
+
+func (pp *PgProtocolImpl) requestLocalInfile(_ string) (io.ReadCloser, error) {
+	return nil, NewMysqlError(ER_CLIENT_LOCAL_FILES_DISABLED)
+}
+
+func (pp *PgProtocolImpl) beginStatement(stmt tree.Statement) {
+	pp.stmt = stmt
+	pp.columns = nil
+	pp.rows = 0
+}
+
+func (pp *PgProtocolImpl) writeMessage(data []byte) error {
+	return pp.tcpConn.WriteAndFlush(pgFinishMessage(data))
+}
+
+/*
+handleStartup handles the messages in the startup phase.
+The server asks for the password in md5 if it knows the password of the user,
+otherwise in cleartext. It returns true if the connection is established.
+*/
+func (pp *PgProtocolImpl) handleStartup(msg *pgMessage) (bool, error) {
+	r := &pgReader{data: msg.payload}
+	if pp.authMethod != 0 {
+		if msg.typ != pgMsgPassword {
+			return false, pp.sendFatal(newPgError(pgStateProtocolViolation, "expected password response, got message type %q", msg.typ))
+		}
+		password := r.readString()
+		if r.err != nil {
+			return false, pp.sendFatal(r.err)
+		}
+		if !pp.checkPassword(password) {
+			return false, pp.sendFatal(newPgError(pgStateInvalidPassword, "password authentication failed for user \"%s\"", pp.username))
+		}
+		return true, pp.sendStartupDone()
+	}
+
+	if msg.typ != pgMsgStartup {
+		return false, pp.sendFatal(newPgError(pgStateProtocolViolation, "expected startup message, got message type %q", msg.typ))
+	}
+	switch code := uint32(r.readInt32()); code {
+	case pgSSLRequestCode, pgGSSENCRequestCode:
+		//the secure connection is not supported, the client goes on in plain text
+		return false, pp.tcpConn.WriteAndFlush([]byte{'N'})
+	case pgProtocolVersion:
+	default:
+		return false, pp.sendFatal(newPgError(pgStateFeatureNotSupported, "unsupported frontend protocol %d.%d", code>>16, code&0xffff))
+	}
+
+	pp.params = make(map[string]string)
+	for r.err == nil && r.pos < len(r.data) {
+		name := r.readString()
+		if name == "" {
+			break
+		}
+		pp.params[name] = r.readString()
+	}
+	if r.err != nil {
+		return false, pp.sendFatal(r.err)
+	}
+	pp.username = pp.params["user"]
+	if pp.username == "" {
+		return false, pp.sendFatal(newPgError(pgStateProtocolViolation, "no PostgreSQL user name specified in startup packet"))
+	}
+	pp.database = pp.params["database"]
+
+	pp.authMethod = pgAuthCleartextPassword
+	if acct, ok := lookupAuthAccount(pp.username, pp.SV, pp.accounts); ok && acct.plugin == "" {
+		pp.authMethod = pgAuthMD5Password
+	}
+	data := pgAppendInt32(pgNewMessage(pgMsgAuthentication), pp.authMethod)
+	if pp.authMethod == pgAuthMD5Password {
+		data = append(data, pp.salt...)
+	}
+	return false, pp.writeMessage(data)
+}
+
+// checkPassword checks the response to the authentication request
+func (pp *PgProtocolImpl) checkPassword(password string) bool {
+	acct, ok := lookupAuthAccount(pp.username, pp.SV, pp.accounts)
+	if !ok {
+		return false
+	}
+	if pp.authMethod == pgAuthMD5Password {
+		return acct.plugin == "" && password == pgMD5Password(pp.username, acct.password, pp.salt)
+	}
+	return acct.checkPassword(password)
+}
+
+// pgMD5Password returns the response of the md5 authentication.
+// Algorithm: "md5" + md5hex( md5hex( password + user ) + salt )
+func pgMD5Password(user, password string, salt []byte) string {
+	inner := md5.Sum([]byte(password + user))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	return "md5" + hex.EncodeToString(outer[:])
+}
+
+// sendStartupDone tells the client that the authentication succeeded and the server is ready
+func (pp *PgProtocolImpl) sendStartupDone() error {
+	if err := pp.writeMessage(pgAppendInt32(pgNewMessage(pgMsgAuthentication), pgAuthOk)); err != nil {
+		return err
+	}
+	clientEncoding := pp.params["client_encoding"]
+	if clientEncoding == "" {
+		clientEncoding = "UTF8"
+	}
+	status := [][2]string{
+		{"server_version", pgServerVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", clientEncoding},
+		{"DateStyle", "ISO, MDY"},
+		{"TimeZone", "UTC"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"application_name", pp.params["application_name"]},
+	}
+	for _, kv := range status {
+		data := pgAppendString(pgAppendString(pgNewMessage(pgMsgParameterStatus), kv[0]), kv[1])
+		if err := pp.writeMessage(data); err != nil {
+			return err
+		}
+	}
+	data := pgAppendInt32(pgAppendInt32(pgNewMessage(pgMsgBackendKeyData), int32(pp.connectionID)), pp.secret)
+	if err := pp.writeMessage(data); err != nil {
+		return err
+	}
+	return pp.sendReadyForQuery()
+}
+
+func (pp *PgProtocolImpl) sendReadyForQuery() error {
+	return pp.writeMessage(append(pgNewMessage(pgMsgReadyForQuery), pgTransactionIdle))
+}
+
+func (pp *PgProtocolImpl) sendErrorResponse(severity string, err error) error {
+	code, message := pgErrorFields(err)
+	data := pgNewMessage(pgMsgErrorResponse)
+	data = pgAppendString(append(data, 'S'), severity)
+	data = pgAppendString(append(data, 'V'), severity)
+	data = pgAppendString(append(data, 'C'), code)
+	data = pgAppendString(append(data, 'M'), message)
+	return pp.writeMessage(append(data, 0))
+}
+
+func (pp *PgProtocolImpl) sendError(err error) error {
+	return pp.sendErrorResponse(pgSeverityError, err)
+}
+
+// sendFatal sends the error and returns it, the connection is closed then
+func (pp *PgProtocolImpl) sendFatal(err error) error {
+	if err1 := pp.sendErrorResponse(pgSeverityFatal, err); err1 != nil {
+		logutil.Errorf("send the error response failed. error:%v", err1)
+	}
+	return err
+}
+
+func (pp *PgProtocolImpl) sendCommandComplete(rows uint64) error {
+	if pp.result != nil {
+		pp.result.complete = pgFinishMessage(pgAppendString(pgNewMessage(pgMsgCommandComplete), pgCommandTag(pp.stmt, rows)))
+		return nil
+	}
+	return pp.writeMessage(pgAppendString(pgNewMessage(pgMsgCommandComplete), pgCommandTag(pp.stmt, rows)))
+}
+
+func (pp *PgProtocolImpl) sendSelectComplete() error {
+	if pp.result != nil {
+		pp.result.selected = true
+		return nil
+	}
+	return pp.writeMessage(pgAppendString(pgNewMessage(pgMsgCommandComplete), fmt.Sprintf("SELECT %d", pp.rows)))
+}
+
+func (pp *PgProtocolImpl) sendMessage(typ byte) error {
+	return pp.writeMessage(pgNewMessage(typ))
+}
+
+// sendRowDescription sends the columns of the result set, it is skipped if Describe has sent them
+func (pp *PgProtocolImpl) sendRowDescription(columns []*MysqlColumn) error {
+	if pp.described {
+		return nil
+	}
+	data := pgAppendInt16(pgNewMessage(pgMsgRowDescription), int16(len(columns)))
+	for _, col := range columns {
+		oid, size := pgColumnType(col)
+		data = pgAppendString(data, col.Name())
+		data = pgAppendInt32(data, 0) //the oid of the table
+		data = pgAppendInt16(data, 0) //the attribute number of the column
+		data = pgAppendInt32(data, int32(oid))
+		data = pgAppendInt16(data, size)
+		data = pgAppendInt32(data, -1) //the type modifier
+		data = pgAppendInt16(data, pgFormatText)
+	}
+	return pp.writeMessage(data)
+}
+
+// appendDataRow appends the DataRow message of the row r in the text format
+func (pp *PgProtocolImpl) appendDataRow(data []byte, mrs *MysqlResultSet, r uint64) ([]byte, error) {
+	start := len(data)
+	data = pgAppendInt16(append(data, pgMsgDataRow, 0, 0, 0, 0), int16(mrs.GetColumnCount()))
+	for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		col, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		if isNil, err := mrs.ColumnIsNull(r, i); err != nil {
+			return nil, err
+		} else if isNil {
+			data = pgAppendInt32(data, -1)
+			continue
+		}
+
+		var value string
+		switch col.ColumnType() {
+		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR,
+			defines.MYSQL_TYPE_LONGLONG:
+			if uint32(col.Flag())&defines.UNSIGNED_FLAG != 0 {
+				v, err := mrs.GetUint64(r, i)
+				if err != nil {
+					return nil, err
+				}
+				value = strconv.FormatUint(v, 10)
+			} else {
+				v, err := mrs.GetInt64(r, i)
+				if err != nil {
+					return nil, err
+				}
+				value = strconv.FormatInt(v, 10)
+			}
+		case defines.MYSQL_TYPE_FLOAT, defines.MYSQL_TYPE_DOUBLE:
+			v, err := mrs.GetFloat64(r, i)
+			if err != nil {
+				return nil, err
+			}
+			bitSize := 64
+			if col.ColumnType() == defines.MYSQL_TYPE_FLOAT {
+				bitSize = 32
+			}
+			value = strconv.FormatFloat(v, 'g', -1, bitSize)
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+			v, err := mrs.GetString(r, i)
+			if err != nil {
+				return nil, err
+			}
+			value = v
+		case defines.MYSQL_TYPE_DATE:
+			v, err := mrs.GetValue(r, i)
+			if err != nil {
+				return nil, err
+			}
+			value = v.(types.Date).String()
+		case defines.MYSQL_TYPE_DATETIME:
+			v, err := mrs.GetValue(r, i)
+			if err != nil {
+				return nil, err
+			}
+			value = v.(types.Datetime).String()
+		default:
+			return nil, fmt.Errorf("unsupported column type %d ", col.ColumnType())
+		}
+		data = append(pgAppendInt32(data, int32(len(value))), value...)
+	}
+	binary.BigEndian.PutUint32(data[start+1:], uint32(len(data)-start-1))
+	return data, nil
+}
+
+// sendDataRows sends the first cnt rows of the result set
+func (pp *PgProtocolImpl) sendDataRows(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+	var data []byte
+	var err error
+	for i := uint64(0); i < cnt; i++ {
+		if pp.result != nil {
+			data = nil
+		}
+		if data, err = pp.appendDataRow(data, mrs, i); err != nil {
+			return err
+		}
+		if pp.result != nil {
+			pp.result.rows = append(pp.result.rows, data)
+		}
+	}
+	pp.rows += cnt
+	if pp.result != nil {
+		return nil
+	}
+	return pp.tcpConn.WriteAndFlush(data)
+}
+
+/*
+pgPortalResult keeps the rows of a portal executed with a maximum number of
+the rows, the rows are sent by the Executes of the portal, and the completion
+is sent after the last row.
+*/
+type pgPortalResult struct {
+	rows [][]byte
+
+	//the CommandComplete of the statement without the rows
+	complete []byte
+
+	//the statement returns the rows, its CommandComplete counts the rows of the last Execute
+	selected bool
+}
+
+// sendPortalRows sends at most maxRows rows of the result, all the rows if maxRows is 0.
+// It sends PortalSuspended if some rows are left, or the completion otherwise.
+func (pp *PgProtocolImpl) sendPortalRows(result *pgPortalResult, maxRows int) error {
+	n := len(result.rows)
+	if maxRows > 0 && maxRows < n {
+		n = maxRows
+	}
+	if n > 0 {
+		var data []byte
+		for _, row := range result.rows[:n] {
+			data = append(data, row...)
+		}
+		result.rows = result.rows[n:]
+		if err := pp.tcpConn.WriteAndFlush(data); err != nil {
+			return err
+		}
+	}
+	if len(result.rows) > 0 {
+		return pp.sendMessage(pgMsgPortalSuspended)
+	}
+	if result.selected {
+		return pp.writeMessage(pgAppendString(pgNewMessage(pgMsgCommandComplete), fmt.Sprintf("SELECT %d", n)))
+	}
+	if result.complete != nil {
+		return pp.tcpConn.WriteAndFlush(result.complete)
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+	return pp.sendDataRows(mrs, cnt)
+}
+
+func (pp *PgProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	return pp.SendResultSetTextBatchRow(mrs, cnt)
+}
+
+// SendColumnCountPacket begins the result set
+func (pp *PgProtocolImpl) SendColumnCountPacket(_ uint64) error {
+	pp.columns = nil
+	pp.rows = 0
+	return nil
+}
+
+// SendColumnDefinitionPacket keeps the column, the columns are sent together
+func (pp *PgProtocolImpl) SendColumnDefinitionPacket(column Column, _ int) error {
+	col, ok := column.(*MysqlColumn)
+	if !ok {
+		return fmt.Errorf("sendColumn need MysqlColumn")
+	}
+	pp.columns = append(pp.columns, col)
+	return nil
+}
+
+// SendEOFPacketIf ends the columns of the result set
+func (pp *PgProtocolImpl) SendEOFPacketIf(_, _ uint16) error {
+	return pp.sendRowDescription(pp.columns)
+}
+
+// sendEOFOrOkPacket ends the rows of the result set
+func (pp *PgProtocolImpl) sendEOFOrOkPacket(_, _ uint16) error {
+	return pp.sendSelectComplete()
+}
+
+func (pp *PgProtocolImpl) sendOKPacket(affectedRows, _ uint64, _, _ uint16, _ string) error {
+	return pp.sendCommandComplete(affectedRows)
+}
+
+func (pp *PgProtocolImpl) SendResponse(resp *Response) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+
+	switch resp.category {
+	case OkResponse:
+		return pp.sendCommandComplete(resp.affectedRows)
+	case EoFResponse:
+		return nil
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			return pp.sendCommandComplete(0)
+		}
+		return pp.sendError(err)
+	case ResultResponse:
+		mer := resp.data.(*MysqlExecutionResult)
+		if mer == nil {
+			return pp.sendCommandComplete(0)
+		}
+		if mer.Mrs() == nil {
+			return pp.sendCommandComplete(mer.AffectedRows())
+		}
+		mrs := mer.Mrs()
+		columns := make([]*MysqlColumn, 0, mrs.GetColumnCount())
+		for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+			col, err := mrs.GetColumn(i)
+			if err != nil {
+				return err
+			}
+			columns = append(columns, col.(*MysqlColumn))
+		}
+		if err := pp.sendRowDescription(columns); err != nil {
+			return err
+		}
+		pp.rows = 0
+		if err := pp.sendDataRows(mrs, mrs.GetRowCount()); err != nil {
+			return err
+		}
+		return pp.sendSelectComplete()
+	default:
+		return fmt.Errorf("unsupported response:%d ", resp.category)
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPgProtocol returns the protocol which keeps the messages sent in the out
+func newTestPgProtocol(t *testing.T, ctrl *gomock.Controller, out *[][]byte) *PgProtocolImpl {
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().WriteAndFlush(gomock.Any()).DoAndReturn(func(msg interface{}) error {
+		*out = append(*out, msg.([]byte))
+		return nil
+	}).AnyTimes()
+	return NewPgProtocol(1, ioses, pu.SV)
+}
+
+// pgSplitMessages splits the data written into the messages, the rows are written together
+func pgSplitMessages(out [][]byte) [][]byte {
+	var msgs [][]byte
+	for _, data := range out {
+		for len(data) >= pgMessageHeaderSize {
+			n := int(binary.BigEndian.Uint32(data[1:])) + 1
+			msgs = append(msgs, data[:n])
+			data = data[n:]
+		}
+		if len(data) > 0 {
+			msgs = append(msgs, data)
+		}
+	}
+	return msgs
+}
+
+// pgMessageTypes returns the types of the messages sent
+func pgMessageTypes(out [][]byte) string {
+	var types []byte
+	for _, msg := range pgSplitMessages(out) {
+		types = append(types, msg[0])
+	}
+	return string(types)
+}
+
+func makePgStartupMessage(params ...string) *pgMessage {
+	data := pgAppendInt32(nil, int32(pgProtocolVersion))
+	for _, p := range params {
+		data = pgAppendString(data, p)
+	}
+	return &pgMessage{typ: pgMsgStartup, payload: append(data, 0)}
+}
+
+func Test_pgCodec(t *testing.T) {
+	_, decoder := NewPgCodec()
+	in := buf.NewByteBuf(1024)
+
+	//the startup message has no type
+	startup := pgAppendString(pgAppendInt32([]byte{0, 0, 0, 0}, int32(pgProtocolVersion)), "user")
+	binary.BigEndian.PutUint32(startup, uint32(len(startup)))
+	_, _ = in.Write(startup)
+	ok, msg, err := decoder.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, pgMsgStartup, msg.(*pgMessage).typ)
+	require.Equal(t, startup[4:], msg.(*pgMessage).payload)
+
+	//the message is not complete
+	query := pgFinishMessage(pgAppendString(pgNewMessage(pgMsgQuery), "select 1"))
+	_, _ = in.Write(query[:7])
+	ok, _, err = decoder.Decode(in)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, _ = in.Write(query[7:])
+	ok, msg, err = decoder.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, pgMsgQuery, msg.(*pgMessage).typ)
+	require.Equal(t, "select 1\x00", string(msg.(*pgMessage).payload))
+
+	//the length is less than the header
+	_, _ = in.Write([]byte{pgMsgSync, 0, 0, 0, 1})
+	_, _, err = decoder.Decode(in)
+	require.Error(t, err)
+}
+
+func Test_pgStartup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	//the ssl is refused
+	var out [][]byte
+	pp := newTestPgProtocol(t, ctrl, &out)
+	established, err := pp.handleStartup(&pgMessage{payload: pgAppendInt32(nil, int32(pgSSLRequestCode))})
+	require.NoError(t, err)
+	require.False(t, established)
+	require.Equal(t, [][]byte{{'N'}}, out)
+
+	//the password of root in md5
+	out = nil
+	root := pp.SV.GetRootname()
+	established, err = pp.handleStartup(makePgStartupMessage("user", root, "database", "db1"))
+	require.NoError(t, err)
+	require.False(t, established)
+	require.Equal(t, "R", pgMessageTypes(out))
+	require.Equal(t, pgAuthMD5Password, int32(binary.BigEndian.Uint32(out[0][5:])))
+	require.Equal(t, pp.salt, out[0][9:])
+	require.Equal(t, "db1", pp.GetDatabaseName())
+
+	out = nil
+	password := pgMD5Password(root, pp.SV.GetRootpassword(), pp.salt)
+	established, err = pp.handleStartup(&pgMessage{typ: pgMsgPassword, payload: pgAppendString(nil, password)})
+	require.NoError(t, err)
+	require.True(t, established)
+	require.Equal(t, "RSSSSSSSSKZ", pgMessageTypes(out))
+
+	//the wrong password
+	out = nil
+	pp = newTestPgProtocol(t, ctrl, &out)
+	_, err = pp.handleStartup(makePgStartupMessage("user", root))
+	require.NoError(t, err)
+	_, err = pp.handleStartup(&pgMessage{typ: pgMsgPassword, payload: pgAppendString(nil, "md5wrong")})
+	require.Error(t, err)
+	require.Equal(t, "RE", pgMessageTypes(out))
+	require.Contains(t, string(out[1]), pgStateInvalidPassword)
+
+	//the unknown user is asked for the password in cleartext
+	out = nil
+	pp = newTestPgProtocol(t, ctrl, &out)
+	_, err = pp.handleStartup(makePgStartupMessage("user", "nobody"))
+	require.NoError(t, err)
+	require.Equal(t, pgAuthCleartextPassword, int32(binary.BigEndian.Uint32(out[0][5:])))
+	_, err = pp.handleStartup(&pgMessage{typ: pgMsgPassword, payload: pgAppendString(nil, "")})
+	require.Error(t, err)
+
+	//the user is required
+	out = nil
+	pp = newTestPgProtocol(t, ctrl, &out)
+	_, err = pp.handleStartup(makePgStartupMessage())
+	require.Error(t, err)
+	require.Equal(t, "E", pgMessageTypes(out))
+}
+
+func Test_pgSendResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var out [][]byte
+	pp := newTestPgProtocol(t, ctrl, &out)
+	pp.beginStatement(&tree.Select{})
+	err := pp.SendResponse(NewResponse(ResultResponse, 0, int(COM_QUERY), makeMysqlLongResult(false)))
+	require.NoError(t, err)
+	require.Equal(t, "TDDDC", pgMessageTypes(out))
+	msgs := pgSplitMessages(out)
+	require.Equal(t, "-2147483648", string(msgs[1][11:]))
+	require.Equal(t, "SELECT 3\x00", string(msgs[4][5:]))
+
+	out = nil
+	pp.beginStatement(&tree.Insert{})
+	err = pp.SendResponse(NewOkResponse(2, 0, 0, 0, int(COM_QUERY), ""))
+	require.NoError(t, err)
+	require.Equal(t, "INSERT 0 2\x00", string(out[0][5:]))
+
+	out = nil
+	err = pp.SendResponse(NewGeneralErrorResponse(COM_QUERY, NewMysqlError(ER_NO_DB_ERROR)))
+	require.NoError(t, err)
+	require.Equal(t, "E", pgMessageTypes(out))
+	require.Contains(t, string(out[0]), "3D000")
+}
+
+func Test_pgPortalRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var out [][]byte
+	pp := newTestPgProtocol(t, ctrl, &out)
+	result := &pgPortalResult{}
+	pp.result = result
+	pp.beginStatement(&tree.Select{})
+	err := pp.SendResponse(NewResponse(ResultResponse, 0, int(COM_QUERY), makeMysqlLongResult(false)))
+	require.NoError(t, err)
+	pp.result = nil
+	// the rows are kept for the Executes of the portal
+	require.Equal(t, "T", pgMessageTypes(out))
+	require.Equal(t, 3, len(result.rows))
+
+	out = nil
+	require.NoError(t, pp.sendPortalRows(result, 2))
+	require.Equal(t, "DDs", pgMessageTypes(out))
+
+	out = nil
+	require.NoError(t, pp.sendPortalRows(result, 2))
+	require.Equal(t, "DC", pgMessageTypes(out))
+	msgs := pgSplitMessages(out)
+	require.Equal(t, "SELECT 1\x00", string(msgs[1][5:]))
+
+	out = nil
+	result = &pgPortalResult{}
+	pp.result = result
+	pp.beginStatement(&tree.Insert{})
+	err = pp.SendResponse(NewOkResponse(2, 0, 0, 0, int(COM_QUERY), ""))
+	require.NoError(t, err)
+	pp.result = nil
+	require.Empty(t, out)
+	require.NoError(t, pp.sendPortalRows(result, 10))
+	require.Equal(t, "INSERT 0 2\x00", string(out[0][5:]))
+}
+
+func Test_pgCommandTag(t *testing.T) {
+	require.Equal(t, "UPDATE 3", pgCommandTag(&tree.Update{}, 3))
+	require.Equal(t, "DELETE 0", pgCommandTag(&tree.Delete{}, 0))
+	require.Equal(t, "CREATE TABLE", pgCommandTag(&tree.CreateTable{}, 0))
+	require.Equal(t, "DROP DATABASE", pgCommandTag(&tree.DropDatabase{}, 0))
+	require.Equal(t, "", pgCommandTag(nil, 0))
+}
+
+func Test_pgErrorFields(t *testing.T) {
+	code, msg := pgErrorFields(newPgError(pgStateUndefinedCursor, "portal %q does not exist", "p1"))
+	require.Equal(t, pgStateUndefinedCursor, code)
+	require.Equal(t, `portal "p1" does not exist`, msg)
+
+	code, _ = pgErrorFields(NewMysqlError(ER_UNKNOWN_ERROR))
+	require.Equal(t, pgStateInternalError, code)
+
+	code, msg = pgErrorFields(errors.New("oops"))
+	require.Equal(t, pgStateInternalError, code)
+	require.Equal(t, "oops", msg)
+}
+
+func Test_pgReplaceParameters(t *testing.T) {
+	values := [][]byte{[]byte("12"), []byte("it's"), nil}
+	oids := []uint32{0, pgTypeInt4, 0}
+	f := func(i int) (string, error) {
+		return pgParameterConstant(values[i-1], oids[i-1]), nil
+	}
+
+	sql, cnt, err := pgReplaceParameters(`select $1, '$2', "$2", a$2 -- $3
+from t where b = $2 and c = $3 /* $1 */`, f)
+	require.NoError(t, err)
+	require.Equal(t, 3, cnt)
+	require.Equal(t, `select 12, '$2', "$2", a$2 -- $3
+from t where b = 'it''s' and c = NULL /* $1 */`, sql)
+
+	_, _, err = pgReplaceParameters("select $0", f)
+	require.Error(t, err)
+
+	require.Equal(t, "'12'", pgParameterConstant([]byte("12"), pgTypeVarchar))
+	require.Equal(t, "-1.5e3", pgParameterConstant([]byte("-1.5e3"), pgTypeFloat8))
+	require.Equal(t, "'nan'", pgParameterConstant([]byte("nan"), pgTypeFloat8))
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
+)
+
+/*
+PgRoutineManager manages the connections of the PostgreSQL protocol.
+The routines are kept in the RoutineManager of the MySQL protocol, so that
+SHOW PROCESSLIST and KILL see the connections of both protocols.
+*/
+type PgRoutineManager struct {
+	*RoutineManager
+}
+
+func NewPgRoutineManager(rm *RoutineManager) *PgRoutineManager {
+	return &PgRoutineManager{RoutineManager: rm}
+}
+
+func (prm *PgRoutineManager) Created(rs goetty.IOSession) {
+	defer func() {
+		if err := recover(); err != nil {
+			logutil.Errorf("create routine manager failed. err:%v", err)
+		}
+	}()
+	pro := NewPgProtocol(nextConnectionID(), rs, prm.pu.SV)
+	if prm.pu.ClusterCatalog != nil {
+		pro.accounts = prm.pu.ClusterCatalog
+	}
+	exe := NewPgCmdExecutor()
+	exe.SetRoutineManager(prm.RoutineManager)
+
+	routine := NewRoutine(pro, exe, prm.pu)
+	routine.SetRoutineMgr(prm.RoutineManager)
+
+	prm.rwlock.Lock()
+	defer prm.rwlock.Unlock()
+
+	prm.clients[rs] = routine
+	metric.ConnectionOpened()
+}
+
+func (prm *PgRoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
+	defer func() {
+		if err := recover(); err != nil {
+			logutil.Errorf("handle message failed. err:%v", err)
+		}
+	}()
+	if prm.pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() {
+		if !prm.pdHook.CanAcceptSomething() {
+			logutil.Errorf("The Heartbeat From PDLeader Is Timeout. The Server Go Offline.")
+			return errors.New("The Heartbeat From PDLeader Is Timeout. The Server Reject Connection.\n")
+		}
+	}
+
+	prm.rwlock.RLock()
+	routine, ok := prm.clients[rs]
+	prm.rwlock.RUnlock()
+	if !ok {
+		return errors.New("routine does not exist")
+	}
+
+	protocol := routine.protocol.(*PgProtocolImpl)
+
+	message, ok := msg.(*pgMessage)
+	if !ok {
+		return errors.New("message is not pgMessage")
+	}
+
+	// finish startup process
+	if !protocol.IsEstablished() {
+		if message.typ == pgMsgStartup && len(message.payload) == 12 &&
+			binary.BigEndian.Uint32(message.payload) == pgCancelRequestCode {
+			prm.cancelRequest(message.payload[4:])
+			return errors.New("the cancel request is done")
+		}
+		established, err := protocol.handleStartup(message)
+		if err != nil {
+			return err
+		}
+		if established {
+			protocol.SetEstablished()
+		}
+		return nil
+	}
+
+	if message.typ == pgMsgTerminate {
+		return errors.New("the client terminates the connection")
+	}
+
+	routine.requestChan <- &Request{
+		cmd:  int(message.typ),
+		data: message.payload,
+	}
+	return nil
+}
+
+/*
+cancelRequest kills the query running in the connection with the process id,
+if the secret key is the one sent to the connection.
+*/
+func (prm *PgRoutineManager) cancelRequest(key []byte) {
+	id := binary.BigEndian.Uint32(key)
+	secret := int32(binary.BigEndian.Uint32(key[4:]))
+	rt := prm.getRoutine(uint64(id))
+	if rt == nil {
+		return
+	}
+	if pro, ok := rt.protocol.(*PgProtocolImpl); ok && pro.secret == secret {
+		logutil.Infof("cancel the query of the connection %d", id)
+		rt.killQuery()
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	processCommandSleep = "Sleep"
+	processCommandQuery = "Query"
+
+	processStateInit      = "init"
+	processStateExecuting = "executing"
+)
+
+/*
+processInfo records the statement running in a routine.
+SHOW PROCESSLIST reads it and KILL cancels the statement through it.
+*/
+type processInfo struct {
+	sync.Mutex
+
+	//the command of the connection, Sleep when it is idle
+	command string
+
+	//the database when the statement began
+	db string
+
+	//the sql text of the statement
+	sql string
+
+	//the time when the command began
+	start time.Time
+
+	//the state of the statement
+	state string
+
+	//the computation running now
+	cw ComputationWrapper
+
+	//the statement has been killed by KILL QUERY or the timeout
+	killed bool
+
+	//the statement has been killed by max_execution_time
+	timedOut bool
+
+	//the timer of max_execution_time of the computation running now
+	timer *time.Timer
+}
+
+// processRow is a row of SHOW PROCESSLIST
+type processRow struct {
+	id      uint64
+	user    string
+	host    string
+	db      string
+	command string
+	time    int64
+	state   string
+	info    string
+}
+
+func newProcessInfo() *processInfo {
+	return &processInfo{
+		command: processCommandSleep,
+		start:   time.Now(),
+	}
+}
+
+// beginQuery marks the start of the query sql on the database db
+func (pi *processInfo) beginQuery(db, sql string) {
+	if pi == nil {
+		return
+	}
+	pi.Lock()
+	defer pi.Unlock()
+	pi.command = processCommandQuery
+	pi.db = db
+	pi.sql = sql
+	pi.start = time.Now()
+	pi.state = processStateInit
+	pi.killed = false
+	pi.timedOut = false
+}
+
+// endQuery marks the connection idle, db is the database in use
+func (pi *processInfo) endQuery(db string) {
+	if pi == nil {
+		return
+	}
+	pi.Lock()
+	defer pi.Unlock()
+	pi.command = processCommandSleep
+	pi.db = db
+	pi.sql = ""
+	pi.start = time.Now()
+	pi.state = ""
+	pi.cw = nil
+	if pi.timer != nil {
+		pi.timer.Stop()
+		pi.timer = nil
+	}
+}
+
+// startComputation records the computation which is going to run, an error
+// is returned if the query has been killed. The computation is cancelled
+// when it runs longer than timeout, 0 means no timeout.
+func (pi *processInfo) startComputation(cw ComputationWrapper, timeout time.Duration) error {
+	if pi == nil {
+		return nil
+	}
+	pi.Lock()
+	defer pi.Unlock()
+	if err := pi.interrupted(); err != nil {
+		return err
+	}
+	pi.cw = cw
+	pi.state = processStateExecuting
+	if timeout > 0 {
+		pi.timer = time.AfterFunc(timeout, func() {
+			pi.expire(cw)
+		})
+	}
+	return nil
+}
+
+// stopComputation clears the computation which has finished, an error is
+// returned if it has been killed.
+func (pi *processInfo) stopComputation() error {
+	if pi == nil {
+		return nil
+	}
+	pi.Lock()
+	defer pi.Unlock()
+	pi.cw = nil
+	pi.state = processStateInit
+	if pi.timer != nil {
+		pi.timer.Stop()
+		pi.timer = nil
+	}
+	return pi.interrupted()
+}
+
+// checkKilled returns an error if the query has been killed
+func (pi *processInfo) checkKilled() error {
+	if pi == nil {
+		return nil
+	}
+	pi.Lock()
+	defer pi.Unlock()
+	return pi.interrupted()
+}
+
+// interrupted returns the error of the query which has been killed
+func (pi *processInfo) interrupted() error {
+	if !pi.killed {
+		return nil
+	}
+	if pi.timedOut {
+		return NewMysqlError(ER_QUERY_TIMEOUT)
+	}
+	return NewMysqlError(ER_QUERY_INTERRUPTED)
+}
+
+// kill cancels the running computation of the query
+func (pi *processInfo) kill() {
+	pi.Lock()
+	defer pi.Unlock()
+	if pi.command != processCommandQuery {
+		return
+	}
+	pi.killed = true
+	if pi.cw != nil {
+		pi.cw.Cancel()
+	}
+}
+
+// expire cancels the computation cw which has reached max_execution_time
+func (pi *processInfo) expire(cw ComputationWrapper) {
+	pi.Lock()
+	defer pi.Unlock()
+	if pi.cw != cw || pi.killed {
+		return
+	}
+	pi.killed = true
+	pi.timedOut = true
+	cw.Cancel()
+}
+
+func (pi *processInfo) row(id uint64, user, host string) processRow {
+	pi.Lock()
+	defer pi.Unlock()
+	return processRow{
+		id:      id,
+		user:    user,
+		host:    host,
+		db:      pi.db,
+		command: pi.command,
+		time:    int64(time.Since(pi.start).Seconds()),
+		state:   pi.state,
+		info:    pi.sql,
+	}
+}
+
+// compileComputation compiles the computation. max_execution_time counts
+// from the start of the compiling, and KILL QUERY cancels the computation
+// from now on until runComputation is done.
+func (mce *MysqlCmdExecutor) compileComputation(cw ComputationWrapper) error {
+	ses := mce.GetSession()
+	if err := ses.process.startComputation(cw, ses.limits.timeout); err != nil {
+		return err
+	}
+	if err := cw.Compile(ses, getDataFromPipeline); err != nil {
+		if kerr := ses.process.stopComputation(); kerr != nil {
+			return kerr
+		}
+		return ses.limits.convertError(err)
+	}
+	return nil
+}
+
+// runComputation runs the computation compiled by compileComputation
+// which can be cancelled by KILL QUERY and max_execution_time.
+func (mce *MysqlCmdExecutor) runComputation(cw ComputationWrapper, ts uint64) error {
+	ses := mce.GetSession()
+	err := cw.Run(ts)
+	if kerr := ses.process.stopComputation(); kerr != nil {
+		return kerr
+	}
+	return ses.limits.convertError(err)
+}
+
+/*
+handle SHOW [FULL] PROCESSLIST
+the users which are not super users see their own connections only.
+*/
+func (mce *MysqlCmdExecutor) handleShowProcessList(sp *tree.ShowProcessList) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	name := proto.GetUserName()
+	all := mce.isSuperUser(name)
+
+	names := []string{"Id", "User", "Host", "db", "Command", "Time", "State", "Info"}
+	types := []uint8{defines.MYSQL_TYPE_LONGLONG, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_LONG,
+		defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VARCHAR}
+	for i := range names {
+		col := new(MysqlColumn)
+		col.SetColumnType(types[i])
+		col.SetName(names[i])
+		ses.Mrs.AddColumn(col)
+	}
+
+	for _, r := range mce.GetRoutineManager().processList() {
+		if !all && r.user != name {
+			continue
+		}
+		var db, state, info interface{}
+		if r.db != "" {
+			db = r.db
+		}
+		if r.state != "" {
+			state = r.state
+		}
+		if r.info != "" {
+			info = r.info
+			//the first 100 characters are shown without FULL
+			if !sp.Full {
+				info = SubStringFromBegin(r.info, 100)
+			}
+		}
+		ses.Mrs.AddRow([]interface{}{r.id, r.user, r.host, db, r.command, r.time, state, info})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle KILL [CONNECTION | QUERY] id
+the users which are not super users can kill their own connections only.
+*/
+func (mce *MysqlCmdExecutor) handleKill(k *tree.Kill) error {
+	name := mce.GetSession().GetMysqlProtocol().GetUserName()
+	rt := mce.GetRoutineManager().getRoutine(k.ConnectionId)
+	if rt == nil {
+		return NewMysqlError(ER_NO_SUCH_THREAD, k.ConnectionId)
+	}
+	if !mce.isSuperUser(name) && rt.protocol.GetUserName() != name {
+		return NewMysqlError(ER_KILL_DENIED_ERROR, k.ConnectionId)
+	}
+	if k.Query {
+		rt.killQuery()
+	} else {
+		rt.killConnection()
+	}
+	//like MySQL, nothing is sent to the connection which kills itself, it is closed
+	if mce.killsItself(k) {
+		return nil
+	}
+
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), nil)
+	if err := mce.GetSession().GetMysqlProtocol().SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// killsItself returns true if the KILL CONNECTION closes the connection of the executor
+func (mce *MysqlCmdExecutor) killsItself(k *tree.Kill) bool {
+	return !k.Query && k.ConnectionId == uint64(mce.GetSession().GetMysqlProtocol().ConnectionID())
+}
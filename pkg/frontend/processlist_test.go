@@ -0,0 +1,143 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_processInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cw := mock_frontend.NewMockComputationWrapper(ctrl)
+	cw.EXPECT().Cancel().Times(1)
+
+	pi := newProcessInfo()
+	//nothing to kill on an idle connection
+	pi.kill()
+	require.NoError(t, pi.checkKilled())
+
+	pi.beginQuery("db", "select 1")
+	require.NoError(t, pi.startComputation(cw, 0))
+	r := pi.row(1, "u1", "127.0.0.1:5000")
+	require.Equal(t, processCommandQuery, r.command)
+	require.Equal(t, processStateExecuting, r.state)
+	require.Equal(t, "select 1", r.info)
+
+	pi.kill()
+	require.Error(t, pi.checkKilled())
+	require.Error(t, pi.stopComputation())
+	require.Error(t, pi.startComputation(cw, 0))
+	pi.endQuery("db")
+	r = pi.row(1, "u1", "127.0.0.1:5000")
+	require.Equal(t, processCommandSleep, r.command)
+	require.Equal(t, "", r.info)
+
+	//the next query is not killed
+	pi.beginQuery("db", "select 2")
+	require.NoError(t, pi.checkKilled())
+
+	//the session without the routine
+	var nilInfo *processInfo
+	nilInfo.beginQuery("db", "select 1")
+	require.NoError(t, nilInfo.startComputation(cw, 0))
+	require.NoError(t, nilInfo.stopComputation())
+	nilInfo.endQuery("db")
+}
+
+func Test_processListAndKill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+
+	rm := &RoutineManager{clients: make(map[goetty.IOSession]*Routine), pu: pu}
+	newRoutine := func(id uint32, user string) (*Routine, *mock_frontend.MockIOSession) {
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().Flush().Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddr().Return("127.0.0.1:5000").AnyTimes()
+		proto := NewMysqlClientProtocol(id, ioses, 1024, pu.SV)
+		proto.username = user
+		rt := &Routine{protocol: proto, executor: NewMysqlCmdExecutor(), process: newProcessInfo()}
+		rm.clients[ioses] = rt
+		return rt, ioses
+	}
+	newExecutor := func(rt *Routine) *MysqlCmdExecutor {
+		ses := NewSession(rt.protocol, getPCI(), guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu), pu.Mempool, pu)
+		ses.Mrs = &MysqlResultSet{}
+		ses.process = rt.process
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+		mce.SetRoutineManager(rm)
+		return mce
+	}
+
+	rt1, _ := newRoutine(1, pu.SV.GetDumpuser())
+	rt2, ioses2 := newRoutine(2, "u1")
+	rt3, _ := newRoutine(3, "u2")
+	rt2.process.beginQuery("db", "select * from t")
+
+	//the super user sees all connections
+	mce := newExecutor(rt1)
+	require.NoError(t, mce.handleShowProcessList(&tree.ShowProcessList{Full: true}))
+	mrs := mce.GetSession().Mrs
+	require.Equal(t, uint64(3), mrs.GetRowCount())
+	row, err := mrs.GetRow(1)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(2), "u1", "127.0.0.1:5000", "db", processCommandQuery, int64(0), processStateInit, "select * from t"}, row)
+
+	//the other users see their own connections
+	mce2 := newExecutor(rt2)
+	require.NoError(t, mce2.handleShowProcessList(&tree.ShowProcessList{}))
+	require.Equal(t, uint64(1), mce2.GetSession().Mrs.GetRowCount())
+
+	require.Error(t, mce.handleKill(tree.NewKill(true, 4)))
+	require.Error(t, mce2.handleKill(tree.NewKill(true, 3)))
+
+	require.NoError(t, mce.handleKill(tree.NewKill(true, 2)))
+	require.Error(t, rt2.process.checkKilled())
+	require.NoError(t, rt3.process.checkKilled())
+
+	ioses2.EXPECT().Close().Return(nil).Times(1)
+	require.NoError(t, mce.handleKill(tree.NewKill(false, 2)))
+
+	//nothing is written to the connection which kills itself
+	ioses4 := mock_frontend.NewMockIOSession(ctrl)
+	ioses4.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses4.EXPECT().RemoteAddr().Return("127.0.0.1:5000").AnyTimes()
+	ioses4.EXPECT().Close().Return(nil).Times(1)
+	proto4 := NewMysqlClientProtocol(4, ioses4, 1024, pu.SV)
+	proto4.username = "u1"
+	rt4 := &Routine{protocol: proto4, executor: NewMysqlCmdExecutor(), process: newProcessInfo()}
+	rm.clients[ioses4] = rt4
+	mce4 := newExecutor(rt4)
+	rt4.process.beginQuery("db", "kill 4")
+	require.True(t, mce4.killsItself(tree.NewKill(false, 4)))
+	require.False(t, mce4.killsItself(tree.NewKill(true, 4)))
+	require.NoError(t, mce4.handleKill(tree.NewKill(false, 4)))
+	require.Error(t, rt4.process.checkKilled())
+}
@@ -0,0 +1,230 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"fmt"
+	"go/constant"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+)
+
+const (
+	varMaxExecutionTime = "max_execution_time"
+	varQueryMemoryLimit = "query_memory_limit"
+)
+
+/*
+sessionVariables keeps the variables set by SET in a connection.
+A variable which is not set takes the default of the account, or
+the default of the server if the account has none.
+*/
+type sessionVariables struct {
+	//milliseconds, 0 means no timeout
+	maxExecutionTime    int64
+	hasMaxExecutionTime bool
+
+	//bytes, 0 means the limit of the server
+	queryMemoryLimit    int64
+	hasQueryMemoryLimit bool
+
+	//LOAD DATA LOCAL INFILE is allowed, the server default if not set
+	localInfile    bool
+	hasLocalInfile bool
+}
+
+func newSessionVariables() *sessionVariables {
+	return &sessionVariables{}
+}
+
+// queryLimits is the limitation of the resources of a query
+type queryLimits struct {
+	//the computation is cancelled when it runs longer
+	timeout time.Duration
+
+	//the maximum memory of the query in bytes
+	memory int64
+
+	//the quota mmu accounting the memory of the query
+	quota *host.Mmu
+}
+
+// convertError converts the error of exceeding the memory quota to the mysql error
+func (ql *queryLimits) convertError(err error) error {
+	if err == nil || !errors.Is(err, mmu.ExceedQuota) {
+		return err
+	}
+	return NewMysqlError(ER_CAPACITY_EXCEEDED, ql.memory, varQueryMemoryLimit,
+		"The query has been aborted, increase query_memory_limit to run it.")
+}
+
+// userQueryLimits returns max_execution_time and query_memory_limit which
+// the administrator sets for the account, 0 means no quota.
+func (mce *MysqlCmdExecutor) userQueryLimits() (int64, int64) {
+	ses := mce.GetSession()
+	name := ses.GetMysqlProtocol().GetUserName()
+	if ses.Pu.ClusterCatalog == nil || mce.isSuperUser(name) {
+		return 0, 0
+	}
+	user, err := ses.Pu.ClusterCatalog.GetUser(name)
+	if err != nil {
+		return 0, 0
+	}
+	return user.MaxExecutionTime, user.QueryMemoryLimit
+}
+
+// queryLimitsDefaults returns max_execution_time and query_memory_limit
+// without SET: the defaults of the account, or the defaults of the server.
+func (mce *MysqlCmdExecutor) queryLimitsDefaults() (int64, int64) {
+	ses := mce.GetSession()
+	maxTime := ses.Pu.SV.GetMaxExecutionTime()
+	memory := ses.Pu.SV.GetQueryMemoryLimit()
+	userTime, userMemory := mce.userQueryLimits()
+	if userTime > 0 {
+		maxTime = userTime
+	}
+	if userMemory > 0 {
+		memory = userMemory
+	}
+	return maxTime, memory
+}
+
+// queryLimitsVariables returns max_execution_time and query_memory_limit of
+// the session. The values set by SET can't exceed the quota of the account,
+// 0 which means no limit is the quota of the account too.
+func (mce *MysqlCmdExecutor) queryLimitsVariables() (int64, int64) {
+	maxTime, memory := mce.queryLimitsDefaults()
+	if vars := mce.GetSession().vars; vars != nil {
+		if vars.hasMaxExecutionTime {
+			maxTime = vars.maxExecutionTime
+		}
+		if vars.hasQueryMemoryLimit {
+			memory = vars.queryMemoryLimit
+		}
+	}
+	userTime, userMemory := mce.userQueryLimits()
+	return clampQueryLimit(maxTime, userTime), clampQueryLimit(memory, userMemory)
+}
+
+// clampQueryLimit returns the value which is limited by quota, 0 means no
+// limit for both of them.
+func clampQueryLimit(value, quota int64) int64 {
+	if quota > 0 && (value <= 0 || value > quota) {
+		return quota
+	}
+	return value
+}
+
+/*
+prepareQueryLimits makes the limitation of the query.
+The memory of the query is taken from a quota mmu of the guest mmu of the
+session, which allows query_memory_limit bytes at most, or process.Limitation.Size
+of the server without query_memory_limit. The quota must be released when the
+query is done.
+*/
+func (mce *MysqlCmdExecutor) prepareQueryLimits() *host.Mmu {
+	ses := mce.GetSession()
+	maxTime, memory := mce.queryLimitsVariables()
+	if memory <= 0 {
+		memory = ses.Pu.SV.GetProcessLimitationSize()
+	}
+	ses.limits = queryLimits{
+		timeout: time.Duration(maxTime) * time.Millisecond,
+		memory:  memory,
+		quota:   host.NewQuota(memory, ses.GuestMmu.Mmu),
+	}
+	return ses.limits.quota
+}
+
+// isQueryLimitVariable returns true if name is max_execution_time or query_memory_limit
+func isQueryLimitVariable(name string) bool {
+	name = strings.ToLower(name)
+	return name == varMaxExecutionTime || name == varQueryMemoryLimit
+}
+
+/*
+setQueryLimitVariable handles SET [SESSION] max_execution_time | query_memory_limit = value.
+DEFAULT restores the default of the account.
+*/
+func (mce *MysqlCmdExecutor) setQueryLimitVariable(va *tree.VarAssignmentExpr) error {
+	name := strings.ToLower(va.Name)
+	if va.Global {
+		return NewMysqlError(ER_LOCAL_VARIABLE, name)
+	}
+	vars := mce.GetSession().vars
+	if vars == nil {
+		return nil
+	}
+
+	var value int64
+	isDefault := false
+	switch v := va.Value.(type) {
+	case *tree.DefaultVal:
+		isDefault = true
+	case *tree.NumVal:
+		if v.Value.Kind() != constant.Int {
+			return NewMysqlError(ER_WRONG_TYPE_FOR_VAR, name)
+		}
+		var ok bool
+		value, ok = constant.Int64Val(v.Value)
+		if !ok || value < 0 {
+			return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, name, tree.String(v, dialect.MYSQL))
+		}
+	default:
+		return NewMysqlError(ER_WRONG_TYPE_FOR_VAR, name)
+	}
+
+	switch name {
+	case varMaxExecutionTime:
+		vars.maxExecutionTime, vars.hasMaxExecutionTime = value, !isDefault
+	case varQueryMemoryLimit:
+		vars.queryMemoryLimit, vars.hasQueryMemoryLimit = value, !isDefault
+	}
+	return nil
+}
+
+/*
+handle "SELECT @@max_execution_time" and "SELECT @@query_memory_limit"
+*/
+func (mce *MysqlCmdExecutor) handleSelectQueryLimit(name string) error {
+	ses := mce.GetSession()
+	name = strings.ToLower(name)
+
+	col := new(MysqlColumn)
+	col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col.SetName("@@" + name)
+	ses.Mrs.AddColumn(col)
+
+	maxTime, memory := mce.queryLimitsVariables()
+	if name == varMaxExecutionTime {
+		ses.Mrs.AddRow([]interface{}{maxTime})
+	} else {
+		ses.Mrs.AddRow([]interface{}{memory})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := ses.protocol.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
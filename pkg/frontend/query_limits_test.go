@@ -0,0 +1,160 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_queryLimitVariables(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().Flush().Return(nil).AnyTimes()
+	proto := NewMysqlClientProtocol(1, ioses, 1024, pu.SV)
+
+	ses := NewSession(proto, getPCI(), guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu), pu.Mempool, pu)
+	ses.Mrs = &MysqlResultSet{}
+	ses.vars = newSessionVariables()
+	mce := NewMysqlCmdExecutor()
+	mce.PrepareSessionBeforeExecRequest(ses)
+
+	set := func(sql string) error {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		return mce.handleSetVar(stmt.(*tree.SetVar))
+	}
+
+	maxTime, memory := mce.queryLimitsVariables()
+	require.Equal(t, pu.SV.GetMaxExecutionTime(), maxTime)
+	require.Equal(t, pu.SV.GetQueryMemoryLimit(), memory)
+
+	require.NoError(t, set("set max_execution_time = 1000, session query_memory_limit = 1048576"))
+	maxTime, memory = mce.queryLimitsVariables()
+	require.Equal(t, int64(1000), maxTime)
+	require.Equal(t, int64(1048576), memory)
+
+	require.Error(t, set("set global max_execution_time = 1000"))
+	require.Error(t, set("set max_execution_time = 'a'"))
+	require.Error(t, set("set query_memory_limit = 1.5"))
+
+	require.NoError(t, mce.handleSelectQueryLimit("MAX_EXECUTION_TIME"))
+	row, err := ses.Mrs.GetRow(0)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1000)}, row)
+
+	//the statement takes its memory from the quota
+	quota := mce.prepareQueryLimits()
+	require.Equal(t, time.Second, ses.limits.timeout)
+	require.NoError(t, quota.Alloc(1<<19))
+	require.Equal(t, mmu.ExceedQuota, quota.Alloc(1<<20))
+	quota.Free(1 << 19)
+	require.Equal(t, int64(1<<19), quota.Peak())
+	//the peak of the next statement starts over
+	quota.ResetPeak()
+	require.Equal(t, int64(0), quota.Peak())
+	merr, ok := ses.limits.convertError(mmu.ExceedQuota).(*MysqlError)
+	require.True(t, ok)
+	require.Equal(t, ER_CAPACITY_EXCEEDED, merr.ErrorCode)
+	quota.Release()
+	require.Equal(t, int64(0), quota.Size())
+
+	require.NoError(t, set("set max_execution_time = default"))
+	maxTime, _ = mce.queryLimitsVariables()
+	require.Equal(t, pu.SV.GetMaxExecutionTime(), maxTime)
+}
+
+func Test_processInfoTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cw := mock_frontend.NewMockComputationWrapper(ctrl)
+	cw.EXPECT().Cancel().Times(1)
+
+	pi := newProcessInfo()
+	pi.beginQuery("db", "select 1")
+	require.NoError(t, pi.startComputation(cw, 10*time.Millisecond))
+	require.Eventually(t, func() bool {
+		return pi.checkKilled() != nil
+	}, time.Second, time.Millisecond)
+	err := pi.stopComputation()
+	merr, ok := err.(*MysqlError)
+	require.True(t, ok)
+	require.Equal(t, ER_QUERY_TIMEOUT, merr.ErrorCode)
+	pi.endQuery("db")
+
+	//the timer is stopped with the computation
+	pi.beginQuery("db", "select 2")
+	require.NoError(t, pi.startComputation(cw, time.Hour))
+	require.NoError(t, pi.stopComputation())
+	pi.endQuery("db")
+}
+
+func Test_quotaFreeNotCharged(t *testing.T) {
+	parent := host.New(1 << 20)
+	quota := host.NewQuota(1<<20, parent)
+	sender := mheap.New(guest.New(1<<20, quota))
+	receiver := mheap.New(guest.New(1<<20, quota))
+
+	data, err := mheap.Alloc(sender, 1024)
+	require.NoError(t, err)
+	size := int64(cap(data))
+	//the heap of the receiving scope has never charged the data
+	mheap.Free(receiver, data)
+	require.Equal(t, int64(0), mheap.Size(receiver))
+	require.Equal(t, size, quota.Size())
+	mheap.Free(sender, data)
+	require.Equal(t, int64(0), mheap.Size(sender))
+	require.Equal(t, int64(0), quota.Size())
+	require.Equal(t, int64(0), parent.Size())
+
+	//the data freed after the statement is done
+	data, err = mheap.Alloc(sender, 1024)
+	require.NoError(t, err)
+	require.Equal(t, size, parent.Size())
+	quota.Release()
+	mheap.Free(sender, data)
+	require.Equal(t, int64(0), quota.Size())
+	require.Equal(t, int64(0), parent.Size())
+}
+
+func Test_clampQueryLimit(t *testing.T) {
+	//no quota of the account
+	require.Equal(t, int64(0), clampQueryLimit(0, 0))
+	require.Equal(t, int64(100), clampQueryLimit(100, 0))
+	//the session can lower the quota, but not raise it
+	require.Equal(t, int64(50), clampQueryLimit(50, 100))
+	require.Equal(t, int64(100), clampQueryLimit(200, 100))
+	require.Equal(t, int64(100), clampQueryLimit(0, 100))
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+/*
+handle ADMIN ROTATE KEY statement, a new master key is appended to the key
+file of this node and the data keys are wrapped by it. The data is not
+encrypted again. Only the super user can rotate the key.
+*/
+func (mce *MysqlCmdExecutor) handleRotateKey() error {
+	if err := mce.checkSuperUser("ADMIN ROTATE KEY"); err != nil {
+		return err
+	}
+	ses := mce.GetSession()
+	rotator, ok := ses.Pu.StorageEngine.(engine.KeyRotator)
+	if !ok {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "ADMIN ROTATE KEY for the storage engine")
+	}
+	id, err := rotator.RotateKey()
+	if err != nil {
+		return err
+	}
+	logutil.Infof("master key rotated, the current key is %d", id)
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), nil)
+	if err = ses.GetMysqlProtocol().SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
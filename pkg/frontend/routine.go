@@ -46,6 +46,12 @@ type Routine struct {
 	onceCloseNotifyChan    sync.Once
 
 	routineMgr *RoutineManager
+
+	//the statement running in the routine
+	process *processInfo
+
+	//the variables of the connection
+	vars *sessionVariables
 }
 
 func (routine *Routine) GetClientProtocol() Protocol {
@@ -93,6 +99,8 @@ func (routine *Routine) Loop() {
 		mgr := routine.GetRoutineMgr()
 
 		ses := NewSession(routine.protocol,mgr.getEpochgc(),routine.guestMmu,routine.mempool,mgr.getParameterUnit())
+		ses.process = routine.process
+		ses.vars = routine.vars
 
 		routine.executor.PrepareSessionBeforeExecRequest(ses)
 
@@ -128,6 +136,24 @@ func (routine *Routine) Quit() {
 	}
 }
 
+/*
+KILL QUERY cancels the statement running in the routine
+ */
+func (routine *Routine) killQuery() {
+	routine.process.kill()
+}
+
+/*
+KILL CONNECTION cancels the statement and closes the connection
+ */
+func (routine *Routine) killConnection() {
+	routine.killQuery()
+	routine.notifyClose()
+	if routine.protocol != nil {
+		routine.protocol.Quit()
+	}
+}
+
 /*
 notify routine to quit
  */
@@ -145,6 +171,8 @@ func NewRoutine(protocol MysqlProtocol, executor CmdExecutor,pu *config.Paramete
 		notifyChan:  make(chan interface{}),
 		guestMmu:    guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu),
 		mempool:     pu.Mempool,
+		process:     newProcessInfo(),
+		vars:        newSessionVariables(),
 	}
 
 	//async process request
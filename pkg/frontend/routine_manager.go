@@ -19,6 +19,8 @@ import (
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
+	"sort"
 	"sync"
 )
 
@@ -30,6 +32,9 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//the summary of the statements by digest
+	digests *digestSummary
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
@@ -47,6 +52,9 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 		}
 	}()
 	pro := NewMysqlClientProtocol(nextConnectionID(),rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()),rm.pu.SV)
+	if rm.pu.ClusterCatalog != nil {
+		pro.accounts = rm.pu.ClusterCatalog
+	}
 	exe := NewMysqlCmdExecutor()
 	exe.SetRoutineManager(rm)
 
@@ -63,6 +71,7 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	defer rm.rwlock.Unlock()
 
 	rm.clients[rs] = routine
+	metric.ConnectionOpened()
 }
 
 /*
@@ -82,30 +91,41 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 	if !ok {
 		return
 	}
+	metric.ConnectionClosed()
 	logutil.Infof("will close iosession")
 	rt.Quit()
 }
 
 
 /*
-KILL statement
+getRoutine returns the routine of the connection id
  */
-func (rm *RoutineManager) killStatement(id uint64) error {
-	rm.rwlock.Lock()
-	defer rm.rwlock.Unlock()
-	var rt *Routine = nil
+func (rm *RoutineManager) getRoutine(id uint64) *Routine {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
 	for _, value := range rm.clients {
 		if uint64(value.getConnID()) == id {
-			rt = value
-			break
+			return value
 		}
 	}
+	return nil
+}
 
-	if rt != nil {
-		logutil.Infof("will close the statement %d",id)
-		rt.notifyClose()
+/*
+processList returns the statements running in all routines, ordered by the connection id
+ */
+func (rm *RoutineManager) processList() []processRow {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+	rows := make([]processRow, 0, len(rm.clients))
+	for _, rt := range rm.clients {
+		host, port := rt.protocol.Peer()
+		rows = append(rows, rt.process.row(uint64(rt.getConnID()), rt.protocol.GetUserName(), host+":"+port))
 	}
-	return nil
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].id < rows[j].id
+	})
+	return rows
 }
 
 func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
@@ -172,6 +192,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 		return nil
 	}
 
+	//the content of the file for LOAD DATA LOCAL INFILE
+	if protocol.dispatchLocalInfile(payload) {
+		return nil
+	}
+
 	req := routine.protocol.GetRequest(payload)
 	routine.requestChan <- req
 
@@ -184,6 +209,8 @@ func NewRoutineManager(pu *config.ParameterUnit, pdHook *PDCallbackImpl) *Routin
 
 		pdHook: pdHook,
 		pu:     pu,
+
+		digests: newDigestSummary(pu.SV.GetMaxStatementDigests()),
 	}
 	return rm
 }
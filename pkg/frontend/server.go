@@ -31,6 +31,11 @@ var initConnectionID uint32 = 1000
 type MOServer struct {
 	addr string
 	app  goetty.NetApplication
+	rm   *RoutineManager
+
+	//the listener of the PostgreSQL protocol, nil if it is disabled
+	pgAddr string
+	pgApp  goetty.NetApplication
 }
 
 func (mo *MOServer) Start() error {
@@ -41,19 +46,50 @@ func (mo *MOServer) Start() error {
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("Server Listening on : %s \n", mo.addr)
+	if mo.pgApp != nil {
+		fmt.Printf("PostgreSQL Protocol Listening on : %s \n", mo.pgAddr)
+	}
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
-	return mo.app.Start()
+	if err := mo.app.Start(); err != nil {
+		return err
+	}
+	if mo.pgApp != nil {
+		return mo.pgApp.Start()
+	}
+	return nil
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pgApp != nil {
+		if err := mo.pgApp.Stop(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Stop()
 }
 
+// EnablePostgres adds the listener of the PostgreSQL protocol at the addr
+func (mo *MOServer) EnablePostgres(addr string) {
+	encoder, decoder := NewPgCodec()
+	prm := NewPgRoutineManager(mo.rm)
+	app, err := goetty.NewTCPApplication(addr, prm.Handler,
+		goetty.WithAppSessionOptions(
+			goetty.WithCodec(encoder, decoder),
+			goetty.WithLogger(logutil.GetGlobalLogger()),
+			goetty.WithBufSize(1024*1024, 1024*1024)),
+		goetty.WithAppSessionAware(prm))
+	if err != nil {
+		log.Panicf("start postgresql server failed with %+v", err)
+	}
+	mo.pgAddr = addr
+	mo.pgApp = app
+}
+
 func nextConnectionID() uint32 {
 	return atomic.AddUint32(&initConnectionID, 1)
 }
@@ -75,5 +111,6 @@ func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl)
 	return &MOServer{
 		addr: addr,
 		app:  app,
+		rm:   rm,
 	}
 }
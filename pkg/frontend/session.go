@@ -44,6 +44,20 @@ type Session struct {
 	ep *tree.ExportParam
 
 	closeRef *CloseExportData
+
+	pqExport *parquetExport
+
+	//the statement running in the routine, for SHOW PROCESSLIST and KILL
+	process *processInfo
+
+	//the variables of the connection set by SET
+	vars *sessionVariables
+
+	//max_execution_time and query_memory_limit of the query
+	limits queryLimits
+
+	//the rows sent to the client by the pipelines, for the slow query log
+	sentRows int64
 }
 
 func NewSession(proto Protocol,pdHook *PDCallbackImpl,
@@ -54,11 +68,15 @@ func NewSession(proto Protocol,pdHook *PDCallbackImpl,
 		GuestMmu: gm,
 		Mempool: mp,
 		Pu: PU,
-		ep: &tree.ExportParam{
-			Outfile: false,
-			Fields: &tree.Fields{},
-			Lines: &tree.Lines{},
-		},
+		ep: newDefaultExportParam(),
+	}
+}
+
+func newDefaultExportParam() *tree.ExportParam {
+	return &tree.ExportParam{
+		Outfile: false,
+		Fields: &tree.Fields{},
+		Lines: &tree.Lines{},
 	}
 }
 
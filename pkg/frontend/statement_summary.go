@@ -0,0 +1,168 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/infoschema"
+	"go.uber.org/zap"
+)
+
+// statementStat is the statistic of a statement which has finished
+type statementStat struct {
+	//the database in use when the statement began
+	db string
+
+	//the statement whose literals are replaced with '?' and its hash
+	digestText string
+	digest     string
+
+	//the fingerprint of the plan, empty if the statement is not compiled
+	plan string
+
+	latency time.Duration
+	err     error
+
+	rowsExamined int64
+	rowsSent     int64
+
+	//the peak memory of the query
+	memory int64
+}
+
+// digestKey identifies a row of the digest summary
+type digestKey struct {
+	db     string
+	digest string
+}
+
+/*
+digestSummary aggregates the statements by the database and the digest. When it
+has max digests, the statements of the new digests are added to the row whose
+digest is empty.
+*/
+type digestSummary struct {
+	sync.Mutex
+
+	max  int
+	rows map[digestKey]*infoschema.Digest
+}
+
+func newDigestSummary(max int64) *digestSummary {
+	return &digestSummary{
+		max:  int(max),
+		rows: make(map[digestKey]*infoschema.Digest),
+	}
+}
+
+// statementDigest returns the normalized text of the statement and its hash
+func statementDigest(stmt tree.Statement) (string, string) {
+	text := tree.Normalize(stmt, dialect.MYSQL)
+	sum := sha256.Sum256([]byte(text))
+	return text, hex.EncodeToString(sum[:])
+}
+
+// record adds the statement st which finished at the time now
+func (ds *digestSummary) record(st *statementStat, now time.Time) {
+	ds.Lock()
+	defer ds.Unlock()
+	key := digestKey{db: st.db, digest: st.digest}
+	row, ok := ds.rows[key]
+	if !ok {
+		if len(ds.rows) >= ds.max {
+			key = digestKey{}
+			row, ok = ds.rows[key]
+		}
+		if !ok {
+			row = &infoschema.Digest{FirstSeen: now}
+			if key.digest != "" {
+				row.Schema, row.Digest, row.DigestText = st.db, st.digest, st.digestText
+			}
+			ds.rows[key] = row
+		}
+	}
+	latency := st.latency.Microseconds()
+	row.Count++
+	if st.err != nil {
+		row.Errors++
+	}
+	row.SumLatency += latency
+	if latency > row.MaxLatency {
+		row.MaxLatency = latency
+	}
+	row.RowsExamined += st.rowsExamined
+	row.RowsSent += st.rowsSent
+	if st.memory > row.MaxMemory {
+		row.MaxMemory = st.memory
+	}
+	if st.plan != "" {
+		row.PlanDigest = st.plan
+	}
+	row.LastSeen = now
+}
+
+// list returns the rows of the summary, the slowest digest is the first one
+func (ds *digestSummary) list() []infoschema.Digest {
+	ds.Lock()
+	defer ds.Unlock()
+	rows := make([]infoschema.Digest, 0, len(ds.rows))
+	for _, row := range ds.rows {
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].SumLatency != rows[j].SumLatency {
+			return rows[i].SumLatency > rows[j].SumLatency
+		}
+		return rows[i].Digest < rows[j].Digest
+	})
+	return rows
+}
+
+// recordStatement adds the statement to the digest summary and writes it to
+// the slow query log if it runs longer than longQueryTime.
+func (mce *MysqlCmdExecutor) recordStatement(st *statementStat) {
+	ses := mce.GetSession()
+	if rm := mce.GetRoutineManager(); rm != nil && rm.digests != nil {
+		rm.digests.record(st, time.Now())
+	}
+	if !ses.Pu.SV.GetSlowQueryLog() || st.latency < time.Duration(ses.Pu.SV.GetLongQueryTime())*time.Millisecond {
+		return
+	}
+	proto := ses.GetMysqlProtocol()
+	fields := []zap.Field{
+		zap.Uint32("connection_id", proto.ConnectionID()),
+		zap.String("user", proto.GetUserName()),
+		zap.String("db", st.db),
+		zap.Duration("query_time", st.latency),
+		zap.Int64("rows_examined", st.rowsExamined),
+		zap.Int64("rows_sent", st.rowsSent),
+		zap.Int64("peak_memory", st.memory),
+		zap.String("digest", st.digest),
+		zap.String("digest_text", SubStringFromBegin(st.digestText, int(ses.Pu.SV.GetLengthOfQueryPrinted()))),
+		zap.String("plan_digest", st.plan),
+	}
+	if st.err != nil {
+		fields = append(fields, zap.Error(st.err))
+	}
+	logutil.Warn("slow query", fields...)
+}
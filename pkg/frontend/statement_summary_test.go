@@ -0,0 +1,74 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_statementDigest(t *testing.T) {
+	digest := func(sql string) (string, string) {
+		stmts, err := parsers.Parse(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		return statementDigest(stmts[0])
+	}
+	text1, d1 := digest("select a from t where b = 1 and c in (1, 2)")
+	text2, d2 := digest("select a from t where b = 20 and c in (3, 4, 5)")
+	_, d3 := digest("select a from t where c = 1")
+	require.Equal(t, "select a from t where b = ? and c in (...)", text1)
+	require.Equal(t, text1, text2)
+	require.Equal(t, d1, d2)
+	require.NotEqual(t, d1, d3)
+}
+
+func Test_digestSummary(t *testing.T) {
+	ds := newDigestSummary(2)
+	now := time.Now()
+	ds.record(&statementStat{db: "db", digest: "d1", digestText: "select ?", latency: 10 * time.Millisecond, rowsSent: 1, memory: 100}, now)
+	ds.record(&statementStat{db: "db", digest: "d1", digestText: "select ?", latency: 30 * time.Millisecond, rowsSent: 1, memory: 50,
+		err: errors.New("failed")}, now.Add(time.Second))
+	ds.record(&statementStat{db: "db", digest: "d2", digestText: "select a from t", latency: time.Millisecond, rowsExamined: 5, plan: "p"}, now)
+	//the summary is full
+	ds.record(&statementStat{db: "db", digest: "d3", latency: time.Millisecond}, now)
+	ds.record(&statementStat{db: "db1", digest: "d1", latency: time.Millisecond}, now)
+
+	rows := ds.list()
+	require.Equal(t, 3, len(rows))
+
+	require.Equal(t, "d1", rows[0].Digest)
+	require.Equal(t, int64(2), rows[0].Count)
+	require.Equal(t, int64(1), rows[0].Errors)
+	require.Equal(t, int64(40000), rows[0].SumLatency)
+	require.Equal(t, int64(30000), rows[0].MaxLatency)
+	require.Equal(t, int64(2), rows[0].RowsSent)
+	require.Equal(t, int64(100), rows[0].MaxMemory)
+	require.Equal(t, now, rows[0].FirstSeen)
+	require.Equal(t, now.Add(time.Second), rows[0].LastSeen)
+
+	//the statements of the other digests
+	require.Equal(t, "", rows[1].Digest)
+	require.Equal(t, "", rows[1].Schema)
+	require.Equal(t, int64(2), rows[1].Count)
+
+	require.Equal(t, "d2", rows[2].Digest)
+	require.Equal(t, int64(5), rows[2].RowsExamined)
+	require.Equal(t, "p", rows[2].PlanDigest)
+}
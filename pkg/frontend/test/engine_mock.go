@@ -648,6 +648,21 @@ func (mr *MockSparseFilterMockRecorder) Eq(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eq", reflect.TypeOf((*MockSparseFilter)(nil).Eq), arg0, arg1)
 }
 
+// Eval mocks base method.
+func (m *MockSparseFilter) Eval(arg0 *engine.Predicate) (engine.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eval", arg0)
+	ret0, _ := ret[0].(engine.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eval indicates an expected call of Eval.
+func (mr *MockSparseFilterMockRecorder) Eval(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eval", reflect.TypeOf((*MockSparseFilter)(nil).Eval), arg0)
+}
+
 // Ge mocks base method.
 func (m *MockSparseFilter) Ge(arg0 string, arg1 interface{}) (engine.Reader, error) {
 	m.ctrl.T.Helper()
@@ -803,6 +818,20 @@ func (mr *MockDatabaseMockRecorder) Relations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockDatabase)(nil).Relations))
 }
 
+// Rename mocks base method.
+func (m *MockDatabase) Rename(arg0 uint64, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockDatabaseMockRecorder) Rename(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockDatabase)(nil).Rename), arg0, arg1, arg2)
+}
+
 // MockEngine is a mock of Engine interface.
 type MockEngine struct {
 	ctrl     *gomock.Controller
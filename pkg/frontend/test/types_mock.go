@@ -40,6 +40,18 @@ func (m *MockComputationWrapper) EXPECT() *MockComputationWrapperMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockComputationWrapper) Cancel() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Cancel")
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockComputationWrapperMockRecorder) Cancel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockComputationWrapper)(nil).Cancel))
+}
+
 // Compile mocks base method.
 func (m *MockComputationWrapper) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) error {
 	m.ctrl.T.Helper()
@@ -68,6 +80,20 @@ func (mr *MockComputationWrapperMockRecorder) GetAffectedRows() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffectedRows", reflect.TypeOf((*MockComputationWrapper)(nil).GetAffectedRows))
 }
 
+// GetPlanDigest mocks base method.
+func (m *MockComputationWrapper) GetPlanDigest() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlanDigest")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPlanDigest indicates an expected call of GetPlanDigest.
+func (mr *MockComputationWrapperMockRecorder) GetPlanDigest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanDigest", reflect.TypeOf((*MockComputationWrapper)(nil).GetPlanDigest))
+}
+
 // GetAst mocks base method.
 func (m *MockComputationWrapper) GetAst() tree.Statement {
 	m.ctrl.T.Helper()
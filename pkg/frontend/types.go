@@ -19,4 +19,10 @@ type ComputationWrapper interface {
 		fill func(interface{}, *batch.Batch) error) error
 
 	Run(ts uint64) error
+
+	//GetPlanDigest returns the fingerprint of the compiled plan
+	GetPlanDigest() string
+
+	//Cancel stops the running computation
+	Cancel()
 }
\ No newline at end of file
@@ -0,0 +1,95 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "mo"
+
+	// Path is the path of the metrics of the http server
+	Path = "/metrics"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+)
+
+// MustRegister registers the collectors whose values are read from the components when
+// the metrics are scraped, e.g. the memory and the shards of the node.
+func MustRegister(cs ...prometheus.Collector) {
+	registry.MustRegister(cs...)
+}
+
+func init() {
+	registry.MustRegister(prometheus.NewGoCollector())
+	registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+
+	registry.MustRegister(connectionGauge)
+	registry.MustRegister(connectionCounter)
+	registry.MustRegister(statementCounter)
+	registry.MustRegister(scanRowsCounter)
+	registry.MustRegister(scanBytesCounter)
+	registry.MustRegister(bufferPinCounter)
+	registry.MustRegister(bufferEvictCounter)
+	registry.MustRegister(flushCounter)
+	registry.MustRegister(mergeCounter)
+	registry.MustRegister(expiredSegmentCounter)
+	registry.MustRegister(expiredRowsCounter)
+	registry.MustRegister(expiredBytesCounter)
+
+	registry.MustRegister(statementDurationHistogram)
+	registry.MustRegister(walSyncDurationHistogram)
+}
+
+// Handler returns the http handler which exports the metrics in the prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Server is the http server of the metrics
+type Server struct {
+	ln  net.Listener
+	srv *http.Server
+}
+
+// Serve starts a http server which serves the metrics at addr with the path Path
+func Serve(addr string) (*Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler())
+	s := &Server{ln: ln, srv: &http.Server{Handler: mux}}
+	go s.srv.Serve(ln)
+	return s, nil
+}
+
+// Addr returns the address which the server listens on
+func (s *Server) Addr() string {
+	return s.ln.Addr().String()
+}
+
+// Close stops the server
+func (s *Server) Close() error {
+	return s.srv.Close()
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	connectionCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "frontend",
+			Name:      "connections_total",
+			Help:      "Total number of accepted connections.",
+		})
+
+	statementCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "sql",
+			Name:      "statements_total",
+			Help:      "Total number of statements by type and status.",
+		}, []string{"type", "status"})
+
+	scanRowsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "sql",
+			Name:      "scan_rows_total",
+			Help:      "Total number of rows read from the storage engine.",
+		})
+
+	scanBytesCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "sql",
+			Name:      "scan_bytes_total",
+			Help:      "Total bytes read from the storage engine.",
+		})
+
+	bufferPinCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "buffer_pin_total",
+			Help:      "Total number of buffer manager pins by evict policy, a miss loads the node.",
+		}, []string{"policy", "result"})
+
+	bufferEvictCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "buffer_evict_total",
+			Help:      "Total number of nodes evicted by the buffer manager by evict policy.",
+		}, []string{"policy"})
+
+	flushCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "flush_total",
+			Help:      "Total number of flushed blocks by type.",
+		}, []string{"type"})
+
+	mergeCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "merge_total",
+			Help:      "Total number of blocks merged into segments.",
+		})
+
+	expiredSegmentCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "ttl_expired_segments_total",
+			Help:      "Total number of segments dropped by the TTL of their tables.",
+		})
+
+	expiredRowsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "ttl_expired_rows_total",
+			Help:      "Total number of rows of the segments dropped by the TTL of their tables.",
+		})
+
+	expiredBytesCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "ttl_expired_bytes_total",
+			Help:      "Total bytes of the segments dropped by the TTL of their tables.",
+		})
+)
+
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+
+	FlushMemBlock       = "memblock"
+	FlushTransientBlock = "transient_block"
+)
+
+// Scanned adds the rows and the bytes read from the storage engine
+func Scanned(rows, bytes int64) {
+	scanRowsCounter.Add(float64(rows))
+	scanBytesCounter.Add(float64(bytes))
+}
+
+// BufferPinned counts a pin of the buffer manager with the evict policy,
+// hit is false if the node is loaded
+func BufferPinned(policy string, hit bool) {
+	if hit {
+		bufferPinCounter.WithLabelValues(policy, "hit").Inc()
+	} else {
+		bufferPinCounter.WithLabelValues(policy, "miss").Inc()
+	}
+}
+
+// BufferEvicted counts a node evicted by the buffer manager with the evict policy
+func BufferEvicted(policy string) {
+	bufferEvictCounter.WithLabelValues(policy).Inc()
+}
+
+// Flushed counts a flushed block of the type typ
+func Flushed(typ string) {
+	flushCounter.WithLabelValues(typ).Inc()
+}
+
+// Merged counts a segment which blocks are merged into
+func Merged() {
+	mergeCounter.Inc()
+}
+
+// Expired counts a segment dropped by the TTL of its table with its rows
+// and bytes
+func Expired(rows, bytes int64) {
+	expiredSegmentCounter.Inc()
+	expiredRowsCounter.Add(float64(rows))
+	expiredBytesCounter.Add(float64(bytes))
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	connectionGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "frontend",
+			Name:      "connections",
+			Help:      "Number of open connections.",
+		})
+)
+
+// ConnectionOpened counts a new connection
+func ConnectionOpened() {
+	connectionCounter.Inc()
+	connectionGauge.Inc()
+}
+
+// ConnectionClosed counts a closed connection
+func ConnectionClosed() {
+	connectionGauge.Dec()
+}
+
+// NewGaugeFunc returns a gauge whose value is read by fn when the metrics are scraped,
+// it needs to be registered by MustRegister.
+func NewGaugeFunc(subsystem, name, help string, fn func() float64) prometheus.GaugeFunc {
+	return prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      name,
+			Help:      help,
+		}, fn)
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	statementDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "sql",
+			Name:      "statement_duration_seconds",
+			Help:      "Bucketed histogram of the latency of statements by type.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 20),
+		}, []string{"type"})
+
+	walSyncDurationHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "wal_sync_duration_seconds",
+			Help:      "Bucketed histogram of the latency of syncing the wal.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 16),
+		})
+)
+
+// StatementDone records the statement of the type typ which has run for d,
+// err is the result of the statement.
+func StatementDone(typ string, err error, d time.Duration) {
+	status := StatusSuccess
+	if err != nil {
+		status = StatusError
+	}
+	statementCounter.WithLabelValues(typ, status).Inc()
+	statementDurationHistogram.WithLabelValues(typ).Observe(d.Seconds())
+}
+
+// WalSynced records a sync of the wal which has taken d
+func WalSynced(d time.Duration) {
+	walSyncDurationHistogram.Observe(d.Seconds())
+}
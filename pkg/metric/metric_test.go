@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T, s *Server) string {
+	resp, err := http.Get("http://" + s.Addr() + Path)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestServe(t *testing.T) {
+	s, err := Serve("127.0.0.1:0")
+	require.NoError(t, err)
+	defer s.Close()
+
+	ConnectionOpened()
+	ConnectionOpened()
+	ConnectionClosed()
+	StatementDone("Select", nil, time.Millisecond)
+	StatementDone("Insert", errors.New("failed"), time.Second)
+	Scanned(10, 80)
+	BufferPinned("lru-k", true)
+	BufferPinned("lru-k", false)
+	BufferEvicted("lru-k")
+	WalSynced(time.Millisecond)
+	Flushed(FlushMemBlock)
+	Merged()
+	Expired(100, 4096)
+	MustRegister(NewGaugeFunc("test", "value", "A value for the test.", func() float64 {
+		return 42
+	}))
+
+	text := scrape(t, s)
+	for _, line := range []string{
+		"mo_frontend_connections 1",
+		"mo_frontend_connections_total 2",
+		`mo_sql_statements_total{status="success",type="Select"} 1`,
+		`mo_sql_statements_total{status="error",type="Insert"} 1`,
+		`mo_sql_statement_duration_seconds_count{type="Select"} 1`,
+		"mo_sql_scan_rows_total 10",
+		"mo_sql_scan_bytes_total 80",
+		`mo_storage_buffer_pin_total{policy="lru-k",result="hit"} 1`,
+		`mo_storage_buffer_pin_total{policy="lru-k",result="miss"} 1`,
+		`mo_storage_buffer_evict_total{policy="lru-k"} 1`,
+		"mo_storage_wal_sync_duration_seconds_count 1",
+		`mo_storage_flush_total{type="memblock"} 1`,
+		"mo_storage_merge_total 1",
+		"mo_storage_ttl_expired_segments_total 1",
+		"mo_storage_ttl_expired_rows_total 100",
+		"mo_storage_ttl_expired_bytes_total 4096",
+		"mo_test_value 42",
+		"go_goroutines",
+	} {
+		require.Contains(t, text, line)
+	}
+}
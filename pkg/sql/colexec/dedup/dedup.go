@@ -48,10 +48,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if n.ctr == nil {
 		size := 0
 		n.ctr = new(Container)
-		n.ctr.bat = batch.New(true, bat.Attrs)
 		for i, vec := range bat.Vecs {
-			n.ctr.bat.Vecs[i] = vector.New(vec.Typ)
-			n.ctr.bat.Vecs[i].Ref = vec.Ref
 			nullable := 0
 			if nulls.Any(bat.Vecs[i].Nsp) {
 				nullable = 1
@@ -108,6 +105,12 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			n.ctr.strHashMap.Init()
 		}
 	}
+	// only the rows which are not seen before are sent, so the batches can be deduplicated one by one
+	n.ctr.bat = batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		n.ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		n.ctr.bat.Vecs[i].Ref = vec.Ref
+	}
 	switch n.ctr.typ {
 	case H8:
 		err = n.ctr.processH8(bat, proc)
@@ -125,9 +128,6 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		proc.Reg.InputBatch = nil
 		return false, err
 	}
-	for i := range n.ctr.bat.Zs {
-		n.ctr.bat.Zs[i] = 1
-	}
 	proc.Reg.InputBatch = n.ctr.bat
 	return false, err
 }
@@ -405,10 +405,8 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 				ctr.inserted[k] = 1
 				ctr.rows++
 				cnt++
-				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+				ctr.bat.Zs = append(ctr.bat.Zs, 1)
 			}
-			ai := int64(v) - 1
-			ctr.bat.Zs[ai] += bat.Zs[i+int64(k)]
 		}
 		if cnt > 0 {
 			for j, vec := range ctr.bat.Vecs {
@@ -694,10 +692,8 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 				ctr.inserted[k] = 1
 				ctr.rows++
 				cnt++
-				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+				ctr.bat.Zs = append(ctr.bat.Zs, 1)
 			}
-			ai := int64(v) - 1
-			ctr.bat.Zs[ai] += bat.Zs[i+int64(k)]
 		}
 		if cnt > 0 {
 			for j, vec := range ctr.bat.Vecs {
@@ -983,10 +979,8 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 				ctr.inserted[k] = 1
 				ctr.rows++
 				cnt++
-				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+				ctr.bat.Zs = append(ctr.bat.Zs, 1)
 			}
-			ai := int64(v) - 1
-			ctr.bat.Zs[ai] += bat.Zs[i+int64(k)]
 		}
 		if cnt > 0 {
 			for j, vec := range ctr.bat.Vecs {
@@ -1272,10 +1266,8 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 				ctr.inserted[k] = 1
 				ctr.rows++
 				cnt++
-				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+				ctr.bat.Zs = append(ctr.bat.Zs, 1)
 			}
-			ai := int64(v) - 1
-			ctr.bat.Zs[ai] += bat.Zs[i+int64(k)]
 		}
 		if cnt > 0 {
 			for j, vec := range ctr.bat.Vecs {
@@ -1535,10 +1527,8 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 				ctr.inserted[k] = 1
 				ctr.rows++
 				cnt++
-				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+				ctr.bat.Zs = append(ctr.bat.Zs, 1)
 			}
-			ai := int64(v) - 1
-			ctr.bat.Zs[ai] += bat.Zs[i+int64(k)]
 		}
 		if cnt > 0 {
 			for j, vec := range ctr.bat.Vecs {
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dedup"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"unsafe"
@@ -44,7 +45,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 	if len(proc.Reg.MergeReceivers) == 1 {
 		reg := proc.Reg.MergeReceivers[0]
-		bat := <-reg.Ch
+		bat := process.Receive(reg)
 		if bat == nil {
 			proc.Reg.MergeReceivers = nil
 		}
@@ -57,7 +58,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		case running:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 				reg := proc.Reg.MergeReceivers[i]
-				bat := <-reg.Ch
+				bat := process.Receive(reg)
 
 				if bat == nil {
 					proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
@@ -74,32 +75,52 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 					argument.ctr.initFlag = true
 				}
 				// do deduplication work
-				switch argument.ctr.typ {
-				case dedup.H8:
-					err = argument.ctr.processH8(bat, proc)
-				case dedup.H24:
-					err = argument.ctr.processH24(bat, proc)
-				case dedup.H32:
-					err = argument.ctr.processH32(bat, proc)
-				case dedup.H40:
-					err = argument.ctr.processH40(bat, proc)
-				default:
-					err = argument.ctr.processHStr(bat, proc)
-				}
-				if err != nil {
-					batch.Clean(argument.ctr.bat, proc.Mp)
+				if err = argument.ctr.process(bat, proc); err != nil {
+					argument.ctr.clean(proc)
 					proc.Reg.InputBatch = nil
 					return false, err
 				}
+				// the distinct rows are written to the partitions in the temporary
+				// files when they exceed the memory limitation
+				if spill.Full(proc, spill.Size(argument.ctr.bat)) {
+					if err = argument.ctr.spill(proc); err != nil {
+						argument.ctr.clean(proc)
+						proc.Reg.InputBatch = nil
+						return false, err
+					}
+				}
 
 				i--
 			}
+			argument.ctr.state = end
+			if argument.ctr.parts != nil {
+				if err = argument.ctr.spill(proc); err != nil {
+					argument.ctr.clean(proc)
+					proc.Reg.InputBatch = nil
+					return false, err
+				}
+				argument.ctr.state = partition
+				continue
+			}
 			if argument.ctr.bat != nil {
 				for i := range argument.ctr.bat.Zs {
 					argument.ctr.bat.Zs[i] = 1
 				}
 			}
-			argument.ctr.state = end
+		case partition:
+			bat, err := argument.ctr.fillPartition(proc)
+			if err != nil {
+				argument.ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return false, err
+			}
+			if bat == nil {
+				argument.ctr.clean(proc)
+				argument.ctr.state = end
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
 		case end:
 			proc.Reg.InputBatch = argument.ctr.bat
 			argument.ctr.bat = nil
@@ -108,6 +129,131 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
+func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
+	switch ctr.typ {
+	case dedup.H8:
+		return ctr.processH8(bat, proc)
+	case dedup.H24:
+		return ctr.processH24(bat, proc)
+	case dedup.H32:
+		return ctr.processH32(bat, proc)
+	case dedup.H40:
+		return ctr.processH40(bat, proc)
+	default:
+		return ctr.processHStr(bat, proc)
+	}
+}
+
+/*
+spill splits the distinct rows by their values into the partitions and appends
+them to the temporary files of the partitions, then the container starts over
+with an empty hash table. The rows of a partition are deduplicated at the end
+by fillPartition.
+*/
+func (ctr *container) spill(proc *process.Process) error {
+	if ctr.parts == nil {
+		ctr.parts = make([]*spill.File, spill.Partitions)
+	}
+	if ctr.bat == nil || len(ctr.bat.Zs) == 0 {
+		return nil
+	}
+	bs, err := spill.Partition(ctr.bat, len(ctr.bat.Vecs), len(ctr.parts), proc)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, b := range bs {
+			if b != nil {
+				batch.Clean(b, proc.Mp)
+			}
+		}
+	}()
+	for i, b := range bs {
+		if b == nil {
+			continue
+		}
+		if ctr.parts[i] == nil {
+			if ctr.parts[i], err = spill.Create(proc); err != nil {
+				return err
+			}
+		}
+		if err = ctr.parts[i].Write(b); err != nil {
+			return err
+		}
+	}
+	bat := ctr.bat
+	ctr.reset(bat)
+	batch.Clean(bat, proc.Mp)
+	return nil
+}
+
+// fillPartition returns the distinct rows of the next partition, nil if there is none
+func (ctr *container) fillPartition(proc *process.Process) (*batch.Batch, error) {
+	for ; ctr.part < len(ctr.parts); ctr.part++ {
+		f := ctr.parts[ctr.part]
+		if f == nil {
+			continue
+		}
+		if err := f.Rewind(); err != nil {
+			return nil, err
+		}
+		for {
+			bat, err := f.Read(proc)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			err = ctr.process(bat, proc)
+			batch.Clean(bat, proc.Mp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		f.Close()
+		ctr.parts[ctr.part] = nil
+		if len(ctr.bat.Zs) > 0 {
+			bat := ctr.bat
+			for i := range bat.Zs {
+				bat.Zs[i] = 1
+			}
+			ctr.reset(bat)
+			ctr.part++
+			return bat, nil
+		}
+	}
+	return nil, nil
+}
+
+// reset empties the hash table, and the rows of the container become an empty batch like the batch
+func (ctr *container) reset(bat *batch.Batch) {
+	ctr.bat = batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		ctr.bat.Vecs[i].Ref = vec.Ref
+	}
+	ctr.rows = 0
+	switch ctr.typ {
+	case dedup.H8:
+		ctr.intHashMap = &hashtable.Int64HashMap{}
+		ctr.intHashMap.Init()
+	default:
+		ctr.strHashMap = &hashtable.StringHashMap{}
+		ctr.strHashMap.Init()
+	}
+}
+
+// clean frees the distinct rows and removes the temporary files
+func (ctr *container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	spill.CloseFiles(ctr.parts)
+	ctr.parts = nil
+}
+
 func initHashTable(n *Argument, bat *batch.Batch) {
 	size := 0
 	n.ctr.bat = batch.New(true, bat.Attrs)
@@ -17,10 +17,12 @@ package mergededup
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 )
 
 const (
 	running = iota
+	partition
 	end
 )
 
@@ -61,6 +63,11 @@ type container struct {
 	}
 
 	bat *batch.Batch
+
+	// parts are the temporary files of the partitions of the distinct rows spilled
+	parts []*spill.File
+	// part is the next partition to deduplicate
+	part int
 }
 
 type Argument struct {
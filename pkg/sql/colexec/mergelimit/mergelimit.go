@@ -37,7 +37,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		reg := proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
+		bat := process.Receive(reg)
 
 		// deal special case for bat
 		{
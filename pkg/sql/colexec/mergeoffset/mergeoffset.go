@@ -39,7 +39,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		rec := proc.Reg.MergeReceivers[i]
-		bat := <-rec.Ch
+		bat := process.Receive(rec)
 		// deal special case for bat
 		{
 			// 1. the last batch at this receiver
@@ -16,12 +16,14 @@ package mergeorder
 
 import (
 	"bytes"
+	"container/heap"
 	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -49,28 +51,19 @@ func Prepare(_ *process.Process, arg interface{}) error {
 		argument.ctr.ds[i] = s.Type == order.Descending
 	}
 	argument.ctr.bat = nil
+	argument.ctr.runs = nil
 	return nil
 }
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
-	argument := arg.(*Argument)
-
-	if len(proc.Reg.MergeReceivers) == 1 {
-		reg := proc.Reg.MergeReceivers[0]
-		bat := <-reg.Ch
-		if bat == nil {
-			proc.Reg.MergeReceivers = nil
-		}
-		proc.Reg.InputBatch = bat
-		return true, nil
-	}
+	ctr := &arg.(*Argument).ctr
 
 	for {
-		switch argument.ctr.state {
+		switch ctr.state {
 		case running:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 				reg := proc.Reg.MergeReceivers[i]
-				bat := <-reg.Ch
+				bat := process.Receive(reg)
 				if bat == nil {
 					proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 					i--
@@ -80,16 +73,44 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 					i--
 					continue
 				}
-				err := mergeSort(proc.Mp, argument, bat)
-				if err != nil {
+				if err := ctr.mergeSort(bat, proc); err != nil {
+					ctr.clean(proc)
 					return false, err
 				}
+				// the sorted rows are written to a temporary file as a run when
+				// they exceed the memory limitation
+				if spill.Full(proc, spill.Size(ctr.bat)) {
+					if err := ctr.spill(proc); err != nil {
+						ctr.clean(proc)
+						return false, err
+					}
+				}
 				i--
 			}
-			argument.ctr.state = end
+			ctr.state = end
+			if len(ctr.runs) > 0 {
+				if err := ctr.prepareMerge(proc); err != nil {
+					ctr.clean(proc)
+					return false, err
+				}
+				ctr.state = merge
+			}
+		case merge:
+			bat, err := ctr.mergeRuns(proc)
+			if err != nil {
+				ctr.clean(proc)
+				return false, err
+			}
+			if bat == nil {
+				ctr.clean(proc)
+				ctr.state = end
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
 		case end:
-			proc.Reg.InputBatch = argument.ctr.bat
-			argument.ctr.bat = nil
+			proc.Reg.InputBatch = ctr.bat
+			ctr.bat = nil
 			return true, nil
 		}
 	}
@@ -103,48 +124,35 @@ func makeFlagsOne(n int) []uint8 {
 	return t
 }
 
-func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
-	batch.Reorder(b, arg.ctr.attrs)
-	if arg.ctr.bat == nil {
-		arg.ctr.bat = b
+// mergeSort merges the sorted batch into the sorted rows of the container
+func (ctr *container) mergeSort(b *batch.Batch, proc *process.Process) error {
+	batch.Reorder(b, ctr.attrs)
+	if ctr.bat == nil {
+		ctr.bat = b
 		return nil
 	}
-	bat1 := arg.ctr.bat
+	batch.Reorder(b, ctr.bat.Attrs)
+	bat1 := ctr.bat
 	bat2 := b
 	// init structures to store result
-	result := batch.New(false, arg.ctr.attrs)
-	for i := 0; i < len(arg.ctr.attrs); i++ {
-		result.Vecs[i] = vector.New(bat2.Vecs[i].Typ)
-	}
+	result := newBatch(bat1)
 	// init structures used to do compare work
-	if arg.ctr.cmps[0] == nil {
-		for k := range arg.ctr.cmps {
-			arg.ctr.cmps[k] = compare.New(batch.GetVector(bat1, arg.ctr.attrs[k]).Typ.Oid, arg.ctr.ds[k])
-		}
-	}
-
-	for k := range arg.ctr.cmps {
-		arg.ctr.cmps[k].Set(0, batch.GetVector(bat1, arg.ctr.attrs[k]))
-		arg.ctr.cmps[k].Set(1, batch.GetVector(bat2, arg.ctr.attrs[k]))
+	ctr.initCompare(bat1)
+	for k := range ctr.cmps {
+		ctr.cmps[k].Set(0, bat1.Vecs[k])
+		ctr.cmps[k].Set(1, bat2.Vecs[k])
 	}
 
 	// init index-number for merge-sort
 	i, j := int64(0), int64(0)
-	l1, l2 := int64(vector.Length(bat1.Vecs[0])), int64(vector.Length(bat2.Vecs[0]))
+	l1, l2 := int64(len(bat1.Zs)), int64(len(bat2.Zs))
 
 	// do merge-sort work
 	for i < l1 && j < l2 {
-		compareResult := 0
-		for k := range arg.ctr.cmps {
-			compareResult = arg.ctr.cmps[k].Compare(0, 1, i, j)
-			if compareResult != 0 {
-				break
-			}
-		}
-		if compareResult >= 0 { // Weight of item1 is bigger than or equal to item2
+		if ctr.compare(i, j) <= 0 { // item1 goes first if it is not after item2
 			for k := 0; k < len(result.Vecs); k++ {
-				err := vector.UnionOne(result.Vecs[k], bat1.Vecs[k], i, mp)
-				if err != nil {
+				if err := vector.UnionOne(result.Vecs[k], bat1.Vecs[k], i, proc.Mp); err != nil {
+					batch.Clean(result, proc.Mp)
 					return err
 				}
 			}
@@ -152,8 +160,8 @@ func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
 			i++
 		} else {
 			for k := 0; k < len(result.Vecs); k++ {
-				err := vector.UnionOne(result.Vecs[k], bat2.Vecs[k], j, mp)
-				if err != nil {
+				if err := vector.UnionOne(result.Vecs[k], bat2.Vecs[k], j, proc.Mp); err != nil {
+					batch.Clean(result, proc.Mp)
 					return err
 				}
 			}
@@ -165,8 +173,8 @@ func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
 		count := int(l1 - i)
 		// union all bat1 from i to l1
 		for k := 0; k < len(result.Vecs); k++ {
-			err := vector.UnionBatch(result.Vecs[k], bat1.Vecs[k], i, count, makeFlagsOne(count), mp)
-			if err != nil {
+			if err := vector.UnionBatch(result.Vecs[k], bat1.Vecs[k], i, count, makeFlagsOne(count), proc.Mp); err != nil {
+				batch.Clean(result, proc.Mp)
 				return err
 			}
 		}
@@ -176,12 +184,172 @@ func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
 		count := int(l2 - j)
 		// union all bat2 from j to l2
 		for k := 0; k < len(result.Vecs); k++ {
-			err := vector.UnionBatch(result.Vecs[k], bat2.Vecs[k], j, count, makeFlagsOne(count), mp)
-			if err != nil {
+			if err := vector.UnionBatch(result.Vecs[k], bat2.Vecs[k], j, count, makeFlagsOne(count), proc.Mp); err != nil {
+				batch.Clean(result, proc.Mp)
 				return err
 			}
 		}
-		result.Zs = append(result.Zs, bat1.Zs[j:]...)
+		result.Zs = append(result.Zs, bat2.Zs[j:]...)
+	}
+	batch.Clean(bat1, proc.Mp)
+	batch.Clean(bat2, proc.Mp)
+	ctr.bat = result
+	return nil
+}
+
+// spill writes the sorted rows of the container to a temporary file as a run
+func (ctr *container) spill(proc *process.Process) error {
+	if ctr.bat == nil {
+		return nil
+	}
+	f, err := spill.Create(proc)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, &run{f: f})
+	if err := f.Chunks(ctr.bat, RunRows, proc); err != nil {
+		return err
+	}
+	batch.Clean(ctr.bat, proc.Mp)
+	ctr.bat = nil
+	return nil
+}
+
+// prepareMerge spills the remaining rows and reads the first batch of every run
+func (ctr *container) prepareMerge(proc *process.Process) error {
+	if err := ctr.spill(proc); err != nil {
+		return err
+	}
+	h := &runHeap{ctr: ctr}
+	for _, r := range ctr.runs {
+		if err := r.f.Rewind(); err != nil {
+			return err
+		}
+		bat, err := r.f.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat != nil {
+			r.bat = bat
+			h.rs = append(h.rs, r)
+		}
+	}
+	if len(h.rs) > 0 {
+		ctr.initCompare(h.rs[0].bat)
 	}
+	heap.Init(h)
+	ctr.heap = h
 	return nil
 }
+
+// mergeRuns returns the next RunRows rows of the k-way merge of the runs, nil at the end
+func (ctr *container) mergeRuns(proc *process.Process) (*batch.Batch, error) {
+	h := ctr.heap
+	if len(h.rs) == 0 {
+		return nil, nil
+	}
+	result := newBatch(h.rs[0].bat)
+	for len(h.rs) > 0 && len(result.Zs) < RunRows {
+		r := h.rs[0]
+		for k, vec := range r.bat.Vecs {
+			if err := vector.UnionOne(result.Vecs[k], vec, r.row, proc.Mp); err != nil {
+				batch.Clean(result, proc.Mp)
+				return nil, err
+			}
+		}
+		result.Zs = append(result.Zs, r.bat.Zs[r.row])
+		if r.row++; r.row < int64(len(r.bat.Zs)) {
+			heap.Fix(h, 0)
+			continue
+		}
+		batch.Clean(r.bat, proc.Mp)
+		r.bat, r.row = nil, 0
+		bat, err := r.f.Read(proc)
+		if err != nil {
+			batch.Clean(result, proc.Mp)
+			return nil, err
+		}
+		if bat == nil {
+			heap.Pop(h)
+			continue
+		}
+		r.bat = bat
+		heap.Fix(h, 0)
+	}
+	return result, nil
+}
+
+// clean frees the rows of the container and removes the runs
+func (ctr *container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	for _, r := range ctr.runs {
+		if r.bat != nil {
+			batch.Clean(r.bat, proc.Mp)
+			r.bat = nil
+		}
+		r.f.Close()
+	}
+	ctr.runs = nil
+	ctr.heap = nil
+}
+
+func (ctr *container) initCompare(bat *batch.Batch) {
+	if ctr.cmps[0] == nil {
+		for k := range ctr.cmps {
+			ctr.cmps[k] = compare.New(bat.Vecs[k].Typ.Oid, ctr.ds[k])
+		}
+	}
+}
+
+// compare compares the row i of the vectors set at 0 and the row j of the vectors set at 1
+func (ctr *container) compare(i, j int64) int {
+	for k := range ctr.cmps {
+		if r := ctr.cmps[k].Compare(0, 1, i, j); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// newBatch returns an empty batch with the attributes of the batch
+func newBatch(bat *batch.Batch) *batch.Batch {
+	result := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		result.Vecs[i] = vector.New(vec.Typ)
+		result.Vecs[i].Ref = vec.Ref
+	}
+	result.As = bat.As
+	result.Refs = bat.Refs
+	return result
+}
+
+func (h *runHeap) Len() int {
+	return len(h.rs)
+}
+
+func (h *runHeap) Less(i, j int) bool {
+	ri, rj := h.rs[i], h.rs[j]
+	for k, cmp := range h.ctr.cmps {
+		cmp.Set(0, ri.bat.Vecs[k])
+		cmp.Set(1, rj.bat.Vecs[k])
+	}
+	return h.ctr.compare(ri.row, rj.row) < 0
+}
+
+func (h *runHeap) Swap(i, j int) {
+	h.rs[i], h.rs[j] = h.rs[j], h.rs[i]
+}
+
+func (h *runHeap) Push(x interface{}) {
+	h.rs = append(h.rs, x.(*run))
+}
+
+func (h *runHeap) Pop() interface{} {
+	n := len(h.rs)
+	r := h.rs[n-1]
+	h.rs = h.rs[:n-1]
+	return r
+}
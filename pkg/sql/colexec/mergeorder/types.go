@@ -18,11 +18,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 )
 
+// RunRows is the maximum number of rows of a batch of the runs and of
+// the batches merged from the runs
+const RunRows = 8192
+
 // state values
 const (
 	running = iota
+	merge
 	end
 )
 
@@ -39,6 +45,25 @@ type container struct {
 
 	// bat store the result of merge-order
 	bat *batch.Batch
+
+	// runs are the sorted runs spilled to the temporary files when bat
+	// exceeds the memory limitation, they are merged at the end.
+	runs []*run
+	heap *runHeap
+}
+
+// run is a sorted run in a temporary file and its current batch
+type run struct {
+	f   *spill.File
+	bat *batch.Batch
+	// row is the current row of bat
+	row int64
+}
+
+// runHeap is the min-heap of the runs ordered by their current rows
+type runHeap struct {
+	rs  []*run
+	ctr *container
 }
 
 type Argument struct {
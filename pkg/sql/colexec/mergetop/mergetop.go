@@ -64,7 +64,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			{ // do merge-top work
 				for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 					reg := proc.Reg.MergeReceivers[i]
-					bat := <-reg.Ch
+					bat := process.Receive(reg)
 
 					if bat == nil {
 						proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
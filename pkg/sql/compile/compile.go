@@ -20,6 +20,7 @@ import (
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/unary"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -36,12 +37,24 @@ func New(db string, sql string, uid string,
 		uid:  uid,
 		sql:  sql,
 		proc: proc,
+		dt:   dialect.MYSQL,
 	}
 }
 
+// SetDialect sets the dialect of the sql, the sql is MySQL by default.
+func (c *compile) SetDialect(dt dialect.DialectType) {
+	c.dt = dt
+}
+
+// SetPrivilegeChecker sets the checker of the privileges of the user,
+// the privileges are checked when the plans are built.
+func (c *compile) SetPrivilegeChecker(pc plan.PrivilegeChecker) {
+	c.pc = pc
+}
+
 // Build generates query execution list based on the result of sql parser.
 func (c *compile) Build() ([]*Exec, error) {
-	stmts, err := parsers.Parse(dialect.MYSQL, c.sql)
+	stmts, err := parsers.Parse(c.dt, c.sql)
 	if err != nil {
 		return nil, err
 	}
@@ -16,13 +16,22 @@ package compile
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
 	"log"
 	"testing"
 )
@@ -99,3 +108,201 @@ func processQuery(query string, e engine.Engine, proc *process.Process) {
 		}
 	}
 }
+
+var spillQuerys = []string{
+	"SELECT userID, MIN(score) FROM t1 GROUP BY userID;",
+	"SELECT userID, SUM(score) FROM t1 GROUP BY userID ORDER BY userID desc;",
+	"SELECT userID as user, MAX(score) as max FROM t1 GROUP BY userID order by user;",
+	"select userID,count(score) from t1 group by userID having count(score)>1;",
+	"SELECT distinct userID, count(score) FROM t1 GROUP BY userID;",
+	"select distinct spID from t1;",
+	"select * from t1 order by userID, spID, score;",
+	"select userID, spID from t1 order by spID desc, userID;",
+}
+
+func TestCompileSpill(t *testing.T) {
+	InitAddress("127.0.0.1")
+	e := memEngine.NewTestEngine()
+	for _, query := range spillQuerys {
+		expected := collectQuery(t, query, e, func(_ *process.Process) {})
+		//every operator spills its state to the temporary files
+		result := collectQuery(t, query, e, func(proc *process.Process) {
+			proc.Lim.Size = 1
+			proc.Lim.SpillDir = t.TempDir()
+			proc.Lim.SpillCompress = true
+		})
+		require.Equal(t, expected, result, query)
+	}
+}
+
+func TestCompileSpillJoin(t *testing.T) {
+	InitAddress("127.0.0.1")
+	e := memEngine.NewTestEngine()
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	//a fact table of 3000 rows and two dimension tables of unique keys
+	createInt64Table(t, db, "fact", []string{"fk1", "fk2", "fv"}, 3000, func(col, row int) int64 {
+		switch col {
+		case 0:
+			return int64(row % 400)
+		case 1:
+			return int64(row % 13)
+		default:
+			return int64(row)
+		}
+	})
+	createInt64Table(t, db, "dim1", []string{"d1k", "d1v"}, 300, func(col, row int) int64 {
+		if col == 0 {
+			return int64(row)
+		}
+		return int64(row % 7)
+	})
+	createInt64Table(t, db, "dim2", []string{"d2k", "d2v"}, 10, func(col, row int) int64 {
+		if col == 0 {
+			return int64(row)
+		}
+		return int64(row % 3)
+	})
+	for _, query := range []string{
+		"select d1v, sum(fv), count(*) from fact join dim1 on fk1 = d1k group by d1v;",
+		"select sum(fv) from fact join dim1 on fk1 = d1k where d1v = 1;",
+		"select d1v, d2v, sum(fv) from fact join dim1 on fk1 = d1k join dim2 on fk2 = d2k group by d1v, d2v;",
+	} {
+		expected := collectQuery(t, query, e, func(_ *process.Process) {})
+		//the largest build side of the join is spilled to the temporary files
+		result := collectQuery(t, query, e, func(proc *process.Process) {
+			proc.Lim.Size = 1
+			proc.Lim.SpillDir = t.TempDir()
+		})
+		require.NotEmpty(t, expected, query)
+		require.Equal(t, expected, result, query)
+	}
+}
+
+func createInt64Table(t *testing.T, db engine.Database, name string, attrs []string, rows int, value func(int, int) int64) {
+	var defs []engine.TableDef
+
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	for _, attr := range attrs {
+		defs = append(defs, &engine.AttributeDef{Attr: engine.Attribute{Name: attr, Type: typ}})
+	}
+	require.NoError(t, db.Create(0, name, defs))
+	r, err := db.Relation(name)
+	require.NoError(t, err)
+	for start := 0; start < rows; start += 1000 {
+		end := start + 1000
+		if end > rows {
+			end = rows
+		}
+		bat := batch.New(true, attrs)
+		for i := range attrs {
+			vs := make([]int64, 0, end-start)
+			for row := start; row < end; row++ {
+				vs = append(vs, value(i, row))
+			}
+			bat.Vecs[i] = vector.New(typ)
+			require.NoError(t, vector.Append(bat.Vecs[i], vs))
+		}
+		require.NoError(t, r.Write(0, bat))
+	}
+}
+
+func collectQuery(t *testing.T, query string, e engine.Engine, limit func(*process.Process)) []string {
+	var rows []string
+
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	limit(proc)
+	c := New("test", query, "", e, proc)
+	es, err := c.Build()
+	require.NoError(t, err)
+	for _, e := range es {
+		err := e.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat == nil {
+				return nil
+			}
+			for i, z := range bat.Zs {
+				row := fmt.Sprintf("%d:", z)
+				for _, vec := range bat.Vecs {
+					switch vs := vec.Col.(type) {
+					case *types.Bytes:
+						row += " " + string(vs.Get(int64(i)))
+					default:
+						if nulls.Contains(vec.Nsp, uint64(i)) {
+							row += " null"
+						} else {
+							row += fmt.Sprintf(" %v", reflect.ValueOf(vs).Index(i).Interface())
+						}
+					}
+				}
+				rows = append(rows, row)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, e.Run(0))
+	}
+	if !strings.Contains(strings.ToLower(query), "order by") {
+		sort.Strings(rows)
+	}
+	return rows
+}
+
+func TestCompileInfoSchema(t *testing.T) {
+	InitAddress("127.0.0.1")
+	e := infoschema.New(memEngine.NewTestEngine(), engine.Node{Addr: "127.0.0.1"}, nil)
+
+	rows := collectQuery(t, "select SCHEMA_NAME from information_schema.SCHEMATA;", e, func(_ *process.Process) {})
+	require.Equal(t, []string{"1: information_schema", "1: test"}, rows)
+
+	rows = collectQuery(t, "select TABLE_NAME, TABLE_ROWS from information_schema.TABLES where TABLE_SCHEMA = 'test' and TABLE_NAME = 't1';", e, func(_ *process.Process) {})
+	require.Equal(t, []string{"1: t1 7"}, rows)
+
+	rows = collectQuery(t, "select COLUMN_NAME, DATA_TYPE from information_schema.COLUMNS where TABLE_NAME = 'R' order by ORDINAL_POSITION;", e, func(_ *process.Process) {})
+	require.Equal(t, []string{"1: orderId varchar", "1: uid int", "1: price double"}, rows)
+
+	rows = collectQuery(t, "select count(*) from information_schema.TABLES t join information_schema.COLUMNS c on t.TABLE_NAME = c.TABLE_NAME where t.TABLE_SCHEMA = 'information_schema';", e, func(_ *process.Process) {})
+	require.Equal(t, 1, len(rows))
+}
+
+func TestPlanDigest(t *testing.T) {
+	InitAddress("127.0.0.1")
+	e := memEngine.NewTestEngine()
+	digest := func(query string, stat *process.Statistic) string {
+		proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+		proc.Stat = stat
+		es, err := New("test", query, "", e, proc).Build()
+		require.NoError(t, err)
+		require.NoError(t, es[0].Compile(nil, func(_ interface{}, _ *batch.Batch) error { return nil }))
+		require.NoError(t, es[0].Run(0))
+		return es[0].PlanDigest()
+	}
+	var stat process.Statistic
+	d1 := digest("select userID from t1 where score > 10;", &stat)
+	d2 := digest("select userID from t1 where score > 20;", nil)
+	d3 := digest("select userID, count(score) from t1 group by userID;", nil)
+	require.Equal(t, d1, d2)
+	require.NotEqual(t, d1, d3)
+	//all rows of t1 are read
+	require.Equal(t, int64(7), stat.ScanRows)
+}
+
+func TestCancel(t *testing.T) {
+	InitAddress("127.0.0.1")
+	e := memEngine.NewTestEngine()
+	for _, query := range []string{"select * from t1;", "select userID, count(score) from t1 group by userID;"} {
+		proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+		es, err := New("test", query, "", e, proc).Build()
+		require.NoError(t, err)
+		// a KILL may arrive before the exec is compiled
+		es[0].Cancel()
+		rows := 0
+		require.NoError(t, es[0].Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += len(bat.Zs)
+			}
+			return nil
+		}))
+		_ = es[0].Run(0)
+		require.Equal(t, 0, rows, query)
+	}
+}
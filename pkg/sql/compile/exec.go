@@ -15,6 +15,9 @@
 package compile
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -25,17 +28,28 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
 	"github.com/matrixorigin/matrixone/pkg/sql/vtree"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 // Compile compiles ast tree to scope list.
 // A scope is an execution unit.
 func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	// the scopes are built with the context of the exec, so they can be
+	// cancelled at any time from now on
+	e.mu.Lock()
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+	if e.cancelled {
+		e.cancel()
+	}
+	e.mu.Unlock()
+	e.c.proc.Ctx = e.ctx
+
 	// do ast rewrite work
 	e.stmt = rewrite.Rewrite(e.stmt)
 	e.stmt = rewrite.AstRewrite(e.stmt)
 
 	// do semantic analysis and build plan for ast
-	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).BuildStatement(e.stmt)
+	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).WithPrivilegeChecker(e.c.pc).WithUser(e.c.uid).BuildStatement(e.stmt)
 	if err != nil {
 		return err
 	}
@@ -67,6 +81,11 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 	switch qry := pn.(type) {
 	case *plan.Query:
+		if len(qry.Temps) > 0 {
+			if err := e.compileTemps(qry.Temps); err != nil {
+				return nil, err
+			}
+		}
 		ft, err := ftree.New().Build(qry)
 		if err != nil {
 			return nil, err
@@ -79,6 +98,12 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.Delete:
+		return &Scope{
+			Magic: Delete,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	case *plan.CreateDatabase:
 		return &Scope{
 			Magic: CreateDatabase,
@@ -100,6 +125,24 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.CreateView:
+		return &Scope{
+			Magic: CreateView,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
+	case *plan.AlterTable:
+		return &Scope{
+			Magic: AlterTable,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
+	case *plan.RenameTable:
+		return &Scope{
+			Magic: RenameTable,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	case *plan.DropDatabase:
 		return &Scope{
 			Magic: DropDatabase,
@@ -118,6 +161,12 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.DropView:
+		return &Scope{
+			Magic: DropView,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	case *plan.ShowDatabases:
 		return &Scope{
 			Magic: ShowDatabases,
@@ -142,6 +191,12 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.ShowCreateView:
+		return &Scope{
+			Magic: ShowCreateView,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	case *plan.ShowCreateDatabase:
 		return &Scope{
 			Magic: ShowCreateDatabase,
@@ -156,6 +211,16 @@ func (e *Exec) Statement() tree.Statement {
 	return e.stmt
 }
 
+// PlanDigest returns the fingerprint of the compiled scopes, the queries
+// which have the same plan but different literals have the same fingerprint.
+func (e *Exec) PlanDigest() string {
+	if e.scope == nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(scopeShape(e.scope)))
+	return hex.EncodeToString(sum[:])
+}
+
 func (e *Exec) SetSchema(db string) error {
 	e.c.db = db
 	return nil
@@ -177,6 +242,26 @@ func (e *Exec) GetAffectedRows() uint64 {
 	return e.affectRows
 }
 
+// Cancel cancels the pipelines of the exec, the pipelines stop when they
+// read or send their next batch. An exec cancelled before it is compiled
+// does not run.
+func (e *Exec) Cancel() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cancelled = true
+	if e.cancel != nil {
+		e.cancel()
+	}
+}
+
+// queryContext returns the context of the query of the process
+func queryContext(proc *process.Process) context.Context {
+	if proc.Ctx == nil {
+		return context.Background()
+	}
+	return proc.Ctx
+}
+
 func (e *Exec) Run(ts uint64) error {
 	if e.scope == nil {
 		return nil
@@ -186,6 +271,11 @@ func (e *Exec) Run(ts uint64) error {
 	Print(nil, []*Scope{e.scope})
 	fmt.Printf("+++++++++\n")
 
+	for _, t := range e.temps {
+		if err := t.Run(ts); err != nil {
+			return err
+		}
+	}
 	switch e.scope.Magic {
 	case Normal:
 		return e.scope.Run(e.c.e)
@@ -202,18 +292,33 @@ func (e *Exec) Run(ts uint64) error {
 		}
 		e.setAffectedRows(affectedRows)
 		return nil
+	case Delete:
+		affectedRows, err := e.scope.Delete(ts)
+		if err != nil {
+			return err
+		}
+		e.setAffectedRows(affectedRows)
+		return nil
 	case CreateDatabase:
 		return e.scope.CreateDatabase(ts)
 	case CreateTable:
 		return e.scope.CreateTable(ts)
 	case CreateIndex:
 		return e.scope.CreateIndex(ts)
+	case CreateView:
+		return e.scope.CreateView(ts)
+	case AlterTable:
+		return e.scope.AlterTable(ts)
+	case RenameTable:
+		return e.scope.RenameTable(ts)
 	case DropDatabase:
 		return e.scope.DropDatabase(ts)
 	case DropTable:
 		return e.scope.DropTable(ts)
 	case DropIndex:
 		return e.scope.DropIndex(ts)
+	case DropView:
+		return e.scope.DropView(ts)
 	case ShowDatabases:
 		return e.scope.ShowDatabases(e.u, e.fill)
 	case ShowTables:
@@ -222,6 +327,8 @@ func (e *Exec) Run(ts uint64) error {
 		return e.scope.ShowColumns(e.u, e.fill)
 	case ShowCreateTable:
 		return e.scope.ShowCreateTable(e.u, e.fill)
+	case ShowCreateView:
+		return e.scope.ShowCreateView(e.u, e.fill)
 	case ShowCreateDatabase:
 		return e.scope.ShowCreateDatabase(e.u, e.fill)
 	}
@@ -15,7 +15,11 @@
 package compile
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/pipeline"
 )
 
@@ -28,3 +32,31 @@ func Print(prefix []byte, ss []*Scope) {
 		fmt.Printf("%s:%v %v\n", prefix, s.Magic, p)
 	}
 }
+
+// scopeShape returns the kinds, the data sources and the operators of the scope
+// and its pre-scopes, the same pre-scopes running on different nodes are shown once.
+func scopeShape(s *Scope) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("%v", s.Magic))
+	if s.DataSource != nil {
+		buf.WriteString(fmt.Sprintf(" %s.%s%v", s.DataSource.SchemaName, s.DataSource.RelationName, s.DataSource.Attributes))
+	}
+	for _, in := range s.Instructions {
+		buf.WriteString(fmt.Sprintf(" %v", in.Op))
+	}
+	if len(s.PreScopes) > 0 {
+		shapes := make([]string, 0, len(s.PreScopes))
+		mp := make(map[string]struct{})
+		for _, ps := range s.PreScopes {
+			shape := scopeShape(ps)
+			if _, ok := mp[shape]; !ok {
+				mp[shape] = struct{}{}
+				shapes = append(shapes, shape)
+			}
+		}
+		sort.Strings(shapes)
+		buf.WriteString(" (" + strings.Join(shapes, ", ") + ")")
+	}
+	return buf.String()
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
@@ -68,6 +69,13 @@ func (s *Scope) CreateDatabase(ts uint64) error {
 		}
 		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("database %s already exists", p.Id))
 	}
+	if p.Encrypted {
+		e, ok := p.E.(engine.EncryptedCreator)
+		if !ok {
+			return errors.New(errno.FeatureNotSupported, "encryption is not supported by the storage engine")
+		}
+		return e.CreateEncrypted(ts, p.Id, 0)
+	}
 	return p.E.Create(ts, p.Id, 0)
 }
 
@@ -84,6 +92,23 @@ func (s *Scope) CreateTable(ts uint64) error {
 	return p.Db.Create(ts, p.Id, p.Defs)
 }
 
+// CreateView do create view work according to create view plan,
+// the old view is replaced if it's a create or replace.
+func (s *Scope) CreateView(ts uint64) error {
+	p, _ := s.Plan.(*plan.CreateView)
+	if r, err := p.Db.Relation(p.Id); err == nil {
+		v := engine.GetViewDef(r.TableDefs())
+		r.Close()
+		if v == nil || !p.Replace {
+			return errors.New(errno.DuplicateTable, fmt.Sprintf("Table '%s' already exists", p.Id))
+		}
+		if err := p.Db.Delete(ts, p.Id); err != nil {
+			return err
+		}
+	}
+	return p.Db.Create(ts, p.Id, p.Defs)
+}
+
 // CreateIndex do create index work according to create index plan
 func (s *Scope) CreateIndex(ts uint64) error {
 	o, _ := s.Plan.(*plan.CreateIndex)
@@ -95,6 +120,43 @@ func (s *Scope) CreateIndex(ts uint64) error {
 	return o.Relation.CreateIndex(ts, o.Defs)
 }
 
+// AlterTable do alter table work according to alter table plan
+func (s *Scope) AlterTable(ts uint64) error {
+	p, _ := s.Plan.(*plan.AlterTable)
+	defer p.Relation.Close()
+	for _, spec := range p.Specs {
+		if spec.Drop {
+			if err := p.Relation.DelTableDef(ts, spec.Def); err != nil {
+				return err
+			}
+		} else {
+			if err := p.Relation.AddTableDef(ts, spec.Def); err != nil {
+				return err
+			}
+		}
+	}
+	if len(p.NewId) > 0 {
+		return p.Db.Rename(ts, p.Id, p.NewId)
+	}
+	return nil
+}
+
+// RenameTable do rename table work according to rename table plan,
+// the tables are renamed in order
+func (s *Scope) RenameTable(ts uint64) error {
+	p, _ := s.Plan.(*plan.RenameTable)
+	for i := range p.Dbs {
+		db, err := p.E.Database(p.Dbs[i])
+		if err != nil {
+			return err
+		}
+		if err := db.Rename(ts, p.Ids[i], p.NewIds[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DropDatabase do drop database work according to drop index plan
 func (s *Scope) DropDatabase(ts uint64) error {
 	p, _ := s.Plan.(*plan.DropDatabase)
@@ -124,7 +186,44 @@ func (s *Scope) DropTable(ts uint64) error {
 			}
 			return err
 		} else {
+			v := engine.GetViewDef(r.TableDefs())
 			r.Close()
+			if v != nil { // a view is dropped by drop view
+				if p.IfExistFlag {
+					continue
+				}
+				return errors.New(errno.UndefinedTable, fmt.Sprintf("Unknown table '%s.%s'", p.Dbs[i], p.Ids[i]))
+			}
+		}
+		if err := db.Delete(ts, p.Ids[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropView do drop view work according to drop view plan
+func (s *Scope) DropView(ts uint64) error {
+	p, _ := s.Plan.(*plan.DropView)
+	for i := range p.Dbs {
+		db, err := p.E.Database(p.Dbs[i])
+		if err != nil {
+			if p.IfExistFlag {
+				continue
+			}
+			return err
+		}
+		r, err := db.Relation(p.Ids[i])
+		if err != nil {
+			if p.IfExistFlag {
+				continue
+			}
+			return errors.New(errno.UndefinedTable, fmt.Sprintf("Unknown table '%s.%s'", p.Dbs[i], p.Ids[i]))
+		}
+		v := engine.GetViewDef(r.TableDefs())
+		r.Close()
+		if v == nil {
+			return errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is not VIEW", p.Dbs[i], p.Ids[i]))
 		}
 		if err := db.Delete(ts, p.Ids[i]); err != nil {
 			return err
@@ -377,6 +476,49 @@ func (s *Scope) ShowCreateTable(u interface{}, fill func(interface{}, *batch.Bat
 	return fill(u, bat)
 }
 
+// ShowCreateView fill batch with definition of a view
+func (s *Scope) ShowCreateView(u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	p, _ := s.Plan.(*plan.ShowCreateView)
+	defer p.Relation.Close()
+	results := p.ResultColumns()
+	defs := p.Relation.TableDefs()
+
+	names := make([]string, 0)
+	for _, r := range results {
+		names = append(names, r.Name)
+	}
+
+	bat := batch.New(true, names)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.New(results[i].Type)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("CREATE VIEW `")
+	buf.WriteString(p.ViewName)
+	buf.WriteString("` (")
+	prefix := ""
+	for _, d := range defs {
+		if v, ok := d.(*engine.AttributeDef); ok {
+			buf.WriteString(prefix)
+			buf.WriteString("`" + v.Attr.Name + "`")
+			prefix = ", "
+		}
+	}
+	buf.WriteString(") AS ")
+	if v := engine.GetViewDef(defs); v != nil {
+		buf.WriteString(v.Sql)
+	}
+
+	vector.Append(bat.Vecs[0], [][]byte{[]byte(p.ViewName)})
+	vector.Append(bat.Vecs[1], [][]byte{buf.Bytes()})
+	vector.Append(bat.Vecs[2], [][]byte{[]byte("utf8mb4")})
+	vector.Append(bat.Vecs[3], [][]byte{[]byte("utf8mb4_0900_ai_ci")})
+
+	bat.InitZsOne(1)
+	return fill(u, bat)
+}
+
 // ShowCreateDatabase fill batch with definition of a database
 func (s *Scope) ShowCreateDatabase(u interface{}, fill func(interface{}, *batch.Batch) error) error {
 	p, _ := s.Plan.(*plan.ShowCreateDatabase)
@@ -422,6 +564,24 @@ func (s *Scope) Insert(ts uint64) (uint64, error) {
 	return uint64(vector.Length(p.Bat.Vecs[0])), p.Relation.Write(ts, p.Bat)
 }
 
+// Delete removes the rows of the relation which satisfy the filter of the
+// delete plan, the filter is evaluated by the storage engine.
+func (s *Scope) Delete(ts uint64) (uint64, error) {
+	p, _ := s.Plan.(*plan.Delete)
+	defer p.Relation.Close()
+	d, ok := p.Relation.(engine.Deleter)
+	if !ok {
+		return 0, errors.New(errno.FeatureNotSupported, "delete is not supported by the storage engine")
+	}
+	var pred *engine.Predicate
+	if p.Cond != nil {
+		if pred, ok = pipeline.ExactPredicate(p.Cond); !ok {
+			return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupport delete condition '%s'", p.Cond))
+		}
+	}
+	return d.Delete(ts, pred)
+}
+
 // Run read data from storage engine and run the instructions of scope.
 func (s *Scope) Run(e engine.Engine) error {
 	p := pipeline.New(s.DataSource.RefCounts, s.DataSource.Attributes, s.Instructions)
@@ -519,6 +679,12 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 			return errors.New(errno.SystemError, string(msg.Code))
 		}
 		if msg.Sid == 1 {
+			// the end of the scope carries the statistic of the remote node
+			if len(msg.Data) > 0 {
+				if stat, err := protocol.DecodeStatistic(msg.Data); err == nil {
+					s.Proc.Stat.Scanned(stat.ScanRows, stat.ScanBytes)
+				}
+			}
 			select {
 			case <-arg.Reg.Ctx.Done():
 			case arg.Reg.Ch <- nil:
@@ -617,6 +783,8 @@ func (s *Scope) RunQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Stat = s.Proc.Stat
+		ss[i].Proc.Ctx = s.Proc.Ctx
 	}
 
 	opTyp := s.Instructions[len(s.Instructions)-2].Op  // push-down operator's type
@@ -691,7 +859,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 		}
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(queryContext(s.Proc))
 	s.Magic = Merge
 	s.PreScopes = ss
 	s.Proc.Cancel = cancel
@@ -761,11 +929,13 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Stat = s.Proc.Stat
+		ss[i].Proc.Ctx = s.Proc.Ctx
 	}
 	for len(ss) > 3 {
 		ss = newMergeScope(ss, arg.Typ, s.Proc)
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(queryContext(s.Proc))
 	s.Magic = Merge
 	s.PreScopes = ss
 	s.Instructions[0] = vm.Instruction{
@@ -833,9 +1003,11 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Stat = s.Proc.Stat
+		ss[i].Proc.Ctx = s.Proc.Ctx
 	}
 	s.PreScopes = s.PreScopes[1:]
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(queryContext(s.Proc))
 	s.Proc.Cancel = cancel
 	s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(s.PreScopes))
 	{
@@ -897,16 +1069,35 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 		return err
 	}
 	for i := 0; i < len(s.Proc.Reg.MergeReceivers); i++ {
+		var view *batch.Batch
+
 		reg := s.Proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
-		if bat == nil {
-			continue
+		for {
+			bat := process.Receive(reg)
+			if bat == nil {
+				break
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			// the groups of a view are sent in several batches when they are spilled
+			if view, err = mergeView(view, bat, s.Proc); err != nil {
+				break
+			}
 		}
-		if len(bat.Zs) == 0 {
-			i--
-			continue
+		if err != nil {
+			if view != nil {
+				batch.Clean(view, s.Proc.Mp)
+			}
+			for _, bat := range arg.Bats {
+				batch.Clean(bat, s.Proc.Mp)
+			}
+			arg.Bats = nil
+			return err
+		}
+		if view != nil {
+			arg.Bats = append(arg.Bats, view)
 		}
-		arg.Bats = append(arg.Bats, bat)
 	}
 	if len(arg.Bats) != len(arg.Svars) {
 		for i, in := range s.Instructions {
@@ -921,6 +1112,23 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 		}
 		return nil
 	}
+	// the largest view is joined partition by partition when the views
+	// exceed the memory limitation
+	spilled, parts, err := spillViews(arg.Bats, arg.Svars, s.Proc)
+	if err != nil {
+		for i, in := range s.Instructions {
+			if in.Op == vm.Connector {
+				arg := s.Instructions[i].Arg.(*connector.Argument)
+				select {
+				case <-arg.Reg.Ctx.Done():
+				case arg.Reg.Ch <- nil:
+				}
+				break
+			}
+		}
+		return err
+	}
+	defer spill.CloseFiles(parts)
 	constructViews(arg.Bats, arg.Svars)
 	for i := 0; i < mcpu; i++ {
 		ss[i].Instructions = vm.Instructions{vm.Instruction{
@@ -934,6 +1142,8 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 				VarsMap:  arg.VarsMap,
 				Bats:     arg.Bats,
 				FreeVars: arg.FreeVars,
+				Spilled:  spilled,
+				Parts:    parts,
 				Arg: &transform.Argument{
 					Typ:        arg.Arg.Typ,
 					IsMerge:    arg.Arg.IsMerge,
@@ -958,12 +1168,14 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 		Arg: &plus.Argument{Typ: arg.Arg.Typ},
 	}
 	{
-		ctx, cancel := context.WithCancel(context.Background())
+		ctx, cancel := context.WithCancel(queryContext(s.Proc))
 		rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		rs.Proc.Cancel = cancel
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = s.Proc.Id
 		rs.Proc.Lim = s.Proc.Lim
+		rs.Proc.Stat = s.Proc.Stat
+		rs.Proc.Ctx = s.Proc.Ctx
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1010,12 +1222,14 @@ func newMergeScope(ss []*Scope, typ int, proc *process.Process) []*Scope {
 		})
 		{
 			m := len(rs[i].PreScopes)
-			ctx, cancel := context.WithCancel(context.Background())
+			ctx, cancel := context.WithCancel(queryContext(proc))
 			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Stat = proc.Stat
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1064,12 +1278,14 @@ func newMergeOrderScope(ss []*Scope, arg *mergeorder.Argument, proc *process.Pro
 		})
 		{
 			m := len(rs[i].PreScopes)
-			ctx, cancel := context.WithCancel(context.Background())
+			ctx, cancel := context.WithCancel(queryContext(proc))
 			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Stat = proc.Stat
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1118,12 +1334,14 @@ func newMergeLimitScope(ss []*Scope, limit uint64, proc *process.Process) []*Sco
 		})
 		{
 			m := len(rs[i].PreScopes)
-			ctx, cancel := context.WithCancel(context.Background())
+			ctx, cancel := context.WithCancel(queryContext(proc))
 			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Stat = proc.Stat
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1175,12 +1393,14 @@ func newMergeTopScope(ss []*Scope, arg *mergetop.Argument, proc *process.Process
 		})
 		{
 			m := len(rs[i].PreScopes)
-			ctx, cancel := context.WithCancel(context.Background())
+			ctx, cancel := context.WithCancel(queryContext(proc))
 			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Stat = proc.Stat
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1229,12 +1449,14 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 		})
 		{
 			m := len(rs[i].PreScopes)
-			ctx, cancel := context.WithCancel(context.Background())
+			ctx, cancel := context.WithCancel(queryContext(proc))
 			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Stat = proc.Stat
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -0,0 +1,175 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
+)
+
+// tempEngine is the engine of a query which reads temporary tables,
+// plan.TempSchema is the database holding the temporary tables.
+type tempEngine struct {
+	engine.Engine
+	db *tempDatabase
+}
+
+func (e *tempEngine) Database(name string) (engine.Database, error) {
+	if name == plan.TempSchema {
+		return e.db, nil
+	}
+	return e.Engine.Database(name)
+}
+
+// tempDatabase keeps every temporary table in its own memory engine, so
+// that a temporary table can be written while the former ones are read.
+type tempDatabase struct {
+	dbs map[string]engine.Database
+}
+
+func (d *tempDatabase) Relations() []string {
+	rs := make([]string, 0, len(d.dbs))
+	for name := range d.dbs {
+		rs = append(rs, name)
+	}
+	return rs
+}
+
+func (d *tempDatabase) Relation(name string) (engine.Relation, error) {
+	db, ok := d.dbs[name]
+	if !ok {
+		return nil, fmt.Errorf("temporary table '%s' not exist", name)
+	}
+	return db.Relation(name)
+}
+
+func (d *tempDatabase) Delete(_ uint64, name string) error {
+	delete(d.dbs, name)
+	return nil
+}
+
+func (d *tempDatabase) Create(ts uint64, name string, defs []engine.TableDef) error {
+	if _, ok := d.dbs[name]; ok {
+		return fmt.Errorf("temporary table '%s' already exists", name)
+	}
+	db, err := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: Address}).Database("test")
+	if err != nil {
+		return err
+	}
+	if err := db.Create(ts, name, defs); err != nil {
+		return err
+	}
+	d.dbs[name] = db
+	return nil
+}
+
+func (d *tempDatabase) Rename(_ uint64, name, _ string) error {
+	return fmt.Errorf("temporary table '%s' can't be renamed", name)
+}
+
+// compileTemps compiles the queries which fill the temporary tables, the
+// exec reads the temporary tables by a tempEngine after them.
+func (e *Exec) compileTemps(ts []*plan.TempTable) error {
+	db := &tempDatabase{dbs: make(map[string]engine.Database)}
+	{
+		c := *e.c
+		c.e = &tempEngine{Engine: e.c.e, db: db}
+		e.c = &c
+		e.e = e.c.e
+	}
+	for _, t := range ts {
+		defs := make([]engine.TableDef, len(t.Attrs))
+		attrs := make([]string, len(t.Attrs))
+		for i, attr := range t.Attrs {
+			defs[i] = &engine.AttributeDef{
+				Attr: engine.Attribute{
+					Name: attr.Name,
+					Alg:  compress.None,
+					Type: attr.Type,
+				},
+			}
+			attrs[i] = attr.Name
+		}
+		if err := db.Create(0, t.Name, defs); err != nil {
+			return err
+		}
+		r, err := db.Relation(t.Name)
+		if err != nil {
+			return err
+		}
+		cols := make([]*Col, len(t.Query.ResultAttributes))
+		for i, attr := range t.Query.ResultAttributes {
+			cols[i] = &Col{
+				Name: attr.Name,
+				Typ:  attr.Type.Oid,
+			}
+		}
+		te := &Exec{
+			c:          e.c,
+			e:          e.c.e,
+			stmt:       e.stmt,
+			resultCols: cols,
+			u:          r,
+			fill:       e.fillTemp(attrs),
+		}
+		if te.scope, err = te.compileScope(t.Query); err != nil {
+			return err
+		}
+		e.temps = append(e.temps, te)
+	}
+	return nil
+}
+
+// fillTemp returns the writer of the result of a query into a temporary
+// table whose columns are attrs, the rows of a batch are written once for
+// every time they occur.
+func (e *Exec) fillTemp(attrs []string) func(interface{}, *batch.Batch) error {
+	return func(u interface{}, bat *batch.Batch) error {
+		m := e.c.proc.Mp
+		rbat := batch.New(true, attrs)
+		defer func() {
+			for _, vec := range rbat.Vecs {
+				if vec != nil {
+					vector.Free(vec, m)
+				}
+			}
+		}()
+		for i, vec := range bat.Vecs {
+			rbat.Vecs[i] = vector.New(vec.Typ)
+			for j, z := range bat.Zs {
+				sel := int64(j)
+				if len(bat.Sels) > 0 {
+					sel = bat.Sels[j]
+				}
+				for ; z > 0; z-- {
+					if err := vector.UnionOne(rbat.Vecs[i], vec, sel, m); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if len(rbat.Vecs) == 0 || vector.Length(rbat.Vecs[0]) == 0 {
+			return nil
+		}
+		return u.(engine.Relation).Write(0, rbat)
+	}
+}
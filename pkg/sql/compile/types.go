@@ -15,8 +15,12 @@
 package compile
 
 import (
+	"context"
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
@@ -33,14 +37,20 @@ const (
 	CreateDatabase
 	CreateTable
 	CreateIndex
+	CreateView
+	AlterTable
+	RenameTable
 	DropDatabase
 	DropTable
 	DropIndex
+	DropView
 	ShowDatabases
 	ShowTables
 	ShowColumns
 	ShowCreateTable
+	ShowCreateView
 	ShowCreateDatabase
+	Delete
 )
 
 var Address string
@@ -100,6 +110,15 @@ type Exec struct {
 	//fill is a result writer runs a callback function.
 	//fill will be called when result data is ready.
 	fill func(interface{}, *batch.Batch) error
+	//temps fill the temporary tables of the query in order before it runs.
+	temps []*Exec
+	//mu protects ctx, cancel and cancelled, Cancel is called by other goroutines.
+	mu sync.Mutex
+	//ctx is the context of all the scopes of the exec, created by Compile.
+	ctx    context.Context
+	cancel context.CancelFunc
+	//cancelled is set if Cancel is called before Compile.
+	cancelled bool
 }
 
 // compile contains all the information needed for compilation.
@@ -114,4 +133,8 @@ type compile struct {
 	e engine.Engine
 	// proc stores the execution context.
 	proc *process.Process
+	// pc checks the privileges of the user, nil if the user holds all privileges.
+	pc plan.PrivilegeChecker
+	// dt the dialect of the sql.
+	dt dialect.DialectType
 }
@@ -20,6 +20,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 const (
@@ -28,8 +31,89 @@ const (
 
 func constructViews(bats []*batch.Batch, fvars []string) {
 	for i, fvar := range fvars {
-		constructView(bats[i], fvar)
+		if bats[i] != nil {
+			constructView(bats[i], fvar)
+		}
+	}
+}
+
+// mergeView appends the groups of the batch to the view, the batch is freed
+func mergeView(view, bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	if view == nil {
+		return bat, nil
+	}
+	defer batch.Clean(bat, proc.Mp)
+	n := int64(len(view.Zs))
+	for i, vec := range view.Vecs {
+		for j := range bat.Zs {
+			if err := vector.UnionOne(vec, bat.Vecs[i], int64(j), proc.Mp); err != nil {
+				return view, err
+			}
+		}
+	}
+	for i, r := range view.Rs {
+		if err := r.Grows(len(bat.Zs), proc.Mp); err != nil {
+			return view, err
+		}
+		for j := range bat.Zs {
+			r.Add(bat.Rs[i], n+int64(j), int64(j))
+		}
+	}
+	view.Zs = append(view.Zs, bat.Zs...)
+	// the hash table of the view is built again by constructView
+	view.Ht = nil
+	return view, nil
+}
+
+/*
+spillViews writes the largest view into the partitions of the temporary
+files by its key when the views exceed the memory limitation, the batch of
+the view is freed and set to nil. It returns the index of the view spilled
+and the partitions, the partitions are nil if no view is spilled.
+*/
+func spillViews(bats []*batch.Batch, fvars []string, proc *process.Process) (int, []*spill.File, error) {
+	var size, max int64
+
+	idx := -1
+	for i, bat := range bats {
+		n := spill.Size(bat)
+		if size += n; n > max {
+			idx, max = i, n
+		}
+	}
+	if idx < 0 || !spill.Full(proc, size) {
+		return -1, nil, nil
+	}
+	bat := bats[idx]
+	bs, err := spill.PartitionBy(bat, []*vector.Vector{batch.GetVector(bat, fvars[idx])}, spill.Partitions, proc)
+	if err != nil {
+		return -1, nil, err
+	}
+	defer func() {
+		for _, b := range bs {
+			if b != nil {
+				batch.Clean(b, proc.Mp)
+			}
+		}
+	}()
+	parts := make([]*spill.File, len(bs))
+	for i, b := range bs {
+		if b == nil {
+			continue
+		}
+		if parts[i], err = spill.Create(proc); err == nil {
+			if err = parts[i].Write(b); err == nil {
+				err = parts[i].Flush()
+			}
+		}
+		if err != nil {
+			spill.CloseFiles(parts)
+			return -1, nil, err
+		}
 	}
+	batch.Clean(bat, proc.Mp)
+	bats[idx] = nil
+	return idx, parts, nil
 }
 
 func constructView(bat *batch.Batch, fvar string) {
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -77,11 +78,13 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 		Magic:     Merge,
 		PreScopes: []*Scope{s},
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(queryContext(e.c.proc))
 	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Stat = e.c.proc.Stat
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 	rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 		Ctx: ctx,
@@ -141,6 +144,9 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 			Op:  vm.Order,
 			Arg: vt.Order,
 		})
+		// the groups are sent in several batches when they are spilled,
+		// so the sorted batches are merged at a new top scope
+		rs = e.newMergeOrderScope(rs, vt.Order)
 	}
 	if vt.Offset != nil {
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
@@ -169,6 +175,38 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 	return rs, nil
 }
 
+// newMergeOrderScope returns a scope which does merge-order work for the batches of the scope
+func (e *Exec) newMergeOrderScope(s *Scope, arg *order.Argument) *Scope {
+	rs := &Scope{
+		Magic:     Merge,
+		PreScopes: []*Scope{s},
+	}
+	ctx, cancel := context.WithCancel(queryContext(e.c.proc))
+	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = e.c.proc.Id
+	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Stat = e.c.proc.Stat
+	rs.Proc.Ctx = e.c.proc.Ctx
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
+	rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 1),
+	}
+	s.Instructions = append(s.Instructions, vm.Instruction{
+		Op: vm.Connector,
+		Arg: &connector.Argument{
+			Mmu: rs.Proc.Mp.Gm,
+			Reg: rs.Proc.Reg.MergeReceivers[0],
+		},
+	})
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.MergeOrder,
+		Arg: &mergeorder.Argument{Fields: arg.Fs},
+	})
+	return rs
+}
+
 // compileCAQ builds the scope which sql is a query with both aggregate functions and join operators.
 func (e *Exec) compileCAQ(freeVars []string, vs []*vtree.View, varsMap, fvarsMap map[string]int) (*Scope, error) {
 	var ss []*Scope
@@ -243,6 +281,8 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 		s.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		s.Proc.Id = e.c.proc.Id
 		s.Proc.Lim = e.c.proc.Lim
+		s.Proc.Stat = e.c.proc.Stat
+		s.Proc.Ctx = e.c.proc.Ctx
 		ss[i] = &Scope{
 			NodeInfo:  ns[i],
 			PreScopes: append([]*Scope{s}, children...),
@@ -255,6 +295,8 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 		ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = e.c.proc.Id
 		ss[i].Proc.Lim = e.c.proc.Lim
+		ss[i].Proc.Stat = e.c.proc.Stat
+		ss[i].Proc.Ctx = e.c.proc.Ctx
 	}
 	rs := &Scope{
 		PreScopes: ss,
@@ -264,11 +306,13 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 		Op:  vm.Oplus,
 		Arg: &oplus.Argument{Typ: v.Arg.Typ},
 	})
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(queryContext(e.c.proc))
 	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Stat = e.c.proc.Stat
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -414,15 +458,19 @@ func (e *Exec) compileQ(vt *vtree.ViewTree, v *vtree.View) (*Scope, error) {
 		ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = e.c.proc.Id
 		ss[i].Proc.Lim = e.c.proc.Lim
+		ss[i].Proc.Stat = e.c.proc.Stat
+		ss[i].Proc.Ctx = e.c.proc.Ctx
 	}
 
 	// init rs
 	rs.PreScopes = ss
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(queryContext(e.c.proc))
 	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Stat = e.c.proc.Stat
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -544,6 +592,8 @@ func (e *Exec) compileAQ(v *vtree.View) (*Scope, error) {
 		ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = e.c.proc.Id
 		ss[i].Proc.Lim = e.c.proc.Lim
+		ss[i].Proc.Stat = e.c.proc.Stat
+		ss[i].Proc.Ctx = e.c.proc.Ctx
 	}
 	rs := &Scope{
 		PreScopes: ss,
@@ -553,11 +603,13 @@ func (e *Exec) compileAQ(v *vtree.View) (*Scope, error) {
 		Op:  vm.Oplus,
 		Arg: &oplus.Argument{Typ: v.Arg.Typ},
 	})
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(queryContext(e.c.proc))
 	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Stat = e.c.proc.Stat
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -30,10 +30,27 @@ func (b *build) Build(qry *plan.Query) (*FTree, error) {
 			Roots:    buildPath(qry.Rels[0], qry, nil),
 		}, nil
 	}
-	rn, err := selectRoot(qry)
+	rns, err := selectRoots(qry)
 	if err != nil {
 		return nil, err
 	}
+	conds := qry.Conds
+	for i, rn := range rns {
+		// building the tree consumes the join conditions
+		qry.Conds = copyConditions(conds)
+		f, e := b.buildTree(rn, qry)
+		if e == nil {
+			return f, nil
+		}
+		if i == 0 {
+			err = e
+		}
+	}
+	return nil, err
+}
+
+// buildTree builds the tree whose root is the relation rn
+func (b *build) buildTree(rn string, qry *plan.Query) (*FTree, error) {
 	roots, err := buildNodes(qry.RelsMap[rn], qry)
 	if err != nil {
 		return nil, err
@@ -43,5 +60,17 @@ func (b *build) Build(qry *plan.Query) (*FTree, error) {
 		Roots:    roots,
 		FreeVars: qry.FreeAttrs,
 	}
-	return f, f.check(qry)
+	if err := f.check(qry); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func copyConditions(conds []*plan.JoinCondition) []*plan.JoinCondition {
+	rs := make([]*plan.JoinCondition, len(conds))
+	for i, cond := range conds {
+		c := *cond
+		rs[i] = &c
+	}
+	return rs
 }
@@ -19,8 +19,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/statistics"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
 	"log"
 	"testing"
 )
@@ -188,6 +194,51 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestSelectRoot(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	analyze(t, e, "R")
+	analyze(t, e, "S")
+	kases := []struct {
+		query string
+		root  string
+	}{
+		// the relation with more rows after the restrict conditions is probed
+		{"select * from R join S on R.uid = S.uid where R.price < 5", "S"},
+		{"select * from R join S on R.uid = S.uid where S.price < 5", "R"},
+		{"select * from S join R on R.uid = S.uid where R.price < 5", "S"},
+		// the fact table of a star schema is probed and the dimensions are built
+		{"select * from dates join lineorder on lo_orderdate = d_datekey join customer on lo_custkey = c_custkey join supplier on lo_suppkey = s_suppkey", "lineorder"},
+	}
+	for _, kase := range kases {
+		stmts, err := parsers.Parse(dialect.MYSQL, kase.query)
+		require.NoError(t, err)
+		qry, err := plan.New("test", kase.query, e).BuildStatement(stmts[0])
+		require.NoError(t, err)
+		f, err := New().Build(qry.(*plan.Query))
+		require.NoError(t, err)
+		root := f.Roots[len(f.Roots)-1].Root.(*Relation)
+		require.Equal(t, kase.root, root.Rel.Alias, kase.query)
+	}
+}
+
+func analyze(t *testing.T, e engine.Engine, name string) {
+	var attrs []engine.Attribute
+
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	r, err := db.Relation(name)
+	require.NoError(t, err)
+	defer r.Close()
+	for _, def := range r.TableDefs() {
+		if v, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, v.Attr)
+		}
+	}
+	stats, err := statistics.Collect(r, attrs, process.New(mheap.New(guest.New(1<<30, host.New(1<<30)))))
+	require.NoError(t, err)
+	require.NoError(t, r.AddTableDef(0, stats))
+}
+
 func processQuery(query string, e engine.Engine) {
 	stmts, err := parsers.Parse(dialect.MYSQL, query)
 	if err != nil {
@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ftree
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/statistics"
+)
+
+// estimateRows returns the estimated number of rows of the relation after its restrict conditions
+func estimateRows(rel *plan.Relation) float64 {
+	return statistics.Rows(rel.Rows, rel.Stats, rel.RestrictConds)
+}
+
+// rootCost returns the estimated rows which are built into hash tables if
+// the relation is the root of the join, all other relations are built
+func rootCost(rn string, qry *plan.Query) float64 {
+	var cost float64
+
+	for _, name := range qry.Rels {
+		if name != rn {
+			cost += estimateRows(qry.RelsMap[name])
+		}
+	}
+	return cost
+}
+
+// joinFraction estimates the fraction of the rows of R which survive the join condition R.r = S.s
+func joinFraction(cond *plan.JoinCondition, qry *plan.Query) float64 {
+	r, s := qry.RelsMap[cond.R], qry.RelsMap[cond.S]
+	sel := statistics.JoinSelectivity(statistics.Column(r.Stats, cond.Rattr), statistics.Column(s.Stats, cond.Sattr), r.Rows, s.Rows)
+	if f := estimateRows(s) * sel; f < 1 {
+		return f
+	}
+	return 1
+}
+
+// sortConditions orders the join conditions of a relation so that the join
+// which filters the most rows of the relation comes first.
+func sortConditions(conds []*plan.JoinCondition, qry *plan.Query) {
+	fs := make(map[*plan.JoinCondition]float64)
+	for _, cond := range conds {
+		fs[cond] = joinFraction(cond, qry)
+	}
+	sort.SliceStable(conds, func(i, j int) bool {
+		return fs[conds[i]] < fs[conds[j]]
+	})
+}
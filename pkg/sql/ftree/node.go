@@ -16,34 +16,83 @@ package ftree
 
 import (
 	"fmt"
+	"sort"
+
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
-// simply pick one, and do not pursue the best
-func selectRoot(qry *plan.Query) (string, error) {
-	var cnt int
-	var rel string
+// selectRoots returns the relations which can be the root of the join, the
+// root is the probe side of the join and the others are built into hash tables.
+// The relations are ordered by the depth of the tree rooted at them, because
+// only the views joined to the root directly can be executed, then by the
+// estimated rows of their build sides and then by the number of their join conditions.
+func selectRoots(qry *plan.Query) ([]string, error) {
+	var rns []string
 
 	if len(qry.Conds) == 0 {
-		return "", errors.New(errno.SQLStatementNotYetComplete, "Product not support now")
+		return nil, errors.New(errno.SQLStatementNotYetComplete, "Product not support now")
 	}
 	mp := make(map[string]int)
 	for _, cond := range qry.Conds {
 		if cond.R == cond.S {
-			return "", errors.New(errno.SQLStatementNotYetComplete, "Self join not support now")
+			return nil, errors.New(errno.SQLStatementNotYetComplete, "Self join not support now")
 		}
 		mp[cond.R]++
 		mp[cond.S]++
 	}
-	for k, v := range mp {
-		if v > cnt {
-			rel = k
-			cnt = v
+	costs := make(map[string]float64)
+	depths := make(map[string]int)
+	for _, rn := range qry.Rels {
+		if _, ok := mp[rn]; ok {
+			rns = append(rns, rn)
+			costs[rn] = rootCost(rn, qry)
+			depths[rn] = joinDepth(rn, qry)
+		}
+	}
+	sort.SliceStable(rns, func(i, j int) bool {
+		if depths[rns[i]] != depths[rns[j]] {
+			return depths[rns[i]] < depths[rns[j]]
+		}
+		if costs[rns[i]] != costs[rns[j]] {
+			return costs[rns[i]] < costs[rns[j]]
+		}
+		return mp[rns[i]] > mp[rns[j]]
+	})
+	return rns, nil
+}
+
+// joinDepth returns the number of joins between the relation and the
+// relation farthest from it
+func joinDepth(rn string, qry *plan.Query) int {
+	var depth int
+
+	mp := map[string]int{rn: 0}
+	queue := []string{rn}
+	for len(queue) > 0 {
+		r := queue[0]
+		queue = queue[1:]
+		for _, cond := range qry.Conds {
+			var s string
+			switch r {
+			case cond.R:
+				s = cond.S
+			case cond.S:
+				s = cond.R
+			default:
+				continue
+			}
+			if _, ok := mp[s]; !ok {
+				mp[s] = mp[r] + 1
+				if mp[s] > depth {
+					depth = mp[s]
+				}
+				queue = append(queue, s)
+			}
 		}
 	}
-	return rel, nil
+	return depth
 }
 
 func buildNodes(rel *plan.Relation, qry *plan.Query) ([]*FNode, error) {
@@ -51,6 +100,7 @@ func buildNodes(rel *plan.Relation, qry *plan.Query) ([]*FNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	sortConditions(conds, qry)
 	fs := buildPath(rel.Alias, qry, conds)
 	if len(conds) > 0 {
 		for i := range fs {
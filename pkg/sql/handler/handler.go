@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -40,7 +41,11 @@ func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) err
 	if err != nil {
 		return err
 	}
+	// the statistic is sent back with the end of the scope, so the rows
+	// examined at the remote node count in the statement
+	stat := &process.Statistic{}
 	s := recoverScope(ps, hp.proc)
+	setStatistic(s, stat)
 	s.Instructions[len(s.Instructions)-1] = vm.Instruction{
 		Op: vm.Output,
 		Arg: &output.Argument{
@@ -51,7 +56,17 @@ func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) err
 	if err := s.ParallelRun(hp.engine); err != nil {
 		conn.WriteAndFlush(&message.Message{Code: []byte(err.Error())})
 	}
-	return conn.WriteAndFlush(&message.Message{Sid: 1})
+	var buf bytes.Buffer
+	protocol.EncodeStatistic(stat, &buf)
+	return conn.WriteAndFlush(&message.Message{Sid: 1, Data: buf.Bytes()})
+}
+
+// setStatistic sets the statistic of the processes of the scope and its pre-scopes
+func setStatistic(s *compile.Scope, stat *process.Statistic) {
+	s.Proc.Stat = stat
+	for _, ps := range s.PreScopes {
+		setStatistic(ps, stat)
+	}
 }
 
 func writeBack(u interface{}, bat *batch.Batch) error {
@@ -321,104 +321,113 @@ const MAX_QUERIES_PER_HOUR = 57644
 const MAX_UPDATES_PER_HOUR = 57645
 const MAX_CONNECTIONS_PER_HOUR = 57646
 const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const CONNECTION = 57649
-const LOAD = 57650
-const INFILE = 57651
-const TERMINATED = 57652
-const OPTIONALLY = 57653
-const ENCLOSED = 57654
-const ESCAPED = 57655
-const STARTING = 57656
-const LINES = 57657
-const DATABASES = 57658
-const TABLES = 57659
-const EXTENDED = 57660
-const FULL = 57661
-const PROCESSLIST = 57662
-const FIELDS = 57663
-const COLUMNS = 57664
-const OPEN = 57665
-const ERRORS = 57666
-const WARNINGS = 57667
-const INDEXES = 57668
-const NAMES = 57669
-const GLOBAL = 57670
-const SESSION = 57671
-const ISOLATION = 57672
-const LEVEL = 57673
-const READ = 57674
-const WRITE = 57675
-const ONLY = 57676
-const REPEATABLE = 57677
-const COMMITTED = 57678
-const UNCOMMITTED = 57679
-const SERIALIZABLE = 57680
-const LOCAL = 57681
-const EXCEPT = 57682
-const CURRENT_TIMESTAMP = 57683
-const DATABASE = 57684
-const CURRENT_TIME = 57685
-const LOCALTIME = 57686
-const LOCALTIMESTAMP = 57687
-const UTC_DATE = 57688
-const UTC_TIME = 57689
-const UTC_TIMESTAMP = 57690
-const REPLACE = 57691
-const CONVERT = 57692
-const SEPARATOR = 57693
-const CURRENT_DATE = 57694
-const CURRENT_USER = 57695
-const CURRENT_ROLE = 57696
-const MATCH = 57697
-const AGAINST = 57698
-const BOOLEAN = 57699
-const LANGUAGE = 57700
-const WITH = 57701
-const QUERY = 57702
-const EXPANSION = 57703
-const ADDDATE = 57704
-const BIT_AND = 57705
-const BIT_OR = 57706
-const BIT_XOR = 57707
-const CAST = 57708
-const COUNT = 57709
-const APPROX_COUNT_DISTINCT = 57710
-const APPROX_PERCENTILE = 57711
-const CURDATE = 57712
-const CURTIME = 57713
-const DATE_ADD = 57714
-const DATE_SUB = 57715
-const EXTRACT = 57716
-const GROUP_CONCAT = 57717
-const MAX = 57718
-const MID = 57719
-const MIN = 57720
-const NOW = 57721
-const POSITION = 57722
-const SESSION_USER = 57723
-const STD = 57724
-const STDDEV = 57725
-const STDDEV_POP = 57726
-const STDDEV_SAMP = 57727
-const SUBDATE = 57728
-const SUBSTR = 57729
-const SUBSTRING = 57730
-const SUM = 57731
-const SYSDATE = 57732
-const SYSTEM_USER = 57733
-const TRANSLATE = 57734
-const TRIM = 57735
-const VARIANCE = 57736
-const VAR_POP = 57737
-const VAR_SAMP = 57738
-const AVG = 57739
-const ROW = 57740
-const OUTFILE = 57741
-const HEADER = 57742
-const MAX_FILE_SIZE = 57743
-const FORCE_QUOTE = 57744
-const UNUSED = 57745
+const MAX_EXECUTION_TIME = 57648
+const QUERY_MEMORY_LIMIT = 57649
+const FORMAT = 57650
+const CONNECTION = 57651
+const LOAD = 57652
+const INFILE = 57653
+const TERMINATED = 57654
+const OPTIONALLY = 57655
+const ENCLOSED = 57656
+const ESCAPED = 57657
+const STARTING = 57658
+const LINES = 57659
+const DATABASES = 57660
+const TABLES = 57661
+const EXTENDED = 57662
+const FULL = 57663
+const PROCESSLIST = 57664
+const FIELDS = 57665
+const COLUMNS = 57666
+const OPEN = 57667
+const ERRORS = 57668
+const WARNINGS = 57669
+const INDEXES = 57670
+const GRANTS = 57671
+const NAMES = 57672
+const GLOBAL = 57673
+const SESSION = 57674
+const ISOLATION = 57675
+const LEVEL = 57676
+const READ = 57677
+const WRITE = 57678
+const ONLY = 57679
+const REPEATABLE = 57680
+const COMMITTED = 57681
+const UNCOMMITTED = 57682
+const SERIALIZABLE = 57683
+const LOCAL = 57684
+const EXCEPT = 57685
+const CURRENT_TIMESTAMP = 57686
+const DATABASE = 57687
+const CURRENT_TIME = 57688
+const LOCALTIME = 57689
+const LOCALTIMESTAMP = 57690
+const UTC_DATE = 57691
+const UTC_TIME = 57692
+const UTC_TIMESTAMP = 57693
+const REPLACE = 57694
+const CONVERT = 57695
+const SEPARATOR = 57696
+const CURRENT_DATE = 57697
+const CURRENT_USER = 57698
+const CURRENT_ROLE = 57699
+const MATCH = 57700
+const AGAINST = 57701
+const BOOLEAN = 57702
+const LANGUAGE = 57703
+const WITH = 57704
+const QUERY = 57705
+const EXPANSION = 57706
+const ADDDATE = 57707
+const BIT_AND = 57708
+const BIT_OR = 57709
+const BIT_XOR = 57710
+const CAST = 57711
+const COUNT = 57712
+const APPROX_COUNT_DISTINCT = 57713
+const APPROX_PERCENTILE = 57714
+const CURDATE = 57715
+const CURTIME = 57716
+const DATE_ADD = 57717
+const DATE_SUB = 57718
+const EXTRACT = 57719
+const GROUP_CONCAT = 57720
+const MAX = 57721
+const MID = 57722
+const MIN = 57723
+const NOW = 57724
+const POSITION = 57725
+const SESSION_USER = 57726
+const STD = 57727
+const STDDEV = 57728
+const STDDEV_POP = 57729
+const STDDEV_SAMP = 57730
+const SUBDATE = 57731
+const SUBSTR = 57732
+const SUBSTRING = 57733
+const SUM = 57734
+const SYSDATE = 57735
+const SYSTEM_USER = 57736
+const TRANSLATE = 57737
+const TRIM = 57738
+const VARIANCE = 57739
+const VAR_POP = 57740
+const VAR_SAMP = 57741
+const AVG = 57742
+const ROW = 57743
+const OUTFILE = 57744
+const HEADER = 57745
+const MAX_FILE_SIZE = 57746
+const FORCE_QUOTE = 57747
+const BACKUP = 57748
+const RESTORE = 57749
+const KILL = 57750
+const ADMIN = 57751
+const ROTATE = 57752
+const TTL = 57753
+const UNUSED = 57754
 
 var yyToknames = [...]string{
 	"$end",
@@ -743,6 +752,8 @@ var yyToknames = [...]string{
 	"MAX_UPDATES_PER_HOUR",
 	"MAX_CONNECTIONS_PER_HOUR",
 	"MAX_USER_CONNECTIONS",
+	"MAX_EXECUTION_TIME",
+	"QUERY_MEMORY_LIMIT",
 	"FORMAT",
 	"CONNECTION",
 	"LOAD",
@@ -764,6 +775,7 @@ var yyToknames = [...]string{
 	"ERRORS",
 	"WARNINGS",
 	"INDEXES",
+	"GRANTS",
 	"NAMES",
 	"GLOBAL",
 	"SESSION",
@@ -840,6 +852,12 @@ var yyToknames = [...]string{
 	"HEADER",
 	"MAX_FILE_SIZE",
 	"FORCE_QUOTE",
+	"BACKUP",
+	"RESTORE",
+	"KILL",
+	"ADMIN",
+	"ROTATE",
+	"TTL",
 	"UNUSED",
 	"';'",
 	"'@'",
@@ -851,1748 +869,2040 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:5946
+//line mysql_sql.y:6270
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 334,
-	-2, 308,
-	-1, 56,
-	185, 471,
-	-2, 507,
-	-1, 65,
-	212, 234,
-	213, 234,
-	-2, 254,
-	-1, 307,
-	58, 1215,
-	422, 1215,
-	-2, 92,
-	-1, 326,
-	58, 634,
-	422, 634,
-	-2, 469,
-	-1, 327,
-	58, 462,
-	422, 462,
-	-2, 470,
-	-1, 334,
-	17, 335,
-	-2, 308,
-	-1, 567,
-	54, 752,
-	-2, 1256,
-	-1, 568,
-	54, 753,
-	-2, 1257,
-	-1, 569,
-	54, 754,
-	-2, 1258,
-	-1, 576,
-	54, 811,
-	-2, 1220,
-	-1, 577,
-	54, 813,
-	-2, 1231,
-	-1, 718,
-	1, 497,
-	421, 497,
-	-2, 504,
-	-1, 828,
-	17, 334,
-	-2, 692,
-	-1, 870,
-	119, 935,
-	-2, 933,
-	-1, 872,
-	119, 416,
-	-2, 930,
-	-1, 873,
-	119, 417,
-	-2, 931,
-	-1, 1064,
-	1, 498,
-	421, 498,
-	-2, 504,
-	-1, 1444,
-	1, 544,
-	206, 544,
-	421, 544,
-	-2, 504,
-	-1, 1446,
-	246, 659,
-	-2, 640,
-	-1, 1547,
-	1, 545,
-	206, 545,
-	421, 545,
-	-2, 504,
-	-1, 1575,
-	246, 659,
-	-2, 641,
-	-1, 1949,
-	55, 519,
-	56, 519,
-	-2, 504,
-	-1, 1953,
-	55, 519,
-	56, 519,
-	-2, 504,
-	-1, 1965,
-	55, 523,
-	56, 523,
-	-2, 504,
-	-1, 1968,
-	55, 524,
-	56, 524,
-	-2, 504,
+	-1, 64,
+	17, 389,
+	-2, 354,
+	-1, 70,
+	185, 532,
+	186, 484,
+	-2, 568,
+	-1, 80,
+	212, 277,
+	213, 277,
+	-2, 297,
+	-1, 332,
+	58, 1289,
+	431, 1289,
+	-2, 106,
+	-1, 351,
+	58, 700,
+	431, 700,
+	-2, 530,
+	-1, 352,
+	58, 523,
+	431, 523,
+	-2, 531,
+	-1, 367,
+	17, 390,
+	-2, 354,
+	-1, 629,
+	54, 818,
+	-2, 1331,
+	-1, 630,
+	54, 819,
+	-2, 1332,
+	-1, 631,
+	54, 820,
+	-2, 1333,
+	-1, 638,
+	54, 877,
+	-2, 1295,
+	-1, 639,
+	54, 879,
+	-2, 1306,
+	-1, 934,
+	1, 558,
+	430, 558,
+	-2, 565,
+	-1, 1056,
+	17, 389,
+	-2, 758,
+	-1, 1098,
+	119, 1001,
+	-2, 999,
+	-1, 1100,
+	119, 471,
+	-2, 996,
+	-1, 1101,
+	119, 472,
+	-2, 997,
+	-1, 1153,
+	1, 559,
+	430, 559,
+	-2, 565,
+	-1, 1350,
+	246, 725,
+	-2, 706,
+	-1, 1529,
+	246, 725,
+	-2, 707,
+	-1, 1654,
+	1, 608,
+	206, 608,
+	430, 608,
+	-2, 565,
+	-1, 1745,
+	1, 609,
+	206, 609,
+	430, 609,
+	-2, 565,
+	-1, 1783,
+	55, 580,
+	56, 580,
+	-2, 565,
+	-1, 1857,
+	55, 580,
+	56, 580,
+	-2, 565,
+	-1, 1997,
+	55, 584,
+	56, 584,
+	-2, 565,
+	-1, 2037,
+	55, 585,
+	56, 585,
+	-2, 565,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16237
+const yyLast = 19110
 
 var yyAct = [...]int{
-	709, 1112, 1955, 1953, 1952, 1960, 1926, 580, 1900, 1544,
-	699, 578, 1113, 1799, 597, 1872, 1915, 1587, 1856, 1773,
-	529, 1857, 1751, 1429, 81, 1710, 495, 283, 768, 1542,
-	1323, 1054, 1702, 1761, 527, 294, 84, 1543, 435, 1609,
-	81, 296, 1682, 1350, 1576, 1439, 385, 1509, 1243, 328,
-	328, 1608, 482, 1510, 1346, 755, 1512, 696, 1317, 80,
-	556, 1521, 1517, 1366, 1351, 1491, 1383, 1218, 1355, 1328,
-	1057, 852, 386, 1382, 1276, 289, 660, 537, 693, 499,
-	81, 1021, 861, 867, 870, 287, 19, 589, 1146, 862,
-	853, 51, 1212, 748, 1551, 1065, 712, 335, 668, 694,
-	1111, 549, 752, 579, 334, 725, 303, 303, 1114, 723,
-	1035, 724, 1027, 278, 281, 410, 770, 437, 520, 801,
-	685, 298, 378, 333, 77, 300, 423, 1042, 1538, 299,
-	1425, 452, 1322, 478, 855, 75, 1318, 1195, 1038, 506,
-	290, 1213, 1340, 1791, 379, 1816, 347, 1202, 502, 742,
-	472, 355, 400, 399, 737, 738, 494, 1844, 1052, 493,
-	496, 497, 1842, 19, 365, 395, 507, 496, 497, 727,
-	392, 330, 702, 1860, 1861, 538, 467, 394, 1876, 463,
-	1700, 1208, 398, 1703, 1704, 1705, 1706, 1209, 1781, 1210,
-	1784, 1541, 504, 1324, 606, 52, 706, 1329, 1330, 1331,
-	1332, 1181, 1367, 415, 1221, 1219, 1216, 1220, 1222, 749,
-	1215, 1214, 1221, 1219, 1038, 1220, 1222, 1384, 1370, 1040,
-	366, 52, 458, 1681, 1596, 1595, 454, 465, 466, 1592,
-	1535, 1333, 464, 1422, 453, 1693, 686, 1504, 1839, 1503,
-	1394, 1392, 1393, 1846, 1500, 1389, 1687, 1388, 1387, 1385,
-	459, 1945, 1961, 349, 1369, 1882, 779, 780, 778, 1841,
-	1801, 1889, 688, 346, 345, 1859, 1797, 1798, 396, 1801,
-	1356, 1359, 52, 1775, 1824, 1790, 397, 1676, 81, 414,
-	1224, 1225, 1226, 1227, 341, 1936, 1645, 1918, 413, 81,
-	1762, 1763, 1764, 1766, 1765, 1667, 516, 1644, 332, 1848,
-	1849, 1386, 1807, 492, 491, 1962, 461, 1736, 1956, 483,
-	1927, 1633, 409, 1277, 505, 439, 1779, 1199, 1088, 1046,
-	1359, 485, 456, 503, 419, 1203, 462, 401, 1501, 1423,
-	487, 440, 1671, 1241, 457, 460, 687, 1793, 1794, 288,
-	389, 1519, 1518, 370, 455, 1086, 1085, 1084, 510, 740,
-	449, 508, 509, 412, 741, 1083, 739, 367, 368, 441,
-	442, 443, 530, 1940, 1904, 1320, 1251, 1193, 350, 1192,
-	1180, 1174, 1078, 1050, 1020, 328, 762, 783, 340, 1360,
-	1639, 386, 386, 386, 1353, 813, 1919, 444, 1354, 1357,
-	662, 534, 372, 371, 484, 418, 486, 417, 411, 1116,
-	1115, 1310, 500, 552, 362, 389, 1390, 1391, 1922, 1913,
-	1341, 1811, 659, 391, 1176, 551, 1232, 1090, 531, 665,
-	532, 414, 81, 81, 81, 81, 1312, 1847, 1360, 348,
-	669, 489, 303, 521, 441, 442, 443, 1441, 1025, 1774,
-	1358, 496, 497, 416, 522, 1230, 496, 497, 1161, 328,
-	328, 414, 328, 439, 715, 1792, 778, 439, 473, 1318,
-	700, 1221, 1219, 488, 1220, 1222, 519, 750, 1678, 440,
-	328, 328, 476, 440, 1059, 683, 1311, 52, 391, 1502,
-	1499, 1232, 469, 328, 1037, 328, 1121, 718, 708, 81,
-	445, 1041, 713, 1442, 655, 451, 515, 1196, 1916, 1917,
-	1669, 1677, 526, 732, 1668, 328, 717, 303, 498, 701,
-	501, 474, 1737, 1739, 1740, 1741, 1738, 328, 386, 490,
-	328, 1672, 1673, 1258, 720, 523, 524, 525, 730, 539,
-	1108, 477, 1495, 756, 1036, 763, 518, 780, 778, 756,
-	719, 1109, 303, 3, 328, 328, 767, 81, 704, 359,
-	682, 1490, 781, 1662, 336, 1231, 733, 360, 681, 1252,
-	1935, 714, 670, 671, 672, 673, 784, 1951, 771, 1747,
-	705, 721, 722, 1932, 303, 698, 728, 689, 779, 780,
-	778, 1883, 769, 734, 772, 830, 286, 12, 540, 703,
-	543, 544, 545, 546, 547, 533, 829, 369, 707, 1879,
-	1933, 1934, 303, 52, 729, 1746, 716, 726, 821, 822,
-	814, 815, 816, 817, 818, 819, 820, 813, 837, 751,
-	1829, 407, 765, 441, 442, 443, 530, 393, 1777, 761,
-	814, 815, 816, 817, 818, 819, 820, 813, 746, 1776,
-	747, 528, 758, 759, 760, 812, 811, 821, 822, 814,
-	815, 816, 817, 818, 819, 820, 813, 859, 859, 864,
-	1753, 764, 1853, 1049, 12, 1153, 766, 1022, 373, 441,
-	442, 443, 530, 1731, 866, 395, 1730, 1430, 835, 1151,
-	1152, 1150, 531, 872, 779, 780, 778, 1713, 831, 832,
-	833, 834, 807, 816, 817, 818, 819, 820, 813, 873,
-	1048, 779, 780, 778, 1729, 357, 1745, 358, 365, 779,
-	780, 778, 356, 354, 353, 361, 1726, 363, 364, 1720,
-	81, 850, 1717, 779, 780, 778, 1716, 283, 531, 842,
-	1692, 284, 6, 1743, 1080, 1055, 1056, 313, 1733, 312,
-	316, 308, 1744, 328, 1023, 771, 858, 1124, 395, 1623,
-	1622, 304, 779, 780, 778, 1068, 1126, 1621, 865, 1620,
-	1617, 772, 323, 328, 1539, 394, 285, 5, 1435, 1742,
-	1434, 756, 756, 756, 1732, 552, 1433, 81, 828, 1019,
-	871, 1432, 1305, 1105, 1106, 1032, 663, 551, 779, 780,
-	778, 1102, 1103, 1104, 1413, 1877, 1852, 1069, 1070, 1071,
-	303, 1122, 1123, 1072, 1752, 1081, 441, 442, 443, 6,
-	1119, 1838, 1818, 1066, 1045, 1805, 779, 780, 778, 1804,
-	1095, 1734, 1727, 1134, 1135, 1136, 1137, 1138, 1139, 1140,
-	1141, 1142, 1143, 1144, 1145, 1074, 1077, 1076, 1155, 1156,
-	1075, 1073, 726, 1723, 5, 1164, 1722, 1110, 1721, 1159,
-	1098, 396, 1101, 1281, 1683, 1664, 1280, 1244, 850, 52,
-	1166, 1091, 1092, 1093, 1087, 1467, 824, 1540, 827, 1443,
-	787, 788, 789, 790, 791, 792, 1099, 785, 1408, 779,
-	780, 778, 825, 826, 823, 1428, 812, 811, 821, 822,
-	814, 815, 816, 817, 818, 819, 820, 813, 1402, 1426,
-	779, 780, 778, 1401, 1117, 1118, 1338, 1120, 306, 305,
-	309, 1148, 1127, 1128, 1129, 1130, 311, 1131, 1132, 1133,
-	779, 780, 778, 1400, 1337, 779, 780, 778, 315, 1154,
-	1399, 1336, 1335, 1398, 1921, 1047, 846, 1965, 845, 1162,
-	1397, 844, 690, 1179, 1943, 779, 780, 778, 1165, 710,
-	1167, 1455, 779, 780, 778, 779, 780, 778, 664, 1826,
-	1168, 1825, 779, 780, 778, 1812, 1474, 1478, 1480, 1482,
-	1484, 1485, 1487, 1695, 1394, 1392, 1393, 1694, 1396, 1469,
-	1470, 1471, 1472, 1453, 1454, 1475, 1529, 1456, 1528, 1457,
-	1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1473,
-	779, 780, 778, 1254, 1970, 1964, 1963, 1477, 1479, 1481,
-	1483, 1486, 1284, 1044, 1946, 1254, 1283, 1579, 310, 314,
-	691, 1182, 318, 692, 1527, 414, 320, 321, 322, 1942,
-	1941, 324, 325, 1381, 669, 1468, 339, 1508, 1187, 328,
-	1444, 1188, 328, 1414, 1190, 414, 338, 328, 1380, 1044,
-	1930, 1206, 1582, 1379, 1198, 779, 780, 778, 1577, 1157,
-	1371, 1204, 1205, 1287, 1590, 1591, 713, 1044, 1929, 1578,
-	779, 780, 778, 1903, 1902, 779, 780, 778, 76, 1238,
-	1285, 779, 780, 778, 1629, 1867, 1282, 542, 76, 328,
-	23, 39, 24, 1629, 1862, 1097, 1850, 81, 81, 1197,
-	1263, 1018, 657, 1583, 76, 654, 23, 39, 24, 1629,
-	1822, 1629, 1821, 1229, 1629, 1820, 1629, 1819, 1810, 1809,
-	1260, 1185, 1259, 1186, 1253, 1200, 656, 1255, 394, 1240,
-	1256, 1257, 1246, 1247, 1788, 1787, 73, 1163, 1194, 661,
-	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1758, 1759, 1234,
-	1211, 1271, 73, 684, 1235, 541, 1236, 1758, 1757, 1066,
-	1228, 1698, 1697, 1696, 1274, 1275, 468, 1242, 1629, 1628,
-	447, 1279, 776, 859, 1239, 1297, 859, 76, 1589, 1300,
-	1352, 1288, 1024, 756, 1245, 1306, 448, 1237, 1254, 756,
-	1022, 446, 328, 1184, 1417, 447, 328, 328, 1254, 1403,
-	328, 1303, 1254, 1395, 1293, 1585, 1254, 1262, 1169, 1476,
-	1254, 1261, 1184, 1183, 1178, 1177, 774, 1304, 1172, 1171,
-	1044, 1043, 1445, 1038, 81, 73, 1292, 1584, 1586, 1415,
-	449, 1250, 1299, 449, 414, 1175, 1158, 1097, 1148, 1272,
-	1053, 395, 76, 1349, 517, 1296, 1966, 1912, 1906, 1890,
-	1887, 81, 1376, 1313, 1315, 1273, 1885, 1294, 1298, 1339,
-	1289, 1828, 1295, 1307, 1301, 1302, 1771, 1378, 1756, 1754,
-	1309, 1308, 1749, 1690, 1689, 1688, 1685, 1675, 1316, 1592,
-	1686, 1660, 1910, 1511, 1626, 1334, 1603, 1602, 1513, 1522,
-	73, 1580, 1524, 1496, 1437, 1149, 1233, 1189, 1412, 812,
-	811, 821, 822, 814, 815, 816, 817, 818, 819, 820,
-	813, 1170, 1361, 1362, 1089, 328, 1410, 1363, 1082, 1411,
-	851, 1376, 849, 848, 847, 843, 1375, 812, 811, 821,
-	822, 814, 815, 816, 817, 818, 819, 820, 813, 1407,
-	802, 840, 838, 836, 828, 1571, 73, 810, 1404, 809,
-	808, 806, 805, 1489, 1409, 425, 428, 429, 430, 426,
-	661, 427, 431, 1440, 804, 1416, 52, 803, 297, 1067,
-	800, 799, 1418, 1342, 1343, 1438, 1507, 798, 797, 796,
-	795, 1406, 794, 793, 666, 658, 1506, 1421, 450, 425,
-	428, 429, 430, 426, 1954, 427, 431, 1431, 1028, 1029,
-	1895, 1436, 1062, 1893, 1553, 1858, 1223, 1096, 1493, 1031,
-	470, 1034, 678, 1033, 676, 1488, 1452, 679, 329, 677,
-	328, 328, 1494, 1492, 81, 1492, 675, 674, 756, 1498,
-	680, 1950, 429, 430, 1173, 1514, 1515, 1516, 414, 1869,
-	1497, 535, 536, 1067, 1055, 1056, 414, 1548, 339, 1319,
-	420, 337, 1060, 1419, 1520, 1349, 1525, 736, 338, 1536,
-	1420, 425, 428, 429, 430, 426, 1526, 427, 431, 433,
-	337, 1531, 403, 405, 406, 1116, 1115, 1532, 1533, 475,
-	1534, 480, 481, 1907, 1833, 1831, 1786, 1785, 1783, 338,
-	1593, 1610, 1612, 1714, 1610, 1610, 1627, 1505, 1427, 1597,
-	1573, 1374, 1326, 1600, 1601, 1325, 339, 479, 1599, 1616,
-	1598, 1373, 1249, 432, 661, 1191, 338, 1604, 1605, 1606,
-	1607, 1897, 1896, 1897, 277, 1557, 1896, 351, 1, 1908,
-	854, 1611, 860, 1750, 1868, 1899, 1561, 1827, 1871, 596,
-	581, 1778, 1207, 1699, 1780, 1613, 1614, 1701, 1615, 1051,
-	1635, 1624, 1201, 471, 1619, 1290, 1550, 1291, 1631, 618,
-	1552, 1554, 1556, 1625, 1558, 1559, 1560, 1562, 1563, 1564,
-	1566, 1567, 1568, 1569, 812, 811, 821, 822, 814, 815,
-	816, 817, 818, 819, 820, 813, 608, 839, 609, 653,
-	404, 1663, 1630, 81, 607, 1618, 1572, 1368, 1638, 344,
-	402, 352, 1680, 1321, 1440, 811, 821, 822, 814, 815,
-	816, 817, 818, 819, 820, 813, 1612, 1594, 1523, 1593,
-	1661, 1125, 1160, 1959, 1949, 1530, 1570, 1708, 1679, 1665,
-	414, 1925, 1905, 1800, 1944, 1840, 1888, 1715, 1881, 1796,
-	1632, 301, 743, 1549, 511, 376, 1684, 1772, 383, 667,
-	1327, 1709, 1217, 1058, 1039, 1691, 695, 302, 1565, 1748,
-	1789, 1755, 1712, 342, 1555, 1061, 343, 1711, 1064, 439,
-	812, 811, 821, 822, 814, 815, 816, 817, 818, 819,
-	820, 813, 1063, 786, 1147, 440, 414, 1728, 841, 414,
-	414, 414, 1636, 1637, 554, 1640, 1641, 1642, 1643, 588,
-	582, 1646, 1647, 1648, 1649, 1650, 1651, 1652, 1653, 1654,
-	1655, 1656, 1657, 1658, 1659, 1760, 1365, 1364, 1768, 1769,
-	1770, 1767, 1588, 731, 26, 434, 777, 868, 83, 1079,
-	869, 1707, 1537, 1873, 1782, 595, 594, 593, 592, 424,
-	422, 421, 293, 292, 1248, 1795, 1372, 773, 775, 1855,
-	81, 1854, 1814, 1802, 1803, 1815, 1424, 414, 1674, 1735,
-	1670, 1666, 1806, 1547, 1546, 1574, 1575, 1813, 1581, 1451,
-	1447, 1449, 414, 1450, 1448, 1446, 1347, 1348, 1808, 1345,
-	1344, 1718, 1719, 1030, 1026, 769, 1817, 1724, 1725, 1836,
-	856, 1405, 863, 408, 711, 78, 291, 1100, 548, 72,
-	11, 1823, 18, 17, 16, 47, 46, 1832, 45, 1834,
-	1835, 1830, 812, 811, 821, 822, 814, 815, 816, 817,
-	818, 819, 820, 813, 1843, 1845, 44, 15, 8, 1875,
-	43, 42, 41, 1851, 14, 13, 37, 36, 35, 34,
-	1278, 33, 32, 1874, 31, 1863, 1864, 1865, 1866, 30,
-	29, 28, 27, 9, 1884, 55, 1886, 1878, 54, 53,
-	1880, 812, 811, 821, 822, 814, 815, 816, 817, 818,
-	819, 820, 813, 1891, 20, 21, 1894, 1901, 1892, 22,
-	61, 60, 59, 58, 57, 1898, 414, 25, 414, 10,
-	7, 4, 2, 0, 0, 700, 1909, 700, 1911, 0,
-	0, 0, 1914, 0, 1875, 1924, 0, 0, 0, 0,
-	0, 0, 0, 414, 1920, 0, 0, 0, 1874, 1923,
-	0, 1928, 700, 1931, 0, 0, 0, 0, 0, 1901,
-	1937, 0, 0, 1837, 0, 1939, 0, 0, 0, 0,
-	0, 1947, 0, 0, 0, 0, 0, 0, 0, 1948,
-	0, 0, 0, 0, 0, 0, 1958, 0, 1957, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1969, 1968,
-	1967, 1958, 986, 972, 0, 934, 988, 906, 922, 996,
-	924, 925, 960, 884, 943, 207, 920, 876, 909, 910,
-	878, 917, 879, 907, 936, 152, 905, 975, 946, 177,
-	994, 179, 0, 0, 236, 192, 0, 0, 939, 977,
-	941, 965, 933, 961, 892, 954, 989, 921, 958, 990,
-	0, 0, 0, 0, 441, 442, 443, 0, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 957, 982, 919,
-	0, 0, 893, 987, 940, 959, 0, 877, 955, 0,
-	882, 885, 995, 980, 914, 915, 0, 0, 0, 0,
-	0, 0, 0, 937, 942, 962, 930, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 911, 0, 950, 0,
-	0, 0, 887, 883, 0, 935, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 984, 985, 146, 272, 886, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 1006,
-	1007, 1008, 1009, 1010, 891, 0, 912, 963, 0, 875,
-	971, 978, 932, 265, 981, 929, 928, 1013, 0, 1012,
-	240, 1014, 1015, 176, 976, 908, 918, 913, 916, 226,
-	209, 983, 949, 214, 224, 180, 251, 218, 256, 242,
-	264, 966, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 1011, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 874, 260, 0, 205, 973, 880, 890,
-	888, 926, 951, 952, 953, 998, 968, 970, 969, 997,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	881, 0, 237, 258, 271, 261, 927, 899, 938, 270,
-	902, 900, 967, 901, 956, 999, 196, 197, 198, 199,
-	923, 139, 947, 931, 1000, 1001, 1002, 1003, 1004, 1005,
-	904, 979, 158, 164, 1286, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 898, 903, 897, 944, 945,
-	991, 992, 993, 964, 889, 974, 894, 896, 895, 948,
-	121, 0, 178, 266, 220, 157, 0, 0, 0, 0,
-	812, 811, 821, 822, 814, 815, 816, 817, 818, 819,
-	820, 813, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 0,
-	0, 0, 1016, 1017, 274, 275, 276, 259, 207, 0,
-	0, 0, 0, 0, 590, 0, 0, 0, 152, 757,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 630, 638, 0, 0, 0, 0, 0,
-	0, 753, 0, 0, 583, 0, 0, 555, 620, 619,
-	598, 0, 0, 0, 135, 599, 0, 604, 0, 600,
-	603, 601, 602, 0, 0, 622, 0, 0, 0, 0,
-	0, 553, 587, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 584, 585, 0, 0, 0,
-	0, 615, 0, 586, 0, 0, 754, 0, 605, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 612, 613, 146, 577, 610, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 628,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	611, 0, 226, 209, 641, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 626, 205,
-	640, 621, 623, 624, 627, 631, 632, 633, 634, 635,
-	637, 639, 642, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 576, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 616, 196,
-	197, 198, 199, 629, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 648, 625,
-	647, 649, 650, 646, 651, 652, 636, 591, 0, 644,
-	643, 645, 0, 121, 0, 178, 266, 220, 157, 85,
-	557, 558, 559, 560, 561, 562, 563, 93, 564, 95,
-	96, 97, 98, 565, 100, 566, 102, 103, 104, 567,
-	568, 569, 570, 109, 110, 111, 571, 572, 114, 115,
-	116, 117, 573, 574, 575, 614, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 590, 0, 0, 0, 152, 1938, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	630, 638, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 583, 0, 0, 555, 620, 619, 598, 0, 0,
-	0, 135, 599, 0, 604, 0, 600, 603, 601, 602,
-	0, 0, 622, 0, 0, 0, 0, 0, 553, 587,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 584, 585, 0, 0, 0, 0, 615, 0,
-	586, 0, 0, 617, 0, 605, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 612, 613, 146, 577, 610, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 628, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 611, 0, 226,
-	209, 641, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 626, 205, 640, 621, 623,
-	624, 627, 631, 632, 633, 634, 635, 637, 639, 642,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 576, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 616, 196, 197, 198, 199,
-	629, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 648, 625, 647, 649, 650,
-	646, 651, 652, 636, 591, 0, 644, 643, 645, 0,
-	121, 0, 178, 266, 220, 157, 85, 557, 558, 559,
-	560, 561, 562, 563, 93, 564, 95, 96, 97, 98,
-	565, 100, 566, 102, 103, 104, 567, 568, 569, 570,
-	109, 110, 111, 571, 572, 114, 115, 116, 117, 573,
-	574, 575, 614, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 207, 0, 0, 0, 0, 0, 590, 0,
-	0, 0, 152, 757, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 630, 638, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 583, 0,
-	0, 555, 620, 619, 598, 0, 0, 0, 135, 599,
-	0, 604, 0, 600, 603, 601, 602, 0, 0, 622,
-	0, 0, 0, 0, 0, 553, 587, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 584,
-	585, 0, 0, 0, 0, 615, 0, 586, 0, 0,
-	617, 0, 605, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 612, 613,
-	146, 577, 610, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 628, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 611, 0, 226, 209, 641, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 626, 205, 640, 621, 623, 624, 627, 631,
-	632, 633, 634, 635, 637, 639, 642, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 576, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 616, 196, 197, 198, 199, 629, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 648, 625, 647, 649, 650, 646, 651, 652,
-	636, 591, 0, 644, 643, 645, 0, 121, 0, 178,
-	266, 220, 157, 85, 557, 558, 559, 560, 561, 562,
-	563, 93, 564, 95, 96, 97, 98, 565, 100, 566,
-	102, 103, 104, 567, 568, 569, 570, 109, 110, 111,
-	571, 572, 114, 115, 116, 117, 573, 574, 575, 0,
-	0, 274, 275, 276, 259, 76, 0, 614, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 207, 0, 0,
-	0, 0, 0, 590, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 630, 638, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 583, 0, 0, 555, 620, 619, 598,
-	0, 0, 0, 135, 599, 0, 604, 0, 600, 603,
-	601, 602, 0, 0, 622, 0, 0, 0, 0, 0,
-	553, 587, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 584, 585, 0, 0, 0, 0,
-	615, 0, 586, 0, 0, 617, 0, 605, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 612, 613, 146, 577, 610, 263, 130,
-	131, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 628, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 611,
-	0, 226, 209, 641, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 626, 205, 640,
-	621, 623, 624, 627, 631, 632, 633, 634, 635, 637,
-	639, 642, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 576, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 616, 196, 197,
-	198, 199, 629, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 202, 169, 234, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 185, 160, 648, 625, 647,
-	649, 650, 646, 651, 652, 636, 591, 0, 644, 643,
-	645, 0, 121, 0, 178, 266, 220, 157, 85, 557,
-	558, 559, 560, 561, 562, 563, 93, 564, 95, 96,
-	97, 98, 565, 100, 566, 102, 103, 104, 567, 568,
-	569, 570, 109, 110, 111, 571, 572, 114, 115, 116,
-	117, 573, 574, 575, 614, 0, 274, 275, 276, 259,
-	0, 0, 0, 0, 207, 0, 0, 0, 0, 0,
-	590, 0, 0, 0, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 630,
-	638, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	583, 0, 0, 555, 620, 619, 598, 0, 0, 0,
-	135, 599, 0, 604, 0, 600, 603, 601, 602, 0,
-	0, 622, 0, 0, 0, 0, 0, 553, 587, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 584, 585, 550, 0, 0, 0, 615, 0, 586,
-	0, 0, 617, 0, 605, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	612, 613, 146, 577, 610, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 628, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 611, 0, 226, 209,
-	641, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 626, 205, 640, 621, 623, 624,
-	627, 631, 632, 633, 634, 635, 637, 639, 642, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 576, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 616, 196, 197, 198, 199, 629,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 648, 625, 647, 649, 650, 646,
-	651, 652, 636, 591, 0, 644, 643, 645, 0, 121,
-	0, 178, 266, 220, 157, 85, 557, 558, 559, 560,
-	561, 562, 563, 93, 564, 95, 96, 97, 98, 565,
-	100, 566, 102, 103, 104, 567, 568, 569, 570, 109,
-	110, 111, 571, 572, 114, 115, 116, 117, 573, 574,
-	575, 614, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 207, 0, 0, 0, 0, 0, 590, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 630, 638, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 583, 0, 0,
-	555, 620, 619, 598, 0, 0, 0, 135, 599, 0,
-	604, 0, 600, 603, 601, 602, 0, 0, 622, 0,
-	0, 0, 0, 0, 553, 587, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 584, 585,
-	0, 0, 0, 0, 615, 0, 586, 0, 0, 617,
-	0, 605, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 612, 613, 146,
-	577, 610, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 628, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 611, 0, 226, 209, 641, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 626, 205, 640, 621, 623, 624, 627, 631, 632,
-	633, 634, 635, 637, 639, 642, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 576, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 616, 196, 197, 198, 199, 629, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 648, 625, 647, 649, 650, 646, 651, 652, 636,
-	591, 0, 644, 643, 645, 0, 121, 0, 178, 266,
-	220, 157, 85, 557, 558, 559, 560, 561, 562, 563,
-	93, 564, 95, 96, 97, 98, 565, 100, 566, 102,
-	103, 104, 567, 568, 569, 570, 109, 110, 111, 571,
-	572, 114, 115, 116, 117, 573, 574, 575, 614, 0,
-	274, 275, 276, 259, 0, 0, 0, 0, 207, 0,
-	0, 0, 0, 0, 590, 0, 0, 0, 152, 0,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 630, 638, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 583, 0, 0, 555, 620, 619,
-	598, 0, 0, 0, 135, 599, 0, 604, 0, 600,
-	603, 601, 602, 0, 0, 622, 0, 0, 0, 0,
-	0, 0, 587, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 584, 585, 0, 0, 0,
-	0, 615, 0, 586, 0, 0, 617, 0, 605, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 612, 613, 146, 577, 610, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 628,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	611, 0, 226, 209, 641, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 626, 205,
-	640, 621, 623, 624, 627, 631, 632, 633, 634, 635,
-	637, 639, 642, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 576, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 616, 196,
-	197, 198, 199, 629, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 648, 625,
-	647, 649, 650, 646, 651, 652, 636, 591, 0, 644,
-	643, 645, 0, 121, 0, 178, 266, 220, 157, 85,
-	557, 558, 559, 560, 561, 562, 563, 93, 564, 95,
-	96, 97, 98, 565, 100, 566, 102, 103, 104, 567,
-	568, 569, 570, 109, 110, 111, 571, 572, 114, 115,
-	116, 117, 573, 574, 575, 614, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 590, 0, 0, 0, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	630, 638, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 555, 620, 619, 598, 0, 0,
-	0, 135, 599, 0, 604, 0, 600, 603, 601, 602,
-	0, 0, 622, 0, 0, 0, 0, 0, 553, 587,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 584, 585, 0, 0, 0, 0, 615, 0,
-	586, 0, 0, 617, 0, 605, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 612, 613, 146, 577, 610, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 628, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 611, 0, 226,
-	209, 641, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 626, 205, 640, 621, 623,
-	624, 627, 631, 632, 633, 634, 635, 637, 639, 642,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 576, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 616, 196, 197, 198, 199,
-	629, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 648, 625, 647, 649, 650,
-	646, 651, 652, 636, 591, 0, 644, 643, 645, 0,
-	121, 0, 178, 266, 220, 157, 85, 557, 558, 559,
-	560, 561, 562, 563, 93, 564, 95, 96, 97, 98,
-	565, 100, 566, 102, 103, 104, 567, 568, 569, 570,
-	109, 110, 111, 571, 572, 114, 115, 116, 117, 573,
-	574, 575, 0, 0, 274, 275, 276, 259, 313, 0,
-	312, 316, 308, 0, 0, 0, 0, 0, 0, 0,
-	207, 0, 304, 0, 0, 0, 0, 0, 0, 0,
-	152, 0, 0, 323, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
-	0, 0, 327, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 306,
-	305, 309, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 176, 315,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 307, 242, 264, 0, 331, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 310,
-	314, 317, 211, 318, 319, 0, 0, 320, 321, 322,
-	0, 0, 324, 325, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 0, 0, 274,
-	275, 276, 259, 313, 0, 312, 316, 308, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 304, 0, 0,
-	0, 0, 0, 0, 0, 152, 0, 0, 323, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 326, 0, 0, 327, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 306, 305, 309, 0, 0, 0,
-	0, 0, 311, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 315, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 307, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 310, 314, 317, 211, 318, 319,
-	0, 0, 320, 321, 322, 0, 0, 324, 325, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	924, 642, 2078, 909, 640, 1525, 1975, 659, 2051, 1859,
+	1541, 2041, 1742, 1218, 1944, 1857, 1881, 1922, 1806, 551,
+	1951, 587, 1952, 1938, 585, 1142, 919, 1634, 100, 97,
+	1733, 481, 307, 1633, 1741, 1856, 1774, 1405, 424, 1809,
+	1526, 1171, 1740, 1551, 1773, 1508, 319, 1926, 97, 321,
+	536, 1479, 981, 618, 1554, 1716, 1692, 353, 353, 1575,
+	1530, 1649, 1511, 1516, 1659, 1512, 1488, 1146, 1324, 868,
+	1439, 1080, 1592, 1565, 314, 96, 1552, 993, 555, 722,
+	641, 903, 595, 1095, 1081, 1089, 63, 425, 1219, 906,
+	438, 311, 24, 651, 974, 1090, 97, 1252, 1318, 1091,
+	1593, 954, 1749, 1154, 927, 668, 64, 978, 368, 611,
+	367, 878, 302, 904, 1112, 942, 1120, 463, 323, 940,
+	1502, 437, 1217, 518, 483, 305, 1029, 1220, 941, 578,
+	366, 417, 905, 895, 948, 325, 602, 64, 469, 324,
+	91, 93, 453, 1876, 1127, 364, 328, 328, 497, 1804,
+	1732, 531, 373, 1083, 363, 92, 1123, 361, 1480, 418,
+	1300, 393, 92, 564, 28, 47, 29, 1967, 1319, 1866,
+	542, 374, 315, 596, 359, 434, 355, 1307, 358, 558,
+	92, 559, 28, 47, 29, 24, 442, 441, 443, 92,
+	565, 28, 47, 29, 435, 92, 431, 968, 433, 64,
+	79, 517, 1102, 88, 86, 384, 2021, 381, 562, 403,
+	88, 362, 92, 963, 964, 944, 440, 552, 553, 719,
+	912, 550, 716, 48, 549, 552, 553, 512, 88, 508,
+	1955, 1956, 2055, 2019, 1873, 1632, 1734, 88, 1737, 1456,
+	1807, 916, 1287, 718, 1635, 1636, 1637, 1638, 1489, 1490,
+	1491, 1492, 1493, 1494, 458, 1140, 1576, 1579, 1594, 1125,
+	88, 1327, 1325, 1322, 1326, 1328, 1123, 1321, 1320, 975,
+	1327, 1325, 404, 1326, 1328, 1689, 499, 503, 1550, 1549,
+	2094, 1394, 1392, 1393, 510, 511, 1599, 1546, 1598, 1597,
+	1595, 1729, 896, 509, 82, 83, 1629, 84, 85, 1966,
+	498, 1871, 97, 457, 1702, 504, 1706, 2016, 1578, 1850,
+	439, 2112, 386, 97, 97, 456, 1495, 2061, 898, 1945,
+	2018, 2023, 383, 382, 1954, 1977, 1705, 2068, 1994, 1684,
+	1007, 1008, 1006, 1831, 1830, 365, 1927, 1928, 1929, 1931,
+	1930, 485, 1596, 377, 1330, 1331, 1332, 1333, 486, 2076,
+	357, 70, 81, 90, 45, 46, 1314, 560, 1308, 464,
+	465, 1969, 1970, 1940, 444, 1973, 1974, 1983, 1977, 2025,
+	2026, 80, 78, 77, 574, 1679, 455, 501, 507, 506,
+	519, 519, 548, 547, 1946, 1675, 1527, 520, 520, 502,
+	505, 1819, 897, 1861, 1178, 1440, 405, 1998, 452, 500,
+	1961, 1184, 537, 563, 1570, 97, 1304, 490, 1192, 959,
+	956, 958, 64, 955, 353, 1703, 1131, 917, 539, 875,
+	425, 425, 425, 535, 1630, 541, 374, 387, 538, 524,
+	540, 523, 460, 428, 2044, 957, 1403, 376, 313, 312,
+	494, 959, 1347, 958, 1188, 1346, 614, 1600, 1601, 590,
+	568, 561, 1571, 1718, 1717, 721, 1182, 56, 966, 1520,
+	1190, 1189, 873, 57, 566, 567, 967, 457, 97, 97,
+	97, 97, 1187, 1336, 965, 530, 1907, 406, 2109, 879,
+	1480, 409, 1968, 521, 407, 2082, 1482, 1414, 1298, 1297,
+	1286, 385, 552, 553, 353, 353, 457, 353, 526, 1280,
+	485, 58, 1860, 328, 485, 2024, 430, 486, 910, 1338,
+	529, 486, 1167, 1138, 893, 353, 353, 1126, 544, 552,
+	553, 496, 1267, 1301, 97, 97, 1148, 976, 89, 1939,
+	411, 410, 573, 2045, 613, 89, 97, 353, 598, 353,
+	1701, 934, 527, 717, 353, 97, 1327, 1325, 1999, 1326,
+	1328, 921, 584, 89, 1104, 400, 554, 64, 557, 949,
+	949, 933, 89, 353, 1680, 1681, 1704, 1521, 89, 1011,
+	920, 920, 514, 597, 870, 353, 425, 947, 353, 581,
+	582, 583, 929, 1337, 328, 89, 911, 1517, 1520, 592,
+	1677, 937, 935, 989, 1676, 461, 914, 59, 60, 61,
+	62, 1572, 438, 892, 994, 353, 353, 997, 97, 97,
+	454, 951, 1769, 2030, 1009, 988, 891, 923, 930, 1041,
+	1181, 928, 1222, 1221, 1179, 945, 938, 939, 328, 915,
+	908, 519, 899, 1472, 998, 999, 1156, 946, 520, 1474,
+	880, 881, 882, 883, 960, 2042, 2043, 913, 604, 605,
+	606, 607, 608, 609, 920, 920, 1988, 932, 922, 1058,
+	1122, 1858, 428, 1259, 328, 545, 556, 982, 310, 13,
+	3, 1751, 2096, 982, 2092, 1503, 936, 1257, 1258, 1256,
+	943, 1908, 1910, 1911, 1912, 1909, 995, 579, 1987, 1473,
+	1282, 977, 987, 972, 950, 328, 1521, 1194, 580, 577,
+	397, 1514, 1825, 1110, 1012, 1515, 1518, 973, 398, 1227,
+	1121, 984, 985, 986, 591, 308, 6, 309, 5, 1087,
+	1087, 1092, 459, 991, 996, 931, 990, 1059, 1060, 1061,
+	1062, 369, 434, 1000, 1057, 430, 408, 1214, 1338, 1007,
+	1008, 1006, 487, 488, 489, 588, 1063, 1686, 1215, 1685,
+	1052, 1056, 1055, 546, 1008, 1006, 1065, 1519, 1006, 1663,
+	1078, 1035, 13, 1658, 450, 1533, 1053, 1054, 1051, 576,
+	1040, 1039, 1049, 1050, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 1041, 1049, 1050, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 1041, 1755, 525, 487, 488, 489, 588, 1070, 1670,
+	1536, 589, 1415, 1759, 2115, 434, 1531, 2104, 2070, 6,
+	2062, 5, 1544, 1545, 1086, 586, 1918, 1532, 2075, 432,
+	1916, 1230, 412, 1748, 435, 2058, 1914, 1750, 1752, 1754,
+	1232, 1756, 1757, 1758, 1760, 1761, 1762, 1764, 1765, 1766,
+	1767, 2010, 2029, 487, 488, 489, 588, 487, 488, 489,
+	1651, 1537, 1917, 589, 1143, 1144, 1915, 1612, 395, 2074,
+	396, 403, 1913, 1770, 1904, 394, 392, 391, 399, 388,
+	1444, 401, 402, 1443, 1421, 97, 97, 994, 1040, 1039,
+	1049, 1050, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1041,
+	1902, 1901, 1100, 1768, 1923, 2091, 1007, 1008, 1006, 1101,
+	1903, 1900, 589, 1897, 1891, 1888, 1652, 1007, 1008, 1006,
+	1747, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1041, 1887,
+	1877, 464, 1106, 1870, 1869, 1763, 1543, 1798, 1513, 1007,
+	1008, 1006, 1785, 1753, 1696, 1695, 97, 1044, 1045, 1046,
+	1047, 1048, 1041, 1948, 307, 1691, 1690, 1108, 1645, 1884,
+	1098, 1644, 1169, 1539, 1107, 1643, 457, 1175, 1094, 1997,
+	1642, 1641, 1137, 519, 1640, 1007, 1008, 1006, 1174, 353,
+	520, 1007, 1008, 1006, 1468, 1538, 1540, 1093, 64, 433,
+	871, 522, 1157, 1015, 1016, 1017, 1018, 1019, 1020, 353,
+	1013, 1855, 1105, 2015, 1620, 1099, 1981, 1117, 1103, 1136,
+	487, 488, 489, 614, 1668, 97, 1980, 1158, 1159, 1160,
+	1615, 1211, 1212, 1007, 1008, 1006, 1007, 1008, 1006, 1161,
+	1964, 1905, 1007, 1008, 1006, 1898, 1894, 1546, 1185, 1893,
+	1130, 1892, 1007, 1008, 1006, 1771, 1810, 1155, 1879, 1534,
+	1868, 1228, 1229, 1805, 1240, 1241, 1242, 1243, 1244, 1245,
+	1246, 1247, 1248, 1249, 1250, 1251, 1164, 1406, 328, 1261,
+	1262, 1078, 1163, 1166, 1165, 1162, 1145, 1693, 1216, 1672,
+	1653, 1204, 1500, 982, 982, 982, 943, 1609, 1199, 1207,
+	1608, 1272, 1499, 1498, 1607, 1270, 1497, 1191, 322, 1485,
+	1134, 613, 1195, 1196, 1197, 1208, 1209, 1210, 1133, 1007,
+	1008, 1006, 1007, 1008, 1006, 1288, 1007, 1008, 1006, 1132,
+	457, 1074, 1205, 1073, 1225, 1072, 925, 872, 372, 1129,
+	2113, 2073, 879, 1959, 353, 1606, 1958, 353, 371, 1862,
+	457, 1790, 353, 1605, 1260, 1789, 97, 1667, 2110, 1312,
+	1447, 1315, 1303, 1417, 1446, 1254, 354, 1007, 1008, 1006,
+	1723, 1223, 1224, 1265, 1226, 1007, 1008, 1006, 1722, 1233,
+	1234, 1235, 1236, 1721, 1237, 1238, 1239, 1710, 1344, 600,
+	1129, 2102, 1654, 457, 1129, 2101, 1285, 1395, 1092, 1092,
+	1398, 97, 1309, 1621, 1400, 1174, 1604, 2081, 2080, 1268,
+	1417, 2072, 1581, 353, 1335, 2057, 2056, 1580, 1271, 1292,
+	1273, 1450, 1293, 1409, 97, 1295, 1448, 1274, 1007, 1008,
+	1006, 1603, 1445, 1305, 1348, 1426, 1302, 1290, 1423, 433,
+	1416, 1291, 1417, 2040, 1402, 1310, 1311, 1399, 1269, 1591,
+	928, 1340, 894, 1007, 1008, 1006, 1590, 1299, 1815, 2035,
+	1422, 599, 1341, 1589, 1342, 513, 1316, 1135, 2027, 492,
+	1410, 1007, 1008, 1006, 1996, 1995, 1155, 1334, 1007, 1008,
+	1006, 1815, 1992, 1437, 1438, 1007, 1008, 1006, 2095, 1434,
+	1815, 1991, 1345, 1417, 1404, 1356, 1263, 1396, 1397, 1087,
+	1794, 1460, 1087, 1275, 1401, 1463, 1343, 1815, 1990, 1407,
+	1815, 1989, 1408, 1986, 1985, 994, 869, 353, 1007, 1008,
+	1006, 353, 353, 1815, 1957, 353, 64, 1655, 1466, 1418,
+	1815, 1814, 1419, 1420, 1123, 1467, 1796, 1795, 1792, 1793,
+	1792, 1791, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1455,
+	97, 1667, 1666, 1484, 1622, 1462, 1202, 1624, 1436, 1109,
+	457, 1284, 434, 1417, 1610, 1417, 1602, 457, 1175, 1413,
+	1254, 1459, 1174, 1442, 1435, 1417, 1425, 1417, 1424, 1174,
+	1452, 1056, 1004, 1451, 493, 982, 1465, 1461, 494, 1457,
+	1464, 982, 1470, 1469, 1458, 1281, 1501, 2085, 1471, 1202,
+	1289, 1509, 1264, 64, 1284, 1283, 1478, 1278, 1277, 1135,
+	1475, 1477, 1496, 2105, 1202, 1201, 1129, 1128, 491, 1170,
+	1141, 874, 492, 1557, 1558, 601, 1002, 92, 494, 1486,
+	575, 1522, 1523, 1547, 97, 1586, 2069, 1561, 1562, 1563,
+	1564, 2066, 1524, 64, 1039, 1049, 1050, 1042, 1043, 1044,
+	1045, 1046, 1047, 1048, 1041, 1556, 2064, 2009, 1040, 1039,
+	1049, 1050, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1041,
+	1963, 1947, 1936, 1920, 1619, 88, 1853, 1504, 1505, 1852,
+	1851, 869, 1848, 1846, 1553, 1788, 1786, 466, 1569, 1617,
+	1555, 1683, 1618, 1664, 1647, 1566, 1568, 353, 471, 474,
+	475, 476, 472, 1586, 473, 477, 1560, 1559, 1585, 1255,
+	471, 474, 475, 476, 472, 1614, 473, 477, 471, 474,
+	475, 476, 472, 1849, 473, 477, 1588, 1611, 1349, 1339,
+	1294, 1151, 1276, 1769, 1657, 1200, 1616, 1193, 1186, 603,
+	1079, 1613, 1077, 1076, 1075, 1071, 1650, 1648, 1623, 1030,
+	1068, 1066, 1064, 88, 1038, 1037, 1036, 1156, 1671, 1034,
+	1033, 1032, 1031, 1028, 1027, 1026, 1628, 97, 1025, 1024,
+	1023, 1022, 1021, 1119, 1639, 2089, 876, 720, 495, 1650,
+	1113, 1114, 2005, 1820, 1646, 2003, 1625, 1661, 1953, 1329,
+	1203, 1697, 1751, 1116, 515, 888, 1118, 1656, 886, 1660,
+	889, 1660, 1662, 887, 890, 885, 475, 476, 884, 1784,
+	1669, 1279, 1547, 1687, 1665, 2048, 593, 594, 1709, 1673,
+	1040, 1039, 1049, 1050, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 1041, 1699, 1698, 1156, 372, 1481, 1694, 1143, 1144,
+	1149, 370, 1626, 962, 1317, 371, 992, 353, 353, 1627,
+	479, 97, 1222, 1221, 1700, 543, 2087, 370, 1711, 533,
+	534, 1713, 1714, 1715, 446, 448, 449, 528, 457, 2086,
+	1712, 1885, 1878, 1811, 1808, 1739, 457, 1719, 1738, 1775,
+	1777, 1735, 1775, 1775, 1746, 1736, 1720, 1707, 1174, 1584,
+	532, 371, 1708, 1583, 1412, 1725, 1296, 1730, 869, 918,
+	1728, 1040, 1039, 1049, 1050, 1042, 1043, 1044, 1045, 1046,
+	1047, 1048, 1041, 1755, 301, 372, 1776, 1509, 2007, 2006,
+	2007, 1772, 2006, 1797, 1759, 371, 1778, 1779, 1782, 478,
+	389, 982, 1180, 1183, 1780, 1, 1726, 1727, 1082, 1088,
+	1724, 1921, 2047, 2077, 1748, 2008, 2050, 658, 1750, 1752,
+	1754, 643, 1756, 1757, 1758, 1760, 1761, 1762, 1764, 1765,
+	1766, 1767, 1960, 1631, 1872, 1800, 1313, 1139, 1483, 1449,
+	1802, 1801, 1306, 1781, 360, 1821, 516, 1453, 1454, 680,
+	670, 1067, 671, 715, 1770, 1040, 1039, 1049, 1050, 1042,
+	1043, 1044, 1045, 1046, 1047, 1048, 1041, 447, 1812, 1813,
+	669, 1777, 1799, 1577, 375, 380, 1816, 445, 1824, 457,
+	390, 1688, 1731, 1548, 1768, 1040, 1039, 1049, 1050, 1042,
+	1043, 1044, 1045, 1046, 1047, 1048, 1041, 1567, 1231, 1266,
+	1943, 1747, 1783, 2084, 1976, 2111, 457, 1854, 2017, 2067,
+	2060, 1972, 1818, 326, 969, 569, 1763, 415, 1937, 422,
+	1886, 1867, 1817, 877, 1753, 1487, 1874, 1323, 1147, 1124,
+	327, 1965, 1883, 1787, 378, 1919, 1150, 379, 1924, 457,
+	1882, 1880, 457, 457, 457, 1153, 485, 1152, 1014, 1253,
+	1069, 616, 650, 486, 644, 1574, 1899, 1942, 1822, 1823,
+	1573, 1826, 1827, 1828, 1829, 1863, 1542, 1832, 1833, 1834,
+	1835, 1836, 1837, 1838, 1839, 1840, 1841, 1842, 1843, 1844,
+	1845, 1925, 1847, 1941, 1933, 1934, 1935, 31, 1932, 480,
+	1005, 1441, 1735, 1096, 1962, 99, 1168, 1097, 2012, 1875,
+	1971, 2052, 657, 656, 1978, 1979, 655, 654, 470, 468,
+	467, 97, 1040, 1039, 1049, 1050, 1042, 1043, 1044, 1045,
+	1046, 1047, 1048, 1041, 318, 317, 1771, 457, 1411, 1582,
+	1001, 1003, 1950, 1949, 1864, 1889, 1890, 1984, 1865, 1803,
+	1682, 1895, 1896, 1906, 1678, 1674, 1982, 1745, 1744, 1528,
+	1529, 2000, 1535, 1355, 2013, 1351, 1353, 920, 1354, 1352,
+	1350, 1510, 2001, 2004, 2002, 1507, 1506, 1115, 1111, 1993,
+	1084, 2011, 451, 926, 94, 316, 1206, 610, 87, 436,
+	65, 73, 69, 2020, 2022, 462, 952, 953, 11, 44,
+	12, 19, 18, 17, 2028, 55, 2031, 2032, 2033, 2034,
+	2054, 2036, 2038, 2037, 54, 53, 52, 2053, 16, 2046,
+	8, 51, 50, 49, 2063, 15, 2065, 14, 43, 42,
+	41, 40, 39, 38, 37, 36, 35, 34, 2059, 33,
+	32, 9, 68, 67, 66, 25, 2071, 1942, 26, 27,
+	2079, 76, 75, 74, 72, 71, 30, 10, 2083, 7,
+	4, 2, 23, 22, 21, 457, 20, 457, 2088, 0,
+	2090, 0, 0, 0, 2093, 0, 0, 910, 0, 910,
+	0, 0, 2054, 2098, 0, 0, 0, 2014, 0, 2053,
+	2097, 2099, 457, 2100, 0, 2103, 0, 2079, 2106, 0,
+	0, 0, 0, 0, 910, 0, 0, 0, 835, 821,
+	2114, 783, 837, 755, 771, 845, 773, 774, 809, 733,
+	792, 227, 769, 725, 758, 759, 727, 766, 728, 756,
+	785, 170, 754, 824, 795, 195, 843, 197, 0, 0,
+	259, 210, 0, 0, 788, 826, 790, 814, 782, 810,
+	741, 803, 838, 770, 807, 839, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 806, 831, 768, 0, 0, 742, 836,
+	789, 808, 0, 726, 804, 2108, 731, 734, 844, 829,
+	763, 764, 0, 0, 0, 0, 0, 0, 0, 786,
+	791, 811, 779, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 760, 0, 799, 0, 0, 0, 736, 732,
+	0, 784, 0, 144, 264, 278, 154, 253, 293, 158,
+	262, 150, 226, 249, 146, 276, 261, 207, 189, 190,
+	145, 0, 244, 168, 181, 165, 224, 833, 834, 164,
+	296, 735, 287, 148, 149, 286, 223, 273, 277, 208,
+	202, 147, 275, 206, 201, 193, 172, 185, 236, 200,
+	237, 186, 212, 211, 213, 856, 857, 858, 859, 860,
+	740, 0, 761, 812, 0, 724, 820, 827, 781, 289,
+	830, 778, 777, 863, 0, 862, 263, 864, 865, 194,
+	825, 757, 767, 762, 765, 247, 229, 832, 798, 234,
+	245, 198, 274, 238, 279, 265, 288, 815, 240, 139,
+	266, 167, 209, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 232, 252, 267, 268, 269, 166, 159, 246,
+	160, 183, 161, 140, 256, 162, 141, 233, 272, 861,
+	180, 242, 205, 142, 204, 235, 271, 270, 297, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 723,
+	284, 0, 225, 822, 729, 739, 737, 775, 800, 801,
+	802, 848, 817, 819, 818, 847, 250, 0, 0, 0,
+	0, 0, 188, 231, 0, 251, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 730, 0, 260, 282,
+	295, 285, 776, 748, 787, 294, 751, 749, 816, 750,
+	805, 849, 214, 215, 216, 217, 218, 219, 772, 157,
+	796, 780, 850, 851, 852, 853, 854, 855, 753, 828,
+	176, 182, 239, 184, 156, 230, 179, 291, 191, 292,
+	222, 187, 257, 192, 199, 243, 290, 228, 248, 155,
+	281, 258, 203, 178, 747, 752, 746, 793, 794, 840,
+	841, 842, 813, 738, 823, 743, 745, 744, 797, 138,
+	0, 196, 846, 241, 175, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+	136, 866, 867, 298, 299, 300, 143, 255, 0, 137,
+	254, 280, 283, 835, 821, 0, 783, 837, 755, 771,
+	845, 773, 774, 809, 733, 792, 227, 769, 725, 758,
+	759, 727, 766, 728, 756, 785, 170, 754, 824, 795,
+	195, 843, 197, 0, 0, 259, 210, 0, 0, 788,
+	826, 790, 814, 782, 810, 741, 803, 838, 770, 807,
+	839, 0, 0, 0, 0, 487, 488, 489, 0, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 806, 831,
+	768, 0, 0, 742, 836, 789, 808, 0, 726, 804,
+	0, 731, 734, 844, 829, 763, 764, 0, 0, 0,
+	0, 0, 0, 0, 786, 791, 811, 779, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 760, 0, 799,
+	0, 0, 0, 736, 732, 0, 784, 0, 144, 264,
+	278, 154, 253, 293, 158, 262, 150, 226, 249, 146,
+	276, 261, 207, 189, 190, 145, 0, 244, 168, 181,
+	165, 224, 833, 834, 164, 296, 735, 287, 148, 149,
+	286, 223, 273, 277, 208, 202, 147, 275, 206, 201,
+	193, 172, 185, 236, 200, 237, 186, 212, 211, 213,
+	856, 857, 858, 859, 860, 740, 0, 761, 812, 0,
+	724, 820, 827, 781, 289, 830, 778, 777, 863, 0,
+	862, 263, 864, 865, 194, 825, 757, 767, 762, 765,
+	247, 229, 832, 798, 234, 245, 198, 274, 238, 279,
+	265, 288, 815, 240, 139, 266, 167, 209, 151, 152,
+	163, 169, 171, 173, 174, 220, 221, 232, 252, 267,
+	268, 269, 166, 159, 246, 160, 183, 161, 140, 256,
+	162, 141, 233, 272, 861, 180, 242, 205, 142, 204,
+	235, 271, 270, 297, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 723, 284, 0, 225, 822, 729,
+	739, 737, 775, 800, 801, 802, 848, 817, 819, 818,
+	847, 250, 0, 0, 0, 0, 0, 188, 231, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 730, 0, 260, 282, 295, 285, 776, 748, 787,
+	294, 751, 749, 816, 750, 805, 849, 214, 215, 216,
+	217, 218, 219, 772, 157, 796, 780, 850, 851, 852,
+	853, 854, 855, 753, 828, 176, 182, 239, 184, 156,
+	230, 179, 291, 191, 292, 222, 187, 257, 192, 199,
+	243, 290, 228, 248, 155, 281, 258, 203, 178, 747,
+	752, 746, 793, 794, 840, 841, 842, 813, 738, 823,
+	743, 745, 744, 797, 138, 0, 196, 846, 241, 175,
+	1040, 1039, 1049, 1050, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 1041, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 676, 0, 0, 866, 867, 298, 299,
+	300, 143, 255, 227, 137, 254, 280, 283, 0, 652,
+	0, 0, 0, 170, 983, 0, 0, 195, 0, 197,
+	0, 0, 259, 210, 0, 0, 0, 0, 692, 700,
+	0, 0, 0, 0, 0, 0, 979, 0, 0, 645,
+	0, 0, 617, 682, 681, 660, 0, 0, 0, 153,
+	661, 0, 666, 0, 662, 665, 663, 664, 0, 0,
+	684, 0, 0, 0, 0, 0, 615, 649, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	646, 647, 0, 0, 0, 0, 677, 0, 648, 0,
+	0, 980, 0, 667, 0, 144, 264, 278, 154, 253,
+	293, 158, 262, 150, 226, 249, 146, 276, 261, 207,
+	189, 190, 145, 0, 244, 168, 181, 165, 224, 674,
+	675, 164, 639, 672, 287, 148, 149, 286, 223, 273,
+	277, 208, 202, 147, 275, 206, 201, 193, 172, 185,
+	236, 200, 237, 186, 212, 211, 213, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 0, 0, 690, 0, 0, 0, 263, 0,
+	0, 194, 0, 0, 0, 673, 0, 247, 229, 703,
+	0, 234, 245, 198, 274, 238, 279, 265, 288, 0,
+	240, 139, 266, 167, 209, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 232, 252, 267, 268, 269, 166,
+	159, 246, 160, 183, 161, 140, 256, 162, 141, 233,
+	272, 0, 180, 242, 205, 142, 204, 235, 271, 270,
+	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 284, 688, 225, 702, 683, 685, 686, 689,
+	693, 694, 695, 696, 697, 699, 701, 704, 250, 0,
+	0, 0, 0, 0, 188, 231, 0, 251, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 282, 295, 638, 0, 0, 0, 294, 0, 0,
+	0, 0, 0, 678, 214, 215, 216, 217, 218, 219,
+	691, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 182, 239, 184, 156, 230, 179, 291,
+	191, 292, 222, 187, 257, 192, 199, 243, 290, 228,
+	248, 155, 281, 258, 203, 178, 710, 687, 709, 711,
+	712, 708, 713, 714, 698, 653, 0, 706, 705, 707,
+	0, 138, 0, 196, 0, 241, 175, 101, 619, 620,
+	621, 622, 623, 624, 625, 109, 626, 111, 112, 113,
+	114, 627, 116, 628, 118, 119, 120, 629, 630, 631,
+	632, 125, 126, 127, 633, 634, 130, 131, 132, 133,
+	635, 636, 637, 0, 0, 298, 299, 300, 143, 255,
+	676, 137, 254, 280, 283, 0, 0, 0, 0, 0,
+	227, 0, 0, 0, 0, 0, 652, 0, 0, 0,
+	170, 2107, 0, 0, 195, 0, 197, 0, 0, 259,
+	210, 0, 0, 0, 0, 692, 700, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 645, 0, 0, 617,
+	682, 681, 660, 0, 0, 0, 153, 661, 0, 666,
+	0, 662, 665, 663, 664, 0, 0, 684, 0, 0,
+	0, 0, 0, 615, 649, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 646, 647, 0,
+	0, 0, 0, 677, 0, 648, 0, 0, 679, 0,
+	667, 0, 144, 264, 278, 154, 253, 293, 158, 262,
+	150, 226, 249, 146, 276, 261, 207, 189, 190, 145,
+	0, 244, 168, 181, 165, 224, 674, 675, 164, 639,
+	672, 287, 148, 149, 286, 223, 273, 277, 208, 202,
+	147, 275, 206, 201, 193, 172, 185, 236, 200, 237,
+	186, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	0, 690, 0, 0, 0, 263, 0, 0, 194, 0,
+	0, 0, 673, 0, 247, 229, 703, 0, 234, 245,
+	198, 274, 238, 279, 265, 288, 0, 240, 139, 266,
+	167, 209, 151, 152, 163, 169, 171, 173, 174, 220,
+	221, 232, 252, 267, 268, 269, 166, 159, 246, 160,
+	183, 161, 140, 256, 162, 141, 233, 272, 0, 180,
+	242, 205, 142, 204, 235, 271, 270, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 0, 284,
+	688, 225, 702, 683, 685, 686, 689, 693, 694, 695,
+	696, 697, 699, 701, 704, 250, 0, 0, 0, 0,
+	0, 188, 231, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 282, 295,
+	638, 0, 0, 0, 294, 0, 0, 0, 0, 0,
+	678, 214, 215, 216, 217, 218, 219, 691, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	182, 239, 184, 156, 230, 179, 291, 191, 292, 222,
+	187, 257, 192, 199, 243, 290, 228, 248, 155, 281,
+	258, 203, 178, 710, 687, 709, 711, 712, 708, 713,
+	714, 698, 653, 0, 706, 705, 707, 0, 138, 0,
+	196, 0, 241, 175, 101, 619, 620, 621, 622, 623,
+	624, 625, 109, 626, 111, 112, 113, 114, 627, 116,
+	628, 118, 119, 120, 629, 630, 631, 632, 125, 126,
+	127, 633, 634, 130, 131, 132, 133, 635, 636, 637,
+	0, 0, 298, 299, 300, 143, 255, 676, 137, 254,
+	280, 283, 0, 0, 0, 0, 0, 227, 0, 0,
+	0, 0, 0, 652, 0, 0, 0, 170, 0, 0,
+	0, 195, 0, 197, 0, 0, 259, 210, 0, 0,
+	0, 0, 692, 700, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 645, 0, 0, 617, 682, 681, 660,
+	0, 0, 0, 153, 661, 0, 666, 0, 662, 665,
+	663, 664, 0, 0, 684, 0, 0, 0, 0, 0,
+	615, 649, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 646, 647, 0, 0, 0, 0,
+	677, 0, 648, 0, 0, 679, 0, 667, 0, 144,
+	264, 278, 154, 253, 293, 158, 262, 150, 226, 249,
+	146, 276, 261, 207, 189, 190, 145, 0, 244, 168,
+	181, 165, 224, 674, 675, 164, 639, 672, 287, 148,
+	149, 286, 223, 273, 277, 208, 202, 147, 275, 206,
+	201, 193, 172, 185, 236, 200, 237, 186, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 0, 0, 690, 0,
+	0, 0, 263, 0, 0, 194, 0, 0, 0, 673,
+	0, 247, 229, 703, 2039, 234, 245, 198, 274, 238,
+	279, 265, 288, 0, 240, 139, 266, 167, 209, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 232, 252,
+	267, 268, 269, 166, 159, 246, 160, 183, 161, 140,
+	256, 162, 141, 233, 272, 0, 180, 242, 205, 142,
+	204, 235, 271, 270, 297, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 284, 688, 225, 702,
+	683, 685, 686, 689, 693, 694, 695, 696, 697, 699,
+	701, 704, 250, 0, 0, 0, 0, 0, 188, 231,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 260, 282, 295, 638, 0, 0,
+	0, 294, 0, 0, 0, 0, 0, 678, 214, 215,
+	216, 217, 218, 219, 691, 157, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 176, 182, 239, 184,
+	156, 230, 179, 291, 191, 292, 222, 187, 257, 192,
+	199, 243, 290, 228, 248, 155, 281, 258, 203, 178,
+	710, 687, 709, 711, 712, 708, 713, 714, 698, 653,
+	0, 706, 705, 707, 0, 138, 0, 196, 0, 241,
+	175, 101, 619, 620, 621, 622, 623, 624, 625, 109,
+	626, 111, 112, 113, 114, 627, 116, 628, 118, 119,
+	120, 629, 630, 631, 632, 125, 126, 127, 633, 634,
+	130, 131, 132, 133, 635, 636, 637, 0, 0, 298,
+	299, 300, 143, 255, 676, 137, 254, 280, 283, 0,
+	0, 0, 0, 0, 227, 0, 0, 0, 0, 0,
+	652, 0, 0, 0, 170, 983, 0, 0, 195, 0,
+	197, 0, 0, 259, 210, 0, 0, 0, 0, 692,
+	700, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	645, 0, 0, 617, 682, 681, 660, 0, 0, 0,
+	153, 661, 0, 666, 0, 662, 665, 663, 664, 0,
+	0, 684, 0, 0, 0, 0, 0, 615, 649, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 646, 647, 0, 0, 0, 0, 677, 0, 648,
+	0, 0, 679, 0, 667, 0, 144, 264, 278, 154,
+	253, 293, 158, 262, 150, 226, 249, 146, 276, 261,
+	207, 189, 190, 145, 0, 244, 168, 181, 165, 224,
+	674, 675, 164, 639, 672, 287, 148, 149, 286, 223,
+	273, 277, 208, 202, 147, 275, 206, 201, 193, 172,
+	185, 236, 200, 237, 186, 212, 211, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 0, 690, 0, 0, 0, 263,
+	0, 0, 194, 0, 0, 0, 673, 0, 247, 229,
+	703, 0, 234, 245, 198, 274, 238, 279, 265, 288,
+	0, 240, 139, 266, 167, 209, 151, 152, 163, 169,
+	171, 173, 174, 220, 221, 232, 252, 267, 268, 269,
+	166, 159, 246, 160, 183, 161, 140, 256, 162, 141,
+	233, 272, 0, 180, 242, 205, 142, 204, 235, 271,
+	270, 297, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 0, 284, 688, 225, 702, 683, 685, 686,
+	689, 693, 694, 695, 696, 697, 699, 701, 704, 250,
+	0, 0, 0, 0, 0, 188, 231, 0, 251, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 260, 282, 295, 638, 0, 0, 0, 294, 0,
+	0, 0, 0, 0, 678, 214, 215, 216, 217, 218,
+	219, 691, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 182, 239, 184, 156, 230, 179,
+	291, 191, 292, 222, 187, 257, 192, 199, 243, 290,
+	228, 248, 155, 281, 258, 203, 178, 710, 687, 709,
+	711, 712, 708, 713, 714, 698, 653, 0, 706, 705,
+	707, 0, 138, 0, 196, 0, 241, 175, 101, 619,
+	620, 621, 622, 623, 624, 625, 109, 626, 111, 112,
+	113, 114, 627, 116, 628, 118, 119, 120, 629, 630,
+	631, 632, 125, 126, 127, 633, 634, 130, 131, 132,
+	133, 635, 636, 637, 0, 0, 298, 299, 300, 143,
+	255, 0, 137, 254, 280, 283, 92, 0, 676, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
+	0, 0, 0, 0, 652, 0, 0, 0, 170, 0,
+	0, 0, 195, 0, 197, 0, 0, 259, 210, 0,
+	0, 0, 0, 692, 700, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 645, 0, 0, 617, 682, 681,
+	660, 0, 0, 0, 153, 661, 0, 666, 0, 662,
+	665, 663, 664, 0, 0, 684, 0, 0, 0, 0,
+	0, 615, 649, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 646, 647, 0, 0, 0,
+	0, 677, 0, 648, 0, 0, 679, 0, 667, 0,
+	144, 264, 278, 154, 253, 293, 158, 262, 150, 226,
+	249, 146, 276, 261, 207, 189, 190, 145, 0, 244,
+	168, 181, 165, 224, 674, 675, 164, 639, 672, 287,
+	148, 149, 286, 223, 273, 277, 208, 202, 147, 275,
+	206, 201, 193, 172, 185, 236, 200, 237, 186, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 0, 690,
+	0, 0, 0, 263, 0, 0, 194, 0, 0, 0,
+	673, 0, 247, 229, 703, 0, 234, 245, 198, 274,
+	238, 279, 265, 288, 0, 240, 139, 266, 167, 209,
+	151, 152, 163, 169, 171, 173, 174, 220, 221, 232,
+	252, 267, 268, 269, 166, 159, 246, 160, 183, 161,
+	140, 256, 162, 141, 233, 272, 0, 180, 242, 205,
+	142, 204, 235, 271, 270, 297, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 284, 688, 225,
+	702, 683, 685, 686, 689, 693, 694, 695, 696, 697,
+	699, 701, 704, 250, 0, 0, 0, 0, 0, 188,
+	231, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 282, 295, 638, 0,
+	0, 0, 294, 0, 0, 0, 0, 0, 678, 214,
+	215, 216, 217, 218, 219, 691, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 182, 239,
+	184, 156, 230, 179, 291, 191, 292, 222, 187, 257,
+	192, 199, 243, 290, 228, 248, 155, 281, 258, 203,
+	178, 710, 687, 709, 711, 712, 708, 713, 714, 698,
+	653, 0, 706, 705, 707, 0, 138, 0, 196, 0,
+	241, 175, 101, 619, 620, 621, 622, 623, 624, 625,
+	109, 626, 111, 112, 113, 114, 627, 116, 628, 118,
+	119, 120, 629, 630, 631, 632, 125, 126, 127, 633,
+	634, 130, 131, 132, 133, 635, 636, 637, 0, 0,
+	298, 299, 300, 143, 255, 676, 137, 254, 280, 283,
+	0, 0, 0, 0, 0, 227, 0, 0, 0, 0,
+	0, 652, 0, 0, 0, 170, 0, 0, 0, 195,
+	0, 197, 0, 0, 259, 210, 0, 0, 0, 0,
+	692, 700, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 645, 0, 0, 617, 682, 681, 660, 0, 0,
+	0, 153, 661, 0, 666, 0, 662, 665, 663, 664,
+	0, 0, 684, 0, 0, 0, 0, 0, 615, 649,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 646, 647, 612, 0, 0, 0, 677, 0,
+	648, 0, 0, 679, 0, 667, 0, 144, 264, 278,
+	154, 253, 293, 158, 262, 150, 226, 249, 146, 276,
+	261, 207, 189, 190, 145, 0, 244, 168, 181, 165,
+	224, 674, 675, 164, 639, 672, 287, 148, 149, 286,
+	223, 273, 277, 208, 202, 147, 275, 206, 201, 193,
+	172, 185, 236, 200, 237, 186, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 289, 0, 0, 690, 0, 0, 0,
+	263, 0, 0, 194, 0, 0, 0, 673, 0, 247,
+	229, 703, 0, 234, 245, 198, 274, 238, 279, 265,
+	288, 0, 240, 139, 266, 167, 209, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 232, 252, 267, 268,
+	269, 166, 159, 246, 160, 183, 161, 140, 256, 162,
+	141, 233, 272, 0, 180, 242, 205, 142, 204, 235,
+	271, 270, 297, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 284, 688, 225, 702, 683, 685,
+	686, 689, 693, 694, 695, 696, 697, 699, 701, 704,
+	250, 0, 0, 0, 0, 0, 188, 231, 0, 251,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 282, 295, 638, 0, 0, 0, 294,
+	0, 0, 0, 0, 0, 678, 214, 215, 216, 217,
+	218, 219, 691, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 176, 182, 239, 184, 156, 230,
+	179, 291, 191, 292, 222, 187, 257, 192, 199, 243,
+	290, 228, 248, 155, 281, 258, 203, 178, 710, 687,
+	709, 711, 712, 708, 713, 714, 698, 653, 0, 706,
+	705, 707, 0, 138, 0, 196, 0, 241, 175, 101,
+	619, 620, 621, 622, 623, 624, 625, 109, 626, 111,
+	112, 113, 114, 627, 116, 628, 118, 119, 120, 629,
+	630, 631, 632, 125, 126, 127, 633, 634, 130, 131,
+	132, 133, 635, 636, 637, 0, 0, 298, 299, 300,
+	143, 255, 676, 137, 254, 280, 283, 0, 0, 0,
+	0, 0, 227, 0, 0, 0, 0, 0, 652, 0,
+	0, 0, 170, 0, 0, 0, 195, 0, 197, 0,
+	0, 259, 210, 0, 0, 0, 0, 692, 700, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 645, 0,
+	0, 617, 682, 681, 660, 0, 0, 0, 153, 661,
+	0, 666, 0, 662, 665, 663, 664, 0, 0, 684,
+	0, 0, 0, 0, 0, 615, 649, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 646,
+	647, 0, 0, 0, 0, 677, 0, 648, 0, 0,
+	679, 0, 667, 0, 144, 264, 278, 154, 253, 293,
+	158, 262, 150, 226, 249, 146, 276, 261, 207, 189,
+	190, 145, 0, 244, 168, 181, 165, 224, 674, 675,
+	164, 639, 672, 287, 148, 149, 286, 223, 273, 277,
+	208, 202, 147, 275, 206, 201, 193, 172, 185, 236,
+	200, 237, 186, 212, 211, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 0, 690, 0, 0, 0, 263, 0, 0,
+	194, 0, 0, 0, 673, 0, 247, 229, 703, 0,
+	234, 245, 198, 274, 238, 279, 265, 288, 0, 240,
+	139, 266, 167, 209, 151, 152, 163, 169, 171, 173,
+	174, 220, 221, 232, 252, 267, 268, 269, 166, 159,
+	246, 160, 183, 161, 140, 256, 162, 141, 233, 272,
+	0, 180, 242, 205, 142, 204, 235, 271, 270, 297,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	0, 284, 688, 225, 702, 683, 685, 686, 689, 693,
+	694, 695, 696, 697, 699, 701, 704, 250, 0, 0,
+	0, 0, 0, 188, 231, 0, 251, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	282, 295, 638, 0, 0, 0, 294, 0, 0, 0,
+	0, 0, 678, 214, 215, 216, 217, 218, 219, 691,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 182, 239, 184, 156, 230, 179, 291, 191,
+	292, 222, 187, 257, 192, 199, 243, 290, 228, 248,
+	155, 281, 258, 203, 178, 710, 687, 709, 711, 712,
+	708, 713, 714, 698, 653, 0, 706, 705, 707, 0,
+	138, 0, 196, 0, 241, 175, 101, 619, 620, 621,
+	622, 623, 624, 625, 109, 626, 111, 112, 113, 114,
+	627, 116, 628, 118, 119, 120, 629, 630, 631, 632,
+	125, 126, 127, 633, 634, 130, 131, 132, 133, 635,
+	636, 637, 0, 0, 298, 299, 300, 143, 255, 676,
+	137, 254, 280, 283, 0, 0, 0, 0, 0, 227,
+	0, 0, 0, 0, 0, 652, 0, 0, 0, 170,
+	0, 0, 0, 195, 0, 197, 0, 0, 259, 210,
+	0, 0, 0, 0, 692, 700, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 645, 0, 0, 617, 682,
+	681, 660, 0, 0, 0, 153, 661, 0, 666, 0,
+	662, 665, 663, 664, 0, 0, 684, 0, 0, 0,
+	0, 0, 0, 649, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 646, 647, 0, 0,
+	0, 0, 677, 0, 648, 0, 0, 679, 0, 667,
+	0, 144, 264, 278, 154, 253, 293, 158, 262, 150,
+	226, 249, 146, 276, 261, 207, 189, 190, 145, 0,
+	244, 168, 181, 165, 224, 674, 675, 164, 639, 672,
+	287, 148, 149, 286, 223, 273, 277, 208, 202, 147,
+	275, 206, 201, 193, 172, 185, 236, 200, 237, 186,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 0, 0,
+	690, 0, 0, 0, 263, 0, 0, 194, 0, 0,
+	0, 673, 0, 247, 229, 703, 0, 234, 245, 198,
+	274, 238, 279, 265, 288, 0, 240, 139, 266, 167,
+	209, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	232, 252, 267, 268, 269, 166, 159, 246, 160, 183,
+	161, 140, 256, 162, 141, 233, 272, 0, 180, 242,
+	205, 142, 204, 235, 271, 270, 297, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 284, 688,
+	225, 702, 683, 685, 686, 689, 693, 694, 695, 696,
+	697, 699, 701, 704, 250, 0, 0, 0, 0, 0,
+	188, 231, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 282, 295, 638,
+	0, 0, 0, 294, 0, 0, 0, 0, 0, 678,
+	214, 215, 216, 217, 218, 219, 691, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 182,
+	239, 184, 156, 230, 179, 291, 191, 292, 222, 187,
+	257, 192, 199, 243, 290, 228, 248, 155, 281, 258,
+	203, 178, 710, 687, 709, 711, 712, 708, 713, 714,
+	698, 653, 0, 706, 705, 707, 0, 138, 0, 196,
+	0, 241, 175, 101, 619, 620, 621, 622, 623, 624,
+	625, 109, 626, 111, 112, 113, 114, 627, 116, 628,
+	118, 119, 120, 629, 630, 631, 632, 125, 126, 127,
+	633, 634, 130, 131, 132, 133, 635, 636, 637, 0,
+	0, 298, 299, 300, 143, 255, 676, 137, 254, 280,
+	283, 0, 0, 0, 0, 0, 227, 0, 0, 0,
+	0, 0, 652, 0, 0, 0, 170, 0, 0, 0,
+	195, 0, 197, 0, 0, 259, 210, 0, 0, 0,
+	0, 692, 700, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 617, 682, 681, 660, 0,
+	0, 0, 153, 661, 0, 666, 0, 662, 665, 663,
+	664, 0, 0, 684, 0, 0, 0, 0, 0, 615,
+	649, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 646, 647, 0, 0, 0, 0, 677,
+	0, 648, 0, 0, 679, 0, 667, 0, 144, 264,
+	278, 154, 253, 293, 158, 262, 150, 226, 249, 146,
+	276, 261, 207, 189, 190, 145, 0, 244, 168, 181,
+	165, 224, 674, 675, 164, 639, 672, 287, 148, 149,
+	286, 223, 273, 277, 208, 202, 147, 275, 206, 201,
+	193, 172, 185, 236, 200, 237, 186, 212, 211, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 0, 690, 0, 0,
+	0, 263, 0, 0, 194, 0, 0, 0, 673, 0,
+	247, 229, 703, 0, 234, 245, 198, 274, 238, 279,
+	265, 288, 0, 240, 139, 266, 167, 209, 151, 152,
+	163, 169, 171, 173, 174, 220, 221, 232, 252, 267,
+	268, 269, 166, 159, 246, 160, 183, 161, 140, 256,
+	162, 141, 233, 272, 0, 180, 242, 205, 142, 204,
+	235, 271, 270, 297, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 0, 284, 688, 225, 702, 683,
+	685, 686, 689, 693, 694, 695, 696, 697, 699, 701,
+	704, 250, 0, 0, 0, 0, 0, 188, 231, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 282, 295, 638, 0, 0, 0,
+	294, 0, 0, 0, 0, 0, 678, 214, 215, 216,
+	217, 218, 219, 691, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 182, 239, 184, 156,
+	230, 179, 291, 191, 292, 222, 187, 257, 192, 199,
+	243, 290, 228, 248, 155, 281, 258, 203, 178, 710,
+	687, 709, 711, 712, 708, 713, 714, 698, 653, 0,
+	706, 705, 707, 0, 138, 0, 196, 0, 241, 175,
+	101, 619, 620, 621, 622, 623, 624, 625, 109, 626,
+	111, 112, 113, 114, 627, 116, 628, 118, 119, 120,
+	629, 630, 631, 632, 125, 126, 127, 633, 634, 130,
+	131, 132, 133, 635, 636, 637, 0, 0, 298, 299,
+	300, 143, 255, 0, 137, 254, 280, 283, 338, 0,
+	337, 341, 333, 0, 0, 0, 0, 0, 0, 0,
+	227, 0, 329, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 348, 195, 0, 197, 0, 0, 259,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 351,
+	0, 0, 352, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 264, 278, 154, 253, 293, 158, 262,
+	150, 226, 249, 146, 276, 261, 207, 189, 190, 145,
+	0, 244, 168, 181, 165, 224, 0, 0, 164, 296,
+	0, 287, 148, 149, 286, 223, 273, 277, 208, 202,
+	147, 275, 206, 201, 193, 172, 185, 236, 200, 237,
+	186, 212, 211, 213, 0, 0, 0, 0, 0, 331,
+	330, 334, 0, 0, 0, 0, 0, 336, 289, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 194, 340,
+	0, 0, 0, 0, 247, 229, 0, 0, 234, 245,
+	198, 274, 238, 332, 265, 288, 0, 356, 139, 266,
+	167, 209, 151, 152, 163, 169, 171, 173, 174, 220,
+	221, 232, 252, 267, 268, 269, 166, 159, 246, 160,
+	183, 161, 140, 256, 162, 141, 233, 272, 0, 180,
+	242, 205, 142, 204, 235, 271, 270, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 0, 284,
+	0, 225, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 335,
+	339, 342, 231, 343, 344, 0, 0, 345, 346, 347,
+	0, 0, 349, 350, 0, 0, 0, 260, 282, 295,
+	285, 0, 0, 0, 294, 0, 0, 0, 0, 0,
+	0, 214, 215, 216, 217, 218, 219, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	182, 239, 184, 156, 230, 179, 291, 191, 292, 222,
+	187, 257, 192, 199, 243, 290, 228, 248, 155, 281,
+	258, 203, 178, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
+	196, 0, 241, 175, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	0, 0, 298, 299, 300, 143, 255, 0, 137, 254,
+	280, 283, 338, 0, 337, 341, 333, 0, 0, 0,
+	0, 0, 0, 0, 227, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 348, 195, 0,
+	197, 0, 0, 259, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 351, 0, 0, 352, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 264, 278, 154,
+	253, 293, 158, 262, 150, 226, 249, 146, 276, 261,
+	207, 189, 190, 145, 0, 244, 168, 181, 165, 224,
+	0, 0, 164, 296, 0, 287, 148, 149, 286, 223,
+	273, 277, 208, 202, 147, 275, 206, 201, 193, 172,
+	185, 236, 200, 237, 186, 212, 211, 213, 0, 0,
+	0, 0, 0, 331, 330, 334, 0, 0, 0, 0,
+	0, 336, 289, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 194, 340, 0, 0, 0, 0, 247, 229,
+	0, 0, 234, 245, 198, 274, 238, 332, 265, 288,
+	0, 240, 139, 266, 167, 209, 151, 152, 163, 169,
+	171, 173, 174, 220, 221, 232, 252, 267, 268, 269,
+	166, 159, 246, 160, 183, 161, 140, 256, 162, 141,
+	233, 272, 0, 180, 242, 205, 142, 204, 235, 271,
+	270, 297, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 0, 284, 0, 225, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 335, 339, 342, 231, 343, 344, 0,
+	0, 345, 346, 347, 0, 0, 349, 350, 0, 0,
+	0, 260, 282, 295, 285, 0, 0, 0, 294, 0,
+	0, 0, 0, 0, 0, 214, 215, 216, 217, 218,
+	219, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 182, 239, 184, 156, 230, 179,
+	291, 191, 292, 222, 187, 257, 192, 199, 243, 290,
+	228, 248, 155, 281, 258, 203, 178, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 0, 196, 0, 241, 175, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 0, 0, 298, 299, 300, 143,
+	255, 0, 137, 254, 280, 283, 92, 0, 28, 47,
+	29, 0, 0, 0, 0, 0, 0, 0, 227, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 195, 0, 197, 0, 0, 259, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 0, 0, 98, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 264, 278, 154, 253, 293, 158, 262, 150, 226,
+	249, 146, 276, 261, 207, 189, 190, 145, 0, 244,
+	168, 181, 165, 224, 0, 0, 164, 296, 0, 287,
+	148, 149, 286, 223, 273, 277, 208, 202, 147, 275,
+	206, 201, 193, 172, 185, 236, 200, 237, 186, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 306, 0, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 194, 0, 0, 0,
+	0, 0, 247, 229, 0, 0, 234, 245, 198, 274,
+	238, 279, 265, 288, 0, 240, 139, 266, 167, 209,
+	151, 152, 163, 169, 171, 173, 174, 220, 221, 232,
+	252, 267, 268, 269, 166, 159, 246, 160, 183, 161,
+	140, 256, 162, 141, 233, 272, 0, 180, 242, 205,
+	142, 204, 235, 271, 270, 297, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 284, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 188,
+	231, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 282, 295, 285, 0,
+	0, 0, 294, 0, 0, 0, 0, 0, 0, 214,
+	215, 216, 217, 218, 219, 304, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 182, 239,
+	184, 156, 230, 179, 291, 191, 292, 222, 187, 257,
+	192, 199, 243, 290, 228, 248, 155, 281, 258, 203,
+	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 0, 196, 89,
+	241, 175, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 207, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1356, 1359, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1360,
-	265, 0, 0, 0, 1353, 0, 1352, 240, 1354, 1357,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	1358, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 0, 0,
+	298, 299, 300, 143, 255, 227, 137, 254, 280, 283,
+	0, 0, 0, 0, 0, 170, 0, 0, 0, 195,
+	0, 197, 0, 0, 259, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1517, 1520, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 144, 264, 278,
+	154, 253, 293, 158, 262, 150, 226, 249, 146, 276,
+	261, 207, 189, 190, 145, 0, 244, 168, 181, 165,
+	224, 0, 0, 164, 296, 0, 287, 148, 149, 286,
+	223, 273, 277, 208, 202, 147, 275, 206, 201, 193,
+	172, 185, 236, 200, 237, 186, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1521, 289, 0, 0, 0, 1514, 0, 1513,
+	263, 1515, 1518, 194, 0, 0, 0, 0, 0, 247,
+	229, 0, 0, 234, 245, 198, 274, 238, 279, 265,
+	288, 0, 240, 139, 266, 167, 209, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 232, 252, 267, 268,
+	269, 166, 159, 246, 160, 183, 161, 140, 256, 162,
+	141, 233, 272, 1519, 180, 242, 205, 142, 204, 235,
+	271, 270, 297, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 284, 0, 225, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 0, 0, 0, 0, 0, 188, 231, 0, 251,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 282, 295, 285, 0, 0, 0, 294,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 217,
+	218, 219, 0, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 176, 182, 239, 184, 156, 230,
+	179, 291, 191, 292, 222, 187, 257, 192, 199, 243,
+	290, 228, 248, 155, 281, 258, 203, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 138, 0, 196, 0, 241, 175, 101,
 	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 0,
-	0, 274, 275, 276, 259, 76, 0, 23, 39, 24,
-	0, 0, 0, 0, 0, 0, 0, 207, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 73, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 0, 0, 146, 272, 0, 263, 130,
-	131, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 0,
-	0, 226, 209, 0, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 0, 205, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 261, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 0, 196, 197,
-	198, 199, 280, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 202, 169, 234, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 185, 160, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 121, 0, 178, 266, 220, 157, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 0, 0, 298, 299, 300,
+	143, 255, 227, 137, 254, 280, 283, 0, 0, 0,
+	0, 0, 170, 414, 0, 0, 195, 0, 197, 0,
+	0, 259, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 426, 427, 0, 0, 0, 0, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 428,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 144, 264, 278, 154, 253, 293,
+	158, 262, 150, 226, 249, 146, 276, 261, 207, 189,
+	190, 145, 0, 244, 168, 181, 165, 224, 0, 0,
+	164, 296, 430, 287, 148, 429, 286, 223, 273, 277,
+	208, 202, 147, 275, 206, 201, 193, 172, 185, 236,
+	200, 237, 186, 212, 211, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 263, 0, 0,
+	194, 0, 0, 0, 0, 0, 247, 229, 0, 0,
+	234, 245, 198, 274, 238, 279, 265, 288, 413, 240,
+	139, 266, 167, 209, 151, 152, 163, 169, 171, 173,
+	174, 220, 221, 232, 252, 267, 268, 269, 166, 159,
+	246, 160, 183, 161, 140, 256, 162, 141, 233, 272,
+	0, 180, 242, 205, 142, 204, 235, 271, 270, 297,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	0, 284, 0, 225, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
+	0, 0, 0, 188, 231, 0, 251, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	282, 295, 285, 0, 0, 0, 294, 0, 0, 0,
+	0, 0, 416, 214, 215, 216, 217, 218, 219, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 182, 239, 184, 156, 230, 179, 291, 191,
+	292, 423, 419, 420, 192, 199, 243, 290, 228, 248,
+	155, 281, 258, 421, 178, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 0, 196, 0, 241, 175, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 0, 0, 298, 299, 300, 143, 255, 227,
+	137, 254, 280, 283, 1010, 0, 0, 0, 0, 170,
+	0, 0, 0, 195, 0, 197, 0, 0, 259, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1007,
+	1008, 1006, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 144, 264, 278, 154, 253, 293, 158, 262, 150,
+	226, 249, 146, 276, 261, 207, 189, 190, 145, 0,
+	244, 168, 181, 165, 224, 0, 0, 164, 296, 0,
+	287, 148, 149, 286, 223, 273, 277, 208, 202, 147,
+	275, 206, 201, 193, 172, 185, 236, 200, 237, 186,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 194, 0, 0,
+	0, 0, 0, 247, 229, 0, 0, 234, 245, 198,
+	274, 238, 279, 265, 288, 0, 240, 139, 266, 167,
+	209, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	232, 252, 267, 268, 269, 166, 159, 246, 160, 183,
+	161, 140, 256, 162, 141, 233, 272, 0, 180, 242,
+	205, 142, 204, 235, 271, 270, 297, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 284, 0,
+	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	188, 231, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 282, 295, 285,
+	0, 0, 0, 294, 0, 0, 0, 0, 0, 0,
+	214, 215, 216, 217, 218, 219, 0, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 182,
+	239, 184, 156, 230, 179, 291, 191, 292, 222, 187,
+	257, 192, 199, 243, 290, 228, 248, 155, 281, 258,
+	203, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 0, 196,
+	0, 241, 175, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 0,
+	0, 298, 299, 300, 143, 255, 227, 137, 254, 280,
+	283, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	195, 0, 197, 0, 0, 259, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 426, 427, 0, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 428, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 264,
+	278, 154, 253, 293, 158, 262, 150, 226, 249, 146,
+	276, 261, 207, 189, 190, 145, 0, 244, 168, 181,
+	165, 224, 0, 0, 164, 296, 430, 287, 148, 429,
+	286, 223, 273, 277, 208, 202, 147, 275, 206, 201,
+	193, 172, 185, 236, 200, 237, 186, 212, 211, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 194, 0, 0, 0, 0, 0,
+	247, 229, 0, 0, 234, 245, 198, 274, 238, 279,
+	265, 288, 0, 240, 139, 266, 167, 209, 151, 152,
+	163, 169, 171, 173, 174, 220, 221, 232, 252, 267,
+	268, 269, 166, 159, 246, 160, 183, 161, 140, 256,
+	162, 141, 233, 272, 0, 180, 242, 205, 142, 204,
+	235, 271, 270, 297, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 0, 284, 0, 225, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 188, 231, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 282, 295, 285, 0, 0, 0,
+	294, 0, 0, 0, 0, 0, 0, 214, 215, 216,
+	217, 218, 219, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 182, 239, 184, 156,
+	230, 179, 291, 191, 292, 423, 419, 420, 192, 199,
+	243, 290, 228, 248, 155, 281, 258, 421, 178, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 0, 196, 0, 241, 175,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 0, 0, 298, 299,
+	300, 143, 255, 0, 137, 254, 280, 283, 227, 0,
+	570, 0, 0, 0, 0, 0, 0, 0, 170, 571,
+	0, 0, 195, 0, 197, 0, 0, 259, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 351, 0, 0,
+	352, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 264, 278, 154, 253, 293, 158, 262, 150, 226,
+	249, 146, 276, 261, 207, 189, 190, 145, 0, 244,
+	168, 181, 165, 224, 0, 0, 164, 296, 0, 287,
+	148, 149, 286, 223, 273, 277, 208, 202, 147, 275,
+	206, 201, 193, 172, 185, 236, 200, 237, 186, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 194, 0, 0, 0,
+	0, 0, 247, 229, 0, 0, 234, 245, 198, 274,
+	238, 279, 265, 288, 0, 240, 139, 266, 167, 209,
+	151, 152, 163, 169, 171, 173, 174, 220, 221, 232,
+	252, 267, 268, 269, 166, 159, 246, 160, 183, 161,
+	140, 256, 162, 141, 233, 272, 0, 180, 242, 205,
+	142, 204, 235, 271, 270, 297, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 284, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 188,
+	231, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 282, 295, 285, 0,
+	0, 0, 294, 0, 0, 0, 0, 572, 0, 214,
+	215, 216, 217, 218, 219, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 182, 239,
+	184, 156, 230, 179, 291, 191, 292, 222, 187, 257,
+	192, 199, 243, 290, 228, 248, 155, 281, 258, 203,
+	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
+	241, 175, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 92, 0,
+	298, 299, 300, 143, 255, 0, 137, 254, 280, 283,
+	227, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 195, 0, 197, 0, 0, 259,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 88, 0, 1085, 98,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 264, 278, 154, 253, 293, 158, 262,
+	150, 226, 249, 146, 276, 261, 207, 189, 190, 145,
+	0, 244, 168, 181, 165, 224, 0, 0, 164, 296,
+	0, 287, 148, 149, 286, 223, 273, 277, 208, 202,
+	147, 275, 206, 201, 193, 172, 185, 236, 200, 237,
+	186, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 194, 0,
+	0, 0, 0, 0, 247, 229, 0, 0, 234, 245,
+	198, 274, 238, 279, 265, 288, 0, 240, 139, 266,
+	167, 209, 151, 152, 163, 169, 171, 173, 174, 220,
+	221, 232, 252, 267, 268, 269, 166, 159, 246, 160,
+	183, 161, 140, 256, 162, 141, 233, 272, 0, 180,
+	242, 205, 142, 204, 235, 271, 270, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 0, 284,
+	0, 225, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	0, 188, 231, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 282, 295,
+	285, 0, 0, 0, 294, 0, 0, 0, 0, 0,
+	0, 214, 215, 216, 217, 218, 219, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	182, 239, 184, 156, 230, 179, 291, 191, 292, 222,
+	187, 257, 192, 199, 243, 290, 228, 248, 155, 281,
+	258, 203, 178, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
+	196, 0, 241, 175, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 207, 0, 274, 275, 276, 259,
-	0, 0, 0, 0, 152, 375, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 387, 388, 0, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 389, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 391, 263, 130, 390, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	374, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 377, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	384, 380, 381, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 382, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 85, 86, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 0, 207, 274, 275, 276, 259, 782, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 779, 780, 778, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 207,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 387,
-	388, 0, 0, 0, 0, 135, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 0, 0, 146, 272, 391,
-	263, 130, 390, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 0, 0, 226, 209, 0, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 0,
-	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 261,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
-	196, 197, 198, 199, 0, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 384, 380, 381, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 382, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 121, 0, 178, 266, 220, 157,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	0, 0, 298, 299, 300, 143, 255, 0, 137, 254,
+	280, 283, 227, 0, 971, 0, 0, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 195, 0, 197, 0,
+	0, 259, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 352, 0, 0, 0, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 144, 264, 278, 154, 253, 293,
+	158, 262, 150, 226, 249, 146, 276, 261, 207, 189,
+	190, 145, 0, 244, 168, 181, 165, 224, 0, 0,
+	164, 296, 0, 287, 148, 149, 286, 223, 273, 277,
+	208, 202, 147, 275, 206, 201, 193, 172, 185, 236,
+	200, 237, 186, 212, 211, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 263, 0, 0,
+	194, 0, 0, 0, 0, 0, 247, 229, 0, 0,
+	234, 245, 198, 274, 238, 279, 265, 288, 0, 240,
+	139, 266, 167, 209, 151, 152, 163, 169, 171, 173,
+	174, 220, 221, 232, 252, 267, 268, 269, 166, 159,
+	246, 160, 183, 161, 140, 256, 162, 141, 233, 272,
+	0, 180, 242, 205, 142, 204, 235, 271, 270, 297,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	0, 284, 0, 225, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
+	0, 0, 0, 188, 231, 0, 251, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	282, 295, 285, 0, 0, 0, 294, 0, 0, 0,
+	0, 970, 0, 214, 215, 216, 217, 218, 219, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 182, 239, 184, 156, 230, 179, 291, 191,
+	292, 222, 187, 257, 192, 199, 243, 290, 228, 248,
+	155, 281, 258, 203, 178, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1371,
+	138, 0, 196, 0, 241, 175, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 0, 0, 274, 275,
-	276, 259, 207, 0, 512, 0, 0, 0, 0, 0,
-	0, 0, 152, 513, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 326, 0, 0, 327, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 514, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 76,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 0,
-	0, 207, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 73, 0, 857,
-	82, 0, 0, 0, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 261, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 0, 0,
-	274, 275, 276, 259, 207, 0, 745, 0, 0, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 326, 0, 0, 327, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 0, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 744, 0, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 85, 86, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 0, 0, 298, 299, 300, 143, 255, 227,
+	137, 254, 280, 283, 0, 0, 0, 0, 0, 170,
+	0, 0, 0, 195, 0, 197, 0, 0, 259, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1359, 0, 2049, 98, 682,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	1378, 1382, 1384, 1386, 1388, 1389, 1391, 0, 1394, 1392,
+	1393, 0, 0, 1373, 1374, 1375, 1376, 1357, 1358, 1379,
+	0, 1360, 0, 1361, 1362, 1363, 1364, 1365, 1366, 1367,
+	1368, 1369, 1370, 1377, 0, 0, 0, 0, 0, 0,
+	0, 1381, 1383, 1385, 1387, 1390, 0, 0, 0, 0,
+	0, 144, 264, 278, 154, 253, 293, 158, 262, 150,
+	226, 249, 146, 276, 261, 207, 189, 190, 145, 1372,
+	244, 168, 181, 165, 224, 0, 0, 164, 296, 0,
+	287, 148, 149, 286, 223, 273, 277, 208, 202, 147,
+	275, 206, 201, 193, 172, 185, 236, 200, 237, 186,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 194, 0, 0,
+	0, 0, 0, 247, 229, 0, 0, 234, 245, 198,
+	274, 238, 279, 265, 288, 0, 240, 139, 266, 167,
+	209, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	232, 252, 267, 268, 269, 166, 159, 246, 160, 183,
+	161, 140, 256, 162, 141, 233, 272, 0, 180, 242,
+	205, 142, 204, 235, 271, 270, 297, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 284, 0,
+	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	188, 231, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 282, 295, 285,
+	0, 0, 0, 294, 0, 0, 1380, 0, 0, 0,
+	214, 215, 216, 217, 218, 219, 0, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 182,
+	239, 184, 156, 230, 179, 291, 191, 292, 222, 187,
+	257, 192, 199, 243, 290, 228, 248, 155, 281, 258,
+	203, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 0, 196,
+	0, 241, 175, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 0,
+	0, 298, 299, 300, 143, 255, 227, 137, 254, 280,
+	283, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	195, 0, 197, 0, 0, 259, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 907, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 264,
+	278, 154, 253, 293, 158, 262, 150, 226, 249, 146,
+	276, 261, 207, 189, 190, 145, 0, 244, 168, 181,
+	165, 224, 0, 0, 164, 296, 0, 287, 148, 149,
+	286, 223, 273, 277, 208, 202, 147, 275, 206, 201,
+	193, 172, 185, 236, 200, 237, 186, 212, 211, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 194, 0, 0, 0, 0, 0,
+	247, 229, 0, 0, 234, 245, 198, 274, 238, 279,
+	265, 288, 0, 240, 139, 266, 167, 209, 151, 152,
+	163, 169, 171, 173, 174, 220, 221, 232, 252, 267,
+	268, 269, 166, 159, 246, 160, 183, 161, 140, 256,
+	162, 141, 233, 272, 0, 180, 242, 205, 142, 204,
+	235, 271, 270, 297, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 0, 284, 0, 225, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 188, 231, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 282, 295, 285, 0, 0, 0,
+	294, 0, 0, 0, 0, 0, 1476, 214, 215, 216,
+	217, 218, 219, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 182, 239, 184, 156,
+	230, 179, 291, 191, 292, 222, 187, 257, 192, 199,
+	243, 290, 228, 248, 155, 281, 258, 203, 178, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 0, 196, 0, 241, 175,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 0, 0, 298, 299,
+	300, 143, 255, 227, 137, 254, 280, 283, 0, 0,
+	0, 0, 0, 170, 1198, 0, 0, 195, 0, 197,
+	0, 0, 259, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 907, 0, 0, 0, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 144, 264, 278, 154, 253,
+	293, 158, 262, 150, 226, 249, 146, 276, 261, 207,
+	189, 190, 145, 0, 244, 168, 181, 165, 224, 0,
+	0, 164, 296, 0, 287, 148, 149, 286, 223, 273,
+	277, 208, 202, 147, 275, 206, 201, 193, 172, 185,
+	236, 200, 237, 186, 212, 211, 213, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 194, 0, 0, 0, 0, 0, 247, 229, 0,
+	0, 234, 245, 198, 274, 238, 279, 265, 288, 0,
+	240, 139, 266, 167, 209, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 232, 252, 267, 268, 269, 166,
+	159, 246, 160, 183, 161, 140, 256, 162, 141, 233,
+	272, 0, 180, 242, 205, 142, 204, 235, 271, 270,
+	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 284, 0, 225, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 0, 188, 231, 0, 251, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 282, 295, 285, 0, 0, 0, 294, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 218, 219,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 182, 239, 184, 156, 230, 179, 291,
+	191, 292, 222, 187, 257, 192, 199, 243, 290, 228,
+	248, 155, 281, 258, 203, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 138, 0, 196, 0, 241, 175, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+	134, 135, 136, 0, 0, 298, 299, 300, 143, 255,
+	227, 137, 254, 280, 283, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 195, 0, 197, 0, 0, 259,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	682, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 264, 278, 154, 253, 293, 158, 262,
+	150, 226, 249, 146, 276, 261, 207, 189, 190, 145,
+	0, 244, 168, 181, 165, 224, 0, 0, 164, 296,
+	0, 287, 148, 149, 286, 223, 273, 277, 208, 202,
+	147, 275, 206, 201, 193, 172, 185, 236, 200, 237,
+	186, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 194, 0,
+	0, 0, 0, 0, 247, 229, 0, 0, 234, 245,
+	198, 274, 238, 279, 265, 288, 0, 240, 139, 266,
+	167, 209, 151, 152, 163, 169, 171, 173, 174, 220,
+	221, 232, 252, 267, 268, 269, 166, 159, 246, 160,
+	183, 161, 140, 256, 162, 141, 233, 272, 0, 180,
+	242, 205, 142, 204, 235, 271, 270, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 0, 284,
+	0, 225, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	0, 188, 231, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 282, 295,
+	285, 0, 0, 0, 294, 0, 0, 0, 0, 0,
+	0, 214, 215, 216, 217, 218, 219, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	182, 239, 184, 156, 230, 179, 291, 191, 292, 222,
+	187, 257, 192, 199, 243, 290, 228, 248, 155, 281,
+	258, 203, 178, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
+	196, 0, 241, 175, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	0, 0, 298, 299, 300, 143, 255, 227, 137, 254,
+	280, 283, 0, 0, 0, 0, 0, 170, 0, 0,
+	0, 195, 0, 197, 0, 0, 259, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1743, 0, 0, 98, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
+	264, 278, 154, 253, 293, 158, 262, 150, 226, 249,
+	146, 276, 261, 207, 189, 190, 145, 0, 244, 168,
+	181, 165, 224, 0, 0, 164, 296, 0, 287, 148,
+	149, 286, 223, 273, 277, 208, 202, 147, 275, 206,
+	201, 193, 172, 185, 236, 200, 237, 186, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 263, 0, 0, 194, 0, 0, 0, 0,
+	0, 247, 229, 0, 0, 234, 245, 198, 274, 238,
+	279, 265, 288, 0, 240, 139, 266, 167, 209, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 232, 252,
+	267, 268, 269, 166, 159, 246, 160, 183, 161, 140,
+	256, 162, 141, 233, 272, 0, 180, 242, 205, 142,
+	204, 235, 271, 270, 297, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 284, 0, 225, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 188, 231,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 260, 282, 295, 285, 0, 0,
+	0, 294, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 218, 219, 0, 157, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 176, 182, 239, 184,
+	156, 230, 179, 291, 191, 292, 222, 187, 257, 192,
+	199, 243, 290, 228, 248, 155, 281, 258, 203, 178,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 0, 196, 0, 241,
+	175, 101, 102, 103, 104, 105, 106, 107, 108, 109,
 	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 207, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1870,
-	82, 620, 0, 0, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 261, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 131, 132, 133, 134, 135, 136, 0, 0, 298,
+	299, 300, 143, 255, 227, 137, 254, 280, 283, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 195, 0,
+	197, 0, 0, 259, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 907, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 264, 278, 154,
+	253, 293, 158, 262, 150, 226, 249, 146, 276, 261,
+	207, 189, 190, 145, 0, 244, 168, 181, 165, 224,
+	0, 0, 164, 296, 0, 287, 148, 149, 286, 223,
+	273, 277, 208, 202, 147, 275, 206, 201, 193, 172,
+	185, 236, 200, 237, 186, 212, 211, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 194, 0, 0, 0, 0, 0, 247, 229,
+	0, 0, 234, 245, 198, 274, 238, 279, 265, 288,
+	0, 240, 139, 266, 167, 209, 151, 152, 163, 169,
+	171, 173, 174, 220, 221, 232, 252, 267, 268, 269,
+	166, 159, 246, 160, 183, 161, 140, 256, 162, 141,
+	233, 272, 0, 180, 242, 205, 142, 204, 235, 271,
+	270, 297, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 0, 284, 0, 225, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 0, 188, 231, 0, 251, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 260, 282, 295, 285, 0, 0, 0, 294, 0,
+	0, 0, 0, 0, 0, 214, 215, 216, 217, 218,
+	219, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 182, 239, 184, 156, 230, 179,
+	291, 191, 292, 222, 187, 257, 192, 199, 243, 290,
+	228, 248, 155, 281, 258, 203, 178, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 0, 196, 0, 241, 175, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 207, 0,
-	274, 275, 276, 259, 0, 0, 0, 0, 152, 0,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	697, 0, 0, 0, 135, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 0, 0, 146, 272, 0, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 0,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	0, 0, 226, 209, 0, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 0, 205,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 261, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 1314, 196,
-	197, 198, 199, 0, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 121, 0, 178, 266, 220, 157, 85,
-	86, 87, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 0, 0, 298, 299, 300, 143,
+	255, 227, 137, 254, 280, 283, 0, 0, 0, 0,
+	0, 170, 0, 0, 0, 195, 0, 197, 0, 0,
+	259, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1587, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 144, 264, 278, 154, 253, 293, 158,
+	262, 150, 226, 249, 146, 276, 261, 207, 189, 190,
+	145, 0, 244, 168, 181, 165, 224, 0, 0, 164,
+	296, 0, 287, 148, 149, 286, 223, 273, 277, 208,
+	202, 147, 275, 206, 201, 193, 172, 185, 236, 200,
+	237, 186, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 263, 0, 0, 194,
+	0, 0, 0, 0, 0, 247, 229, 0, 0, 234,
+	245, 198, 274, 238, 279, 265, 288, 0, 240, 139,
+	266, 167, 209, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 232, 252, 267, 268, 269, 166, 159, 246,
+	160, 183, 161, 140, 256, 162, 141, 233, 272, 0,
+	180, 242, 205, 142, 204, 235, 271, 270, 297, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	284, 0, 225, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
+	0, 0, 188, 231, 0, 251, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 282,
+	295, 285, 0, 0, 0, 294, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 218, 219, 0, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 182, 239, 184, 156, 230, 179, 291, 191, 292,
+	222, 187, 257, 192, 199, 243, 290, 228, 248, 155,
+	281, 258, 203, 178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
+	0, 196, 0, 241, 175, 101, 102, 103, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 207, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 152, 1094, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+	136, 0, 0, 298, 299, 300, 143, 255, 227, 137,
+	254, 280, 283, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 195, 0, 197, 0, 0, 259, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 320, 0, 0, 98, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 264, 278, 154, 253, 293, 158, 262, 150, 226,
+	249, 146, 276, 261, 207, 189, 190, 145, 0, 244,
+	168, 181, 165, 224, 0, 0, 164, 296, 0, 287,
+	148, 149, 286, 223, 273, 277, 208, 202, 147, 275,
+	206, 201, 193, 172, 185, 236, 200, 237, 186, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 194, 0, 0, 0,
+	0, 0, 247, 229, 0, 0, 234, 245, 198, 274,
+	238, 279, 265, 288, 0, 240, 139, 266, 167, 209,
+	151, 152, 163, 169, 171, 173, 174, 220, 221, 232,
+	252, 267, 268, 269, 166, 159, 246, 160, 183, 161,
+	140, 256, 162, 141, 233, 272, 0, 180, 242, 205,
+	142, 204, 235, 271, 270, 297, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 284, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 188,
+	231, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 282, 295, 285, 0,
+	0, 0, 294, 0, 0, 0, 0, 0, 0, 214,
+	215, 216, 217, 218, 219, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 182, 239,
+	184, 156, 230, 179, 291, 191, 292, 222, 187, 257,
+	192, 199, 243, 290, 228, 248, 155, 281, 258, 203,
+	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
+	241, 175, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 0, 0,
+	298, 299, 300, 143, 255, 227, 137, 254, 280, 283,
+	0, 0, 0, 0, 0, 170, 0, 0, 0, 195,
+	0, 197, 0, 0, 259, 210, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 697, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 144, 264, 278,
+	154, 253, 293, 158, 262, 150, 226, 249, 146, 276,
+	261, 207, 189, 190, 145, 0, 244, 168, 181, 165,
+	224, 0, 0, 164, 296, 0, 287, 148, 149, 286,
+	223, 273, 277, 208, 202, 147, 275, 206, 201, 193,
+	172, 185, 236, 200, 237, 186, 212, 211, 213, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
+	0, 0, 0, 289, 0, 0, 0, 0, 1176, 0,
+	263, 0, 0, 194, 0, 0, 0, 0, 0, 247,
+	229, 0, 0, 234, 245, 198, 274, 238, 279, 265,
+	288, 0, 240, 139, 266, 167, 209, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 232, 252, 267, 268,
+	269, 166, 159, 246, 160, 183, 161, 140, 256, 162,
+	141, 233, 272, 0, 180, 242, 205, 142, 204, 235,
+	271, 270, 297, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 284, 0, 225, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
+	250, 0, 0, 0, 0, 0, 188, 231, 0, 251,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
+	0, 0, 260, 282, 295, 285, 0, 0, 0, 294,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 217,
+	218, 219, 0, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 176, 182, 239, 184, 156, 230,
+	179, 291, 191, 292, 222, 187, 257, 192, 199, 243,
+	290, 228, 248, 155, 281, 258, 203, 178, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 207, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 620, 0, 0, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	0, 0, 0, 138, 0, 196, 0, 241, 175, 101,
 	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 207,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1545, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 135, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 0, 0, 146, 272, 0,
-	263, 130, 131, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 0, 0, 226, 209, 0, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 0,
-	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 261,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
-	196, 197, 198, 199, 0, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 202, 169, 234, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 185, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 121, 0, 178, 266, 220, 157,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 0, 0, 298, 299, 300,
+	143, 255, 227, 137, 254, 280, 283, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 195, 0, 197, 0,
+	0, 259, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 0, 0, 0, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 144, 264, 278, 154, 253, 293,
+	158, 262, 150, 226, 249, 146, 276, 261, 207, 189,
+	190, 145, 0, 244, 168, 181, 165, 224, 0, 0,
+	164, 296, 0, 287, 148, 149, 286, 223, 273, 277,
+	208, 202, 147, 275, 206, 201, 193, 172, 185, 236,
+	200, 237, 186, 212, 211, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 0, 0, 0, 1172, 0, 263, 0, 0,
+	194, 0, 0, 0, 0, 0, 247, 229, 0, 0,
+	234, 245, 198, 274, 238, 279, 265, 288, 0, 240,
+	139, 266, 167, 209, 151, 152, 163, 169, 171, 173,
+	174, 220, 221, 232, 252, 267, 268, 269, 166, 159,
+	246, 160, 183, 161, 140, 256, 162, 141, 233, 272,
+	0, 180, 242, 205, 142, 204, 235, 271, 270, 297,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	0, 284, 0, 225, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
+	0, 0, 0, 188, 231, 0, 251, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	282, 295, 285, 0, 0, 0, 294, 0, 0, 0,
+	0, 0, 0, 214, 215, 216, 217, 218, 219, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 182, 239, 184, 156, 230, 179, 291, 191,
+	292, 222, 187, 257, 192, 199, 243, 290, 228, 248,
+	155, 281, 258, 203, 178, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 0, 196, 0, 241, 175, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 207, 0, 274, 275,
-	276, 259, 0, 0, 0, 0, 152, 0, 0, 0,
-	177, 0, 179, 0, 0, 236, 192, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 697, 0,
-	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 126, 241,
-	255, 136, 232, 269, 140, 239, 132, 206, 228, 128,
-	253, 238, 189, 171, 172, 127, 0, 223, 150, 163,
-	147, 204, 0, 0, 146, 272, 0, 263, 130, 131,
-	262, 203, 250, 254, 190, 184, 129, 252, 188, 183,
-	175, 154, 167, 216, 182, 217, 168, 194, 193, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 0, 0, 0, 0,
-	0, 240, 0, 0, 176, 0, 0, 0, 0, 0,
-	226, 209, 0, 0, 214, 224, 180, 251, 218, 256,
-	242, 264, 0, 219, 122, 243, 149, 191, 133, 134,
-	145, 151, 153, 155, 156, 200, 201, 212, 231, 244,
-	245, 246, 148, 141, 225, 142, 165, 143, 123, 233,
-	144, 124, 213, 249, 0, 162, 221, 187, 125, 186,
-	215, 248, 247, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 260, 0, 205, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 170, 211, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 258, 271, 261, 0, 0, 0,
-	270, 0, 0, 0, 0, 0, 0, 196, 197, 198,
-	199, 0, 139, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 158, 164, 0, 166, 138, 210, 161,
-	268, 173, 202, 169, 234, 174, 181, 222, 267, 208,
-	227, 137, 257, 235, 185, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 121, 0, 178, 266, 220, 157, 85, 86, 87,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 0, 0, 298, 299, 300, 143, 255, 227,
+	137, 254, 280, 283, 0, 0, 0, 0, 0, 170,
+	0, 0, 0, 195, 0, 197, 0, 0, 259, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 144, 264, 278, 154, 253, 293, 158, 262, 150,
+	226, 249, 146, 276, 261, 207, 189, 190, 145, 0,
+	244, 168, 181, 165, 224, 0, 0, 164, 296, 0,
+	287, 148, 149, 286, 223, 273, 277, 208, 202, 147,
+	275, 206, 201, 193, 172, 185, 236, 200, 237, 186,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 194, 0, 0,
+	0, 0, 0, 247, 229, 0, 0, 234, 245, 198,
+	274, 238, 279, 265, 288, 0, 240, 139, 266, 167,
+	209, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	232, 252, 267, 268, 269, 166, 159, 246, 160, 183,
+	161, 140, 256, 162, 141, 233, 272, 0, 180, 242,
+	205, 142, 204, 235, 271, 270, 297, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 284, 0,
+	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	188, 231, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 282, 295, 285,
+	0, 0, 0, 294, 0, 0, 0, 0, 0, 0,
+	214, 215, 216, 217, 218, 219, 0, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 182,
+	239, 184, 156, 230, 179, 291, 191, 292, 222, 187,
+	257, 192, 199, 243, 290, 228, 248, 155, 281, 258,
+	203, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 0, 196,
+	0, 241, 175, 101, 102, 103, 104, 105, 106, 107,
 	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 207, 0, 274, 275, 276, 259, 0,
-	0, 0, 0, 152, 0, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 0, 0, 0, 135,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 196, 197, 198, 199, 0, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 0,
+	0, 298, 299, 300, 143, 255, 227, 137, 254, 280,
+	283, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	195, 0, 197, 0, 0, 259, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 351, 0, 0, 352, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 264,
+	278, 154, 253, 293, 158, 262, 150, 226, 249, 146,
+	276, 261, 207, 189, 190, 145, 0, 244, 168, 181,
+	165, 224, 0, 0, 164, 296, 0, 287, 148, 149,
+	286, 223, 273, 277, 208, 202, 147, 275, 206, 201,
+	193, 172, 185, 236, 200, 237, 186, 212, 211, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 194, 0, 0, 0, 0, 0,
+	247, 229, 0, 0, 234, 245, 198, 274, 238, 279,
+	265, 288, 0, 240, 139, 266, 167, 209, 151, 152,
+	163, 169, 171, 173, 174, 220, 221, 232, 252, 267,
+	268, 269, 166, 159, 246, 160, 183, 161, 140, 256,
+	162, 141, 233, 272, 0, 180, 242, 205, 142, 204,
+	235, 271, 270, 297, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 0, 284, 0, 225, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 188, 231, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 282, 295, 285, 0, 0, 0,
+	294, 0, 0, 0, 0, 0, 0, 214, 215, 216,
+	217, 218, 219, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 182, 239, 184, 156,
+	230, 179, 291, 191, 292, 222, 187, 257, 192, 199,
+	243, 290, 228, 248, 155, 281, 258, 203, 178, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 0, 196, 0, 241, 175,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	207, 0, 274, 275, 276, 259, 0, 0, 0, 0,
-	152, 0, 0, 0, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 176, 0,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 256, 242, 264, 0, 219, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 170, 211, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 0, 0, 298, 299,
+	300, 143, 255, 227, 137, 254, 280, 283, 0, 0,
+	0, 0, 0, 170, 0, 0, 0, 195, 0, 197,
+	0, 0, 259, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 144, 264, 278, 154, 253,
+	293, 158, 262, 150, 226, 249, 146, 276, 261, 207,
+	189, 190, 145, 0, 244, 168, 181, 165, 224, 0,
+	0, 164, 296, 0, 287, 148, 149, 286, 223, 273,
+	277, 208, 202, 147, 275, 206, 201, 193, 172, 185,
+	236, 200, 237, 186, 212, 211, 213, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 0, 0, 0, 0, 1176, 0, 263, 0,
+	0, 194, 0, 0, 0, 0, 0, 247, 229, 0,
+	0, 1177, 245, 198, 274, 238, 279, 265, 288, 0,
+	240, 139, 266, 167, 209, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 232, 252, 267, 268, 269, 166,
+	159, 246, 160, 183, 161, 140, 256, 162, 141, 233,
+	272, 0, 180, 242, 205, 142, 204, 235, 271, 270,
+	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 284, 0, 225, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 0, 188, 231, 0, 251, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 282, 295, 285, 0, 0, 0, 294, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 218, 219,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 182, 239, 184, 156, 230, 179, 291,
+	191, 292, 222, 187, 257, 192, 199, 243, 290, 228,
+	248, 155, 281, 258, 203, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 138, 0, 196, 0, 241, 175, 101, 102, 103,
 	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 207, 0, 274,
-	275, 276, 259, 0, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1107, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 0, 0, 146, 272, 0, 263, 130,
-	131, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 0,
-	0, 226, 209, 0, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 0, 205, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 261, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 0, 196, 197,
-	198, 199, 0, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 202, 169, 234, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 185, 160, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 121, 0, 178, 266, 220, 157, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+	134, 135, 136, 0, 0, 298, 299, 300, 143, 255,
+	227, 137, 254, 280, 283, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 195, 0, 197, 0, 0, 259,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 264, 278, 154, 253, 293, 158, 262,
+	150, 226, 249, 146, 276, 261, 207, 189, 190, 145,
+	0, 244, 168, 181, 165, 224, 0, 0, 164, 296,
+	0, 287, 148, 149, 286, 223, 273, 277, 208, 202,
+	147, 275, 206, 201, 193, 172, 185, 236, 200, 237,
+	186, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	0, 0, 0, 1172, 0, 263, 0, 0, 194, 0,
+	0, 0, 0, 0, 247, 229, 0, 0, 1173, 245,
+	198, 274, 238, 279, 265, 288, 0, 240, 139, 266,
+	167, 209, 151, 152, 163, 169, 171, 173, 174, 220,
+	221, 232, 252, 267, 268, 269, 166, 159, 246, 160,
+	183, 161, 140, 256, 162, 141, 233, 272, 0, 180,
+	242, 205, 142, 204, 235, 271, 270, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 0, 284,
+	0, 225, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	0, 188, 231, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 282, 295,
+	285, 0, 0, 0, 294, 0, 0, 0, 0, 0,
+	0, 214, 215, 216, 217, 218, 219, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	182, 239, 184, 156, 230, 179, 291, 191, 292, 222,
+	187, 257, 192, 199, 243, 290, 228, 248, 155, 281,
+	258, 203, 178, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
+	196, 0, 241, 175, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 207, 0, 274, 275, 276, 259,
-	0, 0, 0, 0, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 326, 0, 0, 327, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 0, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 0, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 85, 86, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	0, 0, 298, 299, 300, 143, 255, 227, 137, 254,
+	280, 283, 0, 0, 0, 0, 0, 170, 0, 0,
+	0, 195, 0, 197, 0, 0, 259, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 907,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
+	264, 278, 154, 253, 293, 158, 262, 150, 226, 249,
+	146, 276, 261, 207, 189, 190, 145, 0, 244, 168,
+	181, 165, 224, 0, 0, 164, 296, 0, 287, 148,
+	149, 286, 223, 273, 277, 208, 202, 147, 275, 206,
+	201, 193, 172, 185, 236, 200, 237, 186, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 263, 0, 0, 194, 0, 0, 0, 0,
+	0, 247, 229, 0, 0, 234, 245, 198, 274, 238,
+	279, 265, 288, 0, 240, 139, 266, 167, 209, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 232, 252,
+	267, 268, 269, 166, 159, 246, 160, 183, 161, 140,
+	256, 162, 141, 233, 272, 0, 180, 242, 205, 142,
+	204, 235, 271, 270, 297, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 284, 0, 225, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 188, 231,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 260, 282, 295, 961, 0, 0,
+	0, 294, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 218, 219, 0, 157, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 176, 182, 239, 184,
+	156, 230, 179, 291, 191, 292, 222, 187, 257, 192,
+	199, 243, 290, 228, 248, 155, 281, 258, 203, 178,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 0, 196, 0, 241,
+	175, 101, 102, 103, 104, 105, 106, 107, 108, 109,
 	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 207, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 697, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 735, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 131, 132, 133, 134, 135, 136, 0, 0, 298,
+	299, 300, 143, 255, 227, 137, 254, 280, 283, 0,
+	0, 0, 0, 95, 170, 0, 0, 0, 195, 0,
+	197, 0, 0, 259, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 264, 278, 154,
+	253, 293, 158, 262, 150, 226, 249, 146, 276, 261,
+	207, 189, 190, 145, 0, 244, 168, 181, 165, 224,
+	0, 0, 164, 296, 0, 287, 148, 149, 286, 223,
+	273, 277, 208, 202, 147, 275, 206, 201, 193, 172,
+	185, 236, 200, 237, 186, 212, 211, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 194, 0, 0, 0, 0, 0, 247, 229,
+	0, 0, 234, 245, 198, 274, 238, 279, 265, 288,
+	0, 240, 139, 266, 167, 209, 151, 152, 163, 169,
+	171, 173, 174, 220, 221, 232, 252, 267, 268, 269,
+	166, 159, 246, 160, 183, 161, 140, 256, 162, 141,
+	233, 272, 0, 180, 242, 205, 142, 204, 235, 271,
+	270, 297, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 0, 284, 0, 225, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 0, 188, 231, 0, 251, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 260, 282, 295, 285, 0, 0, 0, 294, 0,
+	0, 0, 0, 0, 0, 214, 215, 216, 217, 218,
+	219, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 182, 239, 184, 156, 230, 179,
+	291, 191, 292, 222, 187, 257, 192, 199, 243, 290,
+	228, 248, 155, 281, 258, 203, 178, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 0, 196, 0, 241, 175, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 207, 0,
-	274, 275, 276, 259, 0, 0, 0, 79, 152, 0,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 135, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 0, 0, 146, 272, 0, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 0,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	0, 0, 226, 209, 0, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 0, 205,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 261, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 0, 196,
-	197, 198, 199, 0, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 121, 0, 178, 266, 220, 157, 85,
-	86, 87, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 0, 0, 298, 299, 300, 143,
+	255, 227, 137, 254, 280, 283, 0, 0, 0, 0,
+	0, 170, 0, 0, 0, 195, 0, 197, 0, 0,
+	259, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 144, 264, 278, 154, 253, 293, 158,
+	262, 150, 226, 249, 146, 276, 261, 207, 189, 190,
+	145, 0, 244, 168, 181, 165, 224, 0, 0, 164,
+	296, 0, 287, 148, 149, 286, 223, 273, 277, 208,
+	202, 147, 275, 206, 201, 193, 172, 185, 236, 200,
+	237, 186, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 263, 0, 0, 194,
+	0, 0, 0, 0, 0, 247, 229, 0, 0, 234,
+	245, 198, 274, 238, 279, 265, 288, 0, 240, 139,
+	266, 167, 209, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 232, 252, 267, 268, 269, 166, 159, 246,
+	160, 183, 161, 140, 256, 162, 141, 233, 272, 0,
+	180, 242, 205, 142, 204, 235, 271, 270, 297, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	284, 0, 225, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
+	0, 0, 188, 231, 0, 251, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 282,
+	295, 285, 0, 0, 0, 294, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 218, 219, 0, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 182, 239, 184, 156, 230, 179, 291, 191, 292,
+	222, 187, 257, 192, 199, 243, 290, 228, 248, 155,
+	281, 258, 203, 178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
+	0, 196, 0, 241, 175, 101, 102, 103, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 207, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+	136, 0, 0, 298, 299, 300, 143, 255, 227, 137,
+	254, 280, 283, 482, 0, 0, 0, 0, 170, 0,
+	0, 0, 195, 0, 197, 0, 0, 259, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 487, 488, 489,
+	484, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 264, 278, 154, 253, 293, 158, 262, 150, 226,
+	249, 146, 276, 261, 207, 189, 190, 145, 0, 244,
+	168, 181, 165, 224, 0, 0, 164, 296, 0, 287,
+	148, 149, 286, 223, 273, 277, 208, 202, 147, 275,
+	206, 201, 193, 172, 185, 236, 200, 237, 186, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 194, 0, 0, 0,
+	0, 0, 247, 229, 0, 0, 234, 245, 198, 274,
+	238, 279, 265, 288, 0, 240, 139, 266, 167, 209,
+	151, 152, 163, 169, 171, 173, 174, 220, 221, 232,
+	252, 267, 268, 269, 166, 159, 246, 160, 183, 161,
+	140, 256, 162, 141, 233, 272, 0, 180, 242, 205,
+	142, 204, 235, 271, 270, 297, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 284, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 188,
+	231, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 282, 295, 285, 0,
+	0, 0, 294, 0, 0, 0, 0, 0, 0, 214,
+	215, 216, 217, 218, 219, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 182, 239,
+	184, 156, 230, 179, 291, 191, 292, 222, 187, 257,
+	192, 199, 243, 290, 228, 248, 155, 281, 258, 203,
+	178, 0, 0, 227, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 0, 0, 138, 195, 196, 197,
+	241, 175, 259, 210, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 487, 488, 489, 484, 0, 0, 0, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	298, 299, 300, 143, 255, 0, 137, 254, 280, 283,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
+	0, 0, 0, 0, 0, 144, 264, 278, 154, 253,
+	293, 158, 262, 150, 226, 249, 146, 276, 261, 207,
+	189, 190, 145, 0, 244, 168, 181, 165, 224, 0,
+	0, 164, 296, 0, 287, 148, 149, 286, 223, 273,
+	277, 208, 202, 147, 275, 206, 201, 193, 172, 185,
+	236, 200, 237, 186, 212, 211, 213, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 194, 0, 0, 0, 0, 0, 247, 229, 0,
+	0, 234, 245, 198, 274, 238, 279, 265, 288, 0,
+	240, 139, 266, 167, 209, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 232, 252, 267, 268, 269, 166,
+	159, 246, 160, 183, 161, 140, 256, 162, 141, 233,
+	272, 0, 180, 242, 205, 142, 204, 235, 271, 270,
+	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 284, 0, 225, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 0, 188, 231, 0, 251, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
+	260, 282, 295, 285, 0, 0, 0, 294, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 218, 219,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 182, 239, 184, 156, 230, 179, 291,
+	191, 292, 222, 187, 257, 192, 199, 243, 290, 228,
+	248, 155, 281, 258, 203, 178, 0, 0, 227, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 138, 195, 196, 197, 241, 175, 259, 210, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 487, 488, 489,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 298, 299, 300, 143, 255,
+	0, 137, 254, 280, 283, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 0, 207, 274, 275, 276, 259, 436, 0,
-	0, 0, 0, 152, 0, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 441, 442, 443, 438, 0, 0, 0, 135,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 196, 197, 198, 199, 0, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 441, 442, 443, 438, 0, 0, 0,
-	135, 0, 274, 275, 276, 259, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 0, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 0, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 441, 442, 443, 0, 0, 0,
-	0, 135, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 1571, 159, 0, 260, 0, 205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 1067, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	1571, 1634, 0, 0, 0, 0, 196, 197, 198, 199,
-	1553, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 1067, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1553,
-	121, 0, 178, 266, 220, 157, 0, 76, 0, 23,
-	39, 24, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 0,
-	0, 71, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 274, 275, 276, 259, 0, 0,
-	40, 0, 0, 0, 0, 73, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1557, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1561, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1550, 0, 0, 0, 1552, 1554, 1556, 0,
-	1558, 1559, 1560, 1562, 1563, 1564, 1566, 1567, 1568, 1569,
-	1557, 67, 68, 0, 69, 70, 0, 0, 0, 0,
-	0, 1561, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1572, 0, 0, 0, 0, 0, 0, 0,
-	0, 1550, 0, 0, 0, 1552, 1554, 1556, 0, 1558,
-	1559, 1560, 1562, 1563, 1564, 1566, 1567, 1568, 1569, 0,
-	0, 0, 1570, 0, 0, 0, 0, 0, 56, 66,
-	74, 0, 38, 0, 0, 0, 0, 0, 0, 1549,
-	0, 1572, 0, 0, 0, 0, 0, 0, 65, 63,
-	62, 0, 0, 0, 1565, 0, 0, 0, 0, 0,
-	1555, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1570, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1549, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1565, 0, 0, 0, 0, 0, 1555,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 48, 0, 0, 0, 0, 0,
-	49, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 50,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 264, 278, 154, 253, 293, 158, 262, 150, 226,
+	249, 146, 276, 261, 207, 189, 190, 145, 0, 244,
+	168, 181, 165, 224, 0, 0, 164, 296, 0, 287,
+	148, 149, 286, 223, 273, 277, 208, 202, 147, 275,
+	206, 201, 193, 172, 185, 236, 200, 237, 186, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 194, 0, 0, 0,
+	0, 0, 247, 229, 0, 0, 234, 245, 198, 274,
+	238, 279, 265, 288, 0, 240, 139, 266, 167, 209,
+	151, 152, 163, 169, 171, 173, 174, 220, 221, 232,
+	252, 267, 268, 269, 166, 159, 246, 160, 183, 161,
+	140, 256, 162, 141, 233, 272, 0, 180, 242, 205,
+	142, 204, 235, 271, 270, 297, 1769, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 284, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1156, 0, 0, 250, 0, 0, 0, 0, 0, 188,
+	231, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 282, 295, 285, 0,
+	0, 0, 294, 0, 0, 1751, 0, 0, 0, 214,
+	215, 216, 217, 218, 219, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 182, 239,
+	184, 156, 230, 179, 291, 191, 292, 222, 187, 257,
+	192, 199, 243, 290, 228, 248, 155, 281, 258, 203,
+	178, 338, 0, 337, 341, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 329, 138, 0, 196, 0,
+	241, 175, 0, 0, 0, 0, 348, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	298, 299, 300, 143, 255, 0, 137, 254, 280, 283,
+	0, 0, 0, 0, 0, 0, 1755, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1759, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1748, 0, 0,
+	0, 1750, 1752, 1754, 0, 1756, 1757, 1758, 1760, 1761,
+	1762, 1764, 1765, 1766, 1767, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1770, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 331, 330, 334, 0, 0, 1768, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 340, 0, 1747, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 900, 0, 0, 1763,
+	0, 0, 0, 0, 0, 0, 0, 1753, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 335, 339, 901, 0, 343, 902, 0, 0,
+	345, 346, 347, 0, 0, 349, 350, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1771,
 }
 
 var yyPact = [...]int{
-	15911, -1000, -297, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	174, -1000, -289, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16926, 1683,
+	-1000, 7550, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 255, 254, 13670, 17333, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 7126, 6702, 128, -184,
+	-188, -169, -92, -1000, 1610, 1401, -1000, -1000, -1000, -1000,
+	129, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	523, 88, 352, 362, 401, 401, 8364, 1690, 1401, 17333,
+	2, -1000, 1624, 174, 192, 17333, -1000, 491, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 14040, 1513, -1000, 6869, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 155, 12452,
-	14437, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6057, 5642,
-	76, -1000, 1443, -1000, -1000, -1000, 70, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 372, -88, 232, 236, 263,
-	263, 7266, 1501, 1236, -32, -1000, 1452, 15911, 106, 14437,
-	-1000, 279, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2610,67 +2920,51 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 13670, 17333, -72, 633, -1000, 156, 476, -1000, -1000,
+	-1000, -1000, 17333, 17333, 1437, -1000, -1000, -1000, 1607, 17740,
+	1401, -1000, 1347, 1353, -1000, -1000, 1504, -1000, 90, 15,
+	-23, 91, -1000, -1000, 165, -1000, -1000, -1000, -1000, -1000,
+	43, -1000, 3, -1000, -9, -1000, -1000, -1000, -109, -1000,
+	-1000, -1000, -1000, -1000, 1194, 385, 1523, -158, 18430, 18430,
+	916, -1000, -1000, 247, 245, 716, -1000, 1594, 1630, 1401,
+	-268, 1654, 1619, -1000, 1690, 237, 213, 213, 233, 213,
+	241, -199, -1000, -1000, -1000, -1000, -1000, -1000, 1616, 654,
+	170, -1000, -1000, -115, -129, 569, -129, -5, -1000, -1000,
+	-1000, -1000, -1000, -1000, 17333, 214, -1000, -189, -1000, 336,
+	-1000, 320, -1000, 9590, 160, 1355, 680, -1000, 598, 17333,
+	17333, 17333, 598, 786, 685, 470, -1000, -1000, -1000, 1566,
+	1567, 1630, 1401, -1000, 1185, 1113, 1350, -1000, 1465, 214,
+	214, 214, 214, 214, 214, 5027, -1000, -1000, -1000, -1000,
+	-1000, 189, 1503, -1000, 2103, 1449, -1000, 455, 915, 1057,
+	-1000, 17333, 1346, -1000, 232, 1502, 17333, 13670, 13670, 13670,
+	13670, -1000, 1547, 1544, -1000, 1537, 1534, 1543, 18430, -1000,
+	-1000, -1000, 18085, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1176, 108, 18785, 12856, 15298, 17333, 12856, -1000, -1000, -1000,
+	-1000, -1000, -116, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 108, 12856, 12856, -87, -1000, 230, -1000,
+	-1000, 1668, -1000, 17333, 17333, -1000, -1000, 1594, 5444, -1000,
+	-1000, 1056, 5444, -1000, -1000, 17333, 12856, 644, 15298, 943,
+	17333, 213, -1000, 12856, 17333, -1000, -1000, 569, 569, -1000,
+	654, 654, -1000, -1000, -121, 1666, 5861, -123, 17333, 17333,
+	213, 231, 16519, 1599, -140, 348, 329, 338, -1000, -1000,
+	-163, -1000, -1000, 1313, 10414, 9178, 209, 12856, 2935, -1000,
+	-1000, 598, 598, 598, 2935, 500, -1000, -1000, -1000, -1000,
+	-1000, -1000, 17333, -1000, -1000, 1594, -1000, -1000, -1000, -1000,
+	-1000, 17333, 1603, 17333, 12856, 15298, 17333, 17333, 17333, 18430,
+	1351, -1000, -1000, 8771, 450, 5444, 894, 1498, -1000, 1497,
+	1496, 1495, 1494, 1491, 1490, 1489, 1475, 1488, 1487, -1000,
+	-1000, -1000, 1486, 1485, 1475, 1482, 1481, 1480, -1000, -1000,
+	669, -1000, -1000, -1000, -1000, 4610, 5861, 5861, 5861, 5861,
+	-1000, -1000, 1479, 1478, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 6278, -1000, 1477,
+	1476, 1475, 1471, 1055, 1053, 1051, 1470, 1469, 1468, 5861,
+	1466, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 12452, 14437, -121,
-	354, -1000, 1098, 276, -1000, -1000, -1000, -1000, 14437, 1420,
-	-1000, -1000, -1000, 1446, 14835, 1236, -1000, 1140, 1175, -1000,
-	-1000, 1334, -1000, 73, -51, -73, 36, -1000, -1000, 92,
-	-1000, -1000, -1000, -1000, -1000, -7, -1000, -58, -1000, -66,
-	-1000, -1000, -1000, -158, -1000, -1000, -1000, -1000, -1000, 1115,
-	295, 1359, -206, -1000, 1424, 1462, 1236, -283, 1491, 1461,
-	120, 120, 136, 120, 146, -1000, -1000, -1000, -1000, -1000,
-	-1000, 420, 91, -1000, -1000, -178, -172, 305, -172, -36,
-	-1000, -1000, -1000, -1000, -1000, -1000, 125, -1000, -210, -1000,
-	223, -1000, 218, -1000, 8464, 82, 1189, 447, -1000, 344,
-	14437, 14437, 14437, 344, 612, 566, 272, -1000, -1000, -1000,
-	1411, 1412, 1462, 1236, -1000, 1099, 1031, 125, 125, 125,
-	125, 125, 4006, -1000, -1000, -1000, -1000, -1000, 1072, 1331,
-	-1000, 14437, 1348, -1000, 271, 721, 898, -1000, 14437, 1330,
-	14437, 12452, 12452, 12452, 12452, -1000, 1386, 1385, -1000, 1373,
-	1371, 1389, 15537, -1000, -1000, -1000, 15186, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1097, 1501, 52, 731, 11658, 13246,
-	14437, 11658, -1000, -1000, -1000, -1000, -1000, -162, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 52, 11658,
-	11658, -130, -1000, -1000, 1424, 4413, -1000, -1000, 889, 4413,
-	-1000, -1000, 11658, 373, 13246, 749, 14437, 120, 14437, -1000,
-	-1000, 305, 305, -1000, 420, 420, -1000, -1000, -165, 1502,
-	4820, -171, 14437, 120, 13643, 1433, -196, 230, 220, 226,
-	-1000, -1000, -208, -1000, -1000, 1178, 9276, 8061, 149, 11658,
-	2370, -1000, -1000, 344, 344, 344, 2370, 261, -1000, -1000,
-	-1000, -1000, -1000, -1000, 14437, -1000, -1000, 1424, -1000, -1000,
-	-1000, -1000, -1000, 11658, 13246, 14437, 14437, 15537, 1161, -1000,
-	-1000, 7664, 258, 4413, 781, 1329, -1000, 1328, 1326, 1325,
-	1324, 1323, 1317, 1316, 1286, 1313, 1310, -1000, -1000, -1000,
-	1298, 1297, 1286, 1296, 1295, 1293, -1000, -1000, 785, -1000,
-	-1000, -1000, -1000, 3599, 4820, 4820, 4820, 4820, -1000, -1000,
-	1292, 1289, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 5227, -1000, 1288, 1287, 1286,
-	1271, 881, 878, 876, 1270, 1269, 1268, 4820, 1266, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -265, -1000, 10002, 17333,
+	17333, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -281, -1000, 8873, 14437, 14437, -1000,
-	1474, 4413, 1967, -1000, 1082, 255, 14437, 1127, -1000, 349,
-	1347, 1358, 1347, -1000, -1000, -1000, -1000, 1372, -1000, 1370,
-	-1000, -1000, -1000, -1000, -1000, 427, -1000, -1000, -1000, -1000,
-	-1000, -58, -66, 1168, -1000, -90, 69, -1000, -1000, 1165,
-	-1000, -1000, -1000, 427, 1168, 132, 875, -1000, 645, 254,
-	-180, 1185, -1000, 710, 159, 1428, 1178, 1350, 1414, 14437,
-	1502, 1502, 1502, 305, 15537, 420, 14437, 420, -1000, -1000,
-	420, -1000, 253, 14437, 159, 1264, -1000, -1000, -1000, 228,
-	217, 216, 13246, 131, -1000, -1000, 1178, -1000, -1000, -1000,
-	1260, 328, -1000, -1000, 4820, -1000, 623, -1000, 2370, 2370,
-	2370, -1000, 10467, -1000, -1000, 1168, 1178, 1356, 1182, -1000,
-	-1000, -1000, -1000, 1502, 4006, -1000, 12452, -1000, 4413, 4413,
-	4413, -1000, 14437, 12849, -1000, 460, 4820, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4413, 1455, 1455, 1455, 4413, 379, 4413,
-	4413, -1000, 691, 1455, 1455, 1455, 1455, -1000, 1455, 1455,
-	1455, 4820, 4820, 4820, 4820, 4820, 4820, 4820, 4820, 4820,
-	4820, 4820, 4820, 1241, 582, 4820, 4820, 4820, 1031, 1003,
-	1181, -1000, -1000, -1000, -1000, -1000, 4413, 178, 4413, -1000,
-	1081, -1000, -1000, 4413, -1000, -1000, -1000, 4413, 4820, 4413,
-	-1000, 1455, 1153, -1000, 1257, -1000, 1163, 1401, -1000, 252,
-	1180, -1000, 325, 1159, -1000, 1462, 623, -1000, 251, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2682,144 +2976,172 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1656, 5444,
+	2528, -1000, 183, 435, 17333, 17333, 17333, 1284, -1000, 614,
+	1509, 1522, 1509, -1000, -1000, -1000, -1000, 1535, -1000, 1512,
+	-1000, -1000, -1000, -1000, -1000, 603, -1000, -1000, -1000, -1000,
+	-1000, 3, -9, 1259, -1000, -50, 86, -1000, -1000, 1341,
+	-1000, -1000, -1000, 603, 1259, 229, 1049, 1038, 1030, 1334,
+	-1000, 1334, -1000, 944, 394, -70, 1345, -1000, 829, 1465,
+	211, 1596, 1313, 1459, 1585, 17333, -1000, 1666, 1666, 1666,
+	569, 18430, 654, 17333, 654, -1000, -1000, 654, -1000, 393,
+	-1000, 17333, 1344, -1000, -1000, 16112, 15705, 188, 433, 210,
+	211, 1464, -1000, -1000, -1000, 345, 314, 331, 15298, 221,
+	-1000, -1000, 1313, -1000, -1000, -1000, 1463, 608, -1000, -1000,
+	5861, -1000, 661, -1000, 2935, 2935, 2935, -1000, 11635, -1000,
+	-1000, -1000, 1461, 1339, -1000, 1259, 1313, 1519, 1334, 1334,
+	-1000, 1666, 5027, -1000, 13670, -1000, 5444, 5444, 5444, -1000,
+	17333, 14891, -1000, 667, 5861, -1000, -1000, -1000, -1000, -1000,
+	-1000, 5444, 1612, 1612, 1612, 5444, 602, 5444, 5444, -1000,
+	765, 1612, 1612, 1612, 1612, -1000, 1612, 1612, 1612, 5861,
+	5861, 5861, 5861, 5861, 5861, 5861, 5861, 5861, 5861, 5861,
+	5861, 1435, 580, 5861, 5861, 5861, 1113, 1220, 1327, -1000,
+	-1000, -1000, -1000, -1000, 5444, 252, 5444, -1000, 1172, -1000,
+	-1000, 5444, -1000, -1000, -1000, 5444, 5861, 5444, -1000, 1612,
+	1228, -1000, 1458, -1000, 1332, 1558, -1000, 380, 1320, -1000,
+	601, 1329, -1000, 1630, 661, -1000, 371, -1000, -1000, -1000,
+	-1000, -1000, -84, -1000, 17333, -1000, -1000, 1324, 1656, 17333,
+	5444, -1000, -1000, 5444, 1456, -1000, 5444, -1000, -1000, -1000,
+	1665, 370, 369, 12856, -1000, 144, 12856, -1000, -1000, 17333,
+	219, 12856, -11, -1000, -1000, 17333, 5444, 5444, 17333, 135,
+	17333, 5444, -1000, -1000, -1000, 1601, -211, -1000, -49, -1000,
+	1518, 84, -1000, 1585, -1000, 358, -1000, 1455, -1000, -1000,
+	-1000, 1666, -1000, 569, -1000, 569, 654, 17333, -1000, -1000,
+	263, -1000, 17333, 1454, 10759, -1000, 17333, 17333, 17333, 17333,
+	17333, -1000, -1000, 17333, -1000, -211, 1168, -1000, -1000, -1000,
+	306, 1313, 12856, 997, 209, -1000, -1000, -1000, -1000, -1000,
+	149, -1000, 17333, 17333, 1661, -1000, 1294, 1457, -1000, 675,
+	678, -1000, 368, -1000, -1000, 732, -1000, 1164, 1218, 661,
+	5444, -1000, -1000, 5444, 5444, 851, 5444, 1162, 1302, 1300,
+	-1000, 1159, -1000, 5444, 5444, 5444, 5444, 5444, 5444, 5444,
+	679, 1322, -1000, 830, 830, 507, 507, 507, 507, 507,
+	806, 806, -1000, -1000, -1000, 4610, 1435, 5861, 5861, 5861,
+	194, 2809, 1821, -1000, 5444, 818, -1000, -1000, 1156, -1000,
+	1088, 1150, 1694, 1145, 5444, -265, 4186, 206, 17333, -265,
+	17333, 17333, 4186, -1000, 17333, -1000, 2528, 909, -1000, -1000,
+	1630, -1000, 661, 661, 17333, 661, 12856, 526, 582, -1000,
+	11228, 12856, -1000, -1000, 12856, 101, 1589, -1000, -1000, -1000,
+	661, 661, 367, -123, 1029, -1000, -1000, 149, -1000, -71,
+	-1000, -1000, -1000, 236, -1000, 1026, 1023, 1022, 1012, 17333,
+	-1000, -1000, -1000, -1000, -1000, 586, 586, 586, 1566, 7957,
+	-1000, 1666, 1666, 569, -1000, -1000, 14484, 14077, -1000, 180,
+	736, -27, -1000, -1000, -1000, 1410, -1000, 1416, 1410, 1410,
+	1410, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1433, 1432, -1000, 1410, 1410, 1410, 1410, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1421, 1422, 1421, -1000, 1286, 1286, 217, -1000,
+	422, -10, -52, -1000, 1259, 1141, -1000, -1000, 1136, -1000,
+	-1000, 1659, 1653, 13670, 13263, -1000, -1000, 5444, 1187, 1180,
+	1173, 142, 1290, -1000, -1000, -1000, -1000, 1155, 1130, 1077,
+	1069, 1028, 1024, 1021, 1288, -1000, 194, 2809, 777, -1000,
+	5861, 5861, 954, 142, 737, -1000, -1000, 737, -1000, 5861,
+	-1000, 938, -1000, 1127, 1279, -1000, -265, -1000, -1000, 1228,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1281, 1259, -1000, -1000, -1000, -1000, 12856, 1606, 211, -1000,
+	7, 240, 17333, -99, -85, -1000, -1000, -71, -1000, 899,
+	896, 895, 890, 886, 883, -40, -1000, -1000, -1000, -1000,
+	-1000, 1420, 737, -1000, 790, 1010, 1116, 1252, -1000, -1000,
+	-1000, 511, -1000, 17333, 686, 382, 213, 382, 682, 1419,
+	-1000, -1000, -1000, -1000, 1666, 1276, -1000, 947, -1000, 736,
+	-1000, -1000, 729, 5861, -1000, -1000, 1009, 790, 356, 346,
+	1417, -1000, 83, 672, 670, -1000, 17333, -1000, -32, -1000,
+	-1000, -1000, -1000, 881, -1000, 880, -1000, -1000, -1000, 1007,
+	1007, -1000, -1000, -1000, -1000, -1000, 870, -1000, 869, -1000,
+	17333, 1584, 1583, -1000, -10, -1000, 273, 297, 41, 1651,
+	-1000, -1000, -1000, 5444, 5444, 1457, -1000, -1000, 661, -1000,
+	-1000, -1000, 1111, -1000, 1410, 1416, -1000, 1410, 1410, 1410,
+	318, 318, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 5861, -1000, -1000, -1000, 1107, 1102, 1094, 1664,
+	-1000, -1000, 4186, 1228, -1000, -1000, 12856, 12856, -221, 1,
+	17333, -270, -97, -85, -1000, 1649, -93, 1642, 1639, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 12449, -1000, -1000,
+	-1000, -1000, -1000, -1000, 18681, 7957, -1000, -1000, 17333, 17333,
+	-1000, 17333, 17333, 213, 5444, -1000, -1000, 180, 1556, -1000,
+	-1000, 2809, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 867, 1412, -1000, -1000, 1411, -1000, -1000,
+	1079, 1075, 1265, -1000, 1263, 1225, 1261, -1000, 5861, -1000,
+	-1000, -1000, -1000, 862, -1000, -1000, -1000, 997, 661, 1218,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -123, -1000,
-	14437, 1157, -1000, 1474, 14437, 4413, -1000, -1000, 4413, 1243,
-	-1000, 4413, -1000, -1000, -1000, 1504, 250, 248, 11658, -1000,
-	121, 11658, -1000, -1000, 14437, 130, 11658, -41, 4413, 4413,
-	14437, -151, -140, 4413, -1000, -1000, -1000, -235, -1000, -106,
-	-1000, 1355, 20, -1000, 1414, -1000, 330, -1000, 1242, -1000,
-	-1000, -1000, 1502, -1000, 305, -1000, 305, 420, 14437, -1000,
-	-1000, -235, 1073, -1000, -1000, -1000, 203, 1178, 11658, 797,
-	149, -1000, -1000, -1000, -1000, -1000, 14437, 14437, 1499, -1000,
-	1176, 1314, -1000, 458, 376, -1000, 247, -1000, -1000, 489,
-	-1000, 1068, 1133, 623, 4413, -1000, -1000, 4413, 4413, 500,
-	4413, 1064, 1155, 1151, -1000, 1044, -1000, 4413, 4413, 4413,
-	4413, 4413, 4413, 4413, 505, 1503, -1000, 586, 586, 273,
-	273, 273, 273, 273, 525, 525, -1000, -1000, -1000, 3599,
-	1241, 4820, 4820, 4820, 112, 1198, 1760, -1000, 4413, 801,
-	-1000, -1000, 1030, -1000, 960, 1024, 2249, 1007, 4413, -281,
-	3184, 1171, 14437, -281, 14437, 14437, 3184, -1000, 14437, -1000,
-	1967, 717, -1000, -1000, 14437, 1462, -1000, 623, 623, 14437,
-	623, 11658, 294, 369, -1000, 10070, 11658, -1000, -1000, 11658,
-	83, 1422, -1000, -1000, 623, 623, 246, -285, -134, 1489,
-	1486, -1000, -1000, -122, -1000, -1000, -1000, 151, -1000, 872,
-	871, 864, 846, 14437, -1000, -1000, -1000, -1000, -1000, 321,
-	321, 321, 1411, 6454, -1000, 1502, 1502, 305, -1000, -64,
-	-91, -1000, 1168, 1004, -1000, -1000, -1000, -1000, 1497, 1485,
-	12452, 12055, -1000, -1000, 4413, 997, 992, 977, 101, 1147,
-	-1000, -1000, -1000, -1000, 922, 884, 877, 874, 867, 847,
-	842, 1143, -1000, 112, 1198, 1711, -1000, 4820, 4820, 822,
-	101, 302, -1000, -1000, 302, -1000, 4820, -1000, 738, -1000,
-	987, 1174, -1000, -281, -1000, -1000, 1153, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1138, 1168,
-	-1000, -1000, -1000, -1000, 11658, 1437, 159, -1000, -56, 145,
-	14437, -288, 839, -1000, 1482, 825, 617, -122, -1000, 716,
-	711, 705, 703, -98, -1000, -1000, -1000, -1000, -1000, 1240,
-	302, -1000, 377, 809, 984, 1167, -1000, -1000, -1000, 835,
-	194, -1000, 14437, 474, 243, 120, 243, 455, 1239, -1000,
-	-1000, -1000, -1000, 1502, -1000, -64, -1000, 213, 210, -28,
-	1481, -1000, -1000, 4413, 4413, 1314, -1000, -1000, 623, -1000,
-	-1000, -1000, 981, -1000, 1229, 1234, -1000, 1229, 1229, 1229,
-	206, 206, 1235, 1238, 1235, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 4820, -1000, -1000, -1000, 968,
-	932, 930, 1569, -1000, -1000, 3184, 1153, -1000, -1000, 11658,
-	11658, -240, -60, 14437, -291, 699, -1000, 807, -138, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 11261, -1000, -1000,
-	-1000, -1000, -1000, -1000, 15845, 6454, 988, -81, -1000, -1000,
-	-1000, 1229, -1000, 1234, 1229, 1229, 1229, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1233, 1232, -1000, 1229,
-	1229, 1229, 1229, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	14437, 14437, -1000, 14437, 14437, 120, 4413, -1000, -1000, -1000,
-	-1000, 695, -1000, -1000, -1000, 797, 623, 1133, -1000, -1000,
-	-1000, 694, -1000, 692, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 685, -1000, 684, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -171, -1000, 1230, -1000,
-	-1000, 1480, 1113, -1000, 1229, 4413, 105, 15796, -1000, 321,
-	321, 265, 321, 321, 321, 321, 74, 63, 321, 321,
-	321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
-	321, 321, 1227, -1000, -1000, 988, -1000, -1000, 483, 4820,
-	-1000, -1000, 795, 377, 266, 303, 1223, -1000, 31, 424,
-	391, -1000, 14437, -1000, -84, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 794, 794, -1000, -1000, -1000, -1000, 1222, 1228,
-	-9, 1221, -1000, 1220, 1219, 14437, 674, -33, -1000, -1000,
-	921, 917, 1108, 1106, -152, -144, 14437, 617, -1000, 11261,
-	1419, 631, -1000, 1477, 15845, -1000, 661, 657, 321, 321,
-	654, 788, 786, 783, 321, 321, 651, 762, 15186, 639,
-	611, 608, 709, 761, 278, 704, 677, 540, 14437, 1218,
-	744, -1000, -1000, 1198, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 595, 1215, -1000, -1000, 1214,
-	-1000, -1000, 1102, -1000, 1092, 11261, 30, 30, 11261, 11261,
-	11261, 1212, 192, -1000, -1000, -1000, 574, -1000, 563, 128,
-	-143, -144, -1000, 1472, -139, 1471, 1470, 1079, -1000, -1000,
-	79, -1000, -1000, 1419, 18, -1000, -1000, -1000, 302, 302,
-	-1000, -1000, -1000, -1000, 759, 755, -1000, -1000, -1000, -1000,
+	-123, -272, 983, -89, 1638, -1000, 976, 1637, 976, 976,
+	1255, -1000, 1410, 5444, 185, 1508, -1000, 586, 586, 587,
+	586, 586, 586, 586, 111, 110, 586, 586, 586, 586,
+	586, 586, 586, 586, 586, 586, 586, 586, 586, 586,
+	1409, 586, -1000, 1408, 1451, 54, 1406, -1000, 1405, 1402,
+	17333, 935, -1000, 607, 294, 1073, 5444, -206, 12449, -1000,
+	-1000, -1000, 980, -1000, 859, -1000, 858, 2809, 33, -1000,
+	-1000, -100, -85, -279, 855, -1000, -1000, 1636, 978, -1000,
+	-1000, 976, -1000, -1000, -1000, 12449, 1593, 893, -1000, 1635,
+	18681, -1000, 854, 840, 586, 586, 839, 971, 969, 966,
+	586, 586, 838, 965, 18085, 836, 826, 825, 835, 961,
+	447, 797, 791, 787, 17333, 1399, 834, 17333, 12449, 76,
+	76, 12449, 12449, 12449, 1398, 282, -1000, 607, 66, -1000,
+	175, 1397, -1000, 887, 1517, -1000, -14, 1248, -1000, 1070,
+	1067, -1000, 212, -97, -85, -1000, 1396, -1000, 960, -1000,
+	-1000, 103, -1000, -1000, 1593, 117, -1000, -1000, -1000, 737,
+	737, -1000, -1000, -1000, -1000, 946, 936, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 85, 14437,
-	1063, -1000, 322, 909, 4413, -227, 11261, -1000, 752, -1000,
-	1061, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1059, 1056,
-	1054, 11261, -1000, -1000, -1000, 27, 905, 903, 1207, 555,
-	-134, 1469, -1000, 617, 1468, 617, 617, -1000, 14437, -1000,
-	321, 751, -19, -1000, -1000, -1000, 7, 108, 103, -1000,
-	166, -1000, -1000, -1000, -1000, -1000, -1000, 81, 1040, -1000,
-	744, 736, -1000, 606, 1354, -1000, -71, 1038, -1000, -1000,
-	-1000, -1000, -1000, 1029, -1000, -1000, -1000, 1409, 9673, -154,
-	-1000, 735, -1000, 617, -1000, -1000, -1000, 534, -1000, 749,
-	2, 516, 4820, 1202, 4820, 1196, 11, 1195, -1000, -1000,
-	-1000, -1000, -1000, 192, -1000, -1000, 1352, 1349, 1512, -1000,
-	-1000, -1000, -1000, 79, 79, 79, 79, -62, -1000, 14437,
-	-1000, 1018, -1000, -1000, -1000, 245, -1000, -1000, -1000, -1000,
-	-1000, 1194, 1467, -1000, 1473, 14437, 1226, 14437, 1193, 320,
-	4820, -1000, -1000, 1514, -1000, 1516, 257, 257, -1000, 879,
-	-1000, 319, -1000, 10864, 14437, -1000, 104, 9, -1000, 1012,
-	-1000, 994, 14437, 508, 544, -1000, -1000, -1000, 531, 42,
-	-1000, 14437, 2777, -1000, 244, 974, -1000, 887, -3, -1000,
-	-1000, 958, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 623,
-	14437, -1000, 104, 1398, -1000, 502, -1000, -1000, -1000, 1340,
-	100, -1000, -1000, 1340, -1, -1000, 96, -1000, -1000, 950,
-	-1000, 880, 1192, -1000, -1, 15845, 4413, -1000, 15845, 948,
-	-1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 150,
+	17333, 1238, -1000, 599, 551, 1235, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1232, 1215, 1206, 12449, -1000, -1000, -1000,
+	81, -1000, -1000, 1199, -1000, 902, 343, 5444, 282, -1000,
+	-1000, 1514, 1511, 1689, -1000, -1000, -1000, -1000, -1000, -1000,
+	1383, 776, -89, 17333, -1000, -1000, 586, 933, 50, -1000,
+	-1000, -1000, 68, 179, 152, -1000, 244, -1000, -1000, -1000,
+	-1000, -1000, -1000, 151, 1192, -1000, 834, 782, 495, -1000,
+	-1000, -1000, -1000, 1183, -1000, -1000, 66, 18681, 3769, -1000,
+	1167, -1000, -1000, 1691, -1000, 1692, 404, 404, 1565, 10821,
+	-102, -1000, 1140, -1000, 760, -1000, 943, 64, 745, 5861,
+	1382, 5861, 1367, 77, 1362, -1000, -1000, -1000, -1000, -1000,
+	743, 103, 103, 103, 103, -4, -1000, 18681, 1135, 1065,
+	-1000, -1000, -1000, -1000, 789, 106, -1000, -1000, 17333, -1000,
+	1132, -1000, -1000, -1000, 366, -1000, -1000, 17333, -1000, -1000,
+	1323, 1633, -1000, 1580, 17333, 1499, 17333, 841, 585, 5861,
+	12, -1000, -1000, -1000, -1000, -1000, -1000, 1213, -1000, 583,
+	-1000, 12042, 17333, -1000, -1000, 180, 74, -1000, 1119, -1000,
+	1115, 17333, 742, 1337, -1000, 17333, 3352, -1000, 359, 1082,
+	57, -1000, -1000, 1064, -1000, -1000, -1000, -1000, 661, 17333,
+	-1000, -1000, 739, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 543, 1892, 1891, 766, 731, 1890, 1889, 1887, 1884,
-	1883, 1882, 1881, 1880, 1879, 1875, 1874, 1859, 1858, 1855,
-	1853, 1852, 1851, 1850, 1849, 1844, 1842, 1841, 1839, 1838,
-	1837, 1836, 586, 1835, 1834, 1832, 1831, 1830, 1828, 114,
-	1827, 1826, 1808, 1806, 1805, 1804, 1803, 1802, 1800, 123,
-	85, 91, 1799, 194, 135, 1798, 101, 1797, 75, 140,
-	1796, 1795, 31, 96, 1794, 104, 97, 77, 175, 89,
-	81, 1793, 1792, 1790, 112, 1784, 1783, 1780, 1779, 54,
-	1777, 64, 35, 28, 1776, 73, 1775, 1774, 1773, 1771,
-	1770, 66, 1769, 62, 44, 1768, 1766, 1765, 1764, 1763,
-	34, 1762, 45, 1761, 1760, 1759, 1758, 1756, 1755, 1752,
-	16, 18, 21, 1751, 1749, 17, 2, 1748, 1747, 76,
-	1746, 1744, 1743, 554, 1742, 1741, 1740, 126, 1739, 108,
-	1738, 1737, 1736, 1735, 9, 1733, 42, 1732, 1731, 1730,
-	46, 1729, 1728, 84, 36, 142, 83, 1727, 1726, 1725,
-	117, 20, 57, 0, 116, 38, 1724, 113, 105, 1723,
-	79, 151, 109, 48, 1722, 43, 63, 1717, 1716, 1700,
-	60, 11, 1699, 103, 12, 74, 1694, 88, 100, 1,
-	90, 1688, 119, 1684, 1683, 95, 1682, 1668, 52, 94,
-	1666, 1665, 1663, 29, 1661, 37, 25, 1660, 121, 125,
-	1657, 1656, 1654, 99, 78, 70, 1653, 1652, 67, 1650,
-	92, 69, 98, 1649, 597, 1648, 93, 58, 19, 1647,
-	122, 1645, 144, 118, 102, 1644, 1642, 129, 1368, 120,
-	1641, 110, 10, 1640, 1639, 13, 1638, 26, 1636, 1635,
-	1634, 1633, 6, 1632, 1631, 1624, 3, 5, 1623, 4,
-	87, 1622, 1621, 47, 56, 53, 61, 1618, 1617, 1603,
-	1602, 1601, 192, 1600, 1599, 1597, 1595, 1594, 1590, 1589,
-	71, 1588, 1587, 1586, 1559, 55, 1557, 1555, 1553, 1552,
-	1551, 32, 1549, 1547, 23, 1544, 30, 1543, 1542, 1541,
-	14, 1540, 1539, 15, 1538, 1537, 7, 8, 1535, 1534,
-	51, 39, 33, 65, 68, 1533, 22, 1532, 82, 1530,
-	1528, 1527, 111, 1513,
-}
-
-//line mysql_sql.y:5946
+	0, 670, 2066, 2064, 2063, 2062, 2061, 2060, 717, 715,
+	2059, 2057, 2056, 2055, 2054, 2053, 2052, 2051, 2049, 2048,
+	2045, 2044, 2043, 2042, 2041, 2040, 2039, 2037, 2036, 2035,
+	2034, 2033, 2032, 2031, 2030, 2029, 2028, 668, 2027, 2025,
+	2023, 2022, 2021, 2020, 125, 2018, 2016, 2015, 2014, 2005,
+	2003, 2002, 2001, 2000, 1999, 1998, 101, 1997, 1996, 117,
+	1995, 1992, 1991, 1990, 1989, 121, 130, 91, 86, 1988,
+	105, 140, 1987, 109, 1986, 74, 172, 1985, 1984, 25,
+	104, 1983, 110, 108, 82, 173, 95, 77, 136, 1982,
+	99, 1980, 114, 1978, 1977, 1976, 1975, 45, 1971, 65,
+	46, 26, 41, 72, 1970, 1969, 1968, 1966, 1965, 100,
+	1963, 55, 60, 1962, 1960, 1959, 1958, 1957, 24, 1956,
+	61, 1955, 1954, 1953, 1950, 1949, 1948, 1944, 11, 20,
+	22, 1943, 1942, 10, 9, 1941, 1940, 69, 1939, 1938,
+	1935, 731, 1934, 1920, 1919, 138, 1918, 127, 1917, 1916,
+	1913, 1912, 12, 1911, 56, 1909, 1908, 1907, 38, 1906,
+	1905, 79, 28, 120, 83, 1903, 1900, 1899, 124, 21,
+	89, 0, 123, 31, 1897, 112, 115, 134, 78, 161,
+	119, 37, 1876, 62, 59, 1870, 1865, 1864, 53, 4,
+	1862, 80, 88, 70, 1861, 97, 122, 13, 84, 1860,
+	126, 1859, 1858, 103, 1857, 1855, 50, 102, 1847, 1846,
+	1844, 42, 1843, 34, 16, 1841, 118, 135, 1840, 132,
+	1839, 113, 81, 67, 1838, 1837, 68, 1835, 98, 66,
+	111, 1833, 736, 1829, 94, 51, 23, 1828, 131, 1827,
+	159, 129, 107, 1825, 1824, 139, 1088, 133, 1823, 116,
+	3, 1822, 1821, 6, 1820, 19, 1819, 1818, 1815, 1814,
+	40, 1813, 5, 1812, 15, 14, 1810, 35, 93, 1809,
+	1808, 43, 54, 76, 73, 1807, 1793, 1792, 1791, 1790,
+	208, 1787, 1785, 1784, 1783, 1782, 1780, 1777, 1763, 71,
+	1762, 1761, 1760, 1759, 52, 1758, 1757, 1756, 1754, 1752,
+	1751, 27, 1748, 33, 39, 30, 18, 1747, 1746, 1744,
+	1743, 1742, 7, 1731, 1727, 8, 1726, 1725, 1, 2,
+	1723, 1722, 44, 36, 47, 64, 63, 1721, 17, 1719,
+	85, 1718, 1715, 1713, 1712, 1710, 128, 1709,
+}
+
+//line mysql_sql.y:6270
 type yySymType struct {
 	union interface{}
 	id    int
@@ -2833,6 +3155,16 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableSpecUnion() tree.AlterTableSpec {
+	v, _ := st.union.(tree.AlterTableSpec)
+	return v
+}
+
+func (st *yySymType) alterTableSpecsUnion() []tree.AlterTableSpec {
+	v, _ := st.union.([]tree.AlterTableSpec)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -2908,6 +3240,16 @@ func (st *yySymType) createOptionsUnion() []tree.CreateOption {
 	return v
 }
 
+func (st *yySymType) cteUnion() *tree.CTE {
+	v, _ := st.union.(*tree.CTE)
+	return v
+}
+
+func (st *yySymType) ctesUnion() []*tree.CTE {
+	v, _ := st.union.([]*tree.CTE)
+	return v
+}
+
 func (st *yySymType) defaultOptionalUnion() bool {
 	v, _ := st.union.(bool)
 	return v
@@ -3148,6 +3490,16 @@ func (st *yySymType) referenceOptionTypeUnion() tree.ReferenceOptionType {
 	return v
 }
 
+func (st *yySymType) renameTablePairUnion() *tree.RenameTablePair {
+	v, _ := st.union.(*tree.RenameTablePair)
+	return v
+}
+
+func (st *yySymType) renameTablePairsUnion() []*tree.RenameTablePair {
+	v, _ := st.union.([]*tree.RenameTablePair)
+	return v
+}
+
 func (st *yySymType) resourceOptionUnion() tree.ResourceOption {
 	v, _ := st.union.(tree.ResourceOption)
 	return v
@@ -3378,237 +3730,255 @@ func (st *yySymType) whereUnion() *tree.Where {
 	return v
 }
 
+func (st *yySymType) withUnion() *tree.With {
+	v, _ := st.union.(*tree.With)
+	return v
+}
+
 func (st *yySymType) zeroFillOptUnion() bool {
 	v, _ := st.union.(bool)
 	return v
 }
 
 var yyR1 = [...]int{
-	0, 310, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 332, 6, 6, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 47, 299, 299, 298, 298, 297, 297, 296, 296,
-	296, 295, 295, 295, 294, 294, 293, 293, 291, 291,
-	292, 290, 289, 289, 287, 287, 285, 285, 286, 286,
-	280, 280, 283, 283, 281, 281, 281, 281, 284, 279,
-	279, 279, 278, 278, 46, 46, 46, 217, 217, 45,
-	45, 231, 231, 231, 231, 231, 229, 229, 229, 229,
-	228, 228, 227, 227, 232, 232, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 40,
-	40, 40, 40, 43, 44, 225, 225, 225, 225, 225,
-	226, 226, 226, 41, 42, 42, 216, 216, 221, 221,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 215, 215, 224, 224, 224, 223, 223, 222, 222,
-	34, 34, 34, 37, 36, 214, 214, 214, 214, 214,
-	214, 214, 214, 35, 35, 35, 35, 35, 35, 33,
-	33, 32, 213, 213, 212, 39, 39, 39, 39, 38,
-	38, 38, 38, 38, 38, 38, 156, 156, 156, 48,
-	7, 31, 31, 262, 262, 167, 167, 168, 168, 166,
-	166, 166, 166, 166, 166, 265, 266, 163, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 30, 311,
-	311, 311, 28, 29, 261, 261, 261, 27, 26, 25,
-	24, 24, 23, 22, 22, 160, 160, 162, 162, 158,
-	312, 312, 237, 237, 161, 161, 21, 21, 159, 159,
-	141, 157, 157, 157, 6, 8, 8, 8, 8, 8,
-	13, 12, 11, 10, 9, 5, 4, 269, 269, 269,
-	269, 269, 269, 307, 307, 307, 308, 73, 73, 69,
-	69, 270, 270, 180, 309, 309, 277, 277, 276, 276,
-	275, 275, 71, 71, 72, 72, 61, 61, 49, 49,
-	282, 282, 282, 282, 288, 288, 259, 259, 107, 107,
-	137, 137, 138, 138, 50, 50, 51, 51, 51, 67,
-	67, 68, 68, 68, 66, 66, 65, 64, 64, 63,
-	62, 62, 62, 53, 53, 52, 52, 52, 52, 52,
-	123, 123, 123, 54, 263, 263, 263, 268, 268, 120,
-	120, 121, 121, 119, 119, 55, 55, 56, 56, 56,
-	56, 118, 118, 117, 57, 57, 58, 58, 60, 60,
-	60, 60, 128, 128, 127, 127, 127, 127, 76, 76,
-	126, 125, 125, 125, 75, 75, 74, 74, 70, 70,
-	59, 59, 124, 313, 313, 122, 149, 149, 149, 155,
-	155, 148, 148, 148, 154, 154, 150, 150, 151, 151,
-	151, 3, 3, 3, 16, 16, 16, 14, 210, 210,
-	209, 209, 211, 211, 211, 211, 205, 205, 206, 206,
-	206, 206, 207, 207, 207, 208, 208, 208, 208, 204,
-	204, 203, 201, 201, 201, 202, 202, 202, 202, 202,
-	202, 152, 152, 15, 198, 198, 199, 199, 199, 200,
-	200, 192, 192, 192, 192, 19, 196, 196, 197, 197,
-	197, 197, 197, 193, 193, 195, 195, 191, 191, 191,
-	191, 191, 18, 190, 190, 188, 188, 186, 186, 187,
-	187, 185, 185, 185, 189, 189, 17, 264, 264, 233,
-	233, 236, 236, 243, 243, 244, 244, 242, 242, 249,
-	249, 248, 248, 247, 247, 246, 246, 245, 245, 240,
-	240, 239, 239, 234, 234, 234, 234, 234, 235, 235,
-	238, 238, 241, 241, 98, 98, 99, 99, 99, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 305, 305,
-	306, 101, 101, 101, 105, 105, 105, 105, 105, 105,
-	100, 100, 100, 102, 102, 102, 83, 83, 82, 82,
-	77, 77, 78, 78, 79, 79, 80, 80, 81, 81,
-	81, 81, 81, 81, 219, 219, 303, 303, 304, 304,
-	300, 300, 300, 302, 302, 302, 302, 302, 301, 301,
-	84, 135, 135, 135, 153, 153, 153, 134, 134, 134,
-	97, 97, 96, 96, 94, 94, 94, 94, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 218, 218, 164,
-	164, 165, 165, 115, 113, 113, 114, 114, 114, 114,
-	111, 112, 110, 110, 110, 110, 110, 109, 109, 108,
-	108, 108, 194, 194, 106, 106, 104, 104, 104, 103,
-	103, 103, 250, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 173, 173, 173, 173,
-	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	173, 173, 173, 173, 173, 85, 85, 85, 85, 85,
-	85, 85, 85, 85, 93, 93, 93, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 274, 274, 274, 130, 132, 132, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	181, 181, 182, 182, 271, 271, 271, 271, 271, 271,
-	272, 272, 273, 273, 273, 273, 267, 267, 267, 267,
-	267, 267, 267, 267, 267, 267, 267, 267, 267, 267,
-	267, 267, 267, 267, 267, 267, 267, 267, 267, 267,
-	267, 267, 267, 267, 172, 129, 129, 129, 251, 183,
-	178, 178, 179, 179, 174, 174, 174, 174, 174, 176,
-	176, 176, 176, 170, 170, 170, 170, 170, 170, 170,
-	170, 170, 175, 175, 177, 177, 184, 184, 184, 184,
-	184, 184, 95, 95, 95, 95, 252, 169, 169, 169,
-	169, 169, 169, 169, 86, 86, 86, 86, 90, 90,
-	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
-	92, 92, 92, 92, 91, 91, 91, 89, 89, 89,
-	89, 89, 87, 87, 87, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 88, 136, 136,
-	253, 253, 254, 254, 255, 256, 256, 257, 257, 257,
-	258, 258, 258, 260, 260, 140, 140, 140, 145, 145,
-	139, 139, 146, 146, 147, 147, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142,
+	1, 1, 1, 1, 1, 1, 5, 5, 5, 4,
+	298, 298, 298, 2, 3, 52, 321, 321, 320, 320,
+	319, 319, 318, 318, 318, 317, 317, 317, 316, 316,
+	315, 315, 313, 313, 314, 312, 311, 311, 309, 309,
+	305, 305, 306, 306, 300, 300, 303, 303, 301, 301,
+	301, 301, 304, 299, 299, 299, 297, 297, 51, 51,
+	51, 235, 235, 50, 50, 249, 249, 249, 249, 249,
+	247, 247, 247, 247, 246, 246, 245, 245, 250, 250,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 45, 45, 45, 45, 48, 49, 243,
+	243, 243, 243, 243, 244, 244, 244, 46, 47, 47,
+	234, 234, 239, 239, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 233, 233, 242, 242, 242,
+	241, 241, 240, 240, 39, 39, 39, 42, 41, 232,
+	232, 232, 232, 232, 232, 232, 232, 40, 40, 40,
+	40, 40, 40, 38, 38, 37, 231, 231, 230, 44,
+	44, 44, 44, 43, 43, 43, 43, 43, 43, 43,
+	174, 174, 174, 53, 53, 11, 11, 54, 54, 58,
+	58, 56, 56, 56, 56, 56, 56, 56, 56, 57,
+	57, 57, 333, 333, 334, 334, 334, 55, 60, 60,
+	59, 36, 36, 280, 280, 185, 185, 186, 186, 184,
+	184, 184, 184, 184, 184, 284, 285, 181, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 35,
+	35, 34, 335, 335, 335, 32, 33, 279, 279, 279,
+	31, 30, 29, 28, 28, 27, 26, 26, 178, 178,
+	180, 180, 176, 336, 336, 255, 255, 179, 179, 25,
+	25, 25, 177, 177, 159, 175, 175, 175, 10, 12,
+	12, 12, 12, 12, 12, 17, 16, 15, 14, 62,
+	13, 9, 8, 288, 288, 288, 288, 288, 288, 329,
+	329, 329, 330, 91, 91, 86, 86, 289, 289, 198,
+	331, 331, 296, 296, 295, 295, 294, 294, 89, 89,
+	90, 90, 78, 78, 66, 66, 307, 307, 308, 308,
+	302, 302, 310, 310, 277, 277, 125, 125, 155, 155,
+	156, 156, 67, 67, 67, 63, 64, 64, 65, 88,
+	88, 68, 68, 68, 84, 84, 85, 85, 85, 83,
+	83, 82, 81, 81, 80, 79, 79, 79, 70, 70,
+	69, 69, 69, 69, 69, 141, 141, 141, 71, 281,
+	281, 281, 287, 287, 138, 138, 139, 139, 137, 137,
+	72, 72, 73, 73, 73, 73, 136, 136, 135, 74,
+	74, 75, 75, 77, 77, 77, 77, 146, 146, 145,
+	145, 145, 145, 94, 94, 144, 143, 143, 143, 93,
+	93, 92, 92, 87, 87, 76, 76, 142, 337, 337,
+	140, 167, 167, 167, 173, 173, 166, 166, 166, 172,
+	172, 168, 168, 169, 169, 169, 7, 7, 7, 20,
+	20, 20, 20, 61, 283, 283, 18, 228, 228, 227,
+	227, 229, 229, 229, 229, 229, 229, 223, 223, 224,
+	224, 224, 224, 225, 225, 225, 226, 226, 226, 226,
+	222, 222, 221, 219, 219, 219, 220, 220, 220, 220,
+	220, 220, 170, 170, 19, 216, 216, 217, 217, 217,
+	218, 218, 210, 210, 210, 210, 23, 214, 214, 215,
+	215, 215, 215, 215, 211, 211, 213, 213, 209, 209,
+	209, 209, 209, 22, 208, 208, 206, 206, 204, 204,
+	205, 205, 203, 203, 203, 207, 207, 21, 282, 282,
+	251, 251, 254, 254, 261, 261, 262, 262, 260, 260,
+	267, 267, 266, 266, 265, 265, 264, 264, 263, 263,
+	263, 263, 263, 258, 258, 257, 257, 252, 252, 252,
+	252, 252, 253, 253, 256, 256, 259, 259, 116, 116,
+	117, 117, 117, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 327, 327, 328, 119, 119, 119, 123,
+	123, 123, 123, 123, 123, 118, 118, 118, 120, 120,
+	120, 101, 101, 100, 100, 100, 95, 95, 96, 96,
+	97, 97, 98, 98, 99, 99, 99, 99, 99, 99,
+	237, 237, 325, 325, 326, 326, 322, 322, 322, 324,
+	324, 324, 324, 324, 323, 323, 102, 153, 153, 153,
+	171, 171, 171, 152, 152, 152, 115, 115, 114, 114,
+	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
+	112, 112, 112, 236, 236, 182, 182, 183, 183, 133,
+	131, 131, 132, 132, 132, 132, 129, 130, 128, 128,
+	128, 128, 128, 127, 127, 126, 126, 126, 212, 212,
+	124, 124, 122, 122, 122, 121, 121, 121, 268, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 103, 103, 103, 103, 103, 103, 103, 103, 103,
+	111, 111, 111, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 293, 293,
+	293, 148, 150, 150, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 199, 199, 200, 200,
+	290, 290, 290, 290, 290, 290, 291, 291, 292, 292,
+	292, 292, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	190, 147, 147, 147, 269, 201, 196, 196, 197, 197,
+	192, 192, 192, 192, 192, 194, 194, 194, 194, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 193, 193,
+	195, 195, 202, 202, 202, 202, 202, 202, 113, 113,
+	113, 113, 270, 187, 187, 187, 187, 187, 187, 187,
+	104, 104, 104, 104, 108, 108, 110, 110, 110, 110,
+	110, 110, 110, 110, 110, 110, 110, 110, 110, 110,
+	109, 109, 109, 107, 107, 107, 107, 107, 105, 105,
+	105, 105, 105, 105, 105, 105, 105, 105, 105, 105,
+	105, 105, 105, 106, 154, 154, 271, 271, 272, 272,
+	273, 274, 274, 275, 275, 275, 276, 276, 276, 278,
+	278, 158, 158, 158, 163, 163, 157, 157, 164, 164,
+	165, 165, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
+	160, 160, 160, 160, 160, 160, 160,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
-	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
-	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 5, 3, 1, 1, 1, 6,
-	1, 7, 9, 0, 2, 0, 1, 1, 2, 2,
+	1, 1, 1, 1, 1, 0, 4, 4, 3, 3,
+	0, 1, 1, 5, 5, 14, 0, 2, 1, 3,
+	3, 3, 1, 3, 5, 0, 2, 3, 1, 3,
+	1, 1, 1, 1, 1, 1, 0, 3, 0, 3,
+	0, 3, 0, 3, 0, 2, 1, 2, 3, 4,
+	3, 3, 1, 0, 1, 1, 0, 1, 9, 4,
+	7, 0, 3, 7, 4, 1, 3, 3, 3, 1,
+	0, 1, 1, 1, 1, 3, 1, 4, 1, 3,
+	1, 2, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 1, 2, 1, 2, 2,
+	1, 1, 1, 3, 2, 2, 2, 2, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 3, 6, 3,
+	1, 1, 1, 1, 1, 1, 1, 2, 4, 6,
+	1, 4, 1, 3, 3, 4, 4, 4, 3, 2,
+	4, 4, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 1, 1, 1, 2, 2, 0,
+	4, 2, 4, 1, 5, 3, 2, 1, 2, 2,
+	4, 4, 5, 2, 1, 7, 1, 3, 3, 1,
+	1, 1, 1, 2, 3, 4, 7, 2, 5, 3,
+	1, 1, 1, 6, 3, 1, 1, 4, 4, 1,
+	3, 2, 3, 2, 3, 5, 6, 5, 3, 5,
+	3, 3, 0, 1, 0, 1, 1, 3, 1, 3,
+	3, 7, 9, 0, 2, 0, 1, 1, 2, 2,
 	2, 1, 4, 2, 2, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 5, 1,
-	1, 1, 5, 5, 0, 1, 1, 2, 2, 3,
-	6, 7, 4, 7, 8, 0, 2, 0, 2, 2,
-	1, 1, 1, 1, 0, 1, 4, 5, 1, 3,
-	1, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	4, 4, 6, 4, 4, 6, 4, 2, 1, 5,
-	4, 4, 2, 0, 1, 3, 3, 1, 3, 1,
-	3, 1, 3, 4, 0, 1, 0, 1, 1, 3,
-	1, 1, 0, 4, 1, 3, 2, 1, 0, 8,
-	0, 4, 7, 4, 0, 2, 0, 2, 0, 2,
-	0, 4, 1, 3, 1, 2, 4, 3, 4, 0,
-	1, 2, 4, 4, 0, 1, 3, 1, 3, 2,
-	0, 1, 1, 3, 3, 1, 3, 3, 3, 3,
-	1, 2, 2, 7, 0, 1, 1, 1, 1, 0,
-	2, 0, 3, 0, 2, 1, 3, 1, 2, 3,
-	5, 0, 1, 2, 1, 3, 1, 1, 4, 4,
-	4, 3, 2, 2, 2, 3, 2, 3, 0, 2,
-	1, 1, 2, 2, 0, 1, 2, 4, 1, 3,
-	1, 3, 3, 0, 1, 2, 0, 1, 2, 1,
-	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 6, 0, 2,
-	1, 2, 2, 2, 2, 2, 0, 1, 2, 2,
-	2, 2, 1, 3, 2, 2, 2, 2, 2, 1,
-	3, 2, 1, 3, 2, 0, 3, 3, 5, 5,
-	4, 1, 1, 4, 1, 3, 1, 3, 2, 1,
-	1, 0, 1, 1, 1, 11, 0, 2, 3, 2,
-	3, 1, 1, 1, 3, 3, 4, 0, 2, 2,
-	2, 2, 5, 1, 1, 0, 3, 0, 1, 1,
-	2, 4, 4, 4, 0, 1, 10, 0, 1, 0,
-	6, 0, 4, 0, 3, 1, 3, 4, 5, 0,
-	3, 1, 3, 2, 3, 1, 2, 0, 6, 0,
-	2, 0, 2, 4, 5, 4, 5, 1, 6, 5,
-	0, 3, 0, 1, 0, 1, 1, 3, 2, 3,
-	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	4, 5, 1, 1, 1, 5, 5, 0, 1, 1,
+	2, 2, 3, 6, 7, 4, 7, 8, 0, 2,
+	0, 2, 2, 1, 1, 1, 1, 0, 1, 4,
+	4, 5, 1, 3, 1, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 1, 4, 4, 6, 4, 4,
+	4, 6, 4, 2, 1, 5, 4, 4, 2, 0,
+	1, 3, 3, 1, 3, 1, 3, 1, 3, 4,
+	0, 1, 0, 1, 1, 3, 1, 1, 0, 4,
+	1, 3, 2, 1, 0, 10, 0, 2, 0, 2,
+	0, 2, 0, 3, 0, 2, 0, 2, 0, 4,
+	1, 3, 1, 2, 2, 2, 1, 3, 6, 0,
+	3, 4, 3, 4, 0, 1, 2, 4, 4, 0,
+	1, 3, 1, 3, 2, 0, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 1, 2, 2, 7, 0,
+	1, 1, 1, 1, 0, 2, 0, 3, 0, 2,
+	1, 3, 1, 2, 3, 5, 0, 1, 2, 1,
+	3, 1, 1, 4, 4, 4, 3, 2, 2, 2,
+	3, 2, 3, 0, 2, 1, 1, 2, 2, 0,
+	1, 2, 4, 1, 3, 1, 3, 3, 0, 1,
+	2, 0, 1, 2, 1, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 7, 0, 2, 6, 0, 2, 1,
+	2, 2, 2, 2, 2, 2, 2, 0, 1, 2,
+	2, 2, 2, 1, 3, 2, 2, 2, 2, 2,
+	1, 3, 2, 1, 3, 2, 0, 3, 3, 5,
+	5, 4, 1, 1, 4, 1, 3, 1, 3, 2,
+	1, 1, 0, 1, 1, 1, 11, 0, 2, 3,
+	2, 3, 1, 1, 1, 3, 3, 4, 0, 2,
+	2, 2, 2, 5, 1, 1, 0, 3, 0, 1,
+	1, 2, 4, 4, 4, 0, 1, 10, 0, 1,
+	0, 6, 0, 4, 0, 3, 1, 3, 4, 5,
+	0, 3, 1, 3, 2, 3, 1, 2, 0, 6,
+	4, 6, 5, 0, 2, 0, 2, 4, 5, 4,
+	5, 1, 6, 5, 0, 3, 0, 1, 0, 1,
+	1, 3, 2, 3, 3, 4, 4, 3, 3, 3,
+	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	5, 4, 7, 1, 3, 3, 0, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 3, 3, 0, 1, 1, 3,
+	1, 1, 2, 1, 7, 7, 7, 7, 8, 5,
+	0, 1, 0, 1, 1, 1, 1, 3, 3, 1,
+	1, 1, 1, 1, 0, 1, 3, 1, 3, 5,
+	1, 1, 1, 1, 3, 5, 0, 1, 1, 2,
+	1, 2, 2, 1, 1, 2, 2, 2, 2, 2,
+	1, 5, 6, 1, 2, 0, 1, 1, 2, 5,
+	0, 1, 1, 1, 2, 2, 3, 3, 1, 1,
+	2, 2, 2, 0, 1, 2, 2, 2, 0, 3,
+	0, 3, 1, 1, 1, 1, 1, 1, 1, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 5, 4, 1, 3,
-	3, 0, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
-	0, 1, 1, 3, 1, 1, 2, 1, 7, 7,
-	7, 7, 8, 5, 0, 1, 0, 1, 1, 1,
-	1, 3, 3, 1, 1, 1, 1, 1, 0, 1,
-	3, 1, 3, 5, 1, 1, 1, 1, 3, 5,
-	0, 1, 1, 2, 1, 2, 2, 1, 1, 2,
-	2, 2, 2, 2, 1, 5, 6, 1, 2, 0,
-	1, 1, 2, 5, 0, 1, 1, 1, 2, 2,
-	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
-	2, 2, 0, 3, 0, 3, 1, 1, 1, 1,
-	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 1, 1, 1, 1, 3,
-	5, 2, 2, 2, 2, 1, 1, 2, 6, 6,
-	6, 1, 1, 1, 1, 1, 2, 2, 1, 2,
-	2, 2, 2, 2, 0, 1, 1, 5, 4, 4,
-	5, 5, 5, 5, 4, 5, 5, 5, 5, 5,
-	5, 5, 1, 1, 1, 4, 2, 2, 4, 2,
-	2, 4, 6, 2, 2, 2, 4, 6, 4, 2,
-	0, 1, 2, 3, 1, 1, 1, 1, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 1, 1, 1, 3, 5, 2, 2, 2,
+	2, 1, 1, 2, 6, 6, 6, 1, 1, 1,
+	1, 1, 2, 2, 1, 2, 2, 2, 2, 2,
+	0, 1, 1, 5, 4, 4, 5, 5, 5, 5,
+	4, 5, 5, 5, 5, 5, 5, 5, 1, 1,
+	1, 4, 2, 2, 4, 2, 2, 4, 6, 2,
+	2, 2, 4, 6, 4, 2, 0, 1, 2, 3,
+	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 0, 1, 1, 1, 3,
-	0, 1, 1, 3, 3, 3, 3, 2, 1, 3,
-	4, 3, 1, 3, 4, 4, 5, 3, 4, 5,
-	6, 1, 0, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 1, 1, 1, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 1, 2, 2,
-	2, 2, 2, 2, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 4, 1, 1, 3,
-	0, 1, 0, 3, 3, 0, 5, 0, 3, 5,
-	0, 1, 1, 0, 1, 1, 2, 2, 0, 1,
+	3, 0, 1, 1, 1, 3, 0, 1, 1, 3,
+	3, 3, 3, 2, 1, 3, 4, 3, 1, 3,
+	4, 4, 5, 3, 4, 5, 6, 1, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 1, 2, 2, 2, 2, 2, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 4, 4, 1, 1, 3, 0, 1, 0, 3,
+	3, 0, 5, 0, 3, 5, 0, 1, 1, 0,
+	1, 1, 2, 2, 0, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3642,409 +4012,438 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -310, -2, -1, -3, -4, -5, -6, -38, -20,
-	-7, -48, -32, -33, -34, -40, -45, -46, -47, -50,
-	-16, -15, -14, 8, 10, -8, -156, -21, -22, -23,
-	-24, -25, -26, -27, -28, -29, -30, -31, 181, 9,
-	49, -35, -36, -37, -41, -42, -43, -44, 283, 289,
-	325, -51, -53, -17, -18, -19, 177, -9, -10, -11,
-	-12, -13, 199, 198, 26, 197, 178, 120, 121, 123,
-	124, 30, -52, 54, 179, -54, 6, 421, -61, 27,
-	-82, -153, 57, -142, -144, 379, 380, 381, 382, 383,
-	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
-	394, 395, 396, 397, 398, 399, 400, 401, 402, 403,
-	404, 405, 406, 407, 408, 409, 410, 411, 412, 413,
-	414, 373, 216, 240, 243, 250, 120, 137, 131, 158,
-	150, 151, 128, 220, 221, 64, 123, 353, 339, 324,
-	126, 235, 237, 239, 242, 222, 146, 142, 234, 218,
-	140, 223, 28, 224, 163, 225, 226, 378, 335, 265,
-	357, 341, 247, 141, 336, 238, 338, 164, 168, 345,
-	289, 135, 136, 343, 347, 162, 196, 32, 375, 34,
-	208, 348, 166, 161, 157, 356, 251, 249, 160, 134,
-	156, 219, 38, 170, 169, 171, 319, 320, 321, 322,
-	227, 228, 344, 153, 143, 269, 129, 18, 351, 203,
-	340, 290, 229, 244, 206, 252, 165, 167, 210, 215,
-	377, 248, 349, 139, 207, 236, 202, 352, 130, 283,
-	292, 230, 124, 241, 346, 355, 37, 305, 133, 127,
-	193, 121, 212, 217, 231, 232, 233, 254, 253, 245,
-	154, 209, 159, 132, 155, 122, 211, 354, 306, 420,
-	267, 308, 152, 149, 213, 186, 376, 350, 342, 125,
-	312, 307, 147, 255, 417, 418, 419, 11, -157, 19,
-	323, -39, 181, -153, -5, -4, -32, -50, 184, -58,
-	-59, -60, -122, -124, -82, 54, -153, -228, -198, -227,
-	-199, -230, -200, -152, 20, 178, 177, 211, 10, 179,
-	287, 185, 8, 6, 288, 197, 9, 289, 291, 292,
-	295, 296, 297, 31, 300, 301, 57, 60, -153, -228,
-	-198, 215, 222, -49, -65, -66, -123, 27, 15, 5,
-	308, 214, -192, -190, -264, 194, 193, 76, 359, 183,
-	298, -311, -261, 342, 341, -161, 340, 333, 335, 177,
-	185, 343, 32, 345, 346, 336, 308, 125, 122, -214,
-	80, 130, 129, -214, 214, 29, -221, 318, -220, -222,
-	345, 346, 356, -215, 344, -140, -153, 58, 59, 75,
-	151, 148, -66, -123, -65, -51, -53, 308, 214, 185,
-	184, 359, -263, 20, -268, 21, 22, -1, -71, 206,
-	-82, 119, -58, -134, -153, 324, 89, -39, 119, -82,
-	30, -125, -126, -127, -128, 41, 45, 47, 42, 43,
-	44, 48, -313, 23, -149, -155, 23, -150, 60, -151,
-	-144, 57, 58, 59, -51, -53, 51, 55, 11, 55,
-	54, 422, 58, 285, 299, 308, 286, 298, 186, 214,
-	299, 214, 333, 186, 290, 293, 294, 334, 51, 187,
-	51, -278, 356, -49, -68, 17, -54, -53, 416, 16,
-	20, 21, -188, 189, -188, 185, -188, 184, -312, 11,
-	99, 213, 212, 337, 334, -237, 338, 339, -161, -160,
-	97, -161, 184, 359, -262, 189, 349, 376, 128, 129,
-	130, -225, 20, 29, 317, -198, 214, 55, 89, 19,
-	-223, 89, 100, -222, -222, -222, -223, -100, 29, -151,
-	60, 116, -100, 29, 119, 30, 30, -67, -68, -54,
-	-53, 56, 56, -262, -262, -262, -262, -262, -55, -56,
-	107, -174, -153, 81, -176, 57, -170, 380, 381, 382,
-	383, 384, 385, 386, 388, 393, 395, 399, 400, 401,
-	402, 406, 407, 412, 413, 414, 308, 147, -171, -173,
-	-296, -291, -169, 54, 105, 106, 113, 82, -172, -250,
-	24, 367, -130, -131, -132, -133, -292, -290, 60, 65,
-	69, 71, 72, 70, 67, 118, -53, -267, -273, -271,
-	148, 200, 144, 145, 8, 111, 318, 116, -274, 59,
-	58, 271, 75, 272, 273, 359, 268, 274, 189, 323,
-	43, 275, 276, 277, 278, 279, 366, 280, 44, 281,
-	270, 204, 282, 370, 369, 371, 363, 360, 358, 361,
-	362, 364, 365, -269, 33, -50, 54, 30, 54, -153,
-	-119, 12, 119, 65, 60, -153, 54, -213, -212, -134,
-	-59, -59, -59, -59, 41, 41, 41, 46, 41, 46,
-	41, -127, -150, -155, 56, -229, 184, 284, 210, -227,
-	211, 289, 292, -204, -203, -201, -152, 60, -199, -232,
-	-134, -152, 334, -229, -204, -203, 326, -49, -174, -153,
-	60, -64, -63, -174, -204, 81, -198, -151, -153, -188,
-	-82, -160, -160, -162, -312, -158, -312, 334, -119, -173,
-	-237, -159, -153, -188, -204, 308, 24, 350, 351, 126,
-	129, 128, 357, -226, 317, 20, -198, -220, -216, 60,
-	318, -203, -224, 51, 116, -275, -174, 29, -223, -223,
-	-223, -224, 115, -153, -49, -204, -198, -153, -83, -82,
-	-154, -151, -144, -118, 55, -117, 11, -148, 80, 78,
-	79, -153, 23, 119, -174, 96, -184, 89, 90, 91,
-	92, 93, 94, 54, 54, 54, 54, 54, 54, 54,
-	54, -182, 54, 54, 54, 54, 54, -182, 54, 54,
-	54, 102, 101, 112, 105, 106, 107, 108, 109, 110,
-	111, 103, 104, 99, 81, 97, 98, 83, -53, -174,
-	-179, -173, -173, -173, -173, -250, 54, -174, 54, -272,
-	54, -181, -182, 54, 60, 60, 60, 54, 54, 54,
-	-173, 54, -270, -180, -309, 415, -73, 56, -69, -153,
-	-307, -308, -69, -72, -153, -66, -174, -146, -147, -139,
-	-143, -150, -151, -144, 266, 182, 20, 80, 23, 25,
-	271, 303, 83, 116, 16, 84, 148, 115, 273, 367,
-	272, 177, 47, 75, 369, 371, 370, 360, 358, 310,
-	314, 316, 313, 359, 333, 29, 10, 26, 198, 21,
-	22, 109, 179, 200, 87, 88, 201, 24, 199, 72,
-	19, 50, 11, 323, 13, 14, 274, 309, 189, 188,
-	99, 326, 185, 45, 8, 118, 27, 96, 311, 41,
-	77, 43, 97, 17, 361, 362, 31, 325, 372, 205,
-	111, 275, 276, 277, 48, 81, 317, 70, 51, 78,
-	15, 46, 98, 180, 366, 44, 214, 315, 279, 281,
-	280, 183, 6, 270, 368, 30, 197, 42, 184, 334,
-	86, 187, 71, 204, 144, 145, 5, 76, 9, 49,
-	52, 363, 364, 365, 33, 85, 12, 282, 278, 318,
-	327, 328, 329, 330, 331, 332, 172, 173, 174, 175,
-	176, 246, 192, 190, 194, 195, 415, 416, 19, -39,
-	119, -70, -153, -119, 55, 89, -75, -74, 51, 52,
-	-76, 51, -74, 41, 41, -231, 107, 57, 55, -202,
-	309, 422, 58, 56, 55, -231, 187, 60, 55, 18,
-	119, -282, 338, 55, -62, 25, 26, -205, -206, 315,
-	24, -191, 52, -186, -187, -185, -189, 29, -82, -119,
-	-119, -119, -160, -154, -162, -157, -162, -158, 119, -141,
-	-153, -205, 54, 127, 130, 130, 129, -198, 187, 54,
-	89, -224, -224, -224, 29, -152, 51, 55, -119, -56,
-	-57, -58, -174, -174, -174, -153, -153, 107, 70, 81,
-	-170, -178, -179, -174, -129, 21, 20, -129, -129, -174,
-	-129, 107, -179, -179, 56, -252, 65, -129, -129, -129,
-	-129, -129, -129, -129, -171, -171, -171, -171, -171, -171,
-	-171, -171, -171, -171, -171, -171, -177, -183, -250, 54,
-	99, 97, 98, 83, -173, -171, -171, 56, 55, -174,
-	-251, 270, -178, 56, -179, -178, -171, -178, -129, 55,
-	54, 56, 55, 33, 119, 55, 89, 56, 55, -67,
-	119, 324, -153, 56, 55, -66, -212, -174, -174, 54,
-	-174, 11, 119, 119, -203, 16, 376, -152, -134, 187,
-	-204, -279, 188, 366, -174, -174, -153, -288, 332, 327,
-	329, -63, -210, 376, 317, 316, 312, -207, -208, 311,
-	313, 310, 314, 51, 260, 261, 262, 263, -185, -140,
-	115, 225, 151, 54, -119, -160, -160, -162, -153, -210,
-	56, 130, -204, -163, 60, -216, -82, -82, -121, 13,
-	55, 119, 70, 56, 55, -174, -174, -174, 23, -179,
-	56, 56, 56, 56, -174, -174, -174, -174, -174, -174,
-	-174, -179, -177, -173, -171, -171, -175, 201, 80, -174,
-	55, 52, 56, 56, 52, 56, 55, 56, -174, -180,
-	-277, -276, -275, 33, -50, -69, -270, -153, -308, -275,
-	-153, -146, -143, -151, -144, 65, -153, -67, -70, -204,
-	107, 107, 57, -152, 318, -152, -204, -217, 376, 27,
-	119, -259, 417, -286, 327, 16, 16, -209, -211, 319,
-	320, 321, 322, 80, -208, 60, 60, 60, 60, -82,
-	-145, 89, -145, -145, -77, -78, -79, -84, -80, -134,
-	-165, -81, 192, 190, 194, -304, 76, 195, 246, 77,
-	185, -119, -119, -160, -167, -168, -166, 266, -265, 318,
-	309, 56, -120, 14, 16, -58, -153, 107, -174, 56,
-	56, 56, -85, -91, 116, 148, 200, 147, 146, 144,
-	305, 306, 140, 141, 139, 56, 56, 56, 56, 56,
-	56, 56, 56, 56, -175, 80, -173, -170, 56, -85,
-	-100, -100, -171, 56, 56, 55, -270, 56, -152, 16,
-	23, -205, 289, 184, -107, 418, 60, 16, 60, -284,
-	60, -211, 65, 65, 65, 65, -208, 54, -100, -102,
-	-151, 60, 116, 60, 56, 55, -86, -90, -87, -89,
-	-88, -92, -91, 148, 149, 116, 152, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 30, 200, 144,
-	145, 146, 147, 164, 131, 150, 374, 172, 132, 173,
-	133, 174, 134, 175, 135, 136, 176, 137, -81, -153,
-	77, -303, -304, -188, -303, 77, 54, -119, -166, 267,
-	31, 118, 269, 29, 265, 16, -174, -179, 56, -253,
-	-255, 54, -254, 54, -253, -253, -253, -93, 136, 135,
-	-93, -256, 54, -257, 54, -256, -170, 56, 56, 56,
-	56, -275, -152, -152, -217, 290, -82, -137, 419, 65,
-	60, 329, -193, -195, -134, 54, -98, -99, -116, 303,
-	216, -189, 220, 64, 221, 324, 222, 185, 224, 225,
-	226, 196, 227, 228, 229, 318, 230, 231, 232, 233,
-	286, 5, 256, -79, -97, -96, -94, 70, 81, 29,
-	303, -95, 64, 115, 239, 217, 240, -115, -164, 190,
-	76, 77, 291, -165, -258, 306, 305, -253, -254, -255,
-	-253, -253, 54, 54, -253, -253, -253, -253, -300, -301,
-	-153, -301, -153, -300, -300, -188, -174, 65, -266, -163,
-	65, 65, 65, 65, -280, -237, 54, 16, 56, 55,
-	-253, -174, -233, 206, 55, -116, -145, -145, -140, 115,
-	-145, -145, -145, -145, 223, 223, -145, -145, -145, -145,
-	-145, -145, -145, -145, -145, -145, -145, -145, -145, -145,
-	54, -94, 70, -171, 60, -102, -103, 29, 238, 234,
-	-104, 29, 218, 219, -106, 54, 246, 77, 77, -82,
-	-260, 307, -136, 60, -136, 54, 52, 255, 54, 54,
-	54, -301, 56, 268, 56, 56, 55, 56, 55, -287,
-	332, -283, -281, 327, 328, 329, 330, -138, -153, -284,
-	-196, -195, -62, 56, 16, -116, 65, 65, -145, -145,
-	65, 60, 60, 60, -145, -145, 65, 60, -155, 65,
-	65, 65, 65, 29, 60, -105, 29, 234, 238, 235,
-	236, 237, 65, 29, 65, 29, 65, 29, -153, 54,
-	-305, -306, 60, 65, 54, -194, 54, 56, 55, 56,
-	-193, -302, 260, 261, 262, 264, 263, -302, -193, -193,
-	-193, 54, -219, -218, 247, 81, 65, 65, -289, 188,
-	-285, 331, -281, 16, 329, 16, 16, 56, 55, -197,
-	196, 64, 376, 258, 259, -62, -234, 248, 249, -235,
-	-241, 251, -100, -100, 60, 60, -101, 217, -83, 56,
-	55, 89, 56, -174, -109, -108, 372, -193, 60, 56,
-	56, 56, 56, -193, 247, 56, 56, -295, 54, 65,
-	-286, 16, -284, 16, -284, -284, -153, -145, 60, 257,
-	-239, 252, 54, -237, 54, -237, 77, 261, 218, 219,
-	56, -306, 60, 56, -113, -114, -111, -112, 51, 336,
-	244, 245, 56, -196, -196, -196, -196, 56, -299, 30,
-	56, -294, -293, -135, -290, -153, 332, 60, -284, 65,
-	-151, -236, 253, 65, -171, 54, -171, 54, -238, 250,
-	54, -218, -112, 51, -111, 51, 10, 9, -115, -298,
-	-297, -296, 56, 55, 119, -243, 54, 16, 56, -232,
-	56, -232, 54, 89, -171, -110, 241, 242, 30, 129,
-	-110, 55, 89, -293, -153, -244, -242, 206, -235, 56,
-	56, -232, 65, 56, 70, 29, 243, -297, 29, -174,
-	119, 56, 55, 57, -240, 254, 56, -153, -242, -245,
-	33, 65, -249, -246, 54, -116, 208, -249, -116, -248,
-	-247, 253, 209, 56, 55, 57, 54, -247, -246, -179,
-	56,
+	-1000, -332, -6, -1, -7, -8, -9, -10, -43, -24,
+	-11, -55, -53, -37, -38, -39, -45, -50, -51, -52,
+	-2, -3, -4, -5, -67, -20, -19, -18, 8, 10,
+	-12, -174, -25, -26, -27, -28, -29, -30, -31, -32,
+	-33, -34, -35, -36, -54, 180, 181, 9, 49, -40,
+	-41, -42, -46, -47, -48, -49, 283, 289, 327, 423,
+	424, 425, 426, -68, -70, -63, -21, -22, -23, -61,
+	177, -13, -14, -62, -15, -16, -17, 199, 198, 26,
+	197, 178, 120, 121, 123, 124, 30, -69, 54, 379,
+	179, -71, 6, 430, -78, 27, -100, -171, 57, -160,
+	-162, 382, 383, 384, 385, 386, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	401, 402, 403, 404, 405, 406, 407, 408, 409, 410,
+	411, 412, 413, 414, 415, 416, 417, 426, 376, 216,
+	240, 243, 250, 423, 120, 137, 131, 158, 150, 151,
+	128, 220, 221, 64, 123, 356, 341, 326, 126, 235,
+	237, 239, 242, 222, 146, 142, 234, 218, 140, 223,
+	28, 224, 163, 225, 226, 381, 337, 265, 360, 343,
+	247, 141, 338, 238, 340, 164, 168, 348, 289, 135,
+	136, 345, 350, 162, 196, 32, 378, 34, 208, 351,
+	166, 161, 157, 359, 251, 249, 160, 134, 156, 219,
+	38, 170, 169, 171, 319, 320, 321, 322, 323, 324,
+	227, 228, 347, 153, 143, 269, 129, 18, 354, 203,
+	342, 290, 229, 244, 206, 252, 165, 167, 210, 339,
+	215, 380, 248, 352, 139, 207, 236, 202, 355, 130,
+	283, 292, 230, 124, 427, 424, 241, 349, 358, 37,
+	305, 133, 127, 193, 121, 212, 217, 231, 232, 233,
+	254, 253, 245, 154, 209, 159, 132, 155, 122, 211,
+	428, 357, 306, 429, 267, 308, 152, 149, 213, 186,
+	353, 344, 346, 125, 312, 307, 147, 255, 420, 421,
+	422, 11, -175, 19, 325, -44, 181, -171, -9, -8,
+	-37, -67, 184, 184, -75, -76, -77, -140, -142, -100,
+	54, -171, -246, -216, -245, -217, -248, -218, -170, 20,
+	178, 177, 211, 10, 179, 287, 185, 8, 6, 288,
+	197, 9, 289, 291, 292, 295, 296, 297, 31, 300,
+	301, 57, 60, -171, -246, -216, 215, 222, 362, 362,
+	-298, 326, 380, 246, 237, 427, -66, -82, -83, -141,
+	27, 15, 5, -68, -70, -283, 308, 214, -210, -208,
+	-282, 78, 194, 193, 76, 362, 183, 298, 346, -335,
+	-279, 344, 343, -179, 342, 335, 337, 177, 185, 345,
+	32, 348, 349, 338, 184, 308, 125, 122, -232, 80,
+	130, 129, -232, 214, 29, -239, 318, -238, -240, 348,
+	349, 359, -233, 347, -158, -171, 58, 59, 75, 151,
+	148, -83, -141, -82, -68, -70, -64, -65, -171, 308,
+	214, 185, 184, 186, 362, -281, 20, -287, 21, 22,
+	-1, -89, 206, -100, 119, -75, -152, -171, 326, 89,
+	-44, 119, -60, -59, -100, -100, 30, -143, -144, -145,
+	-146, 41, 45, 47, 42, 43, 44, 48, -337, 23,
+	-167, -173, 23, -168, 60, -169, -162, 57, 58, 59,
+	-68, 51, 55, 11, 55, 54, 431, 58, 285, 299,
+	308, 286, 298, 186, 214, 299, 214, 335, 186, 290,
+	293, 294, 336, 51, 187, 51, -297, 359, -172, -169,
+	-162, -172, 65, 184, 184, 77, -66, -85, 17, -71,
+	-70, 419, 16, 20, 21, 186, -206, 189, -206, 185,
+	-206, 184, 369, 19, -336, 11, 99, 213, 212, 339,
+	336, -255, 340, 341, -179, -178, 97, -179, 184, 186,
+	362, -100, -280, 189, 352, 379, 128, 129, 130, -243,
+	20, 29, 317, -216, 214, 55, 89, 19, -241, 89,
+	100, -240, -240, -240, -241, -118, 29, -169, 60, 116,
+	-118, 29, 119, 30, 30, -84, -85, -71, -70, 56,
+	56, 55, -88, 54, -280, -280, -280, -280, -280, -280,
+	-72, -73, 107, -192, -171, 81, -194, 57, -188, 383,
+	384, 385, 386, 387, 388, 389, 391, 396, 398, 402,
+	403, 404, 405, 409, 410, 415, 416, 417, 308, 147,
+	-189, -191, -318, -313, -187, 54, 105, 106, 113, 82,
+	-190, -268, 24, 370, -148, -149, -150, -151, -314, -312,
+	60, 65, 69, 71, 72, 70, 67, 118, -70, -286,
+	-292, -290, 148, 200, 144, 145, 8, 111, 318, 116,
+	-293, 59, 58, 271, 75, 272, 273, 362, 268, 274,
+	189, 325, 43, 275, 276, 277, 278, 279, 369, 280,
+	44, 281, 270, 204, 282, 373, 372, 374, 366, 363,
+	361, 364, 365, 367, 368, -288, 33, -67, 54, 30,
+	54, -171, -161, 266, 182, 20, 80, 23, 25, 271,
+	303, 83, 116, 16, 84, 148, 115, 273, 370, 272,
+	177, 47, 75, 372, 374, 373, 363, 361, 310, 314,
+	316, 313, 362, 335, 29, 10, 26, 198, 21, 22,
+	109, 179, 200, 87, 88, 201, 24, 199, 72, 19,
+	50, 11, 325, 13, 14, 274, 309, 189, 188, 99,
+	328, 185, 45, 8, 118, 27, 96, 311, 41, 77,
+	43, 97, 17, 364, 365, 31, 327, 375, 205, 111,
+	275, 276, 277, 48, 81, 317, 70, 51, 78, 15,
+	46, 98, 180, 369, 44, 214, 315, 279, 281, 280,
+	183, 6, 270, 371, 30, 197, 42, 184, 336, 86,
+	187, 71, 204, 144, 145, 5, 76, 9, 49, 52,
+	366, 367, 368, 33, 85, 12, 379, 282, 278, 318,
+	329, 330, 331, 332, 333, 334, 172, 173, 174, 175,
+	176, 246, 192, 190, 194, 195, 418, 419, -137, 12,
+	119, 65, 60, -171, 55, 187, 54, -231, -230, -152,
+	-76, -76, -76, -76, 41, 41, 41, 46, 41, 46,
+	41, -145, -168, -173, 56, -247, 184, 284, 210, -245,
+	211, 289, 292, -222, -221, -219, -170, 60, -217, -250,
+	-152, -170, 336, -247, -222, -221, 328, 187, 11, -101,
+	-100, -101, -66, -192, -171, 60, -81, -80, -192, -100,
+	-222, 81, -216, -169, -171, -206, -219, -100, -178, -178,
+	-180, -336, -176, -336, 336, -137, -191, -255, -177, -171,
+	-177, -206, -58, -57, -56, 182, 179, 204, 180, 178,
+	-222, 308, 24, 353, 354, 126, 129, 128, 360, -244,
+	317, 20, -216, -238, -234, 60, 318, -221, -242, 51,
+	116, -294, -192, 29, -241, -241, -241, -242, 115, -171,
+	-66, -65, 23, -87, -171, -222, -216, -171, -101, -101,
+	-172, -136, 55, -135, 11, -166, 80, 78, 79, -171,
+	23, 119, -192, 96, -202, 89, 90, 91, 92, 93,
+	94, 54, 54, 54, 54, 54, 54, 54, 54, -200,
+	54, 54, 54, 54, 54, -200, 54, 54, 54, 102,
+	101, 112, 105, 106, 107, 108, 109, 110, 111, 103,
+	104, 99, 81, 97, 98, 83, -70, -192, -197, -191,
+	-191, -191, -191, -268, 54, -192, 54, -291, 54, -199,
+	-200, 54, 60, 60, 60, 54, 54, 54, -191, 54,
+	-289, -198, -331, 418, -91, 56, -86, -171, -329, -330,
+	-86, -90, -171, -83, -192, -164, -165, -157, -161, -168,
+	-169, -162, 19, -44, 119, -59, -100, -87, -137, 55,
+	89, -93, -92, 51, 52, -94, 51, -92, 41, 41,
+	-249, 107, 57, 55, -220, 309, 431, 58, 56, 55,
+	-249, 187, 60, 60, 60, 55, 55, 18, 119, -307,
+	325, 55, -79, 25, 26, -88, -223, -224, 315, 24,
+	-209, 52, -204, -205, -203, -207, 29, -100, -137, -137,
+	-137, -178, -172, -180, -175, -180, -176, 119, -159, -171,
+	55, -102, 191, 206, -152, -171, 191, 206, 206, 191,
+	-334, 187, 23, -333, 191, -223, 54, 127, 130, 130,
+	129, -216, 187, 54, 89, -242, -242, -242, 29, -170,
+	54, 56, 55, 51, -137, -73, -74, -75, -192, -192,
+	-192, -171, -171, 107, 70, 81, -188, -196, -197, -192,
+	-147, 21, 20, -147, -147, -192, -147, 107, -197, -197,
+	56, -270, 65, -147, -147, -147, -147, -147, -147, -147,
+	-189, -189, -189, -189, -189, -189, -189, -189, -189, -189,
+	-189, -189, -195, -201, -268, 54, 99, 97, 98, 83,
+	-191, -189, -189, 56, 55, -192, -269, 270, -196, 56,
+	-197, -196, -189, -196, -147, 55, 54, 56, 55, 33,
+	119, 55, 89, 56, 55, -84, 119, 326, -171, 56,
+	-83, -230, -192, -192, 54, -192, 11, 119, 119, -221,
+	16, 379, -170, -152, 187, -222, -299, 188, 369, -100,
+	-192, -192, -171, -308, 221, -171, -80, 23, -228, 379,
+	317, 316, 312, -225, -226, 311, 313, 310, 314, 51,
+	260, 261, 262, 263, -203, -158, 115, 225, 151, 54,
+	-137, -178, -178, -180, -171, -56, 182, 179, -102, 54,
+	-104, -108, -105, -107, -106, -110, -109, 148, 149, 116,
+	152, 154, 155, 156, 157, 158, 159, 160, 161, 162,
+	163, 30, 200, 144, 145, 146, 147, 164, 131, 150,
+	377, 172, 132, 173, 133, 174, 134, 175, 135, 136,
+	176, 137, 140, 141, 139, -171, -90, -90, -171, -100,
+	-171, -228, 56, 130, -222, -181, 60, -234, -67, -171,
+	-100, -139, 13, 55, 119, 70, 56, 55, -192, -192,
+	-192, 23, -197, 56, 56, 56, 56, -192, -192, -192,
+	-192, -192, -192, -192, -197, -195, -191, -189, -189, -193,
+	201, 80, -192, 55, 52, 56, 56, 52, 56, 55,
+	56, -192, -198, -296, -295, -294, 33, -67, -86, -289,
+	-171, -330, -294, -171, -164, -161, -169, -162, 65, -84,
+	-87, -222, 107, 107, 57, -170, 318, -170, -222, -235,
+	379, 27, 119, -302, -255, 60, -67, -227, -229, 319,
+	320, 321, 322, 323, 324, 80, -226, 60, 60, 60,
+	60, -100, -163, 89, -163, -163, -95, -96, -97, -102,
+	-98, -183, -99, 192, 190, 194, -326, 76, 195, 246,
+	77, 185, -137, -137, -178, -262, -260, 206, -115, -114,
+	-112, 70, 81, 29, 303, -113, 64, 115, 239, 217,
+	240, -133, -182, 190, 76, 77, 291, -183, -276, 306,
+	305, -271, -273, 54, -272, 54, -273, -271, -271, 54,
+	54, -271, -271, -271, -271, -274, 54, -275, 54, -274,
+	187, 30, 179, -185, -186, -184, 266, -284, 318, 309,
+	56, 56, -138, 14, 16, -75, -171, 107, -192, 56,
+	56, 56, -103, -109, 116, 148, 200, 147, 146, 144,
+	305, 306, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, -193, 80, -191, -188, 56, -103, -118, -118, -189,
+	56, 56, 55, -289, 56, -170, 16, 23, -223, 289,
+	184, -310, 334, -303, -301, 329, 330, 331, 332, -229,
+	65, 65, 65, 65, 65, 65, -226, 54, -118, -120,
+	-169, 60, 116, 60, 56, 55, -99, -171, 77, -325,
+	-326, -206, -325, 77, 54, -137, 56, 55, 57, -112,
+	70, -189, 60, -120, -121, 29, 238, 234, -122, 29,
+	218, 219, -124, 54, 246, 77, 77, -100, -278, 307,
+	65, 65, -154, 60, -154, 65, 65, -171, 29, 29,
+	-184, 267, 31, 118, 269, 29, 265, 16, -192, -197,
+	56, -271, -272, -271, -271, -271, -111, 136, 135, -111,
+	-188, 56, 56, 56, 56, -294, -170, -170, -235, 290,
+	-100, -277, 420, -305, 333, -301, 16, 331, 16, 16,
+	-211, -213, -152, 54, -116, -117, -134, 303, 216, -207,
+	220, 64, 221, 326, 222, 185, 224, 225, 226, 196,
+	227, 228, 229, 318, 230, 231, 232, 233, 286, 5,
+	256, 428, -97, -322, -323, -171, -323, -171, -322, -322,
+	-206, -192, -260, -263, 33, 65, 54, -212, 54, 56,
+	56, 56, 55, 56, 55, 56, 55, -189, 65, -285,
+	-181, -300, -255, -125, 421, 60, -306, 329, 16, -304,
+	60, 16, -304, -304, 56, 55, -271, -192, -251, 206,
+	55, -134, -163, -163, -158, 115, -163, -163, -163, -163,
+	223, 223, -163, -163, -163, -163, -163, -163, -163, -163,
+	-163, -163, -163, -163, -163, -163, 54, -163, 54, 52,
+	255, 54, 54, 54, -323, 56, -267, -264, 54, -134,
+	208, 99, 56, -192, -127, -126, 375, -211, 60, 65,
+	65, 268, -309, 334, -303, -155, 422, 65, 16, 60,
+	-304, -214, -213, -79, 56, 16, -134, 65, 65, -163,
+	-163, 65, 60, 60, 60, -163, -163, 65, 60, -173,
+	65, 65, 65, 65, 29, 60, -123, 29, 234, 238,
+	235, 236, 237, 65, 29, 65, 29, 65, 29, -171,
+	54, -327, -328, 60, -171, -211, -324, 260, 261, 262,
+	264, 263, -324, -211, -211, -211, 54, -237, -236, 247,
+	81, -267, -134, -266, -265, 253, 209, 54, 56, -131,
+	-132, -129, -130, 51, 338, 244, 245, 56, 56, 56,
+	-311, 188, -305, 54, 60, -215, 196, 64, 379, 258,
+	259, -79, -252, 248, 249, -253, -259, 251, -118, -118,
+	60, 60, -119, 217, -101, 56, 55, 89, 105, 56,
+	56, 56, 56, -211, 247, 56, 55, 57, 54, 205,
+	-197, -236, -130, 51, -129, 51, 10, 9, -317, 54,
+	65, -306, -156, -171, -163, 60, 257, -257, 252, 54,
+	-255, 54, -255, 77, 261, 218, 219, 56, -328, 60,
+	118, -214, -214, -214, -214, 56, -265, -264, -197, 205,
+	56, -128, 241, 242, 30, 129, -128, -321, 30, 56,
+	-316, -315, -153, -312, -171, 334, 56, 55, 65, -169,
+	-254, 253, 65, -189, 54, -189, 54, -256, 250, 54,
+	65, -133, 56, 56, 70, 29, 243, -320, -319, -318,
+	56, 55, 119, -171, -261, 54, 16, 56, -250, 56,
+	-250, 54, 89, -189, 268, 55, 89, -315, -171, -262,
+	-253, 56, 56, -250, 65, 56, -319, 29, -192, 119,
+	56, -258, 254, 56, -171, 65,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	25, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	421, 422, 423, 0, 0, 264, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 200, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 324, -2, 424, 425, 426, -2, 265, 266, 267,
-	268, 269, 196, 197, 198, -2, 0, 173, 0, 165,
-	165, 0, 334, 0, 0, 345, 354, 20, 302, 0,
-	307, 598, 634, 635, 636, 1236, 1237, 1238, 1239, 1240,
-	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
-	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
-	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
-	1271, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
+	20, 21, 22, 23, 24, 476, 477, 478, 0, 0,
+	308, 0, 258, 259, 260, 261, 262, 263, 264, 265,
+	266, 267, 268, 215, 216, 0, 0, 0, 194, 174,
+	175, 176, 133, 134, 135, 136, 0, 0, 0, 0,
+	0, 30, 0, 372, -2, 0, 479, 480, 481, 482,
+	-2, 309, 310, 311, 312, 313, 314, 210, 211, 212,
+	-2, 0, 187, 0, 179, 179, 0, 389, 0, 0,
+	0, 400, 409, 25, 348, 0, 353, 663, 700, 701,
+	702, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
+	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
+	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
+	1340, 1341, 1342, 1343, 1344, 1345, 1346, 1147, 1148, 1149,
+	1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
+	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
+	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
+	1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189,
+	1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199,
+	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
+	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
+	1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
+	1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239,
+	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249,
+	1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259,
+	1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269,
+	1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279,
+	1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289,
+	1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
+	1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309,
+	1310, 0, 203, 0, 0, 207, 0, 305, 199, 200,
+	201, 202, 0, 0, 0, 431, 432, 455, 458, 461,
+	0, 193, 0, 0, 94, 525, 96, 527, 0, 100,
+	102, 103, -2, 107, 108, 109, 110, 111, 112, 113,
+	0, 115, 1198, 117, 1261, 120, 121, 122, 0, 131,
+	132, -2, -2, 522, 0, 0, 1250, 76, 0, 0,
+	0, 31, 32, 0, 0, 0, 373, -2, 0, 0,
+	0, 0, 405, 374, 389, 0, 556, 556, 0, 556,
+	0, 0, 533, 534, 535, 554, 555, 569, 269, 0,
+	0, 280, 281, 0, 297, 288, 297, 0, 272, 273,
+	274, 278, 279, 298, 0, 243, 188, 189, 178, 0,
+	183, 0, 177, 0, 0, 147, 0, 152, 0, 1197,
+	1267, 1213, 0, 1232, 0, 172, 165, 166, 991, 1159,
+	0, 384, 0, 390, 0, 389, 375, 376, 379, 243,
+	243, 243, 243, 243, 243, 0, 410, 411, 412, 413,
+	3, 0, 0, 352, 0, 418, 204, 703, 0, 0,
+	209, 0, 237, 238, 0, 214, 0, 0, 0, 0,
+	0, 446, 0, 0, 445, 0, 0, 0, 0, 459,
+	460, 462, 0, 464, 465, 471, 472, 473, 474, 475,
+	0, 90, 0, 0, 0, 0, 0, 529, 101, 130,
+	104, 105, 0, 125, 127, 129, 128, 114, 126, 116,
+	118, 119, 124, 90, 0, 0, 0, 77, 0, 469,
+	470, 0, 29, 0, 0, 28, 382, 354, 0, 402,
+	404, 0, 0, 406, 407, 0, 0, 0, 0, 0,
+	0, 556, 485, 0, 0, 293, 294, 288, 288, 282,
+	290, 0, 295, 296, 0, 418, 0, 0, 0, 0,
+	556, 0, 0, 0, 0, 0, 181, 0, 186, 137,
+	142, 140, 141, 143, 0, 0, 0, 0, 0, 170,
+	171, 0, 0, 0, 0, 159, 162, 655, 656, 657,
+	163, 164, 0, 992, 993, 354, 385, 401, 403, 398,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	426, 420, 422, 466, 42, 0, 894, 700, 898, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1320, 1325, 1327, -2,
+	-2, -2, 1334, 1338, 1339, 1344, 1345, 1346, -2, -2,
+	907, 771, 772, 773, 774, 0, 0, 0, 0, 0,
+	781, 782, 0, 0, 787, 788, 789, 790, 52, 53,
+	923, 924, 925, 926, 927, 928, 929, 861, 758, 0,
+	846, 836, 0, 856, 874, 875, 0, 0, 0, 0,
+	0, 54, 55, 852, 853, 854, 855, 857, 858, 859,
+	860, 862, 863, 864, 865, 866, 867, 868, 869, 870,
+	871, 872, 873, 876, 878, 848, 849, 850, 851, 840,
+	841, 842, 843, 844, 845, 322, 340, 324, 0, 329,
+	0, 664, 665, 1002, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018,
+	1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028,
+	1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038,
+	1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048,
+	1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
+	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
+	1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
 	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098,
 	1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108,
 	1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
 	1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
 	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
-	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
-	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
-	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
-	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
-	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
-	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198,
-	1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208,
-	1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218,
-	1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228,
-	1229, 1230, 1231, 1232, 1233, 1234, 1235, 0, 189, 0,
-	0, 193, 0, 261, 185, 186, 187, 188, 0, 0,
-	376, 377, 400, 403, 406, 0, 179, 0, 0, 80,
-	464, 82, 466, 0, 86, 88, 89, -2, 93, 94,
-	95, 96, 97, 98, 99, 0, 101, 1129, 103, 1189,
-	106, 107, 108, 0, 117, 118, -2, -2, 461, 0,
-	0, 1178, 62, 325, -2, 0, 0, 0, 0, 350,
-	495, 495, 0, 495, 0, 472, 473, 474, 493, 494,
-	508, 0, 0, 237, 238, 0, 254, 245, 254, 0,
-	229, 230, 231, 235, 236, 255, 203, 174, 175, 164,
-	0, 169, 0, 163, 0, 0, 133, 0, 138, 0,
-	1128, 1193, 1144, 0, 1161, 0, 158, 151, 152, 925,
-	1090, 0, 329, 0, 335, 0, 334, 203, 203, 203,
-	203, 203, 0, 355, 356, 357, 358, 3, 0, 0,
-	306, 0, 363, 190, 637, 0, 0, 195, 0, 0,
-	0, 0, 0, 0, 0, 391, 0, 0, 390, 0,
-	0, 0, 0, 404, 405, 407, 0, 409, 410, 416,
-	417, 418, 419, 420, 0, 334, 76, 0, 0, 0,
-	0, 0, 468, 87, 116, 90, 91, 0, 111, 113,
-	115, 114, 100, 112, 102, 104, 105, 110, 76, 0,
-	0, 0, 63, 327, 308, 0, 347, 349, 0, 0,
-	351, 352, 0, 0, 0, 0, 0, 495, 0, 250,
-	251, 245, 245, 239, 247, 0, 252, 253, 0, 363,
-	0, 0, 0, 495, 0, 0, 0, 0, 167, 0,
-	172, 123, 128, 126, 127, 129, 0, 0, 0, 0,
-	0, 156, 157, 0, 0, 0, 0, 145, 148, 590,
-	591, 592, 149, 150, 0, 926, 927, 308, 330, 346,
-	348, 343, 344, 0, 0, 0, 0, 0, 371, 365,
-	367, 411, 28, 0, 828, 634, 832, 1237, 1238, 1239,
-	1240, 1241, 1242, 1243, 1245, 1250, 1252, -2, -2, -2,
-	1259, 1263, 1264, 1269, 1270, 1271, -2, -2, 841, 705,
-	706, 707, 708, 0, 0, 0, 0, 0, 715, 716,
-	0, 0, 721, 722, 723, 724, 38, 39, 857, 858,
-	859, 860, 861, 862, 863, 795, 692, 0, 780, 770,
-	0, 790, 808, 809, 0, 0, 0, 0, 0, 40,
-	41, 786, 787, 788, 789, 791, 792, 793, 794, 796,
-	797, 798, 799, 800, 801, 802, 803, 804, 805, 806,
-	807, 810, 812, 782, 783, 784, 785, 774, 775, 776,
-	777, 778, 779, 276, 294, 278, 0, 283, 0, 599,
-	334, 0, 0, 191, 0, 262, 0, 363, 182, 0,
-	394, 388, 0, 381, 392, 393, 384, 0, 386, 0,
-	382, 383, 401, 408, 402, 0, 77, 78, 79, 81,
-	92, 0, 0, 70, 449, 455, 452, 462, 465, 0,
-	84, 467, 109, 0, 65, 0, 0, 328, 331, 28,
-	310, 336, 337, 340, 436, 0, 463, 487, -2, 0,
-	363, 363, 363, 245, 0, 247, 0, 247, 242, 246,
-	0, 256, 258, 0, 436, 1220, 204, 176, 177, 0,
-	0, 171, 0, 0, 130, 131, 132, 139, 134, 136,
-	0, 0, 140, 153, 154, 155, 300, 301, 0, 0,
-	0, 144, 0, 159, 326, 270, 271, 0, 273, 596,
-	274, 414, 415, 363, 0, 372, 0, 368, 0, 0,
-	0, 412, 0, 0, 827, 0, 0, 846, 847, 848,
-	849, 850, 851, 820, 815, 815, 815, 0, 815, 0,
-	0, 756, 0, 815, 815, 815, 815, 757, 815, 815,
-	815, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, -2, 822,
-	0, 711, 712, 713, 714, 717, 0, 0, 820, 759,
-	0, 760, 771, 0, 763, 764, 765, 820, 0, 820,
-	769, 815, 277, 291, 0, 295, 0, 0, 287, 289,
-	282, 284, 0, 0, 304, 329, 364, 638, 0, 932,
-	-2, 934, -2, -2, 936, 937, 938, 939, 940, 941,
-	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
-	952, 953, 954, 955, 956, 957, 958, 959, 960, 961,
-	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
-	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
-	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
-	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
-	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011,
-	1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021,
-	1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031,
-	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
-	1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
-	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061,
-	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
-	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 0, 194,
-	0, 0, 398, 334, 0, 0, 378, 395, 0, 0,
-	379, 0, 380, 385, 387, 0, 71, 75, 0, 451,
-	0, 0, 454, 83, 0, 0, 0, 59, 0, 0,
-	0, 314, 0, 0, 339, 341, 342, 428, 437, 0,
-	496, 0, 0, 492, -2, 499, 0, 505, 0, 228,
-	232, 233, 363, 248, 245, 249, 245, 247, 0, 257,
-	260, 428, 0, 178, 166, 168, 0, 125, 0, 0,
-	0, 141, 142, 143, 146, 147, 0, 0, 361, 366,
-	373, 374, 824, 825, 826, 413, 29, 369, 829, 0,
-	831, 0, 821, 822, 0, 816, 817, 0, 0, 0,
-	0, 0, 0, 0, 772, 0, 856, 0, 0, 0,
-	0, 0, 0, 0, 693, 694, 695, 696, 697, 698,
-	699, 700, 701, 702, 703, 704, 833, 844, 845, 0,
-	0, 0, 0, 0, 842, 837, 0, 709, 0, 0,
-	814, 818, 0, 781, 0, 0, 0, 0, 0, 294,
-	296, 0, 0, 294, 0, 0, 0, 303, 0, 275,
-	0, 0, 263, 199, 0, 329, 183, 184, 396, 0,
-	389, 0, 0, 0, 450, 0, 0, 453, 85, 0,
-	67, 0, 60, 61, 332, 333, 29, 316, 48, 0,
-	0, 338, 427, 0, 438, 439, 440, 441, 442, 0,
-	0, 0, 0, 0, 488, 489, 490, 491, 500, 928,
-	928, 928, 0, 600, 240, 363, 363, 245, 259, 205,
-	0, 170, 124, 0, 217, 135, 272, 597, 359, 0,
-	0, 0, 830, 755, 0, 0, 0, 0, 0, 0,
-	744, 738, 739, 773, 0, 0, 0, 0, 0, 0,
-	0, 0, 834, 842, 838, 0, 835, 0, 0, 823,
-	0, 0, 758, 761, 0, 766, 0, 768, 0, 292,
-	0, 297, 298, 294, 281, 288, 280, 290, 285, 286,
-	305, 639, 933, 930, 931, 192, 399, 181, 0, 69,
-	72, 73, 74, 456, 0, 457, 436, 66, 0, 0,
-	0, 318, 0, 315, 0, 0, 0, 429, 430, 0,
-	0, 0, 0, 0, 444, 445, 446, 447, 448, 0,
-	0, 929, 0, 0, 0, 601, 602, 604, 605, 0,
-	0, 607, 661, 0, 616, 495, 616, 0, 0, 618,
-	619, 243, 241, 363, 201, 206, 207, 0, 211, 0,
-	0, 137, 353, 0, 0, 375, 30, 370, 823, 740,
-	741, 742, 0, 725, 910, 912, 728, 910, 910, 910,
-	734, 734, 915, 917, 915, 743, 745, 746, 749, 747,
-	750, 751, 737, 819, 836, 0, 843, 839, 710, 0,
-	0, 0, 0, 748, 293, 0, 279, 397, 460, 0,
-	0, 67, 0, 0, 320, 0, 317, 0, 311, 313,
-	58, 431, 432, 433, 434, 435, 443, 0, 501, 502,
-	593, 594, 595, 503, -2, 0, -2, 920, 865, 866,
-	867, 910, 869, 912, 0, 910, 910, 896, 897, 898,
-	899, 900, 901, 902, 903, 904, 0, 0, 887, 910,
-	910, 910, 910, 907, 870, 871, 872, 873, 874, 875,
-	876, 877, 878, 879, 880, 881, 882, 883, 606, 662,
-	628, 628, 617, 628, 628, 495, 0, 244, 208, 209,
-	210, 0, 213, 214, 216, 0, 360, 362, 718, 726,
-	911, 0, 727, 0, 729, 730, 731, 732, 735, 736,
-	733, 884, 0, 885, 0, 886, 840, 719, 720, 762,
-	767, 299, 458, 459, 64, 68, 50, 309, 0, 319,
-	49, 0, 0, 483, 910, 0, 509, -2, 546, 928,
-	928, 0, 928, 928, 928, 928, 0, 0, 928, 928,
-	928, 928, 928, 928, 928, 928, 928, 928, 928, 928,
-	928, 928, 0, 603, 630, -2, 642, 644, 0, 0,
-	647, 648, 0, 0, 0, 0, 684, 654, 0, 0,
-	854, 855, 0, 660, 923, 921, 922, 868, 892, 893,
-	894, 895, 0, 0, 888, 889, 890, 891, 0, 620,
-	629, 0, 629, 0, 0, 628, 0, 0, 215, 202,
-	0, 0, 0, 0, 44, 0, 0, 0, 476, 0,
-	340, 0, 506, 0, 504, 548, 0, 0, 928, 928,
-	0, 0, 0, 0, 928, 928, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 643, 645, 646, 649, 650, 651, 689, 690, 691,
-	652, 686, 687, 688, 653, 0, 0, 852, 853, 682,
-	864, 924, 0, 908, 0, 0, 0, 0, 0, 0,
-	0, 0, 614, 212, 914, 913, 0, 918, 0, 42,
-	46, 51, 52, 0, 0, 0, 0, 0, 322, 312,
-	475, 484, 485, 340, 542, 547, 549, 550, 0, 0,
-	553, 554, 555, 556, 0, 0, 559, 560, 561, 562,
-	563, 564, 565, 566, 567, 568, 584, 585, 586, 587,
-	588, 589, 569, 570, 571, 572, 573, 574, 581, 0,
-	0, 578, 0, 0, 0, 677, 0, 905, 0, 906,
-	0, 621, 623, 624, 625, 626, 627, 622, 0, 0,
-	0, 0, 613, 615, 657, 0, 0, 0, 31, 0,
-	48, 0, 53, 0, 0, 0, 0, 321, 0, 477,
-	928, 0, 0, 481, 482, 486, 531, 0, 0, 537,
-	0, 543, 551, 552, 557, 558, 575, 0, 0, 577,
-	0, 0, 685, 0, 664, 678, 0, 0, 909, 476,
-	476, 476, 476, 0, 658, 916, 919, 22, 0, 0,
-	45, 0, 54, 0, 56, 57, 323, 0, 479, 0,
-	511, 0, 0, 0, 0, 0, 540, 0, 582, 583,
-	576, 579, 580, 655, 663, 665, 666, 667, 0, 679,
-	680, 681, 683, 608, 609, 610, 611, 0, 21, 0,
-	32, 0, 34, 36, 37, 631, 43, 47, 55, 478,
-	480, 513, 0, 532, 0, 0, 0, 0, 0, 0,
-	0, 656, 668, 0, 669, 0, 0, 0, 612, 23,
-	24, 0, 33, 0, 0, 510, 0, 542, 533, 0,
-	535, 0, 0, 0, 0, 670, 672, 673, 0, 0,
-	671, 0, 0, 35, 632, 0, 515, 0, 529, 534,
-	536, 0, 541, 539, 674, 676, 675, 25, 26, 27,
-	0, 514, 0, 527, 512, 0, 538, 633, 516, -2,
-	0, 530, 517, -2, 0, 525, 0, 518, 526, 0,
-	521, 0, 0, 520, 0, -2, 0, 522, -2, 0,
-	528,
+	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 389, 0,
+	0, 205, 0, 306, 0, 0, 0, 418, 196, 0,
+	449, 443, 0, 436, 447, 448, 439, 0, 441, 0,
+	437, 438, 456, 463, 457, 0, 91, 92, 93, 95,
+	106, 0, 0, 84, 510, 516, 513, 523, 526, 0,
+	98, 528, 123, 0, 79, 0, 0, 0, 0, 26,
+	661, 27, 383, 386, 42, 356, 391, 392, 395, 379,
+	497, 0, 524, 548, -2, 0, 270, 418, 418, 418,
+	288, 0, 290, 0, 290, 285, 289, 0, 299, 302,
+	300, 0, 217, 218, 219, 0, 0, 0, 234, 232,
+	497, 1295, 244, 190, 191, 0, 0, 185, 0, 0,
+	144, 145, 146, 153, 148, 150, 0, 0, 154, 167,
+	168, 169, 346, 347, 0, 0, 0, 158, 0, 173,
+	381, 377, 0, 0, 453, 315, 316, 0, 318, 319,
+	320, 418, 0, 427, 0, 423, 0, 0, 0, 467,
+	0, 0, 893, 0, 0, 912, 913, 914, 915, 916,
+	917, 886, 881, 881, 881, 0, 881, 0, 0, 822,
+	0, 881, 881, 881, 881, 823, 881, 881, 881, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 888, 0, 777,
+	778, 779, 780, 783, 0, 0, 886, 825, 0, 826,
+	837, 0, 829, 830, 831, 886, 0, 886, 835, 881,
+	323, 337, 0, 341, 0, 0, 333, 335, 328, 330,
+	0, 0, 350, 384, 419, 704, 0, 998, -2, 1000,
+	-2, -2, 0, 208, 0, 239, 240, 0, 389, 0,
+	0, 433, 450, 0, 0, 434, 0, 435, 440, 442,
+	0, 85, 89, 0, 512, 0, 0, 515, 97, 0,
+	0, 0, 73, 33, 34, 0, 0, 0, 0, 358,
+	0, 0, 394, 396, 397, 0, 487, 498, 0, 557,
+	0, 0, 553, -2, 560, 0, 566, 0, 271, 275,
+	276, 418, 291, 288, 292, 288, 290, 0, 301, 304,
+	0, 221, 0, 1244, 0, 223, 0, 1244, 0, 0,
+	0, 235, 236, 0, 233, 487, 0, 192, 180, 182,
+	0, 139, 0, 0, 0, 155, 156, 157, 160, 161,
+	0, 380, 0, 0, 416, 421, 428, 429, 890, 891,
+	892, 468, 43, 424, 895, 0, 897, 0, 887, 888,
+	0, 882, 883, 0, 0, 0, 0, 0, 0, 0,
+	838, 0, 922, 0, 0, 0, 0, 0, 0, 0,
+	759, 760, 761, 762, 763, 764, 765, 766, 767, 768,
+	769, 770, 899, 910, 911, 0, 0, 0, 0, 0,
+	908, 903, 0, 775, 0, 0, 880, 884, 0, 847,
+	0, 0, 0, 0, 0, 340, 342, 0, 0, 340,
+	0, 0, 0, 349, 0, 321, 0, 0, 307, 213,
+	384, 197, 198, 451, 0, 444, 0, 0, 0, 511,
+	0, 0, 514, 99, 0, 81, 0, 74, 75, 662,
+	387, 388, 43, 360, 0, 357, 393, 0, 486, 0,
+	499, 500, 501, 502, 503, 0, 0, 0, 0, 0,
+	549, 550, 551, 552, 561, 994, 994, 994, 0, 666,
+	283, 418, 418, 288, 303, 220, 0, 0, 222, 0,
+	-2, 986, 931, 932, 933, 976, 935, 978, 0, 976,
+	976, 962, 963, 964, 965, 966, 967, 968, 969, 970,
+	0, 0, 953, 976, 976, 976, 976, 973, 936, 937,
+	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
+	948, 949, 981, 983, 981, 224, 230, 231, 0, 228,
+	0, 245, 0, 184, 138, 0, 257, 149, 0, 454,
+	317, 414, 0, 0, 0, 896, 821, 0, 0, 0,
+	0, 0, 0, 810, 804, 805, 839, 0, 0, 0,
+	0, 0, 0, 0, 0, 900, 908, 904, 0, 901,
+	0, 0, 889, 0, 0, 824, 827, 0, 832, 0,
+	834, 0, 338, 0, 343, 344, 340, 327, 334, 326,
+	336, 331, 332, 351, 705, 999, 996, 997, 206, 195,
+	0, 83, 86, 87, 88, 517, 0, 518, 497, 80,
+	0, 0, 0, 362, 0, 359, 483, 488, 489, 0,
+	0, 0, 0, 0, 0, 0, 505, 506, 507, 508,
+	509, 0, 0, 995, 0, 0, 0, 667, 668, 670,
+	671, 0, 673, 727, 0, 682, 556, 682, 0, 0,
+	684, 685, 286, 284, 418, 0, 576, 0, 696, -2,
+	708, 710, 0, 0, 713, 714, 0, 0, 0, 0,
+	750, 720, 0, 0, 920, 921, 0, 726, 989, 987,
+	988, 934, 977, 0, 958, 0, 959, 960, 961, 0,
+	0, 954, 955, 956, 957, 950, 0, 951, 0, 952,
+	0, 0, 0, 241, 246, 247, 0, 251, 0, 0,
+	151, 378, 408, 0, 0, 430, 44, 425, 889, 806,
+	807, 808, 0, 791, 976, 978, 794, 976, 976, 976,
+	800, 800, 809, 811, 812, 815, 813, 816, 817, 803,
+	885, 902, 0, 909, 905, 776, 0, 0, 0, 0,
+	814, 339, 0, 325, 452, 521, 0, 0, 81, 0,
+	0, 364, 60, 361, 66, 0, 0, 0, 0, 490,
+	491, 492, 493, 494, 495, 496, 504, 0, 562, 563,
+	658, 659, 660, 564, -2, 0, 672, 728, 694, 694,
+	683, 694, 694, 556, 0, 287, 229, 0, 588, 709,
+	711, 712, 715, 716, 717, 755, 756, 757, 718, 752,
+	753, 754, 719, 0, 0, 918, 919, 748, 930, 990,
+	0, 0, 0, 974, 0, 0, 0, 225, 0, 227,
+	248, 249, 250, 0, 253, 254, 256, 0, 415, 417,
+	784, 792, 793, 795, 796, 797, 798, 801, 802, 799,
+	906, 785, 786, 828, 833, 345, 519, 520, 78, 82,
+	64, 366, 0, 62, 0, 67, 0, 0, 0, 0,
+	0, 544, 976, 0, 570, -2, 610, 994, 994, 0,
+	994, 994, 994, 994, 0, 0, 994, 994, 994, 994,
+	994, 994, 994, 994, 994, 994, 994, 994, 994, 994,
+	0, 994, 669, 0, 686, 695, 0, 695, 0, 0,
+	694, 0, 577, -2, 0, 0, 0, 743, 0, 980,
+	979, 971, 0, 972, 0, 984, 0, 226, 0, 255,
+	242, 58, 0, 368, 0, 365, 363, 0, 0, 68,
+	72, 0, 70, 71, 537, 0, 395, 0, 567, 0,
+	565, 612, 0, 0, 994, 994, 0, 0, 0, 0,
+	994, 994, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 680, 578, -2, 0, 586,
+	0, 0, 751, 0, 730, 744, 0, 0, 975, 0,
+	0, 252, 56, 60, 65, 355, 0, 367, 0, 61,
+	69, 536, 545, 546, 395, 606, 611, 613, 614, 0,
+	0, 617, 618, 619, 620, 0, 0, 623, 624, 625,
+	626, 627, 628, 629, 630, 631, 632, 649, 650, 651,
+	652, 653, 654, 633, 634, 635, 636, 637, 638, 646,
+	0, 0, 643, 0, 0, 0, 687, 689, 690, 691,
+	692, 693, 688, 0, 0, 0, 0, 679, 681, 723,
+	0, 579, 587, 0, 582, 0, 0, 0, 721, 729,
+	731, 732, 733, 0, 745, 746, 747, 749, 982, 985,
+	45, 0, 62, 0, 63, 538, 994, 0, 0, 542,
+	543, 547, 595, 0, 0, 601, 0, 607, 615, 616,
+	621, 622, 639, 0, 0, 641, 0, 0, 0, 537,
+	537, 537, 537, 0, 724, 581, 0, -2, 0, 590,
+	0, 722, 734, 0, 735, 0, 0, 0, 36, 0,
+	0, 59, 0, 370, 0, 540, 0, 572, 0, 0,
+	0, 0, 0, 604, 0, 647, 648, 640, 644, 645,
+	0, 674, 675, 676, 677, 0, 583, -2, 0, 0,
+	592, 736, 738, 739, 0, 0, 737, 35, 0, 46,
+	0, 48, 50, 51, 697, 57, 369, 0, 539, 541,
+	574, 0, 596, 0, 0, 0, 0, 0, 0, 0,
+	0, 678, 589, 591, 740, 742, 741, 37, 38, 0,
+	47, 0, 0, 371, 571, 0, 606, 597, 0, 599,
+	0, 0, 0, 0, 642, 0, 0, 49, 698, 0,
+	593, 598, 600, 0, 605, 603, 39, 40, 41, 0,
+	575, 573, 0, 602, 699, 594,
 }
 
 var yyTok1 = [...]int{
@@ -4053,8 +4452,8 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
 	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 421,
-	90, 89, 91, 3, 422, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 430,
+	90, 89, 91, 3, 431, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
@@ -4122,7 +4521,9 @@ var yyTok3 = [...]int{
 	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
 	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
 	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
-	57745, 420, 0,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4472,7 +4873,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:474
+//line mysql_sql.y:505
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4480,32 +4881,110 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:480
+//line mysql_sql.y:511
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:503
+//line mysql_sql.y:539
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:507
+//line mysql_sql.y:543
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 26:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:549
+		{
+			yyLOCAL = tree.NewCheckTable(yyDollar[4].tableNamesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 27:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:553
+		{
+			yyLOCAL = tree.NewCompactTable(yyDollar[4].tableNamesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 28:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:557
+		{
+			yyLOCAL = tree.NewRotateKey()
+		}
+		yyVAL.union = yyLOCAL
+	case 29:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:563
+		{
+			yyLOCAL = tree.NewKill(yyDollar[2].boolValUnion(), uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 30:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:568
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:572
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:576
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 33:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:582
+		{
+			yyLOCAL = &tree.BackupDatabase{
+				Name: tree.Identifier(yyDollar[3].str),
+				Path: yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 34:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:591
+		{
+			yyLOCAL = &tree.RestoreDatabase{
+				Name: tree.Identifier(yyDollar[3].str),
+				Path: yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 35:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:513
+//line mysql_sql.y:600
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -4520,42 +4999,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 36:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:528
+//line mysql_sql.y:615
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 37:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:532
+//line mysql_sql.y:619
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:538
+//line mysql_sql.y:625
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:542
+//line mysql_sql.y:629
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:548
+//line mysql_sql.y:635
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4563,10 +5042,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:555
+//line mysql_sql.y:642
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4574,58 +5053,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:564
+//line mysql_sql.y:651
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 43:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:568
+//line mysql_sql.y:655
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 44:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:572
+//line mysql_sql.y:659
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:577
+//line mysql_sql.y:664
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 46:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:581
+//line mysql_sql.y:668
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:585
+//line mysql_sql.y:672
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:591
+//line mysql_sql.y:678
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4635,10 +5114,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:600
+//line mysql_sql.y:687
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4648,42 +5127,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:611
+//line mysql_sql.y:698
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:615
+//line mysql_sql.y:702
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:621
+//line mysql_sql.y:708
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:625
+//line mysql_sql.y:712
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:631
+//line mysql_sql.y:718
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4706,10 +5185,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:655
+//line mysql_sql.y:742
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -4728,34 +5207,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:674
+//line mysql_sql.y:761
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:678
+//line mysql_sql.y:765
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 58:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:683
+//line mysql_sql.y:770
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:687
+//line mysql_sql.y:774
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -4763,42 +5242,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:695
+//line mysql_sql.y:782
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:699
+//line mysql_sql.y:786
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 62:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:704
+//line mysql_sql.y:791
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:708
+//line mysql_sql.y:795
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 64:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:713
+//line mysql_sql.y:800
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:717
+//line mysql_sql.y:804
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -4821,36 +5300,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:828
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:832
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:751
+//line mysql_sql.y:838
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:757
+//line mysql_sql.y:844
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -4866,13 +5345,14 @@ yydefault:
 			yyLOCAL = &tree.Fields{
 				Optionally: true,
 				EnclosedBy: b,
+				Enclosed:   true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:775
+//line mysql_sql.y:863
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4887,13 +5367,14 @@ yydefault:
 			}
 			yyLOCAL = &tree.Fields{
 				EnclosedBy: b,
+				Enclosed:   true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:792
+//line mysql_sql.y:881
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4908,53 +5389,54 @@ yydefault:
 			}
 			yyLOCAL = &tree.Fields{
 				EscapedBy: b,
+				Escaped:   true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 73:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:815
+//line mysql_sql.y:905
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:819
+//line mysql_sql.y:909
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:823
+//line mysql_sql.y:913
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 76:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:828
+//line mysql_sql.y:918
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:832
+//line mysql_sql.y:922
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 78:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:838
+//line mysql_sql.y:928
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -4965,10 +5447,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 79:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:848
+//line mysql_sql.y:938
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -4977,10 +5459,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 80:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:856
+//line mysql_sql.y:946
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -4990,26 +5472,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:866
+//line mysql_sql.y:956
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:870
+//line mysql_sql.y:960
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 83:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:880
+//line mysql_sql.y:970
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5020,10 +5502,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:890
+//line mysql_sql.y:980
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5032,30 +5514,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:900
+//line mysql_sql.y:990
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:906
+//line mysql_sql.y:996
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:912
+//line mysql_sql.y:1002
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5063,10 +5545,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:919
+//line mysql_sql.y:1009
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5075,10 +5557,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:927
+//line mysql_sql.y:1017
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5086,58 +5568,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 90:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:935
+//line mysql_sql.y:1025
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:939
+//line mysql_sql.y:1029
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:943
+//line mysql_sql.y:1033
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:947
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:953
+//line mysql_sql.y:1043
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:957
+//line mysql_sql.y:1047
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:963
+//line mysql_sql.y:1053
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5145,10 +5627,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 97:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:970
+//line mysql_sql.y:1060
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5156,418 +5638,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:979
+//line mysql_sql.y:1069
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:983
+//line mysql_sql.y:1073
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:989
+//line mysql_sql.y:1079
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:993
+//line mysql_sql.y:1083
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:997
+//line mysql_sql.y:1087
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1001
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1005
+//line mysql_sql.y:1095
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1009
+//line mysql_sql.y:1099
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1013
+//line mysql_sql.y:1103
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1111
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1119
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1123
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1127
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1131
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1143
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1147
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1151
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1155
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1159
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1163
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1167
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1171
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1175
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1179
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1183
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1187
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1191
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1195
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1199
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1203
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1207
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1129
+//line mysql_sql.y:1219
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 138:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1135
+//line mysql_sql.y:1225
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1143
+//line mysql_sql.y:1233
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1147
+//line mysql_sql.y:1237
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1151
+//line mysql_sql.y:1241
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1155
+//line mysql_sql.y:1245
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1159
+//line mysql_sql.y:1249
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1165
+//line mysql_sql.y:1255
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1169
+//line mysql_sql.y:1259
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1173
+//line mysql_sql.y:1263
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1179
+//line mysql_sql.y:1269
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1185
+//line mysql_sql.y:1275
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 149:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1189
+//line mysql_sql.y:1279
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1196
+//line mysql_sql.y:1286
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1202
+//line mysql_sql.y:1292
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1206
+//line mysql_sql.y:1296
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1212
+//line mysql_sql.y:1302
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5576,10 +6058,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1220
+//line mysql_sql.y:1310
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5589,10 +6071,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1229
+//line mysql_sql.y:1319
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5601,10 +6083,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1237
+//line mysql_sql.y:1327
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5613,10 +6095,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1245
+//line mysql_sql.y:1335
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5640,10 +6122,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1268
+//line mysql_sql.y:1358
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5651,10 +6133,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 160:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1275
+//line mysql_sql.y:1365
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5662,10 +6144,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 161:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1282
+//line mysql_sql.y:1372
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5674,10 +6156,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1290
+//line mysql_sql.y:1380
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5685,10 +6167,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1297
+//line mysql_sql.y:1387
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5696,10 +6178,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1304
+//line mysql_sql.y:1394
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5707,198 +6189,198 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1317
+//line mysql_sql.y:1407
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1321
+//line mysql_sql.y:1411
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1415
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1331
+//line mysql_sql.y:1421
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1335
+//line mysql_sql.y:1425
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1342
+//line mysql_sql.y:1432
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1353
+//line mysql_sql.y:1443
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1359
+//line mysql_sql.y:1449
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1364
+//line mysql_sql.y:1454
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1368
+//line mysql_sql.y:1458
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1372
+//line mysql_sql.y:1462
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1376
+//line mysql_sql.y:1466
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1380
+//line mysql_sql.y:1470
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 184:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1384
+//line mysql_sql.y:1474
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 185:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1388
+//line mysql_sql.y:1478
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1482
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1398
+//line mysql_sql.y:1488
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1402
+//line mysql_sql.y:1492
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1406
+//line mysql_sql.y:1496
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1410
+//line mysql_sql.y:1500
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1415
+//line mysql_sql.y:1505
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 192:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1420
+//line mysql_sql.y:1510
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1516
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1520
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 195:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1436
+//line mysql_sql.y:1526
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -5909,108 +6391,293 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1449
+//line mysql_sql.y:1539
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1453
+//line mysql_sql.y:1543
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1459
+//line mysql_sql.y:1549
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1468
+//line mysql_sql.y:1558
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1474
+//line mysql_sql.y:1564
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1479
+//line mysql_sql.y:1569
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1484
+//line mysql_sql.y:1574
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 206:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1488
+//line mysql_sql.y:1578
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1492
+//line mysql_sql.y:1582
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "row")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 208:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1586
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[5].statementUnion(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1500
+//line mysql_sql.y:1590
 		{
 			yyLOCAL = tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "")
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 213:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1511
+//line mysql_sql.y:1601
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 214:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1605
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 217:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1616
+		{
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), yyDollar[4].alterTableSpecsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 218:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1620
+		{
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), []tree.AlterTableSpec{yyDollar[4].alterTableSpecUnion()})
+		}
+		yyVAL.union = yyLOCAL
+	case 219:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AlterTableSpec
+//line mysql_sql.y:1626
+		{
+			yyLOCAL = []tree.AlterTableSpec{yyDollar[1].alterTableSpecUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 220:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AlterTableSpec
+//line mysql_sql.y:1630
+		{
+			yyLOCAL = append(yyDollar[1].alterTableSpecsUnion(), yyDollar[3].alterTableSpecUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 221:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1636
+		{
+			yyLOCAL = tree.NewAlterTableAddColumn(yyDollar[2].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 222:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1640
+		{
+			yyLOCAL = tree.NewAlterTableAddColumn(yyDollar[3].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1644
+		{
+			yyLOCAL = tree.NewAlterTableDropColumn(tree.Identifier(yyDollar[2].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 224:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1648
+		{
+			yyLOCAL = tree.NewAlterTableDropColumn(tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 225:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1652
+		{
+			yyLOCAL = tree.NewAlterTableRenameColumn(tree.Identifier(yyDollar[3].str), tree.Identifier(yyDollar[5].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 226:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1656
+		{
+			yyLOCAL = tree.NewAlterTableAlterColumn(tree.Identifier(yyDollar[3].str), yyDollar[6].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 227:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1660
+		{
+			yyLOCAL = tree.NewAlterTableAlterColumn(tree.Identifier(yyDollar[3].str), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 228:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1664
+		{
+			yyLOCAL = tree.NewAlterTableRename(*yyDollar[3].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 229:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1670
+		{
+			yyLOCAL = tree.NewAlterTableAddPartition(yyDollar[4].partitionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1674
+		{
+			yyLOCAL = tree.NewAlterTableDropPartition(yyDollar[3].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 231:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableSpec
+//line mysql_sql.y:1678
+		{
+			yyLOCAL = tree.NewAlterTableTruncatePartition(yyDollar[3].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 232:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1683
+		{
+		}
+	case 233:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1685
+		{
+		}
+	case 234:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1688
+		{
+		}
+	case 235:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1690
+		{
+		}
+	case 236:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1692
+		{
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1696
+		{
+			yyLOCAL = tree.NewRenameTable(yyDollar[3].renameTablePairsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 238:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.RenameTablePair
+//line mysql_sql.y:1702
+		{
+			yyLOCAL = []*tree.RenameTablePair{yyDollar[1].renameTablePairUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 239:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.RenameTablePair
+//line mysql_sql.y:1706
+		{
+			yyLOCAL = append(yyDollar[1].renameTablePairsUnion(), yyDollar[3].renameTablePairUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 240:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.RenameTablePair
+//line mysql_sql.y:1712
+		{
+			yyLOCAL = tree.NewRenameTablePair(*yyDollar[1].tableNameUnion(), *yyDollar[3].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 241:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1521
+//line mysql_sql.y:1718
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6022,10 +6689,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 242:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1532
+//line mysql_sql.y:1729
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6038,118 +6705,134 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 243:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1545
+//line mysql_sql.y:1742
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1549
+//line mysql_sql.y:1746
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1554
+//line mysql_sql.y:1751
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1558
+//line mysql_sql.y:1755
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1564
+//line mysql_sql.y:1761
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1568
+//line mysql_sql.y:1765
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1574
+//line mysql_sql.y:1771
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 250:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1578
+//line mysql_sql.y:1775
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1582
+//line mysql_sql.y:1779
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 252:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1586
+//line mysql_sql.y:1783
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1590
+//line mysql_sql.y:1787
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1594
+//line mysql_sql.y:1791
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1600
+//line mysql_sql.y:1797
 		{
 			yyVAL.item = nil
 		}
-	case 216:
+	case 256:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1605
+//line mysql_sql.y:1802
 		{
 			yyVAL.item = nil
 		}
-	case 228:
+	case 269:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1824
+		{
+			yyLOCAL = &tree.ShowGrants{}
+		}
+		yyVAL.union = yyLOCAL
+	case 270:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1828
+		{
+			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname}
+		}
+		yyVAL.union = yyLOCAL
+	case 271:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1626
+//line mysql_sql.y:1834
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6157,10 +6840,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 275:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1640
+//line mysql_sql.y:1848
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6169,10 +6852,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 276:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1650
+//line mysql_sql.y:1858
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6181,58 +6864,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 277:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1659
+//line mysql_sql.y:1867
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1663
+//line mysql_sql.y:1871
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1667
+//line mysql_sql.y:1875
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1673
+//line mysql_sql.y:1881
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1679
+//line mysql_sql.y:1887
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1685
+//line mysql_sql.y:1893
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 283:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1691
+//line mysql_sql.y:1899
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6243,10 +6926,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 284:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1701
+//line mysql_sql.y:1909
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6257,18 +6940,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1713
+//line mysql_sql.y:1921
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 286:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1719
+//line mysql_sql.y:1927
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6281,10 +6964,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 287:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1939
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6297,118 +6980,126 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 288:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1744
+//line mysql_sql.y:1952
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1748
+//line mysql_sql.y:1956
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 290:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1753
+//line mysql_sql.y:1961
 		{
 			yyVAL.str = ""
 		}
-	case 248:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1757
+//line mysql_sql.y:1965
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 249:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1763
+//line mysql_sql.y:1971
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 297:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1776
+//line mysql_sql.y:1984
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1780
+//line mysql_sql.y:1988
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 299:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1786
+//line mysql_sql.y:1994
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 300:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1998
+		{
+			yyLOCAL = tree.NewShowCreateView(yyDollar[4].unresolvedObjectNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 301:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1790
+//line mysql_sql.y:2002
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1796
+//line mysql_sql.y:2008
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1800
+//line mysql_sql.y:2012
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1809
+//line mysql_sql.y:2021
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1813
+//line mysql_sql.y:2025
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 307:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1817
+//line mysql_sql.y:2029
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1833
+//line mysql_sql.y:2046
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6416,10 +7107,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1842
+//line mysql_sql.y:2055
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6427,10 +7118,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 317:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1851
+//line mysql_sql.y:2064
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6439,26 +7130,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1861
+//line mysql_sql.y:2074
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:2080
+		{
+			yyLOCAL = tree.NewDropView(yyDollar[3].boolValUnion(), yyDollar[4].tableNamesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 320:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2086
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 321:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1873
+//line mysql_sql.y:2092
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6468,10 +7167,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1888
+//line mysql_sql.y:2107
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6479,10 +7178,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1897
+//line mysql_sql.y:2116
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6490,20 +7189,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1904
+//line mysql_sql.y:2123
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 325:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1910
+//line mysql_sql.y:2129
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6512,10 +7211,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 326:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1918
+//line mysql_sql.y:2137
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6523,10 +7222,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1925
+//line mysql_sql.y:2144
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6534,10 +7233,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1932
+//line mysql_sql.y:2151
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6556,34 +7255,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1951
+//line mysql_sql.y:2170
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1955
+//line mysql_sql.y:2174
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1959
+//line mysql_sql.y:2178
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:1965
+//line mysql_sql.y:2184
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6591,265 +7290,270 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1974
+//line mysql_sql.y:2193
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1978
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1984
+//line mysql_sql.y:2203
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 290:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1988
+//line mysql_sql.y:2207
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 291:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1994
+//line mysql_sql.y:2213
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1998
+//line mysql_sql.y:2217
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 339:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2004
+//line mysql_sql.y:2223
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2009
+//line mysql_sql.y:2228
 		{
 		}
-	case 296:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2013
+//line mysql_sql.y:2232
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2020
+//line mysql_sql.y:2239
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2024
+//line mysql_sql.y:2243
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2031
+//line mysql_sql.y:2250
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2036
+//line mysql_sql.y:2255
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 349:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2040
+//line mysql_sql.y:2259
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2046
+//line mysql_sql.y:2265
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2050
+//line mysql_sql.y:2269
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2056
+//line mysql_sql.y:2275
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2060
+//line mysql_sql.y:2279
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2065
+//line mysql_sql.y:2284
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 355:
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2069
+//line mysql_sql.y:2288
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
 				FilePath:    yyDollar[3].str,
-				Fields:      yyDollar[4].fieldsUnion(),
-				Lines:       yyDollar[5].linesUnion(),
-				Header:      yyDollar[6].unsignedOptUnion(),
-				MaxFileSize: uint64(yyDollar[7].int64ValUnion()) * 1024,
-				ForceQuote:  yyDollar[8].strsUnion(),
+				FileFormat:  yyDollar[4].str,
+				Compression: yyDollar[5].str,
+				Fields:      yyDollar[6].fieldsUnion(),
+				Lines:       yyDollar[7].linesUnion(),
+				Header:      yyDollar[8].unsignedOptUnion(),
+				MaxFileSize: uint64(yyDollar[9].int64ValUnion()) * 1024,
+				ForceQuote:  yyDollar[10].strsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2082
+//line mysql_sql.y:2303
 		{
-			yyLOCAL = &tree.Fields{
-				Terminated: ",",
-				EnclosedBy: '"',
-			}
+			yyVAL.str = tree.ExportFormatCsv
 		}
-		yyVAL.union = yyLOCAL
-	case 311:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2089
+	case 357:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2307
 		{
-			yyLOCAL = &tree.Fields{
-				Terminated: yyDollar[4].str,
-				EnclosedBy: '"',
+			str := strings.ToLower(yyDollar[2].str)
+			if str != tree.ExportFormatCsv && str != tree.ExportFormatParquet {
+				yylex.Error("error export format")
+				return 1
 			}
+			yyVAL.str = str
 		}
-		yyVAL.union = yyLOCAL
-	case 312:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 358:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2317
+		{
+			yyVAL.str = ""
+		}
+	case 359:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2321
+		{
+			yyVAL.str = strings.ToLower(yyDollar[2].str)
+		}
+	case 360:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2096
+//line mysql_sql.y:2326
 		{
-			str := yyDollar[7].str
-			if str != "\\" && len(str) > 1 {
-				yylex.Error("export1 error field terminator")
-				return 1
-			}
-			var b byte
-			if len(str) != 0 {
-				b = byte(str[0])
-			} else {
-				b = 0
-			}
 			yyLOCAL = &tree.Fields{
-				Terminated: yyDollar[4].str,
-				EnclosedBy: b,
+				Terminated: ",",
+				EnclosedBy: '"',
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 361:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2114
+//line mysql_sql.y:2333
 		{
-			str := yyDollar[4].str
-			if str != "\\" && len(str) > 1 {
-				yylex.Error("export2 error field terminator")
-				return 1
-			}
-			var b byte
-			if len(str) != 0 {
-				b = byte(str[0])
-			} else {
-				b = 0
-			}
-			yyLOCAL = &tree.Fields{
+			res := &tree.Fields{
 				Terminated: ",",
-				EnclosedBy: b,
+				EnclosedBy: '"',
 			}
+			for _, f := range yyDollar[2].fieldsListUnion() {
+				if f.Terminated != "" {
+					res.Terminated = f.Terminated
+				}
+				if f.Optionally {
+					res.Optionally = f.Optionally
+				}
+				if f.Enclosed {
+					res.Enclosed = true
+					res.EnclosedBy = f.EnclosedBy
+				}
+				if f.Escaped {
+					res.Escaped = true
+					res.EscapedBy = f.EscapedBy
+				}
+			}
+			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 362:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2133
+//line mysql_sql.y:2358
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 363:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2139
+//line mysql_sql.y:2364
 		{
 			yyLOCAL = &tree.Lines{
-				TerminatedBy: yyDollar[2].str,
+				StartingBy:   yyDollar[2].str,
+				TerminatedBy: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 364:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2146
+//line mysql_sql.y:2372
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2150
+//line mysql_sql.y:2376
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -6862,227 +7566,288 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 366:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2163
+//line mysql_sql.y:2389
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2167
+//line mysql_sql.y:2393
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 368:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2172
+//line mysql_sql.y:2398
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 369:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2176
+//line mysql_sql.y:2402
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2183
+//line mysql_sql.y:2409
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2188
+//line mysql_sql.y:2414
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2195
+//line mysql_sql.y:2421
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), Ep: yyDollar[2].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 374:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Select
+//line mysql_sql.y:2425
+		{
+			yyDollar[2].selectUnion().With = yyDollar[1].withUnion()
+			yyLOCAL = yyDollar[2].selectUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.With
+//line mysql_sql.y:2432
+		{
+			yyLOCAL = &tree.With{Ctes: yyDollar[2].ctesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 376:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.CTE
+//line mysql_sql.y:2438
+		{
+			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 377:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.CTE
+//line mysql_sql.y:2442
+		{
+			yyLOCAL = append(yyDollar[1].ctesUnion(), yyDollar[3].cteUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 378:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.CTE
+//line mysql_sql.y:2448
+		{
+			yyLOCAL = &tree.CTE{
+				Name: tree.Identifier(yyDollar[1].str),
+				Cols: yyDollar[2].identifierListUnion(),
+				Stmt: yyDollar[5].selectUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 379:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:2457
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 380:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:2461
+		{
+			yyLOCAL = yyDollar[2].identifierListUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 381:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2201
+//line mysql_sql.y:2467
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2205
+//line mysql_sql.y:2471
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2209
+//line mysql_sql.y:2475
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2214
+//line mysql_sql.y:2480
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2218
+//line mysql_sql.y:2484
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2224
+//line mysql_sql.y:2490
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2228
+//line mysql_sql.y:2494
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2232
+//line mysql_sql.y:2498
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 389:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2237
+//line mysql_sql.y:2503
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2241
+//line mysql_sql.y:2507
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2247
+//line mysql_sql.y:2513
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2253
+//line mysql_sql.y:2519
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2257
+//line mysql_sql.y:2523
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2263
+//line mysql_sql.y:2529
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 395:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2268
+//line mysql_sql.y:2534
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2272
+//line mysql_sql.y:2538
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2276
+//line mysql_sql.y:2542
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2283
+//line mysql_sql.y:2549
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2287
+//line mysql_sql.y:2553
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2293
+//line mysql_sql.y:2559
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2297
+//line mysql_sql.y:2563
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7093,10 +7858,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2307
+//line mysql_sql.y:2573
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7107,10 +7872,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2317
+//line mysql_sql.y:2583
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7121,10 +7886,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2327
+//line mysql_sql.y:2593
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7135,10 +7900,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2339
+//line mysql_sql.y:2605
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7147,10 +7912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2347
+//line mysql_sql.y:2613
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7159,10 +7924,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2355
+//line mysql_sql.y:2621
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7171,10 +7936,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 408:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2365
+//line mysql_sql.y:2631
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7186,130 +7951,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 409:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2377
+//line mysql_sql.y:2643
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 410:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2381
+//line mysql_sql.y:2647
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2385
+//line mysql_sql.y:2651
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 414:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2394
+//line mysql_sql.y:2660
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2398
+//line mysql_sql.y:2664
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 416:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2403
+//line mysql_sql.y:2669
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2407
+//line mysql_sql.y:2673
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 418:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2412
+//line mysql_sql.y:2678
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2416
+//line mysql_sql.y:2682
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2422
+//line mysql_sql.y:2688
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2426
+//line mysql_sql.y:2692
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2432
+//line mysql_sql.y:2698
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2436
+//line mysql_sql.y:2702
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2440
+//line mysql_sql.y:2706
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 425:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2444
+//line mysql_sql.y:2710
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 426:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2450
+//line mysql_sql.y:2716
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7318,44 +8083,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2458
+//line mysql_sql.y:2724
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2464
+//line mysql_sql.y:2730
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2472
+//line mysql_sql.y:2738
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2476
+//line mysql_sql.y:2742
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 433:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2486
+//line mysql_sql.y:2752
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7365,10 +8130,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2495
+//line mysql_sql.y:2761
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7378,10 +8143,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 435:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2505
+//line mysql_sql.y:2771
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7391,10 +8156,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2514
+//line mysql_sql.y:2780
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7403,15 +8168,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2524
+//line mysql_sql.y:2790
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 383:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2528
+//line mysql_sql.y:2794
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7419,130 +8184,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 384:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2538
+//line mysql_sql.y:2804
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 385:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2542
+//line mysql_sql.y:2808
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 386:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2546
+//line mysql_sql.y:2812
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 387:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2550
+//line mysql_sql.y:2816
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 388:
+	case 443:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2556
+//line mysql_sql.y:2822
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 444:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2560
+//line mysql_sql.y:2826
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2566
+//line mysql_sql.y:2832
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 391:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2572
+//line mysql_sql.y:2838
 		{
 			yyVAL.str = ""
 		}
-	case 392:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2576
+//line mysql_sql.y:2842
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 393:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2580
+//line mysql_sql.y:2846
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 394:
+	case 449:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2586
+//line mysql_sql.y:2852
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2590
+//line mysql_sql.y:2856
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2596
+//line mysql_sql.y:2862
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2600
+//line mysql_sql.y:2866
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2606
+//line mysql_sql.y:2872
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2610
+//line mysql_sql.y:2876
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2616
+//line mysql_sql.y:2882
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2620
+//line mysql_sql.y:2886
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -7552,28 +8317,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2898
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 458:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2637
+//line mysql_sql.y:2903
 		{
 		}
-	case 404:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2638
+//line mysql_sql.y:2904
 		{
 		}
-	case 405:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2908
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -7583,46 +8348,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 461:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2654
+//line mysql_sql.y:2920
 		{
 			yyVAL.str = ""
 		}
-	case 407:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2658
+//line mysql_sql.y:2924
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 408:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2662
+//line mysql_sql.y:2928
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 411:
+	case 466:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2671
+//line mysql_sql.y:2937
 		{
 			yyVAL.str = ""
 		}
-	case 412:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2941
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 413:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2945
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 427:
+	case 483:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2975
+		{
+			yyLOCAL = tree.NewCreateView(yyDollar[2].boolValUnion(), yyDollar[4].tableNameUnion(), yyDollar[5].identifierListUnion(), yyDollar[7].selectUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 484:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2980
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 485:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2984
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 486:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2708
+//line mysql_sql.y:2990
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7632,189 +8421,205 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 487:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2718
+//line mysql_sql.y:3000
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2722
+//line mysql_sql.y:3004
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2728
+//line mysql_sql.y:3010
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2732
+//line mysql_sql.y:3014
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2738
+//line mysql_sql.y:3020
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2742
+//line mysql_sql.y:3024
+		{
+			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 493:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ResourceOption
+//line mysql_sql.y:3028
+		{
+			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 494:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ResourceOption
+//line mysql_sql.y:3032
 		{
-			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
+			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2746
+//line mysql_sql.y:3036
 		{
-			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
+			yyLOCAL = &tree.ResourceOptionMaxExecutionTime{Millis: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2750
+//line mysql_sql.y:3040
 		{
-			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
+			yyLOCAL = &tree.ResourceOptionQueryMemoryLimit{Size: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2756
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2763
+//line mysql_sql.y:3053
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2768
+//line mysql_sql.y:3058
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2773
+//line mysql_sql.y:3063
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2778
+//line mysql_sql.y:3068
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2784
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2788
+//line mysql_sql.y:3078
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2792
+//line mysql_sql.y:3082
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 506:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2798
+//line mysql_sql.y:3088
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2802
+//line mysql_sql.y:3092
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 508:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2806
+//line mysql_sql.y:3096
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2810
+//line mysql_sql.y:3100
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2816
+//line mysql_sql.y:3106
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2820
+//line mysql_sql.y:3110
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2826
+//line mysql_sql.y:3116
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -7826,42 +8631,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2839
+//line mysql_sql.y:3129
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2843
+//line mysql_sql.y:3133
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2847
+//line mysql_sql.y:3137
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2852
+//line mysql_sql.y:3142
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2856
+//line mysql_sql.y:3146
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -7869,20 +8674,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2863
+//line mysql_sql.y:3153
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 519:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2869
+//line mysql_sql.y:3159
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7891,10 +8696,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 520:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2877
+//line mysql_sql.y:3167
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7902,20 +8707,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 521:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2884
+//line mysql_sql.y:3174
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 524:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2896
+//line mysql_sql.y:3186
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7923,82 +8728,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2905
+//line mysql_sql.y:3195
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2909
+//line mysql_sql.y:3199
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2915
+//line mysql_sql.y:3205
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2919
+//line mysql_sql.y:3209
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2923
+//line mysql_sql.y:3213
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2932
+//line mysql_sql.y:3222
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2936
+//line mysql_sql.y:3226
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2940
+//line mysql_sql.y:3230
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2944
+//line mysql_sql.y:3234
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 536:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2950
+//line mysql_sql.y:3240
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8017,18 +8822,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 537:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2969
+//line mysql_sql.y:3259
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2973
+//line mysql_sql.y:3263
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8049,123 +8854,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2995
+//line mysql_sql.y:3285
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2999
+//line mysql_sql.y:3289
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3003
+//line mysql_sql.y:3293
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3007
+//line mysql_sql.y:3297
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3011
+//line mysql_sql.y:3301
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3017
+//line mysql_sql.y:3307
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3021
+//line mysql_sql.y:3311
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3027
+//line mysql_sql.y:3317
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3032
+//line mysql_sql.y:3322
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 548:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3037
+//line mysql_sql.y:3327
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3041
+//line mysql_sql.y:3331
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3045
+//line mysql_sql.y:3335
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3049
+//line mysql_sql.y:3339
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3053
+//line mysql_sql.y:3343
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 553:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3059
+//line mysql_sql.y:3349
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8174,98 +8979,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3073
+//line mysql_sql.y:3363
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3077
+//line mysql_sql.y:3367
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3082
+//line mysql_sql.y:3372
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3086
+//line mysql_sql.y:3376
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3092
+//line mysql_sql.y:3382
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3096
+//line mysql_sql.y:3386
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3102
+//line mysql_sql.y:3392
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 563:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3106
+//line mysql_sql.y:3396
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 564:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3110
+//line mysql_sql.y:3400
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 565:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3115
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3119
+//line mysql_sql.y:3409
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 567:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3125
+//line mysql_sql.y:3415
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8277,34 +9082,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3137
+//line mysql_sql.y:3427
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3141
+//line mysql_sql.y:3431
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 570:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3146
+//line mysql_sql.y:3436
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 571:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3150
+//line mysql_sql.y:3440
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8314,18 +9119,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3160
+//line mysql_sql.y:3450
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3164
+//line mysql_sql.y:3454
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8334,42 +9139,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3173
+//line mysql_sql.y:3463
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3177
+//line mysql_sql.y:3467
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3183
+//line mysql_sql.y:3473
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3187
+//line mysql_sql.y:3477
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 578:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3193
+//line mysql_sql.y:3483
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8379,10 +9184,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 579:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3202
+//line mysql_sql.y:3492
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8392,42 +9197,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 580:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3212
+//line mysql_sql.y:3502
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3216
+//line mysql_sql.y:3506
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3222
+//line mysql_sql.y:3512
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3226
+//line mysql_sql.y:3516
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3232
+//line mysql_sql.y:3522
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8435,10 +9240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3239
+//line mysql_sql.y:3529
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8446,50 +9251,78 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3248
+//line mysql_sql.y:3538
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3252
+//line mysql_sql.y:3542
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3257
+//line mysql_sql.y:3547
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 589:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3261
+//line mysql_sql.y:3551
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 590:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3555
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 591:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3559
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 592:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3563
+		{
+			vl := make([]tree.Exprs, len(yyDollar[4].exprsUnion()))
+			for i, e := range yyDollar[4].exprsUnion() {
+				vl[i] = tree.Exprs{e}
+			}
+			yyLOCAL = &tree.ValuesIn{ValueList: vl}
+		}
+		yyVAL.union = yyLOCAL
+	case 593:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3266
+//line mysql_sql.y:3572
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3270
+//line mysql_sql.y:3576
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8499,18 +9332,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3280
+//line mysql_sql.y:3586
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 596:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3284
+//line mysql_sql.y:3590
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8520,10 +9353,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3295
+//line mysql_sql.y:3601
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8532,10 +9365,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 598:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3303
+//line mysql_sql.y:3609
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8544,10 +9377,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3311
+//line mysql_sql.y:3617
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8556,10 +9389,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 600:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3319
+//line mysql_sql.y:3625
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8568,10 +9401,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 602:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3330
+//line mysql_sql.y:3636
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -8582,10 +9415,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 603:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3340
+//line mysql_sql.y:3646
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -8595,478 +9428,495 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 604:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3350
+//line mysql_sql.y:3656
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3354
+//line mysql_sql.y:3660
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 606:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3359
+//line mysql_sql.y:3665
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3363
+//line mysql_sql.y:3669
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3368
+//line mysql_sql.y:3674
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3372
+//line mysql_sql.y:3678
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3378
+//line mysql_sql.y:3684
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3382
+//line mysql_sql.y:3688
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3386
+//line mysql_sql.y:3692
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3392
+//line mysql_sql.y:3698
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3396
+//line mysql_sql.y:3702
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 615:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3400
+//line mysql_sql.y:3706
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3404
+//line mysql_sql.y:3710
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3408
+//line mysql_sql.y:3714
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3412
+//line mysql_sql.y:3718
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3416
+//line mysql_sql.y:3722
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3420
+//line mysql_sql.y:3726
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 621:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3424
+//line mysql_sql.y:3730
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3428
+//line mysql_sql.y:3734
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3432
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3436
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3440
+//line mysql_sql.y:3746
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3444
+//line mysql_sql.y:3750
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3448
+//line mysql_sql.y:3754
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3452
+//line mysql_sql.y:3758
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3456
+//line mysql_sql.y:3762
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3460
+//line mysql_sql.y:3766
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3464
+//line mysql_sql.y:3770
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3468
+//line mysql_sql.y:3774
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3472
+//line mysql_sql.y:3778
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3476
+//line mysql_sql.y:3782
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3480
+//line mysql_sql.y:3786
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3484
+//line mysql_sql.y:3790
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3488
+//line mysql_sql.y:3794
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3492
+//line mysql_sql.y:3798
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 639:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3496
+//line mysql_sql.y:3802
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 640:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3500
+//line mysql_sql.y:3806
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 641:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3504
+//line mysql_sql.y:3810
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 642:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line mysql_sql.y:3814
+		{
+			yyLOCAL = tree.NewTableOptionTTL(yyDollar[3].str, uint64(yyDollar[6].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3511
+//line mysql_sql.y:3821
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3515
+//line mysql_sql.y:3825
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3521
+//line mysql_sql.y:3831
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 646:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3526
+//line mysql_sql.y:3836
 		{
 			yyVAL.str = ""
 		}
-	case 582:
+	case 647:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3530
+//line mysql_sql.y:3840
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 583:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3534
+//line mysql_sql.y:3844
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 584:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3540
+//line mysql_sql.y:3850
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3544
+//line mysql_sql.y:3854
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3548
+//line mysql_sql.y:3858
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3552
+//line mysql_sql.y:3862
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3556
+//line mysql_sql.y:3866
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3560
+//line mysql_sql.y:3870
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3576
+//line mysql_sql.y:3886
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3580
+//line mysql_sql.y:3890
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3589
+//line mysql_sql.y:3899
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3594
+//line mysql_sql.y:3904
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 665:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line mysql_sql.y:3909
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 666:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3600
+//line mysql_sql.y:3915
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3607
+//line mysql_sql.y:3922
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3611
+//line mysql_sql.y:3926
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3617
+//line mysql_sql.y:3932
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3621
+//line mysql_sql.y:3936
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3627
+//line mysql_sql.y:3942
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9077,18 +9927,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3637
+//line mysql_sql.y:3952
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 674:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3643
+//line mysql_sql.y:3958
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9098,10 +9948,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 675:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3652
+//line mysql_sql.y:3967
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9111,10 +9961,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 676:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3661
+//line mysql_sql.y:3976
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9138,10 +9988,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 677:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3684
+//line mysql_sql.y:3999
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9151,10 +10001,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 678:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3693
+//line mysql_sql.y:4008
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9165,10 +10015,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 679:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3703
+//line mysql_sql.y:4018
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9176,298 +10026,298 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 680:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3711
+//line mysql_sql.y:4026
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 682:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3717
+//line mysql_sql.y:4032
 		{
 			yyVAL.str = ""
 		}
-	case 617:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3721
+//line mysql_sql.y:4036
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 620:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3731
+//line mysql_sql.y:4046
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3737
+//line mysql_sql.y:4052
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3743
+//line mysql_sql.y:4058
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 694:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3757
+//line mysql_sql.y:4072
 		{
 			yyVAL.str = ""
 		}
-	case 630:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3764
+//line mysql_sql.y:4079
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3770
+//line mysql_sql.y:4085
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3774
+//line mysql_sql.y:4089
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 699:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3778
+//line mysql_sql.y:4093
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3789
+//line mysql_sql.y:4104
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3793
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 705:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3797
+//line mysql_sql.y:4112
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 706:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3802
+//line mysql_sql.y:4117
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3806
+//line mysql_sql.y:4121
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3812
+//line mysql_sql.y:4127
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3816
+//line mysql_sql.y:4131
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3822
+//line mysql_sql.y:4137
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3826
+//line mysql_sql.y:4141
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 712:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3830
+//line mysql_sql.y:4145
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3834
+//line mysql_sql.y:4149
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3838
+//line mysql_sql.y:4153
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3842
+//line mysql_sql.y:4157
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3846
+//line mysql_sql.y:4161
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3850
+//line mysql_sql.y:4165
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3854
+//line mysql_sql.y:4169
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3858
+//line mysql_sql.y:4173
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3862
+//line mysql_sql.y:4177
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 721:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3866
+//line mysql_sql.y:4181
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 722:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3870
+//line mysql_sql.y:4185
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3880
+//line mysql_sql.y:4195
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3884
+//line mysql_sql.y:4199
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 725:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3889
+//line mysql_sql.y:4204
 		{
 			yyVAL.str = ""
 		}
-	case 660:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3893
+//line mysql_sql.y:4208
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 661:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3899
+//line mysql_sql.y:4214
 		{
 			yyVAL.str = ""
 		}
-	case 662:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3903
+//line mysql_sql.y:4218
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 663:
+	case 729:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:3909
+//line mysql_sql.y:4224
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9478,10 +10328,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 730:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3920
+//line mysql_sql.y:4235
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9489,10 +10339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3930
+//line mysql_sql.y:4245
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9500,10 +10350,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3937
+//line mysql_sql.y:4252
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9511,10 +10361,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 734:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3944
+//line mysql_sql.y:4259
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9522,10 +10372,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 735:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3951
+//line mysql_sql.y:4266
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -9533,355 +10383,355 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3960
+//line mysql_sql.y:4275
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3966
+//line mysql_sql.y:4281
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3972
+//line mysql_sql.y:4287
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3976
+//line mysql_sql.y:4291
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3980
+//line mysql_sql.y:4295
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3984
+//line mysql_sql.y:4299
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 742:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3988
+//line mysql_sql.y:4303
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 743:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:3993
+//line mysql_sql.y:4308
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4000
+//line mysql_sql.y:4315
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4004
+//line mysql_sql.y:4319
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4008
+//line mysql_sql.y:4323
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 748:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4013
+//line mysql_sql.y:4328
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4017
+//line mysql_sql.y:4332
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 750:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4022
+//line mysql_sql.y:4337
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4026
+//line mysql_sql.y:4341
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4042
+//line mysql_sql.y:4357
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4048
+//line mysql_sql.y:4363
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4052
+//line mysql_sql.y:4367
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4056
+//line mysql_sql.y:4371
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4060
+//line mysql_sql.y:4375
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4064
+//line mysql_sql.y:4379
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4068
+//line mysql_sql.y:4383
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4072
+//line mysql_sql.y:4387
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4076
+//line mysql_sql.y:4391
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4080
+//line mysql_sql.y:4395
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4084
+//line mysql_sql.y:4399
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4088
+//line mysql_sql.y:4403
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4092
+//line mysql_sql.y:4407
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4096
+//line mysql_sql.y:4411
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4102
+//line mysql_sql.y:4417
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4106
+//line mysql_sql.y:4421
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4110
+//line mysql_sql.y:4425
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4114
+//line mysql_sql.y:4429
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 776:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4118
+//line mysql_sql.y:4433
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4122
+//line mysql_sql.y:4437
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4126
+//line mysql_sql.y:4441
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4130
+//line mysql_sql.y:4445
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4134
+//line mysql_sql.y:4449
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4138
+//line mysql_sql.y:4453
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4142
+//line mysql_sql.y:4457
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4146
+//line mysql_sql.y:4461
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 784:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4151
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 785:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4155
+//line mysql_sql.y:4470
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 786:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4159
+//line mysql_sql.y:4474
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -9891,42 +10741,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4168
+//line mysql_sql.y:4483
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4172
+//line mysql_sql.y:4487
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4176
+//line mysql_sql.y:4491
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4180
+//line mysql_sql.y:4495
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 792:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4187
+//line mysql_sql.y:4502
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9940,10 +10790,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4200
+//line mysql_sql.y:4515
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9957,10 +10807,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 794:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4213
+//line mysql_sql.y:4528
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9973,10 +10823,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4225
+//line mysql_sql.y:4540
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9991,10 +10841,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4239
+//line mysql_sql.y:4554
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10010,10 +10860,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4254
+//line mysql_sql.y:4569
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10029,10 +10879,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4269
+//line mysql_sql.y:4584
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10046,10 +10896,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4282
+//line mysql_sql.y:4597
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10064,15 +10914,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 800:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4297
+//line mysql_sql.y:4612
 		{
 		}
-	case 737:
+	case 803:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4618
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10082,10 +10932,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 804:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4312
+//line mysql_sql.y:4627
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10094,10 +10944,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 805:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4320
+//line mysql_sql.y:4635
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10106,10 +10956,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 806:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4328
+//line mysql_sql.y:4643
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10119,10 +10969,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 807:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4337
+//line mysql_sql.y:4652
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10132,10 +10982,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 808:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4346
+//line mysql_sql.y:4661
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10145,10 +10995,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 809:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4355
+//line mysql_sql.y:4670
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10158,10 +11008,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 810:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4364
+//line mysql_sql.y:4679
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10171,10 +11021,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 811:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4373
+//line mysql_sql.y:4688
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10184,10 +11034,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 812:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4382
+//line mysql_sql.y:4697
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10197,10 +11047,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 813:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4391
+//line mysql_sql.y:4706
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10210,10 +11060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 814:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4400
+//line mysql_sql.y:4715
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10223,10 +11073,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 815:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4409
+//line mysql_sql.y:4724
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10236,10 +11086,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 816:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4418
+//line mysql_sql.y:4733
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10249,10 +11099,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 817:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4427
+//line mysql_sql.y:4742
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10262,10 +11112,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 821:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4443
+//line mysql_sql.y:4758
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10274,10 +11124,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 822:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4461
+//line mysql_sql.y:4776
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10290,10 +11140,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 823:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4473
+//line mysql_sql.y:4788
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10306,10 +11156,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4487
+//line mysql_sql.y:4802
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10318,10 +11168,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4495
+//line mysql_sql.y:4810
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10329,10 +11179,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4502
+//line mysql_sql.y:4817
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10345,10 +11195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 827:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4514
+//line mysql_sql.y:4829
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10357,10 +11207,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 828:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4522
+//line mysql_sql.y:4837
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10372,10 +11222,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 829:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4533
+//line mysql_sql.y:4848
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -10385,10 +11235,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4542
+//line mysql_sql.y:4857
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -10398,10 +11248,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4551
+//line mysql_sql.y:4866
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -10411,10 +11261,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 832:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4560
+//line mysql_sql.y:4875
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -10423,10 +11273,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 833:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4568
+//line mysql_sql.y:4883
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -10437,10 +11287,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 834:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4578
+//line mysql_sql.y:4893
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -10449,10 +11299,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 835:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4586
+//line mysql_sql.y:4901
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -10461,52 +11311,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 836:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4595
+//line mysql_sql.y:4910
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4599
+//line mysql_sql.y:4914
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4605
+//line mysql_sql.y:4920
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 839:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4609
+//line mysql_sql.y:4924
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 846:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4622
+//line mysql_sql.y:4937
 		{
 		}
-	case 781:
+	case 847:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4624
+//line mysql_sql.y:4939
 		{
 		}
-	case 814:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4664
+//line mysql_sql.y:4979
 		{
 			yyLOCAL = &tree.IntervalExpr{
 				Expr: yyDollar[2].exprUnion(),
@@ -10514,322 +11364,322 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 881:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4672
+//line mysql_sql.y:4987
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4676
+//line mysql_sql.y:4991
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 883:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4680
+//line mysql_sql.y:4995
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IntervalType
-//line mysql_sql.y:4686
+//line mysql_sql.y:5001
 		{
 			yyLOCAL = tree.INTERVAL_TYPE_SECOND
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 885:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4692
+//line mysql_sql.y:5007
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 886:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4697
+//line mysql_sql.y:5012
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4701
+//line mysql_sql.y:5016
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4707
+//line mysql_sql.y:5022
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4711
+//line mysql_sql.y:5026
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 890:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4718
+//line mysql_sql.y:5033
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 891:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4722
+//line mysql_sql.y:5037
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 892:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4726
+//line mysql_sql.y:5041
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 893:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4730
+//line mysql_sql.y:5045
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4734
+//line mysql_sql.y:5049
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4740
+//line mysql_sql.y:5055
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 896:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4744
+//line mysql_sql.y:5059
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4748
+//line mysql_sql.y:5063
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 899:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4755
+//line mysql_sql.y:5070
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 900:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4759
+//line mysql_sql.y:5074
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 901:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4763
+//line mysql_sql.y:5078
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 902:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4767
+//line mysql_sql.y:5082
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 903:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4771
+//line mysql_sql.y:5086
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 904:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4775
+//line mysql_sql.y:5090
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 905:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4779
+//line mysql_sql.y:5094
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 906:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4783
+//line mysql_sql.y:5098
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 908:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4789
+//line mysql_sql.y:5104
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 909:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4793
+//line mysql_sql.y:5108
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4799
+//line mysql_sql.y:5114
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4803
+//line mysql_sql.y:5118
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4810
+//line mysql_sql.y:5125
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4814
+//line mysql_sql.y:5129
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4818
+//line mysql_sql.y:5133
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4822
+//line mysql_sql.y:5137
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 916:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4826
+//line mysql_sql.y:5141
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 917:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4830
+//line mysql_sql.y:5145
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 918:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4837
+//line mysql_sql.y:5152
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4841
+//line mysql_sql.y:5156
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4845
+//line mysql_sql.y:5160
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4849
+//line mysql_sql.y:5164
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:4855
+//line mysql_sql.y:5170
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -10843,96 +11693,96 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 923:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4870
+//line mysql_sql.y:5185
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4874
+//line mysql_sql.y:5189
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4879
+//line mysql_sql.y:5194
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4884
+//line mysql_sql.y:5199
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 927:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4888
+//line mysql_sql.y:5203
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4892
+//line mysql_sql.y:5207
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4896
+//line mysql_sql.y:5211
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4906
+//line mysql_sql.y:5221
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4917
+//line mysql_sql.y:5232
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4922
+//line mysql_sql.y:5237
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4928
+//line mysql_sql.y:5243
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10945,10 +11795,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 937:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4940
+//line mysql_sql.y:5255
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10961,10 +11811,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4952
+//line mysql_sql.y:5267
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10977,10 +11827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4964
+//line mysql_sql.y:5279
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10994,10 +11844,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4977
+//line mysql_sql.y:5292
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11011,10 +11861,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4990
+//line mysql_sql.y:5305
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11028,10 +11878,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5003
+//line mysql_sql.y:5318
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11045,10 +11895,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5016
+//line mysql_sql.y:5331
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11062,10 +11912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5029
+//line mysql_sql.y:5344
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11079,10 +11929,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5042
+//line mysql_sql.y:5357
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11096,10 +11946,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5055
+//line mysql_sql.y:5370
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11113,10 +11963,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5068
+//line mysql_sql.y:5383
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11130,10 +11980,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5081
+//line mysql_sql.y:5396
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11147,10 +11997,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5094
+//line mysql_sql.y:5409
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11164,10 +12014,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 950:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5109
+//line mysql_sql.y:5424
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11191,10 +12041,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 951:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5132
+//line mysql_sql.y:5447
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11231,10 +12081,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 952:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5180
+//line mysql_sql.y:5495
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11250,10 +12100,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5197
+//line mysql_sql.y:5512
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11266,10 +12116,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 954:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5209
+//line mysql_sql.y:5524
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11285,10 +12135,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5224
+//line mysql_sql.y:5539
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11304,10 +12154,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5239
+//line mysql_sql.y:5554
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11323,10 +12173,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5254
+//line mysql_sql.y:5569
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11341,10 +12191,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 958:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5270
+//line mysql_sql.y:5585
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11358,10 +12208,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 959:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5283
+//line mysql_sql.y:5598
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11375,10 +12225,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 960:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5296
+//line mysql_sql.y:5611
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11392,10 +12242,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 961:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5309
+//line mysql_sql.y:5624
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11409,10 +12259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5322
+//line mysql_sql.y:5637
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11425,10 +12275,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5334
+//line mysql_sql.y:5649
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11441,10 +12291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5346
+//line mysql_sql.y:5661
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11457,10 +12307,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5358
+//line mysql_sql.y:5673
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11473,10 +12323,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5370
+//line mysql_sql.y:5685
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11489,10 +12339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5382
+//line mysql_sql.y:5697
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11505,10 +12355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5394
+//line mysql_sql.y:5709
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11521,10 +12371,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5406
+//line mysql_sql.y:5721
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11537,10 +12387,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5418
+//line mysql_sql.y:5733
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11553,10 +12403,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 971:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5430
+//line mysql_sql.y:5745
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11570,10 +12420,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 972:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5443
+//line mysql_sql.y:5758
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11587,10 +12437,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5458
+//line mysql_sql.y:5773
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11603,59 +12453,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5481
+//line mysql_sql.y:5796
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 975:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5486
+//line mysql_sql.y:5801
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 976:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5492
+//line mysql_sql.y:5807
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 978:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5498
+//line mysql_sql.y:5813
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 979:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5502
+//line mysql_sql.y:5817
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 980:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5508
+//line mysql_sql.y:5823
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5514
+//line mysql_sql.y:5829
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -11663,10 +12513,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 982:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5521
+//line mysql_sql.y:5836
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11674,10 +12524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 983:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5530
+//line mysql_sql.y:5845
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -11685,10 +12535,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 984:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5537
+//line mysql_sql.y:5852
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11696,10 +12546,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 985:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5544
+//line mysql_sql.y:5859
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11707,52 +12557,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 986:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5553
+//line mysql_sql.y:5868
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5557
+//line mysql_sql.y:5872
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5561
+//line mysql_sql.y:5876
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 989:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5567
+//line mysql_sql.y:5882
 		{
 		}
-	case 924:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5569
+//line mysql_sql.y:5884
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 994:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5579
+//line mysql_sql.y:5894
 		{
 			yyVAL.str = ""
 		}
-	case 929:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5583
+//line mysql_sql.y:5898
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
@@ -118,6 +118,14 @@ import (
     subPartitions []*tree.SubPartition
 
     subquery *tree.Subquery
+
+    alterTableSpec tree.AlterTableSpec
+    alterTableSpecs []tree.AlterTableSpec
+    renameTablePair *tree.RenameTablePair
+    renameTablePairs []*tree.RenameTablePair
+    with *tree.With
+    cte *tree.CTE
+    ctes []*tree.CTE
     intervalType tree.IntervalType
     funcExpr *tree.FuncExpr
 
@@ -243,6 +251,7 @@ import (
 // User
 %token <str> USER IDENTIFIED CIPHER ISSUER X509 SUBJECT SAN REQUIRE SSL NONE PASSWORD
 %token <str> MAX_QUERIES_PER_HOUR MAX_UPDATES_PER_HOUR MAX_CONNECTIONS_PER_HOUR MAX_USER_CONNECTIONS
+%token <str> MAX_EXECUTION_TIME QUERY_MEMORY_LIMIT
 
 // Explain
 %token <str> FORMAT CONNECTION
@@ -251,7 +260,7 @@ import (
 %token <str> LOAD INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES
 
 // Supported SHOW tokens
-%token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
+%token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES GRANTS
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
@@ -278,9 +287,22 @@ import (
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
 
+// Backup
+%token <str> BACKUP RESTORE
+
+// Kill
+%token <str> KILL
+
+// Admin
+%token <str> ADMIN ROTATE
+
+// TTL
+%token <str> TTL
+
 %token <str> UNUSED
 
 %type <statement> stmt
+%type <statement> backup_stmt restore_stmt kill_stmt admin_stmt
 %type <statements> stmt_list
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt
 %type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt
@@ -289,7 +311,7 @@ import (
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
-%type <statement> show_variables_stmt show_status_stmt show_index_stmt
+%type <statement> show_variables_stmt show_status_stmt show_index_stmt show_grants_stmt
 %type <statement> alter_user_stmt update_stmt use_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
@@ -297,6 +319,15 @@ import (
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
 %type <statement> analyze_stmt
+%type <statement> alter_table_stmt rename_stmt
+%type <alterTableSpec> alter_table_spec alter_partition_spec
+%type <alterTableSpecs> alter_table_spec_list
+%type <renameTablePair> rename_table_pair
+%type <renameTablePairs> rename_table_pair_list
+%type <statement> create_view_stmt drop_view_stmt
+%type <with> with_clause
+%type <ctes> cte_list
+%type <cte> common_table_expr
 %type <exportParm> export_data_param_opt
 
 %type <select> select_stmt select_no_parens
@@ -310,7 +341,7 @@ import (
 %type <orderBy> order_list order_by_clause order_by_opt
 %type <limit> limit_opt limit_clause
 %type <str> insert_column
-%type <identifierList> column_list partition_clause_opt partition_id_list insert_column_list
+%type <identifierList> column_list column_list_opt partition_clause_opt partition_id_list insert_column_list
 %type <joinCond> join_condition join_condition_opt on_expression_opt
 
 %type <tableDefs> table_elem_list_opt table_elem_list
@@ -430,7 +461,7 @@ import (
 %type <lengthScaleOpt> float_length_opt decimal_length_opt
 %type <unsignedOpt> unsigned_opt header_opt
 %type <zeroFillOpt> zero_fill_opt
-%type <boolVal> global_scope exists_opt distinct_opt temporary_opt
+%type <boolVal> global_scope exists_opt distinct_opt temporary_opt or_replace_opt
 %type <item> pwd_expire clear_pwd_opt
 %type <str> name_confict distinct_keyword
 %type <insert> insert_data
@@ -440,11 +471,11 @@ import (
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
-%type <boolVal> local_opt
+%type <boolVal> local_opt kill_opt
 %type <duplicateKey> duplicate_opt
 %type <fields> load_fields field_item export_fields
 %type <fieldsList> field_item_list
-%type <str> field_terminator starting_opt lines_terminated_opt
+%type <str> field_terminator starting_opt lines_terminated_opt export_format_opt export_compression_opt
 %type <lines> load_lines export_lines_opt
 %type <int64Val> ignore_lines
 %type <varExpr> user_variable variable system_variable
@@ -491,6 +522,7 @@ stmt:
 |   explain_stmt
 |   show_stmt
 |   alter_stmt
+|   rename_stmt
 |   analyze_stmt
 |   update_stmt
 |   use_stmt
@@ -499,6 +531,10 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   backup_stmt
+|   restore_stmt
+|   kill_stmt
+|   admin_stmt
 |   select_stmt
     {
         $$ = $1
@@ -508,6 +544,57 @@ stmt:
         $$ = tree.Statement(nil)
     }
 
+admin_stmt:
+    ADMIN CHECK TABLE table_name_list
+    {
+        $$ = tree.NewCheckTable($4)
+    }
+|   ADMIN COMPACT TABLE table_name_list
+    {
+        $$ = tree.NewCompactTable($4)
+    }
+|   ADMIN ROTATE KEY
+    {
+        $$ = tree.NewRotateKey()
+    }
+
+kill_stmt:
+    KILL kill_opt INTEGRAL
+    {
+        $$ = tree.NewKill($2, uint64($3.(int64)))
+    }
+
+kill_opt:
+    {
+        $$ = false
+    }
+|   CONNECTION
+    {
+        $$ = false
+    }
+|   QUERY
+    {
+        $$ = true
+    }
+
+backup_stmt:
+    BACKUP DATABASE database_id TO STRING
+    {
+        $$ = &tree.BackupDatabase{
+            Name: tree.Identifier($3),
+            Path: $5,
+        }
+    }
+
+restore_stmt:
+    RESTORE DATABASE database_id FROM STRING
+    {
+        $$ = &tree.RestoreDatabase{
+            Name: tree.Identifier($3),
+            Path: $5,
+        }
+    }
+
 load_data_stmt:
     LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
@@ -769,6 +856,7 @@ field_item:
         $$ = &tree.Fields{
             Optionally: true,
             EnclosedBy: b,
+            Enclosed:   true,
         }
     }
 |   ENCLOSED BY field_terminator
@@ -786,6 +874,7 @@ field_item:
         }
         $$ = &tree.Fields{
             EnclosedBy: b,
+            Enclosed:   true,
         }
     }
 |   ESCAPED BY field_terminator
@@ -803,6 +892,7 @@ field_item:
         }
         $$ = &tree.Fields{
             EscapedBy: b,
+            Escaped:   true,
         }
     }
 
@@ -1511,11 +1601,118 @@ analyze_stmt:
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
+|   ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
 
 alter_stmt:
     alter_user_stmt
+|   alter_table_stmt
 // |    alter_ddl_stmt
 
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_spec_list
+    {
+        $$ = tree.NewAlterTable(*$3, $4)
+    }
+|   ALTER TABLE table_name alter_partition_spec
+    {
+        $$ = tree.NewAlterTable(*$3, []tree.AlterTableSpec{$4})
+    }
+
+alter_table_spec_list:
+    alter_table_spec
+    {
+        $$ = []tree.AlterTableSpec{$1}
+    }
+|   alter_table_spec_list ',' alter_table_spec
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_spec:
+    ADD column_def
+    {
+        $$ = tree.NewAlterTableAddColumn($2)
+    }
+|   ADD COLUMN column_def
+    {
+        $$ = tree.NewAlterTableAddColumn($3)
+    }
+|   DROP ident
+    {
+        $$ = tree.NewAlterTableDropColumn(tree.Identifier($2))
+    }
+|   DROP COLUMN ident
+    {
+        $$ = tree.NewAlterTableDropColumn(tree.Identifier($3))
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = tree.NewAlterTableRenameColumn(tree.Identifier($3), tree.Identifier($5))
+    }
+|   ALTER column_keyword_opt ident SET DEFAULT bit_expr
+    {
+        $$ = tree.NewAlterTableAlterColumn(tree.Identifier($3), $6)
+    }
+|   ALTER column_keyword_opt ident DROP DEFAULT
+    {
+        $$ = tree.NewAlterTableAlterColumn(tree.Identifier($3), nil)
+    }
+|   RENAME to_or_as_opt table_name
+    {
+        $$ = tree.NewAlterTableRename(*$3)
+    }
+
+alter_partition_spec:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = tree.NewAlterTableAddPartition($4)
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = tree.NewAlterTableDropPartition($3)
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = tree.NewAlterTableTruncatePartition($3)
+    }
+
+column_keyword_opt:
+    {}
+|   COLUMN
+    {}
+
+to_or_as_opt:
+    {}
+|   TO
+    {}
+|   AS
+    {}
+
+rename_stmt:
+    RENAME TABLE rename_table_pair_list
+    {
+        $$ = tree.NewRenameTable($3)
+    }
+
+rename_table_pair_list:
+    rename_table_pair
+    {
+        $$ = []*tree.RenameTablePair{$1}
+    }
+|   rename_table_pair_list ',' rename_table_pair
+    {
+        $$ = append($1, $3)
+    }
+
+rename_table_pair:
+    table_name TO table_name
+    {
+        $$ = tree.NewRenameTablePair(*$1, *$3)
+    }
+
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
     {
@@ -1620,6 +1817,17 @@ show_stmt:
 |   show_variables_stmt
 |   show_status_stmt
 |   show_index_stmt
+|   show_grants_stmt
+
+show_grants_stmt:
+    SHOW GRANTS
+    {
+        $$ = &tree.ShowGrants{}
+    }
+|   SHOW GRANTS FOR user_name
+    {
+        $$ = &tree.ShowGrants{Username: $4.Username, Hostname: $4.Hostname}
+    }
 
 show_index_stmt:
     SHOW index_kwd from_or_in table_name where_expression_opt
@@ -1786,6 +1994,10 @@ show_create_stmt:
     {
         $$ = &tree.ShowCreateTable{Name: $4}
     }
+|   SHOW CREATE VIEW table_name_unresolved
+    {
+        $$ = tree.NewShowCreateView($4)
+    }
 |   SHOW CREATE DATABASE not_exists_opt db_name
     {
         $$ = &tree.ShowCreateDatabase{IfNotExists: $4, Name: $5}
@@ -1824,6 +2036,7 @@ drop_stmt:
 drop_ddl_stmt:
     drop_database_stmt
 |   drop_table_stmt
+|   drop_view_stmt
 |   drop_index_stmt
 |   drop_role_stmt
 |   drop_user_stmt
@@ -1862,6 +2075,12 @@ drop_table_stmt:
         $$ = &tree.DropTable{IfExists: $3, Names: $4}
     }
 
+drop_view_stmt:
+    DROP VIEW exists_opt table_name_list
+    {
+        $$ = tree.NewDropView($3, $4)
+    }
+
 drop_database_stmt:
     DROP DATABASE exists_opt database_id
     {
@@ -2065,68 +2284,74 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_format_opt export_compression_opt export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
             FilePath :  $3,
-            Fields:     $4,
-            Lines:      $5,
-            Header:     $6,
-            MaxFileSize:uint64($7)*1024,
-            ForceQuote: $8,
+            FileFormat: $4,
+            Compression:$5,
+            Fields:     $6,
+            Lines:      $7,
+            Header:     $8,
+            MaxFileSize:uint64($9)*1024,
+            ForceQuote: $10,
         }
     }
 
-export_fields:
+export_format_opt:
     {
-        $$ = &tree.Fields{
-            Terminated: ",",
-            EnclosedBy: '"',
-        }
+        $$ = tree.ExportFormatCsv
     }
-|   FIELDS TERMINATED BY STRING
+|   FORMAT ident
     {
-        $$ = &tree.Fields{
-            Terminated: $4,
-            EnclosedBy: '"',
+        str := strings.ToLower($2)
+        if str != tree.ExportFormatCsv && str != tree.ExportFormatParquet {
+            yylex.Error("error export format")
+            return 1
         }
+        $$ = str
     }
-|   FIELDS TERMINATED BY STRING ENCLOSED BY field_terminator
+
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
+export_fields:
     {
-        str := $7
-        if str != "\\" && len(str) > 1 {
-            yylex.Error("export1 error field terminator")
-            return 1
-        }
-        var b byte
-        if len(str) != 0 {
-           b = byte(str[0])
-        } else {
-           b = 0
-        }
         $$ = &tree.Fields{
-            Terminated: $4,
-            EnclosedBy: b,
+            Terminated: ",",
+            EnclosedBy: '"',
         }
     }
-|   FIELDS ENCLOSED BY field_terminator
+|   fields_or_columns field_item_list
     {
-        str := $4
-        if str != "\\" && len(str) > 1 {
-            yylex.Error("export2 error field terminator")
-            return 1
-        }
-        var b byte
-        if len(str) != 0 {
-           b = byte(str[0])
-        } else {
-           b = 0
-        }
-        $$ = &tree.Fields{
+        res := &tree.Fields{
             Terminated: ",",
-            EnclosedBy: b,
+            EnclosedBy: '"',
         }
+        for _, f := range $2 {
+            if f.Terminated != "" {
+                res.Terminated = f.Terminated
+            }
+            if f.Optionally {
+                res.Optionally = f.Optionally
+            }
+            if f.Enclosed {
+                res.Enclosed = true
+                res.EnclosedBy = f.EnclosedBy
+            }
+            if f.Escaped {
+                res.Escaped = true
+                res.EscapedBy = f.EscapedBy
+            }
+        }
+        $$ = res
     }
 
 export_lines_opt:
@@ -2135,10 +2360,11 @@ export_lines_opt:
             TerminatedBy: "\n",
         }
     }
-|   LINES lines_terminated_opt
+|   LINES starting_opt lines_terminated_opt
     {
         $$ = &tree.Lines{
-            TerminatedBy: $2,
+            StartingBy: $2,
+            TerminatedBy: $3,
         }
     }
 
@@ -2195,6 +2421,46 @@ select_stmt:
     {
         $$ = &tree.Select{Select: $1, Ep: $2}
     }
+|   with_clause select_no_parens
+    {
+        $2.With = $1
+        $$ = $2
+    }
+
+with_clause:
+    WITH cte_list
+    {
+        $$ = &tree.With{Ctes: $2}
+    }
+
+cte_list:
+    common_table_expr
+    {
+        $$ = []*tree.CTE{$1}
+    }
+|   cte_list ',' common_table_expr
+    {
+        $$ = append($1, $3)
+    }
+
+common_table_expr:
+    ident column_list_opt AS '(' select_stmt ')'
+    {
+        $$ = &tree.CTE{
+            Name: tree.Identifier($1),
+            Cols: $2,
+            Stmt: $5,
+        }
+    }
+
+column_list_opt:
+    {
+        $$ = nil
+    }
+|   '(' column_list ')'
+    {
+        $$ = $2
+    }
 
 select_no_parens:
     simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
@@ -2702,6 +2968,22 @@ create_ddl_stmt:
     create_table_stmt
 |   create_database_stmt
 |   create_index_stmt
+|   create_view_stmt
+
+create_view_stmt:
+    CREATE or_replace_opt VIEW table_name column_list_opt AS select_stmt
+    {
+        $$ = tree.NewCreateView($2, $4, $5, $7)
+    }
+
+or_replace_opt:
+    {
+        $$ = false
+    }
+|   OR REPLACE
+    {
+        $$ = true
+    }
 
 create_user_stmt:
     CREATE USER not_exists_opt user_spec_list require_clause_opt conn_options
@@ -2750,6 +3032,14 @@ conn_option:
     {
         $$ = &tree.ResourceOptionMaxUserConnections{Count: $2.(int64)}
     }
+|   MAX_EXECUTION_TIME INTEGRAL
+    {
+        $$ = &tree.ResourceOptionMaxExecutionTime{Millis: $2.(int64)}
+    }
+|   QUERY_MEMORY_LIMIT INTEGRAL
+    {
+        $$ = &tree.ResourceOptionQueryMemoryLimit{Size: $2.(int64)}
+    }
 
 
 require_clause_opt:
@@ -3261,6 +3551,22 @@ values_opt:
     {
         $$ = &tree.ValuesLessThan{ValueList: $5}
     }
+|   VALUES LESS THAN MAXVALUE
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES LESS THAN '(' MAXVALUE ')'
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES IN '(' expression_list ')'
+    {
+        vl := make([]tree.Exprs, len($4))
+        for i, e := range $4 {
+            vl[i] = tree.Exprs{e}
+        }
+        $$ = &tree.ValuesIn{ValueList: vl}
+    }
 
 sub_partition_num_opt:
     {
@@ -3504,6 +3810,10 @@ table_option:
 	{
 		$$ = &tree.TableOptionProperties{Preperties: $3}
 	}
+|   TTL equal_opt ident '+' INTERVAL INTEGRAL DAY
+    {
+        $$ = tree.NewTableOptionTTL($3, uint64($6.(int64)))
+    }
 // |   INSERT_METHOD equal_opt insert_method_options
 
 properties_list:
@@ -3595,6 +3905,11 @@ table_name:
         prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1), ExplicitSchema: true}
         $$ = tree.NewTableName(tree.Identifier($3), prefix)
     }
+|   ident '.' reserved_keyword
+    {
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1), ExplicitSchema: true}
+        $$ = tree.NewTableName(tree.Identifier($3), prefix)
+    }
 
 table_elem_list_opt:
     {
@@ -5720,6 +6035,7 @@ reserved_keyword:
 |   VALUES
 |   WHEN
 |   WHERE
+|   WITH
 |   WEEK
 |   QUARTER
 |   PASSWORD
@@ -5743,11 +6059,13 @@ reserved_keyword:
 |   OUTFILE
 
 non_reserved_keyword:
-    AGAINST
+    ADMIN
+|   AGAINST
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ACTION
 |   ALGORITHM
+|   BACKUP
 |   BEGIN
 |   BIGINT
 |   BIT
@@ -5822,6 +6140,8 @@ non_reserved_keyword:
 |   MAX_UPDATES_PER_HOUR
 |   MAX_CONNECTIONS_PER_HOUR
 |   MAX_USER_CONNECTIONS
+|   MAX_EXECUTION_TIME
+|   QUERY_MEMORY_LIMIT
 |   MAX_ROWS
 |   MIN_ROWS
 |   NAMES
@@ -5841,6 +6161,7 @@ non_reserved_keyword:
 |   POINT
 |   POLYGON
 |   PROCEDURE
+|   PROCESSLIST
 |   PROXY
 |   QUERY
 |   RANGE
@@ -5855,6 +6176,8 @@ non_reserved_keyword:
 |   REPLICATION
 |   ROW_FORMAT
 |   ROLLBACK
+|   ROTATE
+|   RESTORE
 |   RESTRICT
 |   SESSION
 |   SERIALIZABLE
@@ -5879,6 +6202,7 @@ non_reserved_keyword:
 |   TINYTEXT
 |   TRANSACTION
 |   TRIGGER
+|   TTL
 |   UNCOMMITTED
 |   UNSIGNED
 |   UNUSED
@@ -5888,9 +6212,9 @@ non_reserved_keyword:
 |   VARCHAR
 |   VARIABLES
 |   VIEW
-|   WITH
 |   WRITE
 |   WARNINGS
+|   GRANTS
 |   WORK
 |   X509
 |   ZEROFILL
@@ -99,9 +99,38 @@ var (
 	}, {
 		input:  "create table t (a int) properties('a' = 'b')",
 		output: "create table t (a int) properties(a = b)",
+	}, {
+		input:  "create table t (a datetime) ttl = a + interval 7 day",
+		output: "create table t (a datetime) ttl = a + interval 7 day",
+	}, {
+		input:  "create table t (a date) TTL a + INTERVAL 30 DAY",
+		output: "create table t (a date) ttl = a + interval 30 day",
 	}, {
 		input:  "load data infile '/root/lineorder_flat_10.tbl' into table lineorder_flat FIELDS TERMINATED BY '' OPTIONALLY ENCLOSED BY '' LINES TERMINATED BY '';",
 		output: "load data infile /root/lineorder_flat_10.tbl into table lineorder_flat fields terminated by \t optionally enclosed by \u0000 lines",
+	}, {
+		input: "kill 12",
+	}, {
+		input:  "kill connection 12",
+		output: "kill 12",
+	}, {
+		input: "kill query 12",
+	}, {
+		input: "admin check table t1, db1.t2",
+	}, {
+		input:  "ADMIN CHECK TABLE admin",
+		output: "admin check table admin",
+	}, {
+		input: "admin compact table t1, db1.t2",
+	}, {
+		input:  "ADMIN ROTATE KEY",
+		output: "admin rotate key",
+	}, {
+		input:  "backup database db1 to '/data/db1.bak'",
+		output: "backup database db1 to /data/db1.bak",
+	}, {
+		input:  "restore database db2 from '/data/db1.bak'",
+		output: "restore database db2 from /data/db1.bak",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
 	}, {
@@ -327,6 +356,11 @@ var (
 		input: "create table A (a int) partition by range columns (a, b, db.t.c)",
 	}, {
 		input: "create table A (a int) partition by range(1 + 21)",
+	}, {
+		input:  "create table A (a date) partition by range columns (a) (partition p0 values less than ('2021-02-01'), partition p1 values less than maxvalue)",
+		output: "create table A (a date) partition by range columns (a) (partition p0 values less than (2021-02-01), partition p1 values less than (maxvalue))",
+	}, {
+		input: "create table A (a int) partition by list(a) (partition p0 values in (1, 3, 5), partition p1 values in (2, 4))",
 	}, {
 		input: "create table A (a int storage disk constraint cx check (b + c) enforced)",
 	}, {
@@ -448,6 +482,45 @@ var (
 	}, {
 		input:  "alter user if exists user() identified by 'test'",
 		output: "alter user if exists user() identified by test",
+	}, {
+		input:  "alter table t1 add c int default 1",
+		output: "alter table t1 add column c int default 1",
+	}, {
+		input: "alter table t1 add column c varchar(20), drop column b",
+	}, {
+		input:  "alter table t1 drop a",
+		output: "alter table t1 drop column a",
+	}, {
+		input: "alter table db.t1 rename column a to b",
+	}, {
+		input:  "alter table t1 alter a set default 10",
+		output: "alter table t1 alter column a set default 10",
+	}, {
+		input: "alter table t1 alter column a drop default",
+	}, {
+		input:  "alter table t1 rename as t2",
+		output: "alter table t1 rename to t2",
+	}, {
+		input: "alter table t1 add partition (partition p2 values less than (20), partition p3 values less than (maxvalue))",
+	}, {
+		input: "alter table t1 drop partition p0, p1",
+	}, {
+		input: "alter table t1 truncate partition p2",
+	}, {
+		input: "rename table t1 to t2, db.t3 to db.t4",
+	}, {
+		input: "with t2 as (select a from t1 where b > 1) select * from t2",
+	}, {
+		input:  "WITH s (x, n) AS (SELECT a, count(*) FROM t GROUP BY a), u AS (SELECT x FROM s) SELECT * FROM s, u WHERE s.x = u.x ORDER BY n LIMIT 1",
+		output: "with s (x, n) as (select a, count(*) from t group by a), u as (select x from s) select * from s, u where s.x = u.x order by n limit 1",
+	}, {
+		input: "create view v as select a, b from t1 where a > 1",
+	}, {
+		input: "create or replace view db.v (x, y) as select a, b from t1",
+	}, {
+		input: "drop view if exists v1, db.v2",
+	}, {
+		input: "show create view db.v",
 	}, {
 		input: "show index from t where true",
 	}, {
@@ -459,12 +532,20 @@ var (
 		input: "show global variables",
 	}, {
 		input: "show warnings",
+	}, {
+		input: "show grants",
+	}, {
+		input: "show grants for u1@localhost",
 	}, {
 		input: "show errors",
 	}, {
 		input: "show full processlist",
 	}, {
 		input: "show processlist",
+	}, {
+		input: "select TABLE_NAME from information_schema.TABLES where TABLE_SCHEMA = db1",
+	}, {
+		input: "select * from information_schema.PROCESSLIST",
 	}, {
 		input:  "show full tables from db1 like 'a%' where a != 0",
 		output: "show full tables from db1 like a% where a != 0",
@@ -525,6 +606,16 @@ var (
 	}, {
 		input:  "create user if not exists u1 identified by 'u1', u2 require cipher 'xxx' subject 'yyy' with max_queries_per_hour 0",
 		output: "create user if not exists u1 identified by u1, u2 require cipher xxx and subject yyy with max_queries_per_hour 0",
+	}, {
+		input:  "create user u1 identified by 'u1' with max_execution_time 1000 query_memory_limit 1048576",
+		output: "create user u1 identified by u1 with max_execution_time 1000 query_memory_limit 1048576",
+	}, {
+		input: "alter user u1 with max_execution_time 0",
+	}, {
+		input: "set max_execution_time = 1000",
+	}, {
+		input:  "set session query_memory_limit = 1048576",
+		output: "set query_memory_limit = 1048576",
 	}, {
 		input:  "create role if not exists 'a'@'localhost', 'b'@'localhost'",
 		output: "create role if not exists a@localhost, b@localhost",
@@ -644,6 +735,8 @@ var (
 	}, {
 		input:  "analyze table part (a,b )",
 		output: "analyze table part(a, b)",
+	}, {
+		input: "analyze table part",
 	}, {
 		input: "select $ from t into outfile '/Users/tmp/test'",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -665,6 +758,15 @@ var (
 	}, {
 		input: "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+	}, {
+		input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY '\t' OPTIONALLY ENCLOSED BY '#' ESCAPED BY '|' LINES STARTING BY 'x' TERMINATED BY '\r\n'",
+		output: "select $ from t into outfile /Users/tmp/test fields terminated by \t optionally enclosed by # escaped by | lines starting by x terminated by \r\n header true",
+	}, {
+		input:  "select $ from t into outfile '/Users/tmp/test' FORMAT PARQUET COMPRESSION 'SNAPPY'",
+		output: "select $ from t into outfile /Users/tmp/test format parquet compression snappy fields terminated by , enclosed by \" lines terminated by \n header true",
+	}, {
+		input:  "select $ from t into outfile '/Users/tmp/test' FORMAT CSV",
+		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
 	}}
 )
 
@@ -722,3 +824,36 @@ func TestMulti(t *testing.T) {
 		}
 	}
 }
+
+var (
+	normalizeSQL = []struct {
+		input  string
+		output string
+	}{{
+		input:  "select a, b from t where a = 1 and b = 'x' limit 10",
+		output: "select a, b from t where a = ? and b = ? limit ?",
+	}, {
+		input:  "select a from t where a in (1, 2, 3) and b is null",
+		output: "select a from t where a in (...) and b is null",
+	}, {
+		input:  "insert into t values (1, 'a'), (2, 'b'), (3, 'c')",
+		output: "insert into t values (?, ?)",
+	}, {
+		input:  "select count(*) from t group by a",
+		output: "select count(*) from t group by a",
+	}}
+)
+
+func TestNormalize(t *testing.T) {
+	for _, tcase := range normalizeSQL {
+		ast, err := ParseOne(tcase.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", tcase.input, err)
+			continue
+		}
+		out := tree.Normalize(ast, dialect.MYSQL)
+		if tcase.output != out {
+			t.Errorf("Normalizing failed. \nExpected/Got:\n%s\n%s", tcase.output, out)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import "strings"
+
+/*
+Translate rewrites the lexical conventions of PostgreSQL which differ from MySQL,
+so that the statements can be parsed by the MySQL grammar:
+
+	"identifier" is quoted with the backquotes,
+	the backslashes in the standard string constants 'text' are escaped,
+	the escape string constants E'text' become the string constants.
+
+The comments are removed and the rest of the statements are kept as they are.
+*/
+func Translate(sql string) string {
+	var buf strings.Builder
+	buf.Grow(len(sql))
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '\'':
+			i = translateString(&buf, sql, i+1, false)
+		case (c == 'E' || c == 'e') && i+1 < len(sql) && sql[i+1] == '\'' && (i == 0 || !isIdentChar(sql[i-1])):
+			i = translateString(&buf, sql, i+2, true)
+		case c == '"':
+			i = translateIdentifier(&buf, sql, i+1)
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			j := strings.IndexByte(sql[i:], '\n')
+			if j < 0 {
+				j = len(sql) - i
+			}
+			buf.WriteByte(' ')
+			i += j
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			j := strings.Index(sql[i+2:], "*/")
+			if j < 0 {
+				j = len(sql) - i
+			} else {
+				j += 4
+			}
+			buf.WriteByte(' ')
+			i += j
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+	return buf.String()
+}
+
+// translateString writes the string constant beginning at i, it returns the position after the constant.
+func translateString(buf *strings.Builder, sql string, i int, escape bool) int {
+	buf.WriteByte('\'')
+	for i < len(sql) {
+		c := sql[i]
+		switch {
+		case c == '\'' && i+1 < len(sql) && sql[i+1] == '\'':
+			buf.WriteString("''")
+			i += 2
+		case c == '\'':
+			buf.WriteByte('\'')
+			return i + 1
+		case c == '\\' && escape:
+			buf.WriteByte(c)
+			if i+1 < len(sql) {
+				buf.WriteByte(sql[i+1])
+			}
+			i += 2
+		case c == '\\':
+			buf.WriteString(`\\`)
+			i++
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+	return i
+}
+
+// translateIdentifier writes the quoted identifier beginning at i, it returns the position after the identifier.
+func translateIdentifier(buf *strings.Builder, sql string, i int) int {
+	buf.WriteByte('`')
+	for i < len(sql) {
+		c := sql[i]
+		switch {
+		case c == '"' && i+1 < len(sql) && sql[i+1] == '"':
+			buf.WriteByte('"')
+			i += 2
+		case c == '"':
+			buf.WriteByte('`')
+			return i + 1
+		case c == '`':
+			buf.WriteString("``")
+			i++
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+	return i
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+/*
+Keywords returns the first word of each statement of the translated sql in
+lower case. It is empty for a statement which doesn't begin with a word.
+*/
+func Keywords(sql string) []string {
+	var keywords []string
+	begin := true
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ';':
+			begin = true
+			i++
+		case c == '\'' || c == '`':
+			if begin {
+				keywords = append(keywords, "")
+				begin = false
+			}
+			i = skipQuoted(sql, i+1, c)
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case begin && isIdentChar(c):
+			j := i
+			for j < len(sql) && isIdentChar(sql[j]) {
+				j++
+			}
+			keywords = append(keywords, strings.ToLower(sql[i:j]))
+			begin = false
+			i = j
+		default:
+			if begin {
+				keywords = append(keywords, "")
+				begin = false
+			}
+			i++
+		}
+	}
+	return keywords
+}
+
+// skipQuoted returns the position after the translated string or identifier beginning at i.
+func skipQuoted(sql string, i int, quote byte) int {
+	for i < len(sql) {
+		c := sql[i]
+		switch {
+		case c == quote && i+1 < len(sql) && sql[i+1] == quote:
+			i += 2
+		case c == quote:
+			return i + 1
+		case c == '\\' && quote == '\'':
+			i += 2
+		default:
+			i++
+		}
+	}
+	return i
+}
@@ -30,6 +30,7 @@ type Scanner struct {
 	LastError           error
 	posVarIndex         int
 	dialectType         dialect.DialectType
+	tokens              *tokenTable
 	MysqlSpecialComment *Scanner
 
 	Pos int
@@ -37,9 +38,9 @@ type Scanner struct {
 }
 
 func NewScanner(dialectType dialect.DialectType, sql string) *Scanner {
-	initTokens(dialectType)
 	return &Scanner{
-		buf: sql,
+		tokens: tokenTables[dialectType],
+		buf:    sql,
 	}
 }
 
@@ -56,11 +57,11 @@ func (s *Scanner) Scan() (int, string) {
 	s.skipBlank()
 	switch ch := s.cur(); {
 	case ch == '@':
-		tokenID := AT_ID
+		tokenID := s.tokens.atID
 		s.skip(1)
 		s.skipBlank()
 		if s.cur() == '@' {
-			tokenID = AT_AT_ID
+			tokenID = s.tokens.atAtID
 			s.skip(1)
 		} else if s.cur() == '\'' || s.cur() == '"' {
 			return int('@'), ""
@@ -71,11 +72,11 @@ func (s *Scanner) Scan() (int, string) {
 			s.skip(1)
 			tID, tBytes = s.scanLiteralIdentifier()
 		} else if s.cur() == eofChar {
-			return LEX_ERROR, ""
+			return s.tokens.lexError, ""
 		} else {
 			tID, tBytes = s.scanIdentifier(true)
 		}
-		if tID == LEX_ERROR {
+		if tID == s.tokens.lexError {
 			return tID, ""
 		}
 		return tokenID, tBytes
@@ -98,7 +99,7 @@ func (s *Scanner) Scan() (int, string) {
 	case ch == ':':
 		if s.peek(1) == '=' {
 			s.skip(2)
-			return ASSIGNMENT, ""
+			return s.tokens.assignment, ""
 		}
 		// Like mysql -h ::1 ?
 		return s.scanBindVar()
@@ -113,7 +114,7 @@ func (s *Scanner) Scan() (int, string) {
 		case '/':
 			s.skip(1)
 			id, str := s.scanCommentTypeLine(2)
-			if id == LEX_ERROR {
+			if id == s.tokens.lexError {
 				return id, str
 			}
 			return s.Scan()
@@ -125,7 +126,7 @@ func (s *Scanner) Scan() (int, string) {
 				return s.scanMySQLSpecificComment()
 			default:
 				id, str := s.scanCommentTypeBlock()
-				if id == LEX_ERROR {
+				if id == s.tokens.lexError {
 					return id, str
 				}
 				return s.Scan()
@@ -148,13 +149,13 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 	case '&':
 		if s.cur() == '&' {
 			s.skip(1)
-			return AND, ""
+			return s.tokens.and, ""
 		}
 		return int(ch), ""
 	case '|':
 		if s.cur() == '|' {
 			s.skip(1)
-			return OR, ""
+			return s.tokens.or, ""
 		}
 		return int(ch), ""
 	case '?':
@@ -163,7 +164,7 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 		buf := make([]byte, 0, 8)
 		buf = append(buf, ":v"...)
 		buf = strconv.AppendInt(buf, int64(s.posVarIndex), 10)
-		return VALUE_ARG, string(buf)
+		return s.tokens.valueArg, string(buf)
 	case '.':
 		return int(ch), ""
 	case '#':
@@ -188,18 +189,18 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 		switch s.cur() {
 		case '>':
 			s.skip(1)
-			return NE, ""
+			return s.tokens.ne, ""
 		case '<':
 			s.skip(1)
-			return SHIFT_LEFT, ""
+			return s.tokens.shiftLeft, ""
 		case '=':
 			s.skip(1)
 			switch s.cur() {
 			case '>':
 				s.skip(1)
-				return NULL_SAFE_EQUAL, ""
+				return s.tokens.nullSafeEqual, ""
 			default:
-				return LE, ""
+				return s.tokens.le, ""
 			}
 		default:
 			return int(ch), ""
@@ -208,25 +209,25 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 		switch s.cur() {
 		case '=':
 			s.skip(1)
-			return GE, ""
+			return s.tokens.ge, ""
 		case '>':
 			s.skip(1)
-			return SHIFT_RIGHT, ""
+			return s.tokens.shiftRight, ""
 		default:
 			return int(ch), ""
 		}
 	case '!':
 		if s.cur() == '=' {
 			s.skip(1)
-			return NE, ""
+			return s.tokens.ne, ""
 		}
 		return int(ch), ""
 	case '\'', '"':
-		return s.scanString(ch, STRING)
+		return s.scanString(ch, s.tokens.string)
 	case '`':
 		return s.scanLiteralIdentifier()
 	default:
-		return LEX_ERROR, string(byte(ch))
+		return s.tokens.lexError, string(byte(ch))
 	}
 }
 
@@ -252,7 +253,7 @@ func (s *Scanner) scanString(delim uint16, typ int) (int, string) {
 			return s.scanStringSlow(&buffer, delim, typ)
 
 		case eofChar:
-			return LEX_ERROR, s.buf[start:s.Pos]
+			return s.tokens.lexError, s.buf[start:s.Pos]
 		}
 
 		s.skip(1)
@@ -267,7 +268,7 @@ func (s *Scanner) scanStringSlow(buffer *strings.Builder, delim uint16, typ int)
 		ch := s.cur()
 		if ch == eofChar {
 			// Unterminated string.
-			return LEX_ERROR, buffer.String()
+			return s.tokens.lexError, buffer.String()
 		}
 
 		if ch != delim && ch != '\\' {
@@ -289,7 +290,7 @@ func (s *Scanner) scanStringSlow(buffer *strings.Builder, delim uint16, typ int)
 
 		if ch == '\\' {
 			if s.cur() == eofChar {
-				return LEX_ERROR, buffer.String()
+				return s.tokens.lexError, buffer.String()
 			}
 			if to, ok := encodeRef[byte(s.cur())]; ok {
 				ch = uint16(to)
@@ -329,10 +330,10 @@ func (s *Scanner) scanLiteralIdentifier() (int, string) {
 		case '`':
 			if s.peek(1) != '`' {
 				if s.Pos == start {
-					return LEX_ERROR, ""
+					return s.tokens.lexError, ""
 				}
 				s.skip(1)
-				return ID, s.buf[start : s.Pos-1]
+				return s.tokens.id, s.buf[start : s.Pos-1]
 			}
 
 			var buf strings.Builder
@@ -341,7 +342,7 @@ func (s *Scanner) scanLiteralIdentifier() (int, string) {
 			return s.scanLiteralIdentifierSlow(&buf)
 		case eofChar:
 			// Premature EOF.
-			return LEX_ERROR, s.buf[start:s.Pos]
+			return s.tokens.lexError, s.buf[start:s.Pos]
 		default:
 			s.skip(1)
 		}
@@ -371,14 +372,14 @@ func (s *Scanner) scanLiteralIdentifierSlow(buf *strings.Builder) (int, string)
 			backTickSeen = true
 		case eofChar:
 			// Premature EOF.
-			return LEX_ERROR, buf.String()
+			return s.tokens.lexError, buf.String()
 		default:
 			buf.WriteByte(byte(s.cur()))
 			// keep scanning
 		}
 		s.skip(1)
 	}
-	return ID, buf.String()
+	return s.tokens.id, buf.String()
 }
 
 // scanCommentTypeBlock scans a '/*' delimited comment;
@@ -395,11 +396,11 @@ func (s *Scanner) scanCommentTypeBlock() (int, string) {
 			continue
 		}
 		if s.cur() == eofChar {
-			return LEX_ERROR, s.buf[start:s.Pos]
+			return s.tokens.lexError, s.buf[start:s.Pos]
 		}
 		s.skip(1)
 	}
-	return COMMENT, s.buf[start:s.Pos]
+	return s.tokens.comment, s.buf[start:s.Pos]
 }
 
 // scanMySQLSpecificComment scans a MySQL comment pragma, which always starts with '//*`
@@ -415,7 +416,7 @@ func (s *Scanner) scanMySQLSpecificComment() (int, string) {
 			continue
 		}
 		if s.cur() == eofChar {
-			return LEX_ERROR, s.buf[start:s.Pos]
+			return s.tokens.lexError, s.buf[start:s.Pos]
 		}
 		s.skip(1)
 	}
@@ -461,22 +462,22 @@ func (s *Scanner) scanCommentTypeLine(prefixLen int) (int, string) {
 		}
 		s.skip(1)
 	}
-	return COMMENT, s.buf[start:s.Pos]
+	return s.tokens.comment, s.buf[start:s.Pos]
 }
 
 // ?
 // scanBindVar scans a bind variable; assumes a ':' has been scanned right before
 func (s *Scanner) scanBindVar() (int, string) {
 	start := s.Pos
-	token := VALUE_ARG
+	token := s.tokens.valueArg
 
 	s.skip(1)
 	if s.cur() == ':' {
-		token = LIST_ARG
+		token = s.tokens.listArg
 		s.skip(1)
 	}
 	if !isLetter(s.cur()) {
-		return LEX_ERROR, s.buf[start:s.Pos]
+		return s.tokens.lexError, s.buf[start:s.Pos]
 	}
 	for {
 		ch := s.cur()
@@ -491,10 +492,10 @@ func (s *Scanner) scanBindVar() (int, string) {
 // scanNumber scans any SQL numeric literal, either floating point or integer
 func (s *Scanner) scanNumber() (int, string) {
 	start := s.Pos
-	token := INTEGRAL
+	token := s.tokens.integral
 
 	if s.cur() == '.' {
-		token = FLOAT
+		token = s.tokens.float
 		s.skip(1)
 		s.scanMantissa(10)
 		goto exponent
@@ -504,7 +505,7 @@ func (s *Scanner) scanNumber() (int, string) {
 	if s.cur() == '0' {
 		s.skip(1)
 		if s.cur() == 'x' || s.cur() == 'X' {
-			token = HEXNUM
+			token = s.tokens.hexNum
 			s.skip(1)
 			s.scanMantissa(16)
 			goto exit
@@ -514,7 +515,7 @@ func (s *Scanner) scanNumber() (int, string) {
 	s.scanMantissa(10)
 
 	if s.cur() == '.' {
-		token = FLOAT
+		token = s.tokens.float
 		s.skip(1)
 		s.scanMantissa(10)
 	}
@@ -522,7 +523,7 @@ func (s *Scanner) scanNumber() (int, string) {
 exponent:
 	if s.cur() == 'e' || s.cur() == 'E' {
 		if s.peek(1) == '+' || s.peek(1) == '-' {
-			token = FLOAT
+			token = s.tokens.float
 			s.skip(2)
 		} else {
 			goto exit
@@ -533,7 +534,7 @@ exponent:
 exit:
 	if isLetter(s.cur()) {
 		// TODO: optimize
-		token = ID
+		token = s.tokens.id
 		s.scanIdentifier(false)
 	}
 
@@ -556,14 +557,14 @@ func (s *Scanner) scanIdentifier(isVariable bool) (int, string) {
 	}
 	keywordName := s.buf[start:s.Pos]
 	lower := strings.ToLower(keywordName)
-	if keywordID, found := keywords[lower]; found {
+	if keywordID, found := s.tokens.keywords[lower]; found {
 		return keywordID, keywordName
 	}
 	// dual must always be case-insensitive
 	if lower == "dual" {
-		return ID, lower
+		return s.tokens.id, lower
 	}
-	return ID, keywordName
+	return s.tokens.id, keywordName
 }
 
 func (s *Scanner) scanBitLiteral() (int, string) {
@@ -571,10 +572,10 @@ func (s *Scanner) scanBitLiteral() (int, string) {
 	s.scanMantissa(2)
 	bit := s.buf[start:s.Pos]
 	if s.cur() != '\'' {
-		return LEX_ERROR, bit
+		return s.tokens.lexError, bit
 	}
 	s.skip(1)
-	return BIT_LITERAL, bit
+	return s.tokens.bitLiteral, bit
 }
 
 func (s *Scanner) scanHex() (int, string) {
@@ -582,13 +583,13 @@ func (s *Scanner) scanHex() (int, string) {
 	s.scanMantissa(16)
 	hex := s.buf[start:s.Pos]
 	if s.cur() != '\'' {
-		return LEX_ERROR, hex
+		return s.tokens.lexError, hex
 	}
 	s.skip(1)
 	if len(hex)%2 != 0 {
-		return LEX_ERROR, hex
+		return s.tokens.lexError, hex
 	}
-	return HEX, hex
+	return s.tokens.hex, hex
 }
 
 func (s *Scanner) scanMantissa(base int) {
@@ -166,3 +166,38 @@ func TestBuffer(t *testing.T) {
 		}
 	}
 }
+
+func TestDialectTokens(t *testing.T) {
+	testcases := []struct {
+		dialect dialect.DialectType
+		in      string
+		id      int
+	}{{
+		dialect: dialect.MYSQL,
+		in:      "use",
+		id:      MYSQL_USE,
+	}, {
+		dialect: dialect.POSTGRESQL,
+		in:      "use",
+		id:      POSTGRESQL_USE,
+	}, {
+		dialect: dialect.MYSQL,
+		in:      "1",
+		id:      MYSQL_INTEGRAL,
+	}, {
+		dialect: dialect.POSTGRESQL,
+		in:      "1",
+		id:      POSTGRESQL_INTEGRAL,
+	}}
+
+	// the scanners of both dialects are interleaved
+	scanners := make([]*Scanner, len(testcases))
+	for i, tcase := range testcases {
+		scanners[i] = NewScanner(tcase.dialect, tcase.in)
+	}
+	for i, tcase := range testcases {
+		if id, _ := scanners[i].Scan(); id != tcase.id {
+			t.Errorf("Scan(%q) = %d, want %d", tcase.in, id, tcase.id)
+		}
+	}
+}
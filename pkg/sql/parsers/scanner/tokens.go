@@ -20,6 +20,76 @@ import (
 
 var keywords map[string]int
 
+// tokenTable holds the token ids of a dialect. The dialects number their
+// tokens differently, so every scanner reads the ids from the table of its
+// own dialect rather than from the package variables.
+type tokenTable struct {
+	keywords map[string]int
+
+	and           int
+	assignment    int
+	atAtID        int
+	atID          int
+	bitLiteral    int
+	comment       int
+	float         int
+	ge            int
+	hex           int
+	hexNum        int
+	id            int
+	integral      int
+	le            int
+	lexError      int
+	listArg       int
+	ne            int
+	nullSafeEqual int
+	or            int
+	shiftLeft     int
+	shiftRight    int
+	string        int
+	valueArg      int
+}
+
+var tokenTables = make(map[dialect.DialectType]*tokenTable)
+
+func init() {
+	// the tokens PostgreSQL does not define keep their MySQL ids
+	initTokens(dialect.MYSQL)
+	initTokens(dialect.POSTGRESQL)
+	tokenTables[dialect.POSTGRESQL] = newTokenTable()
+	initTokens(dialect.MYSQL)
+	tokenTables[dialect.MYSQL] = newTokenTable()
+}
+
+// newTokenTable takes the token ids set by the last initTokens.
+func newTokenTable() *tokenTable {
+	return &tokenTable{
+		keywords:      keywords,
+		and:           AND,
+		assignment:    ASSIGNMENT,
+		atAtID:        AT_AT_ID,
+		atID:          AT_ID,
+		bitLiteral:    BIT_LITERAL,
+		comment:       COMMENT,
+		float:         FLOAT,
+		ge:            GE,
+		hex:           HEX,
+		hexNum:        HEXNUM,
+		id:            ID,
+		integral:      INTEGRAL,
+		le:            LE,
+		lexError:      LEX_ERROR,
+		listArg:       LIST_ARG,
+		ne:            NE,
+		nullSafeEqual: NULL_SAFE_EQUAL,
+		or:            OR,
+		shiftLeft:     SHIFT_LEFT,
+		shiftRight:    SHIFT_RIGHT,
+		string:        STRING,
+		valueArg:      VALUE_ARG,
+	}
+}
+
 func initTokens(dialectType dialect.DialectType) {
 	switch dialectType {
 	case dialect.MYSQL:
@@ -46,6 +116,12 @@ func initTokens(dialectType dialect.DialectType) {
 		HEADER = MYSQL_HEADER
 		MAX_FILE_SIZE = MYSQL_MAX_FILE_SIZE
 		FORCE_QUOTE = MYSQL_FORCE_QUOTE
+		BACKUP = MYSQL_BACKUP
+		KILL = MYSQL_KILL
+		ADMIN = MYSQL_ADMIN
+		TTL = MYSQL_TTL
+		ROTATE = MYSQL_ROTATE
+		RESTORE = MYSQL_RESTORE
 		AVG = MYSQL_AVG
 		ADDDATE = MYSQL_ADDDATE
 		COUNT = MYSQL_COUNT
@@ -165,6 +241,7 @@ func initTokens(dialectType dialect.DialectType) {
 		NEVER = MYSQL_NEVER
 		INDEXES = MYSQL_INDEXES
 		WARNINGS = MYSQL_WARNINGS
+		GRANTS = MYSQL_GRANTS
 		ERRORS = MYSQL_ERRORS
 		OPEN = MYSQL_OPEN
 		FIELDS = MYSQL_FIELDS
@@ -174,6 +251,8 @@ func initTokens(dialectType dialect.DialectType) {
 		MAX_UPDATES_PER_HOUR = MYSQL_MAX_UPDATES_PER_HOUR
 		MAX_CONNECTIONS_PER_HOUR = MYSQL_MAX_CONNECTIONS_PER_HOUR
 		MAX_USER_CONNECTIONS = MYSQL_MAX_USER_CONNECTIONS
+		MAX_EXECUTION_TIME = MYSQL_MAX_EXECUTION_TIME
+		QUERY_MEMORY_LIMIT = MYSQL_QUERY_MEMORY_LIMIT
 		CIPHER = MYSQL_CIPHER
 		ISSUER = MYSQL_ISSUER
 		X509 = MYSQL_X509
@@ -429,6 +508,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"account":                  ACCOUNT,
 		"add":                      ADD,
 		"action":                   ACTION,
+		"admin":                    ADMIN,
 		"against":                  AGAINST,
 		"all":                      ALL,
 		"alter":                    ALTER,
@@ -443,6 +523,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"auto_random":              AUTO_RANDOM,
 		"avg_row_length":           AVG_ROW_LENGTH,
 		"avg":                      AVG,
+		"backup":                   BACKUP,
 		"bsi":					    BSI,
 		"before":                   UNUSED,
 		"begin":                    BEGIN,
@@ -616,7 +697,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"key":                      KEY,
 		"keys":                     KEYS,
 		"key_block_size":           KEY_BLOCK_SIZE,
-		"kill":                     UNUSED,
+		"kill":                     KILL,
 		"language":                 LANGUAGE,
 		"last_insert_id":           LAST_INSERT_ID,
 		"leading":                  UNUSED,
@@ -663,6 +744,8 @@ func initTokens(dialectType dialect.DialectType) {
 		"max_update_per_hour":      MAX_UPDATES_PER_HOUR,
 		"max_connections_per_hour": MAX_CONNECTIONS_PER_HOUR,
 		"max_user_connections":     MAX_USER_CONNECTIONS,
+		"max_execution_time":       MAX_EXECUTION_TIME,
+		"query_memory_limit":       QUERY_MEMORY_LIMIT,
 		"max_rows":                 MAX_ROWS,
 		"min_rows":                 MIN_ROWS,
 		"names":                    NAMES,
@@ -727,6 +810,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"replication":              REPLICATION,
 		"require":                  REQUIRE,
 		"resignal":                 UNUSED,
+		"restore":                  RESTORE,
 		"restrict":                 RESTRICT,
 		"return":                   UNUSED,
 		"revoke":                   REVOKE,
@@ -736,6 +820,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"rlike":                    REGEXP,
 		"rollback":                 ROLLBACK,
 		"role":                     ROLE,
+		"rotate":                   ROTATE,
 		"routine":                  ROUTINE,
 		"row":						ROW,
 		"row_format":               ROW_FORMAT,
@@ -798,6 +883,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"to":                       TO,
 		"trailing":                 UNUSED,
 		"transaction":              TRANSACTION,
+		"ttl":                      TTL,
 		"trigger":                  TRIGGER,
 		"true":                     TRUE,
 		"truncate":                 TRUNCATE,
@@ -831,6 +917,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"with":                     WITH,
 		"write":                    WRITE,
 		"warnings":                 WARNINGS,
+		"grants":                   GRANTS,
 		"work":                     WORK,
 		"xor":                      XOR,
 		"x509":                     X509,
@@ -994,6 +1081,7 @@ var (
 	NEVER                    int
 	INDEXES                  int
 	WARNINGS                 int
+	GRANTS                   int
 	ERRORS                   int
 	OPEN                     int
 	FIELDS                   int
@@ -1003,6 +1091,8 @@ var (
 	MAX_UPDATES_PER_HOUR     int
 	MAX_CONNECTIONS_PER_HOUR int
 	MAX_USER_CONNECTIONS     int
+	MAX_EXECUTION_TIME       int
+	QUERY_MEMORY_LIMIT       int
 	CIPHER                   int
 	ISSUER                   int
 	X509                     int
@@ -1254,6 +1344,12 @@ var (
 	HEADER					 int
 	MAX_FILE_SIZE			 int
 	FORCE_QUOTE				 int
+	BACKUP                   int
+	KILL                     int
+	ADMIN                    int
+	TTL                      int
+	ROTATE                   int
+	RESTORE                  int
 	STARTING                 int
 	LINES                    int
 	UNUSED                   int
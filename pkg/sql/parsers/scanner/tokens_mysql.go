@@ -303,101 +303,110 @@ const MYSQL_MAX_QUERIES_PER_HOUR = 57644
 const MYSQL_MAX_UPDATES_PER_HOUR = 57645
 const MYSQL_MAX_CONNECTIONS_PER_HOUR = 57646
 const MYSQL_MAX_USER_CONNECTIONS = 57647
-const MYSQL_FORMAT = 57648
-const MYSQL_CONNECTION = 57649
-const MYSQL_LOAD = 57650
-const MYSQL_INFILE = 57651
-const MYSQL_TERMINATED = 57652
-const MYSQL_OPTIONALLY = 57653
-const MYSQL_ENCLOSED = 57654
-const MYSQL_ESCAPED = 57655
-const MYSQL_STARTING = 57656
-const MYSQL_LINES = 57657
-const MYSQL_DATABASES = 57658
-const MYSQL_TABLES = 57659
-const MYSQL_EXTENDED = 57660
-const MYSQL_FULL = 57661
-const MYSQL_PROCESSLIST = 57662
-const MYSQL_FIELDS = 57663
-const MYSQL_COLUMNS = 57664
-const MYSQL_OPEN = 57665
-const MYSQL_ERRORS = 57666
-const MYSQL_WARNINGS = 57667
-const MYSQL_INDEXES = 57668
-const MYSQL_NAMES = 57669
-const MYSQL_GLOBAL = 57670
-const MYSQL_SESSION = 57671
-const MYSQL_ISOLATION = 57672
-const MYSQL_LEVEL = 57673
-const MYSQL_READ = 57674
-const MYSQL_WRITE = 57675
-const MYSQL_ONLY = 57676
-const MYSQL_REPEATABLE = 57677
-const MYSQL_COMMITTED = 57678
-const MYSQL_UNCOMMITTED = 57679
-const MYSQL_SERIALIZABLE = 57680
-const MYSQL_LOCAL = 57681
-const MYSQL_EXCEPT = 57682
-const MYSQL_CURRENT_TIMESTAMP = 57683
-const MYSQL_DATABASE = 57684
-const MYSQL_CURRENT_TIME = 57685
-const MYSQL_LOCALTIME = 57686
-const MYSQL_LOCALTIMESTAMP = 57687
-const MYSQL_UTC_DATE = 57688
-const MYSQL_UTC_TIME = 57689
-const MYSQL_UTC_TIMESTAMP = 57690
-const MYSQL_REPLACE = 57691
-const MYSQL_CONVERT = 57692
-const MYSQL_SEPARATOR = 57693
-const MYSQL_CURRENT_DATE = 57694
-const MYSQL_CURRENT_USER = 57695
-const MYSQL_CURRENT_ROLE = 57696
-const MYSQL_MATCH = 57697
-const MYSQL_AGAINST = 57698
-const MYSQL_BOOLEAN = 57699
-const MYSQL_LANGUAGE = 57700
-const MYSQL_WITH = 57701
-const MYSQL_QUERY = 57702
-const MYSQL_EXPANSION = 57703
-const MYSQL_ADDDATE = 57704
-const MYSQL_BIT_AND = 57705
-const MYSQL_BIT_OR = 57706
-const MYSQL_BIT_XOR = 57707
-const MYSQL_CAST = 57708
-const MYSQL_COUNT = 57709
-const MYSQL_APPROX_COUNT_DISTINCT = 57710
-const MYSQL_APPROX_PERCENTILE = 57711
-const MYSQL_CURDATE = 57712
-const MYSQL_CURTIME = 57713
-const MYSQL_DATE_ADD = 57714
-const MYSQL_DATE_SUB = 57715
-const MYSQL_EXTRACT = 57716
-const MYSQL_GROUP_CONCAT = 57717
-const MYSQL_MAX = 57718
-const MYSQL_MID = 57719
-const MYSQL_MIN = 57720
-const MYSQL_NOW = 57721
-const MYSQL_POSITION = 57722
-const MYSQL_SESSION_USER = 57723
-const MYSQL_STD = 57724
-const MYSQL_STDDEV = 57725
-const MYSQL_STDDEV_POP = 57726
-const MYSQL_STDDEV_SAMP = 57727
-const MYSQL_SUBDATE = 57728
-const MYSQL_SUBSTR = 57729
-const MYSQL_SUBSTRING = 57730
-const MYSQL_SUM = 57731
-const MYSQL_SYSDATE = 57732
-const MYSQL_SYSTEM_USER = 57733
-const MYSQL_TRANSLATE = 57734
-const MYSQL_TRIM = 57735
-const MYSQL_VARIANCE = 57736
-const MYSQL_VAR_POP = 57737
-const MYSQL_VAR_SAMP = 57738
-const MYSQL_AVG = 57739
-const MYSQL_ROW = 57740
-const MYSQL_OUTFILE = 57741
-const MYSQL_HEADER = 57742
-const MYSQL_MAX_FILE_SIZE = 57743
-const MYSQL_FORCE_QUOTE = 57744
-const MYSQL_UNUSED = 57745
+const MYSQL_MAX_EXECUTION_TIME = 57648
+const MYSQL_QUERY_MEMORY_LIMIT = 57649
+const MYSQL_FORMAT = 57650
+const MYSQL_CONNECTION = 57651
+const MYSQL_LOAD = 57652
+const MYSQL_INFILE = 57653
+const MYSQL_TERMINATED = 57654
+const MYSQL_OPTIONALLY = 57655
+const MYSQL_ENCLOSED = 57656
+const MYSQL_ESCAPED = 57657
+const MYSQL_STARTING = 57658
+const MYSQL_LINES = 57659
+const MYSQL_DATABASES = 57660
+const MYSQL_TABLES = 57661
+const MYSQL_EXTENDED = 57662
+const MYSQL_FULL = 57663
+const MYSQL_PROCESSLIST = 57664
+const MYSQL_FIELDS = 57665
+const MYSQL_COLUMNS = 57666
+const MYSQL_OPEN = 57667
+const MYSQL_ERRORS = 57668
+const MYSQL_WARNINGS = 57669
+const MYSQL_INDEXES = 57670
+const MYSQL_GRANTS = 57671
+const MYSQL_NAMES = 57672
+const MYSQL_GLOBAL = 57673
+const MYSQL_SESSION = 57674
+const MYSQL_ISOLATION = 57675
+const MYSQL_LEVEL = 57676
+const MYSQL_READ = 57677
+const MYSQL_WRITE = 57678
+const MYSQL_ONLY = 57679
+const MYSQL_REPEATABLE = 57680
+const MYSQL_COMMITTED = 57681
+const MYSQL_UNCOMMITTED = 57682
+const MYSQL_SERIALIZABLE = 57683
+const MYSQL_LOCAL = 57684
+const MYSQL_EXCEPT = 57685
+const MYSQL_CURRENT_TIMESTAMP = 57686
+const MYSQL_DATABASE = 57687
+const MYSQL_CURRENT_TIME = 57688
+const MYSQL_LOCALTIME = 57689
+const MYSQL_LOCALTIMESTAMP = 57690
+const MYSQL_UTC_DATE = 57691
+const MYSQL_UTC_TIME = 57692
+const MYSQL_UTC_TIMESTAMP = 57693
+const MYSQL_REPLACE = 57694
+const MYSQL_CONVERT = 57695
+const MYSQL_SEPARATOR = 57696
+const MYSQL_CURRENT_DATE = 57697
+const MYSQL_CURRENT_USER = 57698
+const MYSQL_CURRENT_ROLE = 57699
+const MYSQL_MATCH = 57700
+const MYSQL_AGAINST = 57701
+const MYSQL_BOOLEAN = 57702
+const MYSQL_LANGUAGE = 57703
+const MYSQL_WITH = 57704
+const MYSQL_QUERY = 57705
+const MYSQL_EXPANSION = 57706
+const MYSQL_ADDDATE = 57707
+const MYSQL_BIT_AND = 57708
+const MYSQL_BIT_OR = 57709
+const MYSQL_BIT_XOR = 57710
+const MYSQL_CAST = 57711
+const MYSQL_COUNT = 57712
+const MYSQL_APPROX_COUNT_DISTINCT = 57713
+const MYSQL_APPROX_PERCENTILE = 57714
+const MYSQL_CURDATE = 57715
+const MYSQL_CURTIME = 57716
+const MYSQL_DATE_ADD = 57717
+const MYSQL_DATE_SUB = 57718
+const MYSQL_EXTRACT = 57719
+const MYSQL_GROUP_CONCAT = 57720
+const MYSQL_MAX = 57721
+const MYSQL_MID = 57722
+const MYSQL_MIN = 57723
+const MYSQL_NOW = 57724
+const MYSQL_POSITION = 57725
+const MYSQL_SESSION_USER = 57726
+const MYSQL_STD = 57727
+const MYSQL_STDDEV = 57728
+const MYSQL_STDDEV_POP = 57729
+const MYSQL_STDDEV_SAMP = 57730
+const MYSQL_SUBDATE = 57731
+const MYSQL_SUBSTR = 57732
+const MYSQL_SUBSTRING = 57733
+const MYSQL_SUM = 57734
+const MYSQL_SYSDATE = 57735
+const MYSQL_SYSTEM_USER = 57736
+const MYSQL_TRANSLATE = 57737
+const MYSQL_TRIM = 57738
+const MYSQL_VARIANCE = 57739
+const MYSQL_VAR_POP = 57740
+const MYSQL_VAR_SAMP = 57741
+const MYSQL_AVG = 57742
+const MYSQL_ROW = 57743
+const MYSQL_OUTFILE = 57744
+const MYSQL_HEADER = 57745
+const MYSQL_MAX_FILE_SIZE = 57746
+const MYSQL_FORCE_QUOTE = 57747
+const MYSQL_BACKUP = 57748
+const MYSQL_RESTORE = 57749
+const MYSQL_KILL = 57750
+const MYSQL_ADMIN = 57751
+const MYSQL_ROTATE = 57752
+const MYSQL_TTL = 57753
+const MYSQL_UNUSED = 57754
@@ -28,7 +28,7 @@ func Parse(dialectType dialect.DialectType, sql string) ([]tree.Statement, error
 	case dialect.MYSQL:
 		return mysql.Parse(sql)
 	case dialect.POSTGRESQL:
-		return postgresql.Parse(sql)
+		return parsePostgresql(sql)
 	default:
 		return nil, errors.New("type of dialect error")
 	}
@@ -39,8 +39,62 @@ func ParseOne(dialectType dialect.DialectType, sql string) (tree.Statement, erro
 	case dialect.MYSQL:
 		return mysql.ParseOne(sql)
 	case dialect.POSTGRESQL:
-		return postgresql.ParseOne(sql)
+		stmts, err := parsePostgresql(sql)
+		if err != nil {
+			return nil, err
+		}
+		if len(stmts) != 1 {
+			return nil, errors.New("syntax error, or too many sql to parse")
+		}
+		return stmts[0], nil
 	default:
 		return nil, errors.New("type of dialect error")
 	}
 }
+
+// postgresqlFallback is the kinds of the statements which the PostgreSQL
+// grammar does not cover and are parsed by the MySQL grammar instead, by
+// their first keyword.
+var postgresqlFallback = map[string]struct{}{
+	"select":   {},
+	"insert":   {},
+	"update":   {},
+	"delete":   {},
+	"create":   {},
+	"drop":     {},
+	"alter":    {},
+	"truncate": {},
+	"show":     {},
+	"set":      {},
+	"begin":    {},
+	"start":    {},
+	"commit":   {},
+	"rollback": {},
+	"explain":  {},
+	"prepare":  {},
+	"execute":  {},
+}
+
+/*
+parsePostgresql parses the sql with the PostgreSQL grammar. The grammar covers
+a few statements only, the statements of the kinds in postgresqlFallback are
+parsed by the MySQL grammar after the lexical conventions of PostgreSQL are
+translated. The error of the PostgreSQL grammar is returned for the others.
+*/
+func parsePostgresql(sql string) ([]tree.Statement, error) {
+	stmts, err := postgresql.Parse(sql)
+	if err == nil {
+		return stmts, nil
+	}
+	translated := postgresql.Translate(sql)
+	keywords := postgresql.Keywords(translated)
+	if len(keywords) == 0 {
+		return nil, err
+	}
+	for _, keyword := range keywords {
+		if _, ok := postgresqlFallback[keyword]; !ok {
+			return nil, err
+		}
+	}
+	return mysql.Parse(translated)
+}
@@ -15,11 +15,13 @@
 package parsers
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/postgresql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -61,3 +63,77 @@ func TestPostgresql(t *testing.T) {
 		t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", debugSQL.output, out)
 	}
 }
+
+func TestPostgresqlFallback(t *testing.T) {
+	cases := []struct {
+		input  string
+		output string
+	}{
+		{"use db1", "use db1"},
+		{`select "a" from "t" where b = 'x\y'`, `select a from t where b = x\y`},
+		{`select a from t where b = E'x\'y'`, `select a from t where b = x'y`},
+		{`select "a""b" from t`, "select a\"b from t"},
+		{"select a from t -- \"comment\"", "select a from t"},
+	}
+	for _, c := range cases {
+		ast, err := ParseOne(dialect.POSTGRESQL, c.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", c.input, err)
+			continue
+		}
+		out := tree.String(ast, dialect.MYSQL)
+		if c.output != out {
+			t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", c.output, out)
+		}
+	}
+}
+
+func TestPostgresqlNoFallback(t *testing.T) {
+	_, pgErr := postgresql.ParseOne("use db1 db2")
+	cases := []struct {
+		input string
+		err   string
+	}{
+		// the statements of PostgreSQL report the error of its grammar
+		{"use db1 db2", pgErr.Error()},
+		// the statements of MySQL only are not parsed
+		{"kill 1", ""},
+		{"load data infile 'a.csv' into table t", ""},
+		{"select 1; kill 1", ""},
+	}
+	for _, c := range cases {
+		_, err := ParseOne(dialect.POSTGRESQL, c.input)
+		if err == nil {
+			t.Errorf("Parse(%q) succeeded", c.input)
+			continue
+		}
+		if _, ok := err.(scanner.PositionedErr); !ok {
+			t.Errorf("Parse(%q) err: %v, want the error of the PostgreSQL grammar", c.input, err)
+		}
+		if c.err != "" && err.Error() != c.err {
+			t.Errorf("Parse(%q) err: %v, want %v", c.input, err, c.err)
+		}
+	}
+}
+
+func TestParseDialectsConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(d dialect.DialectType) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				ast, err := ParseOne(d, "use db1")
+				if err != nil {
+					t.Errorf("Parse(%q) err: %v", "use db1", err)
+					return
+				}
+				if out := tree.String(ast, d); out != "use db1" {
+					t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", "use db1", out)
+					return
+				}
+			}
+		}(dialect.MYSQL + dialect.DialectType(i%2))
+	}
+	wg.Wait()
+}
@@ -82,3 +82,188 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+//ALTER TABLE statement
+type AlterTable struct {
+	statementImpl
+	Table TableName
+	Specs []AlterTableSpec
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, s := range node.Specs {
+		ctx.WriteString(prefix)
+		s.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t TableName, s []AlterTableSpec) *AlterTable {
+	return &AlterTable{
+		Table: t,
+		Specs: s,
+	}
+}
+
+//the change of an ALTER TABLE statement
+type AlterTableSpec interface {
+	NodeFormatter
+}
+
+type alterTableSpecImpl struct {
+	AlterTableSpec
+}
+
+//ADD COLUMN
+type AlterTableAddColumn struct {
+	alterTableSpecImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterTableAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+}
+
+func NewAlterTableAddColumn(c *ColumnTableDef) *AlterTableAddColumn {
+	return &AlterTableAddColumn{
+		Column: c,
+	}
+}
+
+//DROP COLUMN
+type AlterTableDropColumn struct {
+	alterTableSpecImpl
+	Name Identifier
+}
+
+func (node *AlterTableDropColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop column ")
+	node.Name.Format(ctx)
+}
+
+func NewAlterTableDropColumn(n Identifier) *AlterTableDropColumn {
+	return &AlterTableDropColumn{
+		Name: n,
+	}
+}
+
+//RENAME COLUMN
+type AlterTableRenameColumn struct {
+	alterTableSpecImpl
+	Name    Identifier
+	NewName Identifier
+}
+
+func (node *AlterTableRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	node.Name.Format(ctx)
+	ctx.WriteString(" to ")
+	node.NewName.Format(ctx)
+}
+
+func NewAlterTableRenameColumn(n, nn Identifier) *AlterTableRenameColumn {
+	return &AlterTableRenameColumn{
+		Name:    n,
+		NewName: nn,
+	}
+}
+
+//ALTER COLUMN SET DEFAULT or DROP DEFAULT, the default is nil for DROP DEFAULT
+type AlterTableAlterColumn struct {
+	alterTableSpecImpl
+	Name    Identifier
+	Default Expr
+}
+
+func (node *AlterTableAlterColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter column ")
+	node.Name.Format(ctx)
+	if node.Default == nil {
+		ctx.WriteString(" drop default")
+		return
+	}
+	ctx.WriteString(" set default ")
+	node.Default.Format(ctx)
+}
+
+func NewAlterTableAlterColumn(n Identifier, d Expr) *AlterTableAlterColumn {
+	return &AlterTableAlterColumn{
+		Name:    n,
+		Default: d,
+	}
+}
+
+//RENAME TO
+type AlterTableRename struct {
+	alterTableSpecImpl
+	Name TableName
+}
+
+func (node *AlterTableRename) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename to ")
+	node.Name.Format(ctx)
+}
+
+func NewAlterTableRename(n TableName) *AlterTableRename {
+	return &AlterTableRename{
+		Name: n,
+	}
+}
+
+type AlterTableAddPartition struct {
+	alterTableSpecImpl
+	Partitions []*Partition
+}
+
+func (node *AlterTableAddPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("add partition (")
+	for i, p := range node.Partitions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		p.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewAlterTableAddPartition(ps []*Partition) *AlterTableAddPartition {
+	return &AlterTableAddPartition{
+		Partitions: ps,
+	}
+}
+
+type AlterTableDropPartition struct {
+	alterTableSpecImpl
+	Names IdentifierList
+}
+
+func (node *AlterTableDropPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop partition ")
+	node.Names.Format(ctx)
+}
+
+func NewAlterTableDropPartition(ns IdentifierList) *AlterTableDropPartition {
+	return &AlterTableDropPartition{
+		Names: ns,
+	}
+}
+
+type AlterTableTruncatePartition struct {
+	alterTableSpecImpl
+	Names IdentifierList
+}
+
+func (node *AlterTableTruncatePartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("truncate partition ")
+	node.Names.Format(ctx)
+}
+
+func NewAlterTableTruncatePartition(ns IdentifierList) *AlterTableTruncatePartition {
+	return &AlterTableTruncatePartition{
+		Names: ns,
+	}
+}
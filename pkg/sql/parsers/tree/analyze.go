@@ -24,9 +24,11 @@ type AnalyzeStmt struct {
 func (node *AnalyzeStmt) Format(ctx *FmtCtx) {
 	ctx.WriteString("analyze table ")
 	node.Table.Format(ctx)
-	ctx.WriteString("(")
-	node.Cols.Format(ctx)
-	ctx.WriteString(")")
+	if len(node.Cols) > 0 {
+		ctx.WriteString("(")
+		node.Cols.Format(ctx)
+		ctx.WriteString(")")
+	}
 }
 
 func NewAnalyzeStmt(tbl *TableName, cols IdentifierList) *AnalyzeStmt {
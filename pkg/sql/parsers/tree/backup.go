@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// BackupDatabase writes all tables of a database into an archive file
+type BackupDatabase struct {
+	statementImpl
+	Name Identifier
+	Path string
+}
+
+func (node *BackupDatabase) Format(ctx *FmtCtx) {
+	ctx.WriteString("backup database ")
+	node.Name.Format(ctx)
+	ctx.WriteString(" to ")
+	ctx.WriteString(node.Path)
+}
+
+func NewBackupDatabase(name Identifier, path string) *BackupDatabase {
+	return &BackupDatabase{
+		Name: name,
+		Path: path,
+	}
+}
+
+// RestoreDatabase creates a database from an archive file written by BackupDatabase
+type RestoreDatabase struct {
+	statementImpl
+	Name Identifier
+	Path string
+}
+
+func (node *RestoreDatabase) Format(ctx *FmtCtx) {
+	ctx.WriteString("restore database ")
+	node.Name.Format(ctx)
+	ctx.WriteString(" from ")
+	ctx.WriteString(node.Path)
+}
+
+func NewRestoreDatabase(name Identifier, path string) *RestoreDatabase {
+	return &RestoreDatabase{
+		Name: name,
+		Path: path,
+	}
+}
@@ -41,6 +41,11 @@ type NumVal struct {
 }
 
 func (node *NumVal) Format(ctx *FmtCtx) {
+	//the star of count(*) is a NumVal too
+	if ctx.normalize && node.Value.Kind() != constant.Unknown && node.origString != "*" {
+		ctx.WriteByte('?')
+		return
+	}
 	if node.origString != "" {
 		ctx.WriteString(node.origString)
 		return
@@ -94,6 +99,10 @@ type StrVal struct {
 }
 
 func (node *StrVal) Format(ctx *FmtCtx) {
+	if ctx.normalize {
+		ctx.WriteByte('?')
+		return
+	}
 	ctx.WriteString(node.str)
 }
 
@@ -1047,6 +1047,28 @@ func NewTableOptionAutoIncrement(v uint64) *TableOptionAutoIncrement {
 	}
 }
 
+// TableOptionTTL expires the rows whose Column is older than Days days
+type TableOptionTTL struct {
+	tableOptionImpl
+	Column string
+	Days   uint64
+}
+
+func (node *TableOptionTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("ttl = ")
+	ctx.WriteString(node.Column)
+	ctx.WriteString(" + interval ")
+	ctx.WriteString(strconv.FormatUint(node.Days, 10))
+	ctx.WriteString(" day")
+}
+
+func NewTableOptionTTL(c string, d uint64) *TableOptionTTL {
+	return &TableOptionTTL{
+		Column: c,
+		Days:   d,
+	}
+}
+
 type TableOptionComment struct {
 	tableOptionImpl
 	Comment string
@@ -1613,6 +1635,23 @@ type ValuesIn struct {
 	ValueList []Exprs
 }
 
+func (node *ValuesIn) Format(ctx *FmtCtx) {
+	ctx.WriteString("values in (")
+	for i, vl := range node.ValueList {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		if len(vl) > 1 {
+			ctx.WriteByte('(')
+		}
+		vl.Format(ctx)
+		if len(vl) > 1 {
+			ctx.WriteByte(')')
+		}
+	}
+	ctx.WriteByte(')')
+}
+
 func NewValuesIn(vl []Exprs) *ValuesIn {
 	return &ValuesIn{
 		ValueList: vl,
@@ -2056,6 +2095,30 @@ func (node *ResourceOptionMaxUserConnections) Format(ctx *FmtCtx) {
 	ctx.WriteString(strconv.FormatInt(node.Count, 10))
 }
 
+// ResourceOptionMaxExecutionTime is the default max_execution_time of the
+// statements of the user, in milliseconds.
+type ResourceOptionMaxExecutionTime struct {
+	resourceOptionImpl
+	Millis int64
+}
+
+func (node *ResourceOptionMaxExecutionTime) Format(ctx *FmtCtx) {
+	ctx.WriteString("max_execution_time ")
+	ctx.WriteString(strconv.FormatInt(node.Millis, 10))
+}
+
+// ResourceOptionQueryMemoryLimit is the default query_memory_limit of the
+// statements of the user, in bytes.
+type ResourceOptionQueryMemoryLimit struct {
+	resourceOptionImpl
+	Size int64
+}
+
+func (node *ResourceOptionQueryMemoryLimit) Format(ctx *FmtCtx) {
+	ctx.WriteString("query_memory_limit ")
+	ctx.WriteString(strconv.FormatInt(node.Size, 10))
+}
+
 type UserMiscOption interface {
 	NodeFormatter
 }
@@ -2206,3 +2269,37 @@ func NewCreateUser(ife bool, u []*User, r []*Role, tls []TlsOption, res []Resour
 		MiscOpts:    misc,
 	}
 }
+
+//CREATE VIEW statement
+type CreateView struct {
+	statementImpl
+	Replace  bool
+	Name     *TableName
+	ColNames IdentifierList
+	AsSource *Select
+}
+
+func (node *CreateView) Format(ctx *FmtCtx) {
+	ctx.WriteString("create ")
+	if node.Replace {
+		ctx.WriteString("or replace ")
+	}
+	ctx.WriteString("view ")
+	node.Name.Format(ctx)
+	if len(node.ColNames) > 0 {
+		ctx.WriteString(" (")
+		node.ColNames.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteString(" as ")
+	node.AsSource.Format(ctx)
+}
+
+func NewCreateView(r bool, n *TableName, c IdentifierList, s *Select) *CreateView {
+	return &CreateView{
+		Replace:  r,
+		Name:     n,
+		ColNames: c,
+		AsSource: s,
+	}
+}
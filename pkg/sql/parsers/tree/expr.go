@@ -588,6 +588,10 @@ type Tuple struct {
 }
 
 func (node *Tuple) Format(ctx *FmtCtx) {
+	if ctx.normalize && isValueList(node.Exprs) {
+		ctx.WriteString("(...)")
+		return
+	}
 	if node.Exprs != nil {
 		ctx.WriteByte('(')
 		node.Exprs.Format(ctx)
@@ -595,6 +599,19 @@ func (node *Tuple) Format(ctx *FmtCtx) {
 	}
 }
 
+// isValueList returns true if all of the exprs are literals
+func isValueList(exprs Exprs) bool {
+	if len(exprs) == 0 {
+		return false
+	}
+	for _, e := range exprs {
+		if !IsValue(e) {
+			return false
+		}
+	}
+	return true
+}
+
 func NewTuple(e Exprs) *Tuple {
 	return &Tuple{Exprs: e}
 }
@@ -761,6 +778,10 @@ type MaxValue struct {
 	exprImpl
 }
 
+func (node *MaxValue) Format(ctx *FmtCtx) {
+	ctx.WriteString("maxvalue")
+}
+
 func NewMaxValue() *MaxValue {
 	return &MaxValue{}
 }
@@ -24,6 +24,8 @@ import (
 type FmtCtx struct {
 	*strings.Builder
 	dialectType   dialect.DialectType
+	// normalize, the literals are replaced with '?'.
+	normalize bool
 }
 
 func NewFmtCtx(dialectType dialect.DialectType) *FmtCtx {
@@ -48,6 +50,22 @@ func String(node NodeFormatter, dialectType dialect.DialectType) string {
 	return ctx.String()
 }
 
+/*
+Normalize returns the text of the node whose literals are replaced with '?',
+the lists of literals are collapsed to (...) and only the first row of VALUES
+is kept. So the statements which differ in the literals only have the same text.
+*/
+func Normalize(node NodeFormatter, dialectType dialect.DialectType) string {
+	if node == nil {
+		return "<nil>"
+	}
+
+	ctx := NewFmtCtx(dialectType)
+	ctx.normalize = true
+	node.Format(ctx)
+	return ctx.String()
+}
+
 func (ctx *FmtCtx) PrintExpr(currentExpr Expr, expr Expr, left bool) {
 	if precedenceFor(currentExpr) == Syntactic {
 		expr.Format(ctx)
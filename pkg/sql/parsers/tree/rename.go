@@ -0,0 +1,55 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+//RENAME TABLE statement
+type RenameTable struct {
+	statementImpl
+	Pairs []*RenameTablePair
+}
+
+func (node *RenameTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename table ")
+	prefix := ""
+	for _, p := range node.Pairs {
+		ctx.WriteString(prefix)
+		p.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewRenameTable(p []*RenameTablePair) *RenameTable {
+	return &RenameTable{
+		Pairs: p,
+	}
+}
+
+type RenameTablePair struct {
+	From TableName
+	To   TableName
+}
+
+func (node *RenameTablePair) Format(ctx *FmtCtx) {
+	node.From.Format(ctx)
+	ctx.WriteString(" to ")
+	node.To.Format(ctx)
+}
+
+func NewRenameTablePair(f, t TableName) *RenameTablePair {
+	return &RenameTablePair{
+		From: f,
+		To:   t,
+	}
+}
@@ -26,6 +26,7 @@ type SelectStatement interface {
 // Select represents a SelectStatement with an ORDER and/or LIMIT.
 type Select struct {
 	statementImpl
+	With    *With
 	Select  SelectStatement
 	OrderBy OrderBy
 	Limit   *Limit
@@ -33,6 +34,10 @@ type Select struct {
 }
 
 func (node *Select) Format(ctx *FmtCtx) {
+	if node.With != nil {
+		node.With.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	node.Select.Format(ctx)
 	if len(node.OrderBy) > 0 {
 		ctx.WriteByte(' ')
@@ -56,6 +61,40 @@ func NewSelect(s SelectStatement, o OrderBy, l *Limit) *Select {
 	}
 }
 
+// With represents a WITH clause of common table expressions.
+type With struct {
+	Ctes []*CTE
+}
+
+func (node *With) Format(ctx *FmtCtx) {
+	ctx.WriteString("with ")
+	for i, cte := range node.Ctes {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		cte.Format(ctx)
+	}
+}
+
+// CTE represents a common table expression, name [(cols)] as (stmt).
+type CTE struct {
+	Name Identifier
+	Cols IdentifierList
+	Stmt *Select
+}
+
+func (node *CTE) Format(ctx *FmtCtx) {
+	ctx.WriteString(string(node.Name))
+	if len(node.Cols) > 0 {
+		ctx.WriteString(" (")
+		node.Cols.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteString(" as (")
+	node.Stmt.Format(ctx)
+	ctx.WriteByte(')')
+}
+
 // OrderBy represents an ORDER BY clause.
 type OrderBy []*Order
 
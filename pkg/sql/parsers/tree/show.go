@@ -37,6 +37,21 @@ func NewShowCreate(n *UnresolvedObjectName) *ShowCreateTable {
 	return &ShowCreateTable{Name: n}
 }
 
+//SHOW CREATE VIEW statement
+type ShowCreateView struct {
+	showImpl
+	Name *UnresolvedObjectName
+}
+
+func (node *ShowCreateView) Format(ctx *FmtCtx) {
+	ctx.WriteString("show create view ")
+	node.Name.ToTableName().Format(ctx)
+}
+
+func NewShowCreateView(n *UnresolvedObjectName) *ShowCreateView {
+	return &ShowCreateView{Name: n}
+}
+
 //SHOW CREATE DATABASE statement
 type ShowCreateDatabase struct {
 	showImpl
@@ -220,6 +235,29 @@ func NewShowWarnings() *ShowWarnings {
 	return &ShowWarnings{}
 }
 
+//SHOW GRANTS statement
+type ShowGrants struct {
+	showImpl
+	Username string
+	Hostname string
+}
+
+func (node *ShowGrants) Format(ctx *FmtCtx) {
+	ctx.WriteString("show grants")
+	if node.Username != "" {
+		ctx.WriteString(" for ")
+		ctx.WriteString(node.Username)
+		if node.Hostname != "" && node.Hostname != "%" {
+			ctx.WriteByte('@')
+			ctx.WriteString(node.Hostname)
+		}
+	}
+}
+
+func NewShowGrants(u, h string) *ShowGrants {
+	return &ShowGrants{Username: u, Hostname: h}
+}
+
 //SHOW VARIABLES statement
 //System Variables
 type ShowVariables struct {
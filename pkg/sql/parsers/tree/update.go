@@ -221,6 +221,11 @@ type Fields struct {
 	Optionally bool
 	EnclosedBy byte
 	EscapedBy  byte
+	// Enclosed is true if ENCLOSED BY is given, otherwise the EnclosedBy of
+	// SELECT ... INTO OUTFILE only quotes the FORCE_QUOTE columns
+	Enclosed bool
+	// Escaped is true if ESCAPED BY is given, ESCAPED BY '' disables escaping
+	Escaped bool
 }
 
 func (node *Fields) Format(ctx *FmtCtx) {
@@ -285,11 +290,20 @@ type LoadColumn interface {
 	NodeFormatter
 }
 
+const (
+	ExportFormatCsv     = "csv"
+	ExportFormatParquet = "parquet"
+)
+
 type ExportParam struct {
 	// outfile flag
 	Outfile bool
 	// filename path
 	FilePath string
+	// FileFormat is the file format, csv or parquet
+	FileFormat string
+	// Compression is the codec of the parquet column chunks
+	Compression string
 	// Fields
 	Fields *Fields
 	// Lines
@@ -317,6 +331,12 @@ func (ep *ExportParam) Format(ctx *FmtCtx) {
 		return
 	}
 	ctx.WriteString("into outfile " + ep.FilePath)
+	if ep.FileFormat != "" && ep.FileFormat != ExportFormatCsv {
+		ctx.WriteString(" format " + ep.FileFormat)
+	}
+	if ep.Compression != "" {
+		ctx.WriteString(" compression " + ep.Compression)
+	}
 	if ep.Fields != nil {
 		ctx.WriteByte(' ')
 		ep.Fields.Format(ctx)
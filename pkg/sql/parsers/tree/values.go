@@ -24,6 +24,10 @@ func (node *ValuesClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("values ")
 	comma := ""
 	for i := range node.Rows {
+		//the statements inserting a different number of rows have the same text
+		if ctx.normalize && i > 0 {
+			break
+		}
 		ctx.WriteString(comma)
 		ctx.WriteByte('(')
 		node.Rows[i].Format(ctx)
@@ -0,0 +1,248 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (b *build) BuildAlterTable(stmt *tree.AlterTable, plan *AlterTable) error {
+	dbName, tblName, r, err := b.baseTableName(&stmt.Table)
+	if err != nil {
+		return err
+	}
+	if err := b.checkPrivilege(dbName, tblName, privilege.Alter); err != nil {
+		r.Close()
+		return err
+	}
+	db, err := b.e.Database(dbName)
+	if err != nil {
+		r.Close()
+		return errors.New(errno.InvalidSchemaName, err.Error())
+	}
+	if err := b.buildAlterTableSpecs(stmt, dbName, db, r, plan); err != nil {
+		r.Close()
+		return err
+	}
+	plan.Id = tblName
+	plan.Db = db
+	plan.Relation = r
+	return nil
+}
+
+// buildAlterTableSpecs checks the changes in order against the columns the
+// table has after the changes before them.
+func (b *build) buildAlterTableSpecs(stmt *tree.AlterTable, dbName string, db engine.Database, r engine.Relation, plan *AlterTable) error {
+	attrs := make(map[string]engine.Attribute) // columns of the table
+	indexed := make(map[string]struct{})       // columns used by an index
+	var pdef *engine.PartitionByDef            // partitions of the table
+	for _, def := range r.TableDefs() {
+		switch d := def.(type) {
+		case *engine.AttributeDef:
+			attrs[d.Attr.Name] = d.Attr
+		case *engine.PartitionByDef:
+			pdef = d
+		case *engine.IndexTableDef:
+			for _, name := range d.ColNames {
+				indexed[name] = struct{}{}
+			}
+		}
+	}
+	for _, spec := range stmt.Specs {
+		switch s := spec.(type) {
+		case *tree.AlterTableAddColumn:
+			def, pks, err := b.getTableDef(s.Column)
+			if err != nil {
+				return err
+			}
+			if len(pks) > 0 {
+				return errors.New(errno.FeatureNotSupported, "add a primary key column is not supported now")
+			}
+			attr := def.(*engine.AttributeDef).Attr
+			if _, ok := attrs[attr.Name]; ok {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", attr.Name))
+			}
+			attrs[attr.Name] = attr
+			plan.Specs = append(plan.Specs, AlterTableSpec{Def: def})
+		case *tree.AlterTableDropColumn:
+			name := string(s.Name)
+			attr, ok := attrs[name]
+			if !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", name))
+			}
+			if _, ok := indexed[name]; ok {
+				return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("column '%s' is used by an index", name))
+			}
+			if len(attrs) == 1 {
+				return errors.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+			}
+			delete(attrs, name)
+			plan.Specs = append(plan.Specs, AlterTableSpec{Drop: true, Def: &engine.AttributeDef{Attr: attr}})
+		case *tree.AlterTableRenameColumn:
+			name, newName := string(s.Name), string(s.NewName)
+			attr, ok := attrs[name]
+			if !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", name, stmt.Table.ObjectName))
+			}
+			if _, ok := attrs[newName]; ok {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", newName))
+			}
+			delete(attrs, name)
+			attr.Name = newName
+			attrs[newName] = attr
+			if _, ok := indexed[name]; ok {
+				delete(indexed, name)
+				indexed[newName] = struct{}{}
+			}
+			plan.Specs = append(plan.Specs, AlterTableSpec{Def: &engine.RenameAttributeDef{Name: name, NewName: newName}})
+		case *tree.AlterTableAlterColumn:
+			name := string(s.Name)
+			attr, ok := attrs[name]
+			if !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", name, stmt.Table.ObjectName))
+			}
+			def := engine.EmptyDefaultExpr
+			if s.Default != nil {
+				var err error
+				if def, err = getDefaultExpr(name, attr.Type, s.Default); err != nil {
+					return err
+				}
+			}
+			attr.Default = def
+			attrs[name] = attr
+			plan.Specs = append(plan.Specs, AlterTableSpec{Def: &engine.DefaultDef{Name: name, Default: def}})
+		case *tree.AlterTableRename:
+			newDb, newName := dbName, string(s.Name.ObjectName)
+			if len(s.Name.SchemaName) > 0 {
+				newDb = string(s.Name.SchemaName)
+			}
+			if newDb != dbName {
+				return errors.New(errno.FeatureNotSupported, "rename a table to another database is not supported now")
+			}
+			if err := b.checkPrivilege(newDb, newName, privilege.Create|privilege.Insert); err != nil {
+				return err
+			}
+			if r, err := db.Relation(newName); err == nil {
+				r.Close()
+				return errors.New(errno.DuplicateTable, fmt.Sprintf("Table '%s' already exists", newName))
+			}
+			plan.NewId = newName
+		case *tree.AlterTableAddPartition:
+			if pdef == nil {
+				return errors.New(errno.InvalidTableDefinition, "Partition management on a not partitioned table is not possible")
+			}
+			def := &engine.PartitionByDef{
+				Fields: pdef.Fields,
+				List:   append([]engine.ListPartition{}, pdef.List...),
+				Range:  append([]engine.RangePartition{}, pdef.Range...),
+			}
+			if err := addPartitions(def, attrs[pdef.Fields[0]], s.Partitions, len(pdef.List) > 0); err != nil {
+				return err
+			}
+			plan.Specs = append(plan.Specs, AlterTableSpec{Def: &engine.PartitionByDef{
+				Fields: pdef.Fields,
+				List:   def.List[len(pdef.List):],
+				Range:  def.Range[len(pdef.Range):],
+			}})
+			pdef = def
+		case *tree.AlterTableDropPartition:
+			if pdef == nil {
+				return errors.New(errno.InvalidTableDefinition, "Partition management on a not partitioned table is not possible")
+			}
+			if err := checkPartitionNames(pdef, s.Names); err != nil {
+				return err
+			}
+			def := &engine.PartitionByDef{Fields: pdef.Fields}
+			for _, p := range pdef.List {
+				if !nameIn(p.Name, s.Names) {
+					def.List = append(def.List, p)
+				}
+			}
+			for _, p := range pdef.Range {
+				if !nameIn(p.Name, s.Names) {
+					def.Range = append(def.Range, p)
+				}
+			}
+			if def.Len() == 0 {
+				return errors.New(errno.InvalidTableDefinition, "Cannot remove all partitions, use DROP TABLE instead")
+			}
+			for _, name := range s.Names {
+				plan.Specs = append(plan.Specs, AlterTableSpec{Drop: true, Def: &engine.PartitionDef{Name: string(name)}})
+			}
+			pdef = def
+		case *tree.AlterTableTruncatePartition:
+			if pdef == nil {
+				return errors.New(errno.InvalidTableDefinition, "Partition management on a not partitioned table is not possible")
+			}
+			if err := checkPartitionNames(pdef, s.Names); err != nil {
+				return err
+			}
+			for _, name := range s.Names {
+				plan.Specs = append(plan.Specs, AlterTableSpec{Def: &engine.TruncatePartitionDef{Name: string(name)}})
+			}
+		default:
+			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport alter table spec: '%v'", spec))
+		}
+	}
+	return nil
+}
+
+func (b *build) BuildRenameTable(stmt *tree.RenameTable, plan *RenameTable) error {
+	for _, pair := range stmt.Pairs {
+		db, newDb := b.db, b.db
+		if len(pair.From.SchemaName) > 0 {
+			db = string(pair.From.SchemaName)
+		}
+		if len(pair.To.SchemaName) > 0 {
+			newDb = string(pair.To.SchemaName)
+		}
+		if newDb != db {
+			return errors.New(errno.FeatureNotSupported, "rename a table to another database is not supported now")
+		}
+		id, newId := string(pair.From.ObjectName), string(pair.To.ObjectName)
+		if err := b.checkPrivilege(db, id, privilege.Alter|privilege.Drop); err != nil {
+			return err
+		}
+		if err := b.checkPrivilege(newDb, newId, privilege.Create|privilege.Insert); err != nil {
+			return err
+		}
+		plan.Dbs = append(plan.Dbs, db)
+		plan.Ids = append(plan.Ids, id)
+		plan.NewIds = append(plan.NewIds, newId)
+	}
+	return nil
+}
+
+// getDefaultExpr returns the default expr of ALTER COLUMN SET DEFAULT
+func getDefaultExpr(name string, typ types.Type, expr tree.Expr) (engine.DefaultExpr, error) {
+	if isNullExpr(expr) {
+		return engine.MakeDefaultExpr(true, nil, true), nil
+	}
+	value, err := buildConstant(typ, expr)
+	if err != nil {
+		return engine.EmptyDefaultExpr, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", name))
+	}
+	if _, err = rangeCheck(value, typ, "", 0); err != nil {
+		return engine.EmptyDefaultExpr, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", name))
+	}
+	return engine.MakeDefaultExpr(true, value, false), nil
+}
@@ -30,6 +30,7 @@ func New(db string, sql string, e engine.Engine) *build {
 		db:  db,
 		sql: sql,
 		flg: true,
+		tmp: &temps{views: make(map[string]*cte)},
 	}
 }
 
@@ -45,6 +46,7 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		qry.backFill()
+		qry.Temps = b.tmp.ts
 		return qry, nil
 	case *tree.ParenSelect:
 		qry := &Query{
@@ -56,6 +58,7 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		qry.backFill()
+		qry.Temps = b.tmp.ts
 		return qry, nil
 	case *tree.Insert:
 		plan := &Insert{}
@@ -63,6 +66,12 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.Delete:
+		plan := &Delete{}
+		if err := b.BuildDelete(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *tree.CreateDatabase:
 		plan := &CreateDatabase{E: b.e}
 		if err := b.BuildCreateDatabase(stmt, plan); err != nil {
@@ -87,12 +96,36 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.AlterTable:
+		plan := &AlterTable{}
+		if err := b.BuildAlterTable(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.RenameTable:
+		plan := &RenameTable{E: b.e}
+		if err := b.BuildRenameTable(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *tree.CreateIndex:
 		plan := &CreateIndex{}
 		if err := b.BuildCreateIndex(stmt, plan); err != nil {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.CreateView:
+		plan := &CreateView{}
+		if err := b.BuildCreateView(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.DropView:
+		plan := &DropView{E: b.e}
+		if err := b.BuildDropView(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *tree.DropIndex:
 		plan := &DropIndex{}
 		if err := b.BuildDropIndex(stmt, plan); err != nil {
@@ -122,6 +155,15 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 		if err := b.BuildShowCreateTable(stmt, plan); err != nil {
 			return nil, err
 		}
+		if engine.GetViewDef(plan.Relation.TableDefs()) != nil { // a view is shown as SHOW CREATE VIEW does
+			return &ShowCreateView{Relation: plan.Relation, ViewName: plan.Relation.ID()}, nil
+		}
+		return plan, nil
+	case *tree.ShowCreateView:
+		plan := &ShowCreateView{}
+		if err := b.BuildShowCreateView(stmt, plan); err != nil {
+			return nil, err
+		}
 		return plan, nil
 	case *tree.ShowCreateDatabase:
 		plan := &ShowCreateDatabase{}
@@ -16,12 +16,15 @@ package plan
 
 import (
 	"fmt"
-	"log"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"log"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 var querys = []string{
@@ -57,6 +60,7 @@ var querys = []string{
 	"select * from t1 where spID>2 AND userID <2 || userID >=2 OR userID < 2 limit 3;",
 	"select * from t1 where (spID >2  or spID <= 2) && score <> 1 AND userID/2>2;",
 	"select * from t1 where spID >2  || spID <= 2 && score !=1 limit 3;",
+	"select * from t1 where userID in (1, 2, 3) and spID not in (4, 5);",
 
 	`select
 		sum(lo_revenue) as revenue
@@ -187,6 +191,113 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestBuildWithPrivilege(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	pc := privilege.NewChecker("u", "%", []privilege.Grant{
+		{Database: "test", Table: "R", Privileges: privilege.Select},
+		{Database: "test", Table: "t1", Privileges: privilege.Select | privilege.Insert},
+		{Database: "test", Table: "S", Privileges: privilege.Alter | privilege.Drop},
+	})
+	for sql, allowed := range map[string]bool{
+		"select * from R":                   true,
+		"select * from R join S using(uid)": false,
+		"insert into t1 values (1, 1, 1)":   true,
+		"insert into R values (1, 1, 1)":    false,
+		"create table t2 (a int)":           false,
+		"drop table t1":                     false,
+		"create database db":                false,
+		"alter table S add column x int":    true,
+		"alter table R add column x int":    false,
+		"rename table S to S2":              false,
+	} {
+		stmts, err := parsers.Parse(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		_, err = New("test", sql, e).WithPrivilegeChecker(pc).BuildStatement(stmts[0])
+		if allowed {
+			require.NoError(t, err, sql)
+		} else {
+			require.Error(t, err, sql)
+		}
+	}
+}
+
+func TestBuildView(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	build := func(sql string, pc PrivilegeChecker) (Plan, error) {
+		stmts, err := parsers.Parse(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		return New("test", sql, e).WithPrivilegeChecker(pc).WithUser("d").BuildStatement(stmts[0])
+	}
+	pn, err := build("create view V as select uid from S where uid > 1", nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "V", pn.(*CreateView).Defs))
+
+	// a filter of a table is merged into the query
+	pn, err = build("select uid from V", nil)
+	require.NoError(t, err)
+	qry := pn.(*Query)
+	require.Equal(t, 0, len(qry.Temps))
+	require.Equal(t, "S", qry.RelsMap["V"].Name)
+	require.Equal(t, 1, len(qry.RelsMap["V"].RestrictConds))
+
+	// a common table expression is materialised once
+	pn, err = build("with c as (select uid from S order by uid limit 1) select * from c as a join V on a.uid = V.uid join c as d on a.uid = d.uid", nil)
+	require.NoError(t, err)
+	qry = pn.(*Query)
+	require.Equal(t, 1, len(qry.Temps))
+	require.Equal(t, TempSchema, qry.RelsMap["d"].Schema)
+	require.Equal(t, qry.Temps[0].Name, qry.RelsMap["a"].Name)
+
+	// the objects read by a view needn't be granted to its readers, they
+	// are checked against the privileges of the definer
+	grants := map[string][]privilege.Grant{
+		"u": {{Database: "test", Table: "V", Privileges: privilege.Select}},
+		"d": {{Database: "test", Table: "S", Privileges: privilege.Select}},
+	}
+	pc := newDefinerChecker("u", grants)
+	for sql, allowed := range map[string]bool{
+		"select * from V": true,
+		"with s as (select uid from S) select * from s": false,
+		"create view W as select * from V":              false,
+		"drop view V":                                   false,
+	} {
+		_, err := build(sql, pc)
+		if allowed {
+			require.NoError(t, err, sql)
+		} else {
+			require.Error(t, err, sql)
+		}
+	}
+
+	// revoking the privileges of the definer stops the reads through the view
+	grants["d"] = nil
+	_, err = build("select * from V", pc)
+	require.Error(t, err)
+
+	// the privileges of the reader are checked if the definer can't be checked
+	_, err = build("select * from V", privilege.NewChecker("u", "%", grants["u"]))
+	require.Error(t, err)
+}
+
+// definerChecker checks the privileges of the users by their grants
+type definerChecker struct {
+	*privilege.Checker
+	grants map[string][]privilege.Grant
+}
+
+func newDefinerChecker(user string, grants map[string][]privilege.Grant) *definerChecker {
+	return &definerChecker{
+		Checker: privilege.NewChecker(user, "%", grants[user]),
+		grants:  grants,
+	}
+}
+
+func (c *definerChecker) Definer(user string) (PrivilegeChecker, error) {
+	return newDefinerChecker(user, c.grants), nil
+}
+
 func processQuery(query string, e engine.Engine) {
 	stmts, err := parsers.Parse(dialect.MYSQL, query)
 	if err != nil {
@@ -14,10 +14,33 @@
 
 package plan
 
-import "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
 
 func (b *build) BuildCreateDatabase(stmt *tree.CreateDatabase, plan *CreateDatabase) error {
+	if err := b.checkPrivilege(string(stmt.Name), "", privilege.Create); err != nil {
+		return err
+	}
 	plan.IfNotExistFlag = stmt.IfNotExists
 	plan.Id = string(stmt.Name)
+	for _, opt := range stmt.CreateOptions {
+		if e, ok := opt.(*tree.CreateOptionEncryption); ok {
+			switch strings.ToUpper(e.Encrypt) {
+			case "Y":
+				plan.Encrypted = true
+			case "N":
+				plan.Encrypted = false
+			default:
+				return errors.New(errno.InvalidDatabaseDefinition, fmt.Sprintf("Invalid encryption option '%s'", e.Encrypt))
+			}
+		}
+	}
 	return nil
 }
\ No newline at end of file
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -39,10 +40,13 @@ func (b *build) BuildCreateIndex(stmt *tree.CreateIndex, plan *CreateIndex) erro
 	mpColName := make(map[string]types.Type) // map of relation's column names
 	mpIndexName := make(map[string]struct{}) // map of relation's index names
 
-	_, _, r, err := b.tableName(&stmt.Table)
+	dbName, tblName, r, err := b.baseTableName(&stmt.Table)
 	if err != nil {
 		return nil
 	}
+	if err := b.checkPrivilege(dbName, tblName, privilege.Index); err != nil {
+		return err
+	}
 	for _, def := range r.TableDefs() {
 		switch t := def.(type) {
 		case *engine.AttributeDef:
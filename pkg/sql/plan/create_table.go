@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"go/constant"
 	"math"
@@ -36,6 +37,9 @@ func (b *build) BuildCreateTable(stmt *tree.CreateTable, plan *CreateTable) erro
 	if err != nil {
 		return err
 	}
+	if err := b.checkPrivilege(dbName, tblName, privilege.Create); err != nil {
+		return err
+	}
 	db, err := b.e.Database(dbName)
 	if err != nil {
 		return err
@@ -67,7 +71,18 @@ func (b *build) BuildCreateTable(stmt *tree.CreateTable, plan *CreateTable) erro
 	}
 
 	if stmt.PartitionOption != nil {
-		return errors.New(errno.SQLStatementNotYetComplete, "partitionBy not yet complete")
+		attrs := make(map[string]engine.Attribute)
+		for _, def := range defs {
+			if d, ok := def.(*engine.AttributeDef); ok {
+				attrs[d.Attr.Name] = d.Attr
+			}
+		}
+		pdef, err := buildPartitionBy(stmt.PartitionOption, attrs)
+		if err != nil {
+			return err
+		}
+		defs = append(defs, pdef)
+		plan.PartitionBy = pdef
 	}
 	// returns
 	plan.IfNotExistFlag = stmt.IfNotExists
@@ -86,7 +101,11 @@ func (b *build) getOptionDef(option tree.TableOption) (engine.TableDef, error) {
 				Value: property.Value}
 		}
 		return &engine.PropertiesDef{Properties: properties}, nil
-
+	case *tree.TableOptionTTL:
+		return &engine.PropertiesDef{Properties: []engine.Property{{
+			Key:   "ttl",
+			Value: fmt.Sprintf("%s + interval %d day", n.Column, n.Days),
+		}}}, nil
 	}
 	return nil, nil
 }
@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (b *build) BuildCreateView(stmt *tree.CreateView, plan *CreateView) error {
+	dbName, tblName, err := b.tableInfo(*stmt.Name)
+	if err != nil {
+		return err
+	}
+	if err := b.checkPrivilege(dbName, tblName, privilege.Create); err != nil {
+		return err
+	}
+	db, err := b.e.Database(dbName)
+	if err != nil {
+		return err
+	}
+	if r, err := db.Relation(tblName); err == nil {
+		v := engine.GetViewDef(r.TableDefs())
+		r.Close()
+		if v == nil || !stmt.Replace {
+			return errors.New(errno.DuplicateTable, fmt.Sprintf("Table '%s' already exists", tblName))
+		}
+	}
+
+	// the query is rewritten when it's built, so its text is kept first
+	sql := tree.String(stmt.AsSource, dialect.MYSQL)
+	sel, err := parseSelect(sql)
+	if err != nil {
+		return err
+	}
+	c := &cte{
+		name: tblName,
+		db:   dbName,
+		stmt: rewriteSelect(sel),
+	}
+	for _, col := range stmt.ColNames {
+		c.cols = append(c.cols, string(col))
+	}
+	// the user who creates the view must be able to read its objects
+	_, attrs, err := b.buildCteQuery(c)
+	if err != nil {
+		return err
+	}
+	if _, ok := b.tmp.views[dbName+"."+tblName]; ok {
+		return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("View '%s.%s' refers to itself", dbName, tblName))
+	}
+	defs := make([]engine.TableDef, 0, len(attrs)+1)
+	for _, attr := range attrs {
+		defs = append(defs, &engine.AttributeDef{
+			Attr: engine.Attribute{
+				Name: attr.Name,
+				Alg:  compress.Lz4,
+				Type: attr.Type,
+			},
+		})
+	}
+	defs = append(defs, &engine.ViewDef{Sql: sql, Definer: b.user})
+	plan.Replace = stmt.Replace
+	plan.Id = tblName
+	plan.Db = db
+	plan.Defs = defs
+	return nil
+}
+
+// baseTableName is tableName of the statements which can't change a view.
+func (b *build) baseTableName(tbl *tree.TableName) (string, string, engine.Relation, error) {
+	db, id, r, err := b.tableName(tbl)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if engine.GetViewDef(r.TableDefs()) != nil {
+		r.Close()
+		return "", "", nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is not BASE TABLE", db, id))
+	}
+	return db, id, r, nil
+}
@@ -0,0 +1,363 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// TempSchema is the schema of the temporary tables of a query, it can't
+// be a name of any database.
+const TempSchema = "%temp"
+
+// cte is a common table expression or a view referenced by a query.
+type cte struct {
+	view bool
+	busy bool // set while the query is built, a view can't refer to itself
+	name string
+	user string   // definer of the view, empty if it is unknown
+	db   string   // schema which the names of the query are resolved in
+	cols []string // names of the columns, nil if they are named by the query
+	stmt *tree.Select
+	ctes map[string]*cte // common table expressions visible to the query
+	temp *TempTable      // temporary table holding the result, nil if it isn't materialised
+}
+
+// temps is shared by all builds of a statement, so that a common table
+// expression or a view is materialised once however often it is referenced.
+type temps struct {
+	ts    []*TempTable
+	views map[string]*cte
+}
+
+func (b *build) buildWith(stmt *tree.With) error {
+	ctes := make(map[string]*cte, len(b.ctes)+len(stmt.Ctes))
+	for k, v := range b.ctes {
+		ctes[k] = v
+	}
+	mp := make(map[string]struct{})
+	for _, c := range stmt.Ctes {
+		name := string(c.Name)
+		if _, ok := mp[name]; ok {
+			return errors.New(errno.DuplicateTable, fmt.Sprintf("Not unique table/alias: '%s'", name))
+		}
+		mp[name] = struct{}{}
+		// a common table expression sees the ones defined before it only
+		vs := make(map[string]*cte, len(ctes))
+		for k, v := range ctes {
+			vs[k] = v
+		}
+		var cols []string
+		for _, col := range c.Cols {
+			cols = append(cols, string(col))
+		}
+		ctes[name] = &cte{
+			name: name,
+			db:   b.db,
+			cols: cols,
+			stmt: rewriteSelect(c.Stmt),
+			ctes: vs,
+		}
+	}
+	b.ctes = ctes
+	return nil
+}
+
+// getView returns the view db.name whose query is sql and whose definer is
+// user, a view is parsed once for a statement.
+func (b *build) getView(db, name string, cols []string, sql, user string) (*cte, error) {
+	key := db + "." + name
+	if c, ok := b.tmp.views[key]; ok {
+		return c, nil
+	}
+	stmt, err := parseSelect(sql)
+	if err != nil {
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("View '%s' references invalid table(s) or column(s): %v", key, err))
+	}
+	c := &cte{
+		view: true,
+		name: name,
+		user: user,
+		db:   db,
+		cols: cols,
+		stmt: rewriteSelect(stmt),
+	}
+	b.tmp.views[key] = c
+	return c, nil
+}
+
+// buildCte adds the relation alias which reads c to the query, the query
+// of c is merged into the query if it's a filter of a table, otherwise it's
+// materialised into a temporary table.
+func (b *build) buildCte(c *cte, alias string, qry *Query) (string, error) {
+	if _, ok := qry.RelsMap[alias]; ok {
+		return "", errors.New(errno.DuplicateTable, fmt.Sprintf("Not unique table/alias: '%s'", alias))
+	}
+	if c.busy {
+		return "", errors.New(errno.InvalidTableDefinition, fmt.Sprintf("View '%s.%s' refers to itself", c.db, c.name))
+	}
+	c.busy = true
+	defer func() { c.busy = false }()
+	if c.temp == nil {
+		if ok, err := b.mergeCte(c, alias, qry); err != nil || ok {
+			return alias, err
+		}
+	}
+	t, err := b.materialiseCte(c)
+	if err != nil {
+		return "", err
+	}
+	rel := &Relation{
+		Alias:    alias,
+		Name:     t.Name,
+		Schema:   TempSchema,
+		AttrsMap: make(map[string]*Attribute),
+	}
+	for _, attr := range t.Attrs {
+		rel.Attrs = append(rel.Attrs, attr.Name)
+		rel.AttrsMap[attr.Name] = &Attribute{
+			Name: attr.Name,
+			Type: attr.Type,
+		}
+	}
+	qry.RelsMap[alias] = rel
+	qry.Rels = append(qry.Rels, alias)
+	return alias, nil
+}
+
+// mergeCte merges the query of c into qry as the relation alias if the
+// query only filters and projects the columns of one table, it returns
+// false if the query must be materialised.
+func (b *build) mergeCte(c *cte, alias string, qry *Query) (bool, error) {
+	if c.stmt.With != nil || len(c.stmt.OrderBy) > 0 || c.stmt.Limit != nil || c.stmt.Ep != nil {
+		return false, nil
+	}
+	stmt, ok := c.stmt.Select.(*tree.SelectClause)
+	if !ok || stmt.Distinct || len(stmt.GroupBy) > 0 || stmt.Having != nil {
+		return false, nil
+	}
+	if stmt.From == nil || len(stmt.From.Tables) != 1 {
+		return false, nil
+	}
+	sub := &Query{RelsMap: make(map[string]*Relation)}
+	nb, err := b.newCteBuild(c)
+	if err != nil {
+		return false, err
+	}
+	if _, err := nb.buildFromTable(stmt.From.Tables[0], "", sub); err != nil {
+		return false, err
+	}
+	if len(sub.Rels) != 1 {
+		return false, nil
+	}
+	rel := sub.RelsMap[sub.Rels[0]]
+	attrs, ok := projectionAttributes(stmt.Exprs, sub.Rels[0], rel)
+	if !ok {
+		return false, nil
+	}
+	if len(c.cols) > 0 && !equalNames(c.cols, attrs) {
+		return false, nil
+	}
+	if stmt.Where != nil {
+		if err := nb.buildWhere(stmt.Where, sub); err != nil {
+			return false, err
+		}
+		if len(sub.Conds) > 0 {
+			return false, nil
+		}
+		// the columns read by the filter must be the ones of the relation
+		mp := make(map[string]struct{})
+		for _, attr := range attrs {
+			mp[attr] = struct{}{}
+		}
+		for _, e := range rel.RestrictConds {
+			for _, attr := range e.Attributes() {
+				if _, name := util.SplitTableAndColumn(attr); !hasName(mp, name) {
+					return false, nil
+				}
+			}
+		}
+	}
+	mp := make(map[string]*Attribute, len(attrs))
+	for _, attr := range attrs {
+		mp[attr] = rel.AttrsMap[attr]
+	}
+	rel.Alias = alias
+	rel.Attrs = attrs
+	rel.AttrsMap = mp
+	qry.RelsMap[alias] = rel
+	qry.Rels = append(qry.Rels, alias)
+	qry.RestrictConds = append(qry.RestrictConds, sub.RestrictConds...)
+	return true, nil
+}
+
+// materialiseCte builds the query of c which fills a temporary table.
+func (b *build) materialiseCte(c *cte) (*TempTable, error) {
+	if c.temp != nil {
+		return c.temp, nil
+	}
+	qry, attrs, err := b.buildCteQuery(c)
+	if err != nil {
+		return nil, err
+	}
+	c.temp = &TempTable{
+		Name:  fmt.Sprintf("%s_%v", c.name, len(b.tmp.ts)),
+		Attrs: attrs,
+		Query: qry,
+	}
+	b.tmp.ts = append(b.tmp.ts, c.temp)
+	return c.temp, nil
+}
+
+// buildCteQuery builds the query of c and returns it with the columns of c.
+func (b *build) buildCteQuery(c *cte) (*Query, []*Attribute, error) {
+	qry := &Query{
+		Limit:   -1,
+		Offset:  -1,
+		RelsMap: make(map[string]*Relation),
+	}
+	nb, err := b.newCteBuild(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := nb.buildSelect(c.stmt, qry); err != nil {
+		return nil, nil, err
+	}
+	qry.backFill()
+	if len(c.cols) > 0 && len(c.cols) != len(qry.ResultAttributes) {
+		return nil, nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("In definition of view, derived table or common table expression, SELECT list and column names list have different column counts"))
+	}
+	attrs := make([]*Attribute, len(qry.ResultAttributes))
+	mp := make(map[string]struct{})
+	for i, attr := range qry.ResultAttributes {
+		var name string
+
+		if len(c.cols) > 0 {
+			name = c.cols[i]
+		} else {
+			_, name = util.SplitTableAndColumn(attr.Name)
+		}
+		if _, ok := mp[name]; ok {
+			return nil, nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", name))
+		}
+		mp[name] = struct{}{}
+		typ := attr.Type
+		if typ.Size == 0 {
+			typ = typ.Oid.ToType()
+		}
+		attrs[i] = &Attribute{Name: name, Type: typ}
+	}
+	return qry, attrs, nil
+}
+
+// newCteBuild returns the build of the query of c, the objects read by a
+// view are checked against the privileges of its definer, so that a user
+// who can read the view needn't read them.
+func (b *build) newCteBuild(c *cte) (*build, error) {
+	nb := &build{
+		e:    b.e,
+		db:   c.db,
+		sql:  b.sql,
+		flg:  true,
+		pc:   b.pc,
+		user: b.user,
+		ctes: c.ctes,
+		tmp:  b.tmp,
+	}
+	if c.view {
+		pc, err := b.viewChecker(c.user)
+		if err != nil {
+			return nil, err
+		}
+		nb.pc = pc
+	}
+	return nb, nil
+}
+
+// projectionAttributes returns the columns of rel projected by exprs, it
+// returns false if a projection isn't a column of rel or renames one.
+func projectionAttributes(exprs tree.SelectExprs, alias string, rel *Relation) ([]string, bool) {
+	if len(exprs) == 1 {
+		if _, ok := exprs[0].Expr.(tree.UnqualifiedStar); ok {
+			return append([]string{}, rel.Attrs...), true
+		}
+	}
+	attrs := make([]string, 0, len(exprs))
+	mp := make(map[string]struct{})
+	for _, expr := range exprs {
+		e, ok := expr.Expr.(*tree.UnresolvedName)
+		if !ok || e.Star {
+			return nil, false
+		}
+		name, _ := getColumnName(e)
+		tbl, attr := util.SplitTableAndColumn(name)
+		if len(tbl) > 0 && tbl != alias {
+			return nil, false
+		}
+		if len(expr.As) > 0 && string(expr.As) != attr {
+			return nil, false
+		}
+		if _, ok := rel.AttrsMap[attr]; !ok || hasName(mp, attr) {
+			return nil, false
+		}
+		mp[attr] = struct{}{}
+		attrs = append(attrs, attr)
+	}
+	return attrs, true
+}
+
+func hasName(mp map[string]struct{}, name string) bool {
+	_, ok := mp[name]
+	return ok
+}
+
+func equalNames(xs, ys []string) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+	for i := range xs {
+		if xs[i] != ys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func rewriteSelect(stmt *tree.Select) *tree.Select {
+	return rewrite.AstRewrite(rewrite.Rewrite(stmt)).(*tree.Select)
+}
+
+func parseSelect(sql string) (*tree.Select, error) {
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("'%s' is not a query", sql)
+	}
+	stmt, ok := stmts[0].(*tree.Select)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a query", sql)
+	}
+	return stmt, nil
+}
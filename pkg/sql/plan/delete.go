@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (b *build) BuildDelete(stmt *tree.Delete, plan *Delete) error {
+	if len(stmt.OrderBy) > 0 || stmt.Limit != nil {
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport clause: '%s'", tree.String(stmt, dialect.MYSQL)))
+	}
+	tbl := stmt.Table
+	for {
+		if paren, ok := tbl.(*tree.ParenTableExpr); ok {
+			tbl = paren.Expr
+		} else if aliased, ok := tbl.(*tree.AliasedTableExpr); ok {
+			tbl = aliased.Expr
+		} else {
+			break
+		}
+	}
+	name, ok := tbl.(*tree.TableName)
+	if !ok {
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table: '%v'", stmt.Table))
+	}
+	db, id, r, err := b.tableName(name)
+	if err != nil {
+		return err
+	}
+	if engine.GetViewDef(r.TableDefs()) != nil {
+		r.Close()
+		return errors.New(errno.WrongObjectType, fmt.Sprintf("The target table %s of the DELETE is not updatable", id))
+	}
+	if err := b.checkPrivilege(db, id, privilege.Delete); err != nil {
+		r.Close()
+		return err
+	}
+	plan.Id = id
+	plan.Db = db
+	plan.Relation = r
+	if stmt.Where == nil {
+		return nil
+	}
+	// the filter is resolved against the table, as the filter of a select
+	qry := &Query{
+		Limit:   -1,
+		Offset:  -1,
+		RelsMap: make(map[string]*Relation),
+	}
+	if err := b.buildFrom(tree.TableExprs{stmt.Table}, qry); err != nil {
+		r.Close()
+		return err
+	}
+	e, err := b.buildWhereExpr(stmt.Where.Expr, qry)
+	if err != nil {
+		r.Close()
+		return err
+	}
+	if e, err = b.pruneExtend(e, false); err != nil {
+		r.Close()
+		return err
+	}
+	plan.Cond = pruneExtend(e)
+	return nil
+}
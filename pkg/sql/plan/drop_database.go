@@ -14,9 +14,15 @@
 
 package plan
 
-import "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
 
 func (b *build) BuildDropDatabase(stmt *tree.DropDatabase, plan *DropDatabase) error {
+	if err := b.checkPrivilege(string(stmt.Name), "", privilege.Drop); err != nil {
+		return err
+	}
 	plan.Id = string(stmt.Name)
 	plan.IfExistFlag = stmt.IfExists
 	return nil
@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -29,10 +30,13 @@ func (b *build) BuildDropIndex(stmt *tree.DropIndex, plan *DropIndex) error {
 	var notExisted = true
 	indexName := string(stmt.Name)
 
-	_, _, r, err := b.tableName(&stmt.TableName)
+	dbName, tblName, r, err := b.baseTableName(&stmt.TableName)
 	if err != nil {
 		return err
 	}
+	if err := b.checkPrivilege(dbName, tblName, privilege.Index); err != nil {
+		return err
+	}
 	for _, def := range r.TableDefs() {
 		if indexDef, ok := def.(*engine.IndexTableDef); ok {
 			if indexDef.Name == indexName {
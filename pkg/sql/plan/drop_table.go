@@ -14,7 +14,10 @@
 
 package plan
 
-import "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
 
 func (b *build) BuildDropTable(stmt *tree.DropTable, plan *DropTable) error {
 	dbs, ids := make([]string, len(stmt.Names)), make([]string, len(stmt.Names))
@@ -25,6 +28,9 @@ func (b *build) BuildDropTable(stmt *tree.DropTable, plan *DropTable) error {
 			dbs[i] = string(tbl.SchemaName)
 		}
 		ids[i] = string(tbl.ObjectName)
+		if err := b.checkPrivilege(dbs[i], ids[i], privilege.Drop); err != nil {
+			return err
+		}
 	}
 	plan.IfExistFlag = stmt.IfExists
 	plan.Dbs = dbs
@@ -0,0 +1,39 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+func (b *build) BuildDropView(stmt *tree.DropView, plan *DropView) error {
+	dbs, ids := make([]string, len(stmt.Names)), make([]string, len(stmt.Names))
+	for i, tbl := range stmt.Names {
+		if len(tbl.SchemaName) == 0 {
+			dbs[i] = b.db
+		} else {
+			dbs[i] = string(tbl.SchemaName)
+		}
+		ids[i] = string(tbl.ObjectName)
+		if err := b.checkPrivilege(dbs[i], ids[i], privilege.Drop); err != nil {
+			return err
+		}
+	}
+	plan.IfExistFlag = stmt.IfExists
+	plan.Dbs = dbs
+	plan.Ids = ids
+	return nil
+}
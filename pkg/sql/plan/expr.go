@@ -423,10 +423,44 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.Like, Left: left, Right: right}, nil
+	case tree.IN, tree.NOT_IN:
+		return b.buildIn(e, qry, fn)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 }
 
+// buildIn rewrites a IN (x, y) as a = x OR a = y, and a NOT IN (x, y) as
+// a <> x AND a <> y
+func (b *build) buildIn(e *tree.ComparisonExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	tuple, ok := e.Right.(*tree.Tuple)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
+	}
+	op, cmp := overload.Or, overload.EQ
+	if e.Op == tree.NOT_IN {
+		op, cmp = overload.And, overload.NE
+	}
+	var ext extend.Extend
+	for _, expr := range tuple.Exprs {
+		// each call increases the reference count of the left side
+		left, err := fn(e.Left, qry)
+		if err != nil {
+			return nil, err
+		}
+		right, err := fn(expr, qry)
+		if err != nil {
+			return nil, err
+		}
+		cond := &extend.BinaryExtend{Op: cmp, Left: left, Right: right}
+		if ext == nil {
+			ext = cond
+			continue
+		}
+		ext = &extend.BinaryExtend{Op: op, Left: ext, Right: cond}
+	}
+	return ext, nil
+}
+
 // If flg is set, then it will increase the reference count
 // 	. only the original attributes will be looked up
 func (b *build) buildAttribute0(flg bool, e *tree.UnresolvedName, qry *Query) (extend.Extend, error) {
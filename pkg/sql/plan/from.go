@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -60,6 +61,11 @@ func (b *build) buildFromTable(tbl tree.TableExpr, alias string, qry *Query) ([]
 func (b *build) buildTable(tbl *tree.TableName, qry *Query) (string, error) {
 	var r Relation
 
+	if len(tbl.SchemaName) == 0 {
+		if c, ok := b.ctes[string(tbl.ObjectName)]; ok {
+			return b.buildCte(c, string(tbl.ObjectName), qry)
+		}
+	}
 	r.Name = string(tbl.ObjectName)
 	if len(tbl.SchemaName) == 0 {
 		r.Schema = b.db
@@ -71,12 +77,20 @@ func (b *build) buildTable(tbl *tree.TableName, qry *Query) (string, error) {
 	if _, ok := qry.RelsMap[r.Alias]; ok {
 		return "", errors.New(errno.DuplicateTable, fmt.Sprintf("Not unique table/alias: '%s'", r.Alias))
 	}
-	attrs, attrsMap, err := b.getAttributeInfo(r.Schema, r.Name)
+	if err := b.checkPrivilege(r.Schema, r.Name, privilege.Select); err != nil {
+		return "", err
+	}
+	sql, definer, err := b.getAttributeInfo(&r)
 	if err != nil {
 		return "", err
 	}
-	r.Attrs = attrs
-	r.AttrsMap = attrsMap
+	if len(sql) > 0 { // a view is read by its query
+		c, err := b.getView(r.Schema, r.Name, r.Attrs, sql, definer)
+		if err != nil {
+			return "", err
+		}
+		return b.buildCte(c, r.Alias, qry)
+	}
 	qry.RelsMap[r.Alias] = &r
 	qry.Rels = append(qry.Rels, r.Alias)
 	return r.Alias, nil
@@ -136,28 +150,39 @@ func (b *build) renameRelation(old, new string, qry *Query) error {
 	return nil
 }
 
-func (b *build) getAttributeInfo(schema string, name string) ([]string, map[string]*Attribute, error) {
+// getAttributeInfo fills the attributes of the relation, it returns the
+// query and the definer of the relation if it's a view.
+func (b *build) getAttributeInfo(rel *Relation) (string, string, error) {
+	var sql, definer string
 	var attrs []string
 
-	db, err := b.e.Database(schema)
+	db, err := b.e.Database(rel.Schema)
 	if err != nil {
-		return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
+		return "", "", errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
 	}
-	r, err := db.Relation(name)
+	r, err := db.Relation(rel.Name)
 	if err != nil {
-		return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
+		return "", "", errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
 	}
 	defer r.Close()
 	defs := r.TableDefs()
 	mp := make(map[string]*Attribute)
 	for _, def := range defs {
-		if v, ok := def.(*engine.AttributeDef); ok {
+		switch v := def.(type) {
+		case *engine.AttributeDef:
 			mp[v.Attr.Name] = &Attribute{
 				Name: v.Attr.Name,
 				Type: v.Attr.Type,
 			}
 			attrs = append(attrs, v.Attr.Name)
+		case *engine.StatisticsDef:
+			rel.Stats = v
+		case *engine.ViewDef:
+			sql, definer = v.Sql, v.Definer
 		}
 	}
-	return attrs, mp, nil
+	rel.Attrs = attrs
+	rel.AttrsMap = mp
+	rel.Rows = r.Rows()
+	return sql, definer, nil
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"go/constant"
 	"strconv"
@@ -51,6 +52,13 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 	if err != nil {
 		return err
 	}
+	if engine.GetViewDef(r.TableDefs()) != nil {
+		r.Close()
+		return errors.New(errno.WrongObjectType, fmt.Sprintf("The target table %s of the INSERT is not insertable-into", id))
+	}
+	if err := b.checkPrivilege(db, id, privilege.Insert); err != nil {
+		return err
+	}
 	plan.Id = id
 	plan.Relation = r
 	plan.Db = db
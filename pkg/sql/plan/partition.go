@@ -0,0 +1,200 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// buildPartitionBy returns the RANGE or LIST partitions of CREATE TABLE, the
+// rows are partitioned by the value of a column.
+func buildPartitionBy(option *tree.PartitionOption, attrs map[string]engine.Attribute) (*engine.PartitionByDef, error) {
+	if option.SubPartBy != nil {
+		return nil, errors.New(errno.FeatureNotSupported, "subpartition is not supported now")
+	}
+	var name string
+	var err error
+	var isList bool
+
+	switch t := option.PartBy.PType.(type) {
+	case *tree.RangeType:
+		name, err = partitionColumn(t.Expr, t.ColumnList)
+	case *tree.ListType:
+		name, err = partitionColumn(t.Expr, t.ColumnList)
+		isList = true
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, "only RANGE and LIST partitions are supported now")
+	}
+	if err != nil {
+		return nil, err
+	}
+	attr, ok := attrs[name]
+	if !ok {
+		return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Field '%s' in list of fields for partition function not found in table", name))
+	}
+	switch attr.Type.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	case types.T_char, types.T_varchar, types.T_date, types.T_datetime:
+	default:
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Field '%s' is of a not allowed type for this type of partitioning", name))
+	}
+	if len(option.Partitions) == 0 {
+		return nil, errors.New(errno.InvalidTableDefinition, "each partition must be defined")
+	}
+	def := &engine.PartitionByDef{Fields: []string{name}}
+	if err := addPartitions(def, attr, option.Partitions, isList); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+// partitionColumn returns the column of PARTITION BY RANGE(a) or RANGE
+// COLUMNS(a), the other expressions are not supported.
+func partitionColumn(expr tree.Expr, cols []*tree.UnresolvedName) (string, error) {
+	if len(cols) > 1 {
+		return "", errors.New(errno.FeatureNotSupported, "partition by multiple columns is not supported now")
+	}
+	if len(cols) == 1 {
+		return cols[0].Parts[0], nil
+	}
+	for {
+		e, ok := expr.(*tree.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = e.Expr
+	}
+	if n, ok := expr.(*tree.UnresolvedName); ok {
+		return n.Parts[0], nil
+	}
+	return "", errors.New(errno.FeatureNotSupported, fmt.Sprintf("partition by '%s' is not supported now, use a column", tree.String(expr, dialect.MYSQL)))
+}
+
+// addPartitions appends the LIST or RANGE partitions to the def, the From of
+// a RANGE partition is the To of the previous one.
+func addPartitions(def *engine.PartitionByDef, attr engine.Attribute, parts []*tree.Partition, isList bool) error {
+	for _, part := range parts {
+		if len(part.Subs) > 0 {
+			return errors.New(errno.FeatureNotSupported, "subpartition is not supported now")
+		}
+		name := string(part.Name)
+		switch v := part.Values.(type) {
+		case *tree.ValuesLessThan:
+			if isList {
+				return errors.New(errno.InvalidTableDefinition, "Only RANGE PARTITIONING can use VALUES LESS THAN in partition definition")
+			}
+			if len(v.ValueList) != 1 {
+				return errors.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+			}
+			p := engine.RangePartition{Name: name}
+			if n := len(def.Range); n > 0 {
+				p.From = def.Range[n-1].To
+			}
+			if isNullExpr(v.ValueList[0]) {
+				return errors.New(errno.InvalidTableDefinition, "Not allowed to use NULL value in VALUES LESS THAN")
+			}
+			if _, ok := v.ValueList[0].(*tree.MaxValue); !ok {
+				e, err := buildPartitionValue(attr, v.ValueList[0])
+				if err != nil {
+					return err
+				}
+				p.To = []extend.Extend{e}
+			}
+			def.Range = append(def.Range, p)
+		case *tree.ValuesIn:
+			if !isList {
+				return errors.New(errno.InvalidTableDefinition, "Only LIST PARTITIONING can use VALUES IN in partition definition")
+			}
+			p := engine.ListPartition{Name: name}
+			for _, exprs := range v.ValueList {
+				if len(exprs) != 1 {
+					return errors.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+				}
+				e, err := buildPartitionValue(attr, exprs[0])
+				if err != nil {
+					return err
+				}
+				p.Extends = append(p.Extends, e)
+			}
+			def.List = append(def.List, p)
+		default:
+			if isList {
+				return errors.New(errno.InvalidTableDefinition, "LIST PARTITIONING requires definition of VALUES IN for each partition")
+			}
+			return errors.New(errno.InvalidTableDefinition, "RANGE PARTITIONING requires definition of VALUES LESS THAN for each partition")
+		}
+	}
+	if err := def.Check(); err != nil {
+		return errors.New(errno.InvalidTableDefinition, err.Error())
+	}
+	return nil
+}
+
+// buildPartitionValue returns a bound of a partition, it is a value extend
+// holding a vector of one row of the type of the column.
+func buildPartitionValue(attr engine.Attribute, expr tree.Expr) (extend.Extend, error) {
+	def := engine.MakeDefaultExpr(true, nil, true)
+	if !isNullExpr(expr) {
+		value, err := buildConstant(attr.Type, expr)
+		if err != nil {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("Partition column values of incorrect type for '%s'", attr.Name))
+		}
+		if value, err = rangeCheck(value, attr.Type, "", 0); err != nil {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("Partition column values of incorrect type for '%s'", attr.Name))
+		}
+		def = engine.MakeDefaultExpr(true, value, false)
+	}
+	vec, err := engine.DefaultVector(engine.Attribute{Name: attr.Name, Type: attr.Type, Default: def}, 1)
+	if err != nil {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("Partition column values of incorrect type for '%s'", attr.Name))
+	}
+	return &extend.ValueExtend{V: vec}, nil
+}
+
+// checkPartitionNames checks that the partitions of ALTER TABLE ... DROP or
+// TRUNCATE PARTITION exist and are named once.
+func checkPartitionNames(def *engine.PartitionByDef, names tree.IdentifierList) error {
+	parts := make(map[string]struct{}, def.Len())
+	for _, name := range def.Names() {
+		parts[name] = struct{}{}
+	}
+	for i, name := range names {
+		if nameIn(string(name), names[:i]) {
+			return errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate partition name %s", name))
+		}
+		if _, ok := parts[string(name)]; !ok {
+			return errors.New(errno.UndefinedObject, fmt.Sprintf("Unknown partition '%s' in table", name))
+		}
+	}
+	return nil
+}
+
+func nameIn(name string, names tree.IdentifierList) bool {
+	for _, n := range names {
+		if string(n) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/stretchr/testify/require"
+)
+
+// partitionedRelation reports the partitions the memory engine doesn't keep.
+type partitionedRelation struct {
+	engine.Relation
+	def *engine.PartitionByDef
+}
+
+func (r *partitionedRelation) TableDefs() []engine.TableDef {
+	return append(r.Relation.TableDefs(), r.def)
+}
+
+// monthPartitionSql returns a table partitioned by the months of 2021.
+func monthPartitionSql(name string) string {
+	parts := make([]string, 12)
+	for m := 1; m <= 12; m++ {
+		to := fmt.Sprintf("2021-%02d-01", m+1)
+		if m == 12 {
+			to = "2022-01-01"
+		}
+		parts[m-1] = fmt.Sprintf("partition p%d values less than ('%s')", m, to)
+	}
+	return fmt.Sprintf("create table %s (d date, v int) partition by range columns(d) (%s)", name, strings.Join(parts, ", "))
+}
+
+func buildCreateTable(t *testing.T, e engine.Engine, sql string) (*CreateTable, error) {
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	require.NoError(t, err, sql)
+	var p CreateTable
+	err = New("test", sql, e).BuildCreateTable(stmts[0].(*tree.CreateTable), &p)
+	return &p, err
+}
+
+func TestBuildPartitionBy(t *testing.T) {
+	e := memEngine.NewTestEngine()
+
+	p, err := buildCreateTable(t, e, monthPartitionSql("m"))
+	require.NoError(t, err)
+	require.NotNil(t, p.PartitionBy)
+	require.Equal(t, []string{"d"}, p.PartitionBy.Fields)
+	require.Equal(t, 12, len(p.PartitionBy.Range))
+	require.Nil(t, p.PartitionBy.Range[0].From)
+	require.Equal(t, p.PartitionBy.Range[0].To, p.PartitionBy.Range[1].From)
+	i, ok := p.PartitionBy.Locate(types.FromCalendar(2021, 3, 15))
+	require.True(t, ok)
+	require.Equal(t, "p3", p.PartitionBy.Range[i].Name)
+	require.Contains(t, p.Defs, engine.TableDef(p.PartitionBy))
+
+	p, err = buildCreateTable(t, e, "create table l (a int) partition by list(a) (partition p0 values in (1, 3), partition p1 values in (2, null))")
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p1"}, p.PartitionBy.Names())
+	i, ok = p.PartitionBy.Locate(nil)
+	require.True(t, ok)
+	require.Equal(t, 1, i)
+
+	for _, sql := range []string{
+		"create table x (a int) partition by range(a + 1) (partition p0 values less than (10))",
+		"create table x (a int) partition by range(b) (partition p0 values less than (10))",
+		"create table x (a float) partition by range(a) (partition p0 values less than (10))",
+		"create table x (a int) partition by hash(a) partitions 4",
+		"create table x (a int) partition by range(a) (partition p0 values less than (null))",
+		"create table x (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (10))",
+		"create table x (a int) partition by range(a) (partition p0 values less than maxvalue, partition p1 values less than (10))",
+		"create table x (a int) partition by range(a) (partition p0 values less than (10), partition p0 values less than (20))",
+		"create table x (a int) partition by range(a) (partition p0 values in (10))",
+		"create table x (a tinyint) partition by range(a) (partition p0 values less than (1000))",
+		"create table x (a int) partition by list(a) (partition p0 values in (1, 2), partition p1 values in (2))",
+		"create table x (a int) partition by list(a) (partition p0 values less than (10))",
+	} {
+		_, err = buildCreateTable(t, e, sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestBuildAlterPartition(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	p, err := buildCreateTable(t, e, monthPartitionSql("m"))
+	require.NoError(t, err)
+	require.NoError(t, p.Db.Create(0, p.Id, p.Defs))
+	r, err := p.Db.Relation(p.Id)
+	require.NoError(t, err)
+	defer r.Close()
+	pr := &partitionedRelation{Relation: r, def: p.PartitionBy}
+
+	build := func(sql string, r engine.Relation) (*AlterTable, error) {
+		stmts, err := parsers.Parse(dialect.MYSQL, sql)
+		require.NoError(t, err, sql)
+		var a AlterTable
+		err = New("test", sql, e).buildAlterTableSpecs(stmts[0].(*tree.AlterTable), "test", p.Db, r, &a)
+		return &a, err
+	}
+
+	a, err := build("alter table m add partition (partition p13 values less than ('2022-02-01'), partition pmax values less than maxvalue)", pr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(a.Specs))
+	def := a.Specs[0].Def.(*engine.PartitionByDef)
+	require.Equal(t, []string{"p13", "pmax"}, def.Names())
+	require.Equal(t, p.PartitionBy.Range[11].To, def.Range[0].From)
+	require.Nil(t, def.Range[1].To)
+
+	a, err = build("alter table m drop partition p1, p2", pr)
+	require.NoError(t, err)
+	require.Equal(t, []AlterTableSpec{
+		{Drop: true, Def: &engine.PartitionDef{Name: "p1"}},
+		{Drop: true, Def: &engine.PartitionDef{Name: "p2"}},
+	}, a.Specs)
+
+	a, err = build("alter table m truncate partition p3", pr)
+	require.NoError(t, err)
+	require.Equal(t, []AlterTableSpec{{Def: &engine.TruncatePartitionDef{Name: "p3"}}}, a.Specs)
+
+	for _, sql := range []string{
+		"alter table m add partition (partition p0 values less than ('2021-01-01'))",
+		"alter table m add partition (partition p1 values less than ('2023-01-01'))",
+		"alter table m add partition (partition p0 values in ('2023-01-01'))",
+		"alter table m drop partition p1, p1",
+		"alter table m drop partition p14",
+		"alter table m drop partition p1, p2, p3, p4, p5, p6, p7, p8, p9, p10, p11, p12",
+		"alter table m truncate partition p14",
+	} {
+		_, err = build(sql, pr)
+		require.Error(t, err, sql)
+	}
+	_, err = build("alter table m truncate partition p3", r)
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/infoschema"
+)
+
+// PrivilegeChecker checks the privileges of the user who initiated
+// the sql, tbl is empty for the privileges on the database.
+type PrivilegeChecker interface {
+	CheckPrivilege(db, tbl string, p privilege.Privilege) error
+}
+
+// DefinerChecker is a PrivilegeChecker which can check the privileges of
+// other users, the objects read by a view are checked against the privileges
+// of the user who defined the view.
+type DefinerChecker interface {
+	PrivilegeChecker
+	// Definer returns the checker of the privileges of the user
+	Definer(user string) (PrivilegeChecker, error)
+}
+
+// WithPrivilegeChecker makes the build check the privileges of every
+// object used by the statement, nothing is checked without a checker.
+func (b *build) WithPrivilegeChecker(pc PrivilegeChecker) *build {
+	b.pc = pc
+	return b
+}
+
+// WithUser sets the user who initiated the sql, the user is the definer of
+// the views created by the statement.
+func (b *build) WithUser(user string) *build {
+	b.user = user
+	return b
+}
+
+// viewChecker returns the checker of the objects read by a view, they are
+// checked against the privileges of the definer every time the view is read,
+// so that revoking the privileges of the definer stops the reads through the
+// view. The privileges of the reader are checked if the definer is unknown.
+func (b *build) viewChecker(definer string) (PrivilegeChecker, error) {
+	if b.pc == nil {
+		return nil, nil
+	}
+	dc, ok := b.pc.(DefinerChecker)
+	if !ok || len(definer) == 0 {
+		return b.pc, nil
+	}
+	return dc.Definer(definer)
+}
+
+func (b *build) checkPrivilege(db, tbl string, p privilege.Privilege) error {
+	if b.pc == nil {
+		return nil
+	}
+	// every user can read information_schema, its rows are
+	// limited to the objects which the user can see.
+	if p == privilege.Select && infoschema.IsInfoSchema(db) {
+		return nil
+	}
+	return b.pc.CheckPrivilege(db, tbl, p)
+}
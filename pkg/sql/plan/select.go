@@ -32,12 +32,22 @@ func (b *build) buildSelectStatement(stmt tree.SelectStatement, qry *Query) erro
 }
 
 func (b *build) buildSelect(stmt *tree.Select, qry *Query) error {
+	if stmt.With != nil {
+		if err := b.buildWith(stmt.With); err != nil {
+			return err
+		}
+	}
 	fetch := stmt.Limit
 	wrapped := stmt.Select
 	orderBy := stmt.OrderBy
 	for s, ok := wrapped.(*tree.ParenSelect); ok; s, ok = wrapped.(*tree.ParenSelect) {
 		stmt = s.Select
 		wrapped = stmt.Select
+		if stmt.With != nil {
+			if err := b.buildWith(stmt.With); err != nil {
+				return err
+			}
+		}
 		if stmt.OrderBy != nil {
 			if orderBy != nil {
 				return errors.New(errno.SyntaxErrororAccessRuleViolation, "multiple ORDER BY clauses not allowed")
@@ -0,0 +1,39 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (b *build) BuildShowCreateView(stmt *tree.ShowCreateView, plan *ShowCreateView) error {
+	tbl := stmt.Name.ToTableName()
+	db, id, r, err := b.tableName(&tbl)
+	if err != nil {
+		return err
+	}
+	if engine.GetViewDef(r.TableDefs()) == nil {
+		r.Close()
+		return errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is not VIEW", db, id))
+	}
+	plan.Relation = r
+	plan.ViewName = id
+	return nil
+}
@@ -69,6 +69,8 @@ type Relation struct {
 	Aggregations      []*Aggregation
 	RestrictConds     []extend.Extend
 	ProjectionExtends []*ProjectionExtend
+	Rows              int64                 // number of rows of the table
+	Stats             *engine.StatisticsDef // statistics of the table, nil if it is not analyzed
 }
 
 type Field struct {
@@ -97,10 +99,21 @@ type Query struct {
 	ProjectionExtends []*ProjectionExtend
 	ResultAttributes  []*Attribute
 	VarsMap           map[string]int
+	Temps             []*TempTable // temporary tables filled in order before the query runs
+}
+
+// TempTable is a temporary table in TempSchema which holds the result of
+// Query, a common table expression or a view is materialised into it when
+// it can't be merged into the query which reads it.
+type TempTable struct {
+	Name  string
+	Attrs []*Attribute
+	Query *Query
 }
 
 type CreateDatabase struct {
 	IfNotExistFlag bool
+	Encrypted      bool
 	Id             string
 	E              engine.Engine
 }
@@ -121,6 +134,28 @@ type CreateIndex struct {
 	Defs           []engine.TableDef
 }
 
+type AlterTable struct {
+	Id       string
+	NewId    string // new name of the table, empty if it isn't renamed
+	Db       engine.Database
+	Relation engine.Relation
+	Specs    []AlterTableSpec
+}
+
+// AlterTableSpec is a change of the table, the def is dropped by DelTableDef
+// if Drop is true, otherwise it's added by AddTableDef.
+type AlterTableSpec struct {
+	Drop bool
+	Def  engine.TableDef
+}
+
+type RenameTable struct {
+	Dbs    []string
+	Ids    []string
+	NewIds []string
+	E      engine.Engine
+}
+
 type DropDatabase struct {
 	IfExistFlag bool
 	Id          string
@@ -134,6 +169,20 @@ type DropTable struct {
 	E           engine.Engine
 }
 
+type CreateView struct {
+	Replace bool
+	Id      string
+	Db      engine.Database
+	Defs    []engine.TableDef
+}
+
+type DropView struct {
+	IfExistFlag bool
+	Ids         []string
+	Dbs         []string
+	E           engine.Engine
+}
+
 type DropIndex struct {
 	IfExistFlag bool
 	NotExisted  bool // if true, means this index does not exist.
@@ -162,6 +211,11 @@ type ShowCreateTable struct {
 	TableName string
 }
 
+type ShowCreateView struct {
+	Relation engine.Relation
+	ViewName string
+}
+
 type ShowCreateDatabase struct {
 	IfNotExistFlag bool
 	Id             string
@@ -175,11 +229,22 @@ type Insert struct {
 	Relation engine.Relation
 }
 
+type Delete struct {
+	Id       string
+	Db       string
+	Cond     extend.Extend // nil deletes all rows
+	Relation engine.Relation
+}
+
 type build struct {
-	flg bool   // use for having clause
-	db  string // name of schema
-	sql string
-	e   engine.Engine
+	flg  bool   // use for having clause
+	db   string // name of schema
+	sql  string
+	e    engine.Engine
+	pc   PrivilegeChecker
+	user string          // user who initiated the sql
+	ctes map[string]*cte // common table expressions visible to the statement
+	tmp  *temps          // temporary tables shared by all builds of the statement
 }
 
 func (qry *Query) ResultColumns() []*Attribute {
@@ -215,6 +280,10 @@ func (qry *Query) Name() string {
 func (qry *Query) String() string {
 	var buf bytes.Buffer
 
+	for _, t := range qry.Temps {
+		buf.WriteString(fmt.Sprintf("temporary table %s: %v\n", t.Name, t.Attrs))
+		buf.WriteString(t.Query.String())
+	}
 	buf.WriteString(fmt.Sprintf("result attributes: %v\n", qry.ResultAttributes))
 	buf.WriteString(fmt.Sprintf("variables: %v\n", qry.VarsMap))
 	buf.WriteString(fmt.Sprintf("free attributes: %v\n", qry.FreeAttrs))
@@ -442,6 +511,53 @@ func (c CreateIndex) ResultColumns() []*Attribute {
 	return nil
 }
 
+func (a AlterTable) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("alter table ")
+	buf.WriteString(a.Id)
+	for i, spec := range a.Specs {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		switch def := spec.Def.(type) {
+		case *engine.AttributeDef:
+			if spec.Drop {
+				buf.WriteString(fmt.Sprintf(" drop column %s", def.Attr.Name))
+			} else {
+				buf.WriteString(fmt.Sprintf(" add column %s", def.Attr.Name))
+			}
+		case *engine.RenameAttributeDef:
+			buf.WriteString(fmt.Sprintf(" rename column %s to %s", def.Name, def.NewName))
+		case *engine.DefaultDef:
+			buf.WriteString(fmt.Sprintf(" alter column %s", def.Name))
+		}
+	}
+	if len(a.NewId) > 0 {
+		buf.WriteString(" rename to " + a.NewId)
+	}
+	return buf.String()
+}
+
+func (a AlterTable) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (r RenameTable) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("rename table ")
+	for i := 0; i < len(r.Dbs); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(r.Dbs[i] + "." + r.Ids[i] + " to " + r.Dbs[i] + "." + r.NewIds[i])
+	}
+	return buf.String()
+}
+
+func (r RenameTable) ResultColumns() []*Attribute {
+	return nil
+}
+
 func (d DropDatabase) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("drop database ")
@@ -472,6 +588,40 @@ func (d DropTable) ResultColumns() []*Attribute {
 	return nil
 }
 
+func (c CreateView) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("create ")
+	if c.Replace {
+		buf.WriteString("or replace ")
+	}
+	buf.WriteString("view ")
+	buf.WriteString(c.Id)
+	return buf.String()
+}
+
+func (c CreateView) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (d DropView) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("drop view ")
+	if d.IfExistFlag {
+		buf.WriteString("if exists ")
+	}
+	for i := 0; i < len(d.Dbs); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(d.Dbs[i] + "." + d.Ids[i])
+	}
+	return buf.String()
+}
+
+func (d DropView) ResultColumns() []*Attribute {
+	return nil
+}
+
 func (d DropIndex) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("drop index ")
@@ -562,6 +712,22 @@ func (s ShowCreateTable) ResultColumns() []*Attribute {
 	return attrs
 }
 
+func (s ShowCreateView) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("show create view")
+	return buf.String()
+}
+
+func (s ShowCreateView) ResultColumns() []*Attribute {
+	attrs := []*Attribute{
+		&Attribute{Ref: 1, Name: "View", Type: types.Type{Oid: types.T_varchar, Size: 24}},
+		&Attribute{Ref: 1, Name: "Create View", Type: types.Type{Oid: types.T_varchar, Size: 24}},
+		&Attribute{Ref: 1, Name: "character_set_client", Type: types.Type{Oid: types.T_varchar, Size: 24}},
+		&Attribute{Ref: 1, Name: "collation_connection", Type: types.Type{Oid: types.T_varchar, Size: 24}},
+	}
+	return attrs
+}
+
 func (d ShowCreateDatabase) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("show create database ")
@@ -591,3 +757,16 @@ func (i Insert) String() string {
 func (i Insert) ResultColumns() []*Attribute {
 	return nil
 }
+
+func (d Delete) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("delete from %s", d.Id))
+	if d.Cond != nil {
+		buf.WriteString(fmt.Sprintf(" where %s", d.Cond))
+	}
+	return buf.String()
+}
+
+func (d Delete) ResultColumns() []*Attribute {
+	return nil
+}
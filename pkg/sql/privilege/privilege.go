@@ -0,0 +1,232 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// Privilege is a set of privileges
+type Privilege uint32
+
+const (
+	Select Privilege = 1 << iota
+	Insert
+	Update
+	Delete
+	Create
+	Drop
+	Alter
+	Index
+	GrantOption
+	File
+)
+
+// All is the set of privileges of ALL PRIVILEGES, it does not include GRANT OPTION.
+// FILE is a global privilege, ALL PRIVILEGES ON *.* also grants it.
+const All = Select | Insert | Update | Delete | Create | Drop | Alter | Index
+
+var names = []string{"SELECT", "INSERT", "UPDATE", "DELETE", "CREATE", "DROP", "ALTER", "INDEX", "GRANT OPTION", "FILE"}
+
+func (p Privilege) String() string {
+	var s []string
+
+	if p&All == All {
+		s = append(s, "ALL PRIVILEGES")
+		p &^= All
+	}
+	for i, name := range names {
+		if p&(1<<i) != 0 {
+			s = append(s, name)
+		}
+	}
+	if len(s) == 0 {
+		return "USAGE"
+	}
+	return strings.Join(s, ", ")
+}
+
+// FromTree converts the privilege type of the parser
+func FromTree(typ tree.PrivilegeType) (Privilege, error) {
+	switch typ {
+	case tree.PRIVILEGE_TYPE_STATIC_ALL:
+		return All, nil
+	case tree.PRIVILEGE_TYPE_STATIC_SELECT:
+		return Select, nil
+	case tree.PRIVILEGE_TYPE_STATIC_INSERT:
+		return Insert, nil
+	case tree.PRIVILEGE_TYPE_STATIC_UPDATE:
+		return Update, nil
+	case tree.PRIVILEGE_TYPE_STATIC_DELETE:
+		return Delete, nil
+	case tree.PRIVILEGE_TYPE_STATIC_CREATE:
+		return Create, nil
+	case tree.PRIVILEGE_TYPE_STATIC_DROP:
+		return Drop, nil
+	case tree.PRIVILEGE_TYPE_STATIC_ALTER:
+		return Alter, nil
+	case tree.PRIVILEGE_TYPE_STATIC_INDEX:
+		return Index, nil
+	case tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION:
+		return GrantOption, nil
+	case tree.PRIVILEGE_TYPE_STATIC_FILE:
+		return File, nil
+	case tree.PRIVILEGE_TYPE_STATIC_USAGE:
+		return 0, nil
+	}
+	return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported privilege '%s'", typ.ToString()))
+}
+
+// Grant is a set of privileges on an object, an empty Database
+// is all databases (*.*) and an empty Table is all tables of
+// the database (db.*).
+type Grant struct {
+	Database   string    `json:"database,omitempty"`
+	Table      string    `json:"table,omitempty"`
+	Privileges Privilege `json:"privileges"`
+}
+
+// Covers returns true if the grant applies to the table tbl of the
+// database db, tbl is empty for the database itself.
+func (g Grant) Covers(db, tbl string) bool {
+	if g.Database == "" {
+		return true
+	}
+	if g.Database != db {
+		return false
+	}
+	return g.Table == "" || g.Table == tbl
+}
+
+func (g Grant) sameObject(o Grant) bool {
+	return g.Database == o.Database && g.Table == o.Table
+}
+
+// Object returns the object of the grant as it is shown by SHOW GRANTS
+func (g Grant) Object() string {
+	switch {
+	case g.Database == "":
+		return "*.*"
+	case g.Table == "":
+		return fmt.Sprintf("`%s`.*", g.Database)
+	}
+	return fmt.Sprintf("`%s`.`%s`", g.Database, g.Table)
+}
+
+// Statement returns the GRANT statement which grants g to grantee
+func (g Grant) Statement(grantee string) string {
+	p, opt := g.Privileges&^GrantOption, ""
+	if g.Privileges&GrantOption != 0 {
+		opt = " WITH GRANT OPTION"
+	}
+	return fmt.Sprintf("GRANT %s ON %s TO %s%s", p, g.Object(), grantee, opt)
+}
+
+// Allowed returns true if the grants hold all privileges of p on
+// the table tbl of the database db, privileges of different levels
+// are merged.
+func Allowed(grants []Grant, db, tbl string, p Privilege) bool {
+	var held Privilege
+
+	for _, g := range grants {
+		if g.Covers(db, tbl) {
+			held |= g.Privileges
+		}
+	}
+	return held&p == p
+}
+
+// Visible returns true if the grants hold any privilege on the table tbl
+// of the database db, a database is visible if any privilege is held on
+// it or on one of its tables.
+func Visible(grants []Grant, db, tbl string) bool {
+	for _, g := range grants {
+		if g.Privileges&^GrantOption == 0 {
+			continue
+		}
+		if g.Covers(db, tbl) || (tbl == "" && g.Database == db) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add merges the grant g into grants
+func Add(grants []Grant, g Grant) []Grant {
+	for i := range grants {
+		if grants[i].sameObject(g) {
+			grants[i].Privileges |= g.Privileges
+			return grants
+		}
+	}
+	return append(grants, g)
+}
+
+// Remove removes the privileges of g from grants, grants which
+// are left without any privilege are dropped.
+func Remove(grants []Grant, g Grant) []Grant {
+	rs := grants[:0]
+	for _, o := range grants {
+		if o.sameObject(g) {
+			o.Privileges &^= g.Privileges
+		}
+		if o.Privileges != 0 {
+			rs = append(rs, o)
+		}
+	}
+	return rs
+}
+
+// Checker checks the privileges of a user against its grants
+type Checker struct {
+	user   string
+	host   string
+	grants []Grant
+}
+
+func NewChecker(user, host string, grants []Grant) *Checker {
+	return &Checker{
+		user:   user,
+		host:   host,
+		grants: grants,
+	}
+}
+
+// CheckPrivilege returns an error if the user does not hold all privileges
+// of p on the table tbl of the database db, tbl is empty for the database
+// and db is empty for the global privileges.
+func (c *Checker) CheckPrivilege(db, tbl string, p Privilege) error {
+	if Allowed(c.grants, db, tbl, p) {
+		return nil
+	}
+	if db == "" {
+		return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("Access denied; you need (at least one of) the %s privilege(s) for this operation", p))
+	}
+	if tbl == "" {
+		return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("Access denied for user '%s'@'%s' to database '%s'", c.user, c.host, db))
+	}
+	return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("%s command denied to user '%s'@'%s' for table '%s'", p, c.user, c.host, tbl))
+}
+
+// Visible returns true if the user can see the table tbl of the database db,
+// tbl is empty for the database.
+func (c *Checker) Visible(db, tbl string) bool {
+	return Visible(c.grants, db, tbl)
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	require.Equal(t, "USAGE", Privilege(0).String())
+	require.Equal(t, "SELECT, INSERT", (Select | Insert).String())
+	require.Equal(t, "ALL PRIVILEGES", All.String())
+	require.Equal(t, "ALL PRIVILEGES, GRANT OPTION", (All | GrantOption).String())
+	require.Equal(t, "ALL PRIVILEGES, FILE", (All | File).String())
+
+	require.Equal(t, "GRANT SELECT ON *.* TO u", Grant{Privileges: Select}.Statement("u"))
+	require.Equal(t, "GRANT DROP ON `d`.* TO u WITH GRANT OPTION", Grant{Database: "d", Privileges: Drop | GrantOption}.Statement("u"))
+	require.Equal(t, "GRANT INSERT ON `d`.`t` TO u", Grant{Database: "d", Table: "t", Privileges: Insert}.Statement("u"))
+}
+
+func TestFromTree(t *testing.T) {
+	p, err := FromTree(tree.PRIVILEGE_TYPE_STATIC_ALL)
+	require.NoError(t, err)
+	require.Equal(t, All, p)
+	p, err = FromTree(tree.PRIVILEGE_TYPE_STATIC_SELECT)
+	require.NoError(t, err)
+	require.Equal(t, Select, p)
+	_, err = FromTree(tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN)
+	require.Error(t, err)
+}
+
+func TestGrants(t *testing.T) {
+	var grants []Grant
+
+	grants = Add(grants, Grant{Database: "d", Privileges: Select})
+	grants = Add(grants, Grant{Database: "d", Table: "t", Privileges: Insert})
+	grants = Add(grants, Grant{Database: "d", Privileges: Create})
+	require.Equal(t, 2, len(grants))
+
+	require.True(t, Allowed(grants, "d", "t", Select|Insert))
+	require.True(t, Allowed(grants, "d", "", Create))
+	require.False(t, Allowed(grants, "d", "t2", Insert))
+	require.False(t, Allowed(grants, "d2", "t", Select))
+	require.True(t, Allowed([]Grant{{Privileges: All}}, "d2", "t", Drop))
+
+	grants = Remove(grants, Grant{Database: "d", Table: "t", Privileges: Insert})
+	require.Equal(t, 1, len(grants))
+	require.False(t, Allowed(grants, "d", "t", Insert))
+	grants = Remove(grants, Grant{Database: "d", Privileges: Select})
+	require.False(t, Allowed(grants, "d", "t", Select))
+	require.True(t, Allowed(grants, "d", "t", Create))
+
+	c := NewChecker("u", "%", grants)
+	require.NoError(t, c.CheckPrivilege("d", "", Create))
+	require.Error(t, c.CheckPrivilege("d", "t", Select))
+	require.Error(t, c.CheckPrivilege("d2", "", Create))
+	require.Error(t, c.CheckPrivilege("", "", File))
+
+	c = NewChecker("u", "%", []Grant{{Privileges: File}, {Database: "d", Privileges: Select}})
+	require.NoError(t, c.CheckPrivilege("", "", File))
+	require.NoError(t, c.CheckPrivilege("d", "", Select))
+	require.Error(t, c.CheckPrivilege("d", "", Create))
+}
+
+func TestVisible(t *testing.T) {
+	grants := []Grant{
+		{Database: "d", Table: "t", Privileges: Select},
+		{Database: "d2", Privileges: GrantOption},
+	}
+	require.True(t, Visible(grants, "d", "t"))
+	require.True(t, Visible(grants, "d", ""))
+	require.False(t, Visible(grants, "d", "t2"))
+	require.False(t, Visible(grants, "d2", ""))
+	require.True(t, Visible([]Grant{{Privileges: Insert}}, "d3", "t"))
+
+	c := NewChecker("u", "%", grants)
+	require.True(t, c.Visible("d", "t"))
+	require.False(t, c.Visible("d3", ""))
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"encoding/gob"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
@@ -42,6 +43,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/oplus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
@@ -77,6 +79,11 @@ func init() {
 
 	gob.Register(types.Date(0))
 	gob.Register(types.Datetime(0))
+
+	spill.Register(spill.Codec{
+		Encode: EncodeBatch,
+		Decode: DecodeBatchWithProcess,
+	})
 }
 
 func EncodeScope(s Scope, buf *bytes.Buffer) error {
@@ -801,6 +808,24 @@ func DecodeExtend(data []byte) (extend.Extend, []byte, error) {
 	return nil, nil, fmt.Errorf("'%v' extend not yet support", data[0])
 }
 
+// EncodeStatistic encodes the statistic of the processes of a scope run
+// at a remote node, it is sent back with the end of the scope.
+func EncodeStatistic(stat *process.Statistic, buf *bytes.Buffer) {
+	buf.Write(encoding.EncodeInt64(atomic.LoadInt64(&stat.ScanRows)))
+	buf.Write(encoding.EncodeInt64(atomic.LoadInt64(&stat.ScanBytes)))
+}
+
+func DecodeStatistic(data []byte) (process.Statistic, error) {
+	var stat process.Statistic
+
+	if len(data) < 16 {
+		return stat, fmt.Errorf("statistic of %v bytes is too short", len(data))
+	}
+	stat.ScanRows = encoding.DecodeInt64(data[:8])
+	stat.ScanBytes = encoding.DecodeInt64(data[8:16])
+	return stat, nil
+}
+
 func EncodeBatch(bat *batch.Batch, buf *bytes.Buffer) error {
 	// SelsData
 	buf.Write(encoding.EncodeUint32(uint32(len(bat.SelsData))))
@@ -50,6 +50,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/untransform"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1618,3 +1619,14 @@ func TestBatch(t *testing.T) {
 		}
 	}
 }
+
+func TestStatistic(t *testing.T) {
+	var buf bytes.Buffer
+
+	EncodeStatistic(&process.Statistic{ScanRows: 123, ScanBytes: 4567}, &buf)
+	stat, err := DecodeStatistic(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, process.Statistic{ScanRows: 123, ScanBytes: 4567}, stat)
+	_, err = DecodeStatistic(buf.Bytes()[:8])
+	require.Error(t, err)
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	prime  = 0x100000001b3
+	offset = 0xcbf29ce484222325
+	null   = 0x9e3779b97f4a7c15
+)
+
+/*
+Partition splits the rows of the batch into n batches by the hash of the
+first keys vectors, rows with the same keys are in the same partition.
+The rings of the batch are copied row by row, and the batch is not freed.
+A partition without any row is nil.
+*/
+func Partition(bat *batch.Batch, keys, n int, proc *process.Process) ([]*batch.Batch, error) {
+	return PartitionBy(bat, bat.Vecs[:keys], n, proc)
+}
+
+// PartitionBy splits the rows of the batch into n batches by the hash of the
+// vectors keys like Partition, keys are vectors of the batch.
+func PartitionBy(bat *batch.Batch, keys []*vector.Vector, n int, proc *process.Process) ([]*batch.Batch, error) {
+	count := len(bat.Zs)
+	hs := make([]uint64, count)
+	Hash(keys, hs)
+	sels := make([][]int64, n)
+	for i, h := range hs {
+		sels[h%uint64(n)] = append(sels[h%uint64(n)], int64(i))
+	}
+	bs := make([]*batch.Batch, n)
+	for i := range sels {
+		if len(sels[i]) == 0 {
+			continue
+		}
+		b, err := shuffle(bat, sels[i], proc)
+		if err != nil {
+			for _, b := range bs {
+				if b != nil {
+					batch.Clean(b, proc.Mp)
+				}
+			}
+			return nil, err
+		}
+		bs[i] = b
+	}
+	return bs, nil
+}
+
+// shuffle returns a new batch of the rows sels of the batch
+func shuffle(bat *batch.Batch, sels []int64, proc *process.Process) (*batch.Batch, error) {
+	b := batch.New(true, bat.Attrs)
+	b.As = bat.As
+	b.Refs = bat.Refs
+	b.Zs = make([]int64, len(sels))
+	for i, sel := range sels {
+		b.Zs[i] = bat.Zs[sel]
+	}
+	for i, vec := range bat.Vecs {
+		b.Vecs[i] = vector.New(vec.Typ)
+		for _, sel := range sels {
+			if err := vector.UnionOne(b.Vecs[i], vec, sel, proc.Mp); err != nil {
+				batch.Clean(b, proc.Mp)
+				return nil, err
+			}
+		}
+	}
+	for _, r := range bat.Rs {
+		w := r.Dup()
+		b.Rs = append(b.Rs, w)
+		for i, sel := range sels {
+			if err := w.Grow(proc.Mp); err != nil {
+				batch.Clean(b, proc.Mp)
+				return nil, err
+			}
+			w.Add(r, int64(i), sel)
+		}
+	}
+	return b, nil
+}
+
+// Hash computes the hash values of the rows of the vectors
+func Hash(vecs []*vector.Vector, hs []uint64) {
+	for i := range hs {
+		hs[i] = offset
+	}
+	for _, vec := range vecs {
+		switch vs := vec.Col.(type) {
+		case []int8:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []int16:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []int32:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []int64:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []uint8:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []uint16:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []uint32:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []uint64:
+			for i := range hs {
+				hs[i] = mix(hs[i], vs[i])
+			}
+		case []float32:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(math.Float32bits(vs[i])))
+			}
+		case []float64:
+			for i := range hs {
+				hs[i] = mix(hs[i], math.Float64bits(vs[i]))
+			}
+		case []types.Date:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case []types.Datetime:
+			for i := range hs {
+				hs[i] = mix(hs[i], uint64(vs[i]))
+			}
+		case *types.Bytes:
+			for i := range hs {
+				for _, c := range vs.Get(int64(i)) {
+					hs[i] = (hs[i] ^ uint64(c)) * prime
+				}
+				hs[i] = mix(hs[i], uint64(vs.Lengths[i]))
+			}
+		}
+		if nulls.Any(vec.Nsp) {
+			for i := range hs {
+				if nulls.Contains(vec.Nsp, uint64(i)) {
+					hs[i] = mix(hs[i], null)
+				}
+			}
+		}
+	}
+	for i, h := range hs {
+		h ^= h >> 33
+		h *= 0xff51afd7ed558ccd
+		h ^= h >> 33
+		hs[i] = h
+	}
+}
+
+func mix(h, v uint64) uint64 {
+	return (h ^ v) * prime
+}
@@ -0,0 +1,259 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/pierrec/lz4"
+)
+
+var codec Codec
+
+var ErrNoCodec = errors.New("spill: no codec registered")
+
+// Register sets the codec of the temporary files
+func Register(c Codec) {
+	codec = c
+}
+
+/*
+Full returns true if an operator holding size bytes should spill its state.
+It is true when the state reaches the process limitation, or when the memory
+of the statement reaches it and the state is not too small to be worth
+spilling.
+*/
+func Full(proc *process.Process, size int64) bool {
+	lim := proc.Lim.Size
+	if lim <= 0 || size <= 0 {
+		return false
+	}
+	if size >= lim {
+		return true
+	}
+	if gm := proc.Mp.Gm; gm.Accounted() && size >= MinSize {
+		return gm.HostSize() >= lim-lim/4
+	}
+	return false
+}
+
+// Size returns the memory held by the batch in bytes
+func Size(bat *batch.Batch) int64 {
+	if bat == nil {
+		return 0
+	}
+	var size int64
+	for _, vec := range bat.Vecs {
+		size += int64(cap(vec.Data))
+	}
+	for _, r := range bat.Rs {
+		size += int64(r.Size())
+	}
+	return size + int64(cap(bat.Zs))*8
+}
+
+// Create creates a temporary file in the spill directory of the process
+func Create(proc *process.Process) (*File, error) {
+	if codec.Encode == nil {
+		return nil, ErrNoCodec
+	}
+	f, err := os.CreateTemp(proc.Lim.SpillDir, "mo-spill-*")
+	if err != nil {
+		return nil, err
+	}
+	sf := &File{f: f, w: bufio.NewWriter(f), typ: compress.None}
+	if proc.Lim.SpillCompress {
+		sf.typ = compress.Lz4
+	}
+	return sf, nil
+}
+
+// Len returns the number of batches in the file
+func (f *File) Len() int {
+	return f.n
+}
+
+// Size returns the size of the file in bytes
+func (f *File) Size() int64 {
+	return f.size
+}
+
+/*
+Write appends a batch to the file, the batch is not freed.
+Every batch is stored as
+
+	| length of the batch | length of the data | data |
+
+and the data is compressed when it's length is less than the length of the batch.
+*/
+func (f *File) Write(bat *batch.Batch) error {
+	// the raw data of the vectors is not needed, they are decoded from the columns
+	b := *bat
+	b.Vecs = make([]*vector.Vector, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		v := *vec
+		v.Data = nil
+		b.Vecs[i] = &v
+	}
+	f.buf.Reset()
+	if err := codec.Encode(&b, &f.buf); err != nil {
+		return err
+	}
+	data := f.buf.Bytes()
+	if f.typ == compress.Lz4 {
+		if n := lz4.CompressBlockBound(len(data)); cap(f.data) < n {
+			f.data = make([]byte, n)
+		}
+		if cdata, err := compress.Compress(data, f.data[:cap(f.data)], f.typ); err == nil && len(cdata) > 0 {
+			data = cdata
+		}
+	}
+	if _, err := f.w.Write(encoding.EncodeUint32(uint32(f.buf.Len()))); err != nil {
+		return err
+	}
+	if _, err := f.w.Write(encoding.EncodeUint32(uint32(len(data)))); err != nil {
+		return err
+	}
+	if _, err := f.w.Write(data); err != nil {
+		return err
+	}
+	f.n++
+	f.size += int64(len(data)) + 8
+	return nil
+}
+
+// Flush writes the buffered batches to the file
+func (f *File) Flush() error {
+	return f.w.Flush()
+}
+
+/*
+Open opens the file again to read its batches from the beginning, the file
+must be flushed before. The file opened shares the data with f, it can be
+read along with f and the other files opened, and closing it doesn't
+remove the data. It must be closed before f is closed.
+*/
+func (f *File) Open() (*File, error) {
+	r, err := os.Open(f.f.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		typ:    f.typ,
+		n:      f.n,
+		size:   f.size,
+		f:      r,
+		r:      bufio.NewReader(r),
+		shared: true,
+	}, nil
+}
+
+// Rewind flushes the file and prepares to read batches from the beginning
+func (f *File) Rewind() error {
+	if f.w != nil {
+		if err := f.w.Flush(); err != nil {
+			return err
+		}
+	}
+	if _, err := f.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	f.r = bufio.NewReader(f.f)
+	return nil
+}
+
+// Read returns the next batch of the file, nil at the end of the file
+func (f *File) Read(proc *process.Process) (*batch.Batch, error) {
+	var head [8]byte
+
+	if _, err := io.ReadFull(f.r, head[:]); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	n := encoding.DecodeUint32(head[:4])
+	m := encoding.DecodeUint32(head[4:])
+	data := make([]byte, m)
+	if _, err := io.ReadFull(f.r, data); err != nil {
+		return nil, err
+	}
+	if m < n {
+		buf := make([]byte, n)
+		var err error
+		if data, err = compress.Decompress(data, buf, compress.Lz4); err != nil {
+			return nil, err
+		}
+	}
+	bat, _, err := codec.Decode(data, proc)
+	if err != nil {
+		return nil, err
+	}
+	// the selection list refers to data which is not allocated from the heap
+	bat.SelsData, bat.Sels = nil, nil
+	return bat, nil
+}
+
+// Close closes and removes the file, a file opened by Open is only closed
+func (f *File) Close() error {
+	err := f.f.Close()
+	if f.shared {
+		return err
+	}
+	if rerr := os.Remove(f.f.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// CloseFiles closes and removes the files
+func CloseFiles(fs []*File) {
+	for _, f := range fs {
+		if f != nil {
+			f.Close()
+		}
+	}
+}
+
+// Chunks writes the batch into the file in batches of rows rows at most.
+func (f *File) Chunks(bat *batch.Batch, rows int, proc *process.Process) error {
+	count := len(bat.Zs)
+	if count <= rows || len(bat.Rs) > 0 {
+		return f.Write(bat)
+	}
+	for i := 0; i < count; i += rows {
+		n := count - i
+		if n > rows {
+			n = rows
+		}
+		b := batch.New(true, bat.Attrs)
+		for j, vec := range bat.Vecs {
+			b.Vecs[j] = vector.Window(vec, i, i+n, vector.New(vec.Typ))
+		}
+		b.Zs = bat.Zs[i : i+n]
+		if err := f.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	_ "github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func newProcess(t *testing.T, compress bool) *process.Process {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	proc.Lim.SpillDir = t.TempDir()
+	proc.Lim.SpillCompress = compress
+	return proc
+}
+
+// newBatch returns a batch of groups with keys and sums of the keys
+func newBatch(t *testing.T, keys []int32, names []string, proc *process.Process) *batch.Batch {
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	require.NoError(t, vector.Append(bat.Vecs[0], keys))
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	bs := make([][]byte, len(names))
+	for i, name := range names {
+		bs[i] = []byte(name)
+	}
+	require.NoError(t, vector.Append(bat.Vecs[1], bs))
+	r := sum.NewInt(types.Type{Oid: types.T_int64, Size: 8})
+	for i, key := range keys {
+		require.NoError(t, r.Grow(proc.Mp))
+		r.Vs[i] = int64(key)
+	}
+	bat.Rs = append(bat.Rs, r)
+	bat.As = []string{"sum(a)"}
+	bat.Refs = []uint64{1}
+	bat.Zs = make([]int64, len(keys))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func TestFile(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		proc := newProcess(t, compress)
+		keys := make([]int32, 10000)
+		names := make([]string, len(keys))
+		for i := range keys {
+			keys[i] = int32(i % 100)
+			names[i] = "abcdefgh"
+		}
+		bat := newBatch(t, keys, names, proc)
+
+		f, err := spill.Create(proc)
+		require.NoError(t, err)
+		require.NoError(t, f.Write(bat))
+		require.NoError(t, f.Write(bat))
+		require.Equal(t, 2, f.Len())
+		require.NoError(t, f.Rewind())
+		for i := 0; i < 2; i++ {
+			b, err := f.Read(proc)
+			require.NoError(t, err)
+			require.Equal(t, bat.Attrs, b.Attrs)
+			require.Equal(t, bat.Zs, b.Zs)
+			require.Equal(t, keys, b.Vecs[0].Col.([]int32))
+			require.Equal(t, []byte("abcdefgh"), b.Vecs[1].Col.(*types.Bytes).Get(9999))
+			require.Equal(t, bat.Rs[0].(*sum.IntRing).Vs, b.Rs[0].(*sum.IntRing).Vs)
+			batch.Clean(b, proc.Mp)
+		}
+		b, err := f.Read(proc)
+		require.NoError(t, err)
+		require.Nil(t, b)
+		if compress {
+			require.Less(t, f.Size(), int64(len(keys)*4))
+		}
+		require.NoError(t, f.Close())
+	}
+}
+
+func TestPartition(t *testing.T) {
+	proc := newProcess(t, false)
+	keys := []int32{1, 2, 3, 1, 2, 3, 4, 5, 6}
+	names := []string{"a", "b", "c", "a", "b", "c", "d", "e", "f"}
+	bat := newBatch(t, keys, names, proc)
+
+	bs, err := spill.Partition(bat, 2, spill.Partitions, proc)
+	require.NoError(t, err)
+	require.Equal(t, spill.Partitions, len(bs))
+	var rows []int
+	for _, b := range bs {
+		if b == nil {
+			continue
+		}
+		// the same keys are in the same partition
+		for i, key := range b.Vecs[0].Col.([]int32) {
+			require.Equal(t, int64(key), b.Rs[0].(*sum.IntRing).Vs[i])
+			rows = append(rows, int(key))
+		}
+		for _, other := range bs {
+			if other == nil || other == b {
+				continue
+			}
+			for _, key := range other.Vecs[0].Col.([]int32) {
+				require.NotContains(t, b.Vecs[0].Col.([]int32), key)
+			}
+		}
+	}
+	sort.Ints(rows)
+	require.Equal(t, []int{1, 1, 2, 2, 3, 3, 4, 5, 6}, rows)
+}
+
+func TestFull(t *testing.T) {
+	proc := newProcess(t, false)
+	require.False(t, spill.Full(proc, 1<<30))
+	proc.Lim.Size = 1 << 20
+	require.False(t, spill.Full(proc, 1<<10))
+	require.True(t, spill.Full(proc, 1<<20))
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// Partitions is the number of partitions of a spilled hash table
+	Partitions = 16
+	// MinSize is the minimum size of the state of an operator to spill it
+	// when the memory of the statement reaches the process limitation.
+	MinSize = 1 << 20
+)
+
+// Codec encodes the batches written into the temporary files and decodes
+// the batches read from them. The operators can't import the protocol
+// package, so the protocol package registers its functions.
+type Codec struct {
+	Encode func(*batch.Batch, *bytes.Buffer) error
+	Decode func([]byte, *process.Process) (*batch.Batch, []byte, error)
+}
+
+// File is a temporary file of batches, it is removed when it is closed.
+type File struct {
+	// typ is the compress type of the batches
+	typ int
+	// n is the number of batches written
+	n int
+	// size is the size of the file in bytes
+	size int64
+	// shared is true if the file is opened by Open, it reads the data of another file
+	shared bool
+	f      *os.File
+	w      *bufio.Writer
+	r      *bufio.Reader
+	buf    bytes.Buffer
+	data   []byte
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Column returns the statistics of the attribute name, it returns nil if the
+// attribute is not analyzed.
+func Column(stats *engine.StatisticsDef, name string) *engine.ColumnStatistics {
+	if stats == nil {
+		return nil
+	}
+	for i := range stats.Columns {
+		if stats.Columns[i].Name == name {
+			return &stats.Columns[i]
+		}
+	}
+	return nil
+}
+
+// Rows estimates the number of rows of a relation which has rows rows after
+// the conditions are applied.
+func Rows(rows int64, stats *engine.StatisticsDef, conds []extend.Extend) float64 {
+	n := float64(rows)
+	for _, cond := range conds {
+		n *= Selectivity(cond, stats)
+	}
+	return n
+}
+
+// JoinSelectivity estimates the selectivity of the join condition R.r = S.s,
+// rrows and srows are the number of rows of R and S. Without statistics the
+// join attribute of the smaller relation is treated as a key.
+func JoinSelectivity(r, s *engine.ColumnStatistics, rrows, srows int64) float64 {
+	var ndv int64
+
+	if r != nil && r.NDV > ndv {
+		ndv = r.NDV
+	}
+	if s != nil && s.NDV > ndv {
+		ndv = s.NDV
+	}
+	if ndv == 0 {
+		if ndv = rrows; srows < ndv {
+			ndv = srows
+		}
+	}
+	if ndv <= 0 {
+		return 1
+	}
+	return 1 / float64(ndv)
+}
+
+// Selectivity estimates the fraction of the rows of a relation which satisfy the condition e.
+func Selectivity(e extend.Extend, stats *engine.StatisticsDef) float64 {
+	switch e := e.(type) {
+	case *extend.ParenExtend:
+		return Selectivity(e.E, stats)
+	case *extend.UnaryExtend:
+		if e.Op == overload.Not {
+			return 1 - Selectivity(e.E, stats)
+		}
+	case *extend.BinaryExtend:
+		switch e.Op {
+		case overload.And:
+			return Selectivity(e.Left, stats) * Selectivity(e.Right, stats)
+		case overload.Or:
+			l, r := Selectivity(e.Left, stats), Selectivity(e.Right, stats)
+			return l + r - l*r
+		case overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE:
+			return compareSelectivity(e, stats)
+		}
+	}
+	return defaultSelectivity
+}
+
+// compareSelectivity estimates the selectivity of the comparison between an attribute and a constant
+func compareSelectivity(e *extend.BinaryExtend, stats *engine.StatisticsDef) float64 {
+	op := e.Op
+	attr, ok := e.Left.(*extend.Attribute)
+	val, vok := e.Right.(*extend.ValueExtend)
+	if !ok || !vok {
+		attr, ok = e.Right.(*extend.Attribute)
+		val, vok = e.Left.(*extend.ValueExtend)
+		if !ok || !vok {
+			return defaultSelectivity
+		}
+		op = reverse(op)
+	}
+	c := Column(stats, attr.Name)
+	if c == nil || stats.Rows == 0 {
+		if op == overload.EQ {
+			return defaultEqSelectivity
+		}
+		return defaultSelectivity
+	}
+	v, ok := vectorValue(val.V, 0)
+	if !ok {
+		return 0 // comparison with null
+	}
+	notNull := 1 - float64(c.Nulls)/float64(stats.Rows)
+	switch op {
+	case overload.EQ:
+		if c.NDV == 0 || v < c.Min || v > c.Max {
+			return 0
+		}
+		return notNull / float64(c.NDV)
+	case overload.NE:
+		if c.NDV == 0 {
+			return notNull
+		}
+		return notNull * (1 - 1/float64(c.NDV))
+	case overload.LT, overload.LE:
+		return notNull * fraction(c, v)
+	default:
+		return notNull * (1 - fraction(c, v))
+	}
+}
+
+// fraction estimates the fraction of the not null values of the column which
+// are less than v.
+func fraction(c *engine.ColumnStatistics, v float64) float64 {
+	var cnt, total float64
+
+	if v <= c.Min {
+		return 0
+	}
+	if v > c.Max {
+		return 1
+	}
+	if len(c.Buckets) == 0 {
+		if c.Max == c.Min {
+			return defaultSelectivity
+		}
+		return (v - c.Min) / (c.Max - c.Min)
+	}
+	for _, b := range c.Buckets {
+		total += float64(b.Count)
+	}
+	lower := c.Min
+	for _, b := range c.Buckets {
+		if v <= b.Upper {
+			if b.Upper > lower {
+				cnt += float64(b.Count) * (v - lower) / (b.Upper - lower)
+			}
+			return cnt / total
+		}
+		cnt += float64(b.Count)
+		lower = b.Upper
+	}
+	return 1
+}
+
+// reverse returns the operator of the comparison whose operands are swapped
+func reverse(op int) int {
+	switch op {
+	case overload.LT:
+		return overload.GT
+	case overload.LE:
+		return overload.GE
+	case overload.GT:
+		return overload.LT
+	case overload.GE:
+		return overload.LE
+	}
+	return op
+}
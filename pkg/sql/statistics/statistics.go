@@ -0,0 +1,227 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Collect scans the relation and returns the statistics of the attributes.
+func Collect(r engine.Relation, attrs []engine.Attribute, proc *process.Process) (*engine.StatisticsDef, error) {
+	stats := &engine.StatisticsDef{}
+	if len(attrs) == 0 {
+		return stats, nil
+	}
+	cs := make([]*collector, len(attrs))
+	names := make([]string, len(attrs))
+	refs := make([]uint64, len(attrs))
+	for i, attr := range attrs {
+		cs[i] = &collector{
+			name: attr.Name,
+			min:  math.Inf(1),
+			max:  math.Inf(-1),
+			ndv:  approxcd.NewApproxCountDistinct(attr.Type),
+			rnd:  rand.New(rand.NewSource(int64(i))),
+		}
+		if err := cs[i].ndv.Grow(proc.Mp); err != nil {
+			return nil, err
+		}
+		defer cs[i].ndv.Free(proc.Mp)
+		names[i] = attr.Name
+		refs[i] = 1
+	}
+	for _, rd := range r.NewReader(1) {
+		for {
+			bat, err := rd.Read(refs, names)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			stats.Rows += int64(vector.Length(bat.Vecs[0]))
+			for i, vec := range bat.Vecs {
+				cs[i].fill(vec)
+			}
+		}
+	}
+	stats.Columns = make([]engine.ColumnStatistics, len(cs))
+	for i, c := range cs {
+		stats.Columns[i] = c.statistics()
+	}
+	return stats, nil
+}
+
+// Value maps the value v to a float64 which keeps the order of the values of
+// the same type, strings are mapped by their first 8 bytes.
+func Value(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case types.Date:
+		return float64(v), true
+	case types.Datetime:
+		return float64(v), true
+	case []byte:
+		return bytesValue(v), true
+	case string:
+		return bytesValue([]byte(v)), true
+	}
+	return 0, false
+}
+
+// vectorValue returns the i-th value of the vector, the second result is false
+// if the value is null or the type of the vector is not supported.
+func vectorValue(vec *vector.Vector, i int64) (float64, bool) {
+	if nulls.Contains(vec.Nsp, uint64(i)) {
+		return 0, false
+	}
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return float64(vs[i]), true
+	case []int16:
+		return float64(vs[i]), true
+	case []int32:
+		return float64(vs[i]), true
+	case []int64:
+		return float64(vs[i]), true
+	case []uint8:
+		return float64(vs[i]), true
+	case []uint16:
+		return float64(vs[i]), true
+	case []uint32:
+		return float64(vs[i]), true
+	case []uint64:
+		return float64(vs[i]), true
+	case []float32:
+		return float64(vs[i]), true
+	case []float64:
+		return vs[i], true
+	case []types.Date:
+		return float64(vs[i]), true
+	case []types.Datetime:
+		return float64(vs[i]), true
+	case *types.Bytes:
+		return bytesValue(vs.Get(i)), true
+	}
+	return 0, false
+}
+
+func bytesValue(data []byte) float64 {
+	var buf [8]byte
+
+	copy(buf[:], data)
+	return float64(binary.BigEndian.Uint64(buf[:]))
+}
+
+func (c *collector) fill(vec *vector.Vector) {
+	n := int64(vector.Length(vec))
+	cnt := int64(nulls.Length(vec.Nsp))
+	c.nulls += cnt
+	if cnt == n {
+		return
+	}
+	c.ndv.BulkFill(0, nil, vec)
+	for i := int64(0); i < n; i++ {
+		v, ok := vectorValue(vec, i)
+		if !ok {
+			continue
+		}
+		c.rows++
+		if v < c.min {
+			c.min = v
+		}
+		if v > c.max {
+			c.max = v
+		}
+		if len(c.sample) < SampleSize {
+			c.sample = append(c.sample, v)
+		} else if j := c.rnd.Int63n(c.rows); j < SampleSize {
+			c.sample[j] = v
+		}
+	}
+}
+
+func (c *collector) statistics() engine.ColumnStatistics {
+	cs := engine.ColumnStatistics{
+		Name:  c.name,
+		Nulls: c.nulls,
+	}
+	if c.rows == 0 {
+		return cs
+	}
+	cs.Min, cs.Max = c.min, c.max
+	if cs.NDV = int64(c.ndv.Sk[0].Estimate()); cs.NDV > c.rows {
+		cs.NDV = c.rows
+	}
+	cs.Buckets = histogram(c.sample, c.rows)
+	return cs
+}
+
+// histogram builds an equi-depth histogram of rows values from the sample,
+// the equal values are always in the same bucket.
+func histogram(sample []float64, rows int64) []engine.Bucket {
+	var cnt int64
+	var bs []engine.Bucket
+
+	n := len(sample)
+	if n == 0 {
+		return nil
+	}
+	sort.Float64s(sample)
+	depth := (n + MaxBuckets - 1) / MaxBuckets
+	for i := 0; i < n; {
+		j := i + depth
+		if j > n {
+			j = n
+		}
+		for j < n && sample[j] == sample[j-1] {
+			j++
+		}
+		c := int64(j) * rows / int64(n)
+		bs = append(bs, engine.Bucket{Upper: sample[j-1], Count: c - cnt})
+		cnt = c
+		i = j
+	}
+	return bs
+}
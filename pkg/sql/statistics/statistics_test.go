@@ -0,0 +1,161 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollect(t *testing.T) {
+	stats := analyze(t, "R")
+	require.Equal(t, int64(20), stats.Rows)
+	require.Equal(t, 3, len(stats.Columns))
+
+	uid := Column(stats, "uid")
+	require.NotNil(t, uid)
+	require.Equal(t, int64(4), uid.NDV)
+	require.Equal(t, int64(0), uid.Nulls)
+	require.Equal(t, float64(0), uid.Min)
+	require.Equal(t, float64(3), uid.Max)
+
+	price := Column(stats, "price")
+	require.NotNil(t, price)
+	require.Equal(t, int64(20), price.NDV)
+	require.Equal(t, float64(19), price.Max)
+	var cnt int64
+	for i, b := range price.Buckets {
+		if i > 0 {
+			require.Less(t, price.Buckets[i-1].Upper, b.Upper)
+		}
+		cnt += b.Count
+	}
+	require.Equal(t, int64(20), cnt)
+
+	orderId := Column(stats, "orderId")
+	require.NotNil(t, orderId)
+	require.Equal(t, int64(20), orderId.NDV)
+	require.Less(t, orderId.Min, orderId.Max)
+	require.Nil(t, Column(stats, "name"))
+}
+
+func TestPersist(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	r, err := db.Relation("R")
+	require.NoError(t, err)
+	stats, err := Collect(r, attributes(r), newProcess())
+	require.NoError(t, err)
+	require.NoError(t, r.AddTableDef(0, stats))
+	r.Close()
+
+	r, err = db.Relation("R")
+	require.NoError(t, err)
+	defer r.Close()
+	var saved *engine.StatisticsDef
+	for _, def := range r.TableDefs() {
+		if v, ok := def.(*engine.StatisticsDef); ok {
+			saved = v
+		}
+	}
+	require.Equal(t, stats, saved)
+}
+
+func TestSelectivity(t *testing.T) {
+	stats := analyze(t, "R")
+	kases := []struct {
+		e   extend.Extend
+		sel float64
+	}{
+		{compare(overload.EQ, "uid", 2), 0.25},
+		{compare(overload.NE, "uid", 2), 0.75},
+		{compare(overload.EQ, "uid", 7), 0},
+		{compare(overload.LT, "price", 10), 0.5},
+		{compare(overload.GE, "price", 15), 0.25},
+		{compare(overload.GT, "price", 100), 0},
+		{compare(overload.EQ, "name", 1), defaultEqSelectivity},
+		{&extend.BinaryExtend{
+			Op:    overload.And,
+			Left:  compare(overload.EQ, "uid", 2),
+			Right: compare(overload.LT, "price", 10),
+		}, 0.125},
+		{&extend.BinaryExtend{
+			Op:    overload.Or,
+			Left:  compare(overload.EQ, "uid", 2),
+			Right: compare(overload.EQ, "uid", 3),
+		}, 0.4375},
+	}
+	for _, kase := range kases {
+		require.InDelta(t, kase.sel, Selectivity(kase.e, stats), 0.1, kase.e.String())
+	}
+	{ // constant on the left
+		e := &extend.BinaryExtend{
+			Op:    overload.GT,
+			Left:  &extend.ValueExtend{V: testutil.MakeFloat64Vector([]float64{5}, 0)},
+			Right: &extend.Attribute{Name: "price", Type: types.T_float64},
+		}
+		require.InDelta(t, 0.25, Selectivity(e, stats), 0.1)
+	}
+	require.InDelta(t, 5, Rows(stats.Rows, stats, []extend.Extend{compare(overload.EQ, "uid", 2)}), 0.5)
+	require.Equal(t, 0.25, JoinSelectivity(Column(stats, "uid"), nil, 20, 20))
+	require.Equal(t, 0.1, JoinSelectivity(nil, nil, 100, 10))
+}
+
+func analyze(t *testing.T, name string) *engine.StatisticsDef {
+	e := memEngine.NewTestEngine()
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	r, err := db.Relation(name)
+	require.NoError(t, err)
+	defer r.Close()
+	stats, err := Collect(r, attributes(r), newProcess())
+	require.NoError(t, err)
+	return stats
+}
+
+func attributes(r engine.Relation) []engine.Attribute {
+	var attrs []engine.Attribute
+
+	for _, def := range r.TableDefs() {
+		if v, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, v.Attr)
+		}
+	}
+	return attrs
+}
+
+func compare(op int, name string, v float64) extend.Extend {
+	return &extend.BinaryExtend{
+		Op:    op,
+		Left:  &extend.Attribute{Name: name, Type: types.T_float64},
+		Right: &extend.ValueExtend{V: testutil.MakeFloat64Vector([]float64{v}, 0)},
+	}
+}
+
+func newProcess() *process.Process {
+	return process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"math/rand"
+
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+)
+
+const (
+	// MaxBuckets is the max number of buckets of a histogram
+	MaxBuckets = 64
+	// SampleSize is the number of values of each column kept to build the histogram
+	SampleSize = 1 << 14
+)
+
+const (
+	// defaultSelectivity is the selectivity of the conditions which can not be estimated
+	defaultSelectivity = 1.0 / 3
+	// defaultEqSelectivity is the selectivity of an equality without statistics
+	defaultEqSelectivity = 0.1
+)
+
+// collector gathers the statistics of a column
+type collector struct {
+	name  string
+	rows  int64 // number of not null values
+	nulls int64
+	min   float64
+	max   float64
+	ndv   *approxcd.ApproxCountDistinctRing
+	// sample is a reservoir sample of the not null values
+	sample []float64
+	rnd    *rand.Rand
+}
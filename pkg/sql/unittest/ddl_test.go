@@ -89,4 +89,155 @@ func TestIndexFunction(t *testing.T) {
 		{sql: "drop index noeindex on tbl;", err: "[42602]index doesn't exist"},
 	}
 	test(t, testCases)
-}
\ No newline at end of file
+}
+// TestAlterTableFunction checks the rows written before ALTER TABLE are read with the new schema
+func TestAlterTableFunction(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table alt1 (a int, b varchar(10));"},
+		{sql: "insert into alt1 values (1, 'x'), (2, 'y');"},
+		{sql: "alter table alt1 add column c int default 7;"},
+		{sql: "insert into alt1 values (3, 'z', 9);"},
+		{sql: "select * from alt1 order by a;", res: executeResult{
+			attr: []string{"a", "b", "c"},
+			data: [][]string{
+				{"1", "x", "7"},
+				{"2", "y", "7"},
+				{"3", "z", "9"},
+			},
+		}},
+		{sql: "alter table alt1 drop column b, rename column a to id;"},
+		{sql: "alter table alt1 alter column c set default 5;"},
+		{sql: "insert into alt1 (id) values (4);"},
+		{sql: "select * from alt1 order by id;", res: executeResult{
+			attr: []string{"id", "c"},
+			data: [][]string{
+				{"1", "7"},
+				{"2", "7"},
+				{"3", "9"},
+				{"4", "5"},
+			},
+		}},
+		{sql: "alter table alt1 add b int default 0, drop c;"},
+		{sql: "select * from alt1 where id > 2 order by id;", res: executeResult{
+			attr: []string{"id", "b"},
+			data: [][]string{
+				{"3", "0"},
+				{"4", "0"},
+			},
+		}},
+		{sql: "alter table alt1 drop column x;", err: "[42703]Can't DROP 'x'; check that column/key exists"},
+		{sql: "alter table alt1 add column id int;", err: "[42701]Duplicate column name 'id'"},
+		{sql: "alter table alt1 rename column x to y;", err: "[42703]Unknown column 'x' in 'alt1'"},
+		{sql: "alter table alt1 alter column id set default 'abc';", err: "[42611]Invalid default value for 'id'"},
+		{sql: "alter table alt1 drop column id, drop column b;", err: "[42P16]You can't delete all columns with ALTER TABLE; use DROP TABLE instead"},
+		{sql: "alter table alt1 rename to alt2;"},
+		{sql: "rename table alt2 to alt3;"},
+		{sql: "select id from alt3 order by id;", res: executeResult{
+			attr: []string{"id"},
+			data: [][]string{
+				{"1"},
+				{"2"},
+				{"3"},
+				{"4"},
+			},
+		}},
+		{sql: "select id from alt1;", err: "[42000]not exist"},
+	}
+	test(t, testCases)
+}
+
+// TestViewFunction checks the views and the common table expressions, which are merged or materialised
+func TestViewFunction(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table vt1 (a int, b varchar(10), c bigint);"},
+		{sql: "insert into vt1 values (1, 'x', 10), (2, 'y', 20), (3, 'z', 30), (4, 'x', 40);"},
+		{sql: "create view v1 as select a, c from vt1 where c > 15;"},
+		{sql: "select * from v1 order by a;", res: executeResult{
+			attr: []string{"a", "c"},
+			data: [][]string{
+				{"2", "20"},
+				{"3", "30"},
+				{"4", "40"},
+			},
+		}},
+		{sql: "select a from v1 where c < 40 order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"2"},
+				{"3"},
+			},
+		}},
+		{sql: "select b from v1;", err: "[42000]Column 'b' doesn't exist"},
+		{sql: "create view v2 (name, total) as select b, sum(c) from vt1 group by b;"},
+		{sql: "select name, total from v2 order by name;", res: executeResult{
+			attr: []string{"name", "total"},
+			data: [][]string{
+				{"x", "50"},
+				{"y", "20"},
+				{"z", "30"},
+			},
+		}},
+		{sql: "select sum(v1.a) from v1 join v2 on v1.c = v2.total;", res: executeResult{
+			attr: []string{"sum(v1.a)"},
+			data: [][]string{
+				{"5"},
+			},
+		}},
+		{sql: "with t as (select a, c from vt1 where a > 1), s as (select c from t where c < 40) select sum(t.a) from t join s on t.c = s.c;", res: executeResult{
+			attr: []string{"sum(t.a)"},
+			data: [][]string{
+				{"5"},
+			},
+		}},
+		{sql: "with g (k, n) as (select b, count(*) from vt1 group by b) select count(*) from g as x join g as y on x.k = y.k where x.n > 1;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{
+				{"1"},
+			},
+		}},
+		{sql: "with t as (select a from vt1), t as (select a from vt1) select a from t;", err: "[42P07]Not unique table/alias: 't'"},
+		{sql: "with t (x, y) as (select a from vt1) select x from t;", err: "[42P16]In definition of view, derived table or common table expression, SELECT list and column names list have different column counts"},
+		{sql: "create view v1 as select a from vt1;", err: "[42P07]Table 'v1' already exists"},
+		{sql: "create or replace view v1 as select a, b from vt1 where a < 3;"},
+		{sql: "select * from v1 order by a;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"1", "x"},
+				{"2", "y"},
+			},
+		}},
+		{sql: "create or replace view vt1 as select a from v1;", err: "[42P07]Table 'vt1' already exists"},
+		{sql: "create view v3 (x, y) as select a from vt1;", err: "[42P16]In definition of view, derived table or common table expression, SELECT list and column names list have different column counts"},
+		{sql: "show create view v1;", res: executeResult{
+			attr: []string{"View", "Create View", "character_set_client", "collation_connection"},
+			data: [][]string{
+				{"v1", "CREATE VIEW `v1` (`a`, `b`) AS select a, b from vt1 where a < 3", "utf8mb4", "utf8mb4_0900_ai_ci"},
+			},
+		}},
+		{sql: "create view v4 as select a from v1 where a > 1;"},
+		{sql: "select * from v4;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"2"},
+			},
+		}},
+		{sql: "create or replace view v1 as select a from v4;", err: "[42P16]View 'test.v1' refers to itself"},
+		{sql: "with t as (select a from vt1 order by a desc limit 2) select * from t order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"3"},
+				{"4"},
+			},
+		}},
+		{sql: "show create view vt1;", err: "[42809]'test.vt1' is not VIEW"},
+		{sql: "insert into v1 values (5, 'w');", err: "[42809]The target table v1 of the INSERT is not insertable-into"},
+		{sql: "alter table v1 add column d int;", err: "[42809]'test.v1' is not BASE TABLE"},
+		{sql: "drop table v1;", err: "[42P01]Unknown table 'test.v1'"},
+		{sql: "drop view vt1;", err: "[42809]'test.vt1' is not VIEW"},
+		{sql: "drop view v1, v2, v4;"},
+		{sql: "drop view v1;", err: "[42P01]Unknown table 'test.v1'"},
+		{sql: "drop view if exists v1;"},
+		{sql: "select * from v1;", err: "[42000]not exist"},
+	}
+	test(t, testCases)
+}
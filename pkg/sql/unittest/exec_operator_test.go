@@ -646,6 +646,16 @@ func TestRestrictOperator(t *testing.T) {
 				{"4", "0"}, {"6", "17"}, {"1", "30"}, {"5", "100"},
 			},
 		}},
+		{sql: "select userID,MAX(score) from t1 where userID in (2, 3, 7) group by userID order by userID;", res: executeResult{
+			data: [][]string{
+				{"2", "40"}, {"3", "50"},
+			},
+		}},
+		{sql: "select userID,MAX(score) from t1 where userID not in (2, 3) group by userID order by userID desc;", res: executeResult{
+			data: [][]string{
+				{"6", "17"}, {"5", "100"}, {"4", "0"}, {"1", "30"},
+			},
+		}},
 	}
 	test(t, testCases)
 }
\ No newline at end of file
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -54,7 +55,7 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 		switch ctr.state {
 		case Fill:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-				bat := <-proc.Reg.MergeReceivers[i].Ch
+				bat := process.Receive(proc.Reg.MergeReceivers[i])
 				if bat == nil {
 					continue
 				}
@@ -85,16 +86,55 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 }
 
 func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
+	// the groups of a single receiver are merged already, so they are sent as they are received
+	if ctr.state == Fill && len(proc.Reg.MergeReceivers) == 1 {
+		for {
+			bat := process.Receive(proc.Reg.MergeReceivers[0])
+			if bat == nil {
+				proc.Reg.MergeReceivers = nil
+				ctr.state = Eval
+				return true, nil
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
+		}
+	}
 	for {
 		switch ctr.state {
 		case Fill:
 			if err := ctr.fill(proc); err != nil {
-				batch.Clean(ctr.bat, proc.Mp)
+				ctr.clean(proc)
 				proc.Reg.InputBatch = nil
 				ctr.state = Eval
 				return true, err
 			}
 			ctr.state = Eval
+			if ctr.parts != nil {
+				if err := ctr.spill(proc); err != nil {
+					ctr.clean(proc)
+					proc.Reg.InputBatch = nil
+					return true, err
+				}
+				ctr.state = Partition
+			}
+		case Partition:
+			ok, err := ctr.fillPartition(proc)
+			if err != nil {
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				ctr.state = Eval
+				return true, err
+			}
+			if !ok {
+				ctr.state = Eval
+				continue
+			}
+			proc.Reg.InputBatch = ctr.bat
+			ctr.bat = nil
+			return false, nil
 		case Eval:
 			if ctr.bat != nil {
 				proc.Reg.InputBatch = ctr.bat
@@ -107,22 +147,11 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 }
 
 func (ctr *Container) fill(proc *process.Process) error {
-	if len(proc.Reg.MergeReceivers) == 1 {
-		for {
-			bat := <-proc.Reg.MergeReceivers[0].Ch
-			if bat == nil {
-				return nil
-			}
-			if len(bat.Zs) == 0 {
-				continue
-			}
-			ctr.bat = bat
-			return nil
-		}
-	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
+		bat := process.Receive(proc.Reg.MergeReceivers[i])
 		if bat == nil {
+			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+			i--
 			continue
 		}
 		if len(bat.Zs) == 0 {
@@ -132,10 +161,138 @@ func (ctr *Container) fill(proc *process.Process) error {
 		if err := ctr.fillBatch(bat, proc); err != nil {
 			return err
 		}
+		// the groups are written to the partitions in the temporary files
+		// when they exceed the memory limitation
+		if spill.Full(proc, spill.Size(ctr.bat)) {
+			if err := ctr.spill(proc); err != nil {
+				return err
+			}
+		}
+		i--
 	}
 	return nil
 }
 
+/*
+spill splits the groups by their keys into the partitions and appends them to
+the temporary files of the partitions, then the container starts over with an
+empty hash table. The groups of a partition are merged at the end by fillPartition.
+*/
+func (ctr *Container) spill(proc *process.Process) error {
+	if ctr.parts == nil {
+		ctr.parts = make([]*spill.File, spill.Partitions)
+	}
+	if ctr.bat == nil {
+		return nil
+	}
+	bs, err := spill.Partition(ctr.bat, len(ctr.bat.Vecs), len(ctr.parts), proc)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, b := range bs {
+			if b != nil {
+				batch.Clean(b, proc.Mp)
+			}
+		}
+	}()
+	for i, b := range bs {
+		if b == nil {
+			continue
+		}
+		if ctr.parts[i] == nil {
+			if ctr.parts[i], err = spill.Create(proc); err != nil {
+				return err
+			}
+		}
+		if err = ctr.parts[i].Write(b); err != nil {
+			return err
+		}
+	}
+	batch.Clean(ctr.bat, proc.Mp)
+	ctr.reset()
+	return nil
+}
+
+// fillPartition merges the groups of the next partition, it returns false if there is none
+func (ctr *Container) fillPartition(proc *process.Process) (bool, error) {
+	for ; ctr.part < len(ctr.parts); ctr.part++ {
+		f := ctr.parts[ctr.part]
+		if f == nil {
+			continue
+		}
+		if err := f.Rewind(); err != nil {
+			return false, err
+		}
+		ctr.reset()
+		for {
+			bat, err := f.Read(proc)
+			if err != nil {
+				return false, err
+			}
+			if bat == nil {
+				break
+			}
+			if err := ctr.fillBatch(bat, proc); err != nil {
+				return false, err
+			}
+		}
+		f.Close()
+		ctr.parts[ctr.part] = nil
+		if ctr.bat != nil {
+			ctr.part++
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// reset empties the groups and the hash table of the container
+func (ctr *Container) reset() {
+	ctr.bat = nil
+	ctr.rows = 0
+	switch ctr.typ {
+	case H8:
+		ctr.intHashMap = &hashtable.Int64HashMap{}
+		ctr.intHashMap.Init()
+	default:
+		ctr.strHashMap = &hashtable.StringHashMap{}
+		ctr.strHashMap.Init()
+	}
+}
+
+// clean frees the groups and removes the temporary files
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	spill.CloseFiles(ctr.parts)
+	ctr.parts = nil
+}
+
+// newBatch returns an empty batch of groups like the batch, it is used when
+// the batch has no hash table, that is a batch read from a partition.
+func (ctr *Container) newBatch(bat *batch.Batch) *batch.Batch {
+	b := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		b.Vecs[i] = vector.New(vec.Typ)
+		b.Vecs[i].Ref = vec.Ref
+	}
+	for _, r := range bat.Rs {
+		b.Rs = append(b.Rs, r.Dup())
+	}
+	b.As = bat.As
+	b.Refs = bat.Refs
+	switch ctr.typ {
+	case H8:
+		b.Ht = ctr.intHashMap
+	default:
+		b.Ht = ctr.strHashMap
+	}
+	return b
+}
+
 func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 	if len(ctr.vars) == 0 {
 		ctr.vars = append(ctr.vars, bat.Attrs...)
@@ -229,108 +386,17 @@ func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
-	vecs := bat.Vecs
-	count := int64(len(bat.Zs))
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
-		for i := int64(0); i < count; i += UnitLimit {
-			n := count - i
-			if n > UnitLimit {
-				n = UnitLimit
-			}
-			copy(ctr.keyOffs, ctr.zKeyOffs)
-			copy(ctr.h8.keys, ctr.h8.zKeys)
-			for j, vec := range vecs {
-				switch vec.Typ.Oid {
-				case types.T_int8:
-					vs := vecs[j].Col.([]int8)
-					for k := int64(0); k < n; k++ {
-						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint8:
-					vs := vecs[j].Col.([]uint8)
-					for k := int64(0); k < n; k++ {
-						*(*uint8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int16:
-					vs := vecs[j].Col.([]int16)
-					for k := int64(0); k < n; k++ {
-						*(*int16)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint16:
-					vs := vecs[j].Col.([]uint16)
-					for k := int64(0); k < n; k++ {
-						*(*uint16)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int32:
-					vs := vecs[j].Col.([]int32)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint32:
-					vs := vecs[j].Col.([]uint32)
-					for k := int64(0); k < n; k++ {
-						*(*uint32)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float32:
-					vs := vecs[j].Col.([]float32)
-					for k := int64(0); k < n; k++ {
-						*(*float32)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_date:
-					vs := vecs[j].Col.([]types.Date)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int32(vs[i+k])
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int64:
-					vs := vecs[j].Col.([]int64)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint64:
-					vs := vecs[j].Col.([]uint64)
-					for k := int64(0); k < n; k++ {
-						*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float64:
-					vs := vecs[j].Col.([]float64)
-					for k := int64(0); k < n; k++ {
-						*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_datetime:
-					vs := vecs[j].Col.([]types.Datetime)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_char, types.T_varchar:
-					vs := vecs[j].Col.(*types.Bytes)
-					vData := vs.Data
-					vOff := vs.Offsets
-					vLen := vs.Lengths
-					for k := int64(0); k < n; k++ {
-						copy(unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h8.keys[k])), 8)[ctr.keyOffs[k]:], vData[vOff[i+k]:vOff[i+k]+vLen[i+k]])
-						ctr.keyOffs[k] += vLen[i+k]
-					}
-				}
-			}
-			ctr.hashes[0] = 0
-			ctr.intHashMap.InsertBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
-		}
+		ctr.intHashMap = bat.Ht.(*hashtable.Int64HashMap)
 		ctr.rows = ctr.intHashMap.Cardinality()
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
+	vecs := bat.Vecs
+	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
 	for i := int64(0); i < count; i += UnitLimit {
 		n := count - i
@@ -460,106 +526,17 @@ func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
-	vecs := bat.Vecs
-	count := int64(len(bat.Zs))
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
-		for i := int64(0); i < count; i += UnitLimit {
-			n := count - i
-			if n > UnitLimit {
-				n = UnitLimit
-			}
-			copy(ctr.keyOffs, ctr.zKeyOffs)
-			copy(ctr.h24.keys, ctr.h24.zKeys)
-			data := unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h24.keys[0])), cap(ctr.h24.keys)*24)[:len(ctr.h24.keys)*24]
-			for j, vec := range vecs {
-				switch vec.Typ.Oid {
-				case types.T_int8:
-					vs := vecs[j].Col.([]int8)
-					for k := int64(0); k < n; k++ {
-						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint8:
-					vs := vecs[j].Col.([]uint8)
-					for k := int64(0); k < n; k++ {
-						*(*uint8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int16:
-					vs := vecs[j].Col.([]int16)
-					for k := int64(0); k < n; k++ {
-						*(*int16)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint16:
-					vs := vecs[j].Col.([]uint16)
-					for k := int64(0); k < n; k++ {
-						*(*uint16)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int32:
-					vs := vecs[j].Col.([]int32)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint32:
-					vs := vecs[j].Col.([]uint32)
-					for k := int64(0); k < n; k++ {
-						*(*uint32)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float32:
-					vs := vecs[j].Col.([]float32)
-					for k := int64(0); k < n; k++ {
-						*(*float32)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_date:
-					vs := vecs[j].Col.([]types.Date)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int32(vs[i+k])
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int64:
-					vs := vecs[j].Col.([]int64)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint64:
-					vs := vecs[j].Col.([]uint64)
-					for k := int64(0); k < n; k++ {
-						*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float64:
-					vs := vecs[j].Col.([]float64)
-					for k := int64(0); k < n; k++ {
-						*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_datetime:
-					vs := vecs[j].Col.([]types.Datetime)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_char, types.T_varchar:
-					vs := vecs[j].Col.(*types.Bytes)
-					for k := int64(0); k < n; k++ {
-						key := vs.Get(i + k)
-						copy(data[k*24+int64(ctr.keyOffs[k]):], key)
-						ctr.keyOffs[k] += uint32(len(key))
-					}
-				}
-			}
-			ctr.strHashMap.InsertString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
-		}
+		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows = ctr.strHashMap.Cardinality()
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
+	vecs := bat.Vecs
+	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
 	for i := int64(0); i < count; i += UnitLimit {
 		n := count - i
@@ -687,106 +664,17 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
-	vecs := bat.Vecs
-	count := int64(len(bat.Zs))
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
-		for i := int64(0); i < count; i += UnitLimit {
-			n := count - i
-			if n > UnitLimit {
-				n = UnitLimit
-			}
-			copy(ctr.keyOffs, ctr.zKeyOffs)
-			copy(ctr.h32.keys, ctr.h32.zKeys)
-			data := unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h32.keys[0])), cap(ctr.h32.keys)*32)[:len(ctr.h32.keys)*32]
-			for j, vec := range vecs {
-				switch vec.Typ.Oid {
-				case types.T_int8:
-					vs := vecs[j].Col.([]int8)
-					for k := int64(0); k < n; k++ {
-						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint8:
-					vs := vecs[j].Col.([]uint8)
-					for k := int64(0); k < n; k++ {
-						*(*uint8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int16:
-					vs := vecs[j].Col.([]int16)
-					for k := int64(0); k < n; k++ {
-						*(*int16)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint16:
-					vs := vecs[j].Col.([]uint16)
-					for k := int64(0); k < n; k++ {
-						*(*uint16)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int32:
-					vs := vecs[j].Col.([]int32)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint32:
-					vs := vecs[j].Col.([]uint32)
-					for k := int64(0); k < n; k++ {
-						*(*uint32)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float32:
-					vs := vecs[j].Col.([]float32)
-					for k := int64(0); k < n; k++ {
-						*(*float32)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_date:
-					vs := vecs[j].Col.([]types.Date)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int32(vs[i+k])
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int64:
-					vs := vecs[j].Col.([]int64)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint64:
-					vs := vecs[j].Col.([]uint64)
-					for k := int64(0); k < n; k++ {
-						*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float64:
-					vs := vecs[j].Col.([]float64)
-					for k := int64(0); k < n; k++ {
-						*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_datetime:
-					vs := vecs[j].Col.([]types.Datetime)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_char, types.T_varchar:
-					vs := vecs[j].Col.(*types.Bytes)
-					for k := int64(0); k < n; k++ {
-						key := vs.Get(i + k)
-						copy(data[k*32+int64(ctr.keyOffs[k]):], key)
-						ctr.keyOffs[k] += uint32(len(key))
-					}
-				}
-			}
-			ctr.strHashMap.InsertString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
-		}
+		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows = ctr.strHashMap.Cardinality()
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
+	vecs := bat.Vecs
+	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
 	for i := int64(0); i < count; i += UnitLimit {
 		n := count - i
@@ -914,106 +802,17 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
-	vecs := bat.Vecs
-	count := int64(len(bat.Zs))
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
-		for i := int64(0); i < count; i += UnitLimit {
-			n := count - i
-			if n > UnitLimit {
-				n = UnitLimit
-			}
-			copy(ctr.keyOffs, ctr.zKeyOffs)
-			copy(ctr.h40.keys, ctr.h40.zKeys)
-			data := unsafe.Slice((*byte)(unsafe.Pointer(&ctr.h40.keys[0])), cap(ctr.h40.keys)*40)[:len(ctr.h40.keys)*40]
-			for j, vec := range vecs {
-				switch vec.Typ.Oid {
-				case types.T_int8:
-					vs := vecs[j].Col.([]int8)
-					for k := int64(0); k < n; k++ {
-						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint8:
-					vs := vecs[j].Col.([]uint8)
-					for k := int64(0); k < n; k++ {
-						*(*uint8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(1, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int16:
-					vs := vecs[j].Col.([]int16)
-					for k := int64(0); k < n; k++ {
-						*(*int16)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint16:
-					vs := vecs[j].Col.([]uint16)
-					for k := int64(0); k < n; k++ {
-						*(*uint16)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(2, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int32:
-					vs := vecs[j].Col.([]int32)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint32:
-					vs := vecs[j].Col.([]uint32)
-					for k := int64(0); k < n; k++ {
-						*(*uint32)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float32:
-					vs := vecs[j].Col.([]float32)
-					for k := int64(0); k < n; k++ {
-						*(*float32)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_date:
-					vs := vecs[j].Col.([]types.Date)
-					for k := int64(0); k < n; k++ {
-						*(*int32)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int32(vs[i+k])
-					}
-					add.Uint32AddScalar(4, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_int64:
-					vs := vecs[j].Col.([]int64)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_uint64:
-					vs := vecs[j].Col.([]uint64)
-					for k := int64(0); k < n; k++ {
-						*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_float64:
-					vs := vecs[j].Col.([]float64)
-					for k := int64(0); k < n; k++ {
-						*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_datetime:
-					vs := vecs[j].Col.([]types.Datetime)
-					for k := int64(0); k < n; k++ {
-						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
-					}
-					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-				case types.T_char, types.T_varchar:
-					vs := vecs[j].Col.(*types.Bytes)
-					for k := int64(0); k < n; k++ {
-						key := vs.Get(i + k)
-						copy(data[k*40+int64(ctr.keyOffs[k]):], key)
-						ctr.keyOffs[k] += uint32(len(key))
-					}
-				}
-			}
-			ctr.strHashMap.InsertString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
-		}
+		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows = ctr.strHashMap.Cardinality()
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
+	vecs := bat.Vecs
+	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
 	for i := int64(0); i < count; i += UnitLimit {
 		n := count - i
@@ -1141,109 +940,17 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
-	vecs := bat.Vecs
-	count := int64(len(bat.Zs))
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
-		for i := int64(0); i < count; i += UnitLimit { // batch
-			n := count - i
-			if n > UnitLimit {
-				n = UnitLimit
-			}
-			for j, vec := range vecs {
-				switch vec.Typ.Oid {
-				case types.T_int8:
-					vs := vecs[j].Col.([]int8)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*1)[:len(vs)*1]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
-					}
-				case types.T_uint8:
-					vs := vecs[j].Col.([]uint8)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*1)[:len(vs)*1]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
-					}
-				case types.T_int16:
-					vs := vecs[j].Col.([]int16)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*2)[:len(vs)*2]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
-					}
-				case types.T_uint16:
-					vs := vecs[j].Col.([]uint16)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*2)[:len(vs)*2]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
-					}
-				case types.T_int32:
-					vs := vecs[j].Col.([]int32)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*4)[:len(vs)*4]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
-					}
-				case types.T_uint32:
-					vs := vecs[j].Col.([]uint32)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*4)[:len(vs)*4]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
-					}
-				case types.T_float32:
-					vs := vecs[j].Col.([]float32)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*2)[:len(vs)*4]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
-					}
-				case types.T_date:
-					vs := vecs[j].Col.([]types.Date)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*2)[:len(vs)*4]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
-					}
-				case types.T_int64:
-					vs := vecs[j].Col.([]int64)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
-					}
-				case types.T_uint64:
-					vs := vecs[j].Col.([]uint64)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
-					}
-				case types.T_float64:
-					vs := vecs[j].Col.([]float64)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
-					}
-				case types.T_datetime:
-					vs := vecs[j].Col.([]types.Datetime)
-					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
-					}
-				case types.T_char, types.T_varchar:
-					vs := vecs[j].Col.(*types.Bytes)
-					for k := int64(0); k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(i+k)...)
-					}
-				}
-			}
-			for k := int64(0); k < n; k++ {
-				if l := len(ctr.hstr.keys[k]); l < 16 {
-					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], hashtable.StrKeyPadding[l:]...)
-				}
-			}
-			ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], ctr.values)
-			for k := int64(0); k < n; k++ {
-				ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
-			}
-		}
+		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows = ctr.strHashMap.Cardinality()
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
+	vecs := bat.Vecs
+	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
 	for i := int64(0); i < count; i += UnitLimit { // batch
 		n := count - i
@@ -1339,6 +1046,7 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 		ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], ctr.values)
 		{ // batch
 			cnt := 0
+			copy(ctr.inserted[:n], ctr.zInserted[:n])
 			for k, v := range ctr.values[:n] {
 				ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
 				if v > ctr.rows {
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -54,7 +55,7 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 		switch ctr.state {
 		case Fill:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-				bat := <-proc.Reg.MergeReceivers[i].Ch
+				bat := process.Receive(proc.Reg.MergeReceivers[i])
 				if bat == nil {
 					continue
 				}
@@ -89,12 +90,35 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 		switch ctr.state {
 		case Fill:
 			if err := ctr.fill(proc); err != nil {
-				batch.Clean(ctr.bat, proc.Mp)
+				ctr.clean(proc)
 				proc.Reg.InputBatch = nil
 				ctr.state = Eval
 				return true, err
 			}
 			ctr.state = Eval
+			if ctr.parts != nil {
+				if err := ctr.spill(proc); err != nil {
+					ctr.clean(proc)
+					proc.Reg.InputBatch = nil
+					return true, err
+				}
+				ctr.state = Partition
+			}
+		case Partition:
+			ok, err := ctr.fillPartition(proc)
+			if err != nil {
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				ctr.state = Eval
+				return true, err
+			}
+			if !ok {
+				ctr.state = Eval
+				continue
+			}
+			proc.Reg.InputBatch = ctr.bat
+			ctr.bat = nil
+			return false, nil
 		case Eval:
 			if ctr.bat != nil {
 				proc.Reg.InputBatch = ctr.bat
@@ -108,8 +132,10 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 
 func (ctr *Container) fill(proc *process.Process) error {
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
+		bat := process.Receive(proc.Reg.MergeReceivers[i])
 		if bat == nil {
+			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+			i--
 			continue
 		}
 		if len(bat.Zs) == 0 {
@@ -119,10 +145,138 @@ func (ctr *Container) fill(proc *process.Process) error {
 		if err := ctr.fillBatch(bat, proc); err != nil {
 			return err
 		}
+		// the groups are written to the partitions in the temporary files
+		// when they exceed the memory limitation
+		if spill.Full(proc, spill.Size(ctr.bat)) {
+			if err := ctr.spill(proc); err != nil {
+				return err
+			}
+		}
+		i--
 	}
 	return nil
 }
 
+/*
+spill splits the groups by their keys into the partitions and appends them to
+the temporary files of the partitions, then the container starts over with an
+empty hash table. The groups of a partition are merged at the end by fillPartition.
+*/
+func (ctr *Container) spill(proc *process.Process) error {
+	if ctr.parts == nil {
+		ctr.parts = make([]*spill.File, spill.Partitions)
+	}
+	if ctr.bat == nil {
+		return nil
+	}
+	bs, err := spill.Partition(ctr.bat, len(ctr.bat.Vecs), len(ctr.parts), proc)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, b := range bs {
+			if b != nil {
+				batch.Clean(b, proc.Mp)
+			}
+		}
+	}()
+	for i, b := range bs {
+		if b == nil {
+			continue
+		}
+		if ctr.parts[i] == nil {
+			if ctr.parts[i], err = spill.Create(proc); err != nil {
+				return err
+			}
+		}
+		if err = ctr.parts[i].Write(b); err != nil {
+			return err
+		}
+	}
+	batch.Clean(ctr.bat, proc.Mp)
+	ctr.reset()
+	return nil
+}
+
+// fillPartition merges the groups of the next partition, it returns false if there is none
+func (ctr *Container) fillPartition(proc *process.Process) (bool, error) {
+	for ; ctr.part < len(ctr.parts); ctr.part++ {
+		f := ctr.parts[ctr.part]
+		if f == nil {
+			continue
+		}
+		if err := f.Rewind(); err != nil {
+			return false, err
+		}
+		ctr.reset()
+		for {
+			bat, err := f.Read(proc)
+			if err != nil {
+				return false, err
+			}
+			if bat == nil {
+				break
+			}
+			if err := ctr.fillBatch(bat, proc); err != nil {
+				return false, err
+			}
+		}
+		f.Close()
+		ctr.parts[ctr.part] = nil
+		if ctr.bat != nil {
+			ctr.part++
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// reset empties the groups and the hash table of the container
+func (ctr *Container) reset() {
+	ctr.bat = nil
+	ctr.rows = 0
+	switch ctr.typ {
+	case H8:
+		ctr.intHashMap = &hashtable.Int64HashMap{}
+		ctr.intHashMap.Init()
+	default:
+		ctr.strHashMap = &hashtable.StringHashMap{}
+		ctr.strHashMap.Init()
+	}
+}
+
+// clean frees the groups and removes the temporary files
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	spill.CloseFiles(ctr.parts)
+	ctr.parts = nil
+}
+
+// newBatch returns an empty batch of groups like the batch, it is used when
+// the batch has no hash table, that is a batch read from a partition.
+func (ctr *Container) newBatch(bat *batch.Batch) *batch.Batch {
+	b := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		b.Vecs[i] = vector.New(vec.Typ)
+		b.Vecs[i].Ref = vec.Ref
+	}
+	for _, r := range bat.Rs {
+		b.Rs = append(b.Rs, r.Dup())
+	}
+	b.As = bat.As
+	b.Refs = bat.Refs
+	switch ctr.typ {
+	case H8:
+		b.Ht = ctr.intHashMap
+	default:
+		b.Ht = ctr.strHashMap
+	}
+	return b
+}
+
 func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 	if len(ctr.vars) == 0 {
 		ctr.vars = append(ctr.vars, bat.Attrs...)
@@ -216,12 +370,15 @@ func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
 		ctr.intHashMap = bat.Ht.(*hashtable.Int64HashMap)
 		ctr.rows += uint64(len(ctr.bat.Zs))
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
@@ -353,12 +510,15 @@ func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows += uint64(len(ctr.bat.Zs))
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
@@ -488,12 +648,15 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows += uint64(len(ctr.bat.Zs))
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
@@ -623,12 +786,15 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows += uint64(len(ctr.bat.Zs))
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
@@ -758,12 +924,15 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 }
 
 func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
-	if ctr.bat == nil {
+	if ctr.bat == nil && bat.Ht != nil {
 		ctr.bat = bat
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		ctr.rows += uint64(len(ctr.bat.Zs))
 		return nil
 	}
+	if ctr.bat == nil {
+		ctr.bat = ctr.newBatch(bat)
+	}
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	defer batch.Clean(bat, proc.Mp)
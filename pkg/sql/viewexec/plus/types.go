@@ -17,11 +17,13 @@ package plus
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 )
 
 const (
 	Fill = iota
 	Eval
+	Partition
 )
 
 const (
@@ -77,6 +79,11 @@ type Container struct {
 		keys [][]byte
 	}
 	bat *batch.Batch
+
+	// parts are the temporary files of the partitions of the groups spilled
+	parts []*spill.File
+	// part is the next partition to merge
+	part int
 }
 
 type Argument struct {
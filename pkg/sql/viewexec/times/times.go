@@ -20,8 +20,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
@@ -52,6 +54,20 @@ func Prepare(proc *process.Process, arg interface{}) error {
 		}
 	}
 	n.ctr.constructVars(n)
+	if n.Parts != nil {
+		n.ctr.parts = make([]*spill.File, len(n.Parts))
+		for i, f := range n.Parts {
+			if f == nil {
+				continue
+			}
+			part, err := f.Open()
+			if err != nil {
+				n.ctr.clean()
+				return err
+			}
+			n.ctr.parts[i] = part
+		}
+	}
 	return nil
 }
 
@@ -63,10 +79,15 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 	if n.ctr.state == Fill {
 		if err := n.ctr.fill(n.Bats, proc); err != nil {
+			n.ctr.clean()
 			proc.Reg.InputBatch = nil
 			n.ctr.state = End
 			return true, err
 		}
+		if n.Parts != nil {
+			// the partitions of the spilled view are filled by probePartitions
+			n.ctr.views[n.Spilled].isB = true
+		}
 		n.ctr.isB = true
 		for _, v := range n.ctr.views {
 			if !v.isB {
@@ -79,6 +100,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		n.ctr.state = Probe
 	}
 	if _, err := transform.Call(proc, n.Arg); err != nil {
+		n.ctr.clean()
 		n.ctr.state = End
 		proc.Reg.InputBatch = nil
 		return false, err
@@ -86,6 +108,13 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	bat := proc.Reg.InputBatch
 	if bat == nil {
 		n.ctr.state = End
+		if n.Parts != nil {
+			err := n.ctr.probePartitions(n, proc)
+			n.ctr.clean()
+			if err != nil {
+				return true, err
+			}
+		}
 		if n.ctr.pctr == nil {
 			return true, nil
 		}
@@ -109,7 +138,14 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		proc.Reg.InputBatch = &batch.Batch{}
 		return false, nil
 	}
-	if err := n.ctr.probe(n.Arg.Ctr.Is, n.FreeVars, bat, n, proc); err != nil {
+	var err error
+	if n.Parts != nil {
+		err = n.ctr.spillProbe(n, bat, proc)
+	} else {
+		err = n.ctr.probe(n.Arg.Ctr.Is, n.FreeVars, bat, n, proc)
+	}
+	if err != nil {
+		n.ctr.clean()
 		proc.Reg.InputBatch = nil
 		n.ctr.state = End
 		return true, err
@@ -118,6 +154,113 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	return false, nil
 }
 
+// spillProbe splits the batch by the key of the spilled view into the
+// partitions, and appends them to the temporary files of the partitions
+// which have rows of the view. The batch is freed.
+func (ctr *Container) spillProbe(arg *Argument, bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	if ctr.probes == nil {
+		ctr.probes = make([]*spill.File, len(ctr.parts))
+	}
+	// the rings of the batch are empty, the probe fills the rings of the
+	// groups with the vectors, so only the kinds of the rings are kept
+	if ctr.rings == nil {
+		ctr.rings = make([]ring.Ring, len(bat.Rs))
+		for i, r := range bat.Rs {
+			ctr.rings[i] = r.Dup()
+		}
+	}
+	b := *bat
+	b.Rs = nil
+	key := batch.GetVector(bat, arg.Rvars[arg.Spilled])
+	bs, err := spill.PartitionBy(&b, []*vector.Vector{key}, len(ctr.parts), proc)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, b := range bs {
+			if b != nil {
+				batch.Clean(b, proc.Mp)
+			}
+		}
+	}()
+	for i, b := range bs {
+		// the rows can't be joined without any row of the view
+		if b == nil || ctr.parts[i] == nil {
+			continue
+		}
+		if ctr.probes[i] == nil {
+			if ctr.probes[i], err = spill.Create(proc); err != nil {
+				return err
+			}
+		}
+		if err = ctr.probes[i].Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// probePartitions joins the spilled view partition by partition, the rows of
+// a partition of the view are probed by the batches of the same partition.
+func (ctr *Container) probePartitions(arg *Argument, proc *process.Process) error {
+	v := ctr.views[arg.Spilled]
+	for i, f := range ctr.probes {
+		if f == nil {
+			continue
+		}
+		bat, err := ctr.parts[i].Read(proc)
+		if err != nil {
+			return err
+		}
+		v.isB, v.sels = false, nil
+		err = ctr.fillBatch(v, bat, proc)
+		if err == nil && !v.isB {
+			panic("no possible")
+		}
+		if err == nil {
+			err = ctr.probePartition(f, arg, proc)
+		}
+		batch.Clean(bat, proc.Mp)
+		v.bat = nil
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// probePartition probes the views by the batches of the partition f
+func (ctr *Container) probePartition(f *spill.File, arg *Argument, proc *process.Process) error {
+	if err := f.Rewind(); err != nil {
+		return err
+	}
+	for {
+		bat, err := f.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		bat.Rs = make([]ring.Ring, len(ctr.rings))
+		for i, r := range ctr.rings {
+			bat.Rs[i] = r.Dup()
+		}
+		if err := ctr.probe(arg.Arg.Ctr.Is, arg.FreeVars, bat, arg, proc); err != nil {
+			return err
+		}
+	}
+}
+
+// clean closes the partitions of the spilled view and removes the
+// partitions of the probed batches
+func (ctr *Container) clean() {
+	spill.CloseFiles(ctr.parts)
+	spill.CloseFiles(ctr.probes)
+	ctr.parts, ctr.probes = nil, nil
+}
+
 func (ctr *Container) fill(bats []*batch.Batch, proc *process.Process) error {
 	for i := 0; i < len(bats); i++ {
 		bat := bats[i]
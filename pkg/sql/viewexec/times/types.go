@@ -17,7 +17,9 @@ package times
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
 )
 
@@ -106,6 +108,13 @@ type Container struct {
 	pctr     *probeContainer
 	varsMap  map[string]uint8
 	fvarsMap map[string]uint8
+
+	// parts are the partitions of the spilled view opened by the container
+	parts []*spill.File
+	// probes are the partitions of the batches to probe the spilled view
+	probes []*spill.File
+	// rings are the rings of the batches to probe
+	rings []ring.Ring
 }
 
 type Argument struct {
@@ -119,4 +128,10 @@ type Argument struct {
 	VarsMap  map[string]int
 	Bats     []*batch.Batch
 	Arg      *transform.Argument
+
+	// Parts are the partitions of the view Spilled in the temporary files,
+	// nil if no view is spilled. The batch of the view in Bats is nil, and
+	// the view is joined partition by partition.
+	Spilled int
+	Parts   []*spill.File
 }
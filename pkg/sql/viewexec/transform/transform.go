@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -273,9 +274,44 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 		ctr.bat = nil
 		return false, err
 	}
+	if spill.Full(proc, spill.Size(ctr.bat)) {
+		ctr.flush(proc)
+	}
 	return false, err
 }
 
+/*
+flush sends the groups to the next operator when they exceed the memory
+limitation, and the container starts over with an empty hash table.
+The groups with the same keys in different batches are merged by plus.
+*/
+func (ctr *Container) flush(proc *process.Process) {
+	bat := ctr.bat
+	ctr.bat = batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		ctr.bat.Vecs[i].Ref = vec.Ref
+	}
+	ctr.bat.As = bat.As
+	ctr.bat.Refs = bat.Refs
+	ctr.bat.Rs = make([]ring.Ring, len(bat.Rs))
+	for i, r := range bat.Rs {
+		ctr.bat.Rs[i] = r.Dup()
+	}
+	ctr.rows = 0
+	switch ctr.typ {
+	case H8:
+		bat.Ht = ctr.intHashMap
+		ctr.intHashMap = &hashtable.Int64HashMap{}
+		ctr.intHashMap.Init()
+	default:
+		bat.Ht = ctr.strHashMap
+		ctr.strHashMap = &hashtable.StringHashMap{}
+		ctr.strHashMap.Init()
+	}
+	proc.Reg.InputBatch = bat
+}
+
 func (ctr *Container) processFreeVarsUnit(proc *process.Process, arg *Argument) (bool, error) {
 	var err error
 
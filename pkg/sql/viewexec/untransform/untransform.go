@@ -63,7 +63,7 @@ func (ctr *Container) processBare(fvars []string, proc *process.Process) (bool,
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		reg := proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
+		bat := process.Receive(reg)
 		if bat == nil {
 			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 			i--
@@ -94,7 +94,7 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 		switch ctr.state {
 		case Fill:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-				bat := <-proc.Reg.MergeReceivers[i].Ch
+				bat := process.Receive(proc.Reg.MergeReceivers[i])
 				if bat == nil {
 					continue
 				}
@@ -174,6 +174,11 @@ func (ctr *Container) processFreeVars(fvars []string, proc *process.Process) (bo
 				}
 				proc.Reg.InputBatch = ctr.bat
 				ctr.bat = nil
+				// the batches of a single receiver have different groups,
+				// e.g. the partitions of plus, so they are sent one by one.
+				if len(proc.Reg.MergeReceivers) == 1 {
+					return false, nil
+				}
 			}
 			return true, nil
 		}
@@ -183,8 +188,9 @@ func (ctr *Container) processFreeVars(fvars []string, proc *process.Process) (bo
 func (ctr *Container) fill(fvars []string, proc *process.Process) error {
 	if len(proc.Reg.MergeReceivers) == 1 {
 		for {
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat := process.Receive(proc.Reg.MergeReceivers[0])
 			if bat == nil {
+				proc.Reg.MergeReceivers = nil
 				return nil
 			}
 			if len(bat.Zs) == 0 {
@@ -195,7 +201,7 @@ func (ctr *Container) fill(fvars []string, proc *process.Process) error {
 		}
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
+		bat := process.Receive(proc.Reg.MergeReceivers[i])
 		if bat == nil {
 			continue
 		}
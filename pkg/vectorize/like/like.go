@@ -81,7 +81,7 @@ func sliceLikePure(s *types.Bytes, expr []byte, rs []int64) ([]int64, error) {
 		}
 		return rs[:count], nil
 	}
-	if n > 1 && !bytes.ContainsAny(expr[1:len(expr)-1], "_%") {
+	if n > 1 && !bytes.ContainsAny(expr[1:len(expr)-1], "_%") && !isEscaped(expr) {
 		c0 := expr[0]   // first character
 		c1 := expr[n-1] // last character
 		switch {
@@ -248,7 +248,7 @@ func pureLikePure(p []byte, expr []byte, rs []int64) ([]int64, error) {
 		}
 		return nil, nil
 	}
-	if n > 1 && !bytes.ContainsAny(expr[1:n-1], "_%") {
+	if n > 1 && !bytes.ContainsAny(expr[1:n-1], "_%") && !isEscaped(expr) {
 		c0 := expr[0]   // first character
 		c1 := expr[n-1] // last character
 		switch {
@@ -346,7 +346,7 @@ func sliceNullLikePure(s *types.Bytes, expr []byte, nulls *roaring.Bitmap, rs []
 		cFlag = 2
 	case n == 1 && expr[0] == '_':
 		cFlag = 3
-	case n > 1 && !bytes.ContainsAny(expr[1:len(expr)-1], "_%"):
+	case n > 1 && !bytes.ContainsAny(expr[1:len(expr)-1], "_%") && !isEscaped(expr):
 		cFlag = 4
 	default:
 		cFlag = 5
@@ -518,31 +518,39 @@ func convert(expr []byte) string {
 	return fmt.Sprintf("^(?s:%s)$", replace(*(*string)(unsafe.Pointer(&expr))))
 }
 
+// isEscaped returns true if the pattern has escaped characters, they are
+// matched by the regular expression
+func isEscaped(expr []byte) bool {
+	return bytes.IndexByte(expr, '\\') >= 0
+}
+
+// replace converts the pattern into a regular expression, the escaped
+// characters and the other characters which are not wildcards are literals.
 func replace(s string) string {
 	var oc rune
+	var r strings.Builder
 
-	r := make([]byte, len(s)+strings.Count(s, `%`))
-	w := 0
+	r.Grow(len(s) + strings.Count(s, `%`))
 	start := 0
 	for len(s) > start {
 		c, wid := utf8.DecodeRuneInString(s[start:])
 		if oc == '\\' {
-			w += copy(r[w:], s[start:start+wid])
+			r.WriteString(regexp.QuoteMeta(s[start : start+wid]))
 			start += wid
 			oc = 0
 			continue
 		}
 		switch c {
 		case '_':
-			w += copy(r[w:], []byte{'.'})
+			r.WriteByte('.')
 		case '%':
-			w += copy(r[w:], []byte{'.', '*'})
+			r.WriteString(".*")
 		case '\\':
 		default:
-			w += copy(r[w:], s[start:start+wid])
+			r.WriteString(regexp.QuoteMeta(s[start : start+wid]))
 		}
 		start += wid
 		oc = c
 	}
-	return string(r)
+	return r.String()
 }
@@ -28,9 +28,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	errDriver "github.com/matrixorigin/matrixone/pkg/vm/driver/error"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/pb"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/codec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
@@ -213,6 +215,48 @@ func (s *Storage) Append(index uint64, offset int, batchSize int, shardId uint64
 	return writtenBytes, changedBytes, nil
 }
 
+//deleteRows deletes the rows of the table satisfying the predicate, the
+//response is the count of the deleted rows.
+func (s *Storage) deleteRows(index uint64, offset int, batchSize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	if err := s.DB.Closed.Load(); err != nil {
+		panic(err)
+	}
+	if offset >= batchSize {
+		panic(fmt.Sprintf("bad index %d: offset %d, size %d", index, offset, batchSize))
+	}
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("[S-%d|logIndex:%d,%d]deleteRows handler cost %d ms", shardId, index, offset, time.Since(t0).Milliseconds())
+	}()
+	customReq := &pb.AppendRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+	var predicate engine.Predicate
+	if err := encoding.Decode(customReq.Data, &predicate); err != nil {
+		resp := errDriver.ErrorResp(err)
+		return 0, 0, resp
+	}
+	ctx := aoedb.DeleteWhereCtx{
+		TableMutationCtx: aoedb.TableMutationCtx{
+			DBMutationCtx: aoedb.DBMutationCtx{
+				Id:     index,
+				Offset: offset,
+				Size:   batchSize,
+				DB:     aoedb.IdToNameFactory.Encode(shardId),
+			},
+			Table: customReq.TabletName,
+		},
+		Predicate: &predicate,
+	}
+	count, err := s.DB.DeleteWhere(&ctx)
+	if err != nil {
+		resp := errDriver.ErrorResp(err)
+		return 0, 0, resp
+	}
+	writtenBytes := uint64(len(key) + len(customReq.Data))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, codec.Uint642Bytes(count)
+}
+
 //Relation  returns a relation of the db and the table
 func (s *Storage) Relation(dbname, tabletName string) (*aoedb.Relation, error) {
 	return s.DB.Relation(dbname, tabletName)
@@ -497,6 +541,8 @@ func (s *Storage) Write(ctx storage.WriteContext) error {
 			writtenBytes, changedBytes, rep = s.dropTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.Append):
 			writtenBytes, changedBytes, rep = s.Append(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.DeleteRows):
+			writtenBytes, changedBytes, rep = s.deleteRows(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.CreateIndex):
 			writtenBytes, changedBytes, rep = s.createIndex(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.DropIndex):
@@ -97,6 +97,9 @@ type CubeDriver interface {
 	AsyncAllocID([]byte, uint64, func(server.CustomRequest, []byte, error), interface{})
 	// Append appends the data in the table
 	Append(string, uint64, []byte) error
+	// DeleteRows deletes the rows of the table satisfying the encoded
+	// predicate, and returns how many rows were deleted
+	DeleteRows(string, uint64, []byte) (uint64, error)
 	//GetSnapshot gets the snapshot from the table.
 	//If there's no segment, it returns an empty snapshot.
 	GetSnapshot(dbi.GetSnapshotCtx) (*handle.Snapshot, error)
@@ -592,6 +595,27 @@ func (h *driver) Append(name string, shardId uint64, data []byte) error {
 	return err
 }
 
+func (h *driver) DeleteRows(name string, shardId uint64, predicate []byte) (uint64, error) {
+	req := pb.Request{
+		Type:  pb.DeleteRows,
+		Group: pb.AOEGroup,
+		Shard: shardId,
+		Append: pb.AppendRequest{
+			Data:       predicate,
+			TabletName: name,
+		},
+	}
+	value, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if err != nil {
+		return 0, err
+	}
+	// the count is 8 bytes, anything else is an error
+	if len(value) != 8 {
+		return 0, errors.New(string(value))
+	}
+	return codec.Bytes2Uint64(value)
+}
+
 func (h *driver) GetSnapshot(ctx dbi.GetSnapshotCtx) (*handle.Snapshot, error) {
 	ctxStr, err := json.Marshal(ctx)
 	req := pb.Request{
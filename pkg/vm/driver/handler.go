@@ -98,6 +98,12 @@ func (h *driver) BuildRequest(req *server.CustomRequest, cmd interface{}) error
 		req.CustomType = uint64(pb.Append)
 		req.Write = true
 		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.DeleteRows:
+		msg := customReq.Append
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.DeleteRows)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
 	case pb.CreateIndex:
 		msg := customReq.CreateIndex
 		req.Group = uint64(customReq.Group)
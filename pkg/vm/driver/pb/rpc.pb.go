@@ -45,6 +45,7 @@ const (
 	GetSegmentedId Type = 108
 	CreateIndex    Type = 109
 	DropIndex      Type = 110
+	DeleteRows     Type = 111
 )
 
 var Type_name = map[int32]string{
@@ -67,6 +68,7 @@ var Type_name = map[int32]string{
 	108: "GetSegmentedId",
 	109: "CreateIndex",
 	110: "DropIndex",
+	111: "DeleteRows",
 }
 
 var Type_value = map[string]int32{
@@ -89,6 +91,7 @@ var Type_value = map[string]int32{
 	"GetSegmentedId": 108,
 	"CreateIndex":    109,
 	"DropIndex":      110,
+	"DeleteRows":     111,
 }
 
 func (x Type) String() string {
@@ -1625,81 +1628,82 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 1181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xef, 0x6e, 0xe3, 0xc4,
-	0x17, 0xdd, 0x24, 0x6e, 0xfe, 0xdc, 0x26, 0xa9, 0x3b, 0xbf, 0x6e, 0x7f, 0xde, 0xaa, 0x4a, 0x8b,
-	0x05, 0xa5, 0xac, 0xb4, 0xad, 0xe8, 0x02, 0xaa, 0x84, 0x84, 0xd4, 0x34, 0x55, 0x14, 0xed, 0xaa,
-	0x20, 0xa7, 0xf0, 0x0d, 0x24, 0x27, 0x9e, 0x24, 0xde, 0x3a, 0xb6, 0xb1, 0x27, 0xa8, 0x79, 0x1a,
-	0x1e, 0x87, 0xfd, 0x84, 0xf6, 0x09, 0x2a, 0xe8, 0x93, 0xa0, 0x7b, 0xc7, 0xff, 0x26, 0x09, 0x20,
-	0xf1, 0x6d, 0xee, 0x99, 0x73, 0xce, 0xcc, 0x9d, 0xf1, 0xbd, 0x63, 0x68, 0x44, 0xe1, 0xf8, 0x2c,
-	0x8c, 0x02, 0x11, 0xb0, 0x72, 0x38, 0x3a, 0x78, 0x35, 0x75, 0xc5, 0x6c, 0x31, 0x3a, 0x1b, 0x07,
-	0xf3, 0xf3, 0x69, 0x30, 0x0d, 0xce, 0x69, 0x6a, 0xb4, 0x98, 0x50, 0x44, 0x01, 0x8d, 0xa4, 0xe4,
-	0x00, 0xe6, 0x5c, 0xd8, 0x72, 0x6c, 0xfe, 0x56, 0x83, 0x9a, 0xc5, 0x7f, 0x5e, 0xf0, 0x58, 0xb0,
-	0x7d, 0x28, 0xbb, 0x8e, 0x51, 0x3a, 0x2e, 0x9d, 0x6a, 0xdd, 0xea, 0xd3, 0xe3, 0x51, 0x79, 0xd0,
-	0xb3, 0xca, 0xae, 0xc3, 0x0e, 0x41, 0x13, 0xcb, 0x90, 0x1b, 0xe5, 0xe3, 0xd2, 0x69, 0xfb, 0xa2,
-	0x7e, 0x16, 0x8e, 0xce, 0xee, 0x96, 0x21, 0xb7, 0x08, 0x65, 0x47, 0xb0, 0x35, 0x8d, 0x82, 0x45,
-	0x68, 0x54, 0x68, 0xba, 0x81, 0xd3, 0x7d, 0x04, 0x2c, 0x89, 0xb3, 0x3d, 0xd8, 0x8a, 0x67, 0x76,
-	0xe4, 0x18, 0x1a, 0x3a, 0x5b, 0x32, 0x60, 0x27, 0x50, 0x89, 0xb9, 0x30, 0xb6, 0x8e, 0x4b, 0xa7,
-	0xdb, 0x17, 0x6d, 0x14, 0x0d, 0xb9, 0x48, 0x76, 0xd2, 0xd5, 0xde, 0x3f, 0x1e, 0x3d, 0xb3, 0x90,
-	0x80, 0xbc, 0x29, 0x17, 0x46, 0x35, 0xe7, 0xf5, 0xd7, 0x78, 0x53, 0x2e, 0xd8, 0x39, 0x54, 0x1d,
-	0xee, 0x71, 0xc1, 0x8d, 0x1a, 0x51, 0x77, 0x91, 0xda, 0x23, 0x44, 0x65, 0x27, 0x34, 0xf6, 0x19,
-	0x68, 0xf1, 0xd8, 0xf6, 0x8d, 0x3a, 0xd1, 0x77, 0x68, 0x07, 0x63, 0xdb, 0x57, 0xc9, 0x44, 0x61,
-	0x5f, 0x03, 0x84, 0x11, 0x9f, 0xb8, 0x0f, 0x48, 0x30, 0x1a, 0x24, 0x78, 0x8e, 0x82, 0xef, 0x32,
-	0x54, 0x95, 0x15, 0xe8, 0xec, 0x02, 0x6a, 0xb6, 0xe7, 0x05, 0xe3, 0x41, 0xcf, 0x00, 0x52, 0x32,
-	0x54, 0x5e, 0x49, 0x48, 0x95, 0xa5, 0x44, 0x4c, 0xc6, 0x0e, 0x43, 0xee, 0x3b, 0x86, 0x93, 0x27,
-	0x73, 0x45, 0xc8, 0x4a, 0x32, 0x92, 0xc6, 0xbe, 0x81, 0xed, 0x29, 0x17, 0x43, 0xdf, 0x0e, 0xe3,
-	0x59, 0x20, 0x0c, 0x4e, 0xaa, 0xfd, 0xe4, 0xb4, 0x52, 0x58, 0x95, 0x16, 0x05, 0xec, 0x12, 0x1a,
-	0xc2, 0x1e, 0x79, 0x5c, 0x0c, 0x9c, 0xd8, 0x98, 0x90, 0x7a, 0x8f, 0xee, 0x59, 0x82, 0xbd, 0x58,
-	0xd5, 0xe6, 0x64, 0x76, 0x05, 0xcd, 0x71, 0xc4, 0x6d, 0xc1, 0x25, 0xd5, 0x98, 0x92, 0xf8, 0xff,
-	0x28, 0xbe, 0x2e, 0xe0, 0xaa, 0x5e, 0x91, 0xe0, 0xf1, 0x3a, 0x51, 0x10, 0x26, 0x06, 0xb3, 0xfc,
-	0x78, 0x7b, 0x19, 0xba, 0x72, 0xbc, 0x39, 0x9d, 0xf5, 0xa0, 0x85, 0x89, 0xf0, 0xe9, 0x9c, 0xfb,
-	0xb4, 0x7b, 0x97, 0xf4, 0x46, 0x9a, 0x7b, 0x36, 0xa1, 0x5a, 0xa8, 0x22, 0xd6, 0x87, 0x76, 0x0e,
-	0x70, 0x67, 0xe0, 0x18, 0xef, 0xc8, 0xe6, 0x85, 0x6a, 0x83, 0x33, 0xaa, 0xcf, 0x8a, 0x0c, 0x2f,
-	0x42, 0xe6, 0x36, 0xf0, 0x1d, 0xfe, 0x60, 0xdc, 0xe7, 0x17, 0x71, 0x9d, 0xc3, 0x2b, 0x17, 0x51,
-	0x10, 0xe0, 0x45, 0x60, 0x72, 0x52, 0xed, 0xe5, 0x17, 0xd1, 0x4b, 0xc1, 0x95, 0x8b, 0xc8, 0xc8,
-	0xe6, 0xef, 0x15, 0xa8, 0x5b, 0x3c, 0x0e, 0x03, 0x3f, 0xe6, 0xff, 0xb1, 0x94, 0x5f, 0xc1, 0x16,
-	0x8f, 0xa2, 0x20, 0xa2, 0x52, 0x4e, 0xbe, 0xba, 0x1b, 0x04, 0x52, 0xdf, 0x64, 0x55, 0xc9, 0x62,
-	0x5f, 0x42, 0x63, 0xb4, 0x14, 0x3c, 0xc6, 0x59, 0x2a, 0xee, 0x44, 0xd2, 0x4d, 0xc1, 0x82, 0x24,
-	0x67, 0xb2, 0x0b, 0xa8, 0x8f, 0x82, 0xc0, 0x23, 0x95, 0x2c, 0x7f, 0x9d, 0x54, 0x09, 0x56, 0x10,
-	0x65, 0x3c, 0x76, 0x09, 0xb0, 0x70, 0x7d, 0xf1, 0xd5, 0x17, 0xa4, 0xaa, 0xe6, 0x75, 0xf4, 0x7d,
-	0x86, 0x16, 0x74, 0x05, 0x6e, 0xaa, 0x7c, 0x7d, 0x41, 0xca, 0x9a, 0xaa, 0x94, 0xe8, 0xaa, 0x52,
-	0xa2, 0xac, 0x07, 0x6d, 0xda, 0xf4, 0xd0, 0x73, 0xc7, 0x9c, 0xd4, 0xf5, 0xfc, 0x36, 0xbb, 0xca,
-	0x4c, 0xc1, 0x61, 0x45, 0x83, 0xeb, 0xc7, 0x22, 0x72, 0xfd, 0x29, 0x39, 0x34, 0xf2, 0xf5, 0x87,
-	0x19, 0x5a, 0x5c, 0x3f, 0xe7, 0x9a, 0xdf, 0x02, 0xe4, 0x2d, 0x91, 0xe9, 0x50, 0xb9, 0xe7, 0x4b,
-	0xba, 0xd2, 0xa6, 0x85, 0x43, 0xec, 0xab, 0xbf, 0xd8, 0xde, 0x42, 0x5e, 0x66, 0xd3, 0x92, 0x01,
-	0x7b, 0x01, 0x15, 0x21, 0x3c, 0xba, 0xc1, 0x4a, 0xb7, 0xf6, 0xf4, 0x78, 0x54, 0xb9, 0xbb, 0x7b,
-	0x6b, 0x21, 0x66, 0x76, 0x00, 0xfa, 0xff, 0x60, 0x68, 0x7e, 0x04, 0x2d, 0xa5, 0x61, 0x6e, 0xa0,
-	0x5c, 0x42, 0x5b, 0xed, 0x5c, 0x9b, 0xf7, 0x35, 0xb2, 0xc5, 0x78, 0x46, 0xfb, 0xd2, 0x2c, 0x19,
-	0x98, 0x6f, 0x60, 0xbb, 0xd0, 0x27, 0xe9, 0x51, 0x10, 0x76, 0x24, 0x12, 0xa1, 0x0c, 0xd0, 0x0c,
-	0x9b, 0x9e, 0x4c, 0x08, 0x87, 0xc8, 0xf3, 0xdc, 0xb9, 0x2b, 0x28, 0x21, 0xcd, 0x92, 0x81, 0xf9,
-	0x23, 0xec, 0xae, 0xb5, 0x5e, 0xb6, 0x0f, 0x55, 0xd9, 0x76, 0x13, 0xcf, 0x24, 0x62, 0x07, 0x50,
-	0x27, 0xf7, 0x37, 0x7c, 0x99, 0x38, 0x67, 0xf1, 0xdf, 0xd8, 0x5f, 0x43, 0x4b, 0x69, 0xb6, 0xac,
-	0x03, 0x20, 0x3b, 0xde, 0xad, 0x3d, 0xe7, 0x64, 0xdf, 0xb0, 0x0a, 0x08, 0x63, 0xa0, 0x39, 0xb6,
-	0xb0, 0x13, 0x7b, 0x1a, 0x9b, 0x27, 0xc0, 0xd6, 0x7b, 0x2f, 0x66, 0x38, 0x16, 0xe9, 0x0e, 0x71,
-	0x68, 0xbe, 0x84, 0xbd, 0x4d, 0x7d, 0x0a, 0x3d, 0xfd, 0x7c, 0x35, 0x1a, 0x9b, 0x9f, 0xc3, 0xf3,
-	0x8d, 0xcd, 0x88, 0x19, 0x50, 0xa3, 0x67, 0x75, 0x90, 0x14, 0xbd, 0x95, 0x86, 0xe6, 0x5b, 0x60,
-	0xeb, 0x9d, 0x87, 0x1d, 0x26, 0xfd, 0xbe, 0x90, 0x4f, 0x0e, 0xa0, 0x9b, 0xeb, 0x3b, 0xee, 0x98,
-	0xc7, 0x49, 0x46, 0x69, 0x68, 0xde, 0x82, 0xbe, 0xda, 0x89, 0xfe, 0xc5, 0xeb, 0x10, 0x1a, 0x2e,
-	0xb2, 0x69, 0xb6, 0x2c, 0x67, 0x33, 0xc0, 0x64, 0xa0, 0xaf, 0x3e, 0x31, 0x66, 0x1f, 0xfe, 0xb7,
-	0xe1, 0xe5, 0xd8, 0x74, 0x1e, 0xd9, 0xd2, 0x03, 0x7f, 0x12, 0x24, 0x5b, 0xcd, 0x01, 0xf3, 0x53,
-	0xd8, 0x5d, 0x7b, 0x41, 0x36, 0x1e, 0xeb, 0x27, 0xd0, 0x52, 0xda, 0x1c, 0x7e, 0x16, 0xb2, 0x11,
-	0x4a, 0x96, 0x0c, 0xcc, 0x1d, 0x68, 0xdd, 0xcc, 0x43, 0xb1, 0x4c, 0x69, 0xe6, 0x09, 0xb4, 0xd5,
-	0x2a, 0xce, 0x6b, 0x32, 0x11, 0x52, 0x80, 0xfe, 0x4a, 0x4f, 0x54, 0x69, 0x69, 0xe9, 0x9a, 0x1f,
-	0x43, 0xb3, 0xd8, 0x04, 0x55, 0x56, 0x3d, 0x65, 0x9d, 0x40, 0x5b, 0x6d, 0x7a, 0x2a, 0x4f, 0x4b,
-	0x79, 0x3f, 0x01, 0x5b, 0x6f, 0x52, 0x98, 0xfe, 0x3d, 0x5f, 0xc6, 0x46, 0xe9, 0xb8, 0x82, 0x5f,
-	0x2a, 0x8e, 0xb1, 0x70, 0x48, 0x82, 0xb7, 0x8d, 0x68, 0x12, 0xe1, 0xe9, 0x7a, 0x76, 0x2c, 0x7e,
-	0x20, 0x6f, 0x59, 0x20, 0x39, 0x90, 0xee, 0x23, 0x6f, 0xa1, 0xea, 0x3e, 0x5a, 0xc9, 0x3e, 0x5e,
-	0xfe, 0x5a, 0x06, 0x0d, 0xdf, 0x18, 0x56, 0x83, 0xca, 0x90, 0x0b, 0xfd, 0x19, 0x0e, 0x7a, 0xdc,
-	0xd3, 0x4b, 0x38, 0xe8, 0x73, 0xa1, 0x97, 0x59, 0x1b, 0x20, 0xaf, 0x67, 0xbd, 0xc2, 0xea, 0xa0,
-	0xd1, 0x48, 0xc3, 0xd1, 0xc0, 0x1f, 0x47, 0xfa, 0x16, 0xdb, 0x85, 0xd6, 0x90, 0x8b, 0xc1, 0xe4,
-	0x36, 0x10, 0x37, 0x0f, 0x6e, 0x2c, 0xf4, 0x2a, 0x42, 0x3d, 0xee, 0x15, 0xa0, 0x1a, 0x03, 0xa8,
-	0xca, 0xd2, 0xd5, 0x1d, 0xb6, 0x03, 0xdb, 0x85, 0x0a, 0xd4, 0x39, 0xd3, 0xa1, 0x59, 0xfc, 0xb2,
-	0xf4, 0x09, 0x2e, 0x9c, 0x7f, 0x22, 0xfa, 0x94, 0x35, 0xf1, 0x0d, 0xf5, 0x6c, 0xe1, 0x06, 0xbe,
-	0x3e, 0x63, 0x2d, 0x68, 0xdc, 0xa5, 0x3f, 0x3a, 0xba, 0x8b, 0x7e, 0x77, 0x59, 0xcd, 0xc7, 0xfa,
-	0x3b, 0x5c, 0x5f, 0x29, 0x5d, 0xfd, 0x9e, 0x31, 0x68, 0xab, 0x15, 0xaa, 0x7b, 0xa8, 0x2b, 0x94,
-	0xa0, 0x3e, 0x47, 0xdf, 0xac, 0x8a, 0x74, 0xbf, 0xab, 0x7f, 0xf8, 0xb3, 0xf3, 0xec, 0xfd, 0x53,
-	0xa7, 0xf4, 0xe1, 0xa9, 0x53, 0xfa, 0xe3, 0xa9, 0x53, 0x1a, 0x55, 0xe9, 0xe7, 0xfc, 0xf5, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x88, 0xf2, 0x36, 0x19, 0xe8, 0x0b, 0x00, 0x00,
+	// 1185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xed, 0x6e, 0xe3, 0x44,
+	0x14, 0xdd, 0x24, 0x6e, 0x3e, 0x6e, 0x93, 0xec, 0x74, 0xd8, 0x5d, 0xbc, 0xab, 0x2a, 0x2d, 0x16,
+	0x94, 0xb2, 0xd2, 0xb6, 0x22, 0x05, 0x54, 0x09, 0x09, 0xa9, 0x69, 0xaa, 0x28, 0xda, 0x55, 0x41,
+	0x4e, 0xe1, 0x1f, 0x48, 0x4e, 0x3c, 0x49, 0xbc, 0x75, 0x6c, 0x63, 0x4f, 0xa0, 0x79, 0x2a, 0x1e,
+	0x83, 0xfd, 0x85, 0xf6, 0x09, 0x2a, 0xe8, 0x93, 0xa0, 0x3b, 0x63, 0x7b, 0x3c, 0x49, 0x00, 0x89,
+	0x7f, 0x73, 0xcf, 0x9c, 0x73, 0x66, 0xee, 0x8c, 0xef, 0x1d, 0x43, 0x23, 0x8e, 0x26, 0x27, 0x51,
+	0x1c, 0xf2, 0x90, 0x96, 0xa3, 0xf1, 0x8b, 0x57, 0x33, 0x8f, 0xcf, 0x97, 0xe3, 0x93, 0x49, 0xb8,
+	0x38, 0x9d, 0x85, 0xb3, 0xf0, 0x54, 0x4c, 0x8d, 0x97, 0x53, 0x11, 0x89, 0x40, 0x8c, 0xa4, 0xe4,
+	0x05, 0x2c, 0x18, 0x77, 0xe4, 0xd8, 0xfa, 0xbd, 0x06, 0x35, 0x9b, 0xfd, 0xbc, 0x64, 0x09, 0xa7,
+	0xcf, 0xa0, 0xec, 0xb9, 0x66, 0xe9, 0xb0, 0x74, 0x6c, 0xf4, 0xaa, 0x0f, 0xf7, 0x07, 0xe5, 0x61,
+	0xdf, 0x2e, 0x7b, 0x2e, 0xdd, 0x07, 0x83, 0xaf, 0x22, 0x66, 0x96, 0x0f, 0x4b, 0xc7, 0xed, 0x6e,
+	0xfd, 0x24, 0x1a, 0x9f, 0xdc, 0xac, 0x22, 0x66, 0x0b, 0x94, 0x1e, 0xc0, 0xce, 0x2c, 0x0e, 0x97,
+	0x91, 0x59, 0x11, 0xd3, 0x0d, 0x9c, 0x1e, 0x20, 0x60, 0x4b, 0x9c, 0x3e, 0x81, 0x9d, 0x64, 0xee,
+	0xc4, 0xae, 0x69, 0xa0, 0xb3, 0x2d, 0x03, 0x7a, 0x04, 0x95, 0x84, 0x71, 0x73, 0xe7, 0xb0, 0x74,
+	0xbc, 0xdb, 0x6d, 0xa3, 0x68, 0xc4, 0x78, 0xba, 0x93, 0x9e, 0xf1, 0xee, 0xfe, 0xe0, 0x91, 0x8d,
+	0x04, 0xe4, 0xcd, 0x18, 0x37, 0xab, 0x8a, 0x37, 0xd8, 0xe0, 0xcd, 0x18, 0xa7, 0xa7, 0x50, 0x75,
+	0x99, 0xcf, 0x38, 0x33, 0x6b, 0x82, 0xba, 0x87, 0xd4, 0xbe, 0x40, 0x74, 0x76, 0x4a, 0xa3, 0x9f,
+	0x81, 0x91, 0x4c, 0x9c, 0xc0, 0xac, 0x0b, 0xfa, 0x63, 0xb1, 0x83, 0x89, 0x13, 0xe8, 0x64, 0x41,
+	0xa1, 0x5f, 0x03, 0x44, 0x31, 0x9b, 0x7a, 0x77, 0x48, 0x30, 0x1b, 0x42, 0xf0, 0x14, 0x05, 0xdf,
+	0xe5, 0xa8, 0x2e, 0x2b, 0xd0, 0x69, 0x17, 0x6a, 0x8e, 0xef, 0x87, 0x93, 0x61, 0xdf, 0x04, 0xa1,
+	0xa4, 0xa8, 0xbc, 0x90, 0x90, 0x2e, 0xcb, 0x88, 0x98, 0x8c, 0x13, 0x45, 0x2c, 0x70, 0x4d, 0x57,
+	0x25, 0x73, 0x21, 0x90, 0xb5, 0x64, 0x24, 0x8d, 0x7e, 0x03, 0xbb, 0x33, 0xc6, 0x47, 0x81, 0x13,
+	0x25, 0xf3, 0x90, 0x9b, 0x4c, 0xa8, 0x9e, 0xa5, 0xa7, 0x95, 0xc1, 0xba, 0xb4, 0x28, 0xa0, 0xe7,
+	0xd0, 0xe0, 0xce, 0xd8, 0x67, 0x7c, 0xe8, 0x26, 0xe6, 0x54, 0xa8, 0x9f, 0x88, 0x7b, 0x96, 0x60,
+	0x3f, 0xd1, 0xb5, 0x8a, 0x4c, 0x2f, 0xa0, 0x39, 0x89, 0x99, 0xc3, 0x99, 0xa4, 0x9a, 0x33, 0x21,
+	0xfe, 0x10, 0xc5, 0x97, 0x05, 0x5c, 0xd7, 0x6b, 0x12, 0x3c, 0x5e, 0x37, 0x0e, 0xa3, 0xd4, 0x60,
+	0xae, 0x8e, 0xb7, 0x9f, 0xa3, 0x6b, 0xc7, 0xab, 0xe8, 0xb4, 0x0f, 0x2d, 0x4c, 0x84, 0xcd, 0x16,
+	0x2c, 0x10, 0xbb, 0xf7, 0x84, 0xde, 0xcc, 0x72, 0xcf, 0x27, 0x74, 0x0b, 0x5d, 0x44, 0x07, 0xd0,
+	0x56, 0x00, 0x73, 0x87, 0xae, 0xf9, 0x56, 0xd8, 0x3c, 0xd7, 0x6d, 0x70, 0x46, 0xf7, 0x59, 0x93,
+	0xe1, 0x45, 0xc8, 0xdc, 0x86, 0x81, 0xcb, 0xee, 0xcc, 0x5b, 0x75, 0x11, 0x97, 0x0a, 0x5e, 0xbb,
+	0x88, 0x82, 0x00, 0x2f, 0x02, 0x93, 0x93, 0x6a, 0x5f, 0x5d, 0x44, 0x3f, 0x03, 0xd7, 0x2e, 0x22,
+	0x27, 0x5b, 0x7f, 0x54, 0xa0, 0x6e, 0xb3, 0x24, 0x0a, 0x83, 0x84, 0xfd, 0xcf, 0x52, 0x7e, 0x05,
+	0x3b, 0x2c, 0x8e, 0xc3, 0xd8, 0xac, 0xa8, 0xaf, 0xee, 0x0a, 0x81, 0xcc, 0x37, 0x5d, 0x55, 0xb2,
+	0xe8, 0x97, 0xd0, 0x18, 0xaf, 0x38, 0x4b, 0x70, 0xd6, 0x34, 0x94, 0xa4, 0x97, 0x81, 0x05, 0x89,
+	0x62, 0xd2, 0x2e, 0xd4, 0xc7, 0x61, 0xe8, 0x0b, 0x95, 0x2c, 0x7f, 0x22, 0x54, 0x29, 0x56, 0x10,
+	0xe5, 0x3c, 0x7a, 0x0e, 0xb0, 0xf4, 0x02, 0xfe, 0xd5, 0x17, 0x42, 0x55, 0x55, 0x75, 0xf4, 0x7d,
+	0x8e, 0x16, 0x74, 0x05, 0x6e, 0xa6, 0x3c, 0xeb, 0x0a, 0x65, 0x4d, 0x57, 0x9e, 0x75, 0xb7, 0x29,
+	0x25, 0x4a, 0xfb, 0xd0, 0x16, 0x9b, 0x1e, 0xf9, 0xde, 0x84, 0x09, 0x75, 0x5d, 0xdd, 0x66, 0x4f,
+	0x9b, 0x29, 0x38, 0xac, 0x69, 0x70, 0xfd, 0x84, 0xc7, 0x5e, 0x30, 0x13, 0x0e, 0x0d, 0xb5, 0xfe,
+	0x28, 0x47, 0x8b, 0xeb, 0x2b, 0xae, 0xf5, 0x2d, 0x80, 0x6a, 0x89, 0x94, 0x40, 0xe5, 0x96, 0xad,
+	0xc4, 0x95, 0x36, 0x6d, 0x1c, 0x62, 0x5f, 0xfd, 0xc5, 0xf1, 0x97, 0xf2, 0x32, 0x9b, 0xb6, 0x0c,
+	0xe8, 0x73, 0xa8, 0x70, 0xee, 0x8b, 0x1b, 0xac, 0xf4, 0x6a, 0x0f, 0xf7, 0x07, 0x95, 0x9b, 0x9b,
+	0x37, 0x36, 0x62, 0x56, 0x07, 0x60, 0xf0, 0x2f, 0x86, 0xd6, 0x47, 0xd0, 0xd2, 0x1a, 0xe6, 0x16,
+	0xca, 0x39, 0xb4, 0xf5, 0xce, 0xb5, 0x7d, 0x5f, 0x63, 0x87, 0x4f, 0xe6, 0x62, 0x5f, 0x86, 0x2d,
+	0x03, 0xeb, 0x35, 0xec, 0x16, 0xfa, 0x24, 0x92, 0x12, 0xee, 0xc4, 0x3c, 0x15, 0xca, 0x00, 0xcd,
+	0xb0, 0xe9, 0xc9, 0x84, 0x70, 0x88, 0x3c, 0xdf, 0x5b, 0x78, 0x5c, 0x24, 0x64, 0xd8, 0x32, 0xb0,
+	0x7e, 0x84, 0xbd, 0x8d, 0xd6, 0x4b, 0x9f, 0x41, 0x55, 0xb6, 0xdd, 0xd4, 0x33, 0x8d, 0xe8, 0x0b,
+	0xa8, 0x0b, 0xf7, 0xd7, 0x6c, 0x95, 0x3a, 0xe7, 0xf1, 0x3f, 0xd8, 0x5f, 0x42, 0x4b, 0x6b, 0xb6,
+	0xb4, 0x03, 0x20, 0x3b, 0xde, 0xb5, 0xb3, 0x60, 0xc2, 0xbe, 0x61, 0x17, 0x10, 0x4a, 0xc1, 0x70,
+	0x1d, 0xee, 0xa4, 0xf6, 0x62, 0x6c, 0x1d, 0x01, 0xdd, 0xec, 0xbd, 0x98, 0xe1, 0x84, 0x67, 0x3b,
+	0xc4, 0xa1, 0xf5, 0x12, 0x9e, 0x6c, 0xeb, 0x53, 0xe8, 0x19, 0xa8, 0xd5, 0xc4, 0xd8, 0xfa, 0x1c,
+	0x9e, 0x6e, 0x6d, 0x46, 0xd4, 0x84, 0x9a, 0x78, 0x56, 0x87, 0x69, 0xd1, 0xdb, 0x59, 0x68, 0xbd,
+	0x01, 0xba, 0xd9, 0x79, 0xe8, 0x7e, 0xda, 0xef, 0x0b, 0xf9, 0x28, 0x00, 0xdd, 0xbc, 0xc0, 0xf5,
+	0x26, 0x2c, 0x49, 0x33, 0xca, 0x42, 0xeb, 0x1a, 0xc8, 0x7a, 0x27, 0xfa, 0x0f, 0xaf, 0x7d, 0x68,
+	0x78, 0xc8, 0x16, 0xb3, 0x65, 0x39, 0x9b, 0x03, 0x16, 0x05, 0xb2, 0xfe, 0xc4, 0x58, 0x03, 0xf8,
+	0x60, 0xcb, 0xcb, 0xb1, 0xed, 0x3c, 0xf2, 0xa5, 0x87, 0xc1, 0x34, 0x4c, 0xb7, 0xaa, 0x00, 0xeb,
+	0x53, 0xd8, 0xdb, 0x78, 0x41, 0xb6, 0x1e, 0xeb, 0x27, 0xd0, 0xd2, 0xda, 0x1c, 0x7e, 0x16, 0xb2,
+	0x11, 0x4a, 0x96, 0x0c, 0xac, 0xc7, 0xd0, 0xba, 0x5a, 0x44, 0x7c, 0x95, 0xd1, 0xac, 0x23, 0x68,
+	0xeb, 0x55, 0xac, 0x6a, 0x32, 0x15, 0x8a, 0x00, 0xfd, 0xb5, 0x9e, 0xa8, 0xd3, 0xb2, 0xd2, 0xb5,
+	0x3e, 0x86, 0x66, 0xb1, 0x09, 0xea, 0xac, 0x7a, 0xc6, 0x3a, 0x82, 0xb6, 0xde, 0xf4, 0x74, 0x9e,
+	0x91, 0xf1, 0x7e, 0x02, 0xba, 0xd9, 0xa4, 0x30, 0xfd, 0x5b, 0xb6, 0x4a, 0xcc, 0xd2, 0x61, 0x05,
+	0xbf, 0x54, 0x1c, 0x63, 0xe1, 0x08, 0x09, 0xde, 0x36, 0xa2, 0x69, 0x84, 0xa7, 0xeb, 0x3b, 0x09,
+	0xff, 0x41, 0x78, 0xcb, 0x02, 0x51, 0x40, 0xb6, 0x8f, 0xb3, 0xee, 0xf6, 0x7d, 0xb4, 0xd2, 0x7d,
+	0xbc, 0xfc, 0xad, 0x0c, 0x06, 0xbe, 0x31, 0xb4, 0x06, 0x95, 0x11, 0xe3, 0xe4, 0x11, 0x0e, 0xfa,
+	0xcc, 0x27, 0x25, 0x1c, 0x0c, 0x18, 0x27, 0x65, 0xda, 0x06, 0x50, 0xf5, 0x4c, 0x2a, 0xb4, 0x0e,
+	0x86, 0x18, 0x19, 0x38, 0x1a, 0x06, 0x93, 0x98, 0xec, 0xd0, 0x3d, 0x68, 0x8d, 0x18, 0x1f, 0x4e,
+	0xaf, 0x43, 0x7e, 0x75, 0xe7, 0x25, 0x9c, 0x54, 0x11, 0xea, 0x33, 0xbf, 0x00, 0xd5, 0x28, 0x40,
+	0x55, 0x96, 0x2e, 0x71, 0xe9, 0x63, 0xd8, 0x2d, 0x54, 0x20, 0x61, 0x94, 0x40, 0xb3, 0xf8, 0x65,
+	0x91, 0x29, 0x2e, 0xac, 0x3e, 0x11, 0x32, 0xa3, 0x4d, 0x7c, 0x43, 0x7d, 0x87, 0x7b, 0x61, 0x40,
+	0xe6, 0xb4, 0x05, 0x8d, 0x9b, 0xec, 0x47, 0x87, 0x78, 0xe8, 0x77, 0x93, 0xd7, 0x7c, 0x42, 0xde,
+	0xe2, 0xfa, 0x5a, 0xe9, 0x92, 0x5b, 0x4a, 0xa1, 0xad, 0x57, 0x28, 0xf1, 0x51, 0x57, 0x28, 0x41,
+	0xb2, 0x40, 0xdf, 0xbc, 0x8a, 0x48, 0x20, 0x36, 0x21, 0xfb, 0x6e, 0xf8, 0x6b, 0x42, 0xc2, 0x1e,
+	0x79, 0xff, 0x57, 0xe7, 0xd1, 0xbb, 0x87, 0x4e, 0xe9, 0xfd, 0x43, 0xa7, 0xf4, 0xe7, 0x43, 0xa7,
+	0x34, 0xae, 0x8a, 0x9f, 0xf5, 0xb3, 0xbf, 0x07, 0x00, 0xea, 0x07, 0x8a, 0x5e, 0xf8, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -53,6 +53,7 @@ func Transfer(sid, tid, typ uint64, name string,
 	}
 	tbl.Indices = IndexDefs(sid, tid, mp, defs)
 	tbl.Properties, _ = PropertyDef(defs)
+	tbl.View, tbl.Definer = ViewDef(defs)
 	data, err := PartitionDef(defs)
 	if err != nil {
 		return tbl, err
@@ -67,7 +68,6 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 	var err error
 	var defs []engine.TableDef
 	var pdef *engine.PartitionByDef
-	var comment *engine.CommentDef
 
 	if len(tbl.Partition) > 0 {
 		if pdef, _, err = protocol.DecodePartition(tbl.Partition); err != nil {
@@ -105,9 +105,16 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 			Name:     idx.Name,
 		})
 	}
-	if tbl.Comment != nil {
-		comment.Comment = string(tbl.Comment)
-		defs = append(defs, comment)
+	if len(tbl.Comment) > 0 {
+		defs = append(defs, &engine.CommentDef{
+			Comment: string(tbl.Comment),
+		})
+	}
+	if len(tbl.View) > 0 {
+		defs = append(defs, &engine.ViewDef{
+			Sql:     tbl.View,
+			Definer: tbl.Definer,
+		})
 	}
 	return tbl.SchemaId, tbl.Id, tbl.Type, tbl.Name, defs, nil
 }
@@ -211,6 +218,16 @@ func CommentDefs(defs []engine.TableDef) string {
 	return ""
 }
 
+// ViewDef returns the query and the definer of the view defined by defs
+func ViewDef(defs []engine.TableDef) (string, string) {
+	for _, def := range defs {
+		if v, ok := def.(*engine.ViewDef); ok {
+			return v.Sql, v.Definer
+		}
+	}
+	return "", ""
+}
+
 func PartitionDef(defs []engine.TableDef) ([]byte, error) {
 	for _, def := range defs {
 		if p, ok := def.(*engine.PartitionByDef); ok {
@@ -225,20 +242,18 @@ func PartitionDef(defs []engine.TableDef) ([]byte, error) {
 }
 
 func PropertyDef(defs []engine.TableDef) ([]aoe.Property, error) {
+	var properties []aoe.Property
 	for _, def := range defs {
 		if propertiesDef, ok := def.(*engine.PropertiesDef); ok {
-			properties := make([]aoe.Property, len(propertiesDef.Properties))
-			for i, engineProperty := range propertiesDef.Properties {
-				property := aoe.Property{
+			for _, engineProperty := range propertiesDef.Properties {
+				properties = append(properties, aoe.Property{
 					Key:   engineProperty.Key,
 					Value: engineProperty.Value,
-				}
-				properties[i] = property
+				})
 			}
-			return properties, nil
 		}
 	}
-	return nil, nil
+	return properties, nil
 }
 
 func Index(tbl aoe.TableInfo) []*engine.IndexTableDef {
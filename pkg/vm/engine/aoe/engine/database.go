@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/codec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	adb "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
@@ -53,10 +54,19 @@ func (db *database) Create(epoch uint64, name string, defs []engine.TableDef) er
 	if err != nil {
 		return err
 	}
+	if len(tbl.View) > 0 {
+		_, err = db.catalog.CreateView(epoch, db.id, tbl)
+		return err
+	}
 	_, err = db.catalog.CreateTable(epoch, db.id, tbl)
 	return err
 }
 
+//Rename renames the table.
+func (db *database) Rename(epoch uint64, name, newName string) error {
+	return db.catalog.RenameTable(epoch, db.id, name, newName)
+}
+
 //Relations returns names of all the tables in the database.
 func (db *database) Relations() []string {
 	t0 := time.Now()
@@ -85,6 +95,10 @@ func (db *database) Relation(name string) (engine.Relation, error) {
 		return nil, err
 	}
 	if tablets == nil || len(tablets) == 0 {
+		//a view has no tablets
+		if tbl, err := db.catalog.GetTable(db.id, name); err == nil && len(tbl.View) > 0 {
+			return &view{tbl: tbl}, nil
+		}
 		return nil, catalog.ErrTableNotExists
 	}
 
@@ -96,6 +110,10 @@ func (db *database) Relation(name string) (engine.Relation, error) {
 		cfg: db.cfg,
 	}
 	r.tablets = tablets
+	r.versions = newVersions(r.tbl)
+	if r.partition, err = newPartition(r.tbl); err != nil {
+		return nil, err
+	}
 	ldb := db.catalog.Driver.AOEStore()
 	for _, tbl := range tablets {
 		if ids, err := db.catalog.Driver.GetSegmentIds(tbl.Name, tbl.ShardId); err != nil {
@@ -108,7 +126,7 @@ func (db *database) Relation(name string) (engine.Relation, error) {
 			addr := db.catalog.Driver.RaftStore().GetRouter().LeaderReplicaStore(tbl.ShardId).ClientAddr
 			storeId := db.catalog.Driver.RaftStore().GetRouter().LeaderReplicaStore(tbl.ShardId).ID
 			if lRelation, err := ldb.Relation(aoedbName.IdToNameFactory.Encode(tbl.ShardId), tbl.Name); err == nil {
-				r.mp[string(codec.Uint642Bytes(tbl.ShardId))] = lRelation
+				r.mp[tabletKey(tbl)] = lRelation
 			}
 			logutil.Debugf("ClientAddr: %v, shardId: %d, storeId: %d", addr, tbl.ShardId, storeId)
 			if !Exist(r.nodes, addr) {
@@ -124,10 +142,12 @@ func (db *database) Relation(name string) (engine.Relation, error) {
 				logutil.Debugf("shardId: %d, segment: %d, Id: %d",
 					tbl.ShardId, id, db.catalog.Driver.RaftStore().Meta().ID)
 				r.segments = append(r.segments, SegmentInfo{
-					Version:  ids.Version,
-					Id:       string(codec.Uint642Bytes(id)),
-					GroupId:  string(codec.Uint642Bytes(tbl.ShardId)),
-					TabletId: string(codec.Uint642Bytes(tbl.ShardId)),
+					Version:      ids.Version,
+					TableVersion: tbl.Version,
+					Partition:    tbl.Partition,
+					Id:           string(codec.Uint642Bytes(id)),
+					GroupId:      string(codec.Uint642Bytes(tbl.ShardId)),
+					TabletId:     tabletKey(tbl),
 					Node: engine.Node{
 						Id:   string(codec.Uint642Bytes(storeId)),
 						Addr: addr,
@@ -141,6 +161,12 @@ func (db *database) Relation(name string) (engine.Relation, error) {
 	return r, nil
 }
 
+//tabletKey returns the key of the tablet in the relation, the versions of a
+//table may have tablets in the same shard.
+func tabletKey(tbl aoe.TabletInfo) string {
+	return string(codec.Uint642Bytes(tbl.ShardId)) + tbl.Name
+}
+
 func Exist(nodes engine.Nodes, iter string) bool {
 	exist := false
 	for _, node := range nodes {
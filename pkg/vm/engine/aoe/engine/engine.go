@@ -84,6 +84,82 @@ func (e *aoeEngine) Create(epoch uint64, name string, typ int) error {
 	return err
 }
 
+//CreateEncrypted creates a database whose tables are encrypted at rest.
+func (e *aoeEngine) CreateEncrypted(epoch uint64, name string, typ int) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("time cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	_, err := e.catalog.CreateEncryptedDatabase(epoch, name, typ)
+	return err
+}
+
+//RotateKey switches the local storage to a new master key and wraps the data keys by it.
+func (e *aoeEngine) RotateKey() (uint32, error) {
+	return e.catalog.Driver.AOEStore().RotateKey()
+}
+
+//Compactions returns the latest finished merges of the local storage and then the running ones,
+//the merges of the tablets which no table of the catalog refers to are skipped.
+func (e *aoeEngine) Compactions() []engine.Compaction {
+	store := e.catalog.Driver.AOEStore()
+	progress := store.CompactionProgress()
+	jobs := append(progress.History, progress.Running...)
+	if len(jobs) == 0 {
+		return nil
+	}
+	names := e.tabletNames()
+	var cs []engine.Compaction
+	for _, job := range jobs {
+		meta, err := store.Store.Catalog.SimpleGetTable(job.TableID)
+		if err != nil {
+			continue
+		}
+		name, ok := names[meta.Schema.Name]
+		if !ok {
+			continue
+		}
+		segments := job.Merged
+		if len(segments) == 0 {
+			segments = []uint64{job.SegmentID}
+		}
+		cs = append(cs, engine.Compaction{
+			Database: name[0],
+			Table:    name[1],
+			Segments: segments,
+			Manual:   job.Manual,
+			Score:    job.Score,
+			Size:     job.Size,
+			Written:  job.Written,
+			Started:  job.Started,
+			Finished: job.Finished,
+			Err:      job.Err,
+		})
+	}
+	return cs
+}
+
+//tabletNames maps the tablets of the tables in the catalog to the names of the database and the table.
+func (e *aoeEngine) tabletNames() map[string][2]string {
+	names := make(map[string][2]string)
+	dbs, err := e.catalog.ListDatabases()
+	if err != nil {
+		return names
+	}
+	for _, db := range dbs {
+		tbls, err := e.catalog.ListTables(db.Id)
+		if err != nil {
+			continue
+		}
+		for i := range tbls {
+			for _, v := range catalog.Versions(&tbls[i]) {
+				names[e.catalog.EncodeTabletName(0, v.Id)] = [2]string{db.Name, tbls[i].Name}
+			}
+		}
+	}
+	return names
+}
+
 //Databases returns all the databases in the catalog.
 func (e *aoeEngine) Databases() []string {
 	t0 := time.Now()
@@ -22,6 +22,7 @@ import (
 	catalog2 "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	aoe3 "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/config"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/testutil"
@@ -262,12 +263,140 @@ func TestAOEEngine(t *testing.T) {
 	tbls = db.Relations()
 	require.Equal(t, 0, len(tbls))
 
+	//test partitioned relation
+	ptbl := adaptor.MockTableInfo(colCnt)
+	ptbl.Name = fmt.Sprintf("%s%d_p", tableName, time.Now().Unix())
+	_, _, _, _, pdefs, _ := helper.UnTransfer(*ptbl)
+	pdefs = append(pdefs, mockRangePartition(t, "mock_0", 1000))
+	err = db.Create(6, ptbl.Name, pdefs)
+	require.NoError(t, err)
+	tb, err = db.Relation(ptbl.Name)
+	require.NoError(t, err)
+	err = tb.Write(7, ibat)
+	require.NoError(t, err)
+	tb.Close()
+
+	// the relation of a node only lists the segments of the tablets whose
+	// shard leader is on the node, so the segments of both partitions are
+	// gathered from the relations of all the nodes once their blocks are created
+	var rels []*relation
+	var blocks, prunedBlocks []aoe.Block
+	for i := 0; ; i++ {
+		rels = nodeRelations(t, catalogs, ptbl.Name)
+		blocks, prunedBlocks = nil, nil
+		for _, rel := range rels {
+			for _, si := range rel.segments {
+				segment := rel.Segment(si)
+				for _, id := range segment.Blocks() {
+					blocks = append(blocks, segment.Block(id))
+					if si.Partition == 0 {
+						prunedBlocks = append(prunedBlocks, segment.Block(id))
+					}
+				}
+			}
+		}
+		if (len(prunedBlocks) > 0 && len(prunedBlocks) < len(blocks)) || i == 49 {
+			break
+		}
+		for _, rel := range rels {
+			rel.Close()
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.NotEmpty(t, prunedBlocks, "Write: no block in partition p0")
+	require.Less(t, len(prunedBlocks), len(blocks), "Write: no block in partition p1")
+	r := rels[0]
+	require.Equal(t, []int64{2000, 8000}, partitionRows(r), "Write: wrong partition rows")
+	pruned := 0
+	for _, rel := range rels {
+		s := &store{rel: rel}
+		s.SetBlocks(rel.blocks())
+		s.sparseFilter(&filterContext{
+			filterType: FileterPredicate,
+			param1:     &vengine.Predicate{Op: vengine.PredLt, Attr: "mock_0", Vals: []interface{}{int32(10)}},
+		})
+		pruned += len(s.blocks)
+	}
+	require.Equal(t, len(prunedBlocks), pruned, "Prune: wrong blocks")
+	require.Less(t, pruned, len(blocks), "Prune: no block pruned")
+	for _, rel := range rels[1:] {
+		rel.Close()
+	}
+	tb = r
+
+	deleted, err := r.Delete(8, &vengine.Predicate{Op: vengine.PredGe, Attr: "mock_0", Vals: []interface{}{int32(1000)}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8000), deleted, "Delete: wrong deleted rows")
+	deleted, err = r.Delete(8, &vengine.Predicate{Op: vengine.PredGe, Attr: "mock_0", Vals: []interface{}{int32(1000)}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), deleted, "Delete: rows deleted twice")
+
+	err = tb.AddTableDef(8, &vengine.TruncatePartitionDef{Name: "p1"})
+	require.NoError(t, err)
+	tb.Close()
+	tb, err = db.Relation(ptbl.Name)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2000, 0}, partitionRows(tb.(*relation)), "TruncatePartition: wrong partition rows")
+
+	err = tb.DelTableDef(9, &vengine.PartitionDef{Name: "p0"})
+	require.NoError(t, err)
+	err = tb.Write(10, ibat)
+	require.NoError(t, err)
+	tb.Close()
+	tb, err = db.Relation(ptbl.Name)
+	require.NoError(t, err)
+	require.Equal(t, []int64{blockRows}, partitionRows(tb.(*relation)), "DropPartition: wrong partition rows")
+	tb.Close()
+
 	if restart {
 		time.Sleep(3 * time.Second)
 		doRestartEngine(t)
 	}
 }
 
+// mockRangePartition returns the RANGE partitions p0 of the values less than
+// bound and p1 of the others.
+func mockRangePartition(t *testing.T, attr string, bound int64) *vengine.PartitionByDef {
+	vec, err := vengine.DefaultVector(vengine.Attribute{
+		Name:    attr,
+		Type:    types.Type{Oid: types.T_int32, Size: 4, Width: 4},
+		Default: vengine.MakeDefaultExpr(true, bound, false),
+	}, 1)
+	require.NoError(t, err)
+	to := []extend.Extend{&extend.ValueExtend{V: vec}}
+	return &vengine.PartitionByDef{
+		Fields: []string{attr},
+		Range: []vengine.RangePartition{
+			{Name: "p0", To: to},
+			{Name: "p1", From: to},
+		},
+	}
+}
+
+// nodeRelations opens the relation name through the engine of every node.
+func nodeRelations(t *testing.T, catalogs []*catalog2.Catalog, name string) []*relation {
+	var rels []*relation
+	for _, c := range catalogs {
+		db, err := New(c, &EngineConfig{}).Database(testDBName)
+		require.NoError(t, err)
+		tb, err := db.Relation(name)
+		require.NoError(t, err)
+		rels = append(rels, tb.(*relation))
+	}
+	return rels
+}
+
+// partitionRows returns the number of rows of each partition of the relation.
+func partitionRows(r *relation) []int64 {
+	rows := make([]int64, r.partition.Len())
+	for _, tbl := range r.tablets {
+		if rel, ok := r.mp[tabletKey(tbl)]; ok {
+			rows[tbl.Partition] += rel.Rows()
+		}
+	}
+	return rows
+}
+
 func doRestartEngine(t *testing.T) {
 	c := testutil.NewTestAOECluster(t,
 		func(node int) *config.Config {
@@ -0,0 +1,62 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/protocol"
+)
+
+//newPartition returns the partitions of the table, it is nil if the table is
+//not partitioned.
+func newPartition(tbl *aoe.TableInfo) (*engine.PartitionByDef, error) {
+	if len(tbl.Partitions) == 0 {
+		return nil, nil
+	}
+	def, _, err := protocol.DecodePartition(tbl.Partition)
+	return def, err
+}
+
+//writePartitions splits the batch by the partitions of the table, and writes
+//the rows of each partition into a random tablet of the partition.
+func (r *relation) writePartitions(bat *batch.Batch) error {
+	bats, err := r.partition.Split(bat)
+	if err != nil {
+		return err
+	}
+	for i, pbat := range bats {
+		if pbat == nil {
+			continue
+		}
+		tablets := make([]aoe.TabletInfo, 0, len(r.tablets))
+		for _, tbl := range r.tablets {
+			if tbl.Partition == i {
+				tablets = append(tablets, tbl)
+			}
+		}
+		if len(tablets) == 0 {
+			return errors.New("no tablets exists")
+		}
+		if err := r.append(tablets[rand.Intn(len(tablets))], pbat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -18,9 +18,13 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math/rand"
+	"sort"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 
@@ -44,13 +48,57 @@ func (r *relation) ID() string {
 	return r.tbl.Name
 }
 
+//Check verifies the files of the tablets stored on this node against their checksums.
+func (r *relation) Check() ([]string, error) {
+	names := make([]string, 0, len(r.mp))
+	for name := range r.mp {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var msgs []string
+	for _, name := range names {
+		corruptions, err := r.mp[name].Scrub()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range corruptions {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", name, c.String()))
+		}
+	}
+	return msgs, nil
+}
+
+//Compact merges the closed segments of the tablets stored on this node.
+func (r *relation) Compact() ([]string, error) {
+	names := make([]string, 0, len(r.mp))
+	for name := range r.mp {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var msgs []string
+	for _, name := range names {
+		jobs, err := r.mp[name].Compact()
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range jobs {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", name, job.String()))
+		}
+	}
+	return msgs, nil
+}
+
 //Segment returns the segment according to the segmentInfo.
 func (r *relation) Segment(si SegmentInfo) aoe.Segment {
 	t0 := time.Now()
 	defer func() {
 		logutil.Debugf("time cost %d ms", time.Since(t0))
 	}()
-	return r.mp[si.TabletId].Segment(binary.BigEndian.Uint64([]byte(si.Id)))
+	seg := r.mp[si.TabletId].Segment(binary.BigEndian.Uint64([]byte(si.Id)))
+	if v := r.versions[si.TableVersion]; v != nil {
+		return &versionSegment{Segment: seg, v: v}
+	}
+	return seg
 }
 
 //Segments returns all the SegmentIfo in the relation.
@@ -74,10 +122,66 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	defer func() {
 		logutil.Debugf("time cost %d ms", time.Since(t0).Milliseconds())
 	}()
-	if len(r.tablets) == 0 {
+	if r.partition != nil {
+		return r.writePartitions(bat)
+	}
+	// the rows are written to the last version
+	last := len(r.versions) - 1
+	tablets := make([]aoe.TabletInfo, 0, len(r.tablets))
+	for _, tbl := range r.tablets {
+		if tbl.Version == last {
+			tablets = append(tablets, tbl)
+		}
+	}
+	if len(tablets) == 0 {
 		return errors.New("no tablets exists")
 	}
-	targetTbl := r.tablets[rand.Intn(len(r.tablets))]
+	targetTbl := tablets[rand.Intn(len(tablets))]
+	if v := r.versions[last]; v != nil {
+		vbat := *bat
+		vbat.Attrs = make([]string, len(bat.Attrs))
+		for i, attr := range bat.Attrs {
+			name, ok := v.attrs[attr]
+			if !ok {
+				return fmt.Errorf("unknown column '%s'", attr)
+			}
+			vbat.Attrs[i] = name
+		}
+		bat = &vbat
+	}
+	return r.append(targetTbl, bat)
+}
+
+//Delete deletes the rows satisfying the predicate from every tablet of the
+//table, all rows if it is nil.
+func (r *relation) Delete(_ uint64, p *engine.Predicate) (uint64, error) {
+	if p == nil {
+		p = &engine.Predicate{Op: engine.PredAnd}
+	}
+	count := uint64(0)
+	for _, tablet := range r.tablets {
+		q := p
+		if tablet.Version < len(r.versions) && r.versions[tablet.Version] != nil {
+			var err error
+			if q, err = r.versions[tablet.Version].exactPredicate(p); err != nil {
+				return count, err
+			}
+		}
+		data, err := encoding.Encode(q)
+		if err != nil {
+			return count, err
+		}
+		deleted, err := r.catalog.Driver.DeleteRows(tablet.Name, tablet.ShardId, data)
+		if err != nil {
+			return count, err
+		}
+		count += deleted
+	}
+	return count, nil
+}
+
+//append writes the batch into the tablet.
+func (r *relation) append(tablet aoe.TabletInfo, bat *batch.Batch) error {
 	var buf bytes.Buffer
 	if err := protocol.EncodeBatch(bat, &buf); err != nil {
 		return err
@@ -85,7 +189,7 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	if buf.Len() == 0 {
 		return errors.New("empty batch")
 	}
-	return r.catalog.Driver.Append(targetTbl.Name, targetTbl.ShardId, buf.Bytes())
+	return r.catalog.Driver.Append(tablet.Name, tablet.ShardId, buf.Bytes())
 }
 
 func (r *relation) CreateIndex(epoch uint64, defs []engine.TableDef) error {
@@ -115,8 +219,18 @@ func (r *relation) Rows() int64 {
 
 func (r *relation) Size(attr string) int64 {
 	totalSize := int64(0)
-	for _, aoeRelation := range r.mp {
-		totalSize += aoeRelation.Size(attr)
+	for _, tbl := range r.tablets {
+		aoeRelation, ok := r.mp[tabletKey(tbl)]
+		if !ok {
+			continue
+		}
+		name := attr
+		if v := r.versions[tbl.Version]; v != nil {
+			if name, ok = v.attrs[attr]; !ok {
+				continue
+			}
+		}
+		totalSize += aoeRelation.Size(name)
 	}
 	return totalSize
 }
@@ -127,17 +241,130 @@ func (r *relation) Nodes() engine.Nodes {
 
 func (r *relation) TableDefs() []engine.TableDef {
 	_, _, _, _, defs, _ := helper.UnTransfer(*r.tbl)
+	if stats := r.statistics(); stats != nil {
+		defs = append(defs, stats)
+	}
 	return defs
 }
 
+//statistics returns the statistics of the table, they are read from the
+//catalog once when the relation needs them first.
+func (r *relation) statistics() *engine.StatisticsDef {
+	r.statsOnce.Do(func() {
+		stats, err := r.catalog.GetTableStatistics(r.tbl.Id)
+		if err != nil {
+			logutil.Errorf("get statistics of table %d failed, %v", r.tbl.Id, err)
+			return
+		}
+		r.stats = stats
+	})
+	return r.stats
+}
+
 func (r *relation) AddTableDef(u uint64, def engine.TableDef) error {
-	return nil
+	var err error
+
+	switch d := def.(type) {
+	case *engine.StatisticsDef:
+		if err = r.catalog.SetTableStatistics(r.tbl.Id, d); err == nil {
+			r.statsOnce.Do(func() {})
+			r.stats = d
+		}
+		return err
+	case *engine.AttributeDef:
+		err = r.catalog.AddColumn(u, r.pid, r.tbl.Name, aoe.ColumnInfo{
+			Name:    d.Attr.Name,
+			Alg:     int(d.Attr.Alg),
+			Type:    d.Attr.Type,
+			Default: d.Attr.Default,
+		})
+	case *engine.RenameAttributeDef:
+		err = r.catalog.RenameColumn(u, r.pid, r.tbl.Name, d.Name, d.NewName)
+	case *engine.DefaultDef:
+		err = r.catalog.SetColumnDefault(u, r.pid, r.tbl.Name, d.Name, d.Default)
+	case *engine.PartitionByDef:
+		err = r.catalog.AddPartitions(u, r.pid, r.tbl.Name, d)
+	case *engine.TruncatePartitionDef:
+		err = r.catalog.TruncatePartition(u, r.pid, r.tbl.Name, d.Name)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.refresh()
 }
 
 func (r *relation) DelTableDef(u uint64, def engine.TableDef) error {
+	var err error
+
+	switch d := def.(type) {
+	case *engine.AttributeDef:
+		err = r.catalog.DropColumn(u, r.pid, r.tbl.Name, d.Attr.Name)
+	case *engine.PartitionDef:
+		err = r.catalog.DropPartition(u, r.pid, r.tbl.Name, d.Name)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.refresh()
+}
+
+//refresh reloads the schema and the tablets of the table after it is altered,
+//the relation still reads the segments it had, with the new schema.
+func (r *relation) refresh() error {
+	tablets, err := r.catalog.GetTablets(r.pid, r.tbl.Name)
+	if err != nil {
+		return err
+	}
+	if len(tablets) == 0 {
+		return catalog.ErrTableNotExists
+	}
+	r.tbl = &tablets[0].Table
+	r.tablets = tablets
+	r.versions = newVersions(r.tbl)
+	r.partition, err = newPartition(r.tbl)
+	return err
+}
+
+//blocks returns all the blocks of the segments of the relation
+func (r *relation) blocks() []aoe.Block {
+	blocks := make([]aoe.Block, 0)
+	for _, sid := range r.segments {
+		segment := r.Segment(sid)
+		ids := segment.Blocks()
+		for _, id := range ids {
+			blocks = append(blocks, segment.Block(id))
+		}
+	}
+	return blocks
+}
+
+//Snapshot pins the rows of the blocks of the relation, the readers created
+//later do not read the rows written or deleted after it.
+func (r *relation) Snapshot() error {
+	blocks := r.blocks()
+	for i, blk := range blocks {
+		blocks[i] = pinBlock(blk)
+	}
+	r.pinned = blocks
 	return nil
 }
 
+//pinner is implemented by the blocks whose rows can be pinned
+type pinner interface {
+	Pin() aoe.Block
+}
+
+func pinBlock(blk aoe.Block) aoe.Block {
+	if p, ok := blk.(pinner); ok {
+		return p.Pin()
+	}
+	return blk
+}
+
 func (r *relation) NewReader(num int) []engine.Reader {
 	iodepth := num / int(r.cfg.QueueMaxReaderCount)
 	if num % int(r.cfg.QueueMaxReaderCount) > 0 {
@@ -159,13 +386,9 @@ func (r *relation) NewReader(num int) []engine.Reader {
 		}
 		return readStore.readers
 	}
-	blocks := make([]aoe.Block, 0)
-	for _, sid := range r.segments {
-		segment := r.Segment(sid)
-		ids := segment.Blocks()
-		for _, id := range ids {
-			blocks = append(blocks, segment.Block(id))
-		}
+	blocks := r.pinned
+	if blocks == nil {
+		blocks = r.blocks()
 	}
 	readStore.SetBlocks(blocks)
 	for i := 0; i < num; i++ {
@@ -25,6 +25,7 @@ const (
 	FileterGt
 	FileterGe
 	FileterBtw
+	FileterPredicate
 )
 
 func NewAoeSparseFilter(s *store, reader *aoeReader) *AoeSparseFilter {
@@ -100,3 +101,13 @@ func (a AoeSparseFilter) Btw(s string, i interface{}, i2 interface{}) (engine.Re
 	})
 	return a.reader, nil
 }
+
+func (a AoeSparseFilter) Eval(p *engine.Predicate) (engine.Reader, error) {
+	a.reader.filter = append(a.reader.filter, filterContext{
+		filterType: FileterPredicate,
+		attr:       p.Attr,
+		param1:     p,
+		param2:     nil,
+	})
+	return a.reader, nil
+}
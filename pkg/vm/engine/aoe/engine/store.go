@@ -15,6 +15,7 @@
 package engine
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 )
 
@@ -208,6 +209,36 @@ func (s *store) sparseFilter(filter *filterContext)  {
 		}
 		s.SetBlocks(blocks)
 		break
+	case FileterPredicate:
+		exist := make(map[string]struct{}, len(s.blocks))
+		for _, block := range s.blocks {
+			exist[block.ID()] = struct{}{}
+		}
+		p := filter.param1.(*engine.Predicate)
+		// the segments of the partitions which cannot hold a matching row are skipped
+		var partitions []bool
+		if s.rel.partition != nil {
+			partitions = s.rel.partition.Prune(p)
+		}
+		blocks := make([]aoe.Block, 0)
+		for _, sid := range s.rel.segments {
+			if partitions != nil && !partitions[sid.Partition] {
+				continue
+			}
+			segment := s.rel.Segment(sid)
+			ids, err := segment.NewSparseFilter().Eval(p)
+			if err != nil {
+				// the predicate cannot be evaluated, so all the blocks of the segment are kept
+				ids = segment.Blocks()
+			}
+			for _, id := range ids {
+				if _, ok := exist[id]; ok {
+					blocks = append(blocks, segment.Block(id))
+				}
+			}
+		}
+		s.SetBlocks(blocks)
+		break
 	default:
 		panic("No Support")
 	}
@@ -37,11 +37,13 @@ type EngineConfig struct {
 }
 
 type SegmentInfo struct {
-	Version  uint64
-	Id       string
-	GroupId  string
-	TabletId string
-	Node     engine.Node
+	Version      uint64
+	TableVersion int // index of the version of the table stored by the segment
+	Partition    int // index of the partition of the table stored by the segment
+	Id           string
+	GroupId      string
+	TabletId     string
+	Node         engine.Node
 }
 
 type filterContext struct {
@@ -112,6 +114,12 @@ type relation struct {
 	segments []SegmentInfo              //segments of the table
 	tablets  []aoe.TabletInfo           //tablets of the table
 	mp       map[string]*aoedb.Relation //a map of each tablet and its relation
+	versions []*version                 //the mapping of each version, nil if it stores the columns as they are
+	partition *engine.PartitionByDef    //the partitions of the table, nil if it is not partitioned
+	pinned   []aoe.Block                //the blocks pinned by Snapshot, nil if the relation is not pinned
 	reader   *store
 	cfg      *EngineConfig
+
+	statsOnce sync.Once
+	stats     *engine.StatisticsDef //the statistics of the table, nil if it is not analyzed
 }
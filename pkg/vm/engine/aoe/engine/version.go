@@ -0,0 +1,285 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
+)
+
+//version maps the attributes of the table to the columns of a version
+type version struct {
+	first string                      //a column of the version, used to count the rows
+	attrs map[string]string           //name of the column storing an attribute
+	fills map[string]engine.Attribute //attributes which are not stored, and the value to fill them
+}
+
+//newVersions returns the mapping of each version of the table, the mapping of
+//a version which stores the attributes as they are is nil.
+func newVersions(tbl *aoe.TableInfo) []*version {
+	vs := catalog.Versions(tbl)
+	versions := make([]*version, len(vs))
+	for i, v := range vs {
+		names := make(map[uint64]string, len(v.Columns))
+		for j, id := range v.Columns {
+			names[id] = v.Names[j]
+		}
+		same := len(v.Columns) == len(tbl.Columns)
+		ver := &version{
+			first: v.Names[0],
+			attrs: make(map[string]string),
+			fills: make(map[string]engine.Attribute),
+		}
+		for _, col := range tbl.Columns {
+			if name, ok := names[col.Id]; ok {
+				ver.attrs[col.Name] = name
+				same = same && name == col.Name
+				continue
+			}
+			same = false
+			ver.fills[col.Name] = engine.Attribute{
+				Name:    col.Name,
+				Alg:     compress.T(col.Alg),
+				Type:    col.Type,
+				Default: col.Fill,
+			}
+		}
+		if !same {
+			versions[i] = ver
+		}
+	}
+	return versions
+}
+
+//predicate returns the predicate on the columns of the version, the leaves on
+//the attributes which are not stored are true for every block.
+func (v *version) predicate(p *engine.Predicate) *engine.Predicate {
+	switch p.Op {
+	case engine.PredAnd:
+		var args []*engine.Predicate
+		for _, arg := range p.Args {
+			if q := v.predicate(arg); q != nil {
+				args = append(args, q)
+			}
+		}
+		if len(args) == 0 {
+			return nil
+		}
+		return &engine.Predicate{Op: p.Op, Args: args}
+	case engine.PredOr:
+		args := make([]*engine.Predicate, len(p.Args))
+		for i, arg := range p.Args {
+			if args[i] = v.predicate(arg); args[i] == nil {
+				return nil
+			}
+		}
+		return &engine.Predicate{Op: p.Op, Args: args}
+	}
+	name, ok := v.attrs[p.Attr]
+	if !ok {
+		return nil
+	}
+	q := *p
+	q.Attr = name
+	return &q
+}
+
+//exactPredicate returns the predicate on the columns of the version which
+//selects the same rows, the leaves on the attributes which are not stored
+//are evaluated on the value filling them.
+func (v *version) exactPredicate(p *engine.Predicate) (*engine.Predicate, error) {
+	switch p.Op {
+	case engine.PredAnd, engine.PredOr:
+		args := make([]*engine.Predicate, len(p.Args))
+		for i, arg := range p.Args {
+			q, err := v.exactPredicate(arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = q
+		}
+		return &engine.Predicate{Op: p.Op, Args: args}, nil
+	}
+	if name, ok := v.attrs[p.Attr]; ok {
+		q := *p
+		q.Attr = name
+		return &q, nil
+	}
+	attr, ok := v.fills[p.Attr]
+	if !ok {
+		return nil, fmt.Errorf("unknown column '%s'", p.Attr)
+	}
+	vec, err := engine.DefaultVector(attr, 1)
+	if err != nil {
+		return nil, err
+	}
+	ok, err = db.EvalRow(p, map[string]*vector.Vector{p.Attr: vec}, 0)
+	if err != nil {
+		return nil, err
+	}
+	// an And without arguments is true, an Or without arguments is false
+	if ok {
+		return &engine.Predicate{Op: engine.PredAnd}, nil
+	}
+	return &engine.Predicate{Op: engine.PredOr}, nil
+}
+
+//versionSegment reads a segment of a version with the attributes of the table
+type versionSegment struct {
+	aoe.Segment
+	v *version
+}
+
+func (s *versionSegment) Block(id string) aoe.Block {
+	return &versionBlock{Block: s.Segment.Block(id), v: s.v}
+}
+
+func (s *versionSegment) NewSparseFilter() aoe.SparseFilter {
+	return &versionSparseFilter{SparseFilter: s.Segment.NewSparseFilter(), seg: s.Segment, v: s.v}
+}
+
+type versionBlock struct {
+	aoe.Block
+	v *version
+}
+
+func (b *versionBlock) Pin() aoe.Block {
+	return &versionBlock{Block: pinBlock(b.Block), v: b.v}
+}
+
+func (b *versionBlock) Prefetch(attrs []string) {
+	names := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		if name, ok := b.v.attrs[attr]; ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		b.Block.Prefetch(names)
+	}
+}
+
+func (b *versionBlock) Read(refCount []uint64, attrs []string, cds, dds []*bytes.Buffer) (*batch.Batch, error) {
+	names := make([]string, 0, len(attrs))
+	refs := make([]uint64, 0, len(attrs))
+	ccds := make([]*bytes.Buffer, 0, len(attrs))
+	cdds := make([]*bytes.Buffer, 0, len(attrs))
+	for i, attr := range attrs {
+		if name, ok := b.v.attrs[attr]; ok {
+			names = append(names, name)
+			refs = append(refs, refCount[i])
+			ccds = append(ccds, cds[i])
+			cdds = append(cdds, dds[i])
+		}
+	}
+	if len(names) == 0 {
+		// only to count the rows
+		names = append(names, b.v.first)
+		refs = append(refs, 1)
+		ccds = append(ccds, new(bytes.Buffer))
+		cdds = append(cdds, new(bytes.Buffer))
+	}
+	data, err := b.Block.Read(refs, names, ccds, cdds)
+	if err != nil {
+		return nil, err
+	}
+	n := vector.Length(data.Vecs[0])
+	bat := batch.New(true, attrs)
+	for i, j := 0, 0; i < len(attrs); i++ {
+		if _, ok := b.v.attrs[attrs[i]]; ok {
+			bat.Vecs[i] = data.Vecs[j]
+			j++
+			continue
+		}
+		vec, err := engine.DefaultVector(b.v.fills[attrs[i]], n)
+		if err != nil {
+			return nil, err
+		}
+		vec.Or = true
+		vec.Ref = refCount[i]
+		bat.Vecs[i] = vec
+	}
+	return bat, nil
+}
+
+type versionSparseFilter struct {
+	aoe.SparseFilter
+	seg aoe.Segment
+	v   *version
+}
+
+func (f *versionSparseFilter) Eq(attr string, val interface{}) ([]string, error) {
+	if name, ok := f.v.attrs[attr]; ok {
+		return f.SparseFilter.Eq(name, val)
+	}
+	return f.seg.Blocks(), nil
+}
+
+func (f *versionSparseFilter) Ne(attr string, val interface{}) ([]string, error) {
+	if name, ok := f.v.attrs[attr]; ok {
+		return f.SparseFilter.Ne(name, val)
+	}
+	return f.seg.Blocks(), nil
+}
+
+func (f *versionSparseFilter) Lt(attr string, val interface{}) ([]string, error) {
+	if name, ok := f.v.attrs[attr]; ok {
+		return f.SparseFilter.Lt(name, val)
+	}
+	return f.seg.Blocks(), nil
+}
+
+func (f *versionSparseFilter) Le(attr string, val interface{}) ([]string, error) {
+	if name, ok := f.v.attrs[attr]; ok {
+		return f.SparseFilter.Le(name, val)
+	}
+	return f.seg.Blocks(), nil
+}
+
+func (f *versionSparseFilter) Gt(attr string, val interface{}) ([]string, error) {
+	if name, ok := f.v.attrs[attr]; ok {
+		return f.SparseFilter.Gt(name, val)
+	}
+	return f.seg.Blocks(), nil
+}
+
+func (f *versionSparseFilter) Ge(attr string, val interface{}) ([]string, error) {
+	if name, ok := f.v.attrs[attr]; ok {
+		return f.SparseFilter.Ge(name, val)
+	}
+	return f.seg.Blocks(), nil
+}
+
+func (f *versionSparseFilter) Btw(attr string, lo, hi interface{}) ([]string, error) {
+	if name, ok := f.v.attrs[attr]; ok {
+		return f.SparseFilter.Btw(name, lo, hi)
+	}
+	return f.seg.Blocks(), nil
+}
+
+func (f *versionSparseFilter) Eval(p *engine.Predicate) ([]string, error) {
+	if q := f.v.predicate(p); q != nil {
+		return f.SparseFilter.Eval(q)
+	}
+	return f.seg.Blocks(), nil
+}
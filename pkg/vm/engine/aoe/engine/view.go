@@ -0,0 +1,76 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
+)
+
+//view is a view of the database, it has no rows and only its definition is read.
+type view struct {
+	tbl *aoe.TableInfo
+}
+
+func (v *view) Close() {}
+
+func (v *view) ID() string {
+	return v.tbl.Name
+}
+
+func (v *view) Rows() int64 {
+	return 0
+}
+
+func (v *view) Size(_ string) int64 {
+	return 0
+}
+
+func (v *view) Nodes() engine.Nodes {
+	return nil
+}
+
+func (v *view) TableDefs() []engine.TableDef {
+	_, _, _, _, defs, _ := helper.UnTransfer(*v.tbl)
+	return defs
+}
+
+func (v *view) CreateIndex(_ uint64, _ []engine.TableDef) error {
+	return fmt.Errorf("'%s' is a view", v.tbl.Name)
+}
+
+func (v *view) DropIndex(_ uint64, _ string) error {
+	return fmt.Errorf("'%s' is a view", v.tbl.Name)
+}
+
+func (v *view) Write(_ uint64, _ *batch.Batch) error {
+	return fmt.Errorf("'%s' is a view", v.tbl.Name)
+}
+
+func (v *view) AddTableDef(_ uint64, _ engine.TableDef) error {
+	return fmt.Errorf("'%s' is a view", v.tbl.Name)
+}
+
+func (v *view) DelTableDef(_ uint64, _ engine.TableDef) error {
+	return fmt.Errorf("'%s' is a view", v.tbl.Name)
+}
+
+func (v *view) NewReader(n int) []engine.Reader {
+	return make([]engine.Reader, n)
+}
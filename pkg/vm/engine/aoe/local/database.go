@@ -57,3 +57,7 @@ func (d *localRoDatabase) Delete(uint64, string) error {
 func (d *localRoDatabase) Create(uint64, string, []engine.TableDef) error {
 	panic("not supported")
 }
+
+func (d *localRoDatabase) Rename(uint64, string, string) error {
+	panic("not supported")
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// EraseRows overwrites the values of the given rows with zeros and marks
+// them as null. The rows keep their positions, so the row offsets of the
+// columns stay valid.
+func EraseRows(cols []*vector.Vector, rows *roaring.Bitmap) {
+	if rows == nil || rows.IsEmpty() {
+		return
+	}
+	for _, col := range cols {
+		n := uint32(vector.Length(col))
+		it := rows.Iterator()
+		for it.HasNext() {
+			row := it.Next()
+			if row >= n {
+				break
+			}
+			eraseRow(col, row)
+			nulls.Add(col.Nsp, uint64(row))
+		}
+	}
+}
+
+func eraseRow(col *vector.Vector, row uint32) {
+	switch vs := col.Col.(type) {
+	case []int8:
+		vs[row] = 0
+	case []int16:
+		vs[row] = 0
+	case []int32:
+		vs[row] = 0
+	case []int64:
+		vs[row] = 0
+	case []uint8:
+		vs[row] = 0
+	case []uint16:
+		vs[row] = 0
+	case []uint32:
+		vs[row] = 0
+	case []uint64:
+		vs[row] = 0
+	case []float32:
+		vs[row] = 0
+	case []float64:
+		vs[row] = 0
+	case []types.Date:
+		vs[row] = 0
+	case []types.Datetime:
+		vs[row] = 0
+	case *types.Bytes:
+		data := vs.Get(int64(row))
+		for i := range data {
+			data[i] = 0
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float32s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float64s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int16s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int32s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int64s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int8s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/uint16s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/uint32s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/uint64s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/uint8s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/varchar"
+)
+
+// RowPos is the position of a row in the blocks of a column
+type RowPos struct {
+	Block uint32
+	Row   uint32
+}
+
+// PurgeOrder returns the positions of the rows of the blocks which are not
+// deleted, sorted by the primary key column, followed by repeats of the
+// last of them up to the total row count of the blocks. It returns nil if
+// every row is deleted. deletes holds the deleted rows of each block, nil
+// if none.
+func PurgeOrder(pk []*vector.Vector, deletes []*roaring.Bitmap) []RowPos {
+	total := 0
+	alive := make([]RowPos, 0)
+	for blk, vec := range pk {
+		n := vector.Length(vec)
+		total += n
+		for row := 0; row < n; row++ {
+			if deletes[blk] != nil && deletes[blk].Contains(uint32(row)) {
+				continue
+			}
+			alive = append(alive, RowPos{Block: uint32(blk), Row: uint32(row)})
+		}
+	}
+	if len(alive) == 0 {
+		return nil
+	}
+	sortedIdx := make([]uint32, len(alive))
+	sortColumn(gather(pk, alive), sortedIdx)
+	order := make([]RowPos, total)
+	for i, j := range sortedIdx {
+		order[i] = alive[j]
+	}
+	for i := len(alive); i < total; i++ {
+		order[i] = order[len(alive)-1]
+	}
+	return order
+}
+
+// Purge rewrites the blocks of a column in the order returned by
+// PurgeOrder, each block keeps its row count.
+func Purge(column []*vector.Vector, order []RowPos) {
+	purged := make([]*vector.Vector, len(column))
+	start := 0
+	for blk, vec := range column {
+		n := vector.Length(vec)
+		purged[blk] = gather(column, order[start:start+n])
+		start += n
+	}
+	copy(column, purged)
+}
+
+// sortColumn sorts the column and sets sortedIdx to the original positions
+// of the sorted rows. The columns of a type which cannot be sorted are
+// left as they are.
+func sortColumn(col *vector.Vector, sortedIdx []uint32) {
+	switch col.Typ.Oid {
+	case types.T_int8:
+		int8s.Sort(col, sortedIdx)
+	case types.T_int16:
+		int16s.Sort(col, sortedIdx)
+	case types.T_int32:
+		int32s.Sort(col, sortedIdx)
+	case types.T_int64:
+		int64s.Sort(col, sortedIdx)
+	case types.T_uint8:
+		uint8s.Sort(col, sortedIdx)
+	case types.T_uint16:
+		uint16s.Sort(col, sortedIdx)
+	case types.T_uint32:
+		uint32s.Sort(col, sortedIdx)
+	case types.T_uint64:
+		uint64s.Sort(col, sortedIdx)
+	case types.T_float32:
+		float32s.Sort(col, sortedIdx)
+	case types.T_float64:
+		float64s.Sort(col, sortedIdx)
+	case types.T_char, types.T_json, types.T_varchar:
+		varchar.Sort(col, sortedIdx)
+	default:
+		for i := range sortedIdx {
+			sortedIdx[i] = uint32(i)
+		}
+	}
+}
+
+// gather returns a new vector holding the rows at the given positions of
+// the blocks of a column
+func gather(column []*vector.Vector, pos []RowPos) *vector.Vector {
+	vec := vector.New(column[0].Typ)
+	for i, p := range pos {
+		if nulls.Contains(column[p.Block].Nsp, uint64(p.Row)) {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	switch column[0].Col.(type) {
+	case []int8:
+		vs := make([]int8, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]int8)[p.Row]
+		}
+		vec.Col = vs
+	case []int16:
+		vs := make([]int16, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]int16)[p.Row]
+		}
+		vec.Col = vs
+	case []int32:
+		vs := make([]int32, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]int32)[p.Row]
+		}
+		vec.Col = vs
+	case []int64:
+		vs := make([]int64, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]int64)[p.Row]
+		}
+		vec.Col = vs
+	case []uint8:
+		vs := make([]uint8, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]uint8)[p.Row]
+		}
+		vec.Col = vs
+	case []uint16:
+		vs := make([]uint16, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]uint16)[p.Row]
+		}
+		vec.Col = vs
+	case []uint32:
+		vs := make([]uint32, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]uint32)[p.Row]
+		}
+		vec.Col = vs
+	case []uint64:
+		vs := make([]uint64, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]uint64)[p.Row]
+		}
+		vec.Col = vs
+	case []float32:
+		vs := make([]float32, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]float32)[p.Row]
+		}
+		vec.Col = vs
+	case []float64:
+		vs := make([]float64, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]float64)[p.Row]
+		}
+		vec.Col = vs
+	case []types.Date:
+		vs := make([]types.Date, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]types.Date)[p.Row]
+		}
+		vec.Col = vs
+	case []types.Datetime:
+		vs := make([]types.Datetime, len(pos))
+		for i, p := range pos {
+			vs[i] = column[p.Block].Col.([]types.Datetime)[p.Row]
+		}
+		vec.Col = vs
+	case *types.Bytes:
+		vs := &types.Bytes{
+			Offsets: make([]uint32, len(pos)),
+			Lengths: make([]uint32, len(pos)),
+		}
+		for i, p := range pos {
+			data := column[p.Block].Col.(*types.Bytes).Get(int64(p.Row))
+			vs.Offsets[i] = uint32(len(vs.Data))
+			vs.Lengths[i] = uint32(len(data))
+			vs.Data = append(vs.Data, data...)
+		}
+		vec.Col = vs
+	}
+	return vec
+}
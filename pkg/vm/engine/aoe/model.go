@@ -32,6 +32,22 @@ const (
 
 const (
 	SharedShardUnique = "###shared"
+
+	// EncryptionProperty is set to "Y" in the properties of the tables of
+	// the encrypted databases
+	EncryptionProperty = "encryption"
+
+	// CachePinProperty is set to "Y" to keep the table in the cache, its
+	// nodes are only evicted if no other node can be evicted
+	CachePinProperty = "cache_pin"
+	// CacheQuotaProperty is the max size of the table in the cache, in bytes
+	// or with a suffix of K, M or G
+	CacheQuotaProperty = "cache_quota"
+
+	// TTLProperty is the time to live of the rows of a table, its value is
+	// "<column> + INTERVAL n DAY" where the column is a date or datetime
+	// column, rows whose column is older than n days are expired
+	TTLProperty = "ttl"
 )
 
 type CatalogInfo struct {
@@ -48,6 +64,7 @@ type SchemaInfo struct {
 	State     SchemaState  `json:"state"`
 	Type      int          `json:"type"` // Engine type of schema: RSE、AOE、Spill
 	Epoch     uint64       `json:"epoch"`
+	Encrypted bool         `json:"encrypted,omitempty"` // Tables are encrypted at rest
 }
 
 // TableInfo stores the information of a table or view.
@@ -63,6 +80,36 @@ type TableInfo struct {
 	Partition  []byte       `json:"partition"`
 	Properties []Property
 	Epoch      uint64 `json:"epoch"`
+	// Versions are the physical tables which store the rows of the table, the
+	// last one is written and a new one is created whenever a column is added
+	// or dropped, so the old rows are never rewritten. A table which has never
+	// been altered has no versions and is stored by the table Id itself.
+	Versions []TableVersion `json:"versions"`
+	// Partitions are the physical tables which store the rows of the
+	// partitions of a table partitioned by Partition, in the order of its
+	// definition. A partitioned table is stored by them only.
+	Partitions []TablePartition `json:"partitions"`
+	// View is the query of a view, a view has no tablets. It is empty if
+	// the table is a base table.
+	View string `json:"view"`
+	// Definer is the user who created the view, the objects read by the
+	// view are checked against the privileges of the definer.
+	Definer string `json:"definer"`
+}
+
+// TableVersion is a physical table storing the rows written between two
+// schema changes of a table.
+type TableVersion struct {
+	Id      uint64   `json:"id"`      // id of the physical table
+	Columns []uint64 `json:"columns"` // ids of the columns stored by the physical table, in order
+	Names   []string `json:"names"`   // names of the columns in the physical table
+}
+
+// TablePartition is a physical table storing the rows of a partition of a
+// table, it is replaced by a new one when the partition is truncated.
+type TablePartition struct {
+	Name string `json:"name"` // name of the partition
+	Id   uint64 `json:"id"`   // id of the physical table
 }
 
 type Property struct {
@@ -74,6 +121,10 @@ type TabletInfo struct {
 	Name    string
 	ShardId uint64
 	Table   TableInfo
+	Version int // index of the version of the table stored by the tablet
+	// Partition is the index of the partition of the table stored by the
+	// tablet, it is 0 if the table is not partitioned.
+	Partition int
 }
 
 // ColumnInfo stores the information of a column.
@@ -88,6 +139,7 @@ type ColumnInfo struct {
 	Epoch       uint64             `json:"epoch"`
 	PrimaryKey  bool               `json:"primary_key"` // PrimaryKey is the name of the column of the primary key
 	NullAbility bool               `json:"nullability"`
+	Fill        engine.DefaultExpr `json:"fill"` // value of the column in the versions created before the column was added
 }
 
 type IndexInfo struct {
@@ -0,0 +1,114 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoe
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	ErrInvalidProperty = errors.New("invalid table property")
+)
+
+// CacheProperties returns the cache policy set by the properties of a table
+func CacheProperties(properties []Property) (pinned bool, quota uint64, err error) {
+	for _, property := range properties {
+		switch strings.ToLower(property.Key) {
+		case CachePinProperty:
+			switch strings.ToUpper(property.Value) {
+			case "Y":
+				pinned = true
+			case "N":
+				pinned = false
+			default:
+				return false, 0, fmt.Errorf("%w: %s='%s'", ErrInvalidProperty, property.Key, property.Value)
+			}
+		case CacheQuotaProperty:
+			if quota, err = parseSize(property.Value); err != nil {
+				return false, 0, fmt.Errorf("%w: %s='%s'", ErrInvalidProperty, property.Key, property.Value)
+			}
+		}
+	}
+	return pinned, quota, nil
+}
+
+// TTLProperties returns the column and the number of days of the time to live
+// set by the properties of a table, days is 0 if the table has no ttl
+func TTLProperties(properties []Property) (column string, days uint64, err error) {
+	for _, property := range properties {
+		if strings.ToLower(property.Key) != TTLProperty {
+			continue
+		}
+		fields := strings.Fields(strings.ReplaceAll(property.Value, "+", " + "))
+		if len(fields) != 5 || fields[1] != "+" ||
+			!strings.EqualFold(fields[2], "interval") || !strings.EqualFold(fields[4], "day") {
+			return "", 0, fmt.Errorf("%w: %s='%s'", ErrInvalidProperty, property.Key, property.Value)
+		}
+		if days, err = strconv.ParseUint(fields[3], 10, 64); err != nil || days == 0 {
+			return "", 0, fmt.Errorf("%w: %s='%s'", ErrInvalidProperty, property.Key, property.Value)
+		}
+		column = fields[0]
+	}
+	return column, days, nil
+}
+
+// CheckTTL checks the time to live of a table is set on a date or datetime
+// column of the table
+func CheckTTL(tbl *TableInfo) error {
+	column, days, err := TTLProperties(tbl.Properties)
+	if err != nil || days == 0 {
+		return err
+	}
+	for _, col := range tbl.Columns {
+		if col.Name != column {
+			continue
+		}
+		switch col.Type.Oid {
+		case types.T_date, types.T_datetime:
+			return nil
+		}
+		return fmt.Errorf("%w: ttl column '%s' is not a date or datetime column", ErrInvalidProperty, column)
+	}
+	return fmt.Errorf("%w: ttl column '%s' does not exist", ErrInvalidProperty, column)
+}
+
+func parseSize(s string) (uint64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	unit := uint64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		unit = 1 << 10
+	case strings.HasSuffix(s, "M"):
+		unit = 1 << 20
+	case strings.HasSuffix(s, "G"):
+		unit = 1 << 30
+	}
+	if unit != 1 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if n > ^uint64(0)/unit {
+		return 0, strconv.ErrRange
+	}
+	return n * unit, nil
+}
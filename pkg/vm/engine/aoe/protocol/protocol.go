@@ -26,6 +26,8 @@ func EncodeRangePartition(def engine.RangePartition, buf *bytes.Buffer) error {
 				return err
 			}
 		}
+	} else {
+		buf.Write(encoding.EncodeUint32(0))
 	}
 	if n := len(def.To); n > 0 {
 		buf.Write(encoding.EncodeUint32(uint32(n)))
@@ -34,6 +36,8 @@ func EncodeRangePartition(def engine.RangePartition, buf *bytes.Buffer) error {
 				return err
 			}
 		}
+	} else {
+		buf.Write(encoding.EncodeUint32(0))
 	}
 	if def.Subpartition != nil {
 		return EncodePartition(def.Subpartition, buf)
@@ -79,7 +83,7 @@ func DecodeRangePartition(data []byte) (engine.RangePartition, []byte, error) {
 	} else {
 		data = data[4:]
 	}
-	if typ == ListWithSub {
+	if typ == RangeWithSub {
 		sub, remaing, err := DecodePartition(data)
 		if err != nil {
 			return def, nil, err
@@ -197,6 +201,8 @@ func EncodeListPartition(def engine.ListPartition, buf *bytes.Buffer) error {
 				return err
 			}
 		}
+	} else {
+		buf.Write(encoding.EncodeUint32(0))
 	}
 	if def.Subpartition != nil {
 		return EncodePartition(def.Subpartition, buf)
@@ -80,6 +80,14 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
 		schema.ColDefs = append(schema.ColDefs, newInfo)
 	}
+	for _, property := range info.Properties {
+		if property.Key == aoe.EncryptionProperty && property.Value == "Y" {
+			schema.Encrypted = true
+		}
+	}
+	// The properties are checked when the table is created
+	schema.CachePinned, schema.CacheQuota, _ = aoe.CacheProperties(info.Properties)
+	schema.TTLColumn, schema.TTLDays, _ = aoe.TTLProperties(info.Properties)
 	indice := metadata.NewIndexSchema()
 	cols := make([]int, 0)
 	var err error
@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	bm "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/manager"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestDBWithEvictPolicy(t *testing.T, policy string) (*DB, error) {
+	opts := new(storage.Options)
+	opts.WalRole = wal.BrokerRole
+	path := filepath.Join(getTestPath(t), defaultDBPath)
+	config.NewCustomizedMetaOptions(path, config.CST_Customize, defaultTestBlockRows, defaultTestSegmentBlocks, opts)
+	opts.CacheCfg.EvictPolicy = policy
+	return Open(path, opts)
+}
+
+func TestTableCache(t *testing.T) {
+	initTestEnv(t)
+	_, err := openTestDBWithEvictPolicy(t, "clock")
+	assert.ErrorIs(t, err, bm.ErrUnknownPolicy)
+
+	inst, err := openTestDBWithEvictPolicy(t, bm.LRUKPolicy)
+	assert.Nil(t, err)
+	defer inst.Close()
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+
+	pinned := metadata.MockSchema(2)
+	pinned.Name = "pinned"
+	pinned.CachePinned = true
+	pinned.CacheQuota = 1 << 20
+	plain := metadata.MockSchema(2)
+	plain.Name = "plain"
+	tables := make([]*metadata.Table, 0)
+	for _, schema := range []*metadata.Schema{pinned, plain} {
+		tblMeta, err := inst.CreateTable(&CreateTableCtx{
+			DBMutationCtx: *CreateDBMutationCtx(database, gen),
+			Schema:        schema,
+		})
+		assert.Nil(t, err)
+		tables = append(tables, tblMeta)
+		rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+		ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+		for i := 0; i < 2; i++ {
+			err = inst.Append(CreateAppendCtx(database, gen, schema.Name, ck))
+			assert.Nil(t, err)
+		}
+	}
+	testutils.WaitExpect(400, func() bool {
+		return countSortedSegments(tables[0]) == 1 && countSortedSegments(tables[1]) == 1
+	})
+
+	for _, mgr := range []interface{}{inst.SSTBufMgr, inst.IndexBufMgr} {
+		bufMgr := mgr.(*bm.BufferManager)
+		assert.Equal(t, &bm.TableCacheCfg{Pinned: true, Quota: 1 << 20}, bufMgr.TableCache(tables[0].Id))
+		assert.Nil(t, bufMgr.TableCache(tables[1].Id))
+	}
+
+	stats := inst.SSTBufMgr.Stats()
+	assert.Equal(t, bm.LRUKPolicy, stats.Policy)
+	sstMgr := inst.SSTBufMgr.(*bm.BufferManager)
+	sstMgr.RLock()
+	for _, tblMeta := range tables {
+		assert.NotEqual(t, 0, len(sstMgr.TableNodes[tblMeta.Id]))
+	}
+	sstMgr.RUnlock()
+}
@@ -0,0 +1,258 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestDBWithCompaction(t *testing.T, cfg *storage.CompactionCfg) (*DB, error) {
+	opts := new(storage.Options)
+	opts.WalRole = wal.BrokerRole
+	opts.CompactionCfg = cfg
+	path := filepath.Join(getTestPath(t), defaultDBPath)
+	config.NewCustomizedMetaOptions(path, config.CST_Customize, defaultTestBlockRows, defaultTestSegmentBlocks, opts)
+	return Open(path, opts)
+}
+
+// createUnsortedSegments creates a table with two closed segments left
+// unsorted, the flush of the closed segments is turned off meanwhile
+func createUnsortedSegments(t *testing.T, inst *DB) (*metadata.Database, *shard.MockIndexAllocator, *metadata.Table) {
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	inst.Scheduler.ExecCmd(sched.TurnOffFlushSegmentCmd)
+	defer inst.Scheduler.ExecCmd(sched.TurnOnFlushSegmentCmd)
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	for i := 0; i < 3; i++ {
+		err = inst.Append(CreateAppendCtx(database, gen, schema.Name, ck))
+		assert.Nil(t, err)
+	}
+	testutils.WaitExpect(400, func() bool {
+		candidates, _ := inst.Compactor.Plan(tblMeta)
+		return len(candidates) == 2
+	})
+	return database, gen, tblMeta
+}
+
+func TestCompactTable(t *testing.T) {
+	initTestEnv(t)
+	inst, err := openTestDBWithCompaction(t, nil)
+	assert.Nil(t, err)
+	defer inst.Close()
+	assert.Nil(t, inst.CompactionWorker)
+	database, gen, tblMeta := createUnsortedSegments(t, inst)
+
+	candidates, err := inst.Compactor.Plan(tblMeta)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(candidates))
+	assert.Equal(t, 0, countSortedSegments(tblMeta))
+	for _, candidate := range candidates {
+		assert.True(t, candidate.Size > 0)
+		assert.Equal(t, float64(0), candidate.DeletedRatio)
+	}
+
+	// The segment with the deleted rows is merged first
+	deleted := candidates[1].SegmentID
+	deleteCtx := &DeleteCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, tblMeta.Schema.Name),
+		Segment:          deleted,
+		Rows:             roaring64.BitmapOf(1, 3, 5, 7),
+	}
+	assert.Nil(t, inst.Delete(deleteCtx))
+	candidates, err = inst.Compactor.Plan(tblMeta)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(candidates))
+	assert.Equal(t, deleted, candidates[0].SegmentID)
+	assert.True(t, candidates[0].DeletedRatio > 0)
+	assert.True(t, candidates[0].Score > candidates[1].Score)
+
+	rel, err := inst.Relation(database.Name, tblMeta.Schema.Name)
+	assert.Nil(t, err)
+	defer rel.Close()
+	jobs, err := rel.Compact()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(jobs))
+	for _, job := range jobs {
+		assert.Nil(t, job.Err)
+		assert.True(t, job.Manual)
+		assert.True(t, job.Written > 0)
+	}
+	assert.Equal(t, deleted, jobs[0].SegmentID)
+	testutils.WaitExpect(400, func() bool {
+		return countSortedSegments(tblMeta) == 2
+	})
+	assert.Equal(t, 2, countSortedSegments(tblMeta))
+
+	progress := inst.CompactionProgress()
+	assert.Empty(t, progress.Running)
+	assert.Equal(t, 2, len(progress.History))
+
+	jobs, err = inst.CompactTable(database.Name, tblMeta.Schema.Name)
+	assert.Nil(t, err)
+	assert.Empty(t, jobs)
+	_, err = inst.CompactTable(database.Name, "unknown")
+	assert.NotNil(t, err)
+}
+
+func TestCompactionWorker(t *testing.T) {
+	initTestEnv(t)
+	inst, err := openTestDBWithCompaction(t, &storage.CompactionCfg{
+		Interval:  1,
+		MaxMerges: 1,
+	})
+	assert.Nil(t, err)
+	defer inst.Close()
+	assert.NotNil(t, inst.CompactionWorker)
+	_, _, tblMeta := createUnsortedSegments(t, inst)
+
+	// One segment is merged in every round, the upgrade of the merged
+	// segment may be done before the merge is recorded
+	testutils.WaitExpect(4000, func() bool {
+		return countSortedSegments(tblMeta) == 2 && len(inst.CompactionProgress().History) == 2
+	})
+	assert.Equal(t, 2, countSortedSegments(tblMeta))
+	history := inst.CompactionProgress().History
+	assert.Equal(t, 2, len(history))
+	for _, job := range history {
+		assert.False(t, job.Manual)
+		assert.Nil(t, job.Err)
+	}
+}
+
+func TestCompactSortedSegments(t *testing.T) {
+	initTestEnv(t)
+	// the second column of the merge waits for the bytes of the first one
+	cfg := &storage.CompactionCfg{RateLimit: 200000}
+	inst, err := openTestDBWithCompaction(t, cfg)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	}
+	testutils.WaitExpect(4000, func() bool {
+		return countSortedSegments(tblMeta) == 3
+	})
+	assert.Equal(t, 3, countSortedSegments(tblMeta))
+
+	// The last two rows of the first three segments are left, they are
+	// merged into one segment
+	ids := tblMeta.SimpleGetSegmentIds()
+	deleted := roaring64.New()
+	deleted.AddRange(0, rows-2)
+	for _, id := range ids[:3] {
+		deleteCtx := &DeleteCtx{
+			TableMutationCtx: *CreateTableMutationCtx(database, gen, schema.Name),
+			Segment:          id,
+			Rows:             deleted,
+		}
+		assert.Nil(t, inst.Delete(deleteCtx))
+	}
+	candidates, err := inst.Compactor.Plan(tblMeta)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(candidates))
+	assert.Equal(t, ids[:3], candidates[0].Merged)
+	assert.True(t, candidates[0].DeletedRatio > 0.9)
+
+	jobs, err := inst.CompactTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(jobs))
+	assert.Nil(t, jobs[0].Err)
+	assert.True(t, jobs[0].Written > 0)
+	assert.True(t, jobs[0].Finished.Sub(jobs[0].Started) >= 200*time.Millisecond)
+	merged := tblMeta.SimpleGetSegmentIds()
+	assert.Equal(t, 2, len(merged))
+	assert.Equal(t, ids[3], merged[1])
+	assert.Equal(t, 1, countSortedSegments(tblMeta))
+	assert.Equal(t, 2*rows, tblMeta.GetRowCount())
+
+	// the rows left are sorted to the front of the merged segment
+	check := func() {
+		tblData, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+		assert.Nil(t, err)
+		segment := tblData.WeakRefSegment(merged[0])
+		assert.Equal(t, rows, segment.GetMeta().GetRowCount())
+		blk := segment.WeakRefBlock(segment.BlockIds()[0])
+		vec, err := blk.GetVectorCopy("mock_0", bytes.NewBuffer(nil), bytes.NewBuffer(nil))
+		assert.Nil(t, err)
+		last := int32(rows - 1)
+		assert.Equal(t, []int32{last - 1, last - 1, last - 1, last, last, last, last}, vec.Col.([]int32)[:7])
+	}
+	check()
+	candidates, err = inst.Compactor.Plan(tblMeta)
+	assert.Nil(t, err)
+	assert.Empty(t, candidates)
+	inst.Close()
+
+	inst, err = openTestDBWithCompaction(t, cfg)
+	assert.Nil(t, err)
+	defer inst.Close()
+	tblMeta, err = inst.Store.Catalog.SimpleGetTableByName(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, merged, tblMeta.SimpleGetSegmentIds())
+	assert.Equal(t, 1, countSortedSegments(tblMeta))
+	check()
+}
+
+func TestIOLimiter(t *testing.T) {
+	limiter := db.NewIOLimiter(1000)
+	stop := make(chan struct{})
+	now := time.Now()
+	assert.True(t, limiter.Wait(100, stop))
+	assert.True(t, time.Since(now) < 50*time.Millisecond)
+	assert.True(t, limiter.Wait(100, stop))
+	assert.True(t, time.Since(now) >= 100*time.Millisecond)
+
+	close(stop)
+	assert.False(t, limiter.Wait(1000, stop))
+
+	unlimited := db.NewIOLimiter(0)
+	assert.True(t, unlimited.Wait(1<<30, stop))
+}
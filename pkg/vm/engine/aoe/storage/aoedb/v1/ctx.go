@@ -15,10 +15,15 @@
 package aoedb
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
 )
 
 type DBMutationCtx struct {
@@ -55,6 +60,16 @@ type ApplySnapshotCtx struct {
 	Path string
 }
 
+type RecoverCtx struct {
+	DB   string
+	Path string
+	// The recovery stops before the first entry whose log index is
+	// greater than TargetIndex or logged after TargetTime. The zero
+	// values mean no limit.
+	TargetIndex uint64
+	TargetTime  time.Time
+}
+
 type TableMutationCtx struct {
 	DBMutationCtx
 	Table string
@@ -85,6 +100,30 @@ type AppendCtx struct {
 	Data *batch.Batch
 }
 
+type DeleteCtx struct {
+	TableMutationCtx
+	Segment uint64
+	// Rows are the segment-level offsets of the deleted rows, the same
+	// offsets as the ones returned by the segment filter
+	Rows *roaring64.Bitmap
+}
+
+type DeleteWhereCtx struct {
+	TableMutationCtx
+	// Predicate selects the deleted rows, it is evaluated exactly
+	Predicate *engine.Predicate
+}
+
+func (ctx *RecoverCtx) ReachTarget(entry *db.RedoEntry) bool {
+	if ctx.TargetIndex != 0 && entry.Index.Id.Id > ctx.TargetIndex {
+		return true
+	}
+	if !ctx.TargetTime.IsZero() && entry.Ts > ctx.TargetTime.UnixNano() {
+		return true
+	}
+	return false
+}
+
 func (ctx *DBMutationCtx) ToLogIndex(database *metadata.Database) *db.LogIndex {
 	return &db.LogIndex{
 		ShardId: database.GetShardId(),
@@ -15,7 +15,15 @@
 package aoedb
 
 import (
+	"math"
 	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/util"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
@@ -23,10 +31,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+
+	"github.com/RoaringBitmap/roaring"
 )
 
 type Impl = db.DB
 
+// deleteRetryInterval is the wait before matching again the rows of the
+// blocks being sorted
+const deleteRetryInterval = 10 * time.Millisecond
+
 type DB struct {
 	Impl
 }
@@ -93,6 +108,10 @@ func (d *DB) DropDatabase(ctx *DropDBCtx) (*metadata.Database, error) {
 }
 
 func (d *DB) CreateTable(ctx *CreateTableCtx) (*metadata.Table, error) {
+	return d.createTable(ctx, d.Redo != nil)
+}
+
+func (d *DB) createTable(ctx *CreateTableCtx, redo bool) (*metadata.Table, error) {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
 	}
@@ -107,17 +126,31 @@ func (d *DB) CreateTable(ctx *CreateTableCtx) (*metadata.Table, error) {
 	}
 	defer d.Wal.Checkpoint(index)
 
-	if database.InReplaying(index) {
+	replaying := database.InReplaying(index)
+	if replaying {
 		if _, ok := database.ConsumeIdempotentIndex(index); !ok {
 			err = db.ErrIdempotence
 			return nil, err
 		}
 	}
 
-	return database.SimpleCreateTable(ctx.Schema, ctx.Indice, index)
+	meta, err := database.SimpleCreateTable(ctx.Schema, ctx.Indice, index)
+	if err == nil && redo && !replaying {
+		d.logRedo(&db.RedoEntry{
+			Type:  wal.ETRedoCreateTable,
+			DB:    ctx.DB,
+			Table: ctx.Schema.Name,
+			Index: *index,
+		}, ctx.Schema, ctx.Indice, nil)
+	}
+	return meta, err
 }
 
 func (d *DB) DropTable(ctx *DropTableCtx) (*metadata.Table, error) {
+	return d.dropTable(ctx, d.Redo != nil)
+}
+
+func (d *DB) dropTable(ctx *DropTableCtx, redo bool) (*metadata.Table, error) {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
 	}
@@ -131,7 +164,8 @@ func (d *DB) DropTable(ctx *DropTableCtx) (*metadata.Table, error) {
 	}
 	defer d.Wal.Checkpoint(index)
 
-	if database.InReplaying(index) {
+	replaying := database.InReplaying(index)
+	if replaying {
 		if idx, ok := database.ConsumeIdempotentIndex(index); !ok {
 			err = db.ErrIdempotence
 			return nil, err
@@ -153,10 +187,22 @@ func (d *DB) DropTable(ctx *DropTableCtx) (*metadata.Table, error) {
 		return nil, err
 	}
 	d.ScheduleGCTable(meta)
+	if redo && !replaying {
+		d.logRedo(&db.RedoEntry{
+			Type:  wal.ETRedoDropTable,
+			DB:    ctx.DB,
+			Table: ctx.Table,
+			Index: *index,
+		}, nil, nil, nil)
+	}
 	return meta, err
 }
 
 func (d *DB) CreateIndex(ctx *CreateIndexCtx) error {
+	return d.createIndex(ctx, d.Redo != nil)
+}
+
+func (d *DB) createIndex(ctx *CreateIndexCtx, redo bool) error {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
 	}
@@ -170,7 +216,8 @@ func (d *DB) CreateIndex(ctx *CreateIndexCtx) error {
 	}
 	defer d.Wal.Checkpoint(index)
 
-	if database.InReplaying(index) {
+	replaying := database.InReplaying(index)
+	if replaying {
 		if idx, ok := database.ConsumeIdempotentIndex(index); !ok {
 			err = db.ErrIdempotence
 			return err
@@ -190,6 +237,14 @@ func (d *DB) CreateIndex(ctx *CreateIndexCtx) error {
 	if err = meta.SimpleAddIndice(ctx.Indices.Indice, index); err != nil {
 		return err
 	}
+	if redo && !replaying {
+		d.logRedo(&db.RedoEntry{
+			Type:  wal.ETRedoCreateIndex,
+			DB:    ctx.DB,
+			Table: ctx.Table,
+			Index: *index,
+		}, nil, ctx.Indices, nil)
+	}
 
 	tblData, err := d.GetTableData(meta)
 	if err != nil {
@@ -229,6 +284,10 @@ func (d *DB) CreateIndex(ctx *CreateIndexCtx) error {
 }
 
 func (d *DB) DropIndex(ctx *DropIndexCtx) error {
+	return d.dropIndex(ctx, d.Redo != nil)
+}
+
+func (d *DB) dropIndex(ctx *DropIndexCtx, redo bool) error {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
 	}
@@ -242,7 +301,8 @@ func (d *DB) DropIndex(ctx *DropIndexCtx) error {
 	}
 	defer d.Wal.Checkpoint(index)
 
-	if database.InReplaying(index) {
+	replaying := database.InReplaying(index)
+	if replaying {
 		if idx, ok := database.ConsumeIdempotentIndex(index); !ok {
 			err = db.ErrIdempotence
 			return err
@@ -301,11 +361,22 @@ func (d *DB) DropIndex(ctx *DropIndexCtx) error {
 	if err = meta.SimpleDropIndice(names, index); err != nil {
 		return err
 	}
-
+	if redo && !replaying {
+		d.logRedo(&db.RedoEntry{
+			Type:  wal.ETRedoDropIndex,
+			DB:    ctx.DB,
+			Table: ctx.Table,
+			Index: *index,
+		}, nil, nil, names)
+	}
 	return nil
 }
 
 func (d *DB) Append(ctx *AppendCtx) (err error) {
+	return d.doAppend(ctx, d.Redo != nil)
+}
+
+func (d *DB) doAppend(ctx *AppendCtx, redo bool) (err error) {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
 	}
@@ -315,7 +386,8 @@ func (d *DB) Append(ctx *AppendCtx) (err error) {
 	}
 	index := ctx.ToLogIndex(database)
 	var meta *metadata.Table
-	if database.InReplaying(index) {
+	replaying := database.InReplaying(index)
+	if replaying {
 		meta, err = database.GetTableByNameAndLogIndex(ctx.Table, index)
 		if err != nil {
 			return
@@ -342,8 +414,150 @@ func (d *DB) Append(ctx *AppendCtx) (err error) {
 			d.Wal.Checkpoint(index)
 		}
 	}()
-	err = d.DoAppend(meta, ctx.Data, index.AsSlice())
-	return err
+	if err = d.DoAppend(meta, ctx.Data, index.AsSlice()); err != nil {
+		return err
+	}
+	if redo && !replaying {
+		d.logRedo(&db.RedoEntry{
+			Type:  wal.ETRedoAppend,
+			DB:    ctx.DB,
+			Table: ctx.Table,
+			Index: *index,
+			Data:  ctx.Data,
+		}, nil, nil, nil)
+	}
+	return nil
+}
+
+// logRedo logs the applied mutation for the point-in-time recovery. The
+// mutation is not rolled back if it fails to log, only the recovery misses
+// it.
+func (d *DB) logRedo(entry *db.RedoEntry, schema *db.TableSchema, indice *db.IndexSchema, names []string) {
+	entry.Schema, entry.Indice, entry.IndexNames = schema, indice, names
+	if err := d.Redo.Log(entry); err != nil {
+		logutil.Errorf("[AOE]: Log redo entry of %s.%s at %d: %v", entry.DB, entry.Table, entry.Index.Id.Id, err)
+	}
+}
+
+// Delete marks the given rows of a segment as deleted. The readers skip the
+// deleted rows right after the delete is committed, their data is purged
+// when the segment is sorted.
+func (d *DB) Delete(ctx *DeleteCtx) error {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	meta, err := d.Store.Catalog.SimpleGetTableByName(ctx.DB, ctx.Table)
+	if err != nil {
+		return err
+	}
+	segment := meta.SimpleGetSegment(ctx.Segment)
+	if segment == nil {
+		return metadata.SegmentNotFoundErr
+	}
+	rows := roaring.New()
+	it := ctx.Rows.Iterator()
+	for it.HasNext() {
+		row := it.Next()
+		if row > math.MaxUint32 {
+			return metadata.RowOutOfRangeErr
+		}
+		rows.Add(uint32(row))
+	}
+	return segment.SimpleDeleteRows(rows)
+}
+
+// DeleteWhere marks the rows of the table satisfying the predicate as
+// deleted and returns how many rows were deleted. It is a mutation of the
+// shard: when it is replayed, the deletes already committed are skipped.
+func (d *DB) DeleteWhere(ctx *DeleteWhereCtx) (uint64, error) {
+	return d.deleteWhere(ctx, d.Redo != nil)
+}
+
+func (d *DB) deleteWhere(ctx *DeleteWhereCtx, redo bool) (uint64, error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return 0, err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return 0, err
+	}
+	defer d.Wal.Checkpoint(index)
+
+	// The deletes are committed to the catalog before the next mutation is
+	// applied, the catalog already has them if it has a later mutation
+	replaying := database.InReplaying(index)
+	if replaying {
+		return 0, nil
+	}
+	meta := database.SimpleGetTableByName(ctx.Table)
+	if meta == nil {
+		return 0, metadata.TableNotFoundErr
+	}
+	data, err := d.GetTableData(meta)
+	if err != nil {
+		return 0, err
+	}
+	defer data.Unref()
+	count := uint64(0)
+	for _, id := range data.SegmentIds() {
+		segment := data.StrongRefSegment(id)
+		if segment == nil {
+			continue
+		}
+		deleted, err := deleteMatchedRows(&db.Segment{Data: segment, Ids: new(atomic.Value)}, ctx.Predicate)
+		segment.Unref()
+		count += deleted
+		if err != nil {
+			return count, err
+		}
+	}
+	if redo {
+		predicate, err := encoding.Encode(ctx.Predicate)
+		if err != nil {
+			return count, err
+		}
+		entry := &db.RedoEntry{
+			Type:  wal.ETRedoDelete,
+			DB:    ctx.DB,
+			Table: ctx.Table,
+			Index: *index,
+		}
+		entry.Predicate = predicate
+		d.logRedo(entry, nil, nil, nil)
+	}
+	return count, nil
+}
+
+// deleteMatchedRows deletes the rows of the segment matching the predicate,
+// the rows of a block sorted in the meantime are matched again.
+func deleteMatchedRows(segment *db.Segment, p *engine.Predicate) (uint64, error) {
+	count := uint64(0)
+	for {
+		matched, err := segment.MatchRows(p)
+		if err != nil {
+			return count, err
+		}
+		stale := false
+		for _, m := range matched {
+			err = m.Block.SimpleDeleteRowsAt(m.Rows, m.Version)
+			if err == metadata.BlockSortingErr {
+				stale = true
+				continue
+			}
+			if err != nil {
+				return count, err
+			}
+			count += m.Rows.GetCardinality()
+		}
+		if !stale {
+			return count, nil
+		}
+		time.Sleep(deleteRetryInterval)
+	}
 }
 
 func (d *DB) CreateSnapshot(ctx *CreateSnapshotCtx) (uint64, error) {
@@ -354,6 +568,93 @@ func (d *DB) ApplySnapshot(ctx *ApplySnapshotCtx) error {
 	return d.Impl.ApplySnapshot(ctx.DB, ctx.Path)
 }
 
+// Recover restores the database from the snapshot and replays the appends
+// and DDL logged after the snapshot up to the target. It returns the last
+// replayed log index.
+func (d *DB) Recover(ctx *RecoverCtx) (uint64, error) {
+	if d.Redo == nil {
+		return 0, db.ErrRedoDisabled
+	}
+	if err := d.Redo.Sync(); err != nil {
+		return 0, err
+	}
+	if err := d.ApplySnapshot(&ApplySnapshotCtx{DB: ctx.DB, Path: ctx.Path}); err != nil {
+		return 0, err
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return 0, err
+	}
+	snapshotId := database.GetCheckpointId()
+	last := snapshotId
+	logutil.Infof("[AOE]: Recover %s from snapshot %d", database.Repr(), snapshotId)
+	err = db.ReplayRedoLog(d.Dir, d.Opts.ArchiveCfg, d.StoreCipher, func(entry *db.RedoEntry) error {
+		if entry.DB != ctx.DB || entry.Index.Id.Id <= snapshotId {
+			return nil
+		}
+		if ctx.ReachTarget(entry) {
+			return db.ErrStopReplay
+		}
+		if err := d.redo(entry); err != nil {
+			return err
+		}
+		last = entry.Index.Id.Id
+		return nil
+	})
+	logutil.Infof("[AOE]: Recover %s to %d", database.Repr(), last)
+	return last, err
+}
+
+// redo applies the redo entry without logging it again
+func (d *DB) redo(entry *db.RedoEntry) error {
+	mutation := DBMutationCtx{
+		Id:     entry.Index.Id.Id,
+		Offset: int(entry.Index.Id.Offset),
+		Size:   int(entry.Index.Id.Size),
+		DB:     entry.DB,
+	}
+	var err error
+	switch entry.Type {
+	case wal.ETRedoAppend:
+		err = d.doAppend(&AppendCtx{
+			TableMutationCtx: TableMutationCtx{DBMutationCtx: mutation, Table: entry.Table},
+			Data:             entry.Data,
+		}, false)
+	case wal.ETRedoCreateTable:
+		_, err = d.createTable(&CreateTableCtx{
+			DBMutationCtx: mutation,
+			Schema:        entry.Schema,
+			Indice:        entry.Indice,
+		}, false)
+	case wal.ETRedoDropTable:
+		_, err = d.dropTable(&DropTableCtx{DBMutationCtx: mutation, Table: entry.Table}, false)
+	case wal.ETRedoCreateIndex:
+		err = d.createIndex(&CreateIndexCtx{
+			DBMutationCtx: mutation,
+			Table:         entry.Table,
+			Indices:       entry.Indice,
+		}, false)
+	case wal.ETRedoDropIndex:
+		err = d.dropIndex(&DropIndexCtx{
+			DBMutationCtx: mutation,
+			Table:         entry.Table,
+			IndexNames:    entry.IndexNames,
+		}, false)
+	case wal.ETRedoDelete:
+		var p engine.Predicate
+		if err = encoding.Decode(entry.Predicate, &p); err != nil {
+			return err
+		}
+		_, err = d.deleteWhere(&DeleteWhereCtx{
+			TableMutationCtx: TableMutationCtx{DBMutationCtx: mutation, Table: entry.Table},
+			Predicate:        &p,
+		}, false)
+	default:
+		err = db.ErrUnsupported
+	}
+	return err
+}
+
 func (d *DB) PrepareSplitDatabase(ctx *PrepareSplitCtx) (uint64, uint64, [][]byte, []byte, error) {
 	return d.Impl.SpliteDatabaseCheck(ctx.DB, ctx.Size)
 }
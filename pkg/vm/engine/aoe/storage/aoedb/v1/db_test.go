@@ -38,6 +38,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
@@ -1958,6 +1960,37 @@ func TestFilter(t *testing.T) {
 	_, err = sparseFilter.Btw("xxxx", 0, 0)
 	assert.NotNil(t, err)
 
+	// the blocks of mock_0 are [0, 2], [2, 4], [5, 7] and [7, 9]
+	res, _ = sparseFilter.Eval(&engine.Predicate{Op: engine.PredIn, Attr: "mock_0", Vals: []interface{}{int8(0), int8(5)}})
+	assert.Equal(t, decodeBlockIds(res), []string{"1", "3"})
+	res, _ = sparseFilter.Eval(&engine.Predicate{Op: engine.PredIn, Attr: "mock_0", Vals: []interface{}{int8(3), int8(-1), int8(10)}})
+	assert.Equal(t, decodeBlockIds(res), []string{"2"})
+	res, _ = sparseFilter.Eval(&engine.Predicate{Op: engine.PredBtw, Attr: "mock_0", Vals: []interface{}{int8(3), int8(5)}})
+	assert.Equal(t, decodeBlockIds(res), []string{"2", "3"})
+	res, _ = sparseFilter.Eval(&engine.Predicate{Op: engine.PredOr, Args: []*engine.Predicate{
+		{Op: engine.PredLt, Attr: "mock_0", Vals: []interface{}{int8(1)}},
+		{Op: engine.PredGt, Attr: "mock_0", Vals: []interface{}{int8(8)}},
+	}})
+	assert.Equal(t, decodeBlockIds(res), []string{"1", "4"})
+	res, _ = sparseFilter.Eval(&engine.Predicate{Op: engine.PredAnd, Args: []*engine.Predicate{
+		{Op: engine.PredEq, Attr: "mock_0", Vals: []interface{}{int8(3)}},
+		{Op: engine.PredIn, Attr: "mock_12", Vals: []interface{}{[]byte("str8")}},
+	}})
+	assert.Equal(t, decodeBlockIds(res), []string{})
+	res, _ = sparseFilter.Eval(&engine.Predicate{Op: engine.PredPrefix, Attr: "mock_12", Vals: []interface{}{[]byte("str8")}})
+	assert.Equal(t, decodeBlockIds(res), []string{"4"})
+	res, _ = sparseFilter.Eval(&engine.Predicate{Op: engine.PredPrefix, Attr: "mock_12", Vals: []interface{}{[]byte("st")}})
+	assert.Equal(t, decodeBlockIds(res), []string{"1", "2", "3", "4"})
+	_, err = sparseFilter.Eval(&engine.Predicate{Op: engine.PredIn, Attr: "xxxx", Vals: []interface{}{0}})
+	assert.NotNil(t, err)
+	// the argument of and which cannot be evaluated is true for every block
+	res, err = sparseFilter.Eval(&engine.Predicate{Op: engine.PredAnd, Args: []*engine.Predicate{
+		{Op: engine.PredIn, Attr: "xxxx", Vals: []interface{}{0}},
+		{Op: engine.PredLt, Attr: "mock_0", Vals: []interface{}{int8(1)}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, decodeBlockIds(res), []string{"1"})
+
 	// test filter
 	mockBM := roaring.NewBitmap()
 	mockBM.AddRange(0, 40)
@@ -3135,3 +3168,204 @@ func matchStringArray(a, b []string) bool {
 	}
 	return true
 }
+
+func TestDelete(t *testing.T) {
+	waitTime := time.Duration(100) * time.Millisecond
+	if invariants.RaceEnabled {
+		waitTime *= 2
+	}
+	initTestEnv(t)
+	inst, gen, database := initTestDB3(t)
+	inst.Store.Catalog.Cfg.BlockMaxRows = uint64(10)
+	inst.Store.Catalog.Cfg.SegmentMaxBlocks = uint64(2)
+	schema := metadata.MockSchema(2)
+	indice := metadata.NewIndexSchema()
+	_, err := indice.MakeIndex("idx-1", metadata.NumBsi, 0)
+	assert.Nil(t, err)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+		Indice:        indice,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows / 2
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+
+	segId := inst.GetSegmentIds(database.Name, schema.Name).Ids[0]
+	deleteCtx := &DeleteCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, schema.Name),
+		Segment:          segId,
+		Rows:             roaring.BitmapOf(1, 3),
+	}
+	assert.Nil(t, inst.Delete(deleteCtx))
+	deleteCtx.Rows = roaring.BitmapOf(40)
+	assert.Equal(t, metadata.RowOutOfRangeErr, inst.Delete(deleteCtx))
+
+	check := func(masked []uint64, expected []int32) {
+		tblData, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+		assert.Nil(t, err)
+		segment := &db.Segment{
+			Data: tblData.WeakRefSegment(segId),
+			Ids:  new(atomic.Value),
+		}
+		res, err := segment.NewFilter().Ne("mock_0", int32(-1))
+		assert.Nil(t, err)
+		for _, row := range masked {
+			assert.False(t, res.Contains(row))
+		}
+		assert.True(t, res.Contains(2))
+		cnt, err := segment.NewSummarizer().Count("mock_0", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(segment.Rows())-uint64(len(masked)), cnt)
+
+		blk := segment.Block(segment.Blocks()[0])
+		bat, err := blk.Read([]uint64{1}, []string{"mock_0"}, []*bytes.Buffer{bytes.NewBuffer(nil)}, []*bytes.Buffer{bytes.NewBuffer(nil)})
+		assert.Nil(t, err)
+		assert.Equal(t, expected, bat.Vecs[0].Col.([]int32))
+	}
+	check([]uint64{1, 3}, []int32{0, 2, 4})
+
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	}
+	tblData, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+	assert.Nil(t, err)
+	// wait until the segment is sorted and its indices are loaded
+	testutils.WaitExpect(int(waitTime/time.Millisecond)*4, func() bool {
+		segment := &db.Segment{
+			Data: tblData.WeakRefSegment(segId),
+			Ids:  new(atomic.Value),
+		}
+		_, err := segment.NewFilter().Ne("mock_0", int32(-1))
+		return segment.Data.GetType() == base.SORTED_SEG && err == nil
+	})
+
+	// the deleted rows are purged by the sort, the rows left are sorted to
+	// the front and the tail of the segment repeats the last of them
+	seg := tblData.WeakRefSegment(segId)
+	assert.Equal(t, base.SORTED_SEG, seg.GetType())
+	assert.Equal(t, []uint32{18, 19}, seg.GetMeta().GetDeletes().ToArray())
+	check([]uint64{18, 19}, []int32{0, 0, 0, 0, 1, 1, 1, 2, 2, 2})
+	blk := seg.WeakRefBlock(seg.BlockIds()[1])
+	vec, err := blk.GetVectorCopy("mock_0", bytes.NewBuffer(nil), bytes.NewBuffer(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{2, 3, 3, 3, 4, 4, 4, 4, 4, 4}, vec.Col.([]int32))
+
+	deleteCtx.Rows = roaring.BitmapOf(0)
+	assert.Nil(t, inst.Delete(deleteCtx))
+	check([]uint64{0, 18, 19}, []int32{0, 0, 0, 1, 1, 1, 2, 2, 2})
+
+	inst.Close()
+}
+
+func TestDeleteWhere(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB3(t)
+	inst.Store.Catalog.Cfg.BlockMaxRows = uint64(10)
+	inst.Store.Catalog.Cfg.SegmentMaxBlocks = uint64(2)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	ck := mock.MockBatch(tblMeta.Schema.Types(), 5)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+
+	deleteWhere := func(p *engine.Predicate) (uint64, error) {
+		return inst.DeleteWhere(&DeleteWhereCtx{
+			TableMutationCtx: *CreateTableMutationCtx(database, gen, schema.Name),
+			Predicate:        p,
+		})
+	}
+	count := func() uint64 {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		total := uint64(0)
+		for _, id := range rel.SegmentIds().Ids {
+			cnt, err := rel.Segment(id).(*db.Segment).NewSummarizer().Count("mock_0", nil)
+			assert.Nil(t, err)
+			total += cnt
+		}
+		return total
+	}
+
+	deleted, err := deleteWhere(&engine.Predicate{Op: engine.PredGe, Attr: "mock_0", Vals: []interface{}{int32(3)}})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), deleted)
+	assert.Equal(t, uint64(6), count())
+
+	// the rows already deleted are not counted again
+	deleted, err = deleteWhere(&engine.Predicate{
+		Op: engine.PredOr,
+		Args: []*engine.Predicate{
+			{Op: engine.PredEq, Attr: "mock_0", Vals: []interface{}{int32(3)}},
+			{Op: engine.PredEq, Attr: "mock_0", Vals: []interface{}{int32(1)}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), deleted)
+	assert.Equal(t, uint64(4), count())
+
+	_, err = deleteWhere(&engine.Predicate{Op: engine.PredEq, Attr: "xxxx", Vals: []interface{}{int32(3)}})
+	assert.NotNil(t, err)
+
+	// an And without arguments deletes all rows
+	deleted, err = deleteWhere(&engine.Predicate{Op: engine.PredAnd})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), deleted)
+	assert.Equal(t, uint64(0), count())
+
+	inst.Close()
+}
+
+func TestPinBlock(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB3(t)
+	inst.Store.Catalog.Cfg.BlockMaxRows = uint64(10)
+	inst.Store.Catalog.Cfg.SegmentMaxBlocks = uint64(2)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(tblMeta.Schema.Types(), 5))))
+
+	segId := inst.GetSegmentIds(database.Name, schema.Name).Ids[0]
+	tblData, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+	assert.Nil(t, err)
+	segment := &db.Segment{
+		Data: tblData.WeakRefSegment(segId),
+		Ids:  new(atomic.Value),
+	}
+	blk := segment.Block(segment.Blocks()[0])
+	pinned := blk.(*db.Block).Pin()
+	assert.Equal(t, int64(5), pinned.Rows())
+
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(tblMeta.Schema.Types(), 3))))
+	deleteCtx := &DeleteCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, schema.Name),
+		Segment:          segId,
+		Rows:             roaring.BitmapOf(1),
+	}
+	assert.Nil(t, inst.Delete(deleteCtx))
+
+	read := func(blk aoe.Block) []int32 {
+		bat, err := blk.Read([]uint64{1}, []string{"mock_0"}, []*bytes.Buffer{bytes.NewBuffer(nil)}, []*bytes.Buffer{bytes.NewBuffer(nil)})
+		assert.Nil(t, err)
+		return bat.Vecs[0].Col.([]int32)
+	}
+	assert.Equal(t, []int32{0, 2, 3, 4, 0, 1, 2}, read(blk))
+	assert.Equal(t, []int32{0, 1, 2, 3, 4}, read(pinned))
+	assert.Equal(t, int64(8), blk.Rows())
+	assert.Equal(t, int64(5), pinned.Rows())
+
+	inst.Close()
+}
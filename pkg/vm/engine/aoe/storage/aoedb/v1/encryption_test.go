@@ -0,0 +1,215 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestDBWithEncryption(t *testing.T, cfg *storage.EncryptionCfg) (*DB, error) {
+	opts := new(storage.Options)
+	opts.WalRole = wal.BrokerRole
+	opts.EncryptionCfg = cfg
+	path := filepath.Join(getTestPath(t), defaultDBPath)
+	config.NewCustomizedMetaOptions(path, config.CST_Customize, defaultTestBlockRows, defaultTestSegmentBlocks, opts)
+	return Open(path, opts)
+}
+
+func countFullBlocks(tblMeta *metadata.Table) int {
+	cnt := 0
+	for _, segId := range tblMeta.SimpleGetSegmentIds() {
+		segMeta := tblMeta.SimpleGetSegment(segId)
+		segMeta.RLock()
+		for _, blk := range segMeta.BlockSet {
+			if blk.IsFullLocked() {
+				cnt++
+			}
+		}
+		segMeta.RUnlock()
+	}
+	return cnt
+}
+
+func TestEncryptedTable(t *testing.T) {
+	initTestEnv(t)
+	cfg := &storage.EncryptionCfg{
+		KeyFile: filepath.Join(getTestPath(t), "master.key"),
+	}
+	inst, err := openTestDBWithEncryption(t, cfg)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+
+	schema := metadata.MockSchema(2)
+	schema.Encrypted = true
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	assert.NotNil(t, tblMeta.Schema.DataKey)
+
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	insertCnt := 3
+	for i := 0; i < insertCnt; i++ {
+		err = inst.Append(CreateAppendCtx(database, gen, schema.Name, ck))
+		assert.Nil(t, err)
+	}
+	// The last block is left in memory
+	sortedCnt := insertCnt - 1
+	fullCnt := insertCnt*int(tblMeta.Schema.SegmentMaxBlocks) - 1
+	testutils.WaitExpect(400, func() bool {
+		return countSortedSegments(tblMeta) == sortedCnt && countFullBlocks(tblMeta) == fullCnt
+	})
+	assert.Equal(t, sortedCnt, countSortedSegments(tblMeta))
+
+	dataDir := common.MakeDataDir(inst.Dir)
+	entries, err := os.ReadDir(dataDir)
+	assert.Nil(t, err)
+	files := 0
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".seg") && !strings.HasSuffix(name, ".blk") {
+			continue
+		}
+		files++
+		f, err := os.Open(filepath.Join(dataDir, name))
+		assert.Nil(t, err)
+		stat, err := f.Stat()
+		assert.Nil(t, err)
+		encrypted, err := encryption.IsEncrypted(f, stat.Size())
+		assert.Nil(t, err)
+		assert.True(t, encrypted, name)
+		f.Close()
+	}
+	assert.NotEqual(t, 0, files)
+
+	corruptions, err := inst.ScrubTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corruptions))
+
+	tbl, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, rows*uint64(insertCnt), tbl.GetRowCount())
+	inst.Close()
+
+	// The catalog is sealed by the store key and can not be replayed
+	// without the key file
+	_, err = openTestDBWithEncryption(t, nil)
+	assert.NotNil(t, err)
+
+	inst, err = openTestDBWithEncryption(t, cfg)
+	assert.Nil(t, err)
+	defer inst.Close()
+	replayMeta, err := inst.Store.Catalog.SimpleGetTableByName(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.True(t, replayMeta.Schema.Encrypted)
+	assert.Equal(t, sortedCnt, countSortedSegments(replayMeta))
+	tbl, err = inst.Store.DataTables.WeakRefTable(replayMeta.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, rows*uint64(insertCnt)-tblMeta.Schema.BlockMaxRows, tbl.GetRowCount())
+	corruptions, err = inst.ScrubTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corruptions))
+}
+
+func TestRotateKey(t *testing.T) {
+	initTestEnv(t)
+	cfg := &storage.EncryptionCfg{
+		KeyFile: filepath.Join(getTestPath(t), "master.key"),
+	}
+	inst, err := openTestDBWithEncryption(t, cfg)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+
+	schema := metadata.MockSchema(2)
+	schema.Encrypted = true
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	insertCnt := 2
+	for i := 0; i < insertCnt; i++ {
+		err = inst.Append(CreateAppendCtx(database, gen, schema.Name, ck))
+		assert.Nil(t, err)
+	}
+	fullCnt := insertCnt*int(tblMeta.Schema.SegmentMaxBlocks) - 1
+	testutils.WaitExpect(400, func() bool {
+		return countSortedSegments(tblMeta) == insertCnt-1 && countFullBlocks(tblMeta) == fullCnt
+	})
+	assert.Equal(t, insertCnt-1, countSortedSegments(tblMeta))
+	key, err := tblMeta.DataKey()
+	assert.Nil(t, err)
+
+	id, err := inst.RotateKey()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), id)
+	keyring := inst.Opts.Keyring
+	assert.False(t, keyring.IsCurrent(tblMeta.Schema.DataKey))
+	assert.True(t, keyring.IsCurrent(tblMeta.CommitInfo.DataKey))
+	rotated, err := tblMeta.DataKey()
+	assert.Nil(t, err)
+	assert.Equal(t, key, rotated)
+	wrapped, err := os.ReadFile(filepath.Join(common.MakeMetaDir(inst.Dir), "store.key"))
+	assert.Nil(t, err)
+	assert.True(t, keyring.IsCurrent(wrapped))
+	inst.Close()
+
+	// The old master key is not needed any more
+	buf, err := os.ReadFile(cfg.KeyFile)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	assert.Equal(t, 2, len(lines))
+	assert.Nil(t, os.WriteFile(cfg.KeyFile, []byte(lines[1]+"\n"), 0600))
+
+	inst, err = openTestDBWithEncryption(t, cfg)
+	assert.Nil(t, err)
+	defer inst.Close()
+	replayMeta, err := inst.Store.Catalog.SimpleGetTableByName(database.Name, schema.Name)
+	assert.Nil(t, err)
+	rotated, err = replayMeta.DataKey()
+	assert.Nil(t, err)
+	assert.Equal(t, key, rotated)
+	corruptions, err := inst.ScrubTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(corruptions))
+	tbl, err := inst.Store.DataTables.WeakRefTable(replayMeta.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, rows*uint64(insertCnt)-tblMeta.Schema.BlockMaxRows, tbl.GetRowCount())
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestDBWithFileService(t *testing.T, fs fileservice.FileService) *DB {
+	opts := new(storage.Options)
+	opts.WalRole = wal.BrokerRole
+	opts.FileService = fs
+	path := filepath.Join(getTestPath(t), defaultDBPath)
+	config.NewCustomizedMetaOptions(path, config.CST_Customize, defaultTestBlockRows, defaultTestSegmentBlocks, opts)
+	inst, err := Open(path, opts)
+	assert.Nil(t, err)
+	return inst
+}
+
+func countSortedSegments(tblMeta *metadata.Table) int {
+	cnt := 0
+	for _, segId := range tblMeta.SimpleGetSegmentIds() {
+		segMeta := tblMeta.SimpleGetSegment(segId)
+		segMeta.RLock()
+		if segMeta.IsSortedLocked() {
+			cnt++
+		}
+		segMeta.RUnlock()
+	}
+	return cnt
+}
+
+func TestFileService(t *testing.T) {
+	initTestEnv(t)
+	s3 := fileservice.NewMemS3("bucket")
+	server := httptest.NewServer(s3)
+	defer server.Close()
+	remote := fileservice.NewS3FS(&fileservice.S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "bucket",
+		KeyPrefix:       "shard1/",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	})
+	cacheDir := filepath.Join(getTestPath(t), fileservice.DefaultCacheDirName)
+	fs, err := fileservice.NewCachedFS(remote, cacheDir, 1)
+	assert.Nil(t, err)
+
+	inst := openTestDBWithFileService(t, fs)
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	insertCnt := 3
+	for i := 0; i < insertCnt; i++ {
+		err = inst.Append(CreateAppendCtx(database, gen, schema.Name, ck))
+		assert.Nil(t, err)
+	}
+
+	// The last segment is left unclosed until more data arrives
+	sortedCnt := insertCnt - 1
+	testutils.WaitExpect(400, func() bool {
+		return countSortedSegments(tblMeta) == sortedCnt && len(s3.Objects("bucket")) == sortedCnt
+	})
+	assert.Equal(t, sortedCnt, countSortedSegments(tblMeta))
+	objects := s3.Objects("bucket")
+	assert.Equal(t, sortedCnt, len(objects))
+	for _, name := range objects {
+		assert.True(t, strings.HasPrefix(name, "shard1/data/"))
+	}
+
+	// Sorted segments only live in the file service
+	localSegs := 0
+	entries, err := os.ReadDir(common.MakeDataDir(inst.Dir))
+	assert.Nil(t, err)
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".seg") {
+			localSegs++
+		}
+	}
+	assert.Equal(t, 0, localSegs)
+
+	tbl, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, rows*uint64(insertCnt), tbl.GetRowCount())
+	inst.Close()
+
+	inst = openTestDBWithFileService(t, fs)
+	defer inst.Close()
+	replayMeta, err := inst.Store.Catalog.SimpleGetTableByName(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, sortedCnt, countSortedSegments(replayMeta))
+	tbl, err = inst.Store.DataTables.WeakRefTable(replayMeta.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, rows*uint64(insertCnt)-tblMeta.Schema.BlockMaxRows, tbl.GetRowCount())
+
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	defer rel.Close()
+	attrs := []string{schema.ColDefs[0].Name}
+	total := 0
+	for _, segId := range rel.SegmentIds().Ids {
+		seg := rel.Segment(segId)
+		for _, id := range seg.Blocks() {
+			blk := seg.Block(id)
+			cds := []*bytes.Buffer{bytes.NewBuffer(nil)}
+			dds := []*bytes.Buffer{bytes.NewBuffer(nil)}
+			bat, err := blk.Read([]uint64{uint64(1)}, attrs, cds, dds)
+			assert.Nil(t, err)
+			v := batch.GetVector(bat, attrs[0])
+			assert.True(t, vector.Length(v) <= int(defaultTestBlockRows))
+			total += vector.Length(v)
+		}
+	}
+	assert.Equal(t, int(tbl.GetRowCount()), total)
+}
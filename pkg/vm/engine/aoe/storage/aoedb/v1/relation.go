@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	md "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 )
@@ -116,3 +117,13 @@ func (r *Relation) Segment(id uint64) aoe.Segment {
 func (r *Relation) Write(ctx *AppendCtx) error {
 	return r.DBImpl.Append(ctx)
 }
+
+// Scrub verifies every file of the relation against its checksums
+func (r *Relation) Scrub() ([]dataio.Corruption, error) {
+	return r.DBImpl.DoScrubTable(r.Meta)
+}
+
+// Compact merges the closed unsorted segments of the relation
+func (r *Relation) Compact() ([]*db.CompactionJob, error) {
+	return r.DBImpl.DoCompactTable(r.Meta)
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubTable(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+	defer inst.Close()
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	for i := 0; i < 2; i++ {
+		err = inst.Append(CreateAppendCtx(database, gen, schema.Name, ck))
+		assert.Nil(t, err)
+	}
+	testutils.WaitExpect(400, func() bool {
+		return countSortedSegments(tblMeta) == 1
+	})
+	assert.Equal(t, 1, countSortedSegments(tblMeta))
+
+	corruptions, err := inst.ScrubTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Empty(t, corruptions)
+
+	var segFile string
+	entries, err := os.ReadDir(common.MakeDataDir(inst.Dir))
+	assert.Nil(t, err)
+	for _, entry := range entries {
+		if common.IsSegmentFile(entry.Name()) {
+			segFile = filepath.Join(common.MakeDataDir(inst.Dir), entry.Name())
+		}
+	}
+	assert.NotEmpty(t, segFile)
+
+	// Flip a byte in the middle of the column data
+	f, err := os.OpenFile(segFile, os.O_RDWR, 0666)
+	assert.Nil(t, err)
+	info, err := f.Stat()
+	assert.Nil(t, err)
+	buf := make([]byte, 1)
+	_, err = f.ReadAt(buf, info.Size()/2)
+	assert.Nil(t, err)
+	buf[0] ^= 0xff
+	_, err = f.WriteAt(buf, info.Size()/2)
+	assert.Nil(t, err)
+	f.Close()
+
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	defer rel.Close()
+	corruptions, err = rel.Scrub()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(corruptions))
+	assert.True(t, strings.HasSuffix(corruptions[0].File, filepath.Base(segFile)))
+	assert.True(t, strings.HasPrefix(corruptions[0].Part, "block "))
+	assert.True(t, errors.Is(corruptions[0].Err, base.ErrChecksumMismatch))
+
+	_, err = inst.ScrubTable(database.Name, "unknown")
+	assert.NotNil(t, err)
+}
@@ -15,14 +15,21 @@
 package aoedb
 
 import (
+	"io/ioutil"
+
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils/config"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -1008,3 +1015,127 @@ func TestSnapshot10(t *testing.T) {
 	aoedb2, _, _ = initTestDBWithOptions(t, "aoedb2", "", defaultTestBlockRows, defaultTestSegmentBlocks, nil, wal.BrokerRole)
 	aoedb2.Close()
 }
+
+func TestRecover(t *testing.T) {
+	initTestEnv(t)
+	prepareSnapshotPath(defaultSnapshotPath, t)
+	opts := new(storage.Options)
+	opts.WalRole = wal.BrokerRole
+	opts.ArchiveCfg = &storage.ArchiveCfg{
+		Dir:                 filepath.Join(getTestPath(t), "archive"),
+		RotationFileMaxSize: 4096,
+	}
+	path := filepath.Join(getTestPath(t), defaultDBPath)
+	config.NewCustomizedMetaOptions(path, config.CST_Customize, defaultTestBlockRows, defaultTestSegmentBlocks, opts)
+	inst, err := Open(path, opts)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+	idxGen := gen.Shard(database.GetShardId())
+
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	_, err = inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	testutils.WaitExpect(200, func() bool {
+		return idxGen.Get() == database.GetCheckpointId()
+	})
+
+	createSSCtx := &CreateSnapshotCtx{
+		DB:   database.Name,
+		Path: getSnapshotPath(defaultSnapshotPath, t),
+		Sync: false,
+	}
+	ssId, err := inst.CreateSnapshot(createSSCtx)
+	assert.Nil(t, err)
+
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows / 10
+	ids := make([]uint64, 3)
+	for i := range ids {
+		ck := mock.MockBatch(schema.Types(), rows)
+		appendCtx := CreateAppendCtx(database, gen, schema.Name, ck)
+		assert.Nil(t, inst.Append(appendCtx))
+		ids[i] = appendCtx.Id
+	}
+	// the DDL after the snapshot is replayed as well
+	schema2 := metadata.MockSchema(3)
+	schema2.Name = "t2"
+	createCtx = &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema2,
+	}
+	_, err = inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	createId := createCtx.Id
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema2.Name, mock.MockBatch(schema2.Types(), rows))))
+	// a failed append is not logged
+	assert.NotNil(t, inst.Append(CreateAppendCtx(database, gen, "t3", mock.MockBatch(schema2.Types(), rows))))
+	target := time.Now()
+
+	tableRows := func(name string) int64 {
+		rel, err := inst.Relation(database.Name, name)
+		if err != nil {
+			return -1
+		}
+		defer rel.Close()
+		return rel.Rows()
+	}
+
+	last, err := inst.Recover(&RecoverCtx{
+		DB:          database.Name,
+		Path:        createSSCtx.Path,
+		TargetIndex: ids[1],
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ids[1], last)
+	assert.Equal(t, int64(2*rows), tableRows(schema.Name))
+	assert.Equal(t, int64(-1), tableRows(schema2.Name))
+
+	last, err = inst.Recover(&RecoverCtx{
+		DB:          database.Name,
+		Path:        createSSCtx.Path,
+		TargetIndex: createId,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, createId, last)
+	assert.Equal(t, int64(3*rows), tableRows(schema.Name))
+	assert.Equal(t, int64(0), tableRows(schema2.Name))
+
+	last, err = inst.Recover(&RecoverCtx{
+		DB:         database.Name,
+		Path:       createSSCtx.Path,
+		TargetTime: target,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, createId+1, last)
+	assert.Equal(t, int64(3*rows), tableRows(schema.Name))
+	assert.Equal(t, int64(rows), tableRows(schema2.Name))
+
+	last, err = inst.Recover(&RecoverCtx{
+		DB:         database.Name,
+		Path:       createSSCtx.Path,
+		TargetTime: target.Add(-time.Hour),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ssId, last)
+	assert.Equal(t, int64(0), tableRows(schema.Name))
+	assert.Equal(t, int64(-1), tableRows(schema2.Name))
+
+	// the rotated versions are archived and the catalog is still replayed
+	archived, err := ioutil.ReadDir(opts.ArchiveCfg.Dir)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(archived))
+	inst.Close()
+	opts2 := new(storage.Options)
+	opts2.WalRole = wal.BrokerRole
+	opts2.ArchiveCfg = opts.ArchiveCfg
+	config.NewCustomizedMetaOptions(path, config.CST_Customize, defaultTestBlockRows, defaultTestSegmentBlocks, opts2)
+	inst, err = Open(path, opts2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{schema.Name}, inst.TableNames(database.Name))
+	inst.Close()
+}
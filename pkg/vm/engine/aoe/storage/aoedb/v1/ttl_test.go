@@ -0,0 +1,169 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestDBWithTTL(t *testing.T) (*DB, error) {
+	opts := new(storage.Options)
+	opts.WalRole = wal.BrokerRole
+	opts.TTLCleanerCfg = &storage.TTLCleanerCfg{
+		Interval: 50 * time.Millisecond,
+	}
+	path := filepath.Join(getTestPath(t), defaultDBPath)
+	config.NewCustomizedMetaOptions(path, config.CST_Customize, defaultTestBlockRows, defaultTestSegmentBlocks, opts)
+	return Open(path, opts)
+}
+
+// mockTTLBatch returns a batch of the schema whose TTL column is expired
+// in the first expired rows
+func mockTTLBatch(schema *metadata.Schema, rows, expired uint64) *batch.Batch {
+	bat := mock.MockBatch(schema.Types(), rows)
+	colIdx := schema.GetColIdx(schema.TTLColumn)
+	col := bat.Vecs[colIdx].Col.([]types.Datetime)
+	now := types.Now()
+	for i := range col {
+		if uint64(i) < expired {
+			col[i] = now - types.Datetime(int64(2*24*3600)<<20)
+		} else {
+			col[i] = now
+		}
+	}
+	return bat
+}
+
+func TestTTL(t *testing.T) {
+	initTestEnv(t)
+	inst, err := openTestDBWithTTL(t)
+	assert.Nil(t, err)
+
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+	schema := metadata.MockSchema(2)
+	schema.AppendCol("mock_2", types.Type{Oid: types.T_datetime, Size: 8, Width: 8})
+	schema.TTLColumn = "mock_2"
+	schema.TTLDays = 1
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// The first segment is expired, half of the second one is expired
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	var expiredId uint64
+	for i, expired := range []uint64{rows, rows / 2, 0} {
+		ck := mockTTLBatch(tblMeta.Schema, rows, expired)
+		assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+		if i == 0 {
+			// The segment is not dropped before it is sorted
+			expiredId = tblMeta.SimpleGetSegmentIds()[0]
+		}
+	}
+	testutils.WaitExpect(4000, func() bool {
+		return tblMeta.SimpleGetSegment(expiredId) == nil
+	})
+	assert.Nil(t, tblMeta.SimpleGetSegment(expiredId))
+	assert.Equal(t, 2, len(tblMeta.SimpleGetSegmentIds()))
+	assert.Equal(t, 2*rows, tblMeta.GetRowCount())
+
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	ids := rel.SegmentIds().Ids
+	assert.Equal(t, 2, len(ids))
+	assert.NotEqual(t, expiredId, ids[0])
+
+	// The expired rows of the partially expired segment are masked
+	segment := rel.Segment(ids[0])
+	attrs := []string{"mock_0"}
+	read := 0
+	for _, id := range segment.Blocks() {
+		bat, err := segment.Block(id).Read([]uint64{1}, attrs,
+			[]*bytes.Buffer{new(bytes.Buffer)}, []*bytes.Buffer{new(bytes.Buffer)})
+		assert.Nil(t, err)
+		read += vector.Length(batch.GetVector(bat, "mock_0"))
+	}
+	assert.Equal(t, int(rows/2), read)
+	rel.Close()
+	inst.Close()
+
+	// The expired segment is not replayed
+	inst, err = openTestDBWithTTL(t)
+	assert.Nil(t, err)
+	defer inst.Close()
+	tblMeta, err = inst.Store.Catalog.SimpleGetTableByName(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Nil(t, tblMeta.SimpleGetSegment(expiredId))
+	assert.Equal(t, 2, len(tblMeta.SimpleGetSegmentIds()))
+}
+
+func TestTTLUnsortedSegment(t *testing.T) {
+	initTestEnv(t)
+	inst, err := openTestDBWithTTL(t)
+	assert.Nil(t, err)
+	defer inst.Close()
+	// The segments are not sorted
+	inst.Scheduler.ExecCmd(sched.TurnOffFlushSegmentCmd)
+
+	gen := shard.NewMockIndexAllocator()
+	database, err := inst.CreateDatabase(&CreateDBCtx{DB: defaultDBName})
+	assert.Nil(t, err)
+	schema := metadata.MockSchema(2)
+	schema.AppendCol("mock_2", types.Type{Oid: types.T_datetime, Size: 8, Width: 8})
+	schema.TTLColumn = "mock_2"
+	schema.TTLDays = 1
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// The first two segments are expired, the tail segment is not dropped
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	for _, expired := range []uint64{rows, 0, rows} {
+		ck := mockTTLBatch(tblMeta.Schema, rows, expired)
+		assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	}
+	ids := tblMeta.SimpleGetSegmentIds()
+	assert.Equal(t, 3, len(ids))
+	testutils.WaitExpect(4000, func() bool {
+		return tblMeta.SimpleGetSegment(ids[0]) == nil
+	})
+	assert.Nil(t, tblMeta.SimpleGetSegment(ids[0]))
+	assert.Equal(t, ids[1:], tblMeta.SimpleGetSegmentIds())
+	assert.False(t, tblMeta.SimpleGetSegment(ids[1]).IsSorted())
+	assert.Equal(t, 2*rows, tblMeta.GetRowCount())
+}
@@ -15,11 +15,52 @@
 package manager
 
 import (
+	"errors"
 	"fmt"
 	sq "github.com/yireyun/go-queue"
 	"sync"
 )
 
+const (
+	// FIFOPolicy evicts the nodes in the order they are unpinned
+	FIFOPolicy = "fifo"
+	// LRUKPolicy evicts the node whose k-th last access is the oldest, the
+	// nodes accessed less than k times are evicted first
+	LRUKPolicy = "lru-k"
+	// TwoQPolicy evicts the nodes accessed once in FIFO order and keeps the
+	// nodes accessed again in a LRU queue
+	TwoQPolicy = "2q"
+)
+
+var (
+	ErrUnknownPolicy = errors.New("aoe: unknown evict policy")
+)
+
+// NewEvictHolder creates the evict holder of the policy, the FIFO holder is
+// created if the policy is empty
+func NewEvictHolder(policy string, ctx ...interface{}) (IEvictHolder, error) {
+	switch policy {
+	case "", FIFOPolicy:
+		return NewSimpleEvictHolder(ctx...), nil
+	case LRUKPolicy:
+		return NewLRUKEvictHolder(ctx...), nil
+	case TwoQPolicy:
+		return NewTwoQEvictHolder(ctx...), nil
+	}
+	return nil, fmt.Errorf("%w %q", ErrUnknownPolicy, policy)
+}
+
+func evictHolderCapacity(ctx []interface{}) uint64 {
+	c := EVICT_HOLDER_CAPACITY
+	if len(ctx) > 0 {
+		context := ctx[0].(*SimpleEvictHolderCtx)
+		if context != nil {
+			c = context.QCapacity
+		}
+	}
+	return c
+}
+
 type SimpleEvictHolder struct {
 	Queue *sq.EsQueue
 	sync.Mutex
@@ -34,19 +75,17 @@ type SimpleEvictHolderCtx struct {
 }
 
 func NewSimpleEvictHolder(ctx ...interface{}) IEvictHolder {
-	c := EVICT_HOLDER_CAPACITY
-	if len(ctx) > 0 {
-		context := ctx[0].(*SimpleEvictHolderCtx)
-		if context != nil {
-			c = context.QCapacity
-		}
-	}
+	c := evictHolderCapacity(ctx)
 	holder := &SimpleEvictHolder{
 		Queue: sq.NewQueue(uint32(c)),
 	}
 	return holder
 }
 
+func (holder *SimpleEvictHolder) Policy() string {
+	return FIFOPolicy
+}
+
 func (holder *SimpleEvictHolder) Enqueue(node *EvictNode) {
 	holder.Queue.Put(node)
 }
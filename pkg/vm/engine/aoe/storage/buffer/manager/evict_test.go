@@ -0,0 +1,235 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	buf "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer"
+	nif "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/node/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockEvictHandle struct {
+	sync.Mutex
+	id     int
+	iter   uint64
+	closed bool
+}
+
+func (h *mockEvictHandle) IsClosed() bool    { return h.closed }
+func (h *mockEvictHandle) Unload()           {}
+func (h *mockEvictHandle) Unloadable() bool  { return true }
+func (h *mockEvictHandle) Iteration() uint64 { return h.iter }
+
+func (h *mockEvictHandle) access(holder IEvictHolder) {
+	h.iter++
+	holder.Enqueue(&EvictNode{Handle: h, Iter: h.iter})
+}
+
+func mockEvictHandles(n int) []*mockEvictHandle {
+	handles := make([]*mockEvictHandle, n)
+	for i := range handles {
+		handles[i] = &mockEvictHandle{id: i}
+	}
+	return handles
+}
+
+func dequeueAll(holder IEvictHolder) []int {
+	ids := make([]int, 0)
+	for node := holder.Dequeue(); node != nil; node = holder.Dequeue() {
+		ids = append(ids, node.Handle.(*mockEvictHandle).id)
+	}
+	return ids
+}
+
+func TestNewEvictHolder(t *testing.T) {
+	for _, policy := range []string{FIFOPolicy, LRUKPolicy, TwoQPolicy} {
+		holder, err := NewEvictHolder(policy)
+		assert.Nil(t, err)
+		assert.Equal(t, policy, holder.Policy())
+	}
+	holder, err := NewEvictHolder("")
+	assert.Nil(t, err)
+	assert.Equal(t, FIFOPolicy, holder.Policy())
+	_, err = NewEvictHolder("arc")
+	assert.ErrorIs(t, err, ErrUnknownPolicy)
+}
+
+func TestLRUKEvictHolder(t *testing.T) {
+	holder := NewLRUKEvictHolder()
+	handles := mockEvictHandles(6)
+	hot := handles[0]
+	hot.access(holder)
+	hot.access(holder)
+	for _, h := range handles[1:] {
+		h.access(holder)
+	}
+	// The scanned nodes are evicted before the hot one
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 0}, dequeueAll(holder))
+
+	// The history is kept after the nodes are dequeued, so the nodes are
+	// evicted by the second last access
+	handles[3].access(holder)
+	hot.access(holder)
+	handles[1].access(holder)
+	handles[2].access(holder)
+	handles[2].access(holder)
+	assert.Equal(t, []int{0, 1, 3, 2}, dequeueAll(holder))
+
+	handles[4].access(holder)
+	handles[4].closed = true
+	node := holder.Dequeue()
+	assert.Equal(t, handles[4], node.Handle)
+	assert.Equal(t, handles[4].iter, node.Iter)
+	assert.Equal(t, 5, len(holder.(*LRUKEvictHolder).entries))
+}
+
+func TestTwoQEvictHolder(t *testing.T) {
+	holder := NewTwoQEvictHolder()
+	handles := mockEvictHandles(6)
+	hot := handles[0]
+	hot.access(holder)
+	assert.Equal(t, []int{0}, dequeueAll(holder))
+	// The access after being dequeued moves the node into the LRU queue
+	hot.access(holder)
+	for _, h := range handles[1:] {
+		h.access(holder)
+	}
+	hot.access(holder)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 0}, dequeueAll(holder))
+
+	handles[1].access(holder)
+	handles[2].access(holder)
+	handles[2].closed = true
+	holder.(*TwoQEvictHolder).purge()
+	assert.Equal(t, []int{1}, dequeueAll(holder))
+}
+
+type mockTableFile struct {
+	common.IVFile
+	tableId uint64
+}
+
+func (f *mockTableFile) TableID() uint64 {
+	return f.tableId
+}
+
+func TestTableCache(t *testing.T) {
+	nodeCapacity := int64(1024)
+	mgr, err := NewBufferManagerWithPolicy(WORK_DIR, uint64(3*nodeCapacity), LRUKPolicy)
+	assert.Nil(t, err)
+	mgr.TableCache = func(tableId uint64) *TableCacheCfg {
+		switch tableId {
+		case 1:
+			return &TableCacheCfg{Pinned: true}
+		case 2:
+			return &TableCacheCfg{Quota: uint64(nodeCapacity)}
+		}
+		return nil
+	}
+	register := func(tableId uint64) nif.INodeHandle {
+		vf := &mockTableFile{IVFile: common.NewMemFile(nodeCapacity), tableId: tableId}
+		return mgr.RegisterNode(vf, true, mgr.GetNextID(), buf.RawMemoryNodeConstructor)
+	}
+	pin := func(h nif.INodeHandle) {
+		bh := mgr.Pin(h)
+		assert.NotNil(t, bh)
+		bh.Close()
+	}
+
+	// The pinned node is kept while the others are evicted
+	pinned := register(1)
+	pin(pinned)
+	scanned := make([]nif.INodeHandle, 4)
+	for i := range scanned {
+		scanned[i] = register(3)
+		pin(scanned[i])
+	}
+	assert.Equal(t, nif.NODE_LOADED, pinned.GetState())
+	assert.Equal(t, nif.NODE_UNLOAD, scanned[0].GetState())
+	assert.Equal(t, 2, len(mgr.TableNodes))
+
+	// The nodes of the table are evicted after its node is loaded to keep
+	// it within the quota
+	q0, q1 := register(2), register(2)
+	pin(q0)
+	pin(q1)
+	assert.Equal(t, nif.NODE_UNLOAD, q0.GetState())
+	assert.Equal(t, nif.NODE_LOADED, q1.GetState())
+	assert.Equal(t, nif.NODE_LOADED, pinned.GetState())
+
+	// The pinned node is evicted if no other node can be evicted
+	handles := make([]nif.IBufferHandle, 0)
+	for _, h := range []nif.INodeHandle{q1, scanned[3]} {
+		handles = append(handles, mgr.Pin(h))
+	}
+	pin(scanned[0])
+	assert.Equal(t, nif.NODE_UNLOAD, pinned.GetState())
+	for _, bh := range handles {
+		bh.Close()
+	}
+
+	stats := mgr.Stats()
+	assert.Equal(t, LRUKPolicy, stats.Policy)
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(9), stats.Misses)
+	assert.Equal(t, int64(6), stats.Evictions)
+
+	pinned.Close()
+	assert.Equal(t, 2, len(mgr.TableNodes))
+}
+
+func TestTableCacheConcurrentPin(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	nodeCapacity := int64(1024)
+	mgr, err := NewBufferManagerWithPolicy(WORK_DIR, uint64(4*nodeCapacity), LRUKPolicy)
+	assert.Nil(t, err)
+	mgr.TableCache = func(tableId uint64) *TableCacheCfg {
+		return &TableCacheCfg{Quota: uint64(nodeCapacity)}
+	}
+	handles := make([]nif.INodeHandle, 4)
+	for i := range handles {
+		vf := &mockTableFile{IVFile: common.NewMemFile(nodeCapacity), tableId: 1}
+		handles[i] = mgr.RegisterNode(vf, true, mgr.GetNextID(), buf.RawMemoryNodeConstructor)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(h nif.INodeHandle) {
+			defer wg.Done()
+			for j := 0; j < 10000; j++ {
+				if bh := mgr.Pin(h); bh != nil {
+					bh.Close()
+				}
+			}
+		}(handles[i%len(handles)])
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("concurrent pins of a table with a quota are blocked")
+	}
+}
@@ -43,6 +43,14 @@ func (h *MangaedNode) Close() error {
 	return nil
 }
 
+// CacheStats are the statistics of the buffer manager, a miss loads the node
+type CacheStats struct {
+	Policy    string
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
 type IBufferManager interface {
 	sync.Locker
 	RLock()
@@ -51,6 +59,7 @@ type IBufferManager interface {
 
 	String() string
 	NodeCount() int
+	Stats() CacheStats
 	GetNextID() uint64
 	GetNextTransientID() uint64
 
@@ -0,0 +1,179 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"container/heap"
+	"container/list"
+	"sync"
+)
+
+const (
+	// LRU_K is the number of the accesses remembered by the LRU-K holder
+	LRU_K = 2
+)
+
+// LRUKEvictHolder is the evict holder of the LRU-K policy. An access of a
+// node is the enqueue of it after it is unpinned. The nodes accessed less
+// than k times are evicted first in LRU order, so a scan which reads every
+// node once does not flush the nodes accessed again and again. The others
+// are evicted by the time of the k-th last access.
+//
+// The history of a dequeued node is kept for the next access, the history
+// of at most capacity nodes are kept.
+type LRUKEvictHolder struct {
+	sync.Mutex
+	capacity int
+	purgeAt  int
+	clock    uint64
+	entries  map[IEvictHandle]*lrukEntry
+	// cold are the queued nodes accessed less than k times, oldest at front
+	cold *list.List
+	// hot are the queued nodes accessed k times
+	hot lrukHeap
+	// history are the nodes not queued, oldest at front
+	history *list.List
+}
+
+type lrukEntry struct {
+	node *EvictNode
+	// times are the last k access times, the latest at last
+	times []uint64
+	// queue is the cold queue or the history holding the entry
+	queue *list.List
+	elem  *list.Element
+	// index is the index in the hot heap, -1 if not in it
+	index int
+}
+
+func NewLRUKEvictHolder(ctx ...interface{}) IEvictHolder {
+	capacity := int(evictHolderCapacity(ctx))
+	return &LRUKEvictHolder{
+		capacity: capacity,
+		purgeAt:  capacity,
+		entries:  make(map[IEvictHandle]*lrukEntry),
+		cold:     list.New(),
+		history:  list.New(),
+	}
+}
+
+func (holder *LRUKEvictHolder) Policy() string {
+	return LRUKPolicy
+}
+
+func (holder *LRUKEvictHolder) Enqueue(node *EvictNode) {
+	holder.Lock()
+	defer holder.Unlock()
+	holder.clock++
+	entry := holder.entries[node.Handle]
+	if entry == nil {
+		entry = &lrukEntry{index: -1}
+		holder.entries[node.Handle] = entry
+	}
+	entry.node = node
+	entry.times = append(entry.times, holder.clock)
+	if len(entry.times) > LRU_K {
+		entry.times = entry.times[1:]
+	}
+	if entry.index >= 0 {
+		heap.Fix(&holder.hot, entry.index)
+		return
+	}
+	if entry.queue != nil {
+		entry.queue.Remove(entry.elem)
+		entry.queue, entry.elem = nil, nil
+	}
+	if len(entry.times) == LRU_K {
+		heap.Push(&holder.hot, entry)
+	} else {
+		entry.queue, entry.elem = holder.cold, holder.cold.PushBack(entry)
+	}
+	if len(holder.entries) >= holder.purgeAt {
+		holder.purge()
+	}
+}
+
+func (holder *LRUKEvictHolder) Dequeue() *EvictNode {
+	holder.Lock()
+	defer holder.Unlock()
+	var entry *lrukEntry
+	if holder.cold.Len() > 0 {
+		entry = holder.cold.Remove(holder.cold.Front()).(*lrukEntry)
+		entry.queue, entry.elem = nil, nil
+	} else if holder.hot.Len() > 0 {
+		entry = heap.Pop(&holder.hot).(*lrukEntry)
+	} else {
+		return nil
+	}
+	node := entry.node
+	if node.Handle.IsClosed() {
+		delete(holder.entries, node.Handle)
+		return node
+	}
+	entry.queue, entry.elem = holder.history, holder.history.PushBack(entry)
+	for holder.history.Len() > holder.capacity {
+		old := holder.history.Remove(holder.history.Front()).(*lrukEntry)
+		delete(holder.entries, old.node.Handle)
+	}
+	return node
+}
+
+// purge removes the entries of the closed nodes which are never dequeued if
+// there is no need to evict
+func (holder *LRUKEvictHolder) purge() {
+	for handle, entry := range holder.entries {
+		if !handle.IsClosed() {
+			continue
+		}
+		if entry.index >= 0 {
+			heap.Remove(&holder.hot, entry.index)
+		} else {
+			entry.queue.Remove(entry.elem)
+		}
+		delete(holder.entries, handle)
+	}
+	holder.purgeAt = 2 * len(holder.entries)
+	if holder.purgeAt < holder.capacity {
+		holder.purgeAt = holder.capacity
+	}
+}
+
+type lrukHeap []*lrukEntry
+
+func (h lrukHeap) Len() int { return len(h) }
+
+func (h lrukHeap) Less(i, j int) bool { return h[i].times[0] < h[j].times[0] }
+
+func (h lrukHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *lrukHeap) Push(x interface{}) {
+	entry := x.(*lrukEntry)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *lrukHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	entry.index = -1
+	*h = old[:n-1]
+	return entry
+}
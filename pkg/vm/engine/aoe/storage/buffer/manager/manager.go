@@ -16,6 +16,7 @@ package manager
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	buf "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer"
 	mgrif "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/manager/iface"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/node"
@@ -38,11 +39,24 @@ func NewBufferManager(dir string, capacity uint64, evict_ctx ...interface{}) mgr
 		NextID:          uint64(0),
 		NextTransientID: TRANSIENT_START_ID,
 		Dir:             []byte(dir),
+		TableNodes:      make(map[uint64]map[uint64]nif.INodeHandle),
 	}
 
 	return mgr
 }
 
+// NewBufferManagerWithPolicy creates a buffer manager evicting the nodes by
+// the evict policy
+func NewBufferManagerWithPolicy(dir string, capacity uint64, policy string, evict_ctx ...interface{}) (*BufferManager, error) {
+	holder, err := NewEvictHolder(policy, evict_ctx...)
+	if err != nil {
+		return nil, err
+	}
+	mgr := NewBufferManager(dir, capacity).(*BufferManager)
+	mgr.EvictHolder = holder
+	return mgr, nil
+}
+
 func (mgr *BufferManager) NodeCount() int {
 	mgr.RLock()
 	defer mgr.RUnlock()
@@ -52,14 +66,24 @@ func (mgr *BufferManager) NodeCount() int {
 func (mgr *BufferManager) String() string {
 	mgr.RLock()
 	defer mgr.RUnlock()
-	s := fmt.Sprintf("BMgr[Cap:%d,Usage:%d,Nodes:%d,LoadTimes:%d,EvictTimes:%d,UnregisterTimes:%d]:\n", mgr.GetCapacity(), mgr.GetUsage(),
-		len(mgr.Nodes), atomic.LoadInt64(&mgr.LoadTimes), atomic.LoadInt64(&mgr.EvictTimes), atomic.LoadInt64(&mgr.UnregisterTimes))
+	s := fmt.Sprintf("BMgr[Cap:%d,Usage:%d,Nodes:%d,Policy:%s,HitTimes:%d,LoadTimes:%d,EvictTimes:%d,EvictedTimes:%d,UnregisterTimes:%d]:\n",
+		mgr.GetCapacity(), mgr.GetUsage(), len(mgr.Nodes), mgr.EvictHolder.Policy(), atomic.LoadInt64(&mgr.HitTimes),
+		atomic.LoadInt64(&mgr.LoadTimes), atomic.LoadInt64(&mgr.EvictTimes), atomic.LoadInt64(&mgr.EvictedTimes), atomic.LoadInt64(&mgr.UnregisterTimes))
 	for _, node := range mgr.Nodes {
 		s = fmt.Sprintf("%s\n\t%d | %s | Cap: %d ", s, node.GetID(), nif.NodeStateString(mgr.Nodes[node.GetID()].GetState()), mgr.Nodes[node.GetID()].GetCapacity())
 	}
 	return s
 }
 
+func (mgr *BufferManager) Stats() mgrif.CacheStats {
+	return mgrif.CacheStats{
+		Policy:    mgr.EvictHolder.Policy(),
+		Hits:      atomic.LoadInt64(&mgr.HitTimes),
+		Misses:    atomic.LoadInt64(&mgr.LoadTimes),
+		Evictions: atomic.LoadInt64(&mgr.EvictedTimes),
+	}
+}
+
 func (mgr *BufferManager) GetNextID() uint64 {
 	return atomic.AddUint64(&mgr.NextID, uint64(1)) - 1
 }
@@ -131,6 +155,7 @@ func (mgr *BufferManager) RegisterSpillableNode(vf common.IVFile, node_id uint64
 	}
 
 	mgr.Nodes[node_id] = handle
+	mgr.addTableNode(handle)
 	return handle
 }
 
@@ -155,9 +180,56 @@ func (mgr *BufferManager) RegisterNode(vf common.IVFile, useCompress bool, node_
 	}
 	handle = node.NewNodeHandle(&ctx)
 	mgr.Nodes[node_id] = handle
+	mgr.addTableNode(handle)
 	return handle
 }
 
+// addTableNode adds the node of the file of a table into the table nodes
+func (mgr *BufferManager) addTableNode(h nif.INodeHandle) {
+	tf, ok := h.GetFile().(common.ITableFile)
+	if !ok {
+		return
+	}
+	nodes := mgr.TableNodes[tf.TableID()]
+	if nodes == nil {
+		nodes = make(map[uint64]nif.INodeHandle)
+		mgr.TableNodes[tf.TableID()] = nodes
+	}
+	nodes[h.GetID()] = h
+}
+
+func (mgr *BufferManager) removeTableNode(h nif.INodeHandle) {
+	tf, ok := h.GetFile().(common.ITableFile)
+	if !ok {
+		return
+	}
+	nodes := mgr.TableNodes[tf.TableID()]
+	if nodes[h.GetID()] != h {
+		return
+	}
+	delete(nodes, h.GetID())
+	if len(nodes) == 0 {
+		delete(mgr.TableNodes, tf.TableID())
+	}
+}
+
+// tableCache returns the table and the cache policy of the node, nil if the
+// node has no cache policy
+func (mgr *BufferManager) tableCache(h interface{}) (uint64, *TableCacheCfg) {
+	if mgr.TableCache == nil {
+		return 0, nil
+	}
+	nh, ok := h.(nif.INodeHandle)
+	if !ok {
+		return 0, nil
+	}
+	tf, ok := nh.GetFile().(common.ITableFile)
+	if !ok {
+		return 0, nil
+	}
+	return tf.TableID(), mgr.TableCache(tf.TableID())
+}
+
 func (mgr *BufferManager) UnregisterNode(h nif.INodeHandle) {
 	atomic.AddInt64(&mgr.UnregisterTimes, int64(1))
 	node_id := h.GetID()
@@ -169,6 +241,7 @@ func (mgr *BufferManager) UnregisterNode(h nif.INodeHandle) {
 		} else {
 			mgr.Lock()
 			delete(mgr.Nodes, node_id)
+			mgr.removeTableNode(h)
 			h.Clean()
 			mgr.Unlock()
 			return
@@ -177,6 +250,7 @@ func (mgr *BufferManager) UnregisterNode(h nif.INodeHandle) {
 	mgr.Lock()
 	defer mgr.Unlock()
 	delete(mgr.Nodes, node_id)
+	mgr.removeTableNode(h)
 }
 
 func (mgr *BufferManager) Unpin(handle nif.INodeHandle) {
@@ -197,13 +271,29 @@ func (mgr *BufferManager) makePoolNode(vf common.IVFile, useCompress bool, const
 	if node != nil {
 		return node
 	}
+	// The nodes of the pinned tables are put back unless no other node
+	// can be evicted
+	var pinned []*EvictNode
+	defer func() {
+		for _, evict_node := range pinned {
+			mgr.EvictHolder.Enqueue(evict_node)
+		}
+	}()
 	for node == nil {
 		// log.Printf("makePoolNode capacity %d now %d", capacity, mgr.GetUsageSize())
 		evict_node := mgr.EvictHolder.Dequeue()
 		// log.Infof("Evict node %s", evict_node.String())
 		if evict_node == nil {
-			// log.Printf("Cannot get node from queue")
-			return nil
+			if len(pinned) == 0 {
+				// log.Printf("Cannot get node from queue")
+				return nil
+			}
+			evict_node, pinned = pinned[0], pinned[1:]
+		} else if evict_node.Handle.IsClosed() {
+			continue
+		} else if _, cfg := mgr.tableCache(evict_node.Handle); cfg != nil && cfg.Pinned {
+			pinned = append(pinned, evict_node)
+			continue
 		}
 		if evict_node.Handle.IsClosed() {
 			continue
@@ -225,21 +315,77 @@ func (mgr *BufferManager) makePoolNode(vf common.IVFile, useCompress bool, const
 			}
 			evict_node.Handle.Unload()
 			evict_node.Handle.Unlock()
+			mgr.evicted()
 		}
 		node = mgr.Alloc(vf, useCompress, constructor)
 	}
 	return node
 }
 
+func (mgr *BufferManager) evicted() {
+	atomic.AddInt64(&mgr.EvictedTimes, int64(1))
+	metric.BufferEvicted(mgr.EvictHolder.Policy())
+}
+
+// applyQuota unloads the other nodes of the table of the handle loaded
+// until the table is within its quota. The handle is kept loaded over the
+// quota if the other nodes are all pinned. It is called without the lock
+// of the handle, as the other nodes of the table are locked.
+func (mgr *BufferManager) applyQuota(handle nif.INodeHandle) {
+	tableId, cfg := mgr.tableCache(handle)
+	if cfg == nil || cfg.Quota == 0 {
+		return
+	}
+	mgr.RLock()
+	nodes := make([]nif.INodeHandle, 0, len(mgr.TableNodes[tableId]))
+	for _, h := range mgr.TableNodes[tableId] {
+		if h != handle {
+			nodes = append(nodes, h)
+		}
+	}
+	mgr.RUnlock()
+	size := handle.GetCapacity()
+	for _, h := range nodes {
+		if h.GetState() == nif.NODE_LOADED {
+			size += h.GetCapacity()
+		}
+	}
+	for _, h := range nodes {
+		if size <= cfg.Quota {
+			break
+		}
+		h.Lock()
+		if h.GetState() == nif.NODE_LOADED && h.Unloadable() {
+			h.Unload()
+			size -= h.GetCapacity()
+			mgr.evicted()
+		}
+		h.Unlock()
+	}
+}
+
+// Pin loads the node of the handle if it is not loaded, and returns a
+// buffer handle referring to it. The quota of the table is applied after
+// the handle is unlocked, otherwise two nodes of the table loaded at the
+// same time would wait for the lock of each other.
 func (mgr *BufferManager) Pin(handle nif.INodeHandle) nif.IBufferHandle {
+	bh, loaded := mgr.pin(handle)
+	if loaded {
+		mgr.applyQuota(handle)
+	}
+	return bh
+}
+
+func (mgr *BufferManager) pin(handle nif.INodeHandle) (nif.IBufferHandle, bool) {
 	handle.Lock()
 	defer handle.Unlock()
-	if handle.PrepareLoad() {
+	hit := !handle.PrepareLoad()
+	if !hit {
 		n := mgr.makePoolNode(handle.GetFile(), handle.IsCompress(), handle.GetNodeCreator())
 		if n == nil {
 			handle.RollbackLoad()
 			// log.Warnf("Cannot makeSpace(%d,%d)", handle.GetCapacity(), mgr.GetCapacity())
-			return nil
+			return nil, false
 		}
 		buf := node.NewNodeBuffer(handle.GetID(), n)
 		handle.SetBuffer(buf)
@@ -248,9 +394,12 @@ func (mgr *BufferManager) Pin(handle nif.INodeHandle) nif.IBufferHandle {
 			panic(err.Error())
 		}
 		atomic.AddInt64(&mgr.LoadTimes, int64(1))
+	} else {
+		atomic.AddInt64(&mgr.HitTimes, int64(1))
 	}
+	metric.BufferPinned(mgr.EvictHolder.Policy(), hit)
 	handle.Ref()
-	return handle.MakeHandle()
+	return handle.MakeHandle(), !hit
 }
 
 func MockBufMgr(capacity uint64) mgrif.IBufferManager {
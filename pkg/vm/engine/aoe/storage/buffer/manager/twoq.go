@@ -0,0 +1,127 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"container/list"
+	"sync"
+)
+
+// TwoQEvictHolder is the evict holder of the 2Q policy. The nodes accessed
+// the first time are queued in a FIFO queue, they are remembered in a ghost
+// queue after being dequeued. The nodes accessed again before they are
+// forgotten by the ghost queue are queued in a LRU queue, which is only
+// dequeued while the FIFO queue holds at most a quarter of the nodes. So a
+// scan only flushes the nodes accessed once.
+type TwoQEvictHolder struct {
+	sync.Mutex
+	capacity int
+	purgeAt  int
+	entries  map[IEvictHandle]*twoQEntry
+	// in is the FIFO queue of the nodes accessed once
+	in *list.List
+	// out is the ghost queue of the nodes dequeued from in
+	out *list.List
+	// lru is the LRU queue of the nodes accessed again
+	lru *list.List
+}
+
+type twoQEntry struct {
+	node  *EvictNode
+	queue *list.List
+	elem  *list.Element
+}
+
+func NewTwoQEvictHolder(ctx ...interface{}) IEvictHolder {
+	capacity := int(evictHolderCapacity(ctx))
+	return &TwoQEvictHolder{
+		capacity: capacity,
+		purgeAt:  capacity,
+		entries:  make(map[IEvictHandle]*twoQEntry),
+		in:       list.New(),
+		out:      list.New(),
+		lru:      list.New(),
+	}
+}
+
+func (holder *TwoQEvictHolder) Policy() string {
+	return TwoQPolicy
+}
+
+func (holder *TwoQEvictHolder) Enqueue(node *EvictNode) {
+	holder.Lock()
+	defer holder.Unlock()
+	entry := holder.entries[node.Handle]
+	if entry == nil {
+		entry = &twoQEntry{}
+		holder.entries[node.Handle] = entry
+	}
+	entry.node = node
+	switch entry.queue {
+	case holder.in:
+		// An access in the FIFO queue is correlated to the first one
+		return
+	case holder.lru:
+		holder.lru.MoveToBack(entry.elem)
+		return
+	case holder.out:
+		holder.out.Remove(entry.elem)
+		entry.queue, entry.elem = holder.lru, holder.lru.PushBack(entry)
+	default:
+		entry.queue, entry.elem = holder.in, holder.in.PushBack(entry)
+	}
+	if len(holder.entries) >= holder.purgeAt {
+		holder.purge()
+	}
+}
+
+func (holder *TwoQEvictHolder) Dequeue() *EvictNode {
+	holder.Lock()
+	defer holder.Unlock()
+	var entry *twoQEntry
+	if holder.in.Len() > 0 && (holder.lru.Len() == 0 || holder.in.Len()*4 > holder.in.Len()+holder.lru.Len()) {
+		entry = holder.in.Remove(holder.in.Front()).(*twoQEntry)
+		if !entry.node.Handle.IsClosed() {
+			entry.queue, entry.elem = holder.out, holder.out.PushBack(entry)
+			for holder.out.Len() > holder.capacity/2 {
+				old := holder.out.Remove(holder.out.Front()).(*twoQEntry)
+				delete(holder.entries, old.node.Handle)
+			}
+			return entry.node
+		}
+	} else if holder.lru.Len() > 0 {
+		entry = holder.lru.Remove(holder.lru.Front()).(*twoQEntry)
+	} else {
+		return nil
+	}
+	delete(holder.entries, entry.node.Handle)
+	return entry.node
+}
+
+// purge removes the entries of the closed nodes which are never dequeued if
+// there is no need to evict
+func (holder *TwoQEvictHolder) purge() {
+	for handle, entry := range holder.entries {
+		if !handle.IsClosed() {
+			continue
+		}
+		entry.queue.Remove(entry.elem)
+		delete(holder.entries, handle)
+	}
+	holder.purgeAt = 2 * len(holder.entries)
+	if holder.purgeAt < holder.capacity {
+		holder.purgeAt = holder.capacity
+	}
+}
@@ -41,6 +41,15 @@ type IEvictHolder interface {
 	sync.Locker
 	Enqueue(n *EvictNode)
 	Dequeue() *EvictNode
+	Policy() string
+}
+
+// TableCacheCfg is the cache policy of the nodes of a table
+type TableCacheCfg struct {
+	// Pinned nodes are only evicted if no other node can be evicted
+	Pinned bool
+	// Quota is the max size of the loaded nodes of the table, 0 is unlimited
+	Quota uint64
 }
 
 type BufferManager struct {
@@ -52,8 +61,16 @@ type BufferManager struct {
 	NextTransientID uint64
 	EvictTimes      int64
 	LoadTimes       int64
+	HitTimes        int64
+	EvictedTimes    int64
 	UnregisterTimes int64
 	Dir             []byte
+
+	// TableCache returns the cache policy of the table, nil if the table
+	// has none. It is nil if no table has a cache policy.
+	TableCache func(tableId uint64) *TableCacheCfg
+	// TableNodes are the nodes of the tables, by table and node id
+	TableNodes map[uint64]map[uint64]iface.INodeHandle
 }
 
 type mockVFile struct{}
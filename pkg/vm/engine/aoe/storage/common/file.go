@@ -44,6 +44,12 @@ type IVFile interface {
 	GetFileType() FileType
 }
 
+// ITableFile is implemented by the IVFile of the data of a table, the buffer
+// manager applies the cache policy of the table to the node of the file.
+type ITableFile interface {
+	TableID() uint64
+}
+
 type baseFileInfo struct {
 	size int64
 }
@@ -46,6 +46,7 @@ const (
 	TempDirName  = "temp"
 	DataDirName  = "data"
 	MetaDirName  = "meta"
+	RedoDirName  = "redo"
 )
 
 func MakeSpillDir(dirname string) string {
@@ -64,6 +65,10 @@ func MakeMetaDir(dirname string) string {
 	return path.Join(dirname, MetaDirName)
 }
 
+func MakeRedoDir(dirname string) string {
+	return path.Join(dirname, RedoDirName)
+}
+
 func MakeTBlockFileName(dirname, name string, isTmp bool) string {
 	return MakeFilename(dirname, FTTBlock, name, isTmp)
 }
@@ -78,6 +83,11 @@ func MakeSegmentFileName(dirname, name string, tableId uint64, isTmp bool) strin
 	return MakeFilename(dir, FTSegment, name, isTmp)
 }
 
+// MakeSegmentObjectName returns the name of the segment file in the file service
+func MakeSegmentObjectName(name string) string {
+	return MakeFilename("", FTSegment, name, false)
+}
+
 func MakeLockFileName(dirname, name string) string {
 	return MakeFilename(dirname, FTLock, name, false)
 }
@@ -18,9 +18,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 )
 
 // Block is a high-level wrapper of the block type in memory. It
@@ -32,10 +35,42 @@ type Block struct {
 	Id    uint64
 	// string representation of block id
 	StrId string
+	// rows and deletes pinned by Pin
+	pinned  bool
+	sorted  bool
+	rows    int64
+	deletes *roaring.Bitmap
+}
+
+// Pin returns the block as it is now, the rows appended and deleted after
+// Pin are not seen through the returned block. The delete mask of a block
+// is replaced on every delete, so the pinned one is never changed. Once its
+// segment is sorted, the deleted rows are purged and the pinned offsets no
+// longer apply, the sorted block is read as it is.
+func (blk *Block) Pin() aoe.Block {
+	pinned := &Block{
+		Host:   blk.Host,
+		Id:     blk.Id,
+		StrId:  blk.StrId,
+		pinned: true,
+	}
+	data := blk.Host.Data.StrongRefBlock(blk.Id)
+	if data == nil {
+		logutil.Warnf("specified blk %d not found", blk.Id)
+		return pinned
+	}
+	defer data.Unref()
+	pinned.sorted = data.GetType() == base.PERSISTENT_SORTED_BLK
+	pinned.rows = int64(data.GetRowCount())
+	pinned.deletes = blockDeletes(data)
+	return pinned
 }
 
 // Rows returns how many rows this block contains currently.
 func (blk *Block) Rows() int64 {
+	if blk.pinned {
+		return blk.rows
+	}
 	data := blk.Host.Data.StrongRefBlock(blk.Id)
 	if data == nil {
 		// TODO: returns error
@@ -102,6 +137,37 @@ func (blk *Block) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer,
 		vec.Ref = cs[i]
 		bat.Vecs[i] = vec
 	}
+	expired, err := expiredRows(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(bat.Vecs) == 0 {
+		return bat, nil
+	}
+	deletes := blockDeletes(data)
+	rows := vector.Length(bat.Vecs[0])
+	limit := rows
+	if blk.pinned && blk.sorted == (data.GetType() == base.PERSISTENT_SORTED_BLK) {
+		deletes = blk.deletes
+		if blk.rows < int64(rows) {
+			limit = int(blk.rows)
+		}
+	}
+	if deletes != nil || expired != nil || limit < rows {
+		sels := make([]int64, 0, limit)
+		for row := 0; row < limit; row++ {
+			if deletes != nil && deletes.Contains(uint32(row)) {
+				continue
+			}
+			if expired != nil && expired.Contains(uint32(row)) {
+				continue
+			}
+			sels = append(sels, int64(row))
+		}
+		for _, vec := range bat.Vecs {
+			vector.Shrink(vec, sels)
+		}
+	}
 	return bat, nil
 }
 
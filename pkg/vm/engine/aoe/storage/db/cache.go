@@ -0,0 +1,38 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	bm "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/manager"
+)
+
+// tableCache returns the cache policy of the table set by its properties,
+// nil if the table has none
+func (d *DB) tableCache(tableId uint64) *bm.TableCacheCfg {
+	if d.Store.Catalog == nil {
+		return nil
+	}
+	table, err := d.Store.Catalog.SimpleGetTable(tableId)
+	if err != nil {
+		return nil
+	}
+	if !table.Schema.CachePinned && table.Schema.CacheQuota == 0 {
+		return nil
+	}
+	return &bm.TableCacheCfg{
+		Pinned: table.Schema.CachePinned,
+		Quota:  table.Schema.CacheQuota,
+	}
+}
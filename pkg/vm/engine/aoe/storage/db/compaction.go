@@ -0,0 +1,523 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// The weights of the factors of the compaction score, every factor is
+// in [0, 1] so the score is in [0, 1] too
+const (
+	compactDeletedWeight = 0.4
+	compactOverlapWeight = 0.3
+	compactAgeWeight     = 0.2
+	compactSizeWeight    = 0.1
+
+	// compactAgeHorizon is the age at which the age factor reaches 1
+	compactAgeHorizon = time.Hour
+)
+
+// CompactionCandidate is scored by the planner, it is either a closed
+// unsorted segment whose persistent blocks are not merged into a sorted
+// segment yet, or a run of sorted segments whose rows left fit in one
+// segment.
+type CompactionCandidate struct {
+	TableID uint64
+	// SegmentID is the unsorted segment, or the first of Merged
+	SegmentID uint64
+	// Merged is the sorted segments merged into one, nil for an unsorted
+	// segment
+	Merged []uint64
+	// Size is the bytes of the block files of the unsorted segment, or of
+	// the files of the sorted segments
+	Size int64
+	// DeletedRatio is the ratio of the deleted rows of the segments
+	DeletedRatio float64
+	// Overlap is the ratio of the sorted segments of the table whose
+	// primary key range overlaps the one of the unsorted segment
+	Overlap float64
+	// Age is the time since the segments were first found
+	Age   time.Duration
+	Score float64
+}
+
+// CompactionJob is a merge of a candidate scheduled by the compactor
+type CompactionJob struct {
+	CompactionCandidate
+	// Manual is true if the merge is started by ADMIN COMPACT TABLE
+	Manual   bool
+	Started  time.Time
+	Finished time.Time
+	// Written is the bytes of the sorted segment file
+	Written int64
+	Err     error
+}
+
+func (job *CompactionJob) String() string {
+	name := fmt.Sprintf("segment %d", job.SegmentID)
+	if len(job.Merged) > 0 {
+		name = fmt.Sprintf("segments %v", job.Merged)
+	}
+	s := fmt.Sprintf("%s score %.2f (deleted %.2f, overlap %.2f, age %s, size %d)",
+		name, job.Score, job.DeletedRatio, job.Overlap, job.Age.Truncate(time.Second), job.Size)
+	if job.Finished.IsZero() {
+		return fmt.Sprintf("%s running", s)
+	}
+	if job.Err != nil {
+		return fmt.Sprintf("%s failed: %v", s, job.Err)
+	}
+	return fmt.Sprintf("%s merged, %d bytes written in %s", s, job.Written, job.Finished.Sub(job.Started))
+}
+
+// CompactionProgress is the running merges and the latest finished ones
+type CompactionProgress struct {
+	Running []CompactionJob
+	History []CompactionJob
+}
+
+// Compactor plans and runs the merges of the closed unsorted segments and
+// of the sorted segments left small by their deleted rows. The automatic
+// merge of a segment is scheduled once its last block is upgraded, the
+// compactor picks up the segments left behind, e.g. those whose merge
+// failed or was turned off, by the order of their scores.
+type Compactor struct {
+	db      *DB
+	cfg     storage.CompactionCfg
+	limiter *IOLimiter
+
+	mu sync.Mutex
+	// seen is the time each candidate was first found closed
+	seen    map[uint64]time.Time
+	running map[uint64]*CompactionJob
+	history []*CompactionJob
+}
+
+func NewCompactor(db *DB, cfg *storage.CompactionCfg) *Compactor {
+	c := &Compactor{
+		db:      db,
+		seen:    make(map[uint64]time.Time),
+		running: make(map[uint64]*CompactionJob),
+	}
+	if cfg != nil {
+		c.cfg = *cfg
+	}
+	if c.cfg.HistorySize <= 0 {
+		c.cfg.HistorySize = storage.DefaultCompactionHistorySize
+	}
+	c.limiter = NewIOLimiter(c.cfg.RateLimit)
+	return c
+}
+
+// Plan scores the closed unsorted segments of the table and the runs of
+// its sorted segments whose rows left fit in one segment, the candidates
+// are returned in the descending order of their scores
+func (c *Compactor) Plan(meta *metadata.Table) ([]*CompactionCandidate, error) {
+	data, err := c.db.GetTableData(meta)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Unref()
+	pk := meta.Schema.PrimaryKey
+	maxRows := meta.Schema.BlockMaxRows
+	segRows := maxRows * meta.Schema.SegmentMaxBlocks
+	now := time.Now()
+	var candidates []*CompactionCandidate
+	var ranges, sortedRanges [][2]interface{}
+	// group is the run of sorted segments being packed into one
+	var group *CompactionCandidate
+	var groupRows uint64
+	closeGroup := func() {
+		if group != nil && (len(group.Merged) > 1 || groupRows == 0) {
+			group.DeletedRatio = 1 - float64(groupRows)/float64(uint64(len(group.Merged))*segRows)
+			candidates = append(candidates, group)
+			ranges = append(ranges, [2]interface{}{})
+		}
+		group, groupRows = nil, 0
+	}
+	ids := data.SegmentIds()
+	for i, id := range ids {
+		segment := data.StrongRefSegment(id)
+		if segment == nil {
+			continue
+		}
+		if segment.GetType() == base.SORTED_SEG {
+			if r, ok := segmentRange(segment, pk); ok {
+				sortedRanges = append(sortedRanges, r)
+			}
+			// the tail segment is never merged
+			if i == len(ids)-1 || !segment.GetMeta().IsSorted() {
+				segment.Unref()
+				closeGroup()
+				continue
+			}
+			rows := segRows
+			if deletes := segmentDeletes(segment); deletes != nil {
+				rows -= deletes.GetCardinality()
+			}
+			size := segment.GetSegmentFile().Stat().Size()
+			segment.Unref()
+			if group != nil && groupRows+rows > segRows {
+				closeGroup()
+			}
+			if group == nil {
+				group = &CompactionCandidate{
+					TableID:   meta.Id,
+					SegmentID: id,
+					Age:       now.Sub(c.firstSeen(id, now)),
+				}
+			}
+			group.Merged = append(group.Merged, id)
+			group.Size += size
+			groupRows += rows
+			continue
+		}
+		closeGroup()
+		if !segment.CanUpgrade() {
+			segment.Unref()
+			continue
+		}
+		candidate := &CompactionCandidate{
+			TableID:   meta.Id,
+			SegmentID: id,
+			Age:       now.Sub(c.firstSeen(id, now)),
+		}
+		segMeta := segment.GetMeta()
+		file := segment.GetSegmentFile()
+		blkIds := segment.BlockIds()
+		for _, blkId := range blkIds {
+			if blk := segMeta.SimpleGetBlock(blkId); blk != nil {
+				candidate.Size += file.GetBlockSize(*blk.AsCommonID())
+			}
+		}
+		if deletes := segmentDeletes(segment); deletes != nil && len(blkIds) > 0 {
+			candidate.DeletedRatio = float64(deletes.GetCardinality()) / float64(uint64(len(blkIds))*maxRows)
+		}
+		r, _ := segmentRange(segment, pk)
+		segment.Unref()
+		candidates = append(candidates, candidate)
+		ranges = append(ranges, r)
+	}
+	closeGroup()
+
+	var maxSize int64
+	for _, candidate := range candidates {
+		if candidate.Size > maxSize {
+			maxSize = candidate.Size
+		}
+	}
+	for i, candidate := range candidates {
+		if ranges[i][0] != nil && len(sortedRanges) > 0 {
+			overlaps := 0
+			for _, r := range sortedRanges {
+				if rangeOverlaps(ranges[i], r) {
+					overlaps++
+				}
+			}
+			candidate.Overlap = float64(overlaps) / float64(len(sortedRanges))
+		}
+		age := float64(candidate.Age) / float64(compactAgeHorizon)
+		if age > 1 {
+			age = 1
+		}
+		// the smaller segments are merged first, they are the cheaper ones
+		var size float64
+		if maxSize > 0 {
+			size = 1 - float64(candidate.Size)/float64(maxSize)
+		}
+		candidate.Score = compactDeletedWeight*candidate.DeletedRatio +
+			compactOverlapWeight*candidate.Overlap +
+			compactAgeWeight*age +
+			compactSizeWeight*size
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates, nil
+}
+
+func (c *Compactor) firstSeen(id uint64, now time.Time) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seen, ok := c.seen[id]
+	if !ok {
+		c.seen[id] = now
+		seen = now
+	}
+	return seen
+}
+
+// segmentRange returns the primary key range of the segment from the zone
+// maps of its blocks
+func segmentRange(segment iface.ISegment, pk int) (r [2]interface{}, ok bool) {
+	mins, maxs, err := segment.GetIndexHolder().CollectMinMax(pk)
+	if err != nil || len(mins) == 0 {
+		return
+	}
+	r = [2]interface{}{mins[0], maxs[0]}
+	for i := 1; i < len(mins); i++ {
+		if common.CompareInterface(mins[i], r[0]) < 0 {
+			r[0] = mins[i]
+		}
+		if common.CompareInterface(maxs[i], r[1]) > 0 {
+			r[1] = maxs[i]
+		}
+	}
+	return r, true
+}
+
+func rangeOverlaps(a, b [2]interface{}) bool {
+	return common.CompareInterface(a[0], b[1]) <= 0 && common.CompareInterface(b[0], a[1]) <= 0
+}
+
+// Compact merges the candidates one after another through the scheduler.
+// Every merge waits for the IO budget of each column it reads. The merge
+// of an unsorted segment is done once its sorted segment file is flushed
+// and the upgrade of the segment follows, the merge of sorted segments is
+// done once they are replaced by the merged one. The candidates merged
+// meanwhile by others are skipped.
+func (c *Compactor) Compact(candidates []*CompactionCandidate, manual bool) []*CompactionJob {
+	jobs := make([]*CompactionJob, 0, len(candidates))
+	for _, candidate := range candidates {
+		if c.db.IsClosed() {
+			break
+		}
+		if job := c.compact(candidate, manual); job != nil {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+func (c *Compactor) compact(candidate *CompactionCandidate, manual bool) *CompactionJob {
+	data, err := c.db.Store.DataTables.StrongRefTable(candidate.TableID)
+	if err != nil {
+		return nil
+	}
+	defer data.Unref()
+	ids, typ := candidate.Merged, base.SORTED_SEG
+	if len(ids) == 0 {
+		ids, typ = []uint64{candidate.SegmentID}, base.UNSORTED_SEG
+	}
+	segments := make([]iface.ISegment, 0, len(ids))
+	release := func() {
+		for _, segment := range segments {
+			segment.Unref()
+		}
+	}
+	for _, id := range ids {
+		segment := data.StrongRefSegment(id)
+		if segment == nil {
+			release()
+			return nil
+		}
+		segments = append(segments, segment)
+		if segment.GetType() != typ {
+			release()
+			return nil
+		}
+	}
+	job := &CompactionJob{
+		CompactionCandidate: *candidate,
+		Manual:              manual,
+		Started:             time.Now(),
+	}
+	throttle := func(n int64) bool {
+		return c.limiter.Wait(n, c.db.ClosedC)
+	}
+	ctx := &sched.Context{Opts: c.db.Opts, Waitable: true}
+	c.mu.Lock()
+	c.running[job.SegmentID] = job
+	c.mu.Unlock()
+	if typ == base.UNSORTED_SEG {
+		e := sched.NewFlushSegEvent(ctx, segments[0])
+		e.Throttle = throttle
+		if err = c.db.Scheduler.Schedule(e); err == nil {
+			job.Err = e.WaitDone()
+			job.Written = e.Size
+		}
+	} else {
+		data.Ref()
+		e := sched.NewMergeSegEvent(ctx, data, segments)
+		e.Throttle = throttle
+		if err = c.db.Scheduler.Schedule(e); err == nil {
+			job.Err = e.WaitDone()
+			job.Written = e.Size
+		} else {
+			data.Unref()
+		}
+	}
+	if err != nil {
+		release()
+		job.Err = err
+	}
+	job.Finished = time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.running, job.SegmentID)
+	if err == sched.ErrSegmentMerging {
+		return nil
+	}
+	delete(c.seen, job.SegmentID)
+	if len(c.history) == c.cfg.HistorySize {
+		c.history = c.history[1:]
+	}
+	c.history = append(c.history, job)
+	if job.Err != nil {
+		logutil.Warnf("Compaction | Table %d | %s", job.TableID, job.String())
+	} else {
+		logutil.Infof("Compaction | Table %d | %s", job.TableID, job.String())
+	}
+	return job
+}
+
+// Progress returns the running merges and the latest finished ones, the
+// oldest first
+func (c *Compactor) Progress() CompactionProgress {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var progress CompactionProgress
+	for _, job := range c.running {
+		progress.Running = append(progress.Running, *job)
+	}
+	sort.Slice(progress.Running, func(i, j int) bool {
+		return progress.Running[i].Started.Before(progress.Running[j].Started)
+	})
+	for _, job := range c.history {
+		progress.History = append(progress.History, *job)
+	}
+	return progress
+}
+
+// OnExec plans all the tables and merges the best candidates scored no
+// lower than MinScore
+func (c *Compactor) OnExec() {
+	var tables []*metadata.Table
+	c.db.Store.DataTables.ForTables(func(data iface.ITableData) error {
+		tables = append(tables, data.GetMeta())
+		return nil
+	})
+	var candidates []*CompactionCandidate
+	seen := make(map[uint64]time.Time)
+	for _, meta := range tables {
+		if meta.IsDeleted() {
+			continue
+		}
+		planned, err := c.Plan(meta)
+		if err != nil {
+			continue
+		}
+		for _, candidate := range planned {
+			seen[candidate.SegmentID] = c.firstSeen(candidate.SegmentID, time.Now())
+			if candidate.Score >= c.cfg.MinScore {
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+	// forget the segments merged or dropped meanwhile
+	c.mu.Lock()
+	c.seen = seen
+	c.mu.Unlock()
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	if len(candidates) > c.cfg.MaxMerges {
+		candidates = candidates[:c.cfg.MaxMerges]
+	}
+	c.Compact(candidates, false)
+}
+
+func (c *Compactor) OnStopped() {
+	logutil.Infof("Compaction | Stopped | %d merges in history", len(c.Progress().History))
+}
+
+// IOLimiter limits the bytes read by the merges per second. A merge
+// reserves the bytes of every column before it goes on with the next one,
+// and waits until the reservations before it are paid off.
+type IOLimiter struct {
+	mu   sync.Mutex
+	rate uint64
+	next time.Time
+}
+
+// NewIOLimiter returns a limiter of rate bytes per second, 0 is unlimited
+func NewIOLimiter(rate uint64) *IOLimiter {
+	return &IOLimiter{rate: rate}
+}
+
+// Wait reserves n bytes and waits for their turn. It returns false if stop
+// is closed before.
+func (l *IOLimiter) Wait(n int64, stop <-chan struct{}) bool {
+	if l.rate == 0 || n <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(n) / float64(l.rate) * float64(time.Second)))
+	l.mu.Unlock()
+	if wait <= 0 {
+		return true
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+// CompactTable merges all the closed unsorted segments of the table and
+// the sorted segments whose rows left fit in fewer segments
+func (d *DB) CompactTable(dbName, tableName string) ([]*CompactionJob, error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	meta, err := d.Store.Catalog.SimpleGetTableByName(dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	return d.DoCompactTable(meta)
+}
+
+func (d *DB) DoCompactTable(meta *metadata.Table) ([]*CompactionJob, error) {
+	candidates, err := d.Compactor.Plan(meta)
+	if err != nil {
+		return nil, err
+	}
+	return d.Compactor.Compact(candidates, true), nil
+}
+
+func (d *DB) CompactionProgress() CompactionProgress {
+	return d.Compactor.Progress()
+}
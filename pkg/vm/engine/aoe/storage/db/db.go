@@ -15,6 +15,7 @@
 package db
 
 import (
+	"crypto/cipher"
 	"io"
 	"os"
 	"sync"
@@ -56,9 +57,25 @@ type DB struct {
 
 	Wal wal.ShardAwareWal
 
+	// Redo logs the applied appends and DDL for the point-in-time
+	// recovery. It is nil if the archive is not configured.
+	Redo *RedoLog
+
+	// StoreCipher seals the entries of the catalog, the WAL and the redo
+	// log. It is nil if encryption at rest is not configured.
+	StoreCipher cipher.AEAD
+	// keyMu serializes the key rotations
+	keyMu *sync.Mutex
+
 	FlushDriver  flusher.Driver
 	TimedFlusher wb.IHeartbeater
 
+	// Compactor merges the closed unsorted segments, it runs every
+	// CompactionCfg.Interval in CompactionWorker. CompactionWorker is
+	// nil if the background compaction is disabled.
+	Compactor        *Compactor
+	CompactionWorker wb.IHeartbeater
+
 	// Internal data storage of DB.
 	Store struct {
 		Mu         *sync.RWMutex
@@ -306,6 +323,10 @@ func (d *DB) Close() error {
 
 	d.Closed.Store(ErrClosed)
 	close(d.ClosedC)
+	if d.CompactionWorker != nil {
+		// the running merge waits for the scheduler
+		d.CompactionWorker.Stop()
+	}
 	d.Wal.Close()
 	d.Scheduler.Stop()
 	d.stopWorkers()
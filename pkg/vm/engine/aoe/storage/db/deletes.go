@@ -0,0 +1,245 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// segmentDeletes returns the segment-level offsets of the deleted rows of
+// the segment data. The sorted data of a segment is installed before its
+// metadata is upgraded, the deleted rows of the metadata then still refer
+// to the rows before the purge and the purge mask is used instead.
+func segmentDeletes(data iface.ISegment) *roaring.Bitmap {
+	meta := data.GetMeta()
+	if data.GetType() == base.SORTED_SEG && !meta.IsSorted() {
+		return meta.PurgeMask()
+	}
+	return meta.GetDeletes()
+}
+
+// blockDeletes returns the offsets of the deleted rows of the block data,
+// see segmentDeletes.
+func blockDeletes(data iface.IBlock) *roaring.Bitmap {
+	meta := data.GetMeta()
+	if data.GetType() != base.PERSISTENT_SORTED_BLK || meta.Segment.IsSorted() {
+		return meta.GetDeletes()
+	}
+	purged := meta.Segment.PurgeMask()
+	if purged == nil {
+		return nil
+	}
+	maxRows := uint32(meta.Segment.Table.Schema.BlockMaxRows)
+	startPos := meta.Idx * maxRows
+	var deletes *roaring.Bitmap
+	it := purged.Iterator()
+	it.AdvanceIfNeeded(startPos)
+	for it.HasNext() {
+		row := it.Next()
+		if row >= startPos+maxRows {
+			break
+		}
+		if deletes == nil {
+			deletes = roaring.New()
+		}
+		deletes.Add(row - startPos)
+	}
+	return deletes
+}
+
+// MatchedRows are the rows of a block satisfying a predicate, read at the
+// sort version Version of the block.
+type MatchedRows struct {
+	Block   *metadata.Block
+	Version uint64
+	Rows    *roaring.Bitmap
+}
+
+// MatchRows returns the rows of each block of the segment which satisfy
+// the predicate and are neither deleted nor expired. Unlike the sparse
+// filter, the predicate is evaluated exactly, row by row: a comparison with
+// a null is false, an And without arguments is true and an Or without
+// arguments is false.
+func (seg *Segment) MatchRows(p *engine.Predicate) ([]MatchedRows, error) {
+	attrs := make(map[string]struct{})
+	predicateAttrs(p, attrs)
+	var matched []MatchedRows
+	for _, id := range seg.Data.BlockIds() {
+		rows, err := seg.matchBlockRows(id, p, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if rows.Rows != nil && !rows.Rows.IsEmpty() {
+			matched = append(matched, rows)
+		}
+	}
+	return matched, nil
+}
+
+func (seg *Segment) matchBlockRows(id uint64, p *engine.Predicate, attrs map[string]struct{}) (MatchedRows, error) {
+	var res MatchedRows
+	meta := seg.Data.GetMeta().SimpleGetBlock(id)
+	if meta == nil {
+		return res, nil
+	}
+	// The version is read before the data, a sort installing new data
+	// in between makes the delete of the rows fail
+	res.Block, res.Version = meta, meta.SortVersion()
+	data := seg.Data.StrongRefBlock(id)
+	if data == nil {
+		return res, nil
+	}
+	defer data.Unref()
+	schema := meta.Segment.Table.Schema
+	vecs := make(map[string]*vector.Vector, len(attrs))
+	n := int(data.GetRowCount())
+	for attr := range attrs {
+		if schema.GetColIdx(attr) < 0 {
+			return res, errors.New(fmt.Sprintf("column %s not found", attr))
+		}
+		vec, err := data.GetVectorCopy(attr, new(bytes.Buffer), new(bytes.Buffer))
+		if err != nil {
+			return res, err
+		}
+		vecs[attr] = vec
+		n = vector.Length(vec)
+	}
+	masked := blockDeletes(data)
+	expired, err := expiredRows(data)
+	if err != nil {
+		return res, err
+	}
+	res.Rows = roaring.New()
+	for row := 0; row < n; row++ {
+		if (masked != nil && masked.Contains(uint32(row))) ||
+			(expired != nil && expired.Contains(uint32(row))) {
+			continue
+		}
+		ok, err := EvalRow(p, vecs, row)
+		if err != nil {
+			return res, err
+		}
+		if ok {
+			res.Rows.Add(uint32(row))
+		}
+	}
+	return res, nil
+}
+
+func predicateAttrs(p *engine.Predicate, attrs map[string]struct{}) {
+	switch p.Op {
+	case engine.PredAnd, engine.PredOr:
+		for _, arg := range p.Args {
+			predicateAttrs(arg, attrs)
+		}
+	default:
+		attrs[p.Attr] = struct{}{}
+	}
+}
+
+// EvalRow tells if the row of the vectors of the attributes satisfies the
+// predicate, see MatchRows.
+func EvalRow(p *engine.Predicate, vecs map[string]*vector.Vector, row int) (bool, error) {
+	switch p.Op {
+	case engine.PredAnd:
+		for _, arg := range p.Args {
+			if ok, err := EvalRow(arg, vecs, row); !ok || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	case engine.PredOr:
+		for _, arg := range p.Args {
+			if ok, err := EvalRow(arg, vecs, row); ok || err != nil {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+	vec := vecs[p.Attr]
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return false, nil
+	}
+	val := rowValue(vec, row)
+	switch p.Op {
+	case engine.PredEq:
+		return compare(val, p.Vals[0], vec.Typ) == 0, nil
+	case engine.PredNe:
+		return compare(val, p.Vals[0], vec.Typ) != 0, nil
+	case engine.PredLt:
+		return compare(val, p.Vals[0], vec.Typ) < 0, nil
+	case engine.PredLe:
+		return compare(val, p.Vals[0], vec.Typ) <= 0, nil
+	case engine.PredGt:
+		return compare(val, p.Vals[0], vec.Typ) > 0, nil
+	case engine.PredGe:
+		return compare(val, p.Vals[0], vec.Typ) >= 0, nil
+	case engine.PredBtw:
+		return compare(val, p.Vals[0], vec.Typ) >= 0 && compare(val, p.Vals[1], vec.Typ) <= 0, nil
+	case engine.PredIn:
+		for _, v := range p.Vals {
+			if compare(val, v, vec.Typ) == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	case engine.PredPrefix:
+		return bytes.HasPrefix(val.([]byte), p.Vals[0].([]byte)), nil
+	}
+	return false, errors.New(fmt.Sprintf("unsupported predicate %v", p.Op))
+}
+
+func rowValue(vec *vector.Vector, row int) interface{} {
+	switch col := vec.Col.(type) {
+	case []int8:
+		return col[row]
+	case []int16:
+		return col[row]
+	case []int32:
+		return col[row]
+	case []int64:
+		return col[row]
+	case []uint8:
+		return col[row]
+	case []uint16:
+		return col[row]
+	case []uint32:
+		return col[row]
+	case []uint64:
+		return col[row]
+	case []float32:
+		return col[row]
+	case []float64:
+		return col[row]
+	case []types.Date:
+		return col[row]
+	case []types.Datetime:
+		return col[row]
+	case *types.Bytes:
+		return col.Get(int64(row))
+	}
+	panic("unsupported")
+}
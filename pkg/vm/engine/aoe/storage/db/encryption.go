@@ -0,0 +1,146 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"crypto/cipher"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
+)
+
+// StoreKeyName is the file in the meta dir holding the wrapped data key
+// which seals the entries of the catalog, the WAL and the redo log
+const StoreKeyName = "store.key"
+
+// openStoreCipher returns the cipher sealing the log entries of the db in
+// dirname. The store key is made at the first open with a keyring, the
+// entries are not sealed if there is neither the key nor the keyring.
+func openStoreCipher(dirname string, keyring *encryption.Keyring) (cipher.AEAD, error) {
+	name := path.Join(common.MakeMetaDir(dirname), StoreKeyName)
+	wrapped, err := os.ReadFile(name)
+	if os.IsNotExist(err) {
+		if keyring == nil {
+			return nil, nil
+		}
+		if wrapped, err = keyring.NewDataKey(); err != nil {
+			return nil, err
+		}
+		if err = writeStoreKey(name, wrapped); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else if keyring == nil {
+		return nil, encryption.ErrNoKeyring
+	}
+	key, err := keyring.Unwrap(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewAEAD(key)
+}
+
+// RotateKey appends a new master key to the key file, then the store key
+// and the data keys of the tables are wrapped by it. The plain keys are
+// unchanged, so the files and the log entries are not encrypted again. It
+// returns the id of the new master key.
+func (d *DB) RotateKey() (uint32, error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	keyring := d.Opts.Keyring
+	if keyring == nil {
+		return 0, encryption.ErrNoKeyring
+	}
+	d.keyMu.Lock()
+	defer d.keyMu.Unlock()
+	id, err := keyring.Rotate()
+	if err != nil {
+		return 0, err
+	}
+	if err = rewrapStoreKey(d.Dir, keyring); err != nil {
+		return 0, err
+	}
+	if err = d.Store.Catalog.RewrapDataKeys(); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// rewrapStoreKey wraps the store key of the db in dirname by the current
+// master key, nothing is done if the db has no store key
+func rewrapStoreKey(dirname string, keyring *encryption.Keyring) error {
+	name := path.Join(common.MakeMetaDir(dirname), StoreKeyName)
+	wrapped, err := os.ReadFile(name)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if keyring.IsCurrent(wrapped) {
+		return nil
+	}
+	if wrapped, err = keyring.Rewrap(wrapped); err != nil {
+		return err
+	}
+	return writeStoreKey(name, wrapped)
+}
+
+func writeStoreKey(name string, wrapped []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), os.FileMode(0755)); err != nil {
+		return err
+	}
+	tmp := name + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(wrapped); err == nil {
+		err = f.Sync()
+	}
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// dataKey returns the data key of the table, nil if the table is not
+// encrypted
+func (d *DB) dataKey(tableId uint64) ([]byte, error) {
+	return d.Store.Catalog.DataKey(tableId)
+}
+
+// objectDataKey returns the data key of the table of the segment object
+// in the file service
+func (d *DB) objectDataKey(object string) ([]byte, error) {
+	name, ok := common.ParseSegmentFileName(path.Base(object))
+	if !ok {
+		return nil, fmt.Errorf("unexpected object %s", object)
+	}
+	id, err := common.ParseSegmentNameToID(name)
+	if err != nil {
+		return nil, err
+	}
+	return d.dataKey(id.TableID)
+}
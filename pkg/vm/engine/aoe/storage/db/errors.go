@@ -29,4 +29,5 @@ var (
 	ErrStaleErr          = errors.New("aoe: stale")
 	ErrIdempotence       = metadata.IdempotenceErr
 	ErrResourceDeleted   = errors.New("aoe: resource is deleted")
+	ErrRedoDisabled      = errors.New("aoe: redo log is disabled")
 )
@@ -0,0 +1,156 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcreqs
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/gc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/ops"
+)
+
+// ttlRequest drops the closed segments of the tables with a TTL whose rows
+// are all past the horizon. The rows of the partially expired segments are
+// masked when they are read.
+type ttlRequest struct {
+	gc.BaseRequest
+	catalog    *metadata.Catalog
+	tables     *table.Tables
+	interval   time.Duration
+	lastExecTS int64
+}
+
+func NewTTLRequest(catalog *metadata.Catalog, tables *table.Tables, interval time.Duration) *ttlRequest {
+	req := new(ttlRequest)
+	req.catalog = catalog
+	req.tables = tables
+	req.interval = interval
+	req.Op = ops.Op{
+		Impl:   req,
+		ErrorC: make(chan error),
+	}
+	return req
+}
+
+func (req *ttlRequest) IncIteration() {}
+
+func (req *ttlRequest) updateExecTS() { req.lastExecTS = time.Now().UnixMilli() }
+func (req *ttlRequest) checkInterval() bool {
+	now := time.Now().UnixMilli()
+	return now-req.lastExecTS >= req.interval.Milliseconds()
+}
+
+func (req *ttlRequest) Execute() error {
+	req.Next = req
+	if !req.checkInterval() {
+		return nil
+	}
+	return req.DoRun()
+}
+
+func (req *ttlRequest) DoRun() error {
+	tables := make([]*metadata.Table, 0, 4)
+	processor := new(metadata.LoopProcessor)
+	processor.TableFn = func(t *metadata.Table) error {
+		if t.Schema.TTLDays > 0 && !t.IsDeleted() {
+			tables = append(tables, t)
+		}
+		return nil
+	}
+	processor.DatabaseFn = func(database *metadata.Database) error {
+		if database.IsDeleted() {
+			return nil
+		}
+		database.RLock()
+		defer database.RUnlock()
+		return database.LoopLocked(processor)
+	}
+	req.catalog.RLock()
+	req.catalog.LoopLocked(processor)
+	req.catalog.RUnlock()
+	for _, meta := range tables {
+		req.expireTable(meta)
+	}
+	req.updateExecTS()
+	return nil
+}
+
+func (req *ttlRequest) expireTable(meta *metadata.Table) {
+	colIdx, horizon, ok := meta.Schema.TTLHorizon()
+	if !ok {
+		return
+	}
+	data, err := req.tables.StrongRefTable(meta.Id)
+	if err != nil {
+		return
+	}
+	defer data.Unref()
+	for _, id := range data.SegmentIds() {
+		segment := data.StrongRefSegment(id)
+		if segment == nil {
+			continue
+		}
+		// the unsorted segments are dropped once all of their blocks are
+		// flushed, unless they are being sorted
+		closed := segment.GetType() == base.SORTED_SEG || segment.CanUpgrade()
+		if closed && segmentExpired(segment, colIdx, horizon) {
+			req.dropSegment(data, segment)
+		}
+		segment.Unref()
+	}
+}
+
+// segmentExpired returns true if the max value of the TTL column of every
+// block of the segment is past the horizon
+func segmentExpired(segment iface.ISegment, colIdx int, horizon interface{}) bool {
+	_, maxs, err := segment.GetIndexHolder().CollectMinMax(colIdx)
+	if err != nil || len(maxs) == 0 || len(maxs) != len(segment.BlockIds()) {
+		return false
+	}
+	for _, max := range maxs {
+		if max == nil || common.CompareInterface(max, horizon) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (req *ttlRequest) dropSegment(data iface.ITableData, segment iface.ISegment) {
+	meta := segment.GetMeta()
+	rows := meta.GetRowCount()
+	size := segment.GetSegmentFile().Stat().Size()
+	if err := meta.SimpleSoftDelete(); err != nil {
+		// the segment being sorted is dropped by a later run
+		if err != metadata.BlockSortingErr {
+			logutil.Warnf("%s | TTL | %s", meta.AsCommonID().SegmentString(), err)
+		}
+		return
+	}
+	dropped, err := data.DropSegment(meta.Id)
+	if err != nil {
+		logutil.Warnf("%s | TTL | %s", meta.AsCommonID().SegmentString(), err)
+		return
+	}
+	dropped.Unref()
+	metric.Expired(int64(rows), size)
+	logutil.Infof("%s | Expired | [GC] rows=%d size=%d", meta.AsCommonID().SegmentString(), rows, size)
+}
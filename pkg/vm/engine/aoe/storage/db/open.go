@@ -18,6 +18,7 @@ import (
 	"os"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
 	bm "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/manager"
@@ -25,6 +26,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/factories"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/gcreqs"
 	sched "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/flusher"
 	ldio "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	table "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
@@ -56,11 +59,36 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 	}()
 	opts.FillDefaults(dirname)
 
+	if opts.FileService == nil && opts.FileServiceCfg != nil {
+		if opts.FileService, err = fileservice.New(opts.FileServiceCfg, dirname); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Keyring == nil && opts.EncryptionCfg != nil {
+		if opts.Keyring, err = encryption.OpenKeyring(opts.EncryptionCfg.KeyFile); err != nil {
+			return nil, err
+		}
+	}
+	storeCipher, err := openStoreCipher(dirname, opts.Keyring)
+	if err != nil {
+		return nil, err
+	}
+
 	flushDriver := flusher.NewDriver()
 
 	fsMgr := ldio.NewManager(dirname, false)
-	indexBufMgr := bm.NewBufferManager(dirname, opts.CacheCfg.IndexCapacity)
-	sstBufMgr := bm.NewBufferManager(dirname, opts.CacheCfg.DataCapacity)
+	if opts.FileService != nil {
+		fsMgr.FS = opts.FileService
+	}
+	indexBufMgr, err := bm.NewBufferManagerWithPolicy(dirname, opts.CacheCfg.IndexCapacity, opts.CacheCfg.EvictPolicy)
+	if err != nil {
+		return nil, err
+	}
+	sstBufMgr, err := bm.NewBufferManagerWithPolicy(dirname, opts.CacheCfg.DataCapacity, opts.CacheCfg.EvictPolicy)
+	if err != nil {
+		return nil, err
+	}
 
 	mutNodeMgr := mb.NewNodeManager(opts.CacheCfg.InsertCapacity, nil)
 	mtBufMgr := bm.NewBufferManager(dirname, opts.CacheCfg.InsertCapacity)
@@ -75,7 +103,13 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 		MutationBufMgr: mutNodeMgr,
 		ClosedC:        make(chan struct{}),
 		Closed:         new(atomic.Value),
+		StoreCipher:    storeCipher,
+		keyMu:          new(sync.Mutex),
 	}
+	fsMgr.FS = encryption.NewFS(fsMgr.FS, db.objectDataKey)
+	fsMgr.Keys = db.dataKey
+	indexBufMgr.TableCache = db.tableCache
+	sstBufMgr.TableCache = db.tableCache
 
 	db.Store.Mu = &opts.Mu
 	db.Store.DataTables = table.NewTables(opts, &opts.Mu, db.FsMgr, db.MTBufMgr, db.SSTBufMgr, db.IndexBufMgr, flushDriver)
@@ -85,7 +119,16 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 	flushDriver.InitFactory(createFlusherFactory(db.Store.DataTables))
 	db.FlushDriver = flushDriver
 
-	store, err := logstore.NewBatchStore(common.MakeMetaDir(dirname), "store", nil)
+	rotationCfg := &logstore.RotationCfg{
+		Cipher: storeCipher,
+	}
+	if opts.ArchiveCfg != nil {
+		rotationCfg.RotateChecker = &logstore.MaxSizeRotationChecker{
+			MaxSize: opts.ArchiveCfg.RotationFileMaxSize,
+		}
+		rotationCfg.Observer = logstore.NewArchiver(opts.ArchiveCfg.Dir)
+	}
+	store, err := logstore.NewBatchStore(common.MakeMetaDir(dirname), metaStoreName, rotationCfg)
 	if err != nil {
 		return
 	}
@@ -94,6 +137,10 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 	}
 	db.Wal = db.Opts.Wal
 
+	if opts.ArchiveCfg != nil {
+		db.Redo = NewRedoLog(store)
+	}
+
 	db.TimedFlusher = w.NewHeartBeater(DefaultFlushInterval, &timedFlusherHandle{
 		driver:   flushDriver,
 		producer: db.Wal,
@@ -103,6 +150,10 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 		Dir:              dirname,
 		BlockMaxRows:     opts.Meta.Conf.BlockMaxRows,
 		SegmentMaxBlocks: opts.Meta.Conf.SegmentMaxBlocks,
+		Keyring:          opts.Keyring,
+	}
+	if opts.EncryptionCfg != nil {
+		catalogCfg.EncryptTables = opts.EncryptionCfg.Default
 	}
 	if opts.Meta.Catalog, err = metadata.OpenCatalogWithDriver(new(sync.RWMutex), &catalogCfg, store, db.Wal); err != nil {
 		return
@@ -114,7 +165,7 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 	db.Scheduler = db.Opts.Scheduler
 
 	db.startWorkers()
-	replayHandle := NewReplayHandle(dirname, opts.Meta.Catalog, db.Store.DataTables, nil)
+	replayHandle := NewReplayHandleWithFileService(dirname, opts.Meta.Catalog, db.Store.DataTables, nil, opts.FileService)
 	if err = replayHandle.Replay(); err != nil {
 		opts.Meta.Catalog.Close()
 		db.stopWorkers()
@@ -124,7 +175,15 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 	db.DBLocker, dbLocker = dbLocker, nil
 	replayHandle.ScheduleEvents(db.Opts, db.Store.DataTables)
 
+	db.Compactor = NewCompactor(db, opts.CompactionCfg)
+	if opts.CompactionCfg != nil {
+		interval := time.Duration(opts.CompactionCfg.Interval) * time.Second
+		db.CompactionWorker = w.NewHeartBeater(interval, db.Compactor)
+		db.CompactionWorker.Start()
+	}
+
 	db.Opts.GC.Acceptor.Accept(gcreqs.NewCatalogCompactionRequest(db.Store.Catalog, db.Opts.MetaCleanerCfg.Interval))
+	db.Opts.GC.Acceptor.Accept(gcreqs.NewTTLRequest(db.Store.Catalog, db.Store.DataTables, db.Opts.TTLCleanerCfg.Interval))
 	os.RemoveAll(db.GetTempDir())
 	os.MkdirAll(db.GetTempDir(), os.FileMode(0755))
 	return db, err
@@ -0,0 +1,266 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/protocol"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+)
+
+// the store of the catalog and the shard wal, the redo entries are logged in it
+const metaStoreName = "store"
+
+var (
+	// ErrStopReplay is returned by the RedoHandler to stop the replay
+	ErrStopReplay = errors.New("aoe: stop replay")
+)
+
+// RedoEntry is an append or a DDL logged for the point-in-time recovery
+type RedoEntry struct {
+	Type logstore.EntryType
+	// Unix time in nanoseconds when the entry is logged
+	Ts    int64
+	DB    string
+	Table string
+	Index LogIndex
+	// Data is the rows of wal.ETRedoAppend
+	Data *batch.Batch
+	redoDDL
+}
+
+// redoDDL is the DDL of the entry, marshalled in json
+type redoDDL struct {
+	// Schema is the table of wal.ETRedoCreateTable
+	Schema *TableSchema `json:"schema,omitempty"`
+	// Indice is the indices of wal.ETRedoCreateTable and wal.ETRedoCreateIndex
+	Indice *IndexSchema `json:"indice,omitempty"`
+	// IndexNames is the indices of wal.ETRedoDropIndex
+	IndexNames []string `json:"names,omitempty"`
+	// Predicate is the encoded predicate of wal.ETRedoDelete
+	Predicate []byte `json:"predicate,omitempty"`
+}
+
+// redoIndexSize is the size of a marshalled LogIndex
+const redoIndexSize = 48
+
+type RedoHandler = func(*RedoEntry) error
+
+func (e *RedoEntry) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeInt64(e.Ts))
+	buf.Write(encoding.EncodeUint32(uint32(len(e.DB))))
+	buf.WriteString(e.DB)
+	buf.Write(encoding.EncodeUint32(uint32(len(e.Table))))
+	buf.WriteString(e.Table)
+	index, _ := e.Index.Marshal()
+	buf.Write(encoding.EncodeUint32(uint32(len(index))))
+	buf.Write(index)
+	ddl, err := json.Marshal(&e.redoDDL)
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(encoding.EncodeUint32(uint32(len(ddl))))
+	buf.Write(ddl)
+	if e.Type == wal.ETRedoAppend {
+		if err := protocol.EncodeBatch(e.Data, &buf); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes the payload of the entry, the Type is set by the caller
+func (e *RedoEntry) Unmarshal(data []byte) error {
+	if len(data) < 8 {
+		return fmt.Errorf("aoe: redo entry of %d bytes is too short for the timestamp", len(data))
+	}
+	e.Ts = encoding.DecodeInt64(data[:8])
+	data = data[8:]
+	database, data, err := redoField(data, "database")
+	if err != nil {
+		return err
+	}
+	e.DB = string(database)
+	table, data, err := redoField(data, "table")
+	if err != nil {
+		return err
+	}
+	e.Table = string(table)
+	index, data, err := redoField(data, "index")
+	if err != nil {
+		return err
+	}
+	if len(index) != 0 && len(index) != redoIndexSize {
+		return fmt.Errorf("aoe: redo entry has an index of %d bytes, %d bytes expected", len(index), redoIndexSize)
+	}
+	if err = e.Index.UnMarshal(index); err != nil {
+		return err
+	}
+	ddl, data, err := redoField(data, "ddl")
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(ddl, &e.redoDDL); err != nil {
+		return err
+	}
+	if e.Type == wal.ETRedoAppend {
+		bat, _, err := protocol.DecodeBatch(data)
+		if err != nil {
+			return err
+		}
+		e.Data = bat
+	}
+	return nil
+}
+
+// redoField returns the length prefixed field at the head of data and the rest of data
+func redoField(data []byte, name string) ([]byte, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("aoe: redo entry is truncated before the length of the %s", name)
+	}
+	n := int(encoding.DecodeUint32(data[:4]))
+	if len(data)-4 < n {
+		return nil, nil, fmt.Errorf("aoe: redo entry is truncated in the %s: %d bytes expected, %d left", name, n, len(data)-4)
+	}
+	return data[4 : 4+n], data[4+n:], nil
+}
+
+// RedoLog logs the applied appends and DDL into the store of the catalog.
+// The entries are written by the store in background, nobody waits for
+// them. The rotated versions of the store are archived, so the entries
+// after a snapshot can be replayed.
+type RedoLog struct {
+	store logstore.Store
+}
+
+func NewRedoLog(store logstore.Store) *RedoLog {
+	return &RedoLog{store: store}
+}
+
+// Log enqueues the entry, it must be called after the entry is applied
+func (l *RedoLog) Log(e *RedoEntry) error {
+	if e.Ts == 0 {
+		e.Ts = time.Now().UnixNano()
+	}
+	buf, err := e.Marshal()
+	if err != nil {
+		return err
+	}
+	// the entry is not freed to the pool as it is written asynchronously
+	entry := logstore.NewAsyncBaseEntry()
+	entry.Meta.SetType(e.Type)
+	if err = entry.Unmarshal(buf); err != nil {
+		return err
+	}
+	return l.store.AppendEntry(entry)
+}
+
+// Sync waits until the entries logged before are written
+func (l *RedoLog) Sync() error {
+	entry := logstore.NewAsyncBaseEntry()
+	defer entry.Free()
+	entry.Meta.SetType(logstore.ETFlush)
+	if err := l.store.AppendEntry(entry); err != nil {
+		return err
+	}
+	return entry.WaitDone()
+}
+
+// ReplayRedoLog replays the synced redo entries of both the archived and the
+// current versions of the store of the db in dirname in order, until the
+// handler returns ErrStopReplay.
+func ReplayRedoLog(dirname string, cfg *storage.ArchiveCfg, aead cipher.AEAD, handler RedoHandler) error {
+	versions, err := logstore.LoadVersionFiles(metaStoreName, cfg.Dir, common.MakeMetaDir(dirname))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, version := range versions {
+			version.Close()
+		}
+	}()
+	// the entries are synced when the following flush entry is written,
+	// which may be in the next version
+	uncommitted := make([]*RedoEntry, 0)
+	for _, version := range versions {
+		if uncommitted, err = replayRedoVersion(version, aead, uncommitted, handler); err != nil {
+			if err == ErrStopReplay {
+				return nil
+			}
+			return err
+		}
+	}
+	if len(uncommitted) > 0 {
+		logutil.Warnf("%d unsynced redo entries are skipped", len(uncommitted))
+	}
+	return nil
+}
+
+func replayRedoVersion(version *logstore.VersionFile, aead cipher.AEAD, uncommitted []*RedoEntry, handler RedoHandler) ([]*RedoEntry, error) {
+	meta := logstore.NewEntryMeta()
+	for {
+		if _, err := io.ReadFull(version, meta.Buf); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return nil, err
+		}
+		r, err := logstore.OpenEntry(aead, meta, version)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		payload := make([]byte, meta.PayloadSize())
+		if _, err := io.ReadFull(r, payload); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return nil, err
+		}
+		typ := meta.GetType()
+		switch {
+		case typ == logstore.ETFlush:
+			for _, entry := range uncommitted {
+				if err := handler(entry); err != nil {
+					return nil, err
+				}
+			}
+			uncommitted = uncommitted[:0]
+		case wal.IsRedoEntry(typ):
+			entry := &RedoEntry{Type: typ}
+			if err := entry.Unmarshal(payload); err != nil {
+				return nil, err
+			}
+			uncommitted = append(uncommitted, entry)
+		}
+		// the entries of the catalog are skipped
+	}
+	return uncommitted, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedoEntryUnmarshal(t *testing.T) {
+	e := &RedoEntry{
+		Type:    wal.ETRedoDropIndex,
+		Ts:      1,
+		DB:      "db",
+		Table:   "t",
+		Index:   LogIndex{ShardId: 2, Count: 3},
+		redoDDL: redoDDL{IndexNames: []string{"idx"}},
+	}
+	data, err := e.Marshal()
+	assert.Nil(t, err)
+	decoded := &RedoEntry{Type: e.Type}
+	assert.Nil(t, decoded.Unmarshal(data))
+	assert.Equal(t, e, decoded)
+
+	// a truncated entry is an error rather than a panic
+	for i := 0; i < len(data); i++ {
+		assert.NotNil(t, (&RedoEntry{Type: e.Type}).Unmarshal(data[:i]))
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/gcreqs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -180,6 +181,10 @@ type sortedSegmentFile struct {
 	h    *replayHandle
 	name string
 	id   common.ID
+	// remote is the file service of the segment file uploaded, the name
+	// is the object name then. It is nil for the local segment file.
+	remote fileservice.FileService
+	osize  int64
 }
 
 type unsortedSegmentFile struct {
@@ -210,10 +215,17 @@ func newUnsortedSegmentFile(id common.ID, h *replayHandle) *unsortedSegmentFile
 }
 
 func (sf *sortedSegmentFile) clean() {
+	if sf.remote != nil {
+		sf.h.doRemoveObject(sf.remote, sf.name)
+		return
+	}
 	sf.h.doRemove(sf.name)
 }
 
 func (sf *sortedSegmentFile) size() int64 {
+	if sf.remote != nil {
+		return sf.osize
+	}
 	stat, _ := os.Stat(sf.name)
 	return stat.Size()
 }
@@ -393,6 +405,13 @@ type replayHandle struct {
 }
 
 func NewReplayHandle(workDir string, catalog *metadata.Catalog, tables *table.Tables, observer IReplayObserver) *replayHandle {
+	return NewReplayHandleWithFileService(workDir, catalog, tables, observer, nil)
+}
+
+// NewReplayHandleWithFileService makes the replay handle of the db whose sorted
+// segment files are uploaded to the remote file service
+func NewReplayHandleWithFileService(workDir string, catalog *metadata.Catalog, tables *table.Tables,
+	observer IReplayObserver, remote fileservice.FileService) *replayHandle {
 	fs := &replayHandle{
 		catalog:    catalog,
 		tables:     tables,
@@ -420,7 +439,13 @@ func NewReplayHandle(workDir string, catalog *metadata.Catalog, tables *table.Ta
 	if err != nil {
 		panic(fmt.Sprintf("err: %s", err))
 	}
-	if empty {
+	var objects []fileservice.ObjectInfo
+	if remote != nil {
+		if objects, err = remote.List(common.DataDirName + "/"); err != nil {
+			panic(fmt.Sprintf("err: %s", err))
+		}
+	}
+	if empty && len(objects) == 0 {
 		return fs
 	}
 
@@ -449,6 +474,10 @@ func NewReplayHandle(workDir string, catalog *metadata.Catalog, tables *table.Ta
 		}
 	}
 
+	// the uploaded segment files take the place of the local ones
+	for _, obj := range objects {
+		fs.addObject(remote, obj)
+	}
 	for _, file := range dataFiles {
 		fs.addDataFile(file.Name())
 	}
@@ -540,9 +569,13 @@ func (h *replayHandle) addSegment(id common.ID, name string) {
 		}
 		h.files[id.TableID] = tbl
 	}
-	_, ok = tbl.sortedfiles[id]
-	if ok {
-		panic("logic error")
+	if file, ok := tbl.sortedfiles[id]; ok {
+		if file.remote == nil {
+			panic("logic error")
+		}
+		// a crash happened after the segment file was uploaded
+		h.others = append(h.others, name)
+		return
 	}
 	tbl.sortedfiles[id] = &sortedSegmentFile{
 		h:    h,
@@ -551,6 +584,22 @@ func (h *replayHandle) addSegment(id common.ID, name string) {
 	}
 }
 
+// addObject adds the segment file uploaded to the file service
+func (h *replayHandle) addObject(remote fileservice.FileService, obj fileservice.ObjectInfo) {
+	name, ok := common.ParseSegmentFileName(path.Base(obj.Name))
+	if !ok {
+		return
+	}
+	id, err := common.ParseSegmentNameToID(name)
+	if err != nil {
+		panic(err)
+	}
+	h.addSegment(id, obj.Name)
+	file := h.files[id.TableID].sortedfiles[id]
+	file.remote = remote
+	file.osize = obj.Size
+}
+
 // addBSI basically means replace if exists
 func (h *replayHandle) addBSI(id common.ID, filename string) {
 	tbl, ok := h.files[id.TableID]
@@ -680,6 +729,21 @@ func (h *replayHandle) rebuildTable(meta *metadata.Table) error {
 			continue
 		}
 	}
+	for id, sorted := range tablesFiles.sortedfiles {
+		if meta.SimpleGetSegment(id.SegmentID) == nil {
+			// The segments dropped by the TTL are removed from the metadata before
+			// their files are released, the files are left if a crash happened
+			// in between
+			h.addCleanable(sorted)
+			delete(tablesFiles.sortedfiles, id)
+		}
+	}
+	for id, bsi := range tablesFiles.bsifiles {
+		if meta.SimpleGetSegment(id.SegmentID) == nil {
+			h.addCleanable(bsi)
+			delete(tablesFiles.bsifiles, id)
+		}
+	}
 	for id, _ := range tablesFiles.sortedfiles {
 		unsorted, ok := tablesFiles.unsortedfiles[id]
 		if ok {
@@ -812,6 +876,17 @@ func (h *replayHandle) doRemove(name string) {
 	logutil.Infof("%s | Removed", name)
 }
 
+func (h *replayHandle) doRemoveObject(remote fileservice.FileService, name string) {
+	if err := remote.Delete(name); err != nil {
+		logutil.Warnf("%s | Remove | %s", name, err)
+		return
+	}
+	if h.observer != nil {
+		h.observer.OnRemove(name)
+	}
+	logutil.Infof("%s | Removed", name)
+}
+
 func (h *replayHandle) cleanupFile(fname string) {
 	h.doRemove(fname)
 }
@@ -51,6 +51,10 @@ func (e *flushBlockIndexEvent) Execute() error {
 	defer e.Block.Unref()
 	meta := e.Block.GetMeta()
 	dir := e.Block.GetSegmentFile().GetDir()
+	key, err := meta.Segment.Table.DataKey()
+	if err != nil {
+		return err
+	}
 	bsiEnabled := make([]int, 0)
 	schema := meta.Segment.Table.Schema
 	indice := meta.Segment.Table.GetIndexSchema()
@@ -95,7 +99,7 @@ func (e *flushBlockIndexEvent) Execute() error {
 		version := e.Block.GetIndexHolder().AllocateVersion(colIdx)
 		filename := common.MakeBlockBitSlicedIndexFileName(version, meta.Segment.Table.Id, meta.Segment.Id, meta.Id, uint16(colIdx))
 		filename = filepath.Join(filepath.Join(dir, "data"), filename)
-		if err := index.DefaultRWHelper.FlushBitSlicedIndex(bsi.(index.Index), filename); err != nil {
+		if err := index.DefaultRWHelper.FlushBitSlicedIndex(bsi.(index.Index), filename, key); err != nil {
 			panic(err)
 		}
 		logutil.Infof("[BLK] BSI Flushed | %s", filename)
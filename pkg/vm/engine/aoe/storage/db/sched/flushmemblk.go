@@ -16,6 +16,7 @@ package sched
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -69,6 +70,11 @@ func (e *flushMemblockEvent) Execute() error {
 		err := bw.Execute()
 		meta.Segment.Table.UpdateFlushTS()
 		meta.SetSize(bw.GetSize())
-		return err
+		if err != nil {
+			meta.SortDone()
+			return err
+		}
+		metric.Flushed(metric.FlushMemBlock)
+		return nil
 	})
 }
@@ -15,9 +15,11 @@
 package sched
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 )
 
 type flushSegEvent struct {
@@ -25,6 +27,10 @@ type flushSegEvent struct {
 	// Segment to be flushed
 	Segment   iface.ISegment
 	Destoryer dataio.FileDestoryer
+	// Size of the flushed segment file
+	Size int64
+	// Throttle limits the bytes read by the flush, nil is unlimited
+	Throttle Throttle
 }
 
 func NewFlushSegEvent(ctx *Context, seg iface.ISegment) *flushSegEvent {
@@ -48,16 +54,27 @@ func (e *flushSegEvent) Execute() error {
 		blk := e.Segment.StrongRefBlock(id)
 		blks = append(blks, blk)
 	}
-	iter := table.NewBacktrackingBlockIterator(blks, 0)
-	fn := func() {
+	iter := newThrottledIterator(table.NewBacktrackingBlockIterator(blks, 0), e.Throttle)
+	defer func() {
 		for _, blk := range blks {
 			blk.Unref()
 		}
+	}()
+	w := dataio.NewSegmentWriter(iter, meta, meta.Table.Database.Catalog.Cfg.Dir, nil)
+	if e.Ctx.Opts != nil && e.Ctx.Opts.FileService != nil {
+		w.SetFileService(e.Ctx.Opts.FileService)
 	}
-	w := dataio.NewSegmentWriter(iter, meta, meta.Table.Database.Catalog.Cfg.Dir, fn)
 	if err := w.Execute(); err != nil {
 		return err
 	}
+	// The segment can be dropped by the TTL until the writer starts the
+	// sort, it is not upgraded then
+	if meta.IsDeleted() {
+		w.GetDestoryer()("Rollback-SegmentDropped")
+		return metadata.SegmentNotFoundErr
+	}
 	e.Destoryer = w.GetDestoryer()
+	e.Size = w.GetSize()
+	metric.Merged()
 	return nil
 }
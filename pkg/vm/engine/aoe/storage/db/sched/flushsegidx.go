@@ -52,6 +52,10 @@ func (e *flushSegIndexEvent) Execute() error {
 	ids := e.Segment.BlockIds()
 	meta := e.Segment.GetMeta()
 	dir := e.Segment.GetSegmentFile().GetDir()
+	key, err := meta.Table.DataKey()
+	if err != nil {
+		return err
+	}
 	bsiEnabled := make([]int, 0)
 	indice := meta.Table.GetIndexSchema()
 	for _, idx := range indice.Indice {
@@ -93,7 +97,7 @@ func (e *flushSegIndexEvent) Execute() error {
 		version := e.Segment.GetIndexHolder().AllocateVersion(colIdx)
 		filename := common.MakeBitSlicedIndexFileName(version, meta.Table.Id, meta.Id, uint16(colIdx))
 		filename = filepath.Join(dir, filename)
-		if err := index.DefaultRWHelper.FlushBitSlicedIndex(bsi.(index.Index), filename); err != nil {
+		if err := index.DefaultRWHelper.FlushBitSlicedIndex(bsi.(index.Index), filename, key); err != nil {
 			panic(err)
 		}
 		logutil.Infof("[SEG] BSI Flushed, type %d | %s", bsi.(index.Index).Type(), filename)
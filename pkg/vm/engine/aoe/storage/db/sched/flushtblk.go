@@ -14,6 +14,7 @@
 package sched
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -43,5 +44,9 @@ func NewFlushTransientBlockEvent(ctx *Context, n base.INode, data batch.IBatch,
 }
 
 func (e *flushTransientBlockEvent) Execute() error {
-	return e.File.Sync(e.Data, e.Meta)
+	if err := e.File.Sync(e.Data, e.Meta); err != nil {
+		return err
+	}
+	metric.Flushed(metric.FlushTransientBlock)
+	return nil
 }
@@ -0,0 +1,175 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sched
+
+import (
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
+	bif "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/iterator/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// Throttle is called with the bytes of every column read by a merge, it
+// returns false if the merge is to be stopped
+type Throttle = func(n int64) bool
+
+// throttledIterator passes the columns read through the throttle
+type throttledIterator struct {
+	bif.BlockIterator
+	throttle Throttle
+}
+
+func newThrottledIterator(iter bif.BlockIterator, throttle Throttle) bif.BlockIterator {
+	if throttle == nil {
+		return iter
+	}
+	return &throttledIterator{
+		BlockIterator: iter,
+		throttle:      throttle,
+	}
+}
+
+func (iter *throttledIterator) FetchColumn() ([]*vector.Vector, error) {
+	column, err := iter.BlockIterator.FetchColumn()
+	if err != nil {
+		return nil, err
+	}
+	var n int64
+	for _, vec := range column {
+		n += int64(len(vec.Data))
+	}
+	if !iter.throttle(n) {
+		return nil, ErrMergeStopped
+	}
+	return column, nil
+}
+
+type mergeSegEvent struct {
+	BaseEvent
+	// Table data of the merged segments
+	TableData iface.ITableData
+	// Segments to be merged, they are sorted and their rows left fit in
+	// one segment
+	Segments []iface.ISegment
+	// Merged is the segment the rows are merged into, it is nil if every
+	// row is deleted and the segments are dropped
+	Merged iface.ISegment
+	// Size of the merged segment file
+	Size int64
+	// Throttle limits the bytes read by the merge, nil is unlimited
+	Throttle Throttle
+}
+
+func NewMergeSegEvent(ctx *Context, td iface.ITableData, segments []iface.ISegment) *mergeSegEvent {
+	e := &mergeSegEvent{
+		TableData: td,
+		Segments:  segments,
+	}
+	e.BaseEvent = *NewBaseEvent(e, MergeSegTask, ctx)
+	return e
+}
+
+func (e *mergeSegEvent) Execute() error {
+	tableMeta := e.TableData.GetMeta()
+	metas := make([]*metadata.Segment, len(e.Segments))
+	ids := make([]uint64, len(e.Segments))
+	for i, segment := range e.Segments {
+		metas[i] = segment.GetMeta()
+		ids[i] = metas[i].Id
+		metas[i].StartSort()
+	}
+	// the rows read before the merge are stale after it, see SortVersion
+	defer func() {
+		for _, meta := range metas {
+			meta.SortDone()
+		}
+	}()
+
+	var blks []iface.IBlock
+	var deletes []*roaring.Bitmap
+	var rows uint64
+	for _, segment := range e.Segments {
+		for _, id := range segment.BlockIds() {
+			blk := segment.StrongRefBlock(id)
+			blks = append(blks, blk)
+			blkDeletes := blk.GetMeta().GetDeletes()
+			deletes = append(deletes, blkDeletes)
+			rows += tableMeta.Schema.BlockMaxRows
+			if blkDeletes != nil {
+				rows -= blkDeletes.GetCardinality()
+			}
+		}
+	}
+	defer func() {
+		for _, blk := range blks {
+			blk.Unref()
+		}
+	}()
+	if rows == 0 {
+		return e.drop(metas)
+	}
+
+	merged := tableMeta.NewMergedSegment(metas, rows)
+	iter := table.NewMergedBlockIterator(
+		newThrottledIterator(table.NewBacktrackingBlockIterator(blks, 0), e.Throttle),
+		deletes, uint16(tableMeta.Schema.PrimaryKey), uint32(tableMeta.Schema.SegmentMaxBlocks))
+	w := dataio.NewSegmentWriter(iter, merged, tableMeta.Database.Catalog.Cfg.Dir, nil)
+	if e.Ctx.Opts != nil && e.Ctx.Opts.FileService != nil {
+		w.SetFileService(e.Ctx.Opts.FileService)
+	}
+	if err := w.Execute(); err != nil {
+		return err
+	}
+	err := tableMeta.SimpleMergeSegments(merged, metas, w.GetSize())
+	// the rows of the merged segment can be deleted once it is committed
+	merged.SortDone()
+	if err != nil {
+		w.GetDestoryer()("Rollback-MergeAborted")
+		return err
+	}
+	mergedData, dropped, err := e.TableData.MergeSegments(merged, ids)
+	if err != nil {
+		return err
+	}
+	for _, segment := range dropped {
+		segment.Unref()
+	}
+	e.Merged = mergedData
+	e.Size = w.GetSize()
+	metric.Merged()
+	logutil.Infof("%s | Merged %v | Rows %d", merged.AsCommonID().SegmentString(), ids, rows)
+	return nil
+}
+
+// drop drops the segments whose rows are all deleted
+func (e *mergeSegEvent) drop(metas []*metadata.Segment) error {
+	for _, meta := range metas {
+		if err := meta.SimpleSoftDelete(); err != nil {
+			return err
+		}
+		dropped, err := e.TableData.DropSegment(meta.Id)
+		if err != nil {
+			return err
+		}
+		dropped.Unref()
+		logutil.Infof("%s | Dropped | All rows deleted", meta.AsCommonID().SegmentString())
+	}
+	return nil
+}
@@ -15,6 +15,7 @@
 package sched
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
@@ -29,6 +30,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/sched"
 )
 
+var (
+	ErrSegmentMerging = errors.New("aoe sched: segment is being merged")
+	ErrMergeStopped   = errors.New("aoe sched: merge is stopped")
+)
+
 type CommandType = sched.CommandType
 
 const (
@@ -156,6 +162,12 @@ type scheduler struct {
 		mu     sync.RWMutex
 		blkmap map[uint64]*metablkCommiter
 	}
+	// merging is the segments being flushed or upgraded, a segment is not
+	// merged twice by the compactor and the closed segment handling
+	merging struct {
+		mu   sync.Mutex
+		segs map[uint64]struct{}
+	}
 }
 
 func NewScheduler(opts *storage.Options, tables *table.Tables) *scheduler {
@@ -166,6 +178,7 @@ func NewScheduler(opts *storage.Options, tables *table.Tables) *scheduler {
 		Controller:    NewController(),
 	}
 	s.commiters.blkmap = make(map[uint64]*metablkCommiter)
+	s.merging.segs = make(map[uint64]struct{})
 
 	// Start different type of handlers
 	dispatcher := sched.NewBaseDispatcher()
@@ -186,6 +199,7 @@ func NewScheduler(opts *storage.Options, tables *table.Tables) *scheduler {
 	dispatcher.RegisterHandler(StatelessEvent, statelessHandler)
 	dispatcher.RegisterHandler(FlushSegTask, flushsegHandler)
 	dispatcher.RegisterHandler(FlushIndexTask, flushsegHandler)
+	dispatcher.RegisterHandler(MergeSegTask, flushsegHandler)
 	dispatcher.RegisterHandler(FlushBlkTask, flushblkHandler)
 	dispatcher.RegisterHandler(CommitBlkTask, metaHandler)
 	dispatcher.RegisterHandler(UpgradeBlkTask, memdataHandler)
@@ -202,6 +216,7 @@ func NewScheduler(opts *storage.Options, tables *table.Tables) *scheduler {
 	s.RegisterDispatcher(StatelessEvent, dispatcher)
 	s.RegisterDispatcher(FlushSegTask, dispatcher)
 	s.RegisterDispatcher(FlushIndexTask, dispatcher)
+	s.RegisterDispatcher(MergeSegTask, dispatcher)
 	s.RegisterDispatcher(FlushBlkTask, dispatcher)
 	s.RegisterDispatcher(CommitBlkTask, dispatcher)
 	s.RegisterDispatcher(UpgradeBlkTask, dispatcher)
@@ -247,18 +262,21 @@ func (s *scheduler) onFlushBlkDone(e sched.Event) {
 // onCommitBlkDone handles the finished commit block event, schedules a
 // new flush table event and an upgrade block event.
 func (s *scheduler) onCommitBlkDone(e sched.Event) {
+	event := e.(*commitBlkEvent)
 	if err := e.GetError(); err != nil {
+		event.Meta.SortDone()
 		s.opts.EventListener.OnBackgroundError(err)
 		return
 	}
-	event := e.(*commitBlkEvent)
 	if !event.Ctx.HasDataScope() {
+		event.Meta.SortDone()
 		return
 	}
 	newMeta := event.Meta
 	mctx := &Context{Opts: s.opts}
 	tableData, err := s.tables.StrongRefTable(newMeta.Segment.Table.Id)
 	if err != nil {
+		newMeta.SortDone()
 		s.opts.EventListener.OnBackgroundError(err)
 		return
 	}
@@ -301,7 +319,9 @@ func (s *scheduler) onUpgradeBlkDone(e sched.Event) {
 	logutil.Infof(" %s | Segment %d | FlushSegEvent | Started", sched.EventPrefix, event.Meta.Segment.Id)
 	flushCtx := &Context{Opts: s.opts}
 	flushEvent := NewFlushSegEvent(flushCtx, segment)
-	s.Schedule(flushEvent)
+	if err := s.Schedule(flushEvent); err != nil {
+		segment.Unref()
+	}
 }
 
 // onFlushSegDone handles the finished flush segment event, generates a new
@@ -309,11 +329,16 @@ func (s *scheduler) onUpgradeBlkDone(e sched.Event) {
 func (s *scheduler) onFlushSegDone(e sched.Event) {
 	event := e.(*flushSegEvent)
 	if err := e.GetError(); err != nil {
+		event.Segment.GetMeta().SortDone()
+		s.finishMerge(event.Segment.GetMeta().Id)
 		event.Segment.Unref()
 		return
 	}
 	if !s.IsOn(UpgradeSegMask) {
 		logutil.Warn("[Scheduler] Upgrade Segment Is Turned-Off")
+		// the segment is upgraded from its flushed file on the replay
+		event.Segment.GetMeta().SortDone()
+		s.finishMerge(event.Segment.GetMeta().Id)
 		event.Segment.Unref()
 		return
 	}
@@ -322,13 +347,20 @@ func (s *scheduler) onFlushSegDone(e sched.Event) {
 	meta := event.Segment.GetMeta()
 	td, err := s.tables.StrongRefTable(meta.Table.Id)
 	if err != nil {
+		meta.SortDone()
+		s.finishMerge(meta.Id)
 		event.Segment.Unref()
 		event.Rollback("Rollback-TableNotExist")
 		return
 	}
 	logutil.Infof(" %s | Segment %d | UpgradeSegEvent | Started", sched.EventPrefix, meta.Id)
 	newevent := NewUpgradeSegEvent(ctx, event.Segment, td)
-	s.Schedule(newevent)
+	if err = s.Schedule(newevent); err != nil {
+		meta.SortDone()
+		s.finishMerge(meta.Id)
+		event.Segment.Unref()
+		td.Unref()
+	}
 }
 
 // onUpgradeSegDone handles the finished upgrade segment event and releases the
@@ -337,6 +369,7 @@ func (s *scheduler) onUpgradeSegDone(e sched.Event) {
 	event := e.(*upgradeSegEvent)
 	defer event.TableData.Unref()
 	defer event.OldSegment.Unref()
+	defer s.finishMerge(event.OldSegment.GetMeta().Id)
 	if err := e.GetError(); err != nil {
 		s.opts.EventListener.OnBackgroundError(err)
 		return
@@ -349,6 +382,26 @@ func (s *scheduler) onUpgradeSegDone(e sched.Event) {
 	s.Schedule(newevent)
 }
 
+// onMergeSegDone handles the finished merge segments event, releases the
+// merged segments and flushes the indices of the segment they are merged
+// into.
+func (s *scheduler) onMergeSegDone(e sched.Event) {
+	event := e.(*mergeSegEvent)
+	defer event.TableData.Unref()
+	for _, segment := range event.Segments {
+		s.finishMerge(segment.GetMeta().Id)
+		segment.Unref()
+	}
+	if e.GetError() != nil || event.Merged == nil {
+		return
+	}
+	event.Merged.Unref()
+	flushCtx := &Context{Opts: s.opts}
+	newevent := NewFlushSegIndexEvent(flushCtx, event.Merged)
+	newevent.FlushAll = true
+	s.Schedule(newevent)
+}
+
 func (s *scheduler) OnExecDone(op interface{}) {
 	e := op.(sched.Event)
 	switch e.Type() {
@@ -362,6 +415,8 @@ func (s *scheduler) OnExecDone(op interface{}) {
 		s.onFlushSegDone(e)
 	case UpgradeSegTask:
 		s.onUpgradeSegDone(e)
+	case MergeSegTask:
+		s.onMergeSegDone(e)
 	case PrecommitBlkMetaTask:
 		s.onPrecommitBlkDone(e)
 	}
@@ -387,10 +442,55 @@ func (s *scheduler) preprocess(e sched.Event) {
 	e.AddObserver(s)
 }
 
-// Schedule schedules the given event via the internal scheduler.
+// startMerge returns false if any of the segments is already being merged
+func (s *scheduler) startMerge(ids ...uint64) bool {
+	s.merging.mu.Lock()
+	defer s.merging.mu.Unlock()
+	for _, id := range ids {
+		if _, ok := s.merging.segs[id]; ok {
+			return false
+		}
+	}
+	for _, id := range ids {
+		s.merging.segs[id] = struct{}{}
+	}
+	return true
+}
+
+func (s *scheduler) finishMerge(ids ...uint64) {
+	s.merging.mu.Lock()
+	for _, id := range ids {
+		delete(s.merging.segs, id)
+	}
+	s.merging.mu.Unlock()
+}
+
+// Schedule schedules the given event via the internal scheduler. A flush
+// segment or merge segments event fails with ErrSegmentMerging if any of
+// its segments is already being merged, the caller keeps the ownership of
+// the segments then.
 func (s *scheduler) Schedule(e sched.Event) error {
+	var ids []uint64
+	switch e.Type() {
+	case FlushSegTask:
+		ids = append(ids, e.(*flushSegEvent).Segment.GetMeta().Id)
+	case MergeSegTask:
+		for _, segment := range e.(*mergeSegEvent).Segments {
+			ids = append(ids, segment.GetMeta().Id)
+		}
+	default:
+		s.preprocess(e)
+		return s.BaseScheduler.Schedule(e)
+	}
+	if !s.startMerge(ids...) {
+		return ErrSegmentMerging
+	}
 	s.preprocess(e)
-	return s.BaseScheduler.Schedule(e)
+	if err := s.BaseScheduler.Schedule(e); err != nil {
+		s.finishMerge(ids...)
+		return err
+	}
+	return nil
 }
 
 func (s *scheduler) ExecCmd(cmd CommandType) error {
@@ -28,6 +28,7 @@ const (
 	UpgradeSegTask
 	FlushSegTask
 	FlushIndexTask
+	MergeSegTask
 )
 
 type BaseEvent struct {
@@ -43,6 +43,7 @@ func NewUpgradeBlkEvent(ctx *Context, meta *metadata.Block, td iface.ITableData)
 func (e *upgradeBlkEvent) Execute() error {
 	var err error
 	e.Data, err = e.TableData.UpgradeBlock(e.Meta)
+	e.Meta.SortDone()
 	if err != nil {
 		return err
 	}
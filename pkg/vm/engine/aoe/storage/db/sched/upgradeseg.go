@@ -40,13 +40,14 @@ func NewUpgradeSegEvent(ctx *Context, old iface.ISegment, td iface.ITableData) *
 func (e *upgradeSegEvent) Execute() error {
 	var err error
 	sid := e.OldSegment.GetMeta().Id
+	// the rows of the segment can be deleted again once its metadata
+	// refers to the purged rows
+	defer e.OldSegment.GetMeta().SortDone()
 	e.Segment, err = e.TableData.UpgradeSegment(sid)
-	if err == nil {
-		if e.Ctx.Controller.IsOn(UpgradeSegMetaMask) {
-			newSize := e.Segment.GetSegmentFile().Stat().Size()
-			if err = e.Segment.GetMeta().SimpleUpgrade(newSize, nil); err != nil {
-				panic(err)
-			}
+	if err == nil && e.Ctx.Controller.IsOn(UpgradeSegMetaMask) {
+		newSize := e.Segment.GetSegmentFile().Stat().Size()
+		if err = e.Segment.GetMeta().SimpleUpgrade(newSize, nil); err != nil {
+			panic(err)
 		}
 	}
 	return err
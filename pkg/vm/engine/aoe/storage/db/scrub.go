@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"os"
+	"path"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// ScrubTable reads every segment, block and index file of the table and
+// returns the parts which do not match their checksums
+func (d *DB) ScrubTable(dbName, tableName string) ([]dataio.Corruption, error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	meta, err := d.Store.Catalog.SimpleGetTableByName(dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	return d.DoScrubTable(meta)
+}
+
+// DoScrubTable walks the data dir and the file service for the files of
+// the table. The files removed during the scrub are skipped, they belong
+// to the segments compacted or dropped meanwhile.
+func (d *DB) DoScrubTable(meta *metadata.Table) ([]dataio.Corruption, error) {
+	var corruptions []dataio.Corruption
+	fs := d.Opts.FileService
+	if fs == nil {
+		fs = fileservice.NewLocalFS(d.Dir)
+	}
+	fs = encryption.NewFS(fs, d.objectDataKey)
+	key := func() ([]byte, error) {
+		return d.dataKey(meta.Id)
+	}
+	objects, err := fs.List(common.DataDirName + "/")
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		name, ok := common.ParseSegmentFileName(path.Base(obj.Name))
+		if !ok {
+			continue
+		}
+		id, err := common.ParseSegmentNameToID(name)
+		if err != nil || id.TableID != meta.Id {
+			continue
+		}
+		corruptions = append(corruptions, dataio.ScrubSegmentFile(fs, id)...)
+	}
+
+	dataDir := common.MakeDataDir(d.Dir)
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		fname := entry.Name()
+		filename := path.Join(dataDir, fname)
+		if name, ok := common.ParseTBlockfileName(fname); ok {
+			if _, _, id, err := dataio.ParseTBlockfileName(name); err == nil && id.TableID == meta.Id {
+				corruptions = append(corruptions, dataio.ScrubBlockFile(filename, id, key)...)
+			}
+		} else if name, ok := common.ParseBlockfileName(fname); ok {
+			if id, err := common.ParseBlkNameToID(name); err == nil && id.TableID == meta.Id {
+				corruptions = append(corruptions, dataio.ScrubBlockFile(filename, id, key)...)
+			}
+		} else if name, ok := common.ParseBitSlicedIndexFileName(fname); ok {
+			if _, tid, _, _, ok := common.ParseBitSlicedIndexFileNameToInfo(name); ok && tid == meta.Id {
+				corruptions = append(corruptions, dataio.ScrubIndexFile(filename, key)...)
+			}
+		} else if name, ok := common.ParseBlockBitSlicedIndexFileName(fname); ok {
+			if _, tid, _, _, _, ok := common.ParseBlockBitSlicedIndexFileNameToInfo(name); ok && tid == meta.Id {
+				corruptions = append(corruptions, dataio.ScrubIndexFile(filename, key)...)
+			}
+		}
+	}
+
+	found := corruptions[:0]
+	for _, c := range corruptions {
+		if errors.Is(c.Err, fileservice.ErrNotFound) || errors.Is(c.Err, os.ErrNotExist) {
+			continue
+		}
+		logutil.Warnf("Scrub | Table %d | %s", meta.Id, c.String())
+		found = append(found, c)
+	}
+	return found, nil
+}
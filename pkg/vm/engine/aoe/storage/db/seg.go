@@ -28,6 +28,9 @@ import (
 type Segment struct {
 	Data iface.ISegment
 	Ids  *atomic.Value
+	// expired caches the rows past the TTL horizon, they are computed once
+	// for the filters and summarizers of the segment
+	expired atomic.Value
 }
 
 // ID returns the string representation of this segment's id.
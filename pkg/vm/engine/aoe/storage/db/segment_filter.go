@@ -66,13 +66,7 @@ func (f *SegmentFilter) Eq(attr string, val interface{}) (*roaring64.Bitmap, err
 	if !ctx.BoolRes {
 		return roaring64.NewBitmap(), nil
 	}
-	buf, err := ctx.BMRes.ToBase64()
-	if err != nil {
-		return nil, err
-	}
-	ret := roaring64.NewBitmap()
-	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.output(ctx.BMRes)
 }
 
 func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -101,13 +95,7 @@ func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, err
 			ctx.BMRes.Or(subMap)
 		}
 	}
-	buf, err := ctx.BMRes.ToBase64()
-	if err != nil {
-		return nil, err
-	}
-	ret := roaring64.NewBitmap()
-	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.output(ctx.BMRes)
 }
 
 func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -139,13 +127,7 @@ func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, err
 	if !ctx.BoolRes {
 		return roaring64.NewBitmap(), nil
 	}
-	buf, err := ctx.BMRes.ToBase64()
-	if err != nil {
-		return nil, err
-	}
-	ret := roaring64.NewBitmap()
-	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.output(ctx.BMRes)
 }
 
 func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -177,13 +159,7 @@ func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, err
 	if !ctx.BoolRes {
 		return roaring64.NewBitmap(), nil
 	}
-	buf, err := ctx.BMRes.ToBase64()
-	if err != nil {
-		return nil, err
-	}
-	ret := roaring64.NewBitmap()
-	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.output(ctx.BMRes)
 }
 
 func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -215,13 +191,7 @@ func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, err
 	if !ctx.BoolRes {
 		return roaring64.NewBitmap(), nil
 	}
-	buf, err := ctx.BMRes.ToBase64()
-	if err != nil {
-		return nil, err
-	}
-	ret := roaring64.NewBitmap()
-	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.output(ctx.BMRes)
 }
 
 func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -253,13 +223,7 @@ func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, err
 	if !ctx.BoolRes {
 		return roaring64.NewBitmap(), nil
 	}
-	buf, err := ctx.BMRes.ToBase64()
-	if err != nil {
-		return nil, err
-	}
-	ret := roaring64.NewBitmap()
-	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.output(ctx.BMRes)
 }
 
 func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*roaring64.Bitmap, error) {
@@ -292,7 +256,16 @@ func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*r
 	if !ctx.BoolRes {
 		return roaring64.NewBitmap(), nil
 	}
-	buf, err := ctx.BMRes.ToBase64()
+	return f.output(ctx.BMRes)
+}
+
+// output masks the deleted and expired rows out of the result of a filter
+// and converts it to the bitmap returned to the engine.
+func (f *SegmentFilter) output(bm *roaring.Bitmap) (*roaring64.Bitmap, error) {
+	if masked := f.segment.maskedRows(); masked != nil {
+		bm.AndNot(masked)
+	}
+	buf, err := bm.ToBase64()
 	if err != nil {
 		return nil, err
 	}
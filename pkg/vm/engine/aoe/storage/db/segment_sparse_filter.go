@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
@@ -417,36 +418,214 @@ func (f *SegmentSparseFilter) Btw(attr string, minv interface{}, maxv interface{
 	return res, nil
 }
 
+// Eval returns the ids of the blocks which *might* have rows satisfying the
+// predicate, And intersects the blocks of its arguments and Or unions them.
+// An argument of And which cannot be evaluated is true for every block.
+func (f *SegmentSparseFilter) Eval(p *engine.Predicate) ([]string, error) {
+	set, err := f.eval(p)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(set))
+	for _, id := range f.segment.Blocks() {
+		if _, ok := set[id]; ok {
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
+
+func (f *SegmentSparseFilter) eval(p *engine.Predicate) (map[string]struct{}, error) {
+	var ids []string
+	var err error
+
+	switch p.Op {
+	case engine.PredAnd:
+		res := newBlockSet(f.segment.Blocks())
+		for _, arg := range p.Args {
+			set, err := f.eval(arg)
+			if err != nil {
+				continue
+			}
+			for id := range res {
+				if _, ok := set[id]; !ok {
+					delete(res, id)
+				}
+			}
+		}
+		return res, nil
+	case engine.PredOr:
+		res := make(map[string]struct{})
+		for _, arg := range p.Args {
+			set, err := f.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			for id := range set {
+				res[id] = struct{}{}
+			}
+		}
+		return res, nil
+	case engine.PredIn:
+		return f.in(p.Attr, p.Vals)
+	case engine.PredBtw:
+		return f.eval(&engine.Predicate{
+			Op: engine.PredAnd,
+			Args: []*engine.Predicate{
+				{Op: engine.PredGe, Attr: p.Attr, Vals: p.Vals[:1]},
+				{Op: engine.PredLe, Attr: p.Attr, Vals: p.Vals[1:]},
+			},
+		})
+	case engine.PredPrefix:
+		prefix := p.Vals[0].([]byte)
+		q := &engine.Predicate{Op: engine.PredGe, Attr: p.Attr, Vals: p.Vals}
+		if next := prefixSuccessor(prefix); next != nil {
+			q = &engine.Predicate{
+				Op: engine.PredAnd,
+				Args: []*engine.Predicate{
+					q,
+					{Op: engine.PredLt, Attr: p.Attr, Vals: []interface{}{next}},
+				},
+			}
+		}
+		return f.eval(q)
+	case engine.PredEq:
+		ids, err = f.Eq(p.Attr, p.Vals[0])
+	case engine.PredNe:
+		ids, err = f.Ne(p.Attr, p.Vals[0])
+	case engine.PredLt:
+		ids, err = f.Lt(p.Attr, p.Vals[0])
+	case engine.PredLe:
+		ids, err = f.Le(p.Attr, p.Vals[0])
+	case engine.PredGt:
+		ids, err = f.Gt(p.Attr, p.Vals[0])
+	case engine.PredGe:
+		ids, err = f.Ge(p.Attr, p.Vals[0])
+	default:
+		return nil, errors.New(fmt.Sprintf("unsupported predicate %v", p.Op))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newBlockSet(ids), nil
+}
+
+// in collects the min and max values of a sorted segment only once, rather
+// than once for each value of the list.
+func (f *SegmentSparseFilter) in(attr string, vals []interface{}) (map[string]struct{}, error) {
+	res := make(map[string]struct{})
+	if f.segment.Data.GetType() != base.SORTED_SEG {
+		for _, v := range vals {
+			ids, err := f.Eq(attr, v)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range ids {
+				res[id] = struct{}{}
+			}
+		}
+		return res, nil
+	}
+	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	if colIdx == -1 {
+		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	}
+	blkMin, blkMax, err := f.segment.Data.GetIndexHolder().CollectMinMax(colIdx)
+	if err != nil {
+		return nil, err
+	}
+	typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+	for idx, id := range f.segment.Blocks() {
+		for _, v := range vals {
+			if compare(blkMin[idx], v, typ) <= 0 && compare(blkMax[idx], v, typ) >= 0 {
+				res[id] = struct{}{}
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func newBlockSet(ids []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// prefixSuccessor returns the smallest value greater than all the values with
+// the prefix, or nil if there is no such value.
+func prefixSuccessor(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xff {
+			next := make([]byte, i+1)
+			copy(next, prefix)
+			next[i]++
+			return next
+		}
+	}
+	return nil
+}
+
 func compare(val1, val2 interface{}, typ types.Type) int {
 	switch typ.Oid {
 	case types.T_int8:
-		return int(val1.(int8) - val2.(int8))
+		return compareOrdered(int64(val1.(int8)), int64(val2.(int8)))
 	case types.T_int16:
-		return int(val1.(int16) - val2.(int16))
+		return compareOrdered(int64(val1.(int16)), int64(val2.(int16)))
 	case types.T_int32:
-		return int(val1.(int32) - val2.(int32))
+		return compareOrdered(int64(val1.(int32)), int64(val2.(int32)))
 	case types.T_int64:
-		return int(val1.(int64) - val2.(int64))
+		return compareOrdered(val1.(int64), val2.(int64))
 	case types.T_uint8:
-		return int(val1.(uint8)) - int(val2.(uint8))
+		return compareUnsigned(uint64(val1.(uint8)), uint64(val2.(uint8)))
 	case types.T_uint16:
-		return int(val1.(uint16)) - int(val2.(uint16))
+		return compareUnsigned(uint64(val1.(uint16)), uint64(val2.(uint16)))
 	case types.T_uint32:
-		return int(val1.(uint32)) - int(val2.(uint32))
+		return compareUnsigned(uint64(val1.(uint32)), uint64(val2.(uint32)))
 	case types.T_uint64:
-		return int(val1.(uint64)) - int(val2.(uint64))
+		return compareUnsigned(val1.(uint64), val2.(uint64))
 	case types.T_float32:
-		return int(val1.(float32) - val2.(float32))
+		return compareFloat(float64(val1.(float32)), float64(val2.(float32)))
 	case types.T_float64:
-		return int(val1.(float64) - val2.(float64))
+		return compareFloat(val1.(float64), val2.(float64))
 	case types.T_char, types.T_json, types.T_varchar:
 		return bytes.Compare(val1.([]byte), val2.([]byte))
 	case types.T_datetime:
-		return int(val1.(types.Datetime) - val2.(types.Datetime))
+		return compareOrdered(int64(val1.(types.Datetime)), int64(val2.(types.Datetime)))
 	case types.T_date:
-		return int(val1.(types.Date) - val2.(types.Date))
+		return compareOrdered(int64(val1.(types.Date)), int64(val2.(types.Date)))
 	}
 	panic("unsupported")
 }
 
+func compareOrdered(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
 
+func compareUnsigned(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -43,6 +43,7 @@ func (s *SegmentSummarizer) Count(attr string, filter *roaring.Bitmap) (uint64,
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter = s.maskDeletes(filter)
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Count(colIdx, filter)
 	} else {
@@ -83,6 +84,7 @@ func (s *SegmentSummarizer) NullCount(attr string, filter *roaring.Bitmap) (uint
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter = s.maskDeletes(filter)
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().NullCount(colIdx, 0, filter)
 	} else {
@@ -127,6 +129,7 @@ func (s *SegmentSummarizer) Max(attr string, filter *roaring.Bitmap) (interface{
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter = s.maskDeletes(filter)
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Max(colIdx, filter)
 	} else {
@@ -163,6 +166,7 @@ func (s *SegmentSummarizer) Min(attr string, filter *roaring.Bitmap) (interface{
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter = s.maskDeletes(filter)
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Min(colIdx, filter)
 	} else {
@@ -199,6 +203,7 @@ func (s *SegmentSummarizer) Sum(attr string, filter *roaring.Bitmap) (int64, uin
 	if colIdx == -1 {
 		return 0, 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter = s.maskDeletes(filter)
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Sum(colIdx, filter)
 	} else {
@@ -230,6 +235,22 @@ func (s *SegmentSummarizer) Sum(attr string, filter *roaring.Bitmap) (int64, uin
 	}
 }
 
-
-
-
+// maskDeletes removes the deleted and expired rows from the filter, a nil filter
+// stands for all rows of the segment.
+func (s *SegmentSummarizer) maskDeletes(filter *roaring.Bitmap) *roaring.Bitmap {
+	deletes := s.segment.maskedRows()
+	if deletes == nil {
+		return filter
+	}
+	masked := roaring.NewBitmap()
+	if filter == nil {
+		masked.AddRange(0, uint64(s.segment.Rows()))
+	} else {
+		masked.Or(filter)
+	}
+	it := deletes.Iterator()
+	for it.HasNext() {
+		masked.Remove(uint64(it.Next()))
+	}
+	return masked
+}
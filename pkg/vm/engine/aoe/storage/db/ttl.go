@@ -0,0 +1,122 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+)
+
+// expiredRows returns the offsets in the block of the rows whose TTL
+// column is past the horizon of the table, or nil if the table has no
+// TTL or no row of the block is expired. The rows of a NULL TTL column
+// never expire. The whole segments past the horizon are dropped by the
+// GC, expiredRows only masks the rows of the partially expired ones.
+func expiredRows(data iface.IBlock) (*roaring.Bitmap, error) {
+	meta := data.GetMeta()
+	schema := meta.Segment.Table.Schema
+	colIdx, horizon, ok := schema.TTLHorizon()
+	if !ok {
+		return nil, nil
+	}
+	// The zone map of the block tells if any row can be expired, the
+	// transient blocks have none and are always checked row by row
+	mins, _, err := data.WeakRefSegment().GetIndexHolder().CollectMinMax(colIdx)
+	if err == nil && int(meta.Idx) < len(mins) && mins[meta.Idx] != nil &&
+		common.CompareInterface(mins[meta.Idx], horizon) >= 0 {
+		return nil, nil
+	}
+	vec, err := data.GetVectorCopy(schema.ColDefs[colIdx].Name, new(bytes.Buffer), new(bytes.Buffer))
+	if err != nil {
+		return nil, err
+	}
+	var expired *roaring.Bitmap
+	for row := 0; row < vector.Length(vec); row++ {
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			continue
+		}
+		var value interface{}
+		switch col := vec.Col.(type) {
+		case []types.Datetime:
+			value = col[row]
+		case []types.Date:
+			value = col[row]
+		default:
+			return nil, nil
+		}
+		if common.CompareInterface(value, horizon) < 0 {
+			if expired == nil {
+				expired = roaring.New()
+			}
+			expired.Add(uint32(row))
+		}
+	}
+	return expired, nil
+}
+
+// maskedRows returns the segment-level offsets of the rows which are
+// deleted or expired, or nil if there is none.
+func (seg *Segment) maskedRows() *roaring.Bitmap {
+	masked := segmentDeletes(seg.Data)
+	expired := seg.expiredRows()
+	if expired == nil {
+		return masked
+	}
+	if masked == nil {
+		return expired.Clone()
+	}
+	masked.Or(expired)
+	return masked
+}
+
+// expiredRows returns the segment-level offsets of the expired rows, or nil
+// if there is none. The TTL columns of the blocks are read by the first call
+// only, the rows are expired as of that call.
+func (seg *Segment) expiredRows() *roaring.Bitmap {
+	if expired := seg.expired.Load(); expired != nil {
+		return expired.(*roaring.Bitmap)
+	}
+	var masked *roaring.Bitmap
+	if _, _, ok := seg.Data.GetMeta().Table.Schema.TTLHorizon(); ok {
+		maxRows := uint32(seg.Data.GetMeta().Table.Schema.BlockMaxRows)
+		for _, id := range seg.Data.BlockIds() {
+			data := seg.Data.StrongRefBlock(id)
+			if data == nil {
+				continue
+			}
+			expired, err := expiredRows(data)
+			startPos := data.GetMeta().Idx * maxRows
+			data.Unref()
+			if err != nil || expired == nil {
+				continue
+			}
+			if masked == nil {
+				masked = roaring.New()
+			}
+			it := expired.Iterator()
+			for it.HasNext() {
+				masked.Add(startPos + it.Next())
+			}
+		}
+	}
+	seg.expired.Store(masked)
+	return masked
+}
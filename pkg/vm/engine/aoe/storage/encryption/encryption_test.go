@@ -0,0 +1,184 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyring(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys", "master.key")
+	k, err := OpenKeyring(path)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), k.Current())
+
+	wrapped, err := k.NewDataKey()
+	assert.Nil(t, err)
+	key, err := k.Unwrap(wrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, KeySize, len(key))
+
+	id, err := k.Rotate()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), id)
+	rotated, err := k.NewDataKey()
+	assert.Nil(t, err)
+
+	k, err = OpenKeyring(path)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), k.Current())
+	// The keys wrapped before the rotation are still unwrapped
+	key2, err := k.Unwrap(wrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, key, key2)
+	_, err = k.Unwrap(rotated)
+	assert.Nil(t, err)
+
+	// Rewrap keeps the plain key and wraps it by the current key
+	assert.False(t, k.IsCurrent(wrapped))
+	rewrapped, err := k.Rewrap(wrapped)
+	assert.Nil(t, err)
+	assert.True(t, k.IsCurrent(rewrapped))
+	key2, err = k.Unwrap(rewrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, key, key2)
+
+	wrapped[len(wrapped)-1] ^= 0xff
+	_, err = k.Unwrap(wrapped)
+	assert.Equal(t, ErrCorrupted, err)
+
+	other, err := OpenKeyring(filepath.Join(t.TempDir(), "other.key"))
+	assert.Nil(t, err)
+	_, err = other.Unwrap(rotated)
+	assert.True(t, errors.Is(err, ErrUnknownKey))
+
+	assert.Nil(t, os.WriteFile(path, []byte("1:abc\n"), 0600))
+	_, err = OpenKeyring(path)
+	assert.True(t, errors.Is(err, ErrBadKeyFile))
+}
+
+func encrypt(t *testing.T, key, data []byte) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, key)
+	assert.Nil(t, err)
+	_, err = w.Write(data)
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestReadWrite(t *testing.T) {
+	key := make([]byte, KeySize)
+	_, err := io.ReadFull(rand.Reader, key)
+	assert.Nil(t, err)
+
+	for _, size := range []int{0, 1, ChunkSize - 1, ChunkSize, 3*ChunkSize + 7} {
+		data := make([]byte, size)
+		_, err = io.ReadFull(rand.Reader, data)
+		assert.Nil(t, err)
+		sealed := encrypt(t, key, data)
+		assert.Equal(t, EncryptedSize(int64(size)), int64(len(sealed)))
+		encrypted, err := IsEncrypted(bytes.NewReader(sealed), int64(len(sealed)))
+		assert.Nil(t, err)
+		assert.True(t, encrypted)
+
+		rd, err := NewReader(bytes.NewReader(sealed), int64(len(sealed)), key)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(size), rd.Size())
+		plain, err := io.ReadAll(io.NewSectionReader(rd, 0, rd.Size()))
+		assert.Nil(t, err)
+		assert.True(t, bytes.Equal(data, plain))
+		if size > ChunkSize {
+			// A read across the boundary of the chunks
+			buf := make([]byte, 16)
+			_, err = rd.ReadAt(buf, ChunkSize-8)
+			assert.Nil(t, err)
+			assert.Equal(t, data[ChunkSize-8:ChunkSize+8], buf)
+		}
+	}
+}
+
+func TestCorrupted(t *testing.T) {
+	key := make([]byte, KeySize)
+	_, err := io.ReadFull(rand.Reader, key)
+	assert.Nil(t, err)
+	data := make([]byte, 2*ChunkSize)
+	sealed := encrypt(t, key, data)
+
+	readAll := func(sealed, key []byte) error {
+		rd, err := NewReader(bytes.NewReader(sealed), int64(len(sealed)), key)
+		if err != nil {
+			return err
+		}
+		_, err = io.ReadAll(io.NewSectionReader(rd, 0, rd.Size()))
+		return err
+	}
+
+	tampered := append([]byte{}, sealed...)
+	tampered[HeaderSize+10] ^= 1
+	assert.Equal(t, ErrCorrupted, readAll(tampered, key))
+
+	// The file is cut at the boundary of a chunk
+	truncated := sealed[:HeaderSize+ChunkSize+gcmOverhead]
+	assert.NotNil(t, readAll(truncated, key))
+
+	wrongKey := make([]byte, KeySize)
+	assert.Equal(t, ErrCorrupted, readAll(sealed, wrongKey))
+}
+
+func TestEncryptFile(t *testing.T) {
+	dir := t.TempDir()
+	key := make([]byte, KeySize)
+	_, err := io.ReadFull(rand.Reader, key)
+	assert.Nil(t, err)
+	data := []byte("plaintext of the segment file")
+	src := filepath.Join(dir, "1_1.seg.tmp")
+	dst := filepath.Join(dir, "1_1.seg")
+	assert.Nil(t, os.WriteFile(src, data, 0600))
+
+	size, err := EncryptFile(src, dst, key)
+	assert.Nil(t, err)
+	_, err = os.Stat(src)
+	assert.True(t, os.IsNotExist(err))
+	sealed, err := os.ReadFile(dst)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(sealed)), size)
+	assert.False(t, bytes.Contains(sealed, data))
+
+	r, n, err := OpenFile(bytes.NewReader(sealed), size, func() ([]byte, error) { return key, nil })
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), n)
+	plain := make([]byte, n)
+	_, err = r.ReadAt(plain, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, data, plain)
+
+	_, _, err = OpenFile(bytes.NewReader(sealed), size, nil)
+	assert.Equal(t, ErrNoKeyring, err)
+
+	// The plaintext files are read as is
+	r, n, err = OpenFile(bytes.NewReader(data), int64(len(data)), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), n)
+	assert.Equal(t, bytes.NewReader(data), r)
+}
@@ -0,0 +1,314 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// The encrypted file is the header followed by the chunks of the plaintext
+// sealed by AES-GCM:
+//
+//	magic | chunk size | nonce prefix | chunk0 | chunk1 | ... | last chunk
+//
+// The nonce of a chunk is the prefix followed by the index of the chunk, the
+// highest bit of the index is set for the last chunk so that a truncated
+// file is detected. The header is the additional data of every chunk.
+const (
+	ChunkSize = 64 * 1024
+
+	magicSize       = 8
+	chunkSizeSize   = 4
+	noncePrefixSize = 8
+	HeaderSize      = magicSize + chunkSizeSize + noncePrefixSize
+
+	lastChunkFlag uint32 = 1 << 31
+	gcmOverhead          = 16
+)
+
+var (
+	magic = []byte{'A', 'O', 'E', 'E', 'N', 'C', 0, 1}
+
+	ErrCorrupted = errors.New("aoe: encrypted data is corrupted or the key is wrong")
+)
+
+// IsEncrypted returns true if the file starts with the header of the
+// encrypted files
+func IsEncrypted(r io.ReaderAt, size int64) (bool, error) {
+	if size < HeaderSize {
+		return false, nil
+	}
+	buf := make([]byte, magicSize)
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		return false, err
+	}
+	return bytes.Equal(buf, magic), nil
+}
+
+// EncryptedSize returns the size of the encrypted file of the plaintext
+func EncryptedSize(size int64) int64 {
+	chunks := (size + ChunkSize - 1) / ChunkSize
+	if chunks == 0 {
+		chunks = 1
+	}
+	return HeaderSize + size + chunks*gcmOverhead
+}
+
+func chunkNonce(prefix []byte, index uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+4)
+	copy(nonce, prefix)
+	if last {
+		index |= lastChunkFlag
+	}
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], index)
+	return nonce
+}
+
+// Writer encrypts the plaintext written into the underlying writer, Close
+// must be called to write the last chunk
+type Writer struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header []byte
+	buf    []byte
+	index  uint32
+}
+
+func NewWriter(w io.Writer, key []byte) (*Writer, error) {
+	aead, err := NewAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, HeaderSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint32(header[magicSize:], ChunkSize)
+	if _, err = io.ReadFull(rand.Reader, header[magicSize+chunkSizeSize:]); err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(header); err != nil {
+		return nil, err
+	}
+	return &Writer{
+		w:      w,
+		aead:   aead,
+		header: header,
+		buf:    make([]byte, 0, ChunkSize),
+	}, nil
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// the chunk is not sealed until more data comes, it may be the last one
+		if len(w.buf) == ChunkSize {
+			if err := w.seal(false); err != nil {
+				return 0, err
+			}
+		}
+		m := copy(w.buf[len(w.buf):ChunkSize], p)
+		w.buf = w.buf[:len(w.buf)+m]
+		p = p[m:]
+	}
+	return n, nil
+}
+
+func (w *Writer) seal(last bool) error {
+	prefix := w.header[magicSize+chunkSizeSize:]
+	sealed := w.aead.Seal(nil, chunkNonce(prefix, w.index, last), w.buf, w.header)
+	if _, err := w.w.Write(sealed); err != nil {
+		return err
+	}
+	w.index++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// Close writes the last chunk, it does not close the underlying writer
+func (w *Writer) Close() error {
+	return w.seal(true)
+}
+
+// Reader reads the plaintext of an encrypted file by ranges, the chunk read
+// last is kept for the sequential reads
+type Reader struct {
+	r      io.ReaderAt
+	aead   cipher.AEAD
+	header []byte
+	size   int64
+	chunks int64
+
+	mu    sync.Mutex
+	index int64
+	chunk []byte
+}
+
+// NewReader opens the encrypted file of size bytes
+func NewReader(r io.ReaderAt, size int64, key []byte) (*Reader, error) {
+	aead, err := NewAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if size < HeaderSize {
+		return nil, ErrCorrupted
+	}
+	header := make([]byte, HeaderSize)
+	if _, err = r.ReadAt(header, 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:magicSize], magic) || binary.BigEndian.Uint32(header[magicSize:]) != ChunkSize {
+		return nil, ErrCorrupted
+	}
+	n := size - HeaderSize
+	full, rem := n/(ChunkSize+gcmOverhead), n%(ChunkSize+gcmOverhead)
+	rd := &Reader{
+		r:      r,
+		aead:   aead,
+		header: header,
+		index:  -1,
+	}
+	switch {
+	case rem == 0 && full > 0:
+		rd.chunks, rd.size = full, full*ChunkSize
+	case rem >= gcmOverhead:
+		rd.chunks, rd.size = full+1, full*ChunkSize+rem-gcmOverhead
+	default:
+		return nil, ErrCorrupted
+	}
+	return rd, nil
+}
+
+// Size returns the size of the plaintext
+func (rd *Reader) Size() int64 {
+	return rd.size
+}
+
+func (rd *Reader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("aoe: negative offset")
+	}
+	rd.mu.Lock()
+	defer rd.mu.Unlock()
+	n := 0
+	for n < len(p) {
+		if off >= rd.size {
+			return n, io.EOF
+		}
+		index := off / ChunkSize
+		if err := rd.load(index); err != nil {
+			return n, err
+		}
+		m := copy(p[n:], rd.chunk[off-index*ChunkSize:])
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+func (rd *Reader) load(index int64) error {
+	if rd.index == index {
+		return nil
+	}
+	length := int64(ChunkSize + gcmOverhead)
+	last := index == rd.chunks-1
+	if last {
+		length = rd.size - index*ChunkSize + gcmOverhead
+	}
+	buf := make([]byte, length)
+	if _, err := rd.r.ReadAt(buf, HeaderSize+index*(ChunkSize+gcmOverhead)); err != nil && err != io.EOF {
+		return err
+	}
+	prefix := rd.header[magicSize+chunkSizeSize:]
+	chunk, err := rd.aead.Open(buf[:0], chunkNonce(prefix, uint32(index), last), buf, rd.header)
+	if err != nil {
+		rd.index = -1
+		return ErrCorrupted
+	}
+	rd.index, rd.chunk = index, chunk
+	return nil
+}
+
+// EncryptFile writes the encrypted src into dst and removes src. The dst is
+// written into a temp file which is renamed at last, so it is never partial.
+func EncryptFile(src, dst string, key []byte) (int64, error) {
+	r, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	f, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	tmp := f.Name()
+	w, err := NewWriter(f, key)
+	if err == nil {
+		if _, err = io.Copy(w, r); err == nil {
+			err = w.Close()
+		}
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	var size int64
+	if err == nil {
+		var stat os.FileInfo
+		if stat, err = f.Stat(); err == nil {
+			size = stat.Size()
+		}
+	}
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(tmp, dst)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return size, os.Remove(src)
+}
+
+// OpenFile returns the plaintext of the file of size bytes, the key is only
+// asked for if the file is encrypted
+func OpenFile(r io.ReaderAt, size int64, key func() ([]byte, error)) (io.ReaderAt, int64, error) {
+	encrypted, err := IsEncrypted(r, size)
+	if err != nil || !encrypted {
+		return r, size, err
+	}
+	if key == nil {
+		return nil, 0, ErrNoKeyring
+	}
+	k, err := key()
+	if err != nil {
+		return nil, 0, err
+	}
+	if k == nil {
+		return nil, 0, ErrNoKeyring
+	}
+	rd, err := NewReader(r, size, k)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rd, rd.Size(), nil
+}
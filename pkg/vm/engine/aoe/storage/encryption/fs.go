@@ -0,0 +1,78 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
+)
+
+// FS decrypts the encrypted objects opened from the file service. The
+// objects are written encrypted already, so only Open is intercepted and
+// the plaintext objects are returned as they are.
+type FS struct {
+	fileservice.FileService
+	key func(name string) ([]byte, error)
+}
+
+// NewFS returns the file service whose objects are decrypted with the data
+// key returned by key for the object name
+func NewFS(fs fileservice.FileService, key func(name string) ([]byte, error)) *FS {
+	return &FS{
+		FileService: fs,
+		key:         key,
+	}
+}
+
+func (fs *FS) Open(name string) (fileservice.File, error) {
+	f, err := fs.FileService.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	r, size, err := OpenFile(f, f.Size(), func() ([]byte, error) {
+		return fs.key(name)
+	})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if r == io.ReaderAt(f) {
+		return f, nil
+	}
+	return &file{
+		ReaderAt: r,
+		raw:      f,
+		size:     size,
+	}, nil
+}
+
+type file struct {
+	io.ReaderAt
+	raw  fileservice.File
+	size int64
+}
+
+func (f *file) Close() error {
+	return f.raw.Close()
+}
+
+func (f *file) Name() string {
+	return f.raw.Name()
+}
+
+func (f *file) Size() int64 {
+	return f.size
+}
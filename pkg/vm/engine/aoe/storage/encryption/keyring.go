@@ -0,0 +1,265 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// KeySize is the size of the master keys and the data keys, AES-256 is used
+	KeySize = 32
+
+	keyIdSize = 4
+)
+
+var (
+	ErrNoKeyring  = errors.New("aoe: encryption is not configured")
+	ErrUnknownKey = errors.New("aoe: unknown master key")
+	ErrBadKeyFile = errors.New("aoe: bad key file")
+)
+
+// Keyring holds the master keys of the key file. A line of the key file is
+// a master key, its id and the hex of the key separated by a colon:
+//
+//	1:6a2f...
+//
+// The last key is the current one which wraps the new data keys, the older
+// keys are kept after a rotation to unwrap the data keys wrapped by them.
+type Keyring struct {
+	sync.RWMutex
+	path    string
+	keys    map[uint32]cipher.AEAD
+	current uint32
+
+	// the data keys unwrapped, by the wrapped keys
+	dataKeys sync.Map
+}
+
+// OpenKeyring loads the master keys of the key file, a key file with a new
+// master key is created if it does not exist
+func OpenKeyring(path string) (*Keyring, error) {
+	k := &Keyring{
+		path: path,
+		keys: make(map[uint32]cipher.AEAD),
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, err
+		}
+		if _, err = k.Rotate(); err != nil {
+			return nil, err
+		}
+		return k, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err = k.addLine(line); err != nil {
+			return nil, err
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(k.keys) == 0 {
+		return nil, fmt.Errorf("%w: no master key in %s", ErrBadKeyFile, path)
+	}
+	return k, nil
+}
+
+func (k *Keyring) addLine(line string) error {
+	fields := strings.SplitN(line, ":", 2)
+	if len(fields) != 2 {
+		return fmt.Errorf("%w: %q", ErrBadKeyFile, line)
+	}
+	id, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return fmt.Errorf("%w: bad key id %q", ErrBadKeyFile, fields[0])
+	}
+	key, err := hex.DecodeString(fields[1])
+	if err != nil || len(key) != KeySize {
+		return fmt.Errorf("%w: the key %d is not a %d bytes hex", ErrBadKeyFile, id, KeySize)
+	}
+	if _, ok := k.keys[uint32(id)]; ok {
+		return fmt.Errorf("%w: duplicate key %d", ErrBadKeyFile, id)
+	}
+	aead, err := NewAEAD(key)
+	if err != nil {
+		return err
+	}
+	k.keys[uint32(id)] = aead
+	k.current = uint32(id)
+	return nil
+}
+
+// Rotate appends a new master key to the key file, the new data keys are
+// wrapped by it. It returns the id of the new key.
+func (k *Keyring) Rotate() (uint32, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return 0, err
+	}
+	k.Lock()
+	defer k.Unlock()
+	id := uint32(1)
+	for kid := range k.keys {
+		if kid >= id {
+			id = kid + 1
+		}
+	}
+	f, err := os.OpenFile(k.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return 0, err
+	}
+	_, err = fmt.Fprintf(f, "%d:%s\n", id, hex.EncodeToString(key))
+	if err == nil {
+		err = f.Sync()
+	}
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err = k.addLine(fmt.Sprintf("%d:%s", id, hex.EncodeToString(key))); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// Current returns the id of the master key wrapping the new data keys
+func (k *Keyring) Current() uint32 {
+	k.RLock()
+	defer k.RUnlock()
+	return k.current
+}
+
+// NewDataKey makes a random data key and returns it wrapped by the current
+// master key
+func (k *Keyring) NewDataKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return k.Wrap(key)
+}
+
+// Wrap encrypts the data key by the current master key, the wrapped key is
+// the id of the master key followed by the sealed data key
+func (k *Keyring) Wrap(key []byte) ([]byte, error) {
+	k.RLock()
+	id, aead := k.current, k.keys[k.current]
+	k.RUnlock()
+	ad := make([]byte, keyIdSize)
+	binary.BigEndian.PutUint32(ad, id)
+	sealed, err := Seal(aead, key, ad)
+	if err != nil {
+		return nil, err
+	}
+	return append(ad, sealed...), nil
+}
+
+// Unwrap decrypts the data key wrapped by Wrap, the keys unwrapped are cached
+func (k *Keyring) Unwrap(wrapped []byte) ([]byte, error) {
+	if v, ok := k.dataKeys.Load(string(wrapped)); ok {
+		return v.([]byte), nil
+	}
+	if len(wrapped) < keyIdSize {
+		return nil, ErrCorrupted
+	}
+	id := binary.BigEndian.Uint32(wrapped)
+	k.RLock()
+	aead, ok := k.keys[id]
+	k.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %d", ErrUnknownKey, id)
+	}
+	key, err := Open(aead, wrapped[keyIdSize:], wrapped[:keyIdSize])
+	if err != nil {
+		return nil, err
+	}
+	k.dataKeys.Store(string(wrapped), key)
+	return key, nil
+}
+
+// Rewrap unwraps the data key and wraps it by the current master key, the
+// plain data key is unchanged
+func (k *Keyring) Rewrap(wrapped []byte) ([]byte, error) {
+	key, err := k.Unwrap(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return k.Wrap(key)
+}
+
+// IsCurrent returns true if the data key is wrapped by the current master key
+func (k *Keyring) IsCurrent(wrapped []byte) bool {
+	if len(wrapped) < keyIdSize {
+		return false
+	}
+	return binary.BigEndian.Uint32(wrapped) == k.Current()
+}
+
+// NewAEAD makes the AES-GCM cipher of the key
+func NewAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Seal encrypts the plaintext with a random nonce, the nonce is the prefix
+// of the result
+func Seal(aead cipher.AEAD, plaintext, ad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, ad), nil
+}
+
+// Open decrypts the result of Seal
+func Open(aead cipher.AEAD, sealed, ad []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrCorrupted
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], ad)
+	if err != nil {
+		return nil, ErrCorrupted
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"container/list"
+	"io"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// CachedFS keeps the objects of the remote file service in a local directory.
+// The objects written are kept too, the least recently used objects are removed
+// when the size of the cached objects exceeds the capacity. The files already
+// opened are still readable after they are removed.
+type CachedFS struct {
+	remote   FileService
+	cache    *LocalFS
+	capacity int64
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+func NewCachedFS(remote FileService, dir string, capacity int64) (*CachedFS, error) {
+	fs := &CachedFS{
+		remote:   remote,
+		cache:    NewLocalFS(dir),
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+	//the objects cached before the restart
+	objects, err := fs.cache.List("")
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		fs.add(obj)
+	}
+	return fs, nil
+}
+
+// Write writes the object into the cache and uploads it from the cache
+func (fs *CachedFS) Write(name string, r io.Reader, size int64) error {
+	if err := fs.cache.Write(name, r, size); err != nil {
+		return err
+	}
+	f, err := fs.cache.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = fs.remote.Write(name, io.NewSectionReader(f, 0, size), size); err != nil {
+		fs.cache.Delete(name)
+		return err
+	}
+	fs.add(ObjectInfo{Name: name, Size: size})
+	return nil
+}
+
+// Open opens the cached object, the object is downloaded if it is not cached
+func (fs *CachedFS) Open(name string) (File, error) {
+	fs.mu.Lock()
+	if e, ok := fs.entries[name]; ok {
+		fs.lru.MoveToFront(e)
+	}
+	fs.mu.Unlock()
+
+	f, err := fs.cache.Open(name)
+	if err == nil {
+		return f, nil
+	} else if err != ErrNotFound {
+		return nil, err
+	}
+
+	remote, err := fs.remote.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer remote.Close()
+	if err = fs.cache.Write(name, io.NewSectionReader(remote, 0, remote.Size()), remote.Size()); err != nil {
+		return nil, err
+	}
+	logutil.Infof("%s | Cached | Size %d", name, remote.Size())
+	if f, err = fs.cache.Open(name); err != nil {
+		return nil, err
+	}
+	fs.add(ObjectInfo{Name: name, Size: remote.Size()})
+	return f, nil
+}
+
+func (fs *CachedFS) List(prefix string) ([]ObjectInfo, error) {
+	return fs.remote.List(prefix)
+}
+
+func (fs *CachedFS) Delete(name string) error {
+	if err := fs.remote.Delete(name); err != nil {
+		return err
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if e, ok := fs.entries[name]; ok {
+		fs.removeLocked(e)
+	}
+	return fs.cache.Delete(name)
+}
+
+// CachedSize returns the size of the cached objects
+func (fs *CachedFS) CachedSize() int64 {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.size
+}
+
+func (fs *CachedFS) add(obj ObjectInfo) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if e, ok := fs.entries[obj.Name]; ok {
+		fs.removeLocked(e)
+	}
+	fs.entries[obj.Name] = fs.lru.PushFront(obj)
+	fs.size += obj.Size
+	fs.evictLocked()
+}
+
+// evictLocked removes the least recently used objects, the latest one is always kept
+func (fs *CachedFS) evictLocked() {
+	for fs.size > fs.capacity && fs.lru.Len() > 1 {
+		e := fs.lru.Back()
+		obj := e.Value.(ObjectInfo)
+		fs.removeLocked(e)
+		if err := fs.cache.Delete(obj.Name); err != nil {
+			logutil.Warnf("%s | Evict | %s", obj.Name, err)
+		}
+	}
+}
+
+func (fs *CachedFS) removeLocked(e *list.Element) {
+	obj := fs.lru.Remove(e).(ObjectInfo)
+	delete(fs.entries, obj.Name)
+	fs.size -= obj.Size
+}
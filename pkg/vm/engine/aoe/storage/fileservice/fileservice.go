@@ -0,0 +1,120 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+var (
+	ErrNotFound = errors.New("aoe: object not found")
+)
+
+const (
+	LocalBackend = "local"
+	S3Backend    = "s3"
+
+	DefaultCacheDirName = "cache"
+)
+
+// FileService stores the immutable objects of the storage. The names of the
+// objects are the paths separated by slashes, like data/1_2.seg.
+type FileService interface {
+	// Write writes size bytes of the reader to the object, the object is
+	// replaced if it exists
+	Write(name string, r io.Reader, size int64) error
+
+	// Open opens the object to read the ranges of it
+	Open(name string) (File, error)
+
+	// List returns the objects whose names have the prefix, ordered by the names
+	List(prefix string) ([]ObjectInfo, error)
+
+	// Delete deletes the object, it is not an error if the object does not exist
+	Delete(name string) error
+}
+
+// File reads the ranges of an object
+type File interface {
+	io.ReaderAt
+	io.Closer
+	Name() string
+	Size() int64
+}
+
+type ObjectInfo struct {
+	Name string
+	Size int64
+}
+
+// Config is the file service to store the sorted segments. The segments are
+// on the local disk of the db if it is not configured.
+type Config struct {
+	// Backend is local or s3
+	Backend string `toml:"backend"`
+
+	// Dir is the directory of the local backend, like a mounted shared disk
+	Dir string `toml:"dir"`
+
+	Endpoint        string `toml:"endpoint"`
+	Region          string `toml:"region"`
+	Bucket          string `toml:"bucket"`
+	KeyPrefix       string `toml:"key-prefix"`
+	AccessKeyID     string `toml:"access-key-id"`
+	SecretAccessKey string `toml:"secret-access-key"`
+
+	// CacheDir keeps the objects read, it is {$db}/cache by default
+	CacheDir string `toml:"cache-dir"`
+
+	// CacheSize is the capacity of the read cache in bytes, 0 disables the cache
+	CacheSize int64 `toml:"cache-size"`
+}
+
+// New makes the file service of the config for the db in the dirname
+func New(cfg *Config, dirname string) (FileService, error) {
+	var fs FileService
+	switch cfg.Backend {
+	case LocalBackend:
+		if cfg.Dir == "" {
+			return nil, errors.New("aoe: the dir of the local file service is empty")
+		}
+		fs = NewLocalFS(cfg.Dir)
+	case S3Backend:
+		if cfg.Endpoint == "" || cfg.Bucket == "" {
+			return nil, errors.New("aoe: the endpoint and the bucket of the s3 file service are required")
+		}
+		fs = NewS3FS(&S3Config{
+			Endpoint:        cfg.Endpoint,
+			Region:          cfg.Region,
+			Bucket:          cfg.Bucket,
+			KeyPrefix:       cfg.KeyPrefix,
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+		})
+	default:
+		return nil, fmt.Errorf("aoe: unknown file service backend %q", cfg.Backend)
+	}
+	if cfg.CacheSize <= 0 {
+		return fs, nil
+	}
+	cacheDir := cfg.CacheDir
+	if cacheDir == "" {
+		cacheDir = filepath.Join(dirname, DefaultCacheDirName)
+	}
+	return NewCachedFS(fs, cacheDir, cfg.CacheSize)
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var moduleName = "FileService"
+
+func initTestEnv(t *testing.T) string {
+	testutils.RemoveDefaultTestPath(moduleName, t)
+	return testutils.MakeDefaultTestPath(moduleName, t)
+}
+
+func newTestS3(t *testing.T, s *MemS3) (*S3FS, func()) {
+	server := httptest.NewServer(s)
+	fs := NewS3FS(&S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "bucket",
+		KeyPrefix:       "db1/",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	})
+	return fs, server.Close
+}
+
+func writeObject(t *testing.T, fs FileService, name string, data []byte) {
+	assert.Nil(t, fs.Write(name, bytes.NewReader(data), int64(len(data))))
+}
+
+func readObject(t *testing.T, fs FileService, name string) []byte {
+	f, err := fs.Open(name)
+	assert.Nil(t, err)
+	defer f.Close()
+	buf := make([]byte, f.Size())
+	n, err := f.ReadAt(buf, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, len(buf), n)
+	return buf
+}
+
+func testFileService(t *testing.T, fs FileService) {
+	data := []byte("0123456789abcdef")
+	writeObject(t, fs, "data/1_1.seg", data)
+	writeObject(t, fs, "data/1_2.seg", data[:4])
+	writeObject(t, fs, "data/2_1.seg", nil)
+	writeObject(t, fs, "meta/1.ckp", data)
+
+	f, err := fs.Open("data/1_1.seg")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), f.Size())
+	buf := make([]byte, 4)
+	n, err := f.ReadAt(buf, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []byte("abcd"), buf)
+	n, err = f.ReadAt(buf, 14)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []byte("ef"), buf[:n])
+	_, err = f.ReadAt(buf, 16)
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, f.Close())
+
+	//the object is replaced
+	writeObject(t, fs, "data/1_2.seg", data[4:12])
+	assert.Equal(t, data[4:12], readObject(t, fs, "data/1_2.seg"))
+	assert.Equal(t, 0, len(readObject(t, fs, "data/2_1.seg")))
+
+	objects, err := fs.List("data/")
+	assert.Nil(t, err)
+	assert.Equal(t, []ObjectInfo{
+		{Name: "data/1_1.seg", Size: 16},
+		{Name: "data/1_2.seg", Size: 8},
+		{Name: "data/2_1.seg", Size: 0},
+	}, objects)
+
+	_, err = fs.Open("data/3_1.seg")
+	assert.Equal(t, ErrNotFound, err)
+
+	assert.Nil(t, fs.Delete("data/1_1.seg"))
+	assert.Nil(t, fs.Delete("data/1_1.seg"))
+	_, err = fs.Open("data/1_1.seg")
+	assert.Equal(t, ErrNotFound, err)
+	objects, err = fs.List("data/1_")
+	assert.Nil(t, err)
+	assert.Equal(t, []ObjectInfo{{Name: "data/1_2.seg", Size: 8}}, objects)
+}
+
+func TestLocalFS(t *testing.T) {
+	dir := initTestEnv(t)
+	fs := NewLocalFS(dir)
+	testFileService(t, fs)
+
+	f, err := fs.Open("data/1_2.seg")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "data", "1_2.seg"), f.Name())
+	assert.Nil(t, f.Close())
+}
+
+func TestS3FS(t *testing.T) {
+	s := NewMemS3("bucket")
+	s.MaxKeys = 1
+	fs, stop := newTestS3(t, s)
+	defer stop()
+	testFileService(t, fs)
+	assert.Equal(t, []string{"db1/data/1_2.seg", "db1/data/2_1.seg", "db1/meta/1.ckp"}, s.Objects("bucket"))
+
+	//the requests without the signature are denied
+	unsigned := NewS3FS(&S3Config{Endpoint: fs.cfg.Endpoint, Bucket: "bucket"})
+	_, err := unsigned.Open("meta/1.ckp")
+	assert.NotNil(t, err)
+	assert.NotEqual(t, ErrNotFound, err)
+
+	other := NewS3FS(&S3Config{Endpoint: fs.cfg.Endpoint, Bucket: "other", AccessKeyID: "key"})
+	_, err = other.List("")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestCachedFS(t *testing.T) {
+	dir := initTestEnv(t)
+	s := NewMemS3("bucket")
+	remote, stop := newTestS3(t, s)
+	defer stop()
+
+	cacheDir := filepath.Join(dir, DefaultCacheDirName)
+	fs, err := NewCachedFS(remote, cacheDir, 20)
+	assert.Nil(t, err)
+	testFileService(t, fs)
+
+	//the objects written are uploaded and cached
+	data := bytes.Repeat([]byte{1}, 12)
+	writeObject(t, fs, "data/4_1.seg", data)
+	assert.Equal(t, data, readObject(t, remote, "data/4_1.seg"))
+	_, err = os.Stat(filepath.Join(cacheDir, "data", "4_1.seg"))
+	assert.Nil(t, err)
+
+	//the least recently used object is evicted
+	writeObject(t, fs, "data/5_1.seg", data)
+	assert.Equal(t, int64(12), fs.CachedSize())
+	_, err = os.Stat(filepath.Join(cacheDir, "data", "4_1.seg"))
+	assert.True(t, os.IsNotExist(err))
+
+	//the object is downloaded
+	assert.Equal(t, data, readObject(t, fs, "data/4_1.seg"))
+	_, err = os.Stat(filepath.Join(cacheDir, "data", "4_1.seg"))
+	assert.Nil(t, err)
+
+	//the cached object is read without the remote
+	assert.Nil(t, remote.Delete("data/4_1.seg"))
+	assert.Equal(t, data, readObject(t, fs, "data/4_1.seg"))
+
+	//the cache is loaded after the restart
+	fs, err = NewCachedFS(remote, cacheDir, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), fs.CachedSize())
+	assert.Equal(t, data, readObject(t, fs, "data/4_1.seg"))
+	assert.Nil(t, fs.Delete("data/4_1.seg"))
+	assert.Equal(t, int64(0), fs.CachedSize())
+	_, err = fs.Open("data/4_1.seg")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestNew(t *testing.T) {
+	dir := initTestEnv(t)
+	_, err := New(&Config{Backend: "ftp"}, dir)
+	assert.NotNil(t, err)
+	_, err = New(&Config{Backend: S3Backend}, dir)
+	assert.NotNil(t, err)
+
+	fs, err := New(&Config{Backend: LocalBackend, Dir: filepath.Join(dir, "shared")}, dir)
+	assert.Nil(t, err)
+	_, ok := fs.(*LocalFS)
+	assert.True(t, ok)
+
+	fs, err = New(&Config{Backend: S3Backend, Endpoint: "http://127.0.0.1:9000", Bucket: "b", CacheSize: 1024}, dir)
+	assert.Nil(t, err)
+	cached, ok := fs.(*CachedFS)
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(dir, DefaultCacheDirName), cached.cache.dir)
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const tmpSuffix = ".tmp"
+
+// LocalFS stores the objects as the files in the directory
+type LocalFS struct {
+	dir string
+}
+
+// LocalFile is the object opened from the LocalFS
+type LocalFile struct {
+	*os.File
+	size int64
+}
+
+func NewLocalFS(dir string) *LocalFS {
+	return &LocalFS{dir: dir}
+}
+
+func (f *LocalFile) Size() int64 {
+	return f.size
+}
+
+// Path returns the path of the file of the object
+func (fs *LocalFS) Path(name string) string {
+	return filepath.Join(fs.dir, filepath.FromSlash(name))
+}
+
+// Write writes a temp file and renames it, the readers never see a partial object
+func (fs *LocalFS) Write(name string, r io.Reader, size int64) error {
+	path := fs.Path(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	w, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*"+tmpSuffix)
+	if err != nil {
+		return err
+	}
+	tmp := w.Name()
+	if _, err = io.CopyN(w, r, size); err == nil {
+		err = w.Sync()
+	}
+	if err1 := w.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+func (fs *LocalFS) Open(name string) (File, error) {
+	f, err := os.Open(fs.Path(name))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &LocalFile{File: f, size: stat.Size()}, nil
+}
+
+func (fs *LocalFS) List(prefix string) ([]ObjectInfo, error) {
+	objects := make([]ObjectInfo, 0)
+	err := filepath.Walk(fs.dir, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(path, tmpSuffix) {
+			return nil
+		}
+		rel, err := filepath.Rel(fs.dir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if strings.HasPrefix(name, prefix) {
+			objects = append(objects, ObjectInfo{Name: name, Size: info.Size()})
+		}
+		return nil
+	})
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+	return objects, err
+}
+
+func (fs *LocalFS) Delete(name string) error {
+	err := os.Remove(fs.Path(name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const memS3MaxKeys = 1000
+
+// MemS3 is an in-memory S3 compatible service for the tests. It serves the
+// path style requests of PutObject, HeadObject, GetObject with a range,
+// DeleteObject and ListObjectsV2 of the buckets created.
+type MemS3 struct {
+	sync.RWMutex
+	buckets map[string]map[string][]byte
+
+	// MaxKeys is the maximum number of the objects in a page of the listing
+	MaxKeys int
+}
+
+type memS3ListResult struct {
+	XMLName               xml.Name   `xml:"ListBucketResult"`
+	Name                  string     `xml:"Name"`
+	Prefix                string     `xml:"Prefix"`
+	KeyCount              int        `xml:"KeyCount"`
+	Contents              []s3Object `xml:"Contents"`
+	IsTruncated           bool       `xml:"IsTruncated"`
+	NextContinuationToken string     `xml:"NextContinuationToken,omitempty"`
+}
+
+func NewMemS3(buckets ...string) *MemS3 {
+	s := &MemS3{
+		buckets: make(map[string]map[string][]byte),
+		MaxKeys: memS3MaxKeys,
+	}
+	for _, bucket := range buckets {
+		s.buckets[bucket] = make(map[string][]byte)
+	}
+	return s
+}
+
+// Objects returns the names of the objects in the bucket
+func (s *MemS3) Objects(bucket string) []string {
+	s.RLock()
+	defer s.RUnlock()
+	names := make([]string, 0, len(s.buckets[bucket]))
+	for name := range s.buckets[bucket] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *MemS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), s3SignAlgorithm+" Credential=") {
+		s.writeError(w, http.StatusForbidden, "AccessDenied", "the request is not signed")
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key := path, ""
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		bucket, key = path[:i], path[i+1:]
+	}
+	s.Lock()
+	defer s.Unlock()
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		s.writeError(w, http.StatusNotFound, "NoSuchBucket", bucket)
+		return
+	}
+
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		s.list(w, r, bucket, objects)
+	case r.Method == http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		if int64(len(data)) != r.ContentLength {
+			s.writeError(w, http.StatusBadRequest, "IncompleteBody", "the length of the body mismatched")
+			return
+		}
+		objects[key] = data
+	case r.Method == http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		data, ok := objects[key]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "NoSuchKey", key)
+			return
+		}
+		status := http.StatusOK
+		if rng := r.Header.Get("Range"); rng != "" {
+			var start, end int
+			if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil || start > end || start >= len(data) {
+				s.writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange", rng)
+				return
+			}
+			if end >= len(data) {
+				end = len(data) - 1
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			data = data[start : end+1]
+			status = http.StatusPartialContent
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		s.writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+// list returns the objects after the continuation token, the token is the last key of the previous page
+func (s *MemS3) list(w http.ResponseWriter, r *http.Request, bucket string, objects map[string][]byte) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	token := query.Get("continuation-token")
+	keys := make([]string, 0)
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) && key > token {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := memS3ListResult{Name: bucket, Prefix: prefix}
+	if len(keys) > s.MaxKeys {
+		keys = keys[:s.MaxKeys]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	result.KeyCount = len(keys)
+	for _, key := range keys {
+		result.Contents = append(result.Contents, s3Object{Key: key, Size: int64(len(objects[key]))})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(&result)
+}
+
+func (s *MemS3) writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(&struct {
+		XMLName xml.Name `xml:"Error"`
+		s3Error
+	}{s3Error: s3Error{Code: code, Message: message}})
+}
@@ -0,0 +1,337 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3DefaultRegion   = "us-east-1"
+	s3Service         = "s3"
+	s3SignAlgorithm   = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+)
+
+type S3Config struct {
+	// Endpoint is the url of the service, like http://127.0.0.1:9000
+	Endpoint        string
+	Region          string
+	Bucket          string
+	KeyPrefix       string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3FS stores the objects in a bucket of an S3 compatible service. The
+// requests are in the path style and signed by the signature version 4.
+type S3FS struct {
+	cfg    S3Config
+	client *http.Client
+}
+
+// s3File reads the ranges of the object by the range requests
+type s3File struct {
+	fs   *S3FS
+	name string
+	size int64
+}
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type s3Object struct {
+	Key  string `xml:"Key"`
+	Size int64  `xml:"Size"`
+}
+
+type s3ListResult struct {
+	Contents              []s3Object `xml:"Contents"`
+	IsTruncated           bool       `xml:"IsTruncated"`
+	NextContinuationToken string     `xml:"NextContinuationToken"`
+}
+
+func NewS3FS(cfg *S3Config) *S3FS {
+	fs := &S3FS{
+		cfg:    *cfg,
+		client: &http.Client{},
+	}
+	fs.cfg.Endpoint = strings.TrimSuffix(fs.cfg.Endpoint, "/")
+	if fs.cfg.Region == "" {
+		fs.cfg.Region = s3DefaultRegion
+	}
+	return fs
+}
+
+func (fs *S3FS) Write(name string, r io.Reader, size int64) error {
+	body := io.LimitReader(r, size)
+	if size == 0 {
+		body = http.NoBody
+	}
+	req, err := fs.newRequest(http.MethodPut, fs.cfg.KeyPrefix+name, nil, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	resp, err := fs.do(req, name)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (fs *S3FS) Open(name string) (File, error) {
+	req, err := fs.newRequest(http.MethodHead, fs.cfg.KeyPrefix+name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fs.do(req, name)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.ContentLength < 0 {
+		return nil, fmt.Errorf("s3: no length of the object %s", name)
+	}
+	return &s3File{fs: fs, name: name, size: resp.ContentLength}, nil
+}
+
+func (fs *S3FS) List(prefix string) ([]ObjectInfo, error) {
+	objects := make([]ObjectInfo, 0)
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", fs.cfg.KeyPrefix+prefix)
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		req, err := fs.newRequest(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := fs.do(req, prefix)
+		if err != nil {
+			return nil, err
+		}
+		var result s3ListResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range result.Contents {
+			objects = append(objects, ObjectInfo{
+				Name: strings.TrimPrefix(obj.Key, fs.cfg.KeyPrefix),
+				Size: obj.Size,
+			})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	return objects, nil
+}
+
+func (fs *S3FS) Delete(name string) error {
+	req, err := fs.newRequest(http.MethodDelete, fs.cfg.KeyPrefix+name, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := fs.do(req, name)
+	if err == ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (f *s3File) Name() string {
+	return f.name
+}
+
+func (f *s3File) Size() int64 {
+	return f.size
+}
+
+func (f *s3File) Close() error {
+	return nil
+}
+
+func (f *s3File) ReadAt(buf []byte, off int64) (int, error) {
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	if off >= f.size {
+		return 0, io.EOF
+	}
+	n := int64(len(buf))
+	if off+n > f.size {
+		n = f.size - off
+	}
+	req, err := f.fs.newRequest(http.MethodGet, f.fs.cfg.KeyPrefix+f.name, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+n-1))
+	resp, err := f.fs.do(req, f.name)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		//the range is ignored and the whole object is returned
+		if _, err = io.CopyN(ioutil.Discard, resp.Body, off); err != nil {
+			return 0, err
+		}
+	}
+	read, err := io.ReadFull(resp.Body, buf[:n])
+	if err == nil && int(n) < len(buf) {
+		err = io.EOF
+	}
+	return read, err
+}
+
+func (fs *S3FS) newRequest(method, key string, query url.Values, body io.Reader) (*http.Request, error) {
+	u, err := url.Parse(fs.cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = "/" + fs.cfg.Bucket + "/" + key
+	u.RawPath = "/" + s3Escape(fs.cfg.Bucket, false) + "/" + s3Escape(key, false)
+	u.RawQuery = s3CanonicalQuery(query)
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	fs.sign(req, time.Now().UTC())
+	return req, nil
+}
+
+// do sends the request, the error response is returned as the error
+func (fs *S3FS) do(req *http.Request, name string) (*http.Response, error) {
+	resp, err := fs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	var e s3Error
+	if req.Method != http.MethodHead {
+		_ = xml.NewDecoder(resp.Body).Decode(&e)
+	}
+	if e.Code == "" {
+		e.Code = resp.Status
+	}
+	return nil, fmt.Errorf("s3: %s %s: %s %s", req.Method, name, e.Code, e.Message)
+}
+
+// sign adds the authorization of the signature version 4 to the request,
+// the payload is not signed.
+func (fs *S3FS) sign(req *http.Request, now time.Time) {
+	amzTime := now.Format(s3TimeFormat)
+	req.Header.Set("X-Amz-Date", amzTime)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+	if fs.cfg.AccessKeyID == "" {
+		return
+	}
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + s3UnsignedPayload,
+		"x-amz-date:" + amzTime,
+		"",
+		signedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+	scope := strings.Join([]string{now.Format(s3DateFormat), fs.cfg.Region, s3Service, "aws4_request"}, "/")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3SignAlgorithm, amzTime, scope, hex.EncodeToString(hash[:])}, "\n")
+
+	key := s3HMAC([]byte("AWS4"+fs.cfg.SecretAccessKey), now.Format(s3DateFormat))
+	key = s3HMAC(key, fs.cfg.Region)
+	key = s3HMAC(key, s3Service)
+	key = s3HMAC(key, "aws4_request")
+	signature := hex.EncodeToString(s3HMAC(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SignAlgorithm, fs.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3CanonicalQuery returns the query sorted by the names, the names and the values are escaped
+func s3CanonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]string, 0, len(names))
+	for _, name := range names {
+		for _, value := range query[name] {
+			params = append(params, s3Escape(name, true)+"="+s3Escape(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// s3Escape escapes the characters except the unreserved ones of RFC 3986
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' && !escapeSlash {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
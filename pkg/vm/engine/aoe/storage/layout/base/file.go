@@ -15,8 +15,10 @@
 package base
 
 import (
+	"errors"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"hash/crc32"
 	"io"
 	"os"
 
@@ -32,6 +34,46 @@ type Pointer struct {
 
 	// OriginLen is the original length of Column and has not been compressed
 	OriginLen uint64
+
+	// Checksum is the CRC32C of the stored bytes, it is valid only if
+	// HasChecksum is set
+	Checksum uint32
+
+	// HasChecksum is false if the file was written without checksums
+	HasChecksum bool
+}
+
+var (
+	ErrChecksumMismatch = errors.New("aoe: checksum mismatch")
+
+	checksumTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// Checksum returns the CRC32C of buf
+func Checksum(buf []byte) uint32 {
+	return crc32.Checksum(buf, checksumTable)
+}
+
+// SetChecksum sets the checksum of the pointer
+func (p *Pointer) SetChecksum(checksum uint32) {
+	p.Checksum = checksum
+	p.HasChecksum = true
+}
+
+// Verify checks the data read from the pointer against its checksum, the
+// pointers without checksum are not verified. buf must be all the data of
+// the pointer, a partial read can't be verified.
+func (p *Pointer) Verify(buf []byte) error {
+	if !p.HasChecksum {
+		return nil
+	}
+	if uint64(len(buf)) != p.Len {
+		return fmt.Errorf("%w: offset %d, length %d, read %d", ErrChecksumMismatch, p.Offset, p.Len, len(buf))
+	}
+	if Checksum(buf) != p.Checksum {
+		return fmt.Errorf("%w: offset %d, length %d", ErrChecksumMismatch, p.Offset, p.Len)
+	}
+	return nil
 }
 
 type IndicesMeta struct {
@@ -108,12 +150,13 @@ type IBaseFile interface {
 	GetIndicesMeta() *IndicesMeta
 
 	// ReadPoint reads Part to buf, and detects Pointer.Len
-	// in aoe, the Pointer is Column
-	ReadPoint(ptr *Pointer, buf []byte)
+	// in aoe, the Pointer is Column. ErrChecksumMismatch is
+	// returned if buf does not match the checksum of the Pointer
+	ReadPoint(ptr *Pointer, buf []byte) error
 
 	// ReadPart is to use part[colIdx] to find Pointer and then call ReadPoint
 	// the external Read interface is implemented through ReadPart
-	ReadPart(colIdx uint64, id common.ID, buf []byte)
+	ReadPart(colIdx uint64, id common.ID, buf []byte) error
 
 	// PrefetchPart calls readahead in Linux
 	PrefetchPart(colIdx uint64, id common.ID) error
@@ -150,7 +193,7 @@ type ISegmentFile interface {
 
 	// ReadBlockPoint reads a Pointer data to buf,
 	// which called by EmbedBlockIndexFile.
-	ReadBlockPoint(id common.ID, ptr *Pointer, buf []byte)
+	ReadBlockPoint(id common.ID, ptr *Pointer, buf []byte) error
 	GetBlockIndicesMeta(id common.ID) *IndicesMeta
 
 	MakeVirtualBlkIndexFile(id common.ID, meta *IndexMeta) common.IVFile
@@ -164,3 +207,18 @@ type ISegmentFile interface {
 type IBlockFile interface {
 	IBaseFile
 }
+
+// DataKeyer is implemented by the segment files of the tables which may be
+// encrypted, the files of the segment are decrypted with the data key
+type DataKeyer interface {
+	DataKey() ([]byte, error)
+}
+
+// DataKeyOf returns the function asking for the data key of the segment
+// file, it is nil if the files of the segment are never encrypted
+func DataKeyOf(sf ISegmentFile) func() ([]byte, error) {
+	if keyer, ok := sf.(DataKeyer); ok {
+		return keyer.DataKey
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/prefetch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 )
@@ -38,9 +39,16 @@ type FileNameFactory = func(string, common.ID) string
 // col02 : coldata len | coldata originlen |
 // ...
 // col01 data | col02 data |  ...
+// indices
+// col01 checksum | col02 checksum | ... | header checksum | magic
+//
+// The block file of an encrypted table is encrypted as a whole, the layout
+// above is the plaintext read through the decrypting reader.
 type BlockFile struct {
 	common.RefHelper
 	os.File
+	reader      io.ReaderAt
+	encrypted   bool
 	ID          common.ID
 	Parts       map[base.Key]*base.Pointer
 	Meta        *FileMeta
@@ -86,7 +94,14 @@ func NewBlockFile(segFile base.ISegmentFile, id common.ID, nameFactory FileNameF
 	}
 
 	bf.File = *r
-	bf.initPointers(id)
+	if err = bf.initReader(base.DataKeyOf(segFile)); err != nil {
+		r.Close()
+		panic(fmt.Sprintf("Cannot decrypt specified file %s: %s", name, err))
+	}
+	if err = bf.initPointers(id); err != nil {
+		r.Close()
+		panic(fmt.Sprintf("Cannot load specified file %s: %s", name, err))
+	}
 	bf.Ref()
 	bf.OnZeroCB = bf.close
 	return bf
@@ -118,99 +133,174 @@ func (bf *BlockFile) MakeVirtualIndexFile(meta *base.IndexMeta) common.IVFile {
 	return newEmbedBlockIndexFile(&bf.ID, bf.SegmentFile, meta)
 }
 
-func (bf *BlockFile) initPointers(id common.ID) {
+// initReader sets the reader of the plaintext, the size in Info is the
+// plaintext size after it
+func (bf *BlockFile) initReader(key func() ([]byte, error)) error {
+	r, size, err := encryption.OpenFile(&bf.File, bf.Info.Size(), key)
+	if err != nil {
+		return err
+	}
+	bf.reader = r
+	bf.encrypted = r != io.ReaderAt(&bf.File)
+	bf.Info.(*fileStat).size = size
+	return nil
+}
+
+func (bf *BlockFile) initPointers(id common.ID) error {
 	var (
 		cols uint16
 		algo uint8
 		err  error
 	)
-	offset, _ := bf.File.Seek(0, io.SeekCurrent)
-	if err = binary.Read(&bf.File, binary.BigEndian, &algo); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	f := io.NewSectionReader(bf.reader, 0, bf.Info.Size())
+	offset, _ := f.Seek(0, io.SeekCurrent)
+	if err = binary.Read(f, binary.BigEndian, &algo); err != nil {
+		return err
 	}
-	if err = binary.Read(&bf.File, binary.BigEndian, &cols); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	if err = binary.Read(f, binary.BigEndian, &cols); err != nil {
+		return err
 	}
-	if err = binary.Read(&bf.File, binary.BigEndian, &bf.Count); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	if err = binary.Read(f, binary.BigEndian, &bf.Count); err != nil {
+		return err
 	}
 
 	buf := make([]byte, 24)
-	if err = binary.Read(&bf.File, binary.BigEndian, &buf); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	if err = binary.Read(f, binary.BigEndian, &buf); err != nil {
+		return err
 	}
 	bf.Range = new(metadata.LogRange)
 	if err = bf.Range.Unmarshal(buf); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+		return err
 	}
 
 	var sz int32
-	if err = binary.Read(&bf.File, binary.BigEndian, &sz); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	if err = binary.Read(f, binary.BigEndian, &sz); err != nil {
+		return err
+	}
+	if sz < 0 || int64(sz) > bf.Info.Size() {
+		return fmt.Errorf("invalid log index length %d", sz)
 	}
 	buf = make([]byte, sz)
-	if err = binary.Read(&bf.File, binary.BigEndian, &buf); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	if err = binary.Read(f, binary.BigEndian, &buf); err != nil {
+		return err
 	}
 	bf.PrevIdx = new(metadata.LogIndex)
 	if err = bf.PrevIdx.UnMarshal(buf); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+		return err
 	}
 	var sz_ int32
-	if err = binary.Read(&bf.File, binary.BigEndian, &sz_); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	if err = binary.Read(f, binary.BigEndian, &sz_); err != nil {
+		return err
+	}
+	if sz_ < 0 || int64(sz_) > bf.Info.Size() {
+		return fmt.Errorf("invalid log index length %d", sz_)
 	}
 	buf = make([]byte, sz_)
-	if err = binary.Read(&bf.File, binary.BigEndian, &buf); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+	if err = binary.Read(f, binary.BigEndian, &buf); err != nil {
+		return err
 	}
 	bf.Idx = new(metadata.LogIndex)
 	if err = bf.Idx.UnMarshal(buf); err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+		return err
 	}
 	headSize := 8 + int(sz+sz_) + 24 + 3 + 8 + 2*8*int(cols)
 	currOffset := headSize + int(offset)
+	keys := make([]base.Key, cols)
 	for i := uint16(0); i < cols; i++ {
 		key := base.Key{
 			Col: uint64(i),
 			ID:  id.AsBlockID(),
 		}
+		keys[i] = key
 		bf.Parts[key] = &base.Pointer{}
-		err = binary.Read(&bf.File, binary.BigEndian, &bf.Parts[key].Len)
+		err = binary.Read(f, binary.BigEndian, &bf.Parts[key].Len)
 		if err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+			return err
 		}
-		err = binary.Read(&bf.File, binary.BigEndian, &bf.Parts[key].OriginLen)
+		err = binary.Read(f, binary.BigEndian, &bf.Parts[key].OriginLen)
 		if err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+			return err
 		}
 		bf.Parts[key].Offset = int64(currOffset)
 		// log.Infof("(Offset, Len, OriginLen, Algo)=(%d %d, %d, %d)", currOffset, bf.Parts[key].Len, bf.Parts[key].OriginLen, algo)
 		currOffset += int(bf.Parts[key].Len)
 	}
 	bf.DataAlgo = int(algo)
-	if _, err = bf.Seek(int64(currOffset), io.SeekStart); err != nil {
-		panic(err)
+	if _, err = f.Seek(int64(currOffset), io.SeekStart); err != nil {
+		return err
 	}
-	idxMeta, err := index.DefaultRWHelper.ReadIndicesMeta(bf.File)
+	idxMeta, err := index.DefaultRWHelper.ReadIndicesMeta(f)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("indices: %w", err)
 	}
 	bf.Meta.Indices = idxMeta
+	return bf.initChecksums(f, keys, offset, headSize)
+}
+
+// initChecksums reads the checksum trailer following the indices and
+// verifies the header, the block files without trailer are not verified
+func (bf *BlockFile) initChecksums(f *io.SectionReader, keys []base.Key, offset int64, headSize int) error {
+	pos, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	trailerSize := int64(checksumSize * (len(keys) + 2))
+	switch remain := bf.Info.Size() - pos; remain {
+	case 0:
+		return nil
+	case trailerSize:
+	default:
+		return fmt.Errorf("unexpected %d bytes after the indices", remain)
+	}
+	trailer := make([]uint32, len(keys)+2)
+	if err = binary.Read(f, binary.BigEndian, trailer); err != nil {
+		return err
+	}
+	if trailer[len(keys)+1] != blockChecksumMagic {
+		return errors.New("checksums: bad magic")
+	}
+	header := make([]byte, headSize)
+	if _, err = bf.reader.ReadAt(header, offset); err != nil {
+		return err
+	}
+	ptr := &base.Pointer{
+		Offset: offset,
+		Len:    uint64(headSize),
+	}
+	ptr.SetChecksum(trailer[len(keys)])
+	if err = ptr.Verify(header); err != nil {
+		return fmt.Errorf("metadata: %w", err)
+	}
+	for i, key := range keys {
+		bf.Parts[key].SetChecksum(trailer[i])
+	}
+	return nil
 }
 
 func (bf *BlockFile) Stat() common.FileInfo {
 	return bf.Info
 }
 
-func (bf *BlockFile) ReadPoint(ptr *base.Pointer, buf []byte) {
-	n, err := bf.ReadAt(buf, ptr.Offset)
+// ReadPoint reads the prefix of the data of ptr into buf. If ptr has a
+// checksum and buf is shorter than the data, all the data is read to be
+// verified.
+func (bf *BlockFile) ReadPoint(ptr *base.Pointer, buf []byte) error {
+	if ptr.HasChecksum && len(buf) < int(ptr.Len) {
+		data := make([]byte, ptr.Len)
+		if err := bf.ReadPoint(ptr, data); err != nil {
+			return err
+		}
+		copy(buf, data)
+		return nil
+	}
+	n, err := bf.reader.ReadAt(buf, ptr.Offset)
 	if err != nil {
 		panic(fmt.Sprintf("logic error: %s", err))
 	}
 	if n > int(ptr.Len) {
 		panic("logic error")
 	}
+	return ptr.Verify(buf)
 }
 
 func (bf *BlockFile) DataCompressAlgo(id common.ID) int {
@@ -236,7 +326,7 @@ func (bf *BlockFile) GetFileType() common.FileType {
 	return common.DiskFile
 }
 
-func (bf *BlockFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
+func (bf *BlockFile) ReadPart(colIdx uint64, id common.ID, buf []byte) error {
 	key := base.Key{
 		Col: colIdx,
 		ID:  id.AsBlockID(),
@@ -249,7 +339,7 @@ func (bf *BlockFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
 	if len(buf) > int(pointer.Len) {
 		panic(fmt.Sprintf("buf len is %d, but pointer len is %d", len(buf), pointer.Len))
 	}
-	bf.ReadPoint(pointer, buf)
+	return bf.ReadPoint(pointer, buf)
 }
 
 func (bf *BlockFile) PrefetchPart(colIdx uint64, id common.ID) error {
@@ -261,6 +351,10 @@ func (bf *BlockFile) PrefetchPart(colIdx uint64, id common.ID) error {
 	if !ok {
 		return errors.New(fmt.Sprintf("column block <blk:%d-col:%d> not found", id.BlockID, colIdx))
 	}
+	// the offsets of an encrypted file are not the ones on the disk
+	if bf.encrypted {
+		return nil
+	}
 	offset := pointer.Offset
 	sz := pointer.Len
 	return prefetch.Prefetch(bf.Fd(), uintptr(offset), uintptr(sz))
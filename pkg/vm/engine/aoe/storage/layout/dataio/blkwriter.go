@@ -17,7 +17,9 @@ package dataio
 import (
 	"bytes"
 	"encoding/binary"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 
 	"github.com/pierrec/lz4"
@@ -39,6 +42,9 @@ type ivecsSerializer func(*os.File, []vector.IVectorNode, *metadata.Block) error
 
 type blockFileGetter func(string, *metadata.Block) (*os.File, error)
 
+// blockChecksumMagic ends the checksum trailer of the block files
+const blockChecksumMagic uint32 = 0x41424b43
+
 var (
 	defaultVecsSerializer  = lz4CompressionVecs
 	defaultIVecsSerializer = lz4CompressionIVecs
@@ -135,6 +141,14 @@ func (bw *BlockWriter) commitFile(fname string) error {
 	if err != nil {
 		return err
 	}
+	key, err := bw.meta.Segment.Table.DataKey()
+	if err != nil {
+		return err
+	}
+	if key != nil {
+		_, err = encryption.EncryptFile(fname, name, key)
+		return err
+	}
 	err = os.Rename(fname, name)
 	return err
 }
@@ -154,6 +168,12 @@ func (bw *BlockWriter) createIOWriter(dir string, meta *metadata.Block) (*os.Fil
 }
 
 func (bw *BlockWriter) defaultPreprocessor(data []*gvector.Vector, meta *metadata.Block) error {
+	if !meta.TrySort() {
+		// the offsets of the deleted rows must stay valid, so the block
+		// keeps its row order and only the deleted rows are erased
+		mergesort.EraseRows(data, meta.GetDeletes())
+		return nil
+	}
 	err := mergesort.SortBlockColumns(data,meta.Segment.Table.Schema.PrimaryKey)
 	return err
 }
@@ -163,7 +183,8 @@ func (bw *BlockWriter) flushIndices(w *os.File, data []*gvector.Vector, meta *me
 	for idx, colDef := range meta.Segment.Table.Schema.ColDefs {
 		typ := colDef.Type
 		isPrimary := idx == meta.Segment.Table.Schema.PrimaryKey
-		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isPrimary)
+		isSorted := isPrimary && meta.GetDeletes() == nil
+		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isSorted)
 		if err != nil {
 			return err
 		}
@@ -352,7 +373,8 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 	for idx, colDef := range meta.Segment.Table.Schema.ColDefs {
 		typ := colDef.Type
 		isPrimary := idx == meta.Segment.Table.Schema.PrimaryKey
-		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isPrimary)
+		isSorted := isPrimary && meta.GetDeletes() == nil
+		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isSorted)
 		if err != nil {
 			return err
 		}
@@ -362,7 +384,29 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 	if err != nil {
 		return err
 	}
-	_, err = w.Write(ibuf)
+	if _, err = w.Write(ibuf); err != nil {
+		return err
+	}
+	return writeChecksums(w, buf.Bytes(), colBufs)
+}
+
+// writeChecksums writes the checksum trailer of the block file:
+// col01 checksum | col02 checksum | ... | header checksum | magic
+// The block files written before have nothing after the indices.
+func writeChecksums(w io.Writer, header []byte, colBufs [][]byte) error {
+	var buf bytes.Buffer
+	for _, colBuf := range colBufs {
+		if err := binary.Write(&buf, binary.BigEndian, base.Checksum(colBuf)); err != nil {
+			return err
+		}
+	}
+	if err := binary.Write(&buf, binary.BigEndian, base.Checksum(header)); err != nil {
+		return err
+	}
+	if err := binary.Write(&buf, binary.BigEndian, blockChecksumMagic); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
 	return err
 }
 
@@ -489,6 +533,8 @@ func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.B
 	// for compatibility
 	var indices []index.Index
 	ibuf, err := index.DefaultRWHelper.WriteIndices(indices)
-	_, err = w.Write(ibuf)
-	return err
+	if _, err = w.Write(ibuf); err != nil {
+		return err
+	}
+	return writeChecksums(w, buf.Bytes(), colBufs)
 }
@@ -15,6 +15,8 @@
 package dataio
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 )
@@ -66,7 +68,9 @@ func (cpf *ColPartFile) Read(buf []byte) (n int, err error) {
 
 	// SortedSegmentFile read one of its own Point
 	// UnsortedSegmentFile calls BlockFile to read a Point of .blk
-	cpf.SegmentFile.ReadPart(uint64(cpf.ID.Idx), *cpf.ID, buf)
+	if err = cpf.SegmentFile.ReadPart(uint64(cpf.ID.Idx), *cpf.ID, buf); err != nil {
+		return 0, fmt.Errorf("%s: %w", cpf.Name(), err)
+	}
 	return len(buf), nil
 }
 
@@ -90,6 +94,10 @@ func (cpf *ColPartFile) RefCount() int64 {
 	return cpf.SegmentFile.RefCount()
 }
 
+func (cpf *ColPartFile) TableID() uint64 {
+	return cpf.ID.TableID
+}
+
 type MockColPartFile struct {
 }
 
@@ -16,11 +16,14 @@ package dataio
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"os"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
 )
 
 type EmbedIndexFile struct {
@@ -37,9 +40,10 @@ type EmbedBlockIndexFile struct {
 type IndexFile struct {
 	os.File
 	common.RefHelper
-	ID   common.ID
-	Meta *base.IndexMeta
-	Info *fileStat
+	ID     common.ID
+	Meta   *base.IndexMeta
+	Info   *fileStat
+	reader io.ReaderAt
 }
 
 func newEmbedIndexFile(host base.ISegmentFile, meta *base.IndexMeta) common.IVFile {
@@ -71,7 +75,7 @@ func newEmbedBlockIndexFile(id *common.ID, host base.ISegmentFile, meta *base.In
 	return f
 }
 
-func newIndexFile(file *os.File, id *common.ID, meta *base.IndexMeta) common.IVFile {
+func newIndexFile(host base.ISegmentFile, file *os.File, id *common.ID, meta *base.IndexMeta) common.IVFile {
 	f := &IndexFile{
 		File: *file,
 		ID:   *id,
@@ -81,6 +85,11 @@ func newIndexFile(file *os.File, id *common.ID, meta *base.IndexMeta) common.IVF
 			osize: int64(meta.Ptr.Len),
 		},
 	}
+	r, err := index.OpenBitSlicedIndexFile(file, base.DataKeyOf(host))
+	if err != nil {
+		panic(fmt.Sprintf("Cannot open specified file %s: %s", file.Name(), err))
+	}
+	f.reader = r
 	f.OnZeroCB = f.close
 	f.Ref()
 	return f
@@ -118,13 +127,20 @@ func (f *IndexFile) GetFileType() common.FileType {
 	return common.DiskFile
 }
 
+func (f *IndexFile) TableID() uint64 {
+	return f.ID.TableID
+}
+
 func (f *IndexFile) Read(buf []byte) (n int, err error) {
 	if len(buf) != int(f.Meta.Ptr.Len) {
 		return 0, errors.New("length mismatch reading idx file")
 	}
-	if _, err := f.ReadAt(buf, f.Meta.Ptr.Offset); err != nil {
+	if _, err := f.reader.ReadAt(buf, f.Meta.Ptr.Offset); err != nil {
 		return 0, err
 	}
+	if err := f.Meta.Ptr.Verify(buf); err != nil {
+		return 0, fmt.Errorf("%s: %w", f.Name(), err)
+	}
 	return len(buf), nil
 }
 
@@ -152,13 +168,19 @@ func (f *EmbedIndexFile) Read(buf []byte) (n int, err error) {
 	if len(buf) != int(f.Meta.Ptr.Len) {
 		panic("logic error")
 	}
-	f.SegmentFile.ReadPoint(f.Meta.Ptr, buf)
+	if err = f.SegmentFile.ReadPoint(f.Meta.Ptr, buf); err != nil {
+		return 0, err
+	}
 	return len(buf), nil
 }
 
 func (bf *EmbedBlockIndexFile) Stat() common.FileInfo {
 	return bf.Info
 }
+
+func (bf *EmbedBlockIndexFile) TableID() uint64 {
+	return bf.ID.TableID
+}
 func (bf *EmbedBlockIndexFile) Ref() {
 	bf.SegmentFile.RefBlock(bf.ID)
 }
@@ -175,6 +197,8 @@ func (bf *EmbedBlockIndexFile) Read(buf []byte) (n int, err error) {
 	if len(buf) != int(bf.Meta.Ptr.Len) {
 		panic("logic error")
 	}
-	bf.SegmentFile.ReadBlockPoint(bf.ID, bf.Meta.Ptr, buf)
+	if err = bf.SegmentFile.ReadBlockPoint(bf.ID, bf.Meta.Ptr, buf); err != nil {
+		return 0, err
+	}
 	return len(buf), nil
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 )
 
@@ -42,6 +43,13 @@ type Manager struct {
 	SortedFiles   map[common.ID]base.ISegmentFile
 	Dir           string
 	Mock          bool
+
+	// FS stores the sorted segment files, it is the local dir by default
+	FS fileservice.FileService
+
+	// Keys returns the data key of the table to decrypt the block and index
+	// files, nil if encryption at rest is not configured
+	Keys func(tableId uint64) ([]byte, error)
 }
 
 func NewManager(dir string, mock bool) *Manager {
@@ -50,6 +58,7 @@ func NewManager(dir string, mock bool) *Manager {
 		SortedFiles:   make(map[common.ID]base.ISegmentFile),
 		Dir:           dir,
 		Mock:          mock,
+		FS:            fileservice.NewLocalFS(dir),
 	}
 }
 
@@ -70,7 +79,7 @@ func (mgr *Manager) RegisterUnsortedFiles(id common.ID) (base.ISegmentFile, erro
 	if mgr.Mock {
 		usf = NewMockSegmentFile(mgr.Dir, UnsortedSegFile, id)
 	} else {
-		usf = NewUnsortedSegmentFile(mgr.Dir, id)
+		usf = NewUnsortedSegmentFileWithKeys(mgr.Dir, id, mgr.Keys)
 	}
 	mgr.Lock()
 	defer mgr.Unlock()
@@ -88,7 +97,7 @@ func (mgr *Manager) RegisterSortedFiles(id common.ID) (base.ISegmentFile, error)
 	if mgr.Mock {
 		sf = NewMockSegmentFile(mgr.Dir, UnsortedSegFile, id)
 	} else {
-		sf = NewSortedSegmentFile(mgr.Dir, id)
+		sf = NewSortedSegmentFileWithKeys(mgr.Dir, id, mgr.FS, mgr.Keys)
 	}
 	mgr.Lock()
 	defer mgr.Unlock()
@@ -111,7 +120,7 @@ func (mgr *Manager) UpgradeFile(id common.ID) base.ISegmentFile {
 	if mgr.Mock {
 		sf = NewMockSegmentFile(mgr.Dir, UnsortedSegFile, id)
 	} else {
-		sf = NewSortedSegmentFile(mgr.Dir, id)
+		sf = NewSortedSegmentFileWithKeys(mgr.Dir, id, mgr.FS, mgr.Keys)
 	}
 	mgr.Lock()
 	_, ok := mgr.UnsortedFiles[id]
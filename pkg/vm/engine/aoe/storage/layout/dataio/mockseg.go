@@ -69,16 +69,18 @@ func (msf *MockSegmentFile) GetBlockIndicesMeta(id common.ID) *base.IndicesMeta
 	return nil
 }
 
-func (msf *MockSegmentFile) ReadPoint(ptr *base.Pointer, buf []byte) {
+func (msf *MockSegmentFile) ReadPoint(ptr *base.Pointer, buf []byte) error {
 	logutil.Debugf("(%s:%s) | ReadPoint (Off: %d, Len: %d) size: %d cap: %d", msf.TypeName, msf.FileName, ptr.Offset, ptr.Len, len(buf), cap(buf))
+	return nil
 }
 
 func (sf *MockSegmentFile) GetBlockSize(_ common.ID) int64 {
 	return 0
 }
 
-func (msf *MockSegmentFile) ReadBlockPoint(id common.ID, ptr *base.Pointer, buf []byte) {
+func (msf *MockSegmentFile) ReadBlockPoint(id common.ID, ptr *base.Pointer, buf []byte) error {
 	logutil.Debugf("(%s:%s) | ReadBlockPoint[%s] (Off: %d, Len: %d) size: %d cap: %d", msf.TypeName, msf.FileName, id.BlockString(), ptr.Offset, ptr.Len, len(buf), cap(buf))
+	return nil
 }
 
 func (sf *MockSegmentFile) DataCompressAlgo(id common.ID) int {
@@ -93,8 +95,9 @@ func (msf *MockSegmentFile) PartSize(colIdx uint64, id common.ID, _ bool) int64
 	return 0
 }
 
-func (msf *MockSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
+func (msf *MockSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) error {
 	logutil.Debugf("(%s:%s) | ReadPart %d %s size: %d cap: %d", msf.TypeName, msf.FileName, colIdx, id.SegmentString(), len(buf), cap(buf))
+	return nil
 }
 
 func (msf *MockSegmentFile) Close() error {
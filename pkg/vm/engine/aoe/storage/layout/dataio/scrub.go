@@ -0,0 +1,176 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataio
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
+)
+
+// Corruption is a damaged part of a segment, block or index file found by
+// a scrub. Part is "metadata" if the file cannot be parsed at all.
+type Corruption struct {
+	File string
+	Part string
+	Err  error
+}
+
+func (c *Corruption) String() string {
+	return fmt.Sprintf("%s: %s: %s", c.File, c.Part, c.Err)
+}
+
+// ScrubSegmentFile reads every column chunk and index of the sorted segment
+// file in the file service and verifies them against their checksums
+func ScrubSegmentFile(fs fileservice.FileService, id common.ID) []Corruption {
+	name := common.MakeSegmentObjectName(id.ToSegmentFileName())
+	r, err := fs.Open(name)
+	if err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	defer r.Close()
+	sf := &SortedSegmentFile{
+		ID:    id,
+		file:  r,
+		Parts: make(map[base.Key]*base.Pointer),
+		Meta:  NewFileMeta(),
+	}
+	if err = sf.initPointers(); err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	var corruptions []Corruption
+	for _, key := range sortedKeys(sf.Parts) {
+		if err = scrubPointer(r, r.Size(), sf.Parts[key]); err != nil {
+			corruptions = append(corruptions, Corruption{
+				File: name,
+				Part: fmt.Sprintf("block %d column %d", key.ID.BlockID, key.Col),
+				Err:  err,
+			})
+		}
+	}
+	return append(corruptions, scrubIndices(name, r, r.Size(), sf.Meta.Indices)...)
+}
+
+// ScrubBlockFile verifies the block file or transient block file
+// of the local disk like ScrubSegmentFile, key is asked for the data key
+// if the file is encrypted
+func ScrubBlockFile(name string, id common.ID, key func() ([]byte, error)) []Corruption {
+	f, err := os.Open(name)
+	if err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	bf := &BlockFile{
+		File:  *f,
+		ID:    id,
+		Parts: make(map[base.Key]*base.Pointer),
+		Meta:  NewFileMeta(),
+		Info: &fileStat{
+			size: info.Size(),
+			name: name,
+		},
+	}
+	if err = bf.initReader(key); err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	if err = bf.initPointers(id); err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	var corruptions []Corruption
+	size := bf.Info.Size()
+	for _, key := range sortedKeys(bf.Parts) {
+		if err = scrubPointer(bf.reader, size, bf.Parts[key]); err != nil {
+			corruptions = append(corruptions, Corruption{
+				File: name,
+				Part: fmt.Sprintf("column %d", key.Col),
+				Err:  err,
+			})
+		}
+	}
+	return append(corruptions, scrubIndices(name, bf.reader, size, bf.Meta.Indices)...)
+}
+
+// ScrubIndexFile verifies the bit sliced index file of the local disk,
+// key is asked for the data key if the file is encrypted
+func ScrubIndexFile(name string, key func() ([]byte, error)) []Corruption {
+	f, err := os.Open(name)
+	if err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	defer f.Close()
+	r, err := index.OpenBitSlicedIndexFile(f, key)
+	if err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	meta, err := index.DefaultRWHelper.ReadIndicesMeta(r)
+	if err != nil {
+		return []Corruption{{File: name, Part: "metadata", Err: err}}
+	}
+	return scrubIndices(name, r, r.Size(), meta)
+}
+
+func scrubIndices(name string, r io.ReaderAt, size int64, meta *base.IndicesMeta) []Corruption {
+	if meta == nil {
+		return nil
+	}
+	var corruptions []Corruption
+	for i, im := range meta.Data {
+		if err := scrubPointer(r, size, im.Ptr); err != nil {
+			corruptions = append(corruptions, Corruption{
+				File: name,
+				Part: fmt.Sprintf("index %d", i),
+				Err:  err,
+			})
+		}
+	}
+	return corruptions
+}
+
+// scrubPointer reads the data of the pointer and verifies its checksum
+func scrubPointer(r io.ReaderAt, size int64, ptr *base.Pointer) error {
+	if ptr.Offset < 0 || ptr.Offset+int64(ptr.Len) > size {
+		return fmt.Errorf("offset %d, length %d is out of the file", ptr.Offset, ptr.Len)
+	}
+	buf := make([]byte, ptr.Len)
+	if _, err := r.ReadAt(buf, ptr.Offset); err != nil {
+		return err
+	}
+	return ptr.Verify(buf)
+}
+
+// sortedKeys returns the keys of the parts ordered by block and column
+func sortedKeys(parts map[base.Key]*base.Pointer) []base.Key {
+	keys := make([]base.Key, 0, len(parts))
+	for key := range parts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ID.BlockID != keys[j].ID.BlockID {
+			return keys[i].ID.BlockID < keys[j].ID.BlockID
+		}
+		return keys[i].Col < keys[j].Col
+	})
+	return keys
+}
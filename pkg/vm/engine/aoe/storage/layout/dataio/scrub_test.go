@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataio
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func corruptFile(t *testing.T, name string, offset int64) {
+	f, err := os.OpenFile(name, os.O_RDWR, 0666)
+	assert.Nil(t, err)
+	defer f.Close()
+	buf := make([]byte, 1)
+	_, err = f.ReadAt(buf, offset)
+	assert.Nil(t, err)
+	buf[0] ^= 0xff
+	_, err = f.WriteAt(buf, offset)
+	assert.Nil(t, err)
+}
+
+func TestScrubBlockFile(t *testing.T) {
+	dir := initTestEnv(t)
+	rowCount, blkCount := uint64(10), uint64(4)
+	catalog := metadata.MockCatalog(dir, rowCount, blkCount, nil, nil)
+	defer catalog.Close()
+	schema := metadata.MockSchema(2)
+	gen := shard.NewMockIndexAllocator()
+	tbl := metadata.MockDBTable(catalog, "db1", schema, nil, 1, gen.Shard(uint64(100)))
+	segMeta := tbl.SimpleGetSegment(uint64(1))
+	assert.NotNil(t, segMeta)
+	meta := segMeta.SimpleGetBlock(uint64(1))
+	assert.NotNil(t, meta)
+
+	bat := mock.MockBatch(schema.Types(), rowCount)
+	w := NewBlockWriter(bat.Vecs, meta, dir)
+	assert.Nil(t, w.Execute())
+
+	id := *meta.AsCommonID()
+	name := w.GetFileName()
+	assert.Empty(t, ScrubBlockFile(name, id, nil))
+
+	segFile := NewUnsortedSegmentFile(dir, *meta.Segment.AsCommonID())
+	bf := NewBlockFile(segFile, id, nil)
+	ptr := bf.Parts[base.Key{Col: 1, ID: id.AsBlockID()}]
+	assert.NotZero(t, ptr.Checksum)
+	buf := make([]byte, ptr.Len)
+	assert.Nil(t, bf.ReadPart(1, id, buf))
+	bf.File.Close()
+
+	corruptFile(t, name, ptr.Offset+int64(ptr.Len)/2)
+	corruptions := ScrubBlockFile(name, id, nil)
+	assert.Equal(t, 1, len(corruptions))
+	assert.Equal(t, "column 1", corruptions[0].Part)
+	assert.True(t, errors.Is(corruptions[0].Err, base.ErrChecksumMismatch))
+
+	bf = NewBlockFile(segFile, id, nil)
+	assert.Nil(t, bf.ReadPart(0, id, make([]byte, bf.Parts[base.Key{Col: 0, ID: id.AsBlockID()}].Len)))
+	err := bf.ReadPart(1, id, buf)
+	assert.True(t, errors.Is(err, base.ErrChecksumMismatch))
+	// A partial read is verified against the checksum of the column block
+	err = bf.ReadPart(1, id, buf[:1])
+	assert.True(t, errors.Is(err, base.ErrChecksumMismatch))
+	bf.File.Close()
+
+	// A zero checksum is verified too
+	zero := &base.Pointer{Len: 1}
+	zero.SetChecksum(0)
+	assert.True(t, errors.Is(zero.Verify([]byte{1}), base.ErrChecksumMismatch))
+
+	// The row count in the header is verified on load
+	corruptFile(t, name, 5)
+	corruptions = ScrubBlockFile(name, id, nil)
+	assert.Equal(t, 1, len(corruptions))
+	assert.Equal(t, "metadata", corruptions[0].Part)
+	assert.True(t, errors.Is(corruptions[0].Err, base.ErrChecksumMismatch))
+	assert.Panics(t, func() {
+		NewBlockFile(segFile, id, nil)
+	})
+}
@@ -31,9 +31,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/pierrec/lz4"
 )
 
@@ -50,9 +54,14 @@ const (
 	blkRangeSize = 24
 	colSizeSize  = 8
 	colPosSize   = 8
+	checksumSize = 4
 )
 
-const Version uint64 = 1
+// Version 2 adds the checksums of the column chunks and the metadata
+const Version uint64 = 2
+
+// checksumVersion is the first version whose files carry checksums
+const checksumVersion uint64 = 2
 
 type FileDestoryer = func(string) error
 
@@ -68,6 +77,10 @@ type SegmentWriter struct {
 	size       int64
 	fileHandle *os.File
 	destoryer  FileDestoryer
+
+	// fs is the remote file service which the segment file is uploaded to,
+	// the segment file is kept in the local dir if it is nil
+	fs fileservice.FileService
 	//preprocessor func([]*batch.Batch, *metadata.Segment) error
 
 	// fileGetter is createFile()，use dir&TableID&SegmentID to
@@ -111,6 +124,10 @@ func (sw *SegmentWriter) SetFileGetter(f func(string, *metadata.Segment) (*os.Fi
 	sw.fileGetter = f
 }
 
+func (sw *SegmentWriter) SetFileService(fs fileservice.FileService) {
+	sw.fs = fs
+}
+
 func (sw *SegmentWriter) GetDestoryer() FileDestoryer {
 	return sw.destoryer
 }
@@ -133,6 +150,14 @@ func (sw *SegmentWriter) commitFile(fname string) (string, error) {
 	if err != nil {
 		return name, err
 	}
+	key, err := sw.meta.Table.DataKey()
+	if err != nil {
+		return name, err
+	}
+	if key != nil {
+		sw.size, err = encryption.EncryptFile(fname, name, key)
+		return name, err
+	}
 	err = os.Rename(fname, name)
 	return name, err
 }
@@ -215,9 +240,33 @@ func (sw *SegmentWriter) Execute() error {
 		logutil.Infof("SegmentFile | \"%s\" | Removed | Reason: \"%s\"", name, reason)
 		return os.Remove(name)
 	}
+	if err == nil && sw.fs != nil {
+		err = sw.upload(name)
+	}
 	return err
 }
 
+// upload uploads the segment file to the file service and removes the local one
+func (sw *SegmentWriter) upload(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	object := common.MakeSegmentObjectName(sw.meta.AsCommonID().ToSegmentFileName())
+	err = sw.fs.Write(object, f, sw.size)
+	f.Close()
+	if err != nil {
+		os.Remove(name)
+		return err
+	}
+	logutil.Infof("SegmentFile | \"%s\" | Uploaded | Size %d", object, sw.size)
+	sw.destoryer = func(reason string) error {
+		logutil.Infof("SegmentFile | \"%s\" | Removed | Reason: \"%s\"", object, reason)
+		return sw.fs.Delete(object)
+	}
+	return os.Remove(name)
+}
+
 func (sw *SegmentWriter) GetSize() int64 {
 	return sw.size
 }
@@ -294,8 +343,9 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 		startPosSize +
 		endPosSize +
 		int(blkCnt)*(blkCountSize+2*blkIdxSize+blkRangeSize) +
-		int(blkCnt)*colCnt*(colSizeSize*2) +
-		colCnt*colPosSize
+		int(blkCnt)*colCnt*(colSizeSize*2+checksumSize) +
+		colCnt*colPosSize +
+		checksumSize
 
 	if _, err = w.Seek(int64(metaSize), io.SeekStart); err != nil {
 		return err
@@ -311,13 +361,39 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 		typs = append(typs, def.Type)
 	}
 
+	// the sort purges the deleted rows: the rows left are sorted to the
+	// front of the segment and the tail repeats the last of them. If every
+	// row is deleted, the rows keep their order and are erased instead
+	meta.StartSort()
+	var deletes []*roaring.Bitmap
+	purged := false
+	for _, blk := range meta.BlockSet {
+		blkDeletes := blk.GetDeletes()
+		deletes = append(deletes, blkDeletes)
+		purged = purged || blkDeletes != nil
+	}
+
 	// get the shuffle info of the column
 	iter.Reset(uint16(pkIdx))
 	pkColumn, err := iter.FetchColumn()
 	if err != nil {
 		return err
 	}
-	if err = preprocessColumn(pkColumn, &sortedIdx, true); err != nil {
+	sorted := true
+	var order []mergesort.RowPos
+	if purged {
+		order = mergesort.PurgeOrder(pkColumn, deletes)
+		sorted = order != nil
+	}
+	switch {
+	case order != nil:
+		mergesort.Purge(pkColumn, order)
+	case sorted:
+		err = preprocessColumn(pkColumn, &sortedIdx, true)
+	default:
+		eraseColumn(pkColumn, deletes)
+	}
+	if err != nil {
 		return err
 	}
 	// could safely release vectors' mem nodes here
@@ -326,7 +402,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	for i := 0; i < colCnt; i++ {
 		if i == pkIdx {
 			// build zone map
-			zmi, err := index.BuildSegmentZoneMapIndex(pkColumn, typs[i], int16(i), true)
+			zmi, err := index.BuildSegmentZoneMapIndex(pkColumn, typs[i], int16(i), sorted)
 			if err != nil {
 				return err
 			}
@@ -349,7 +425,15 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 		if err != nil {
 			return err
 		}
-		if err = preprocessColumn(column, &sortedIdx, false); err != nil {
+		switch {
+		case order != nil:
+			mergesort.Purge(column, order)
+		case sorted:
+			err = preprocessColumn(column, &sortedIdx, false)
+		default:
+			eraseColumn(column, deletes)
+		}
+		if err != nil {
 			return err
 		}
 		zmi, err := index.BuildSegmentZoneMapIndex(column, typs[i], int16(i), false)
@@ -401,6 +485,9 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 			return err
 		}
 	}
+	if err = binary.Write(&metaBuf, binary.BigEndian, base.Checksum(metaBuf.Bytes())); err != nil {
+		return err
+	}
 
 	if _, err = w.Write(metaBuf.Bytes()); err != nil {
 		return err
@@ -428,6 +515,12 @@ func preprocessColumn(column []*vector.Vector, sortedIdx *[]uint16, isPrimary bo
 	return nil
 }
 
+func eraseColumn(column []*vector.Vector, deletes []*roaring.Bitmap) {
+	for i, vec := range column {
+		mergesort.EraseRows([]*vector.Vector{vec}, deletes[i])
+	}
+}
+
 func processColumn(column []*vector.Vector, metaBuf, dataBuf *bytes.Buffer) (int, error) {
 	colSz := 0
 	for _, vec := range column {
@@ -446,6 +539,9 @@ func processColumn(column []*vector.Vector, metaBuf, dataBuf *bytes.Buffer) (int
 		if err = binary.Write(metaBuf, binary.BigEndian, uint64(colSize)); err != nil {
 			return 0, err
 		}
+		if err = binary.Write(metaBuf, binary.BigEndian, base.Checksum(cbuf)); err != nil {
+			return 0, err
+		}
 		if err = binary.Write(dataBuf, binary.BigEndian, cbuf); err != nil {
 			return 0, err
 		}
@@ -27,6 +27,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/prefetch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -35,30 +37,51 @@ import (
 // SortedSegmentFile file structure:
 // header | reserved | algo | datalen | colCntlen |
 // blkId 01 | blkCount 01| blkPreIdx 01| blkIdx 01| blkId 02 | blkCount 02...
-// col01 : blkdatalen 01 | blkdata originlen 01| blkdatalen 02 | blkdata originlen 02...
-// col02 : blkdatalen 01 | blkdata originlen 01| blkdatalen 02 | blkdata originlen 02...
+// col01 : blkdatalen 01 | blkdata originlen 01| blkdata checksum 01 | blkdatalen 02 ...
+// col02 : blkdatalen 01 | blkdata originlen 01| blkdata checksum 01 | blkdatalen 02 ...
 // ...
-// startPos | endPos | col01Pos | col02Pos ...
+// startPos | endPos | col01Pos | col02Pos ... | metadata checksum
 // col01 : blkdata01 | blkdata02 | blkdata03 ...
 // col02 : blkdata01 | blkdata02 | blkdata03 ...
 // ...
+//
+// The file is read through the file service, it is on the local disk
+// or in an object store. The checksums are CRC32C, the files of Version 1
+// are written without them.
 type SortedSegmentFile struct {
 	common.RefHelper
-	ID common.ID
-	os.File
+	ID         common.ID
+	fs         fileservice.FileService
+	file       fileservice.File
 	Refs       int32
 	Parts      map[base.Key]*base.Pointer
 	Meta       *FileMeta
 	BlocksMeta map[common.ID]*FileMeta
 	Info       *fileStat
 	DataAlgo   int
+	keys       func(uint64) ([]byte, error)
 }
 
 func NewSortedSegmentFile(dirname string, id common.ID) base.ISegmentFile {
+	return NewSortedSegmentFileWithFS(dirname, id, fileservice.NewLocalFS(dirname))
+}
+
+// NewSortedSegmentFileWithFS opens the segment file stored in the file service
+func NewSortedSegmentFileWithFS(dirname string, id common.ID, fs fileservice.FileService) base.ISegmentFile {
+	return NewSortedSegmentFileWithKeys(dirname, id, fs, nil)
+}
+
+// NewSortedSegmentFileWithKeys opens the segment file stored in the file
+// service, the encrypted index files of the segment are decrypted with the
+// data key returned by keys for the table. The segment file itself is
+// decrypted by the file service.
+func NewSortedSegmentFileWithKeys(dirname string, id common.ID, fs fileservice.FileService, keys func(uint64) ([]byte, error)) base.ISegmentFile {
 	name := common.MakeSegmentFileName(dirname, id.ToSegmentFileName(), id.TableID, false)
 	sf := &SortedSegmentFile{
 		Parts:      make(map[base.Key]*base.Pointer),
 		ID:         id,
+		fs:         fs,
+		keys:       keys,
 		Meta:       NewFileMeta(),
 		BlocksMeta: make(map[common.ID]*FileMeta),
 		Info: &fileStat{
@@ -66,22 +89,31 @@ func NewSortedSegmentFile(dirname string, id common.ID) base.ISegmentFile {
 		},
 	}
 
-	if info, err := os.Stat(name); os.IsNotExist(err) {
+	r, err := fs.Open(common.MakeSegmentObjectName(id.ToSegmentFileName()))
+	if err == fileservice.ErrNotFound {
 		panic(fmt.Sprintf("Specified file %s not existed", name))
-	} else {
-		sf.Info.size = info.Size()
-	}
-	r, err := os.OpenFile(name, os.O_RDONLY, 0666)
-	if err != nil {
+	} else if err != nil {
 		panic(fmt.Sprintf("Cannot open specified file %s: %s", name, err))
 	}
 
-	sf.File = *r
-	sf.initPointers()
+	sf.file = r
+	sf.Info.size = r.Size()
+	if err = sf.initPointers(); err != nil {
+		r.Close()
+		panic(fmt.Sprintf("Cannot load specified file %s: %s", name, err))
+	}
 	sf.OnZeroCB = sf.close
 	return sf
 }
 
+// DataKey returns the data key of the table of the segment
+func (sf *SortedSegmentFile) DataKey() ([]byte, error) {
+	if sf.keys == nil {
+		return nil, encryption.ErrNoKeyring
+	}
+	return sf.keys(sf.ID.TableID)
+}
+
 func (sf *SortedSegmentFile) MakeVirtualIndexFile(meta *base.IndexMeta) common.IVFile {
 	return newEmbedIndexFile(sf, meta)
 }
@@ -91,7 +123,7 @@ func (sf *SortedSegmentFile) MakeVirtualBlkIndexFile(id common.ID, meta *base.In
 }
 
 func (sf *SortedSegmentFile) MakeVirtualSeparateIndexFile(file *os.File, id *common.ID, meta *base.IndexMeta) common.IVFile {
-	return newIndexFile(file, id, meta)
+	return newIndexFile(sf, file, id, meta)
 }
 
 func (sf *SortedSegmentFile) MakeVirtualPartFile(id *common.ID) common.IVFile {
@@ -106,6 +138,15 @@ func (sf *SortedSegmentFile) GetDir() string {
 	return filepath.Dir(sf.Name())
 }
 
+// Name returns the name of the segment file in the local data dir
+func (sf *SortedSegmentFile) Name() string {
+	return sf.Info.name
+}
+
+func (sf *SortedSegmentFile) Close() error {
+	return sf.file.Close()
+}
+
 func (sf *SortedSegmentFile) close() {
 	sf.Close()
 	sf.Destory()
@@ -127,14 +168,15 @@ func (msf *SortedSegmentFile) RegisterTBlock(id common.ID) (base.IBlockFile, err
 	panic("not supported")
 }
 
-func (sf *SortedSegmentFile) initPointers() {
+func (sf *SortedSegmentFile) initPointers() error {
+	r := io.NewSectionReader(sf.file, 0, sf.file.Size())
 	// read metadata-1
 	sz := headerSize + reservedSize + algoSize + blkCntSize + colCntSize
 	buf := make([]byte, sz)
-	metaBuf := bytes.NewBuffer(buf)
-	if err := binary.Read(&sf.File, binary.BigEndian, metaBuf.Bytes()); err != nil {
-		panic(err)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
 	}
+	metaBuf := bytes.NewBuffer(buf)
 
 	blkCnt := uint32(0)
 	colCnt := uint32(0)
@@ -143,36 +185,54 @@ func (sf *SortedSegmentFile) initPointers() {
 	reserved := make([]byte, 64)
 	var err error
 	if err = binary.Read(metaBuf, binary.BigEndian, &header); err != nil {
-		panic(err)
+		return err
 	}
-	if version := encoding.DecodeUint64(header); version != Version {
-		panic("version mismatched")
+	version := encoding.DecodeUint64(header)
+	if version == 0 || version > Version {
+		return errors.New("version mismatched")
 	}
+	checksummed := version >= checksumVersion
 	if err = binary.Read(metaBuf, binary.BigEndian, &reserved); err != nil {
-		panic(err)
+		return err
 	}
 	if err = binary.Read(metaBuf, binary.BigEndian, &algo); err != nil {
-		panic(err)
+		return err
 	}
 	if err = binary.Read(metaBuf, binary.BigEndian, &blkCnt); err != nil {
-		panic(err)
+		return err
 	}
 	if err = binary.Read(metaBuf, binary.BigEndian, &colCnt); err != nil {
-		panic(err)
+		return err
 	}
 
 	// read metadata-2
-	sz = startPosSize +
-		endPosSize +
-		int(blkCnt)*(blkCountSize+2*blkIdxSize+blkRangeSize) +
-		int(blkCnt*colCnt)*(colSizeSize*2) +
-		int(colCnt)*colPosSize
-
-	buf = make([]byte, sz)
-	metaBuf = bytes.NewBuffer(buf)
-	if err = binary.Read(&sf.File, binary.BigEndian, metaBuf.Bytes()); err != nil {
-		panic(err)
+	partSize := colSizeSize * 2
+	if checksummed {
+		partSize += checksumSize
+	}
+	sz2 := int64(startPosSize+endPosSize) +
+		int64(blkCnt)*(blkCountSize+2*blkIdxSize+blkRangeSize) +
+		int64(blkCnt)*int64(colCnt)*int64(partSize) +
+		int64(colCnt)*colPosSize
+	if checksummed {
+		sz2 += checksumSize
+	}
+	if int64(sz)+sz2 > sf.file.Size() {
+		return fmt.Errorf("metadata of %d blocks and %d columns is out of the file", blkCnt, colCnt)
+	}
+
+	buf2 := make([]byte, sz2)
+	if _, err = io.ReadFull(r, buf2); err != nil {
+		return err
+	}
+	if checksummed {
+		ptr := &base.Pointer{Len: uint64(len(buf) + len(buf2) - checksumSize)}
+		ptr.SetChecksum(binary.BigEndian.Uint32(buf2[len(buf2)-checksumSize:]))
+		if err = ptr.Verify(append(buf, buf2[:len(buf2)-checksumSize]...)); err != nil {
+			return fmt.Errorf("metadata: %w", err)
+		}
 	}
+	metaBuf = bytes.NewBuffer(buf2)
 
 	blkCounts := make([]uint64, blkCnt)
 	idxBuf := make([]byte, blkIdxSize)
@@ -182,29 +242,29 @@ func (sf *SortedSegmentFile) initPointers() {
 
 	for i := uint32(0); i < blkCnt; i++ {
 		if err = binary.Read(metaBuf, binary.BigEndian, &blkCounts[i]); err != nil {
-			panic(err)
+			return err
 		}
 		if err = binary.Read(metaBuf, binary.BigEndian, &idxBuf); err != nil {
-			panic(err)
+			return err
 		}
 
 		if _, err = metaBuf.Read(rangeBuf); err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+			return err
 		}
 
 		if !bytes.Equal(idxBuf, []byte{}) {
 			preIndices[i] = &metadata.LogIndex{}
 			if err = preIndices[i].UnMarshal(idxBuf); err != nil {
-				panic(err)
+				return err
 			}
 		}
 		if err = binary.Read(metaBuf, binary.BigEndian, &idxBuf); err != nil {
-			panic(err)
+			return err
 		}
 		if !bytes.Equal(idxBuf, []byte{}) {
 			indices[i] = &metadata.LogIndex{}
 			if err = indices[i].UnMarshal(idxBuf); err != nil {
-				panic(err)
+				return err
 			}
 		}
 	}
@@ -222,11 +282,19 @@ func (sf *SortedSegmentFile) initPointers() {
 			key.ID.Idx = uint16(i)
 			sf.Parts[key] = &base.Pointer{}
 			if err = binary.Read(metaBuf, binary.BigEndian, &sf.Parts[key].Len); err != nil {
-				panic(err)
+				return err
 			}
 			if err = binary.Read(metaBuf, binary.BigEndian, &sf.Parts[key].OriginLen); err != nil {
-				panic(err)
+				return err
+			}
+			if !checksummed {
+				continue
 			}
+			var checksum uint32
+			if err = binary.Read(metaBuf, binary.BigEndian, &checksum); err != nil {
+				return err
+			}
+			sf.Parts[key].SetChecksum(checksum)
 		}
 	}
 
@@ -235,14 +303,14 @@ func (sf *SortedSegmentFile) initPointers() {
 	colPos := make([]int64, colCnt)
 
 	if err = binary.Read(metaBuf, binary.BigEndian, &startPos); err != nil {
-		panic(err)
+		return err
 	}
 	if err = binary.Read(metaBuf, binary.BigEndian, &endPos); err != nil {
-		panic(err)
+		return err
 	}
 	for i := 0; i < int(colCnt); i++ {
 		if err = binary.Read(metaBuf, binary.BigEndian, &colPos[i]); err != nil {
-			panic(err)
+			return err
 		}
 	}
 
@@ -263,24 +331,25 @@ func (sf *SortedSegmentFile) initPointers() {
 	}
 
 	// skip data
-	if _, err = sf.Seek(curOffset, io.SeekStart); err != nil {
-		panic(err)
+	if _, err = r.Seek(curOffset, io.SeekStart); err != nil {
+		return err
 	}
 
 	// read index
-	idxMeta, err := index.DefaultRWHelper.ReadIndicesMeta(sf.File)
+	idxMeta, err := index.DefaultRWHelper.ReadIndicesMeta(r)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("indices: %w", err)
 	}
 	sf.Meta.Indices = idxMeta
 
 	// read footer
 	footer := make([]byte, 64)
-	if err = binary.Read(&sf.File, binary.BigEndian, &footer); err != nil {
-		panic(err)
+	if _, err = io.ReadFull(r, footer); err != nil {
+		return fmt.Errorf("footer: %w", err)
 	}
 
 	sf.DataAlgo = int(algo)
+	return nil
 }
 
 func (sf *SortedSegmentFile) GetFileType() common.FileType {
@@ -302,24 +371,25 @@ func (sf *SortedSegmentFile) GetBlockIndicesMeta(id common.ID) *base.IndicesMeta
 func (sf *SortedSegmentFile) Destory() {
 	name := sf.Name()
 	logutil.Infof(" %s | SegmentFile | Destorying", name)
-	err := os.Remove(name)
+	err := sf.fs.Delete(common.MakeSegmentObjectName(sf.ID.ToSegmentFileName()))
 	if err != nil {
 		panic(err)
 	}
 }
 
-func (sf *SortedSegmentFile) ReadPoint(ptr *base.Pointer, buf []byte) {
-	n, err := sf.ReadAt(buf, ptr.Offset)
+func (sf *SortedSegmentFile) ReadPoint(ptr *base.Pointer, buf []byte) error {
+	n, err := sf.file.ReadAt(buf, ptr.Offset)
 	if err != nil {
 		panic(fmt.Sprintf("logic error: %s", err))
 	}
 	if n != int(ptr.Len) {
 		panic("logic error")
 	}
+	return ptr.Verify(buf)
 }
 
-func (sf *SortedSegmentFile) ReadBlockPoint(id common.ID, ptr *base.Pointer, buf []byte) {
-	sf.ReadPoint(ptr, buf)
+func (sf *SortedSegmentFile) ReadBlockPoint(id common.ID, ptr *base.Pointer, buf []byte) error {
+	return sf.ReadPoint(ptr, buf)
 }
 
 func (sf *SortedSegmentFile) GetBlockSize(_ common.ID) int64 {
@@ -345,7 +415,7 @@ func (sf *SortedSegmentFile) PartSize(colIdx uint64, id common.ID, isOrigin bool
 	return int64(pointer.Len)
 }
 
-func (sf *SortedSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
+func (sf *SortedSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) error {
 	key := base.Key{
 		Col: colIdx,
 		ID:  id,
@@ -358,7 +428,7 @@ func (sf *SortedSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
 		panic(fmt.Sprintf("buf len is %d, but pointer len is %d", len(buf), pointer.Len))
 	}
 
-	sf.ReadPoint(pointer, buf)
+	return sf.ReadPoint(pointer, buf)
 }
 
 func (sf *SortedSegmentFile) PrefetchPart(colIdx uint64, id common.ID) error {
@@ -372,19 +442,27 @@ func (sf *SortedSegmentFile) PrefetchPart(colIdx uint64, id common.ID) error {
 	}
 	offset := pointer.Offset
 	sz := pointer.Len
-	// integrate vfs later
-	return prefetch.Prefetch(sf.Fd(), uintptr(offset), uintptr(sz))
+	// only the files on the local disk are read ahead
+	f, ok := sf.file.(*fileservice.LocalFile)
+	if !ok {
+		return nil
+	}
+	return prefetch.Prefetch(f.Fd(), uintptr(offset), uintptr(sz))
 }
 
 func (sf *SortedSegmentFile) CopyTo(dir string) error {
 	name := filepath.Base(sf.Name())
 	dest := filepath.Join(dir, name)
-	_, err := CopyFile(sf.Name(), dest)
+	_, err := CopyFromReader(io.NewSectionReader(sf.file, 0, sf.file.Size()), dest)
 	return err
 }
 
 func (sf *SortedSegmentFile) LinkTo(dir string) error {
+	f, ok := sf.file.(*fileservice.LocalFile)
+	if !ok {
+		return sf.CopyTo(dir)
+	}
 	name := filepath.Base(sf.Name())
 	dest := filepath.Join(dir, name)
-	return os.Link(sf.Name(), dest)
+	return os.Link(f.Name(), dest)
 }
@@ -228,7 +228,9 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 		node := common.GPool.Alloc(uint64(sz))
 		defer common.GPool.Free(node)
 		buf := node.Buf[:sz]
-		file.ReadPart(uint64(i), id, buf)
+		if err := file.ReadPart(uint64(i), id, buf); err != nil {
+			panic(err)
+		}
 		obuf := make([]byte, osz)
 		_, err := compress.Decompress(buf, obuf, compress.Lz4)
 		if err != nil {
@@ -308,22 +310,22 @@ func (f *TransientBlockFile) GetIndicesMeta() *base.IndicesMeta {
 	return nil
 }
 
-func (f *TransientBlockFile) ReadPoint(ptr *base.Pointer, buf []byte) {
+func (f *TransientBlockFile) ReadPoint(ptr *base.Pointer, buf []byte) error {
 	f.mu.RLock()
 	file := f.files[len(f.files)-1]
 	file.Ref()
 	f.mu.RUnlock()
-	file.ReadPoint(ptr, buf)
-	file.Unref()
+	defer file.Unref()
+	return file.ReadPoint(ptr, buf)
 }
 
-func (f *TransientBlockFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
+func (f *TransientBlockFile) ReadPart(colIdx uint64, id common.ID, buf []byte) error {
 	f.mu.RLock()
 	file := f.files[len(f.files)-1]
 	file.Ref()
 	f.mu.RUnlock()
-	file.ReadPart(colIdx, id, buf)
-	file.Unref()
+	defer file.Unref()
+	return file.ReadPart(colIdx, id, buf)
 }
 
 func (f *TransientBlockFile) PartSize(colIdx uint64, id common.ID, isOrigin bool) int64 {
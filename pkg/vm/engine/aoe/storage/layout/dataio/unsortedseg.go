@@ -22,6 +22,7 @@ import (
 	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 )
 
@@ -34,12 +35,20 @@ type UnsortedSegmentFile struct {
 	TBlocks map[common.ID]base.IBaseFile
 	Dir     string
 	Info    *fileStat
+	keys    func(uint64) ([]byte, error)
 }
 
 func NewUnsortedSegmentFile(dirname string, id common.ID) base.ISegmentFile {
+	return NewUnsortedSegmentFileWithKeys(dirname, id, nil)
+}
+
+// NewUnsortedSegmentFileWithKeys returns the segment file whose encrypted
+// block files are decrypted with the data key returned by keys for the table
+func NewUnsortedSegmentFileWithKeys(dirname string, id common.ID, keys func(uint64) ([]byte, error)) base.ISegmentFile {
 	usf := &UnsortedSegmentFile{
 		ID:      id,
 		Dir:     dirname,
+		keys:    keys,
 		Blocks:  make(map[common.ID]base.IBlockFile),
 		TBlocks: make(map[common.ID]base.IBaseFile),
 		Info: &fileStat{
@@ -50,6 +59,14 @@ func NewUnsortedSegmentFile(dirname string, id common.ID) base.ISegmentFile {
 	return usf
 }
 
+// DataKey returns the data key of the table of the segment
+func (sf *UnsortedSegmentFile) DataKey() ([]byte, error) {
+	if sf.keys == nil {
+		return nil, encryption.ErrNoKeyring
+	}
+	return sf.keys(sf.ID.TableID)
+}
+
 func (sf *UnsortedSegmentFile) close() {
 	sf.Destory()
 }
@@ -120,7 +137,7 @@ func (sf *UnsortedSegmentFile) MakeVirtualBlkIndexFile(id common.ID, meta *base.
 }
 
 func (sf *UnsortedSegmentFile) MakeVirtualSeparateIndexFile(file *os.File, id *common.ID, meta *base.IndexMeta) common.IVFile {
-	return newIndexFile(file, id, meta)
+	return newIndexFile(sf, file, id, meta)
 }
 
 func (sf *UnsortedSegmentFile) MakeVirtualPartFile(id *common.ID) common.IVFile {
@@ -172,7 +189,7 @@ func (sf *UnsortedSegmentFile) AddBlock(id common.ID, bf base.IBlockFile) {
 	atomic.AddInt64(&sf.Info.size, bf.Stat().Size())
 }
 
-func (sf *UnsortedSegmentFile) ReadPoint(ptr *base.Pointer, buf []byte) {
+func (sf *UnsortedSegmentFile) ReadPoint(ptr *base.Pointer, buf []byte) error {
 	panic("not supported")
 }
 
@@ -186,14 +203,14 @@ func (sf *UnsortedSegmentFile) GetBlockSize(id common.ID) int64 {
 	return blk.Stat().Size()
 }
 
-func (sf *UnsortedSegmentFile) ReadBlockPoint(id common.ID, ptr *base.Pointer, buf []byte) {
+func (sf *UnsortedSegmentFile) ReadBlockPoint(id common.ID, ptr *base.Pointer, buf []byte) error {
 	sf.RLock()
 	blk, ok := sf.Blocks[id.AsBlockID()]
 	if !ok {
 		panic("logic error")
 	}
 	sf.RUnlock()
-	blk.ReadPoint(ptr, buf)
+	return blk.ReadPoint(ptr, buf)
 }
 
 func (sf *UnsortedSegmentFile) DataCompressAlgo(id common.ID) int {
@@ -216,14 +233,14 @@ func (sf *UnsortedSegmentFile) PartSize(colIdx uint64, id common.ID, isOrigin bo
 	return blk.PartSize(colIdx, id, isOrigin)
 }
 
-func (sf *UnsortedSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
+func (sf *UnsortedSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) error {
 	sf.RLock()
 	blk, ok := sf.Blocks[id.AsBlockID()]
 	if !ok {
 		panic("logic error")
 	}
 	sf.RUnlock()
-	blk.ReadPart(colIdx, id, buf)
+	return blk.ReadPart(colIdx, id, buf)
 }
 
 func (sf *UnsortedSegmentFile) PrefetchPart(colIdx uint64, id common.ID) error {
@@ -129,6 +129,28 @@ func (holder *BlockIndexHolder) EvalFilter(colIdx int, ctx *FilterCtx) error {
 	return nil
 }
 
+// CollectMinMax returns the min and max values of the column in the zone
+// map of the block
+func (holder *BlockIndexHolder) CollectMinMax(colIdx int) (min interface{}, max interface{}, err error) {
+	holder.self.RLock()
+	defer holder.self.RUnlock()
+	idxes, ok := holder.self.colIndices[colIdx]
+	if !ok || len(idxes) == 0 {
+		return nil, nil, errors.New("no index found")
+	}
+	for _, idx := range idxes {
+		node := idx.GetManagedNode()
+		zonemap, ok := node.DataNode.(*BlockZoneMapIndex)
+		if ok {
+			min, max = zonemap.MinV, zonemap.MaxV
+		}
+		if err = node.Close(); err != nil || ok {
+			return
+		}
+	}
+	return nil, nil, errors.New("zone map not found")
+}
+
 func (holder *BlockIndexHolder) close() {
 	for _, idxes := range holder.self.colIndices {
 		for _, idx := range idxes {
@@ -292,7 +314,11 @@ func (holder *BlockIndexHolder) LoadIndex(segFile base.ISegmentFile, filename st
 		if err != nil {
 			panic(err)
 		}
-		idxMeta, err := DefaultRWHelper.ReadIndicesMeta(*file)
+		r, err := OpenBitSlicedIndexFile(file, base.DataKeyOf(segFile))
+		if err != nil {
+			panic(err)
+		}
+		idxMeta, err := DefaultRWHelper.ReadIndicesMeta(r)
 		if err != nil {
 			panic(err)
 		}
@@ -21,6 +21,7 @@ import (
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"io"
 	"os"
@@ -31,11 +32,20 @@ var (
 	DefaultRWHelper = new(RWHelper)
 )
 
+// checksumFlag leads the indices written with the checksums of their
+// payloads, the indices written before start with the count directly:
+// checksumFlag | cnt | types | cols | (len | checksum | payload)...
+const checksumFlag int16 = -1
+
 type RWHelper struct{}
 
 func (h *RWHelper) WriteIndices(indices []Index) ([]byte, error) {
 	var buf bytes.Buffer
-	_, err := buf.Write(encoding.EncodeInt16(int16(len(indices))))
+	_, err := buf.Write(encoding.EncodeInt16(checksumFlag))
+	if err != nil {
+		return nil, err
+	}
+	_, err = buf.Write(encoding.EncodeInt16(int16(len(indices))))
 	if err != nil {
 		return nil, err
 	}
@@ -54,20 +64,37 @@ func (h *RWHelper) WriteIndices(indices []Index) ([]byte, error) {
 	for _, i := range indices {
 		ibuf, _ := i.Marshal()
 		buf.Write(encoding.EncodeInt32(int32(len(ibuf))))
+		buf.Write(encoding.EncodeUint32(base.Checksum(ibuf)))
 		buf.Write(ibuf)
 	}
 
 	return buf.Bytes(), nil
 }
 
+// readCount reads the count of the indices and whether the payloads
+// carry checksums
+func readCount(r io.Reader) (cnt int16, checksummed bool, err error) {
+	twoBytes := make([]byte, 2)
+	if _, err = io.ReadFull(r, twoBytes); err != nil {
+		return 0, false, err
+	}
+	cnt = encoding.DecodeInt16(twoBytes)
+	if cnt != checksumFlag {
+		return cnt, false, nil
+	}
+	if _, err = io.ReadFull(r, twoBytes); err != nil {
+		return 0, false, err
+	}
+	return encoding.DecodeInt16(twoBytes), true, nil
+}
+
 func (h *RWHelper) ReadIndices(f os.File) (indices []Index, err error) {
 	twoBytes := make([]byte, 2)
 	fourBytes := make([]byte, 4)
-	_, err = f.Read(twoBytes)
+	indexCnt, checksummed, err := readCount(&f)
 	if err != nil {
 		panic(fmt.Sprintf("unexpect error: %s", err))
 	}
-	indexCnt := encoding.DecodeInt16(twoBytes)
 	for i := 0; i < int(indexCnt); i++ {
 		_, err := f.Read(twoBytes)
 		if err != nil {
@@ -94,31 +121,44 @@ func (h *RWHelper) ReadIndices(f os.File) (indices []Index, err error) {
 			panic(fmt.Sprintf("unexpect error: %s", err))
 		}
 		length := encoding.DecodeInt32(fourBytes)
+		ptr := &base.Pointer{Len: uint64(length)}
+		if checksummed {
+			if _, err = f.Read(fourBytes); err != nil {
+				panic(fmt.Sprintf("unexpect error: %s", err))
+			}
+			ptr.SetChecksum(encoding.DecodeUint32(fourBytes))
+		}
 		buf := make([]byte, int(length))
 		_, err = f.Read(buf)
 		if err != nil {
 			panic(fmt.Sprintf("unexpect error: %s", err))
 		}
+		if err = ptr.Verify(buf); err != nil {
+			return nil, err
+		}
 		indices[i].Unmarshal(buf)
 	}
 	return indices, err
 }
 
-func (h *RWHelper) ReadIndicesMeta(f os.File) (meta *base.IndicesMeta, err error) {
+// ReadIndicesMeta reads the pointers of the indices, an error is returned
+// if the indices are truncated
+func (h *RWHelper) ReadIndicesMeta(f io.ReadSeeker) (meta *base.IndicesMeta, err error) {
 	twoBytes := make([]byte, 2)
 	fourBytes := make([]byte, 4)
-	_, err = f.Read(twoBytes)
+	indexCnt, checksummed, err := readCount(f)
 	if err != nil {
-		panic(fmt.Sprintf("unexpect error: %s", err))
+		return nil, err
+	}
+	if indexCnt < 0 {
+		return nil, fmt.Errorf("invalid indices count %d", indexCnt)
 	}
-	indexCnt := encoding.DecodeInt16(twoBytes)
 	if indexCnt > 0 {
 		meta = base.NewIndicesMeta()
 	}
 	for i := 0; i < int(indexCnt); i++ {
-		_, err := f.Read(twoBytes)
-		if err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+		if _, err = io.ReadFull(f, twoBytes); err != nil {
+			return nil, err
 		}
 		indexType := encoding.DecodeUint16(twoBytes)
 		im := new(base.IndexMeta)
@@ -128,35 +168,40 @@ func (h *RWHelper) ReadIndicesMeta(f os.File) (meta *base.IndicesMeta, err error
 		meta.Data = append(meta.Data, im)
 	}
 	for i := 0; i < int(indexCnt); i++ {
-		_, err := f.Read(twoBytes)
-		if err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+		if _, err = io.ReadFull(f, twoBytes); err != nil {
+			return nil, err
 		}
 		col := encoding.DecodeInt16(twoBytes)
 		meta.Data[i].Cols.Add(uint32(col))
 	}
 	for i := 0; i < int(indexCnt); i++ {
-		_, err := f.Read(fourBytes)
-		if err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+		if _, err = io.ReadFull(f, fourBytes); err != nil {
+			return nil, err
 		}
 		length := encoding.DecodeInt32(fourBytes)
 		meta.Data[i].Ptr.Len = uint64(length)
+		if checksummed {
+			if _, err = io.ReadFull(f, fourBytes); err != nil {
+				return nil, err
+			}
+			meta.Data[i].Ptr.SetChecksum(encoding.DecodeUint32(fourBytes))
+		}
 		offset, err := f.Seek(0, io.SeekCurrent)
 		if err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+			return nil, err
 		}
 		meta.Data[i].Ptr.Offset = offset
-		_, err = f.Seek(int64(length), io.SeekCurrent)
-		if err != nil {
-			panic(fmt.Sprintf("unexpect error: %s", err))
+		if _, err = f.Seek(int64(length), io.SeekCurrent); err != nil {
+			return nil, err
 		}
 	}
 	// log.Info(meta.String())
 	return meta, nil
 }
 
-func (h *RWHelper) FlushBitSlicedIndex(idx Index, filename string) error {
+// FlushBitSlicedIndex writes the index to the file, the file is encrypted
+// with the key if it is not nil
+func (h *RWHelper) FlushBitSlicedIndex(idx Index, filename string, key []byte) error {
 	buf, err := DefaultRWHelper.WriteIndices([]Index{idx})
 	if err != nil {
 		return err
@@ -165,9 +210,36 @@ func (h *RWHelper) FlushBitSlicedIndex(idx Index, filename string) error {
 	if err != nil {
 		return err
 	}
-	if err = binary.Write(f, binary.BigEndian, buf); err != nil {
+	if key != nil {
+		var w *encryption.Writer
+		if w, err = encryption.NewWriter(f, key); err != nil {
+			f.Close()
+			return err
+		}
+		if _, err = w.Write(buf); err == nil {
+			err = w.Close()
+		}
+	} else {
+		err = binary.Write(f, binary.BigEndian, buf)
+	}
+	if err != nil {
+		f.Close()
 		return err
 	}
 	logutil.Infof("Flush BSI file | %s", f.Name())
 	return f.Close()
 }
+
+// OpenBitSlicedIndexFile returns the reader of the plaintext of the bit
+// sliced index file, key is asked for the data key if the file is encrypted
+func OpenBitSlicedIndexFile(f *os.File, key func() ([]byte, error)) (*io.SectionReader, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	r, size, err := encryption.OpenFile(f, info.Size(), key)
+	if err != nil {
+		return nil, err
+	}
+	return io.NewSectionReader(r, 0, size), nil
+}
@@ -87,6 +87,8 @@ func TestTable(t *testing.T) {
 	assert.Equal(t, int64(1), tableHolder.GetSegmentCount())
 	seg0 = tableHolder.StrongRefSegment(seg0Id.SegmentID)
 	assert.Nil(t, seg0)
+	seg1 := tableHolder.StrongRefSegment(seg1Holder.GetID().SegmentID)
+	assert.Equal(t, seg1Holder, seg1)
 }
 
 func TestStrBsi(t *testing.T) {
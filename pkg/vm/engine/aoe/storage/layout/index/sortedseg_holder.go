@@ -629,7 +629,11 @@ func (holder *sortedSegmentHolder) LoadIndex(segFile base.ISegmentFile, filename
 			if err != nil {
 				panic(err)
 			}
-			idxMeta, err := DefaultRWHelper.ReadIndicesMeta(*file)
+			r, err := OpenBitSlicedIndexFile(file, base.DataKeyOf(segFile))
+			if err != nil {
+				panic(err)
+			}
+			idxMeta, err := DefaultRWHelper.ReadIndicesMeta(r)
 			if err != nil {
 				panic(err)
 			}
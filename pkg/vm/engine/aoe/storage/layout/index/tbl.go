@@ -82,6 +82,9 @@ func (holder *TableHolder) DropSegment(id uint64) SegmentIndexHolder {
 	dropped := holder.tree.Segments[idx]
 	delete(holder.tree.IdMap, id)
 	holder.tree.Segments = append(holder.tree.Segments[:idx], holder.tree.Segments[idx+1:]...)
+	for i := idx; i < len(holder.tree.Segments); i++ {
+		holder.tree.IdMap[holder.tree.Segments[i].GetID().SegmentID] = i
+	}
 	atomic.AddInt64(&holder.tree.SegmentCnt, int64(-1))
 	return dropped
 }
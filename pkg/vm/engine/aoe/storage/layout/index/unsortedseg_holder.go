@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"path/filepath"
+	"sort"
 	"sync"
 	"sync/atomic"
 )
@@ -97,9 +98,25 @@ func (holder *unsortedSegmentHolder) EvalFilter(colIdx int, ctx *FilterCtx) erro
 	return nil
 }
 
-// CollectMinMax is not needed in unsortedSegmentHolder
+// CollectMinMax returns the min and max values of the column of every
+// block, the blocks are in the order of their ids.
 func (holder *unsortedSegmentHolder) CollectMinMax(colIdx int) ([]interface{}, []interface{}, error) {
-	panic("unsupported")
+	holder.tree.RLock()
+	defer holder.tree.RUnlock()
+	ids := make([]uint64, 0, len(holder.tree.blockHolders))
+	for id := range holder.tree.blockHolders {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	min := make([]interface{}, len(ids))
+	max := make([]interface{}, len(ids))
+	for i, id := range ids {
+		var err error
+		if min[i], max[i], err = holder.tree.blockHolders[id].CollectMinMax(colIdx); err != nil {
+			return nil, nil, err
+		}
+	}
+	return min, max, nil
 }
 
 func (holder *unsortedSegmentHolder) Count(colIdx int, filter *roaring.Bitmap) (uint64, error) {
@@ -15,11 +15,20 @@
 package table
 
 import (
+	"errors"
+
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	bif "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/iterator/iface"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 )
 
+var (
+	NoRowsToMergeErr = errors.New("aoe: no row to merge")
+)
+
 type BacktrackingBlockIterator struct {
 	currColumn   []*vector.Vector
 	blocks       []iface.IBlock
@@ -67,6 +76,69 @@ func (iter *BacktrackingBlockIterator) BlockCount() uint32 {
 	return uint32(len(iter.blocks))
 }
 
+// MergedBlockIterator merges the blocks of several sorted segments into the
+// blocks of one segment. The rows which are not deleted are sorted by the
+// primary key to the front of the blocks and the tail repeats the last of
+// them, like the purge of a segment.
+type MergedBlockIterator struct {
+	iter    bif.BlockIterator
+	deletes []*roaring.Bitmap
+	pk      uint16
+	col     uint16
+	count   uint32
+	order   []mergesort.RowPos
+}
+
+// NewMergedBlockIterator returns the iterator of count blocks merged from
+// the blocks of iter, deletes holds the deleted rows of each of them
+func NewMergedBlockIterator(iter bif.BlockIterator, deletes []*roaring.Bitmap, pk uint16, count uint32) *MergedBlockIterator {
+	return &MergedBlockIterator{
+		iter:    iter,
+		deletes: deletes,
+		pk:      pk,
+		count:   count,
+	}
+}
+
+func (iter *MergedBlockIterator) FetchColumn() ([]*vector.Vector, error) {
+	if iter.order == nil {
+		// the order of the rows is taken from the primary key column
+		col := iter.col
+		iter.iter.Reset(iter.pk)
+		pk, err := iter.iter.FetchColumn()
+		if err != nil {
+			return nil, err
+		}
+		if iter.order = mergesort.PurgeOrder(pk, iter.deletes); iter.order == nil {
+			return nil, NoRowsToMergeErr
+		}
+		if col == iter.pk {
+			mergesort.Purge(pk, iter.order)
+			return pk[:iter.count], nil
+		}
+		iter.iter.Reset(col)
+	}
+	column, err := iter.iter.FetchColumn()
+	if err != nil {
+		return nil, err
+	}
+	mergesort.Purge(column, iter.order)
+	return column[:iter.count], nil
+}
+
+func (iter *MergedBlockIterator) Clear() {
+	iter.iter.Clear()
+}
+
+func (iter *MergedBlockIterator) Reset(col uint16) {
+	iter.col = col
+	iter.iter.Reset(col)
+}
+
+func (iter *MergedBlockIterator) BlockCount() uint32 {
+	return iter.count
+}
+
 //func (iter *BacktrackingBlockIterator) FinalizeColumn() error {
 //	f, _ := os.Create("/tmp/xxxx.tmp")
 //	binary.Write(f, binary.BigEndian, len(iter.blocks))
@@ -248,12 +248,11 @@ func (td *tableData) RegisterSegment(meta *metadata.Segment) (seg iface.ISegment
 
 func (td *tableData) Size(attr string) uint64 {
 	size := uint64(0)
-	segCnt := atomic.LoadUint32(&td.tree.segmentCnt)
-	var seg iface.ISegment
-	for i := 0; i < int(segCnt); i++ {
-		td.tree.RLock()
-		seg = td.tree.segments[i]
-		td.tree.RUnlock()
+	td.tree.RLock()
+	segs := make([]iface.ISegment, len(td.tree.segments))
+	copy(segs, td.tree.segments)
+	td.tree.RUnlock()
+	for _, seg := range segs {
 		size += seg.Size(attr)
 	}
 	return size
@@ -357,6 +356,10 @@ func (td *tableData) UpgradeBlock(meta *metadata.Block) (blk iface.IBlock, err e
 }
 
 func (td *tableData) UpgradeSegment(id uint64) (seg iface.ISegment, err error) {
+	// the segments can be dropped in the meantime, so the index is looked
+	// up and used under the same lock
+	td.tree.Lock()
+	defer td.tree.Unlock()
 	idx, ok := td.tree.helper[id]
 	if !ok {
 		panic("logic error")
@@ -383,8 +386,6 @@ func (td *tableData) UpgradeSegment(id uint64) (seg iface.ISegment, err error) {
 	}
 	upgradeSeg.SetNext(oldNext)
 
-	td.tree.Lock()
-	defer td.tree.Unlock()
 	td.tree.segments[idx] = upgradeSeg
 	if idx > 0 {
 		upgradeSeg.Ref()
@@ -396,6 +397,94 @@ func (td *tableData) UpgradeSegment(id uint64) (seg iface.ISegment, err error) {
 	return upgradeSeg, nil
 }
 
+// DropSegment removes the segment from the table, the segment is unlinked
+// from its previous segment. The tail segment cannot be dropped. The
+// dropped segment is returned with the reference of the table.
+func (td *tableData) DropSegment(id uint64) (seg iface.ISegment, err error) {
+	td.tree.Lock()
+	defer td.tree.Unlock()
+	if err = td.checkDropLocked(id); err != nil {
+		return nil, err
+	}
+	return td.dropSegmentLocked(id), nil
+}
+
+func (td *tableData) checkDropLocked(id uint64) error {
+	idx, ok := td.tree.helper[id]
+	if !ok {
+		return metadata.SegmentNotFoundErr
+	}
+	if idx == len(td.tree.segments)-1 {
+		return metadata.DropActiveSegmentErr
+	}
+	return nil
+}
+
+func (td *tableData) dropSegmentLocked(id uint64) iface.ISegment {
+	idx := td.tree.helper[id]
+	seg := td.tree.segments[idx]
+	next := seg.GetNext()
+	if idx > 0 {
+		td.tree.segments[idx-1].SetNext(next)
+	} else {
+		next.Unref()
+	}
+	td.tree.segments = append(td.tree.segments[:idx], td.tree.segments[idx+1:]...)
+	td.tree.ids = append(td.tree.ids[:idx], td.tree.ids[idx+1:]...)
+	delete(td.tree.helper, id)
+	for i := idx; i < len(td.tree.segments); i++ {
+		td.tree.helper[td.tree.ids[i]] = i
+	}
+	atomic.AddUint32(&td.tree.segmentCnt, ^uint32(0))
+	td.indexHolder.DropSegment(id).Unref()
+	return seg
+}
+
+// MergeSegments replaces the segments of ids with the sorted segment of
+// meta, which takes the place of the first of them. The tail segment
+// cannot be merged. The merged segment is returned with a reference, the
+// replaced segments are returned with the references of the table.
+func (td *tableData) MergeSegments(meta *metadata.Segment, ids []uint64) (merged iface.ISegment, dropped []iface.ISegment, err error) {
+	if merged, err = newSegment(td, meta); err != nil {
+		return nil, nil, err
+	}
+	for _, blkMeta := range meta.BlockSet {
+		blk, err := merged.RegisterBlock(blkMeta)
+		if err != nil {
+			panic(err)
+		}
+		blk.Unref()
+	}
+
+	td.tree.Lock()
+	defer td.tree.Unlock()
+	for _, id := range ids {
+		if err = td.checkDropLocked(id); err != nil {
+			td.indexHolder.DropSegment(meta.Id).Unref()
+			merged.Unref()
+			return nil, nil, err
+		}
+	}
+	for _, id := range ids[1:] {
+		dropped = append(dropped, td.dropSegmentLocked(id))
+	}
+	idx := td.tree.helper[ids[0]]
+	old := td.tree.segments[idx]
+	merged.SetNext(old.GetNext())
+	td.tree.segments[idx] = merged
+	td.tree.ids[idx] = meta.Id
+	delete(td.tree.helper, ids[0])
+	td.tree.helper[meta.Id] = idx
+	if idx > 0 {
+		merged.Ref()
+		td.tree.segments[idx-1].SetNext(merged)
+	}
+	td.indexHolder.DropSegment(ids[0]).Unref()
+	dropped = append([]iface.ISegment{old}, dropped...)
+	merged.Ref()
+	return merged, dropped, nil
+}
+
 func MockSegments(meta *metadata.Table, tblData iface.ITableData) []uint64 {
 	segs := make([]uint64, 0)
 	for _, segMeta := range meta.SegmentSet {
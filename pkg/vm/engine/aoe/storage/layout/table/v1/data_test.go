@@ -175,4 +175,27 @@ func TestBase1(t *testing.T) {
 	sroot.Unref()
 	attr := schema.ColDefs[0].Name
 	t.Log(tblData.Size(attr))
+
+	_, err = tblData.DropSegment(ids[len(ids)-1])
+	assert.Equal(t, metadata.DropActiveSegmentErr, err)
+	dropped, err := tblData.DropSegment(ids[1])
+	assert.Nil(t, err)
+	dropped.Unref()
+	assert.Nil(t, tblData.WeakRefSegment(ids[1]))
+	next := tblData.WeakRefRoot().GetNext()
+	assert.Equal(t, ids[2], next.GetMeta().Id)
+	next.Unref()
+	dropped, err = tblData.DropSegment(ids[0])
+	assert.Nil(t, err)
+	dropped.Unref()
+	assert.Nil(t, tblData.WeakRefSegment(ids[0]))
+	_, err = tblData.DropSegment(ids[0])
+	assert.Equal(t, metadata.SegmentNotFoundErr, err)
+	assert.Equal(t, segCnt-2, uint64(tblData.GetSegmentCount()))
+	assert.Equal(t, ids[2:], tblData.SegmentIds())
+	root = tblData.WeakRefRoot()
+	assert.Equal(t, ids[2], root.GetMeta().Id)
+	assert.Equal(t, int64(1), root.RefCount())
+	assert.Equal(t, ids[3], tblData.WeakRefSegment(ids[3]).GetMeta().Id)
+	t.Log(tblData.Size(attr))
 }
@@ -100,6 +100,15 @@ type ITableData interface {
 	// be called after the new segment file has been flushed.
 	UpgradeSegment(id uint64) (ISegment, error)
 
+	// DropSegment removes the segment from the TableData, it returns the
+	// dropped segment which requires UnRef
+	DropSegment(id uint64) (ISegment, error)
+
+	// MergeSegments replaces the segments with the sorted segment they are
+	// merged into, it returns the merged segment and the replaced segments,
+	// which all require UnRef
+	MergeSegments(meta *metadata.Segment, ids []uint64) (ISegment, []ISegment, error)
+
 	// UpgradeBlock upgrade various information of metadata in segment,
 	// and it will be called after the new Block file has been flushed.
 	UpgradeBlock(*metadata.Block) (IBlock, error)
@@ -0,0 +1,130 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logstore
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// Archiver keeps a copy of every rotated version file in Dir, so the
+// entries are still there after the version is truncated. A version failed
+// to archive is retried on the next rotation.
+type Archiver struct {
+	emptyObserver
+	Dir     string
+	mu      sync.Mutex
+	pending []string
+}
+
+func NewArchiver(dir string) *Archiver {
+	return &Archiver{Dir: dir}
+}
+
+func (a *Archiver) OnRotated(vf *VersionFile) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	names := append(a.pending, vf.Name())
+	a.pending = nil
+	for i, name := range names {
+		if err := archiveFile(name, a.Dir); err != nil {
+			logutil.Errorf("Archive version file %s: %v", name, err)
+			a.pending = append(a.pending, names[i:]...)
+			return
+		}
+		logutil.Infof("Archived version file: %s", name)
+	}
+}
+
+// archiveFile links the file into dir, or copies it if they are on
+// different devices.
+func archiveFile(name, dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	target := path.Join(dir, path.Base(name))
+	if _, err := os.Stat(target); err == nil {
+		return nil
+	}
+	if err := os.Link(name, target); err == nil {
+		return nil
+	}
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	tmp := target + ".tmp"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err == nil {
+		err = dst.Sync()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, target)
+}
+
+// LoadVersionFiles opens the version files of name in all the dirs for read
+// in version order. The file in the former dir is used if a version is found
+// in multiple dirs.
+func LoadVersionFiles(name string, dirs ...string) ([]*VersionFile, error) {
+	found := make(map[uint64]bool)
+	versions := make([]*VersionFile, 0)
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			version, err := ParseVersion(f.Name(), name, DefaultSuffix)
+			if err != nil || found[version] {
+				continue
+			}
+			file, err := os.Open(path.Join(dir, f.Name()))
+			if err != nil {
+				for _, v := range versions {
+					v.Close()
+				}
+				return nil, err
+			}
+			found[version] = true
+			versions = append(versions, &VersionFile{
+				File:    file,
+				Version: version,
+				Size:    f.Size(),
+			})
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+	return versions, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logstore
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+var (
+	ErrChecksumMismatch = errors.New("aoe: log entry checksum mismatch")
+
+	checksumTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// checksumFlag is set in the first reserved byte of the meta of the entry
+// whose checksum is stored in the reserved bytes [checksumOffset,
+// checksumOffset+4). The entries without payload and the entries written
+// before have no checksum.
+const (
+	checksumFlag   = byte(2)
+	checksumOffset = 16
+)
+
+func (meta *EntryMeta) HasChecksum() bool {
+	return meta.GetReservedBuf()[0]&checksumFlag != 0
+}
+
+// checksum returns the CRC32C of the type, the payload size and the
+// payload of the entry
+func (meta *EntryMeta) checksum(payload []byte) uint32 {
+	sum := crc32.Checksum(meta.Buf[:EntryTypeSize+EntrySizeSize], checksumTable)
+	return crc32.Update(sum, checksumTable, payload)
+}
+
+// SetChecksum stores the checksum of payload in meta. The payload size
+// of meta must be set before.
+func (meta *EntryMeta) SetChecksum(payload []byte) {
+	reserved := meta.GetReservedBuf()
+	binary.BigEndian.PutUint32(reserved[checksumOffset:checksumOffset+4], meta.checksum(payload))
+	reserved[0] |= checksumFlag
+}
+
+// VerifyChecksum checks payload against the checksum stored in meta, the
+// entries without checksum are not verified
+func (meta *EntryMeta) VerifyChecksum(payload []byte) error {
+	if !meta.HasChecksum() {
+		return nil
+	}
+	reserved := meta.GetReservedBuf()
+	expected := binary.BigEndian.Uint32(reserved[checksumOffset : checksumOffset+4])
+	if actual := meta.checksum(payload); actual != expected {
+		return fmt.Errorf("%w: type %d, size %d", ErrChecksumMismatch, meta.GetType(), meta.PayloadSize())
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logstore
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
+)
+
+// sealedFlag is set in the first reserved byte of the meta of the entry
+// whose payload is sealed by the cipher of the store. The entry type is the
+// additional data of the sealed payload.
+const sealedFlag = byte(1)
+
+func (meta *EntryMeta) IsSealed() bool {
+	return meta.GetReservedBuf()[0]&sealedFlag != 0
+}
+
+// sealEntry returns the entry written instead of entry, its payload is
+// the sealed payload of entry
+func sealEntry(aead cipher.AEAD, entry Entry) (Entry, error) {
+	meta := entry.GetMeta()
+	payload, err := encryption.Seal(aead, entry.GetPayload(), meta.Buf[:EntryTypeSize])
+	if err != nil {
+		return nil, err
+	}
+	sealed := NewBaseEntryWithMeta(NewEntryMeta())
+	copy(sealed.Meta.Buf, meta.Buf)
+	sealed.Meta.SetPayloadSize(uint32(len(payload)))
+	sealed.Meta.GetReservedBuf()[0] |= sealedFlag
+	sealed.Payload = payload
+	sealed.Auxilary = entry.GetAuxilaryInfo()
+	return sealed, nil
+}
+
+// OpenEntry returns the reader of the plaintext payload of the entry of
+// meta. If the entry has a checksum, the payload is read from r and
+// verified. If the entry is sealed, the sealed payload is opened by aead,
+// and meta is changed to the meta of the plaintext. Keep the payload size
+// before to skip the entry in the store.
+func OpenEntry(aead cipher.AEAD, meta *EntryMeta, r io.Reader) (io.Reader, error) {
+	if !meta.IsSealed() && !meta.HasChecksum() {
+		return r, nil
+	}
+	if meta.IsSealed() && aead == nil {
+		return nil, encryption.ErrNoKeyring
+	}
+	buf := make([]byte, meta.PayloadSize())
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	if err := meta.VerifyChecksum(buf); err != nil {
+		return nil, err
+	}
+	if !meta.IsSealed() {
+		return bytes.NewReader(buf), nil
+	}
+	payload, err := encryption.Open(aead, buf, meta.Buf[:EntryTypeSize])
+	if err != nil {
+		return nil, err
+	}
+	meta.GetReservedBuf()[0] &^= sealedFlag | checksumFlag
+	meta.SetPayloadSize(uint32(len(payload)))
+	return bytes.NewReader(payload), nil
+}
@@ -15,6 +15,7 @@
 package logstore
 
 import (
+	"crypto/cipher"
 	"errors"
 	"fmt"
 	"io"
@@ -37,6 +38,7 @@ type simpleReplayer struct {
 	count       int
 	offset      int64
 	truncOffset int64
+	aead        cipher.AEAD
 }
 
 func NewSimpleReplayer() *simpleReplayer {
@@ -88,13 +90,18 @@ func (replayer *simpleReplayer) doReplay(r *VersionFile, observer ReplayObserver
 		logutil.Infof("Replaying (%d, %d, %d) - %d", eType, meta.PayloadSize(), replayer.offset, replayer.count)
 		return errors.New(fmt.Sprintf("no handler for type: %d", eType))
 	}
-	if entry, n, err := handler(r, meta); err != nil {
+	size := int64(meta.PayloadSize())
+	pr, err := OpenEntry(replayer.aead, meta, r)
+	if err != nil {
+		return err
+	}
+	if entry, n, err := handler(pr, meta); err != nil {
 		return err
 	} else {
 		if n != int64(meta.PayloadSize()) {
 			panic(fmt.Sprintf("bad %d, %d for type %d", n, meta.PayloadSize(), eType))
 		}
-		replayer.offset += n
+		replayer.offset += size
 		if !entry.GetMeta().IsFlush() {
 			replayer.uncommitted = append(replayer.uncommitted, entry)
 		} else {
@@ -108,6 +115,7 @@ func (replayer *simpleReplayer) doReplay(r *VersionFile, observer ReplayObserver
 }
 
 func (replayer *simpleReplayer) Replay(s Store) error {
+	replayer.aead = s.Cipher()
 	err := s.ReplayVersions(replayer.doReplay)
 	logutil.Infof("replay count: %d", replayer.count)
 	return err
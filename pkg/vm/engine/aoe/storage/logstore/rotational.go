@@ -26,8 +26,10 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 )
 
@@ -291,7 +293,9 @@ func (r *Rotational) Write(buf []byte) (n int, err error) {
 
 func (r *Rotational) syncLocked() error {
 	if r.file != nil {
+		start := time.Now()
 		err := r.file.Sync()
+		metric.WalSynced(time.Since(start))
 		if r.observer != nil {
 			r.observer.OnSynced()
 		}
@@ -16,6 +16,7 @@ package logstore
 
 import (
 	"bytes"
+	"path/filepath"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
@@ -205,3 +206,41 @@ func TestVersionsMeta(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(hub.versions))
 }
+
+func TestArchiver(t *testing.T) {
+	dir := testutils.InitTestEnv(moduleName, t)
+	archiveDir := filepath.Join(dir, "archive")
+	s, err := NewBatchStore(dir, "store", &RotationCfg{
+		RotateChecker: &MaxSizeRotationChecker{MaxSize: 200},
+		Observer:      NewArchiver(archiveDir),
+	})
+	assert.Nil(t, err)
+	s.Start()
+
+	for i := 0; i < 10; i++ {
+		entry := NewAsyncBaseEntry()
+		entry.Meta.SetType(ETCustomizeStart)
+		assert.Nil(t, entry.Unmarshal(make([]byte, 60)))
+		assert.Nil(t, s.AppendEntry(entry))
+		assert.Nil(t, entry.WaitDone())
+		entry.Free()
+	}
+	assert.Nil(t, s.Close())
+	// the rotated versions are kept in the store as well
+	assert.False(t, s.GetHistory().Empty())
+
+	versions, err := LoadVersionFiles("store", archiveDir)
+	assert.Nil(t, err)
+	assert.True(t, len(versions) > 0)
+	for _, version := range versions {
+		version.Close()
+	}
+
+	all, err := LoadVersionFiles("store", archiveDir, dir)
+	assert.Nil(t, err)
+	assert.Equal(t, len(versions)+1, len(all))
+	for i, version := range all {
+		assert.Equal(t, uint64(i), version.Version)
+		version.Close()
+	}
+}
@@ -16,7 +16,10 @@ package logstore
 
 import (
 	"encoding/binary"
+	"errors"
 	"io"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 
@@ -117,6 +120,41 @@ func TestStore(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestStoreChecksum(t *testing.T) {
+	dir := testutils.InitTestEnv(moduleName, t)
+	name := "sstore"
+	store, err := New(dir, name, &RotationCfg{})
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		e := newMockDDLEntry(mockCreateOp, []byte{byte(i)})
+		assert.Nil(t, store.AppendEntry(e))
+		assert.True(t, e.Meta.HasChecksum())
+	}
+	assert.Nil(t, store.Sync())
+	store.Close()
+
+	// Flip a byte of the payload of the second entry
+	f, err := os.OpenFile(filepath.Join(dir, MakeVersionFile(name, ".rot", 0)), os.O_RDWR, 0666)
+	assert.Nil(t, err)
+	offset := int64(EntryMetaSize+2) + int64(EntryMetaSize) + 1
+	buf := make([]byte, 1)
+	_, err = f.ReadAt(buf, offset)
+	assert.Nil(t, err)
+	buf[0] ^= 0xff
+	_, err = f.WriteAt(buf, offset)
+	assert.Nil(t, err)
+	f.Close()
+
+	store, err = New(dir, name, &RotationCfg{})
+	assert.Nil(t, err)
+	defer store.Close()
+	replayer := NewSimpleReplayer()
+	err = replayer.RegisterEntryHandler(mockETDDL, mockETDDLHandler)
+	assert.Nil(t, err)
+	err = replayer.Replay(store)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+}
+
 // func TestAynscEntry(t *testing.T) {
 // 	queue := make(chan *AsyncBaseEntry, 1000)
 // 	doneq := make(chan *AsyncBaseEntry, 1000)
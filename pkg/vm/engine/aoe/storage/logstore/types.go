@@ -15,6 +15,7 @@
 package logstore
 
 import (
+	"crypto/cipher"
 	"io"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"os"
@@ -29,6 +30,9 @@ type RotationCfg struct {
 	RotateChecker  IRotateChecker
 	Observer       Observer
 	HistoryFactory HistoryFactory
+
+	// Cipher seals the payloads of the entries appended if it is not nil
+	Cipher cipher.AEAD
 }
 
 type VersionReplayHandler = func(*VersionFile, ReplayObserver) error
@@ -62,6 +66,10 @@ type Store interface {
 	Truncate(int64) error
 	GetHistory() IHistory
 	TryCompact()
+
+	// Cipher returns the cipher to open the sealed entries, nil if the
+	// entries are not sealed
+	Cipher() cipher.AEAD
 }
 
 type store struct {
@@ -69,6 +77,7 @@ type store struct {
 	name string
 	file StoreFile
 	pos  int64
+	aead cipher.AEAD
 }
 
 func New(dir, name string, cfg *RotationCfg) (*store, error) {
@@ -88,6 +97,7 @@ func New(dir, name string, cfg *RotationCfg) (*store, error) {
 		dir:  dir,
 		name: name,
 		file: w,
+		aead: cfg.Cipher,
 	}
 	stats, err := s.file.Stat()
 	if err != nil {
@@ -119,6 +129,16 @@ func (s *store) GetHistory() IHistory {
 
 func (s *store) AppendEntry(entry Entry) error {
 	// defer entry.Free()
+	if s.aead != nil && len(entry.GetPayload()) > 0 {
+		sealed, err := sealEntry(s.aead, entry)
+		if err != nil {
+			return err
+		}
+		entry = sealed
+	}
+	if len(entry.GetPayload()) > 0 {
+		entry.GetMeta().SetChecksum(entry.GetPayload())
+	}
 	if _, err := entry.WriteTo(s.file, s.file); err != nil {
 		return err
 	}
@@ -141,6 +161,10 @@ func (s *store) ReplayVersions(handler VersionReplayHandler) error {
 	return s.file.ReplayVersions(handler)
 }
 
+func (s *store) Cipher() cipher.AEAD {
+	return s.aead
+}
+
 func (s *store) TryCompact() {
 	s.file.TryCompact()
 }
@@ -42,3 +42,4 @@ func (vf *VersionFile) Destroy() error {
 	err := os.Remove(name)
 	return err
 }
+
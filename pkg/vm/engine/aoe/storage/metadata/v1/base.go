@@ -99,11 +99,13 @@ func (e *BaseEntry) checkStale1(info *CommitInfo) error {
 		return nil
 	case OpUpgradeFull:
 		return nil
+	case OpRewrapDataKey:
+		return nil
 	}
 	comp := e.CommitInfo.LogIndex.Compare(info.LogIndex)
 	if comp > 0 {
 		return CommitStaleErr
-	} else if comp == 0 && !e.CommitInfo.SameTran(info) {
+	} else if comp == 0 && !e.CommitInfo.SameTran(info) && !info.IsDeleteOf(e.CommitInfo) {
 		logutil.Error(e.PString(PPL1))
 		logutil.Error(info.PString(PPL1))
 		return CommitStaleErr
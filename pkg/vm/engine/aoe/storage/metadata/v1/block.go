@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
@@ -26,6 +27,8 @@ import (
 
 var (
 	UpgradeInfullBlockErr = errors.New("aoe: upgrade infull block")
+	RowOutOfRangeErr      = errors.New("aoe: row offset out of range")
+	BlockSortingErr       = errors.New("aoe: block is being sorted")
 )
 
 type blockLogEntry struct {
@@ -83,6 +86,12 @@ type Block struct {
 	Idx         uint32      `json:"idx"`
 	Count       uint64      `json:"count"`
 	SegmentedId uint64      `json:"segmentedid"`
+	// sorting is set while the rows of the block are being reordered,
+	// the deletes are rejected until the reordered data is installed
+	sorting bool
+	// sorts counts the sorts of the block, the offsets of the rows read
+	// before a sort are stale after it
+	sorts uint64
 }
 
 func newBlockEntry(segment *Segment, tranId uint64, exIndex *LogIndex) *Block {
@@ -309,6 +318,7 @@ func (e *Block) prepareUpgrade(ctx *upgradeBlockCtx) (LogEntry, error) {
 		CommitId: ctx.tranId,
 		Op:       newOp,
 		Size:     e.CommitInfo.GetSize(),
+		Deletes:  e.CommitInfo.Deletes,
 	}
 	if ctx.exIndice != nil {
 		cInfo.LogIndex = ctx.exIndice[0]
@@ -323,6 +333,118 @@ func (e *Block) prepareUpgrade(ctx *upgradeBlockCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// Safe
+// SimpleDeleteRows marks the rows at the given offsets of the block as
+// deleted. The offsets are the positions of the rows in the current data
+// of the block.
+func (e *Block) SimpleDeleteRows(rows *roaring.Bitmap) error {
+	tranId := e.Segment.Table.Database.Catalog.NextUncommitId()
+	ctx := newDeleteRowsCtx(e, rows, tranId)
+	return e.Segment.Table.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+// Safe
+// SimpleDeleteRowsAt is SimpleDeleteRows for the offsets read at the given
+// SortVersion of the block, it fails with BlockSortingErr if the block was
+// sorted since.
+func (e *Block) SimpleDeleteRowsAt(rows *roaring.Bitmap, version uint64) error {
+	tranId := e.Segment.Table.Database.Catalog.NextUncommitId()
+	ctx := newDeleteRowsCtx(e, rows, tranId)
+	ctx.version = &version
+	return e.Segment.Table.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Block) prepareDeleteRows(ctx *deleteRowsCtx) (LogEntry, error) {
+	e.Lock()
+	defer e.Unlock()
+	if e.IsDeletedLocked() {
+		return nil, BlockNotFoundErr
+	}
+	if e.sorting || (ctx.version != nil && *ctx.version != e.sorts) {
+		return nil, BlockSortingErr
+	}
+	if !ctx.rows.IsEmpty() && uint64(ctx.rows.Maximum()) >= e.GetCountLocked() {
+		return nil, RowOutOfRangeErr
+	}
+	deletes := ctx.rows.Clone()
+	if e.CommitInfo.Deletes != nil {
+		deletes.Or(e.CommitInfo.Deletes.Bitmap)
+	}
+	cInfo := &CommitInfo{
+		TranId:    ctx.tranId,
+		CommitId:  ctx.tranId,
+		Op:        e.CommitInfo.Op,
+		Size:      e.CommitInfo.GetSize(),
+		LogIndex:  e.CommitInfo.LogIndex,
+		PrevIndex: e.CommitInfo.PrevIndex,
+		Deletes:   &DeleteMask{Bitmap: deletes},
+	}
+	if e.CommitInfo.LogRange != nil {
+		logRange := *e.CommitInfo.LogRange
+		cInfo.LogRange = &logRange
+	}
+	if err := e.onCommit(cInfo); err != nil {
+		return nil, err
+	}
+	logEntry := e.Segment.Table.Database.Catalog.prepareCommitEntry(e, ETDeleteRows, e)
+	return logEntry, nil
+}
+
+// Safe
+// GetDeletes returns the offsets of the deleted rows of the block, or nil
+// if no row was deleted. The returned bitmap must not be modified.
+func (e *Block) GetDeletes() *roaring.Bitmap {
+	e.RLock()
+	defer e.RUnlock()
+	return e.getDeletesLocked()
+}
+
+func (e *Block) getDeletesLocked() *roaring.Bitmap {
+	if e.CommitInfo.Deletes == nil || e.CommitInfo.Deletes.IsEmpty() {
+		return nil
+	}
+	return e.CommitInfo.Deletes.Bitmap
+}
+
+// Safe
+// TrySort marks the block as being sorted. It fails if the block has
+// deleted rows, whose offsets would be invalidated by the new row order.
+func (e *Block) TrySort() bool {
+	e.Lock()
+	defer e.Unlock()
+	if e.getDeletesLocked() != nil {
+		return false
+	}
+	e.sorting = true
+	return true
+}
+
+// Safe
+// IsSorting returns true if the rows of the block are being sorted
+func (e *Block) IsSorting() bool {
+	e.RLock()
+	defer e.RUnlock()
+	return e.sorting
+}
+
+// Safe
+// SortDone is called once the sorted data of the block is installed
+func (e *Block) SortDone() {
+	e.Lock()
+	defer e.Unlock()
+	e.sorting = false
+	e.sorts++
+}
+
+// Safe
+// SortVersion returns the number of sorts of the block, see
+// SimpleDeleteRowsAt
+func (e *Block) SortVersion() uint64 {
+	e.RLock()
+	defer e.RUnlock()
+	return e.sorts
+}
+
 func (e *Block) toLogEntry(info *CommitInfo) *blockLogEntry {
 	if info == nil {
 		info = e.CommitInfo
@@ -377,6 +499,8 @@ func (e *Block) ToLogEntry(eType LogEntryType) LogEntry {
 		break
 	case ETUpgradeBlock:
 		break
+	case ETDeleteRows:
+		break
 	case ETDropBlock:
 		if !e.IsSoftDeletedLocked() {
 			panic("logic error")
@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore/sm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
@@ -58,6 +59,12 @@ type CatalogCfg struct {
 	BlockMaxRows        uint64 `toml:"block-max-rows"`
 	SegmentMaxBlocks    uint64 `toml:"segment-max-blocks"`
 	RotationFileMaxSize int    `toml:"rotation-file-max-size"`
+
+	// Keyring wraps the data keys of encrypted tables, nil if encryption
+	// at rest is not configured. All the tables created are encrypted if
+	// EncryptTables is set.
+	Keyring       *encryption.Keyring `json:"-" toml:"-"`
+	EncryptTables bool                `json:"-" toml:"-"`
 }
 
 type Catalog struct {
@@ -283,6 +290,81 @@ func (catalog *Catalog) SimpleGetTableByName(dbName, tableName string) (*Table,
 	return table, nil
 }
 
+// prepareDataKey wraps a new data key into the schema of the table to be
+// encrypted
+func (catalog *Catalog) prepareDataKey(schema *Schema) error {
+	if catalog.Cfg.EncryptTables {
+		schema.Encrypted = true
+	}
+	if !schema.Encrypted || len(schema.DataKey) > 0 {
+		return nil
+	}
+	if catalog.Cfg.Keyring == nil {
+		return encryption.ErrNoKeyring
+	}
+	key, err := catalog.Cfg.Keyring.NewDataKey()
+	if err != nil {
+		return err
+	}
+	schema.DataKey = key
+	return nil
+}
+
+// SimpleGetTable returns the table with the specified id in any database
+func (catalog *Catalog) SimpleGetTable(id uint64) (*Table, error) {
+	catalog.RLock()
+	dbs := make([]*Database, 0, len(catalog.Databases))
+	for _, database := range catalog.Databases {
+		dbs = append(dbs, database)
+	}
+	catalog.RUnlock()
+	for _, database := range dbs {
+		if table := database.SimpleGetTable(id); table != nil {
+			return table, nil
+		}
+	}
+	return nil, TableNotFoundErr
+}
+
+// DataKey returns the plain data key of the table with the specified id,
+// nil if the table is not encrypted
+func (catalog *Catalog) DataKey(tableId uint64) ([]byte, error) {
+	table, err := catalog.SimpleGetTable(tableId)
+	if err != nil {
+		return nil, err
+	}
+	return table.DataKey()
+}
+
+// RewrapDataKeys wraps the data keys of the encrypted tables by the current
+// master key of the keyring
+func (catalog *Catalog) RewrapDataKeys() error {
+	catalog.RLock()
+	dbs := make([]*Database, 0, len(catalog.Databases))
+	for _, database := range catalog.Databases {
+		dbs = append(dbs, database)
+	}
+	catalog.RUnlock()
+	for _, database := range dbs {
+		if database.IsDeleted() {
+			continue
+		}
+		err := database.ForLoopTables(func(table *Table) error {
+			if table.IsDeleted() {
+				return nil
+			}
+			if err := table.SimpleRewrapDataKey(); err != nil && err != TableNotFoundErr {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (catalog *Catalog) GetTableByNameAndLogIndex(dbName, tableName string, index *LogIndex) (*Table, error) {
 	database, err := catalog.SimpleGetDatabaseByName(dbName)
 	if err != nil {
@@ -983,7 +1065,47 @@ func (catalog *Catalog) onReplayUpgradeSegment(entry *segmentLogEntry) error {
 	tbl := db.TableSet[entry.TableId]
 	pos := tbl.IdIndex[entry.Id]
 	seg := tbl.SegmentSet[pos]
-	return seg.onCommit(entry.CommitInfo)
+	if err := seg.onCommit(entry.CommitInfo); err != nil {
+		return err
+	}
+	_, err := seg.onPurgeLocked(entry.CommitInfo)
+	return err
+}
+
+func (catalog *Catalog) onReplayDropSegment(entry *segmentLogEntry) error {
+	db := catalog.Databases[entry.DatabaseId]
+	tbl := db.TableSet[entry.TableId]
+	pos, ok := tbl.IdIndex[entry.Id]
+	if !ok {
+		return nil
+	}
+	seg := tbl.SegmentSet[pos]
+	if err := seg.onCommit(entry.CommitInfo); err != nil {
+		return err
+	}
+	tbl.onDropSegment(seg)
+	return nil
+}
+
+func (catalog *Catalog) onReplayMergeSegments(entry *mergeSegmentsLogEntry) error {
+	db := catalog.Databases[entry.DatabaseId]
+	tbl := db.TableSet[entry.TableId]
+	merged := entry.Merged
+	merged.rebuild(tbl, true)
+	dropped := make([]*Segment, 0, len(entry.Dropped))
+	for _, drop := range entry.Dropped {
+		pos, ok := tbl.IdIndex[drop.Id]
+		if !ok {
+			continue
+		}
+		seg := tbl.SegmentSet[pos]
+		if err := seg.onCommit(drop.CommitInfo); err != nil {
+			return err
+		}
+		dropped = append(dropped, seg)
+	}
+	tbl.onMergeSegments(merged, dropped)
+	return nil
 }
 
 func (catalog *Catalog) onReplaySegmentCheckpoint(entry *segmentLogEntry) error {
@@ -1021,6 +1143,16 @@ func (catalog *Catalog) onReplayUpgradeBlock(entry *blockLogEntry) error {
 	return blk.onCommit(entry.CommitInfo)
 }
 
+func (catalog *Catalog) onReplayDeleteRows(entry *blockLogEntry) error {
+	db := catalog.Databases[entry.DatabaseId]
+	tbl := db.TableSet[entry.TableId]
+	segpos := tbl.IdIndex[entry.SegmentId]
+	seg := tbl.SegmentSet[segpos]
+	blkpos := seg.IdIndex[entry.Id]
+	blk := seg.BlockSet[blkpos]
+	return blk.onCommit(entry.CommitInfo)
+}
+
 func (catalog *Catalog) onReplayBlockCheckpoint(entry *blockLogEntry) error {
 	db := catalog.Databases[entry.DatabaseId]
 	tbl := db.TableSet[entry.TableId]
@@ -59,10 +59,18 @@ func (p *commitPipeline) prepare(ctx interface{}) (LogEntry, error) {
 		return v.table.prepareCreateSegment(v)
 	case *upgradeSegmentCtx:
 		return v.segment.prepareUpgrade(v)
+	case *dropSegmentCtx:
+		return v.segment.prepareSoftDelete(v)
+	case *mergeSegmentsCtx:
+		return v.merged.Table.prepareMergeSegments(v)
 	case *createBlockCtx:
 		return v.segment.prepareCreateBlock(v)
 	case *upgradeBlockCtx:
 		return v.block.prepareUpgrade(v)
+	case *rewrapDataKeyCtx:
+		return v.table.prepareRewrapDataKey(v)
+	case *deleteRowsCtx:
+		return v.block.prepareDeleteRows(v)
 	case *TxnCtx:
 		return p.catalog.prepareCommitTxn(v)
 	default:
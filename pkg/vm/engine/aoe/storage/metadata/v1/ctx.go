@@ -14,6 +14,8 @@
 
 package metadata
 
+import "github.com/RoaringBitmap/roaring"
+
 type writeCtx struct {
 	exIndex *LogIndex
 	tranId  uint64
@@ -90,6 +92,18 @@ type upgradeSegmentCtx struct {
 	size     int64
 }
 
+type dropSegmentCtx struct {
+	writeCtx
+	segment *Segment
+}
+
+type mergeSegmentsCtx struct {
+	writeCtx
+	merged   *Segment
+	segments []*Segment
+	size     int64
+}
+
 type createBlockCtx struct {
 	writeCtx
 	segment *Segment
@@ -102,6 +116,19 @@ type upgradeBlockCtx struct {
 	exIndice []*LogIndex
 }
 
+type rewrapDataKeyCtx struct {
+	writeCtx
+	table *Table
+}
+
+type deleteRowsCtx struct {
+	writeCtx
+	block *Block
+	rows  *roaring.Bitmap
+	// version is the sort version the rows were read at, nil if unchecked
+	version *uint64
+}
+
 type replaceTableCtx struct {
 	writeCtx
 	table   *Table
@@ -161,6 +188,26 @@ func newUpgradeSegmentCtx(segment *Segment, size int64, exIndice []*LogIndex, tr
 	}
 }
 
+func newDropSegmentCtx(segment *Segment, tranId uint64) *dropSegmentCtx {
+	return &dropSegmentCtx{
+		writeCtx: writeCtx{
+			tranId: tranId,
+		},
+		segment: segment,
+	}
+}
+
+func newMergeSegmentsCtx(merged *Segment, segments []*Segment, size int64, tranId uint64) *mergeSegmentsCtx {
+	return &mergeSegmentsCtx{
+		writeCtx: writeCtx{
+			tranId: tranId,
+		},
+		merged:   merged,
+		segments: segments,
+		size:     size,
+	}
+}
+
 func newCreateBlockCtx(segment *Segment, tranId uint64) *createBlockCtx {
 	return &createBlockCtx{
 		writeCtx: writeCtx{
@@ -179,3 +226,13 @@ func newUpgradeBlockCtx(block *Block, exIndice []*LogIndex, tranId uint64) *upgr
 		exIndice: exIndice,
 	}
 }
+
+func newDeleteRowsCtx(block *Block, rows *roaring.Bitmap, tranId uint64) *deleteRowsCtx {
+	return &deleteRowsCtx{
+		writeCtx: writeCtx{
+			tranId: tranId,
+		},
+		block: block,
+		rows:  rows,
+	}
+}
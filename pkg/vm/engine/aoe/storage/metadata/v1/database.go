@@ -611,6 +611,9 @@ func (db *Database) CreateTableInTxn(txn *TxnCtx, schema *Schema, indice *IndexS
 
 func (db *Database) prepareCreateTable(ctx *createTableCtx) (LogEntry, error) {
 	var err error
+	if err = db.Catalog.prepareDataKey(ctx.schema); err != nil {
+		return nil, err
+	}
 	entry := NewTableEntry(db, ctx.schema, ctx.indice, ctx.tranId, ctx.exIndex)
 	db.Lock()
 	if db.IsSoftDeletedLocked() {
@@ -25,18 +25,18 @@ var replacedStopper = func(info *CommitInfo) bool {
 }
 
 var deleteStopper = func(info *CommitInfo) bool {
-	return info.Op >= OpSoftDelete
+	return info.IsDeleted()
 }
 
 func createDeleteAndIndexStopper(index uint64) commitChecker {
 	return func(info *CommitInfo) bool {
 		if info.LogIndex != nil {
-			if info.LogIndex.Id.Id <= index && info.Op >= OpSoftDelete {
+			if info.LogIndex.Id.Id <= index && info.IsDeleted() {
 				return true
 			}
 			return false
 		}
-		return info.Op >= OpSoftDelete
+		return info.IsDeleted()
 	}
 }
 
@@ -44,6 +44,9 @@ const (
 	ETDatabaseSnapshot
 	ETDatabaseReplaced
 	ETTransaction
+	ETDeleteRows
+	ETRewrapDataKey
+	ETMergeSegments
 )
 
 type IEntry interface {
@@ -0,0 +1,231 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
+)
+
+type mergeSegmentsLogEntry struct {
+	DatabaseId uint64
+	TableId    uint64
+	Merged     *Segment
+	Dropped    []*BaseEntry
+}
+
+func (e *mergeSegmentsLogEntry) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func (e *mergeSegmentsLogEntry) Unmarshal(buf []byte) error {
+	return json.Unmarshal(buf, e)
+}
+
+// Safe
+// NewMergedSegment returns the segment the live rows of the sorted segments
+// are merged into. The rows are sorted to the front of the new segment and
+// the tail repeats the last of them, it is masked by the deletes of the
+// blocks like the purged segments. The segment is not in the table until it
+// is committed by SimpleMergeSegments.
+func (e *Table) NewMergedSegment(segments []*Segment, rows uint64) *Segment {
+	catalog := e.Database.Catalog
+	tranId := catalog.NextUncommitId()
+	var index *LogIndex
+	for _, segment := range segments {
+		if idx := segment.MaxLogIndex(); idx != nil && (index == nil || idx.Compare(index) > 0) {
+			index = idx
+		}
+	}
+	merged := &Segment{
+		Table:    e,
+		BlockSet: make([]*Block, 0),
+		IdIndex:  make(map[uint64]int),
+		BaseEntry: &BaseEntry{
+			Id: catalog.NextSegmentId(),
+			CommitInfo: &CommitInfo{
+				CommitId: tranId,
+				TranId:   tranId,
+				SSLLNode: *common.NewSSLLNode(),
+				Op:       OpUpgradeSorted,
+			},
+		},
+	}
+	maxRows := e.Schema.BlockMaxRows
+	for i := uint64(0); i < e.Schema.SegmentMaxBlocks; i++ {
+		info := &CommitInfo{
+			CommitId: tranId,
+			TranId:   tranId,
+			SSLLNode: *common.NewSSLLNode(),
+			Op:       OpUpgradeFull,
+		}
+		if index != nil {
+			blkIndex := *index
+			info.LogIndex = &blkIndex
+		}
+		if start := i * maxRows; rows < start+maxRows {
+			deletes := roaring.New()
+			if rows > start {
+				deletes.AddRange(rows-start, maxRows)
+			} else {
+				deletes.AddRange(0, maxRows)
+			}
+			info.Deletes = &DeleteMask{Bitmap: deletes}
+		}
+		merged.onNewBlock(&Block{
+			Segment: merged,
+			Count:   maxRows,
+			BaseEntry: &BaseEntry{
+				Id:         catalog.NextBlockId(),
+				CommitInfo: info,
+			},
+		})
+	}
+	return merged
+}
+
+// Safe
+// SimpleMergeSegments replaces the sorted segments with the merged segment
+// of NewMergedSegment, whose file of size bytes is flushed already. The
+// merged segment takes the place of the first of them in the table. Only
+// the sorted segments before the tail segment can be merged, the data of
+// the segments is to be released by the caller.
+func (e *Table) SimpleMergeSegments(merged *Segment, segments []*Segment, size int64) error {
+	tranId := e.Database.Catalog.NextUncommitId()
+	ctx := newMergeSegmentsCtx(merged, segments, size, tranId)
+	return e.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Table) prepareMergeSegments(ctx *mergeSegmentsCtx) (LogEntry, error) {
+	curr := e.SimpleGetCurrSegment()
+	for _, segment := range ctx.segments {
+		if segment == curr {
+			return nil, DropActiveSegmentErr
+		}
+	}
+	unlock := func(segments []*Segment) {
+		for _, segment := range segments {
+			segment.Unlock()
+		}
+	}
+	var rows uint64
+	for i, segment := range ctx.segments {
+		segment.Lock()
+		if segment.IsDeletedLocked() {
+			unlock(ctx.segments[:i+1])
+			return nil, SegmentNotFoundErr
+		}
+		if !segment.IsSortedLocked() {
+			unlock(ctx.segments[:i+1])
+			return nil, DropActiveSegmentErr
+		}
+		rows += segment.GetRowCountLocked()
+	}
+	for _, segment := range ctx.segments {
+		cInfo := &CommitInfo{
+			TranId:   ctx.tranId,
+			CommitId: ctx.tranId,
+			Op:       OpSoftDelete,
+			Size:     segment.CommitInfo.Size,
+			SSLLNode: *common.NewSSLLNode(),
+		}
+		if err := segment.onCommit(cInfo); err != nil {
+			unlock(ctx.segments)
+			return nil, err
+		}
+	}
+	ctx.merged.CommitInfo.Size = ctx.size
+	entry := &segmentMerge{Segment: ctx.merged, dropped: ctx.segments}
+	logEntry := e.Database.Catalog.prepareCommitEntry(entry, ETMergeSegments, nil)
+	unlock(ctx.segments)
+	e.Lock()
+	e.onMergeSegments(ctx.merged, ctx.segments)
+	e.Unlock()
+	e.AddRows(ctx.merged.GetRowCount() - rows)
+	return logEntry, nil
+}
+
+// onMergeSegments puts the merged segment at the place of the first of the
+// dropped segments
+func (e *Table) onMergeSegments(merged *Segment, dropped []*Segment) {
+	pos := len(e.SegmentSet)
+	for _, segment := range dropped {
+		if idx, ok := e.IdIndex[segment.Id]; ok && idx < pos {
+			pos = idx
+		}
+		delete(e.IdIndex, segment.Id)
+	}
+	segments := make([]*Segment, 0, len(e.SegmentSet)+1)
+	for i, segment := range e.SegmentSet {
+		if i == pos {
+			segments = append(segments, merged)
+		}
+		if _, ok := e.IdIndex[segment.Id]; ok {
+			segments = append(segments, segment)
+		}
+	}
+	if pos == len(e.SegmentSet) {
+		segments = append(segments, merged)
+	}
+	e.SegmentSet = segments
+	for i, segment := range e.SegmentSet {
+		e.IdIndex[segment.Id] = i
+	}
+}
+
+// segmentMerge commits the merged segment together with its blocks and the
+// drops of the segments merged
+type segmentMerge struct {
+	*Segment
+	dropped []*Segment
+}
+
+func (e *segmentMerge) CommitLocked(id uint64) {
+	e.Segment.CommitLocked(id)
+	for _, blk := range e.BlockSet {
+		blk.Lock()
+		blk.CommitLocked(id)
+		blk.Unlock()
+	}
+	// the dropped segments are locked by prepareMergeSegments
+	for _, segment := range e.dropped {
+		segment.CommitLocked(id)
+	}
+}
+
+func (e *segmentMerge) ToLogEntry(eType LogEntryType) LogEntry {
+	if eType != ETMergeSegments {
+		panic("not supported")
+	}
+	entry := &mergeSegmentsLogEntry{
+		DatabaseId: e.Table.Database.Id,
+		TableId:    e.Table.Id,
+		Merged:     e.Segment,
+	}
+	for _, segment := range e.dropped {
+		entry.Dropped = append(entry.Dropped, &BaseEntry{
+			Id:         segment.Id,
+			CommitInfo: segment.CommitInfo.Clone(),
+		})
+	}
+	buf, _ := entry.Marshal()
+	logEntry := logstore.NewAsyncBaseEntry()
+	logEntry.Meta.SetType(eType)
+	logEntry.Unmarshal(buf)
+	return logEntry
+}
@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/internal/invariants"
@@ -57,6 +58,68 @@ func initTestEnv(t *testing.T) string {
 	return testutils.MakeDefaultTestPath(moduleName, t)
 }
 
+func TestDropSegment(t *testing.T) {
+	dir := initTestEnv(t)
+	cfg := new(CatalogCfg)
+	cfg.Dir = dir
+	cfg.BlockMaxRows, cfg.SegmentMaxBlocks = uint64(10), uint64(2)
+	cfg.RotationFileMaxSize = 20 * int(common.K)
+	catalog, _ := OpenCatalog(new(sync.RWMutex), cfg)
+	catalog.Start()
+
+	schema := MockSchema(2)
+	database, err := catalog.SimpleCreateDatabase("db1", nil)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	table, err := database.SimpleCreateTable(schema, nil, gen.Next(database.ShardId))
+	assert.Nil(t, err)
+	var prev *Block
+	for i := 0; i < 6; i++ {
+		blk := table.SimpleGetOrCreateNextBlock(prev)
+		assert.Nil(t, blk.SetCount(cfg.BlockMaxRows))
+		assert.Nil(t, blk.SimpleUpgrade(nil))
+		table.AddRows(cfg.BlockMaxRows)
+		prev = blk
+	}
+	segments := make([]*Segment, 0)
+	for _, id := range table.SimpleGetSegmentIds() {
+		segments = append(segments, table.SimpleGetSegment(id))
+	}
+	assert.Equal(t, 3, len(segments))
+	assert.Nil(t, segments[0].SimpleUpgrade(mockSegmentSize, nil))
+	segments[1].StartSort()
+	assert.Equal(t, BlockSortingErr, segments[1].SimpleSoftDelete())
+	segments[1].SortDone()
+	assert.Nil(t, segments[2].SimpleUpgrade(mockSegmentSize, nil))
+	assert.Equal(t, DropActiveSegmentErr, segments[2].SimpleSoftDelete())
+
+	assert.Nil(t, segments[0].SimpleSoftDelete())
+	assert.Equal(t, SegmentNotFoundErr, segments[0].SimpleSoftDelete())
+	assert.Nil(t, table.SimpleGetSegment(segments[0].Id))
+	assert.Equal(t, segments[1], table.SimpleGetSegment(segments[1].Id))
+	assert.Equal(t, segments[2], table.SimpleGetSegment(segments[2].Id))
+	assert.Equal(t, 2, table.SimpleGetSegmentCount())
+	assert.Equal(t, 4*cfg.BlockMaxRows, table.GetRowCount())
+	catalog.Compact(nil, nil)
+	// the closed segments are dropped even if they are not sorted
+	assert.False(t, segments[1].IsSorted())
+	assert.Nil(t, segments[1].SimpleSoftDelete())
+	t.Log(catalog.PString(PPL0, 0))
+
+	catalog.Close()
+
+	catalog, err = OpenCatalog(new(sync.RWMutex), cfg)
+	assert.Nil(t, err)
+	catalog.Start()
+	t.Log(catalog.PString(PPL0, 0))
+	replayed, err := catalog.SimpleGetTableByName("db1", schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{segments[2].Id}, replayed.SimpleGetSegmentIds())
+	assert.NotNil(t, replayed.SimpleGetSegment(segments[2].Id))
+
+	catalog.Close()
+}
+
 func initTest(dir string, blockRows, segmentBlocks uint64, hasWal bool, cleanup bool) (*Catalog, Wal) {
 	if cleanup {
 		os.RemoveAll(dir)
@@ -1626,3 +1689,117 @@ func TestIndice(t *testing.T) {
 
 	catalog.Close()
 }
+
+func TestDeleteRows(t *testing.T) {
+	dir := initTestEnv(t)
+	cfg := new(CatalogCfg)
+	cfg.Dir = dir
+	cfg.BlockMaxRows, cfg.SegmentMaxBlocks = uint64(10), uint64(2)
+	cfg.RotationFileMaxSize = 20 * int(common.K)
+	catalog, _ := OpenCatalog(new(sync.RWMutex), cfg)
+	catalog.Start()
+
+	schema := MockSchema(2)
+	database, err := catalog.SimpleCreateDatabase("db1", nil)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	table, err := database.SimpleCreateTable(schema, nil, gen.Next(database.ShardId))
+	assert.Nil(t, err)
+	segment := table.SimpleCreateSegment()
+	b1 := segment.SimpleCreateBlock()
+	b2 := segment.SimpleCreateBlock()
+	assert.Nil(t, b1.SetCount(8))
+	assert.Nil(t, b2.SetCount(5))
+
+	assert.Nil(t, b1.SimpleDeleteRows(roaring.BitmapOf(1, 3)))
+	assert.Nil(t, b1.SimpleDeleteRows(roaring.BitmapOf(3, 7)))
+	assert.Equal(t, []uint32{1, 3, 7}, b1.GetDeletes().ToArray())
+	assert.Equal(t, RowOutOfRangeErr, b1.SimpleDeleteRows(roaring.BitmapOf(8)))
+	assert.False(t, b1.TrySort())
+
+	assert.Nil(t, b2.GetDeletes())
+	assert.True(t, b2.TrySort())
+	assert.Equal(t, BlockSortingErr, b2.SimpleDeleteRows(roaring.BitmapOf(0)))
+	b2.SortDone()
+	assert.Nil(t, segment.SimpleDeleteRows(roaring.BitmapOf(2, 10, 14)))
+	assert.Equal(t, RowOutOfRangeErr, segment.SimpleDeleteRows(roaring.BitmapOf(20)))
+	assert.Equal(t, []uint32{0, 4}, b2.GetDeletes().ToArray())
+	assert.Equal(t, []uint32{1, 2, 3, 7, 10, 14}, segment.GetDeletes().ToArray())
+
+	assert.Nil(t, b1.SetCount(cfg.BlockMaxRows))
+	assert.Nil(t, b1.SimpleUpgrade(nil))
+	assert.True(t, b1.IsFull())
+	assert.Equal(t, []uint32{1, 2, 3, 7}, b1.GetDeletes().ToArray())
+	catalog.Compact(nil, nil)
+	assert.Nil(t, b2.SimpleDeleteRows(roaring.BitmapOf(1)))
+	t.Log(catalog.PString(PPL0, 0))
+
+	catalog.Close()
+
+	catalog, err = OpenCatalog(new(sync.RWMutex), cfg)
+	assert.Nil(t, err)
+	catalog.Start()
+	t.Log(catalog.PString(PPL0, 0))
+	replayed, err := catalog.SimpleGetTableByName("db1", schema.Name)
+	assert.Nil(t, err)
+	rb1, err := replayed.SimpleGetBlock(segment.Id, b1.Id)
+	assert.Nil(t, err)
+	assert.True(t, rb1.IsFull())
+	assert.Equal(t, []uint32{1, 2, 3, 7}, rb1.GetDeletes().ToArray())
+	rb2, err := replayed.SimpleGetBlock(segment.Id, b2.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{0, 1, 4}, rb2.GetDeletes().ToArray())
+
+	catalog.Close()
+}
+
+func TestPurgeRows(t *testing.T) {
+	dir := initTestEnv(t)
+	cfg := new(CatalogCfg)
+	cfg.Dir = dir
+	cfg.BlockMaxRows, cfg.SegmentMaxBlocks = uint64(10), uint64(2)
+	cfg.RotationFileMaxSize = 20 * int(common.K)
+	catalog, _ := OpenCatalog(new(sync.RWMutex), cfg)
+	catalog.Start()
+
+	schema := MockSchema(2)
+	database, err := catalog.SimpleCreateDatabase("db1", nil)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	table, err := database.SimpleCreateTable(schema, nil, gen.Next(database.ShardId))
+	assert.Nil(t, err)
+	segment := table.SimpleCreateSegment()
+	b1 := segment.SimpleCreateBlock()
+	b2 := segment.SimpleCreateBlock()
+	for _, blk := range []*Block{b1, b2} {
+		assert.Nil(t, blk.SetCount(cfg.BlockMaxRows))
+		assert.Nil(t, blk.SimpleUpgrade(nil))
+	}
+	assert.Nil(t, segment.PurgeMask())
+	assert.Nil(t, segment.SimpleDeleteRows(roaring.BitmapOf(1, 3, 12)))
+
+	segment.StartSort()
+	assert.Equal(t, BlockSortingErr, b2.SimpleDeleteRows(roaring.BitmapOf(0)))
+	assert.Equal(t, []uint32{17, 18, 19}, segment.PurgeMask().ToArray())
+	assert.Nil(t, segment.SimpleUpgrade(mockSegmentSize, nil))
+	segment.SortDone()
+	assert.Nil(t, b1.GetDeletes())
+	assert.Equal(t, []uint32{7, 8, 9}, b2.GetDeletes().ToArray())
+	assert.Equal(t, []uint32{17, 18, 19}, segment.GetDeletes().ToArray())
+
+	assert.Nil(t, segment.SimpleDeleteRows(roaring.BitmapOf(0)))
+	assert.Equal(t, []uint32{0, 17, 18, 19}, segment.GetDeletes().ToArray())
+
+	catalog.Close()
+
+	catalog, err = OpenCatalog(new(sync.RWMutex), cfg)
+	assert.Nil(t, err)
+	catalog.Start()
+	replayed, err := catalog.SimpleGetTableByName("db1", schema.Name)
+	assert.Nil(t, err)
+	rseg := replayed.SimpleGetSegment(segment.Id)
+	assert.True(t, rseg.IsSortedLocked())
+	assert.Equal(t, []uint32{0, 17, 18, 19}, rseg.GetDeletes().ToArray())
+
+	catalog.Close()
+}
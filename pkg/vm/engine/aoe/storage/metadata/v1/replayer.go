@@ -15,6 +15,7 @@ package metadata
 
 import (
 	"bytes"
+	"crypto/cipher"
 	"errors"
 	"fmt"
 	"io"
@@ -47,6 +48,7 @@ type replayEntry struct {
 	catalogEntry *catalogLogEntry
 	blkEntry     *blockLogEntry
 	replaceEntry *dbReplaceLogEntry
+	mergeEntry   *mergeSegmentsLogEntry
 	txnStore     *TxnStore
 }
 
@@ -164,16 +166,23 @@ func (cache *replayCache) applyReplayEntry(entry *replayEntry, catalog *Catalog,
 		err = catalog.onReplayCreateBlock(entry.blkEntry)
 	case ETUpgradeBlock:
 		err = catalog.onReplayUpgradeBlock(entry.blkEntry)
+	case ETDeleteRows:
+		err = catalog.onReplayDeleteRows(entry.blkEntry)
 	case ETCreateTable:
 		catalog.Sequence.TryUpdateTableId(entry.tblEntry.Table.Id)
 		err = catalog.onReplayCreateTable(entry.tblEntry)
-	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable:
+	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable, ETRewrapDataKey:
 		err = catalog.onReplayTableOperation(entry.tblEntry)
 	case ETCreateSegment:
 		catalog.Sequence.TryUpdateSegmentId(entry.segEntry.Id)
 		err = catalog.onReplayCreateSegment(entry.segEntry)
 	case ETUpgradeSegment:
 		err = catalog.onReplayUpgradeSegment(entry.segEntry)
+	case ETDropSegment:
+		err = catalog.onReplayDropSegment(entry.segEntry)
+	case ETMergeSegments:
+		catalog.Sequence.TryUpdateSegmentId(entry.mergeEntry.Merged.Id)
+		err = catalog.onReplayMergeSegments(entry.mergeEntry)
 	case ETTransaction:
 		err = cache.onReplayTxn(entry.txnStore)
 	default:
@@ -223,6 +232,7 @@ type catalogReplayer struct {
 	offset   int64
 	cache    *replayCache
 	version  uint64
+	aead     cipher.AEAD
 }
 
 func newCatalogReplayer() *catalogReplayer {
@@ -308,7 +318,16 @@ func (replayer *catalogReplayer) doReplay(r *logstore.VersionFile, observer logs
 		replayer.tryTruncate()
 		return err
 	}
-	if entry, n, err := defaultHandler(r, entry); err != nil {
+	size := int64(meta.PayloadSize())
+	pr, err := logstore.OpenEntry(replayer.aead, meta, r)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		// The sealed payload is partially written
+		replayer.tryTruncate()
+		return io.EOF
+	} else if err != nil {
+		return err
+	}
+	if entry, n, err := defaultHandler(pr, entry); err != nil {
 		if !errors.Is(err, io.EOF) {
 			return err
 		}
@@ -330,12 +349,13 @@ func (replayer *catalogReplayer) doReplay(r *logstore.VersionFile, observer logs
 		}
 	}
 	replayer.offset += int64(meta.Size())
-	replayer.offset += int64(meta.PayloadSize())
+	replayer.offset += size
 	replayer.replayed++
 	return nil
 }
 
 func (replayer *catalogReplayer) Replay(s Store) error {
+	replayer.aead = s.Cipher()
 	err := s.ReplayVersions(replayer.doReplay)
 	if err != nil {
 		return err
@@ -367,6 +387,9 @@ func (replayer *catalogReplayer) RegisterEntryHandler(_ LogEntryType, _ logstore
 
 func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore.ReplayObserver) error {
 	logType := entry.GetMeta().GetType()
+	if wal.IsRedoEntry(logType) {
+		return nil
+	}
 	if observer != nil {
 		switch logType {
 		case shard.ETShardWalSafeId:
@@ -402,6 +425,14 @@ func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore
 			blkEntry: blk,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
+	case ETDeleteRows:
+		blk := &blockLogEntry{}
+		blk.Unmarshal(entry.GetPayload())
+		replayer.cache.Append(&replayEntry{
+			typ:      ETDeleteRows,
+			blkEntry: blk,
+			commitId: GetCommitIdFromLogEntry(entry),
+		})
 	case ETCreateDatabase:
 		db := &Database{}
 		db.Unmarshal(entry.GetPayload())
@@ -458,7 +489,7 @@ func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore
 			tblEntry: tbl,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
-	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable:
+	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable, ETRewrapDataKey:
 		tbl := &tableLogEntry{}
 		tbl.Unmarshal(entry.GetPayload())
 		replayer.cache.Append(&replayEntry{
@@ -476,14 +507,22 @@ func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore
 			segEntry: seg,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
-	case ETUpgradeSegment:
+	case ETUpgradeSegment, ETDropSegment:
 		seg := &segmentLogEntry{}
 		seg.Unmarshal(entry.GetPayload())
 		replayer.cache.Append(&replayEntry{
-			typ:      ETUpgradeSegment,
+			typ:      logType,
 			segEntry: seg,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
+	case ETMergeSegments:
+		merge := &mergeSegmentsLogEntry{}
+		merge.Unmarshal(entry.GetPayload())
+		replayer.cache.Append(&replayEntry{
+			typ:        ETMergeSegments,
+			mergeEntry: merge,
+			commitId:   GetCommitIdFromLogEntry(entry),
+		})
 	case ETTransaction:
 		txnStore := new(TxnStore)
 		txnStore.Unmarshal(entry.GetPayload())
@@ -166,6 +166,12 @@ type Schema struct {
 	BlockMaxRows     uint64         `json:"blkrows"`
 	PrimaryKey       int            `json:"primarykey"`
 	SegmentMaxBlocks uint64         `json:"segblocks"`
+	Encrypted        bool           `json:"encrypted,omitempty"`
+	DataKey          []byte         `json:"datakey,omitempty"`
+	CachePinned      bool           `json:"cachepinned,omitempty"`
+	CacheQuota       uint64         `json:"cachequota,omitempty"`
+	TTLColumn        string         `json:"ttlcol,omitempty"`
+	TTLDays          uint64         `json:"ttldays,omitempty"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -218,6 +224,11 @@ func (s *Schema) Valid() bool {
 		}
 		names[colDef.Name] = true
 	}
+	if s.TTLDays > 0 {
+		if _, _, ok := s.TTLHorizon(); !ok {
+			return false
+		}
+	}
 	return true
 }
 
@@ -231,6 +242,26 @@ func (s *Schema) GetColIdx(attr string) int {
 	return idx
 }
 
+// TTLHorizon returns the index of the TTL column and the oldest value of
+// the column a row must have to be kept now. ok is false if the table has
+// no TTL or the TTL column is not a date or datetime column.
+func (s *Schema) TTLHorizon() (colIdx int, horizon interface{}, ok bool) {
+	if s.TTLDays == 0 {
+		return -1, nil, false
+	}
+	if colIdx = s.GetColIdx(s.TTLColumn); colIdx == -1 {
+		return -1, nil, false
+	}
+	switch s.ColDefs[colIdx].Type.Oid {
+	case types.T_datetime:
+		secs := int64(s.TTLDays) * 24 * 3600
+		return colIdx, types.Now() - types.Datetime(secs<<20), true
+	case types.T_date:
+		return colIdx, types.Today() - types.Date(s.TTLDays), true
+	}
+	return -1, nil, false
+}
+
 func MockSchema(colCnt int) *Schema {
 	rand.Seed(time.Now().UnixNano())
 	schema := NewEmptySchema(fmt.Sprintf("%d", rand.Intn(1000000)))
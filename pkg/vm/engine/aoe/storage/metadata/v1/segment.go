@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
 )
@@ -26,6 +27,7 @@ import (
 var (
 	UpgradeInfullSegmentErr = errors.New("aoe: upgrade infull segment")
 	UpgradeNotNeededErr     = errors.New("aoe: already upgraded")
+	DropActiveSegmentErr    = errors.New("aoe: drop unclosed or tail segment")
 )
 
 type segmentLogEntry struct {
@@ -305,6 +307,13 @@ func (e *Segment) FirstInFullBlock() *Block {
 	return found
 }
 
+// Safe
+func (e *Segment) IsSorted() bool {
+	e.RLock()
+	defer e.RUnlock()
+	return e.IsSortedLocked()
+}
+
 func (e *Segment) IsUpgradable() bool {
 	e.RLock()
 	defer e.RUnlock()
@@ -408,13 +417,253 @@ func (e *Segment) prepareUpgrade(ctx *upgradeSegmentCtx) (LogEntry, error) {
 	if ctx.exIndice != nil {
 		cInfo.LogIndex = ctx.exIndice[0]
 	}
+	if purged := e.purgeMaskLocked(); purged != nil {
+		cInfo.Deletes = &DeleteMask{Bitmap: purged}
+	}
 	if err := e.onCommit(cInfo); err != nil {
 		return nil, err
 	}
-	logEntry := e.Table.Database.Catalog.prepareCommitEntry(e, ETUpgradeSegment, e)
+	blocks, err := e.onPurgeLocked(cInfo)
+	if err != nil {
+		return nil, err
+	}
+	entry := &segmentPurge{Segment: e, blocks: blocks}
+	logEntry := e.Table.Database.Catalog.prepareCommitEntry(entry, ETUpgradeSegment, e)
 	return logEntry, nil
 }
 
+// Safe
+// SimpleSoftDelete drops the segment from its table. Only the closed
+// segments before the tail segment of the table can be dropped, an unsorted
+// segment cannot be dropped while it is being sorted. The segment is removed
+// from the table once the drop is committed, the data of the segment is to
+// be released by the caller.
+func (e *Segment) SimpleSoftDelete() error {
+	tranId := e.Table.Database.Catalog.NextUncommitId()
+	ctx := newDropSegmentCtx(e, tranId)
+	return e.Table.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Segment) prepareSoftDelete(ctx *dropSegmentCtx) (LogEntry, error) {
+	if e.Table.SimpleGetCurrSegment() == e {
+		return nil, DropActiveSegmentErr
+	}
+	e.Lock()
+	if e.IsDeletedLocked() {
+		e.Unlock()
+		return nil, SegmentNotFoundErr
+	}
+	if !e.IsSortedLocked() {
+		if !e.IsUpgradableLocked() {
+			e.Unlock()
+			return nil, DropActiveSegmentErr
+		}
+		// The sort upgrades the segment once it is done, the segment is
+		// dropped after it instead
+		for _, blk := range e.BlockSet {
+			if blk.IsSorting() {
+				e.Unlock()
+				return nil, BlockSortingErr
+			}
+		}
+	}
+	rows := e.Table.Schema.BlockMaxRows * e.Table.Schema.SegmentMaxBlocks
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		Op:       OpSoftDelete,
+		Size:     e.CommitInfo.Size,
+		SSLLNode: *common.NewSSLLNode(),
+	}
+	if err := e.onCommit(cInfo); err != nil {
+		e.Unlock()
+		return nil, err
+	}
+	logEntry := e.Table.Database.Catalog.prepareCommitEntry(e, ETDropSegment, e)
+	e.Unlock()
+	e.Table.Lock()
+	e.Table.onDropSegment(e)
+	e.Table.Unlock()
+	e.Table.AddRows(-rows)
+	return logEntry, nil
+}
+
+// Safe
+// GetDeletes returns the segment-level offsets of the deleted rows of all
+// blocks, or nil if no row was deleted.
+func (e *Segment) GetDeletes() *roaring.Bitmap {
+	var ret *roaring.Bitmap
+	e.RLock()
+	defer e.RUnlock()
+	for _, blk := range e.BlockSet {
+		deletes := blk.GetDeletes()
+		if deletes == nil {
+			continue
+		}
+		if ret == nil {
+			ret = roaring.New()
+		}
+		startPos := blk.Idx * uint32(e.Table.Schema.BlockMaxRows)
+		it := deletes.Iterator()
+		for it.HasNext() {
+			ret.Add(startPos + it.Next())
+		}
+	}
+	return ret
+}
+
+// Safe
+// SimpleDeleteRows marks the rows at the given segment-level offsets as
+// deleted, the rows of each block are deleted by a separate commit.
+func (e *Segment) SimpleDeleteRows(rows *roaring.Bitmap) error {
+	if rows.IsEmpty() {
+		return nil
+	}
+	maxRows := uint32(e.Table.Schema.BlockMaxRows)
+	e.RLock()
+	blks := make([]*Block, len(e.BlockSet))
+	copy(blks, e.BlockSet)
+	e.RUnlock()
+	if rows.Maximum() >= uint32(len(blks))*maxRows {
+		return RowOutOfRangeErr
+	}
+	for _, blk := range blks {
+		startPos := blk.Idx * maxRows
+		offsets := roaring.New()
+		it := rows.Iterator()
+		it.AdvanceIfNeeded(startPos)
+		for it.HasNext() {
+			row := it.Next()
+			if row >= startPos+maxRows {
+				break
+			}
+			offsets.Add(row - startPos)
+		}
+		if offsets.IsEmpty() {
+			continue
+		}
+		if err := blk.SimpleDeleteRows(offsets); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Safe
+// StartSort marks all blocks of the segment as being sorted, no row of the
+// segment can be deleted until SortDone. The deleted rows of the blocks are
+// purged by the sort, see PurgeMask.
+func (e *Segment) StartSort() {
+	e.RLock()
+	defer e.RUnlock()
+	for _, blk := range e.BlockSet {
+		blk.Lock()
+		blk.sorting = true
+		blk.Unlock()
+	}
+}
+
+// Safe
+// PurgeMask returns the segment-level offsets of the rows left without data
+// by the sort of the segment. The sort moves the rows which are not deleted
+// to the front of the segment and fills the tail with repeats of the last of
+// them, so the blocks stay full. If every row is deleted, the whole segment
+// is masked. It returns nil if no row is deleted.
+func (e *Segment) PurgeMask() *roaring.Bitmap {
+	e.RLock()
+	defer e.RUnlock()
+	return e.purgeMaskLocked()
+}
+
+func (e *Segment) purgeMaskLocked() *roaring.Bitmap {
+	var deleted, total uint64
+	for _, blk := range e.BlockSet {
+		if deletes := blk.GetDeletes(); deletes != nil {
+			deleted += deletes.GetCardinality()
+		}
+		total += e.Table.Schema.BlockMaxRows
+	}
+	if deleted == 0 {
+		return nil
+	}
+	mask := roaring.New()
+	mask.AddRange(total-deleted, total)
+	return mask
+}
+
+// onPurgeLocked replaces the deleted rows of the blocks with their part of
+// the purge mask of the upgraded segment. It returns the updated blocks.
+func (e *Segment) onPurgeLocked(info *CommitInfo) ([]*Block, error) {
+	if info.Deletes == nil {
+		return nil, nil
+	}
+	maxRows := uint32(e.Table.Schema.BlockMaxRows)
+	var blocks []*Block
+	for _, blk := range e.BlockSet {
+		startPos := blk.Idx * maxRows
+		deletes := roaring.New()
+		it := info.Deletes.Iterator()
+		it.AdvanceIfNeeded(startPos)
+		for it.HasNext() {
+			row := it.Next()
+			if row >= startPos+maxRows {
+				break
+			}
+			deletes.Add(row - startPos)
+		}
+		blk.Lock()
+		if blk.getDeletesLocked() == nil && deletes.IsEmpty() {
+			blk.Unlock()
+			continue
+		}
+		cInfo := &CommitInfo{
+			TranId:    info.TranId,
+			CommitId:  info.CommitId,
+			Op:        blk.CommitInfo.Op,
+			Size:      blk.CommitInfo.GetSize(),
+			LogIndex:  blk.CommitInfo.LogIndex,
+			PrevIndex: blk.CommitInfo.PrevIndex,
+			Deletes:   &DeleteMask{Bitmap: deletes},
+		}
+		if blk.CommitInfo.LogRange != nil {
+			logRange := *blk.CommitInfo.LogRange
+			cInfo.LogRange = &logRange
+		}
+		err := blk.onCommit(cInfo)
+		blk.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk)
+	}
+	return blocks, nil
+}
+
+// segmentPurge commits the upgrade of a segment together with the new
+// deleted rows of its blocks
+type segmentPurge struct {
+	*Segment
+	blocks []*Block
+}
+
+func (e *segmentPurge) CommitLocked(id uint64) {
+	e.Segment.CommitLocked(id)
+	for _, blk := range e.blocks {
+		blk.Lock()
+		blk.CommitLocked(id)
+		blk.Unlock()
+	}
+}
+
+// Safe
+func (e *Segment) SortDone() {
+	e.RLock()
+	defer e.RUnlock()
+	for _, blk := range e.BlockSet {
+		blk.SortDone()
+	}
+}
+
 func (e *Segment) DryUpgrade(size int64) {
 	e.CommitInfo.Op = OpUpgradeSorted
 	e.CommitInfo.Size = size
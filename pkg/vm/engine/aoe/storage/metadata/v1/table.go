@@ -22,6 +22,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
 )
@@ -123,6 +124,31 @@ func (e *Table) GetIndexSchema() *IndexSchema {
 	return e.CommitInfo.Indice
 }
 
+// DataKey returns the plain data key of the table, nil if the table is
+// not encrypted
+func (e *Table) DataKey() ([]byte, error) {
+	e.RLock()
+	wrapped := e.wrappedDataKeyLocked()
+	e.RUnlock()
+	if len(wrapped) == 0 {
+		return nil, nil
+	}
+	keyring := e.Database.Catalog.Cfg.Keyring
+	if keyring == nil {
+		return nil, encryption.ErrNoKeyring
+	}
+	return keyring.Unwrap(wrapped)
+}
+
+// wrappedDataKeyLocked returns the wrapped data key of the table, the one
+// wrapped again by the last key rotation if any
+func (e *Table) wrappedDataKeyLocked() []byte {
+	if len(e.CommitInfo.DataKey) > 0 {
+		return e.CommitInfo.DataKey
+	}
+	return e.Schema.DataKey
+}
+
 func (e *Table) DebugCheckReplayedState() {
 	if e.Database == nil {
 		panic("database is missing")
@@ -281,6 +307,7 @@ func (e *Table) prepareHardDelete(ctx *deleteTableCtx) (LogEntry, error) {
 	}
 	cInfo.LogIndex = e.CommitInfo.LogIndex
 	cInfo.Indice = e.CommitInfo.Indice
+	cInfo.DataKey = e.CommitInfo.DataKey
 	if err := e.onCommit(cInfo); err != nil {
 		return nil, err
 	}
@@ -320,6 +347,7 @@ func (e *Table) prepareSoftDelete(ctx *dropTableCtx) (LogEntry, error) {
 		return nil, TableNotFoundErr
 	}
 	cInfo.Indice = e.CommitInfo.Indice
+	cInfo.DataKey = e.CommitInfo.DataKey
 	err := e.onCommit(cInfo)
 	e.Unlock()
 	if err != nil {
@@ -372,6 +400,7 @@ func (e *Table) prepareAddIndice(ctx *addIndiceCtx) (LogEntry, error) {
 		return nil, err
 	}
 	cInfo.Indice = indice
+	cInfo.DataKey = e.CommitInfo.DataKey
 	err = e.onCommit(cInfo)
 	e.Unlock()
 
@@ -421,6 +450,7 @@ func (e *Table) prepareDropIndice(ctx *dropIndiceCtx) (LogEntry, error) {
 		return nil, err
 	}
 	cInfo.Indice = indice
+	cInfo.DataKey = e.CommitInfo.DataKey
 	err = e.onCommit(cInfo)
 	e.Unlock()
 
@@ -435,6 +465,56 @@ func (e *Table) prepareDropIndice(ctx *dropIndiceCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// SimpleRewrapDataKey wraps the data key of the table by the current master
+// key of the keyring, nothing is done if the table is not encrypted or the
+// key is wrapped by the current master key already
+func (e *Table) SimpleRewrapDataKey() error {
+	keyring := e.Database.Catalog.Cfg.Keyring
+	e.RLock()
+	wrapped := e.wrappedDataKeyLocked()
+	e.RUnlock()
+	if len(wrapped) == 0 || (keyring != nil && keyring.IsCurrent(wrapped)) {
+		return nil
+	}
+	if keyring == nil {
+		return encryption.ErrNoKeyring
+	}
+	tranId := e.Database.Catalog.NextUncommitId()
+	ctx := new(rewrapDataKeyCtx)
+	ctx.tranId = tranId
+	ctx.table = e
+	return e.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Table) prepareRewrapDataKey(ctx *rewrapDataKeyCtx) (LogEntry, error) {
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		Op:       OpRewrapDataKey,
+		SSLLNode: *common.NewSSLLNode(),
+	}
+	e.Lock()
+	if e.IsDeletedLocked() {
+		e.Unlock()
+		return nil, TableNotFoundErr
+	}
+	key, err := e.Database.Catalog.Cfg.Keyring.Rewrap(e.wrappedDataKeyLocked())
+	if err != nil {
+		e.Unlock()
+		return nil, err
+	}
+	cInfo.LogIndex = e.CommitInfo.LogIndex
+	cInfo.Indice = e.CommitInfo.Indice
+	cInfo.DataKey = key
+	err = e.onCommit(cInfo)
+	e.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	logEntry := e.Database.Catalog.prepareCommitEntry(e, ETRewrapDataKey, nil)
+	return logEntry, nil
+}
+
 // Not safe
 func (e *Table) Marshal() ([]byte, error) {
 	return json.Marshal(e)
@@ -475,7 +555,7 @@ func (e *Table) ToLogEntry(eType LogEntryType) LogEntry {
 			DatabaseId: e.Database.Id,
 		}
 		buf, _ = entry.Marshal()
-	case ETAddIndice, ETDropIndice:
+	case ETAddIndice, ETDropIndice, ETRewrapDataKey:
 		entry := tableLogEntry{
 			BaseEntry:  e.BaseEntry,
 			DatabaseId: e.Database.Id,
@@ -634,6 +714,18 @@ func (e *Table) onNewSegment(entry *Segment) {
 	e.SegmentSet = append(e.SegmentSet, entry)
 }
 
+func (e *Table) onDropSegment(entry *Segment) {
+	pos, ok := e.IdIndex[entry.Id]
+	if !ok {
+		return
+	}
+	delete(e.IdIndex, entry.Id)
+	e.SegmentSet = append(e.SegmentSet[:pos], e.SegmentSet[pos+1:]...)
+	for i := pos; i < len(e.SegmentSet); i++ {
+		e.IdIndex[e.SegmentSet[i].Id] = i
+	}
+}
+
 // Safe
 func (e *Table) SimpleGetBlock(segId, blkId uint64) (*Block, error) {
 	seg := e.SimpleGetSegment(segId)
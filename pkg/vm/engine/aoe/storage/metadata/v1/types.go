@@ -15,9 +15,11 @@ package metadata
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"sync/atomic"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
@@ -63,6 +65,8 @@ const (
 	OpSoftDelete
 	OpReplaced
 	OpHardDelete
+	// The ops after are not deletes
+	OpRewrapDataKey
 )
 
 var OpNames = map[OpT]string{
@@ -75,6 +79,7 @@ var OpNames = map[OpT]string{
 	OpHardDelete:    "HardDelete",
 	OpAddIndice:     "AddIndice",
 	OpDropIndice:    "DropIndice",
+	OpRewrapDataKey: "RewrapDataKey",
 }
 
 func OpName(op OpT) string {
@@ -115,6 +120,30 @@ func (r *LogRange) Unmarshal(data []byte) error {
 	return nil
 }
 
+// DeleteMask is the set of the deleted row offsets of a block. A committed
+// mask is never modified, deleting more rows commits a new mask.
+type DeleteMask struct {
+	*roaring.Bitmap
+}
+
+func (m *DeleteMask) MarshalJSON() ([]byte, error) {
+	buf, err := m.ToBase64()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(buf)
+}
+
+func (m *DeleteMask) UnmarshalJSON(data []byte) error {
+	var buf string
+	if err := json.Unmarshal(data, &buf); err != nil {
+		return err
+	}
+	m.Bitmap = roaring.New()
+	_, err := m.FromBase64(buf)
+	return err
+}
+
 type CommitInfo struct {
 	common.SSLLNode `json:"-"`
 	CommitId        uint64       `json:"cid"`
@@ -125,6 +154,10 @@ type CommitInfo struct {
 	PrevIndex       *LogIndex    `json:"pidx"`
 	LogRange        *LogRange    `json:"range"`
 	Indice          *IndexSchema `json:"indice"`
+	Deletes         *DeleteMask  `json:"deletes,omitempty"`
+	// DataKey is the data key of the table wrapped again by a key rotation,
+	// the data key of the schema is used if it is empty
+	DataKey []byte `json:"datakey,omitempty"`
 }
 
 func (info *CommitInfo) Clone() *CommitInfo {
@@ -173,6 +206,11 @@ func (info *CommitInfo) GetIndex() uint64 {
 	return info.LogIndex.Id.Id
 }
 
+// IsDeleteOf returns true if info only deletes rows on top of prev
+func (info *CommitInfo) IsDeleteOf(prev *CommitInfo) bool {
+	return info.Op == prev.Op && info.Deletes != nil && info.Deletes != prev.Deletes
+}
+
 func (info *CommitInfo) IsHardDeleted() bool {
 	return info.Op == OpHardDelete
 }
@@ -182,7 +220,7 @@ func (info *CommitInfo) IsSoftDeleted() bool {
 }
 
 func (info *CommitInfo) IsDeleted() bool {
-	return info.Op >= OpSoftDelete
+	return info.Op >= OpSoftDelete && info.Op <= OpHardDelete
 }
 
 func (info *CommitInfo) IsReplaced() bool {
@@ -234,6 +272,9 @@ func (info *CommitInfo) PString(level PPLevel) string {
 			// }
 			// s = fmt.Sprintf("%s%s]", s, ids)
 		}
+		if cInfo.Deletes != nil {
+			s = fmt.Sprintf("%s[Deletes=%d]", s, cInfo.Deletes.GetCardinality())
+		}
 		prev = curr
 		curr = curr.GetNext()
 	}
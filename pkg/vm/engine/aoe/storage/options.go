@@ -20,9 +20,12 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/encryption"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/event"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/gc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/gc/gci"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
 )
@@ -37,9 +40,17 @@ const (
 
 	DefaultCleanInterval = 5
 
+	// DefaultTTLCleanInterval is the interval in seconds the expired
+	// segments of the tables with a TTL are dropped
+	DefaultTTLCleanInterval = 60
+
 	DefaultBlockWriters     = uint16(8)
 	DefaultSegmentWriters   = uint16(4)
 	DefaultStatelessWorkers = uint16(1)
+
+	DefaultCompactionInterval    = uint64(60)
+	DefaultCompactionMaxMerges   = 4
+	DefaultCompactionHistorySize = 64
 )
 
 type IterOptions struct {
@@ -54,6 +65,9 @@ type CacheCfg struct {
 	IndexCapacity  uint64 `toml:"index-cache-size"`
 	InsertCapacity uint64 `toml:"insert-cache-size"`
 	DataCapacity   uint64 `toml:"data-cache-size"`
+	// EvictPolicy is the evict policy of the index and the data cache, it is
+	// one of "fifo", "lru-k" and "2q". The default is "fifo".
+	EvictPolicy string `toml:"evict-policy"`
 }
 
 type MetaCfg struct {
@@ -71,6 +85,42 @@ type MetaCleanerCfg struct {
 	Interval time.Duration
 }
 
+// TTLCleanerCfg sets how often the expired segments of the tables with a
+// TTL are dropped
+type TTLCleanerCfg struct {
+	Interval time.Duration
+}
+
+// ArchiveCfg enables the redo log of the applied appends and DDL in the
+// store of the catalog. The store is rotated by RotationFileMaxSize and the
+// rotated versions are archived into Dir, they are replayed by the
+// point-in-time recovery.
+type ArchiveCfg struct {
+	Dir                 string `toml:"archive-dir"`
+	RotationFileMaxSize int    `toml:"rotation-file-max-size"`
+}
+
+// EncryptionCfg enables the encryption at rest. The master keys are in
+// KeyFile, it is created with a new key if it does not exist. The files of
+// the tables created with the encryption option, or all the tables created
+// if Default is set, are encrypted by the data keys of the tables.
+type EncryptionCfg struct {
+	KeyFile string `toml:"key-file"`
+	Default bool   `toml:"default"`
+}
+
+// CompactionCfg enables the background compaction. Every Interval seconds
+// the closed unsorted segments are scored, and at most MaxMerges of those
+// scored no lower than MinScore are merged into sorted segments. The merges
+// read at most RateLimit bytes per second, 0 is unlimited.
+type CompactionCfg struct {
+	Interval    uint64  `toml:"interval"`
+	MaxMerges   int     `toml:"max-merges"`
+	MinScore    float64 `toml:"min-score"`
+	RateLimit   uint64  `toml:"rate-limit"`
+	HistorySize int     `toml:"history-size"`
+}
+
 type Options struct {
 	EventListener event.Listener
 
@@ -95,6 +145,24 @@ type Options struct {
 	CacheCfg *CacheCfg `toml:"cache-cfg"`
 
 	MetaCleanerCfg *MetaCleanerCfg
+
+	TTLCleanerCfg *TTLCleanerCfg
+
+	ArchiveCfg *ArchiveCfg `toml:"archive-cfg"`
+
+	// FileService stores the sorted segments, it is made of FileServiceCfg
+	// if it is nil. The sorted segments are on the local disk without both.
+	FileService    fileservice.FileService
+	FileServiceCfg *fileservice.Config `toml:"file-service-cfg"`
+
+	// Keyring wraps the data keys of the encrypted tables, it is opened
+	// from EncryptionCfg if it is nil. Nothing is encrypted without both.
+	Keyring       *encryption.Keyring
+	EncryptionCfg *EncryptionCfg `toml:"encryption-cfg"`
+
+	// CompactionCfg is nil if the background compaction is disabled, the
+	// tables can still be compacted by ADMIN COMPACT TABLE.
+	CompactionCfg *CompactionCfg `toml:"compaction-cfg"`
 }
 
 func (o *Options) FillDefaults(dirname string) *Options {
@@ -156,6 +224,25 @@ func (o *Options) FillDefaults(dirname string) *Options {
 			// Interval: time.Duration(200) * time.Millisecond,
 		}
 	}
+	if o.TTLCleanerCfg == nil {
+		o.TTLCleanerCfg = &TTLCleanerCfg{
+			Interval: time.Duration(DefaultTTLCleanInterval) * time.Second,
+		}
+	}
+	if o.CompactionCfg != nil {
+		if o.CompactionCfg.Interval == 0 {
+			o.CompactionCfg.Interval = DefaultCompactionInterval
+		}
+		if o.CompactionCfg.MaxMerges <= 0 {
+			o.CompactionCfg.MaxMerges = DefaultCompactionMaxMerges
+		}
+		if o.CompactionCfg.HistorySize <= 0 {
+			o.CompactionCfg.HistorySize = DefaultCompactionHistorySize
+		}
+	}
+	if o.ArchiveCfg != nil && o.ArchiveCfg.RotationFileMaxSize <= 0 {
+		o.ArchiveCfg.RotationFileMaxSize = logstore.DefaultVersionFileSize
+	}
 	return o
 }
 
@@ -22,6 +22,26 @@ import (
 
 type Role uint8
 
+const (
+	ETRedoStart = uint16(50)
+)
+
+// The redo entries logged with the catalog for the point-in-time recovery,
+// the replay of the catalog skips them.
+const (
+	ETRedoAppend = iota + ETRedoStart
+	ETRedoCreateTable
+	ETRedoDropTable
+	ETRedoCreateIndex
+	ETRedoDropIndex
+	ETRedoDelete
+	ETRedoEnd
+)
+
+func IsRedoEntry(typ uint16) bool {
+	return typ >= ETRedoStart && typ < ETRedoEnd
+}
+
 const (
 	BrokerRole Role = iota
 	HolderRole
@@ -36,4 +36,5 @@ type SparseFilter interface {
 	Gt(string, interface{}) ([]string, error)
 	Ge(string, interface{}) ([]string, error)
 	Btw(string, interface{}, interface{}) ([]string, error)
+	Eval(*engine.Predicate) ([]string, error)
 }
\ No newline at end of file
@@ -0,0 +1,127 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+)
+
+type archiveWriter struct {
+	w   *bufio.Writer
+	buf [5]byte
+}
+
+func newArchiveWriter(w io.Writer) (*archiveWriter, error) {
+	aw := &archiveWriter{w: bufio.NewWriter(w)}
+	if _, err := aw.w.WriteString(Magic); err != nil {
+		return nil, err
+	}
+	binary.LittleEndian.PutUint16(aw.buf[:2], Version)
+	if _, err := aw.w.Write(aw.buf[:2]); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+func (aw *archiveWriter) writeSection(kind uint8, payload []byte) error {
+	aw.buf[0] = kind
+	binary.LittleEndian.PutUint32(aw.buf[1:], uint32(len(payload)))
+	if _, err := aw.w.Write(aw.buf[:5]); err != nil {
+		return err
+	}
+	if _, err := aw.w.Write(payload); err != nil {
+		return err
+	}
+	sum := crc32.Update(crc32.ChecksumIEEE(aw.buf[:1]), crc32.IEEETable, payload)
+	binary.LittleEndian.PutUint32(aw.buf[:4], sum)
+	_, err := aw.w.Write(aw.buf[:4])
+	return err
+}
+
+func (aw *archiveWriter) flush() error {
+	return aw.w.Flush()
+}
+
+type archiveReader struct {
+	r       *bufio.Reader
+	buf     [5]byte
+	payload []byte
+}
+
+func newArchiveReader(r io.Reader) (*archiveReader, error) {
+	ar := &archiveReader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(Magic)+2)
+	if _, err := io.ReadFull(ar.r, magic); err != nil {
+		return nil, ErrBadMagic
+	}
+	if string(magic[:len(Magic)]) != Magic {
+		return nil, ErrBadMagic
+	}
+	if binary.LittleEndian.Uint16(magic[len(Magic):]) != Version {
+		return nil, ErrBadVersion
+	}
+	return ar, nil
+}
+
+// next returns the next section of the archive, the payload is
+// only valid until the next call. io.EOF is returned at the end
+// of the archive.
+func (ar *archiveReader) next() (uint8, []byte, error) {
+	if _, err := io.ReadFull(ar.r, ar.buf[:5]); err != nil {
+		if err == io.EOF {
+			return 0, nil, io.EOF
+		}
+		return 0, nil, ErrTruncated
+	}
+	kind := ar.buf[0]
+	n := int(binary.LittleEndian.Uint32(ar.buf[1:]))
+	if cap(ar.payload) < n {
+		ar.payload = make([]byte, n)
+	}
+	payload := ar.payload[:n]
+	if _, err := io.ReadFull(ar.r, payload); err != nil {
+		return 0, nil, ErrTruncated
+	}
+	if _, err := io.ReadFull(ar.r, ar.buf[1:5]); err != nil {
+		return 0, nil, ErrTruncated
+	}
+	sum := crc32.Update(crc32.ChecksumIEEE(ar.buf[:1]), crc32.IEEETable, payload)
+	if sum != binary.LittleEndian.Uint32(ar.buf[1:5]) {
+		return 0, nil, ErrBadChecksum
+	}
+	return kind, payload, nil
+}
+
+func encodeCounts(s *Summary) []byte {
+	data := make([]byte, 24)
+	binary.LittleEndian.PutUint64(data, uint64(s.Tables))
+	binary.LittleEndian.PutUint64(data[8:], uint64(s.Batches))
+	binary.LittleEndian.PutUint64(data[16:], uint64(s.Rows))
+	return data
+}
+
+func decodeCounts(data []byte) (Summary, error) {
+	if len(data) != 24 {
+		return Summary{}, ErrBadSection
+	}
+	return Summary{
+		Tables:  int(binary.LittleEndian.Uint64(data)),
+		Batches: int(binary.LittleEndian.Uint64(data[8:])),
+		Rows:    int64(binary.LittleEndian.Uint64(data[16:])),
+	}, nil
+}
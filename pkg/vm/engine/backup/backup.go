@@ -0,0 +1,366 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/protocol"
+)
+
+// Backup writes the catalog metadata and the data of all tables of the
+// database db into the archive at path. The backup is logical, the rows
+// are read through the engine readers and the indexes are rebuilt from
+// their definitions on restore, see FormatRows. All the tables are pinned before any of
+// them is read, so the archive holds the database as it was at that point
+// and appends are not blocked while the backup is running. The archive is
+// written into a temporary file first and is renamed to path once it is
+// complete.
+func Backup(e engine.Engine, db string, path string) (*Summary, error) {
+	d, err := e.Database(db)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("backup: file %s already exists", path)
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return nil, err
+	}
+	s, err := backup(d, db, f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return nil, err
+	}
+	return s, nil
+}
+
+func backup(d engine.Database, db string, w io.Writer) (*Summary, error) {
+	var buf bytes.Buffer
+
+	aw, err := newArchiveWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	names := d.Relations()
+	data, err := json.Marshal(&Manifest{
+		Version:  Version,
+		Format:   FormatRows,
+		Database: db,
+		Created:  time.Now(),
+		Tables:   names,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = aw.writeSection(sectionManifest, data); err != nil {
+		return nil, err
+	}
+	rels := make([]engine.Relation, 0, len(names))
+	defer func() {
+		for _, r := range rels {
+			r.Close()
+		}
+	}()
+	for _, name := range names {
+		r, err := d.Relation(name)
+		if err != nil {
+			return nil, err
+		}
+		rels = append(rels, r)
+	}
+	for _, r := range rels {
+		if ss, ok := r.(engine.Snapshotter); ok {
+			if err = ss.Snapshot(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	s := new(Summary)
+	for i, r := range rels {
+		if err = backupTable(aw, &buf, uint32(i), names[i], r, s); err != nil {
+			return nil, err
+		}
+		s.Tables++
+	}
+	if err = aw.writeSection(sectionEnd, encodeCounts(s)); err != nil {
+		return nil, err
+	}
+	if err = aw.flush(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func backupTable(aw *archiveWriter, buf *bytes.Buffer, idx uint32, name string, r engine.Relation, s *Summary) error {
+	var attrs []string
+
+	defs := r.TableDefs()
+	tbl, err := helper.Transfer(0, 0, 0, name, defs)
+	if err != nil {
+		return err
+	}
+	data, err := helper.EncodeTable(tbl)
+	if err != nil {
+		return err
+	}
+	if err = aw.writeSection(sectionTable, data); err != nil {
+		return err
+	}
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, attr.Attr.Name)
+		}
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+	refs := make([]uint64, len(attrs))
+	for i := range refs {
+		refs[i] = 1
+	}
+	for _, rd := range r.NewReader(1) {
+		for {
+			bat, err := rd.Read(refs, attrs)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			// the readers reuse the memory of the batch,
+			// so it is encoded before the next read
+			buf.Reset()
+			buf.Write(make([]byte, 4))
+			binary.LittleEndian.PutUint32(buf.Bytes(), idx)
+			if err = protocol.EncodeBatch(bat, buf); err != nil {
+				return err
+			}
+			if err = aw.writeSection(sectionBatch, buf.Bytes()); err != nil {
+				return err
+			}
+			s.Batches++
+			s.Rows += int64(batchRows(bat))
+		}
+	}
+	return nil
+}
+
+func batchRows(bat *batch.Batch) int {
+	if len(bat.Vecs) == 0 {
+		return 0
+	}
+	return vector.Length(bat.Vecs[0])
+}
+
+// Verify checks the checksums and the structure of the archive at path
+// and returns its manifest.
+func Verify(path string) (*Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return verify(f)
+}
+
+func verify(r io.Reader) (*Manifest, error) {
+	var s Summary
+	var end bool
+	var mf *Manifest
+
+	ar, err := newArchiveReader(r)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		kind, payload, err := ar.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if end || (mf == nil && kind != sectionManifest) {
+			return nil, ErrBadSection
+		}
+		switch kind {
+		case sectionManifest:
+			if mf != nil {
+				return nil, ErrBadSection
+			}
+			mf = new(Manifest)
+			if err := json.Unmarshal(payload, mf); err != nil {
+				return nil, err
+			}
+			if mf.Format != FormatRows {
+				return nil, ErrBadFormat
+			}
+		case sectionTable:
+			s.Tables++
+		case sectionBatch:
+			if len(payload) < 4 || int(binary.LittleEndian.Uint32(payload)) >= s.Tables {
+				return nil, ErrUnknownTable
+			}
+			bat, _, err := protocol.DecodeBatch(payload[4:])
+			if err != nil {
+				return nil, err
+			}
+			s.Batches++
+			s.Rows += int64(batchRows(bat))
+		case sectionEnd:
+			cnt, err := decodeCounts(payload)
+			if err != nil {
+				return nil, err
+			}
+			if cnt != s || len(mf.Tables) != s.Tables {
+				return nil, ErrCountsMismatch
+			}
+			end = true
+		default:
+			return nil, ErrBadSection
+		}
+	}
+	if !end {
+		return nil, ErrTruncated
+	}
+	return mf, nil
+}
+
+// Restore creates the database db from the archive at path, the tables of
+// the archive are registered into the catalog of the engine and their data
+// is written back. The whole archive is verified before anything is created,
+// and the database is dropped again if the restore fails.
+func Restore(e engine.Engine, db string, path string, epoch uint64) (*Summary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err = verify(f); err != nil {
+		return nil, err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	for _, name := range e.Databases() {
+		if name == db {
+			return nil, fmt.Errorf("backup: database %s already exists", db)
+		}
+	}
+	if err = e.Create(epoch, db, 0); err != nil {
+		return nil, err
+	}
+	d, err := e.Database(db)
+	if err == nil {
+		var s *Summary
+		if s, err = restore(d, f, epoch); err == nil {
+			return s, nil
+		}
+	}
+	if derr := e.Delete(epoch, db); derr != nil {
+		logutil.Errorf("backup: drop database %s after a failed restore: %v", db, derr)
+	}
+	return nil, err
+}
+
+func restore(d engine.Database, r io.Reader, epoch uint64) (*Summary, error) {
+	var rels []engine.Relation
+
+	defer func() {
+		for _, rel := range rels {
+			rel.Close()
+		}
+	}()
+	ar, err := newArchiveReader(r)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Summary)
+	for {
+		kind, payload, err := ar.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch kind {
+		case sectionTable:
+			tbl, err := helper.DecodeTable(payload)
+			if err != nil {
+				return nil, err
+			}
+			_, _, _, name, defs, err := helper.UnTransfer(tbl)
+			if err != nil {
+				return nil, err
+			}
+			if err = d.Create(epoch, name, defs); err != nil {
+				return nil, err
+			}
+			rel, err := d.Relation(name)
+			if err != nil {
+				return nil, err
+			}
+			rels = append(rels, rel)
+			s.Tables++
+		case sectionBatch:
+			idx := int(binary.LittleEndian.Uint32(payload))
+			if idx >= len(rels) {
+				return nil, ErrUnknownTable
+			}
+			bat, _, err := protocol.DecodeBatch(payload[4:])
+			if err != nil {
+				return nil, err
+			}
+			if err = rels[idx].Write(epoch, bat); err != nil {
+				return nil, err
+			}
+			s.Batches++
+			s.Rows += int64(batchRows(bat))
+		case sectionEnd:
+			cnt, err := decodeCounts(payload)
+			if err != nil {
+				return nil, err
+			}
+			if cnt != *s {
+				return nil, ErrCountsMismatch
+			}
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
+	"github.com/stretchr/testify/require"
+)
+
+// tables hides the segment keys that the memEngine lists as relations
+type tables struct {
+	engine.Database
+	names []string
+}
+
+func (t *tables) Relations() []string {
+	return t.names
+}
+
+func newTestDatabase(t *testing.T) engine.Database {
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	return db
+}
+
+func newTestTable(t *testing.T, db engine.Database) {
+	defs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{
+			Alg:  compress.None,
+			Name: "a",
+			Type: types.Type{Oid: types.T_int64, Size: 8},
+		}},
+		&engine.AttributeDef{Attr: engine.Attribute{
+			Alg:  compress.None,
+			Name: "b",
+			Type: types.Type{Oid: types.T_varchar, Size: 24},
+		}},
+	}
+	require.NoError(t, db.Create(0, "t", defs))
+	r, err := db.Relation("t")
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		bat := batch.New(true, []string{"a", "b"})
+		bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		bat.Vecs[0].Col = []int64{int64(i), int64(i + 10), int64(i + 20)}
+		bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+		vector.Append(bat.Vecs[1], [][]byte{[]byte("x"), []byte("yy"), []byte("zzz")})
+		require.NoError(t, r.Write(0, bat))
+	}
+}
+
+func readTable(t *testing.T, db engine.Database) []int64 {
+	var rows []int64
+
+	r, err := db.Relation("t")
+	require.NoError(t, err)
+	rd := r.NewReader(1)[0]
+	for {
+		bat, err := rd.Read([]uint64{1, 1}, []string{"a", "b"})
+		require.NoError(t, err)
+		if bat == nil {
+			break
+		}
+		rows = append(rows, bat.Vecs[0].Col.([]int64)...)
+		require.Equal(t, "zzz", string(bat.Vecs[1].Col.(*types.Bytes).Get(2)))
+	}
+	return rows
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	var buf bytes.Buffer
+
+	db := newTestDatabase(t)
+	newTestTable(t, db)
+	s, err := backup(&tables{db, []string{"t"}}, "test", &buf)
+	require.NoError(t, err)
+	require.Equal(t, Summary{Tables: 1, Batches: 2, Rows: 6}, *s)
+
+	mf, err := verify(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, "test", mf.Database)
+	require.Equal(t, []string{"t"}, mf.Tables)
+
+	restored := newTestDatabase(t)
+	s, err = restore(restored, bytes.NewReader(buf.Bytes()), 0)
+	require.NoError(t, err)
+	require.Equal(t, Summary{Tables: 1, Batches: 2, Rows: 6}, *s)
+	require.Equal(t, readTable(t, db), readTable(t, restored))
+}
+
+func TestCorruptedArchive(t *testing.T) {
+	var buf bytes.Buffer
+
+	db := newTestDatabase(t)
+	newTestTable(t, db)
+	_, err := backup(&tables{db, []string{"t"}}, "test", &buf)
+	require.NoError(t, err)
+	data := buf.Bytes()
+
+	_, err = verify(bytes.NewReader(data[:len(data)-3]))
+	require.Equal(t, ErrTruncated, err)
+
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)/2] ^= 0xff
+	_, err = verify(bytes.NewReader(corrupted))
+	require.Equal(t, ErrBadChecksum, err)
+
+	_, err = verify(bytes.NewReader([]byte("not an archive")))
+	require.Equal(t, ErrBadMagic, err)
+}
+
+func TestRowsMismatch(t *testing.T) {
+	var buf, tampered bytes.Buffer
+
+	db := newTestDatabase(t)
+	newTestTable(t, db)
+	_, err := backup(&tables{db, []string{"t"}}, "test", &buf)
+	require.NoError(t, err)
+
+	// rewrite the archive with one more row in the end section
+	ar, err := newArchiveReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	aw, err := newArchiveWriter(&tampered)
+	require.NoError(t, err)
+	for {
+		kind, payload, err := ar.next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if kind == sectionEnd {
+			payload = encodeCounts(&Summary{Tables: 1, Batches: 2, Rows: 7})
+		}
+		require.NoError(t, aw.writeSection(kind, payload))
+	}
+	require.NoError(t, aw.flush())
+
+	_, err = verify(bytes.NewReader(tampered.Bytes()))
+	require.Equal(t, ErrCountsMismatch, err)
+	_, err = restore(newTestDatabase(t), bytes.NewReader(tampered.Bytes()), 0)
+	require.Equal(t, ErrCountsMismatch, err)
+}
+
+func TestUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+
+	aw, err := newArchiveWriter(&buf)
+	require.NoError(t, err)
+	data, err := json.Marshal(&Manifest{Version: Version, Format: "files", Database: "test"})
+	require.NoError(t, err)
+	require.NoError(t, aw.writeSection(sectionManifest, data))
+	require.NoError(t, aw.writeSection(sectionEnd, encodeCounts(&Summary{})))
+	require.NoError(t, aw.flush())
+
+	_, err = verify(bytes.NewReader(buf.Bytes()))
+	require.Equal(t, ErrBadFormat, err)
+}
+
+// restoreEngine restores into a database whose tables can not be created
+type restoreEngine struct {
+	engine.Engine
+	dropped []string
+}
+
+type failedDatabase struct {
+	engine.Database
+}
+
+func (d *failedDatabase) Create(uint64, string, []engine.TableDef) error {
+	return errors.New("create table failed")
+}
+
+func (e *restoreEngine) Databases() []string {
+	return nil
+}
+
+func (e *restoreEngine) Database(string) (engine.Database, error) {
+	db, err := e.Engine.Database("test")
+	if err != nil {
+		return nil, err
+	}
+	return &failedDatabase{db}, nil
+}
+
+func (e *restoreEngine) Delete(_ uint64, name string) error {
+	e.dropped = append(e.dropped, name)
+	return nil
+}
+
+func TestRestoreFailed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.bak")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	db := newTestDatabase(t)
+	newTestTable(t, db)
+	_, err = backup(&tables{db, []string{"t"}}, "test", f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	e := &restoreEngine{Engine: memEngine.NewTestEngine()}
+	_, err = Restore(e, "restored", path, 0)
+	require.Error(t, err)
+	require.Equal(t, []string{"restored"}, e.dropped)
+}
+
+func TestBackupFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.bak")
+	e := memEngine.NewTestEngine()
+
+	_, err := Backup(e, "unknown", path)
+	require.Error(t, err)
+	_, err = os.Stat(path + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, os.WriteFile(path, nil, 0644))
+	_, err = Backup(e, "test", path)
+	require.Error(t, err)
+	_, err = Restore(e, "test", path, 0)
+	require.Equal(t, ErrBadMagic, err)
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	Magic   = "MOBACKUP"
+	Version = uint16(1)
+)
+
+// FormatRows is the format of the archives holding the rows of the tables.
+// The segment, block and index files of the AOE storage are not archived:
+// the files of a table are spread over the replicas of its shards, and
+// installing them into the shards of the restored tables would bypass the
+// raft log, so only the local replicas would see them. The rows are read
+// through the engine instead and written back through it on restore, so the
+// archive does not depend on the shards or on the layout of the files.
+const FormatRows = "rows"
+
+// The archive is a header followed by a list of sections:
+//
+//	header:  magic | version(uint16)
+//	section: kind(uint8) | length(uint32) | payload | crc32(kind + payload)
+//
+// The payload of the table section is the encoded aoe.TableInfo of the
+// table, the payload of the batch section is the index of its table
+// followed by the encoded batch, the end section holds the number of
+// tables, batches and rows of the archive.
+const (
+	sectionManifest uint8 = iota + 1
+	sectionTable
+	sectionBatch
+	sectionEnd
+)
+
+var (
+	ErrBadMagic       = errors.New("backup: not a backup archive")
+	ErrBadVersion     = errors.New("backup: unsupported archive version")
+	ErrBadFormat      = errors.New("backup: unsupported archive format")
+	ErrBadChecksum    = errors.New("backup: checksum mismatch")
+	ErrTruncated      = errors.New("backup: archive is truncated")
+	ErrBadSection     = errors.New("backup: unknown section")
+	ErrUnknownTable   = errors.New("backup: batch of an unknown table")
+	ErrCountsMismatch = errors.New("backup: archive counts mismatch")
+)
+
+// Manifest describes the content of an archive, it is the first section
+// of every archive and is stored as json.
+type Manifest struct {
+	Version  uint16    `json:"version"`
+	Format   string    `json:"format"`
+	Database string    `json:"database"`
+	Created  time.Time `json:"created"`
+	Tables   []string  `json:"tables"`
+}
+
+// Summary is the result of a backup or a restore
+type Summary struct {
+	Tables  int
+	Batches int
+	Rows    int64
+}
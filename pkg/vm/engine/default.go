@@ -0,0 +1,216 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// DefaultVector returns a vector of n rows filled with the default value of
+// the attribute, it is used to read the blocks written before the attribute
+// was added. The rows are the zero value of the type if the attribute has no
+// default value, as a not null column without default is filled by mysql.
+func DefaultVector(attr Attribute, n int) (*vector.Vector, error) {
+	vec := vector.New(attr.Type)
+	v, isNull := attr.GetDefaultExpr()
+	if !attr.HasDefaultExpr() || isNull || v == nil {
+		if err := vector.Append(vec, zeroValues(attr.Type, n)); err != nil {
+			return nil, err
+		}
+		if attr.HasDefaultExpr() {
+			for i := 0; i < n; i++ {
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		}
+		return vec, nil
+	}
+	vs, err := defaultValues(attr.Type, v, n)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default value '%v' of attribute '%s': %v", v, attr.Name, err)
+	}
+	if err := vector.Append(vec, vs); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+func zeroValues(typ types.Type, n int) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		return make([]int8, n)
+	case types.T_int16:
+		return make([]int16, n)
+	case types.T_int32:
+		return make([]int32, n)
+	case types.T_int64:
+		return make([]int64, n)
+	case types.T_uint8:
+		return make([]uint8, n)
+	case types.T_uint16:
+		return make([]uint16, n)
+	case types.T_uint32:
+		return make([]uint32, n)
+	case types.T_uint64:
+		return make([]uint64, n)
+	case types.T_float32:
+		return make([]float32, n)
+	case types.T_float64:
+		return make([]float64, n)
+	case types.T_date:
+		return make([]types.Date, n)
+	case types.T_datetime:
+		return make([]types.Datetime, n)
+	case types.T_char, types.T_varchar:
+		return make([][]byte, n)
+	}
+	return nil
+}
+
+// defaultValues converts the default value to the type of the attribute,
+// the value is widened to int64, uint64 or float64 when the definition is
+// decoded from json, so every numeric type is accepted.
+func defaultValues(typ types.Type, v interface{}, n int) (interface{}, error) {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar:
+		var s []byte
+		switch v := v.(type) {
+		case string:
+			s = []byte(v)
+		case []byte:
+			s = v
+		default:
+			return nil, fmt.Errorf("unexpected type %T", v)
+		}
+		vs := make([][]byte, n)
+		for i := range vs {
+			vs[i] = s
+		}
+		return vs, nil
+	case types.T_float32, types.T_float64:
+		f, ok := toFloat64(v)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T", v)
+		}
+		if typ.Oid == types.T_float32 {
+			vs := make([]float32, n)
+			for i := range vs {
+				vs[i] = float32(f)
+			}
+			return vs, nil
+		}
+		vs := make([]float64, n)
+		for i := range vs {
+			vs[i] = f
+		}
+		return vs, nil
+	}
+	x, ok := toInt64(v)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+	vs := zeroValues(typ, n)
+	switch vs := vs.(type) {
+	case []int8:
+		for i := range vs {
+			vs[i] = int8(x)
+		}
+	case []int16:
+		for i := range vs {
+			vs[i] = int16(x)
+		}
+	case []int32:
+		for i := range vs {
+			vs[i] = int32(x)
+		}
+	case []int64:
+		for i := range vs {
+			vs[i] = x
+		}
+	case []uint8:
+		for i := range vs {
+			vs[i] = uint8(x)
+		}
+	case []uint16:
+		for i := range vs {
+			vs[i] = uint16(x)
+		}
+	case []uint32:
+		for i := range vs {
+			vs[i] = uint32(x)
+		}
+	case []uint64:
+		for i := range vs {
+			vs[i] = uint64(x)
+		}
+	case []types.Date:
+		for i := range vs {
+			vs[i] = types.Date(x)
+		}
+	case []types.Datetime:
+		for i := range vs {
+			vs[i] = types.Datetime(x)
+		}
+	default:
+		return nil, fmt.Errorf("unsupport type %s", typ)
+	}
+	return vs, nil
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint64:
+		return int64(v), true
+	case float32:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	case types.Date:
+		return int64(v), true
+	case types.Datetime:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	if x, ok := toInt64(v); ok {
+		return float64(x), true
+	}
+	return 0, false
+}
@@ -1,7 +1,14 @@
 package engine
 
-import "encoding/gob"
+import (
+	"encoding/gob"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
 
 func init() {
 	gob.Register(Attribute{})
+	// the values of the predicates sent to the storage
+	gob.Register(types.Date(0))
+	gob.Register(types.Datetime(0))
 }
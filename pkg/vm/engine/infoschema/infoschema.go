@@ -0,0 +1,235 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+/*
+New returns an engine which has the virtual database information_schema besides
+the databases of e. The tables of information_schema are generated from the
+databases of e and the session sess when they are read, they are read at the
+node n. The virtual database is read-only.
+*/
+func New(e engine.Engine, n engine.Node, sess Session) engine.Engine {
+	return &infoEngine{Engine: e, n: n, sess: sess}
+}
+
+// IsInfoSchema returns true if db is the virtual database
+func IsInfoSchema(db string) bool {
+	return strings.EqualFold(db, Name)
+}
+
+func (e *infoEngine) Delete(epoch uint64, name string) error {
+	if IsInfoSchema(name) {
+		return fmt.Errorf("Access denied to database '%s'", Name)
+	}
+	return e.Engine.Delete(epoch, name)
+}
+
+func (e *infoEngine) Create(epoch uint64, name string, typ int) error {
+	if IsInfoSchema(name) {
+		return fmt.Errorf("Can't create database '%s'; database exists", name)
+	}
+	return e.Engine.Create(epoch, name, typ)
+}
+
+func (e *infoEngine) Databases() []string {
+	return append([]string{Name}, e.Engine.Databases()...)
+}
+
+func (e *infoEngine) Database(name string) (engine.Database, error) {
+	if IsInfoSchema(name) {
+		return &database{e: e}, nil
+	}
+	return e.Engine.Database(name)
+}
+
+// visible returns true if the table tbl of the database db can be seen
+func (e *infoEngine) visible(db, tbl string) bool {
+	return e.sess == nil || e.sess.Visible(db, tbl)
+}
+
+func (d *database) Relations() []string {
+	names := make([]string, len(tables))
+	for i, tbl := range tables {
+		names[i] = tbl.name
+	}
+	return names
+}
+
+func (d *database) Relation(name string) (engine.Relation, error) {
+	for _, tbl := range tables {
+		if strings.EqualFold(tbl.name, name) {
+			return &relation{tbl: tbl, n: d.e.n, rows: tbl.rows(d.e)}, nil
+		}
+	}
+	return nil, fmt.Errorf("Unknown table '%s' in %s", name, Name)
+}
+
+func (d *database) Delete(_ uint64, _ string) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+func (d *database) Create(_ uint64, _ string, _ []engine.TableDef) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+func (d *database) Rename(_ uint64, _, _ string) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+func (r *relation) Close() {}
+
+func (r *relation) ID() string {
+	return r.tbl.name
+}
+
+func (r *relation) Rows() int64 {
+	return int64(len(r.rows))
+}
+
+func (_ *relation) Size(_ string) int64 {
+	return 0
+}
+
+func (r *relation) Nodes() engine.Nodes {
+	return engine.Nodes{r.n}
+}
+
+func (r *relation) TableDefs() []engine.TableDef {
+	defs := make([]engine.TableDef, len(r.tbl.cols))
+	for i, col := range r.tbl.cols {
+		defs[i] = &engine.AttributeDef{Attr: engine.Attribute{Name: col.name, Type: col.typ}}
+	}
+	return defs
+}
+
+func (r *relation) CreateIndex(_ uint64, _ []engine.TableDef) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+func (r *relation) DropIndex(_ uint64, _ string) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+func (r *relation) Write(_ uint64, _ *batch.Batch) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+func (r *relation) AddTableDef(_ uint64, _ engine.TableDef) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+func (r *relation) DelTableDef(_ uint64, _ engine.TableDef) error {
+	return fmt.Errorf("Access denied to database '%s'", Name)
+}
+
+// NewReader returns n readers, the first one reads all rows and the others read nothing
+func (r *relation) NewReader(n int) []engine.Reader {
+	rds := make([]engine.Reader, n)
+	for i := range rds {
+		rds[i] = &reader{r: r, done: i > 0}
+	}
+	return rds
+}
+
+func (_ *reader) NewFilter() engine.Filter {
+	return nil
+}
+
+func (_ *reader) NewSummarizer() engine.Summarizer {
+	return nil
+}
+
+func (_ *reader) NewSparseFilter() engine.SparseFilter {
+	return nil
+}
+
+func (rd *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if rd.done || len(rd.r.rows) == 0 {
+		return nil, nil
+	}
+	rd.done = true
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		j := rd.r.tbl.index(attr)
+		if j < 0 {
+			return nil, fmt.Errorf("Unknown column '%s' in '%s'", attr, rd.r.tbl.name)
+		}
+		vec, err := newVector(rd.r.tbl.cols[j].typ, rd.r.rows, j)
+		if err != nil {
+			return nil, err
+		}
+		vec.Or = true
+		vec.Ref = cs[i]
+		bat.Vecs[i] = vec
+	}
+	bat.Zs = make([]int64, len(rd.r.rows))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat, nil
+}
+
+func (tbl *table) index(name string) int {
+	for i, col := range tbl.cols {
+		if col.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// newVector returns the vector of the j-th column of rows
+func newVector(typ types.Type, rows [][]interface{}, j int) (*vector.Vector, error) {
+	vec := vector.New(typ)
+	switch typ.Oid {
+	case types.T_int64:
+		vs := make([]int64, len(rows))
+		for i, row := range rows {
+			if v, ok := row[j].(int64); ok {
+				vs[i] = v
+			} else {
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return nil, err
+		}
+	default:
+		vs := make([][]byte, len(rows))
+		for i, row := range rows {
+			if v, ok := row[j].(string); ok {
+				vs[i] = []byte(v)
+			} else {
+				vs[i] = []byte{}
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return nil, err
+		}
+	}
+	return vec, nil
+}
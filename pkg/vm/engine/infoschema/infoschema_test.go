@@ -0,0 +1,170 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/stretchr/testify/require"
+)
+
+type testSession struct {
+	tables map[string]bool
+}
+
+func (s *testSession) Visible(db, tbl string) bool {
+	return tbl == "" || s.tables[tbl]
+}
+
+func (s *testSession) Processes() []Process {
+	return []Process{{Id: 1, User: "u", Host: "127.0.0.1:1", Command: "Query", Time: 2, State: "executing", Info: "select 1"}}
+}
+
+func (s *testSession) Digests() []Digest {
+	return []Digest{{Schema: "test", Digest: "d", DigestText: "select ?", Count: 4, SumLatency: 100, MaxLatency: 40}}
+}
+
+// compactionEngine is the engine which has merged the table R and is merging the table S
+type compactionEngine struct {
+	engine.Engine
+}
+
+func (e *compactionEngine) Compactions() []engine.Compaction {
+	started := time.Date(2021, 10, 1, 8, 0, 0, 0, time.UTC)
+	return []engine.Compaction{
+		{Database: "test", Table: "R", Segments: []uint64{3, 4}, Manual: true, Score: 1.5, Size: 100, Written: 60,
+			Started: started, Finished: started.Add(time.Second)},
+		{Database: "test", Table: "R", Segments: []uint64{5}, Score: 2, Size: 10, Started: started,
+			Finished: started.Add(time.Second), Err: errors.New("no space left")},
+		{Database: "test", Table: "S", Segments: []uint64{1}, Score: 3, Size: 10, Started: started},
+		{Database: "test", Table: "R", Segments: []uint64{6}, Score: 2, Size: 10, Started: started},
+	}
+}
+
+func readAll(t *testing.T, e engine.Engine, name string, attrs ...string) *batch.Batch {
+	db, err := e.Database("INFORMATION_SCHEMA")
+	require.NoError(t, err)
+	rel, err := db.Relation(name)
+	require.NoError(t, err)
+	defer rel.Close()
+	rds := rel.NewReader(2)
+	bat, err := rds[0].Read(make([]uint64, len(attrs)), attrs)
+	require.NoError(t, err)
+	require.NotNil(t, bat)
+	require.Equal(t, int(rel.Rows()), len(bat.Zs))
+	empty, err := rds[1].Read(make([]uint64, len(attrs)), attrs)
+	require.NoError(t, err)
+	require.Nil(t, empty)
+	return bat
+}
+
+func TestInfoSchema(t *testing.T) {
+	e := New(memEngine.NewTestEngine(), engine.Node{Addr: "127.0.0.1"}, &testSession{tables: map[string]bool{"R": true}})
+	require.Equal(t, []string{Name, "test"}, e.Databases())
+
+	bat := readAll(t, e, Schemata, "SCHEMA_NAME", "SQL_PATH")
+	require.Equal(t, "test", string(bat.Vecs[0].Col.(*types.Bytes).Get(1)))
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 0))
+
+	// the tables of information_schema and R which can be seen
+	bat = readAll(t, e, Tables, "TABLE_NAME", "TABLE_TYPE", "TABLE_ROWS")
+	require.Equal(t, len(tables)+1, len(bat.Zs))
+	require.Equal(t, "R", string(bat.Vecs[0].Col.(*types.Bytes).Get(int64(len(tables)))))
+	require.Equal(t, baseTable, string(bat.Vecs[1].Col.(*types.Bytes).Get(int64(len(tables)))))
+	require.Equal(t, int64(20), bat.Vecs[2].Col.([]int64)[len(tables)])
+	require.True(t, nulls.Contains(bat.Vecs[2].Nsp, 0))
+
+	bat = readAll(t, e, Columns, "TABLE_NAME", "COLUMN_NAME", "DATA_TYPE", "COLUMN_TYPE")
+	n := len(bat.Zs)
+	require.Equal(t, "R", string(bat.Vecs[0].Col.(*types.Bytes).Get(int64(n-3))))
+	require.Equal(t, "orderId", string(bat.Vecs[1].Col.(*types.Bytes).Get(int64(n-3))))
+	require.Equal(t, "varchar(10)", string(bat.Vecs[3].Col.(*types.Bytes).Get(int64(n-3))))
+	require.Equal(t, "int", string(bat.Vecs[2].Col.(*types.Bytes).Get(int64(n-2))))
+	require.Equal(t, "int unsigned", string(bat.Vecs[3].Col.(*types.Bytes).Get(int64(n-2))))
+
+	bat = readAll(t, e, ProcessList, "ID", "DB", "INFO")
+	require.Equal(t, int64(1), bat.Vecs[0].Col.([]int64)[0])
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 0))
+	require.Equal(t, "select 1", string(bat.Vecs[2].Col.(*types.Bytes).Get(0)))
+
+	bat = readAll(t, e, StatementsSummary, "DIGEST_TEXT", "COUNT_STAR", "AVG_LATENCY", "PLAN_DIGEST")
+	require.Equal(t, "select ?", string(bat.Vecs[0].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, []int64{4}, bat.Vecs[1].Col.([]int64))
+	require.Equal(t, []int64{25}, bat.Vecs[2].Col.([]int64))
+	require.True(t, nulls.Contains(bat.Vecs[3].Nsp, 0))
+
+	db, err := e.Database(Name)
+	require.NoError(t, err)
+	require.Error(t, db.Create(0, "t", nil))
+	require.Error(t, e.Delete(0, Name))
+	_, err = db.Relation("t")
+	require.Error(t, err)
+}
+
+func TestInfoSchemaCompactions(t *testing.T) {
+	e := New(memEngine.NewTestEngine(), engine.Node{Addr: "127.0.0.1"}, &testSession{tables: map[string]bool{"R": true}})
+	db, err := e.Database(Name)
+	require.NoError(t, err)
+	rel, err := db.Relation(Compactions)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rel.Rows())
+	rel.Close()
+
+	// the merges of S can not be seen
+	e = New(&compactionEngine{memEngine.NewTestEngine()}, engine.Node{Addr: "127.0.0.1"}, &testSession{tables: map[string]bool{"R": true}})
+	bat := readAll(t, e, Compactions, "SEGMENTS", "TYPE", "STATE", "SCORE", "WRITTEN", "END_TIME", "ERROR")
+	require.Equal(t, 3, len(bat.Zs))
+	require.Equal(t, "3,4", string(bat.Vecs[0].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "MANUAL", string(bat.Vecs[1].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "AUTO", string(bat.Vecs[1].Col.(*types.Bytes).Get(1)))
+	require.Equal(t, compactionFinished, string(bat.Vecs[2].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, compactionFailed, string(bat.Vecs[2].Col.(*types.Bytes).Get(1)))
+	require.Equal(t, compactionRunning, string(bat.Vecs[2].Col.(*types.Bytes).Get(2)))
+	require.Equal(t, "1.50", string(bat.Vecs[3].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, int64(60), bat.Vecs[4].Col.([]int64)[0])
+	require.Equal(t, "2021-10-01 08:00:01", string(bat.Vecs[5].Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(bat.Vecs[5].Nsp, 2))
+	require.True(t, nulls.Contains(bat.Vecs[6].Nsp, 0))
+	require.Equal(t, "no space left", string(bat.Vecs[6].Col.(*types.Bytes).Get(1)))
+}
+
+func TestInfoSchemaViews(t *testing.T) {
+	me := memEngine.NewTestEngine()
+	db, err := me.Database("test")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "V", []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type{Oid: types.T_int64, Size: 8}}},
+		&engine.ViewDef{Sql: "select a from R"},
+	}))
+	e := New(me, engine.Node{Addr: "127.0.0.1"}, &testSession{tables: map[string]bool{"V": true}})
+
+	bat := readAll(t, e, Tables, "TABLE_NAME", "TABLE_TYPE", "ENGINE")
+	require.Equal(t, len(tables)+1, len(bat.Zs))
+	require.Equal(t, "V", string(bat.Vecs[0].Col.(*types.Bytes).Get(int64(len(tables)))))
+	require.Equal(t, view, string(bat.Vecs[1].Col.(*types.Bytes).Get(int64(len(tables)))))
+	require.True(t, nulls.Contains(bat.Vecs[2].Nsp, uint64(len(tables))))
+
+	bat = readAll(t, e, Views, "TABLE_NAME", "VIEW_DEFINITION", "IS_UPDATABLE")
+	require.Equal(t, "V", string(bat.Vecs[0].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "select a from R", string(bat.Vecs[1].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "NO", string(bat.Vecs[2].Col.(*types.Bytes).Get(0)))
+}
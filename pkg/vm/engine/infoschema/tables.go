@@ -0,0 +1,423 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	charset   = "utf8mb4"
+	collation = "utf8mb4_bin"
+	// engineName is the ENGINE of the base tables
+	engineName = "MatrixOne"
+	// timeLayout is the layout of the DATETIME columns
+	timeLayout = "2006-01-02 15:04:05"
+)
+
+var (
+	varcharType = types.Type{Oid: types.T_varchar, Size: 24}
+	int64Type   = types.Type{Oid: types.T_int64, Size: 8}
+)
+
+// tables are the tables of information_schema, the generators of the
+// rows refer to the tables, so they are set by init.
+var tables []*table
+
+func init() {
+	tables = []*table{
+		{
+			name: Schemata,
+			cols: []column{
+				{"CATALOG_NAME", varcharType},
+				{"SCHEMA_NAME", varcharType},
+				{"DEFAULT_CHARACTER_SET_NAME", varcharType},
+				{"DEFAULT_COLLATION_NAME", varcharType},
+				{"SQL_PATH", varcharType},
+			},
+			rows: schemataRows,
+		},
+		{
+			name: Tables,
+			cols: []column{
+				{"TABLE_CATALOG", varcharType},
+				{"TABLE_SCHEMA", varcharType},
+				{"TABLE_NAME", varcharType},
+				{"TABLE_TYPE", varcharType},
+				{"ENGINE", varcharType},
+				{"VERSION", int64Type},
+				{"TABLE_ROWS", int64Type},
+				{"AVG_ROW_LENGTH", int64Type},
+				{"DATA_LENGTH", int64Type},
+				{"TABLE_COLLATION", varcharType},
+				{"TABLE_COMMENT", varcharType},
+			},
+			rows: tablesRows,
+		},
+		{
+			name: Columns,
+			cols: []column{
+				{"TABLE_CATALOG", varcharType},
+				{"TABLE_SCHEMA", varcharType},
+				{"TABLE_NAME", varcharType},
+				{"COLUMN_NAME", varcharType},
+				{"ORDINAL_POSITION", int64Type},
+				{"COLUMN_DEFAULT", varcharType},
+				{"IS_NULLABLE", varcharType},
+				{"DATA_TYPE", varcharType},
+				{"CHARACTER_MAXIMUM_LENGTH", int64Type},
+				{"CHARACTER_SET_NAME", varcharType},
+				{"COLLATION_NAME", varcharType},
+				{"COLUMN_TYPE", varcharType},
+				{"COLUMN_KEY", varcharType},
+				{"EXTRA", varcharType},
+				{"COLUMN_COMMENT", varcharType},
+			},
+			rows: columnsRows,
+		},
+		{
+			name: Statistics,
+			cols: []column{
+				{"TABLE_CATALOG", varcharType},
+				{"TABLE_SCHEMA", varcharType},
+				{"TABLE_NAME", varcharType},
+				{"NON_UNIQUE", int64Type},
+				{"INDEX_SCHEMA", varcharType},
+				{"INDEX_NAME", varcharType},
+				{"SEQ_IN_INDEX", int64Type},
+				{"COLUMN_NAME", varcharType},
+				{"NULLABLE", varcharType},
+				{"INDEX_TYPE", varcharType},
+			},
+			rows: statisticsRows,
+		},
+		{
+			name: Views,
+			cols: []column{
+				{"TABLE_CATALOG", varcharType},
+				{"TABLE_SCHEMA", varcharType},
+				{"TABLE_NAME", varcharType},
+				{"VIEW_DEFINITION", varcharType},
+				{"CHECK_OPTION", varcharType},
+				{"IS_UPDATABLE", varcharType},
+				{"CHARACTER_SET_CLIENT", varcharType},
+				{"COLLATION_CONNECTION", varcharType},
+			},
+			rows: viewsRows,
+		},
+		{
+			name: ProcessList,
+			cols: []column{
+				{"ID", int64Type},
+				{"USER", varcharType},
+				{"HOST", varcharType},
+				{"DB", varcharType},
+				{"COMMAND", varcharType},
+				{"TIME", int64Type},
+				{"STATE", varcharType},
+				{"INFO", varcharType},
+			},
+			rows: processListRows,
+		},
+		{
+			name: StatementsSummary,
+			cols: []column{
+				{"SCHEMA_NAME", varcharType},
+				{"DIGEST", varcharType},
+				{"DIGEST_TEXT", varcharType},
+				{"COUNT_STAR", int64Type},
+				{"SUM_ERRORS", int64Type},
+				{"SUM_LATENCY", int64Type},
+				{"AVG_LATENCY", int64Type},
+				{"MAX_LATENCY", int64Type},
+				{"SUM_ROWS_EXAMINED", int64Type},
+				{"SUM_ROWS_SENT", int64Type},
+				{"MAX_MEMORY", int64Type},
+				{"PLAN_DIGEST", varcharType},
+				{"FIRST_SEEN", varcharType},
+				{"LAST_SEEN", varcharType},
+			},
+			rows: statementsSummaryRows,
+		},
+		{
+			name: Compactions,
+			cols: []column{
+				{"TABLE_SCHEMA", varcharType},
+				{"TABLE_NAME", varcharType},
+				{"SEGMENTS", varcharType},
+				{"TYPE", varcharType},
+				{"STATE", varcharType},
+				{"SCORE", varcharType},
+				{"SIZE", int64Type},
+				{"WRITTEN", int64Type},
+				{"START_TIME", varcharType},
+				{"END_TIME", varcharType},
+				{"ERROR", varcharType},
+			},
+			rows: compactionsRows,
+		},
+	}
+}
+
+// tableInfo is a table which is described by the virtual tables,
+// rel is nil for the tables of information_schema.
+type tableInfo struct {
+	db   string
+	name string
+	rel  engine.Relation
+	defs []engine.TableDef
+}
+
+// schemas returns the databases which can be seen, information_schema is the first one
+func (e *infoEngine) schemas() []string {
+	dbs := []string{Name}
+	for _, db := range e.Engine.Databases() {
+		if e.visible(db, "") {
+			dbs = append(dbs, db)
+		}
+	}
+	return dbs
+}
+
+// eachTable calls fn on every table which can be seen
+func (e *infoEngine) eachTable(fn func(tableInfo)) {
+	for _, tbl := range tables {
+		fn(tableInfo{db: Name, name: tbl.name, defs: (&relation{tbl: tbl}).TableDefs()})
+	}
+	for _, db := range e.schemas()[1:] {
+		d, err := e.Engine.Database(db)
+		if err != nil {
+			continue
+		}
+		for _, name := range d.Relations() {
+			if !e.visible(db, name) {
+				continue
+			}
+			rel, err := d.Relation(name)
+			if err != nil {
+				continue
+			}
+			fn(tableInfo{db: db, name: name, rel: rel, defs: rel.TableDefs()})
+			rel.Close()
+		}
+	}
+}
+
+func schemataRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	for _, db := range e.schemas() {
+		rows = append(rows, []interface{}{catalogName, db, charset, collation, nil})
+	}
+	return rows
+}
+
+func tablesRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	e.eachTable(func(t tableInfo) {
+		if t.rel == nil {
+			rows = append(rows, []interface{}{catalogName, t.db, t.name, systemView, nil, int64(10), nil, nil, nil, collation, ""})
+			return
+		}
+		if engine.GetViewDef(t.defs) != nil {
+			rows = append(rows, []interface{}{catalogName, t.db, t.name, view, nil, nil, nil, nil, nil, nil, "VIEW"})
+			return
+		}
+		var comment string
+		var size int64
+		for _, def := range t.defs {
+			switch d := def.(type) {
+			case *engine.AttributeDef:
+				size += t.rel.Size(d.Attr.Name)
+			case *engine.CommentDef:
+				comment = d.Comment
+			}
+		}
+		cnt, avg := t.rel.Rows(), int64(0)
+		if cnt > 0 {
+			avg = size / cnt
+		}
+		rows = append(rows, []interface{}{catalogName, t.db, t.name, baseTable, engineName, int64(10), cnt, avg, size, collation, comment})
+	})
+	return rows
+}
+
+func columnsRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	e.eachTable(func(t tableInfo) {
+		pks, keys := tableKeys(t.defs)
+		pos := int64(0)
+		for _, def := range t.defs {
+			d, ok := def.(*engine.AttributeDef)
+			if !ok {
+				continue
+			}
+			pos++
+			attr := d.Attr
+			var dft, maxLen, cs, coll interface{}
+			if v, isNull := attr.GetDefaultExpr(); attr.HasDefaultExpr() && !isNull {
+				dft = fmt.Sprintf("%v", v)
+			}
+			if attr.Type.Oid == types.T_char || attr.Type.Oid == types.T_varchar {
+				maxLen, cs, coll = int64(attr.Type.Width), charset, collation
+			}
+			nullable, key := "YES", ""
+			switch {
+			case pks[attr.Name]:
+				nullable, key = "NO", "PRI"
+			case keys[attr.Name]:
+				key = "MUL"
+			}
+			rows = append(rows, []interface{}{catalogName, t.db, t.name, attr.Name, pos, dft, nullable,
+				dataType(attr.Type), maxLen, cs, coll, columnType(attr.Type), key, "", ""})
+		}
+	})
+	return rows
+}
+
+func statisticsRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	e.eachTable(func(t tableInfo) {
+		for _, def := range t.defs {
+			switch d := def.(type) {
+			case *engine.PrimaryIndexDef:
+				for i, name := range d.Names {
+					rows = append(rows, []interface{}{catalogName, t.db, t.name, int64(0), t.db, "PRIMARY", int64(i + 1), name, "", "BTREE"})
+				}
+			case *engine.IndexTableDef:
+				for i, name := range d.ColNames {
+					rows = append(rows, []interface{}{catalogName, t.db, t.name, int64(1), t.db, d.Name, int64(i + 1), name, "YES", d.Typ.ToString()})
+				}
+			}
+		}
+	})
+	return rows
+}
+
+func viewsRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	e.eachTable(func(t tableInfo) {
+		if v := engine.GetViewDef(t.defs); v != nil {
+			rows = append(rows, []interface{}{catalogName, t.db, t.name, v.Sql, "NONE", "NO", charset, collation})
+		}
+	})
+	return rows
+}
+
+func processListRows(e *infoEngine) [][]interface{} {
+	if e.sess == nil {
+		return nil
+	}
+	var rows [][]interface{}
+	for _, p := range e.sess.Processes() {
+		rows = append(rows, []interface{}{int64(p.Id), p.User, p.Host, nullString(p.Db), p.Command, p.Time, nullString(p.State), nullString(p.Info)})
+	}
+	return rows
+}
+
+func statementsSummaryRows(e *infoEngine) [][]interface{} {
+	if e.sess == nil {
+		return nil
+	}
+	var rows [][]interface{}
+	for _, d := range e.sess.Digests() {
+		var avg int64
+		if d.Count > 0 {
+			avg = d.SumLatency / d.Count
+		}
+		rows = append(rows, []interface{}{nullString(d.Schema), nullString(d.Digest), nullString(d.DigestText), d.Count, d.Errors,
+			d.SumLatency, avg, d.MaxLatency, d.RowsExamined, d.RowsSent, d.MaxMemory, nullString(d.PlanDigest),
+			d.FirstSeen.Format(timeLayout), d.LastSeen.Format(timeLayout)})
+	}
+	return rows
+}
+
+func compactionsRows(e *infoEngine) [][]interface{} {
+	lister, ok := e.Engine.(engine.CompactionLister)
+	if !ok {
+		return nil
+	}
+	var rows [][]interface{}
+	for _, c := range lister.Compactions() {
+		if !e.visible(c.Database, c.Table) {
+			continue
+		}
+		segments := make([]string, len(c.Segments))
+		for i, id := range c.Segments {
+			segments[i] = strconv.FormatUint(id, 10)
+		}
+		typ := "AUTO"
+		if c.Manual {
+			typ = "MANUAL"
+		}
+		state, end, msg := compactionRunning, interface{}(nil), interface{}(nil)
+		if !c.Finished.IsZero() {
+			state, end = compactionFinished, c.Finished.Format(timeLayout)
+		}
+		if c.Err != nil {
+			state, msg = compactionFailed, c.Err.Error()
+		}
+		rows = append(rows, []interface{}{c.Database, c.Table, strings.Join(segments, ","), typ, state,
+			fmt.Sprintf("%.2f", c.Score), c.Size, c.Written, c.Started.Format(timeLayout), end, msg})
+	}
+	return rows
+}
+
+// tableKeys returns the columns of the primary key and the columns of the other indexes
+func tableKeys(defs []engine.TableDef) (map[string]bool, map[string]bool) {
+	pks, keys := make(map[string]bool), make(map[string]bool)
+	for _, def := range defs {
+		switch d := def.(type) {
+		case *engine.PrimaryIndexDef:
+			for _, name := range d.Names {
+				pks[name] = true
+			}
+		case *engine.IndexTableDef:
+			for _, name := range d.ColNames {
+				keys[name] = true
+			}
+		}
+	}
+	return pks, keys
+}
+
+// dataType returns the type name without the width and the attributes, e.g. int for INT UNSIGNED
+func dataType(typ types.Type) string {
+	return strings.ToLower(strings.Fields(typ.String())[0])
+}
+
+// columnType returns the type as it is shown by SHOW COLUMNS
+func columnType(typ types.Type) string {
+	if typ.Width > 0 {
+		return fmt.Sprintf("%s(%v)", strings.ToLower(typ.String()), typ.Width)
+	}
+	return strings.ToLower(typ.String())
+}
+
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
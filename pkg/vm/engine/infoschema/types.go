@@ -0,0 +1,130 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Name is the name of the virtual database
+const Name = "information_schema"
+
+const (
+	Schemata    = "SCHEMATA"
+	Tables      = "TABLES"
+	Columns     = "COLUMNS"
+	Statistics  = "STATISTICS"
+	ProcessList = "PROCESSLIST"
+	Views       = "VIEWS"
+	// StatementsSummary is the summary of the statements by digest
+	StatementsSummary = "STATEMENTS_SUMMARY"
+	// Compactions is the running merges of the local storage and the latest finished ones
+	Compactions = "COMPACTIONS"
+)
+
+const (
+	// catalogName is the TABLE_CATALOG of every table
+	catalogName = "def"
+
+	baseTable  = "BASE TABLE"
+	view       = "VIEW"
+	systemView = "SYSTEM VIEW"
+)
+
+const (
+	compactionRunning  = "RUNNING"
+	compactionFinished = "FINISHED"
+	compactionFailed   = "FAILED"
+)
+
+// Session is the session which queries the virtual database.
+type Session interface {
+	// Visible returns true if the user of the session can see the table tbl
+	// of the database db, tbl is empty for the database.
+	Visible(db, tbl string) bool
+	// Processes returns the connections which the user of the session can see.
+	Processes() []Process
+	// Digests returns the statement digests which the user of the session can see.
+	Digests() []Digest
+}
+
+// Process is a row of PROCESSLIST
+type Process struct {
+	Id      uint64
+	User    string
+	Host    string
+	Db      string
+	Command string
+	Time    int64
+	State   string
+	Info    string
+}
+
+// Digest is a row of STATEMENTS_SUMMARY, the latencies are in microseconds.
+// Digest is empty for the statements counted after the summary is full.
+type Digest struct {
+	Schema       string
+	Digest       string
+	DigestText   string
+	Count        int64
+	Errors       int64
+	SumLatency   int64
+	MaxLatency   int64
+	RowsExamined int64
+	RowsSent     int64
+	MaxMemory    int64
+	PlanDigest   string
+	FirstSeen    time.Time
+	LastSeen     time.Time
+}
+
+// column is a column of a virtual table
+type column struct {
+	name string
+	typ  types.Type
+}
+
+// table is a virtual table whose rows are generated when it is read,
+// a value of a row is a string, an int64 or nil for null.
+type table struct {
+	name string
+	cols []column
+	rows func(*infoEngine) [][]interface{}
+}
+
+// infoEngine adds the virtual database to an engine
+type infoEngine struct {
+	engine.Engine
+	n    engine.Node
+	sess Session
+}
+
+type database struct {
+	e *infoEngine
+}
+
+type relation struct {
+	tbl  *table
+	rows [][]interface{}
+	n    engine.Node
+}
+
+type reader struct {
+	r    *relation
+	done bool
+}
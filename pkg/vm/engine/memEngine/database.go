@@ -2,6 +2,8 @@ package memEngine
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/meta"
@@ -26,8 +28,14 @@ func (d *database) Relation(name string) (engine.Relation, error) {
 	return &relation{id: name, db: d.db, n: d.n, md: md}, nil
 }
 
-func (d *database) Delete(_ uint64, _ string) error {
-	return nil
+func (d *database) Delete(_ uint64, name string) error {
+	keys, _ := d.db.Range()
+	for _, k := range keys {
+		if strings.HasPrefix(k, name+".") {
+			d.db.Del(k)
+		}
+	}
+	return d.db.Del(name)
 }
 
 func (d *database) Create(_ uint64, name string, defs []engine.TableDef) error {
@@ -37,8 +45,11 @@ func (d *database) Create(_ uint64, name string, defs []engine.TableDef) error {
 		switch d := def.(type) {
 		case *engine.AttributeDef:
 			md.Attrs = append(md.Attrs, d.Attr)
+			md.Cols = append(md.Cols, meta.Column{Key: d.Attr.Name})
 		case *engine.IndexTableDef:
 			md.Index = append(md.Index, engine.IndexTableDef{Typ: d.Typ, ColNames: d.ColNames, Name: d.Name})
+		case *engine.ViewDef:
+			md.View, md.Definer = d.Sql, d.Definer
 		}
 	}
 	data, err := encoding.Encode(md)
@@ -47,3 +58,28 @@ func (d *database) Create(_ uint64, name string, defs []engine.TableDef) error {
 	}
 	return d.db.Set(name, data)
 }
+
+func (d *database) Rename(_ uint64, name, newName string) error {
+	var buf bytes.Buffer
+
+	if _, err := d.db.Get(newName, &buf); err == nil {
+		return fmt.Errorf("table '%s' already exists", newName)
+	}
+	data, err := d.db.Get(name, &buf)
+	if err != nil {
+		return err
+	}
+	if err := d.db.Set(newName, data); err != nil {
+		return err
+	}
+	keys, datas := d.db.Range()
+	for i, k := range keys {
+		if strings.HasPrefix(k, name+".") {
+			if err := d.db.Set(newName+k[len(name):], datas[i]); err != nil {
+				return err
+			}
+			d.db.Del(k)
+		}
+	}
+	return d.db.Del(name)
+}
@@ -7,5 +7,20 @@ type Metadata struct {
 	Rows  int64
 	Name  string
 	Attrs []engine.Attribute
+	Cols  []Column // storage of the attributes
 	Index []engine.IndexTableDef
+	Stats *engine.StatisticsDef
+	// Version numbers the keys of the added columns, so that a new column
+	// never reads the segments of a dropped or renamed one.
+	Version int64
+	View    string // query of the view, empty if it is a table
+	Definer string // user who created the view
+}
+
+// Column is the storage of an attribute, the segments written before the
+// column was added are read as Fill.
+type Column struct {
+	Key   string // key of the column in a segment
+	Since int64  // first segment which has the column
+	Fill  engine.DefaultExpr
 }
@@ -36,12 +36,28 @@ func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
 		}
 	}
 	bat := batch.New(true, attrs)
-	id := r.segs[0]
+	seg := r.segs[0]
+	id := sKey(int(seg), r.id)
 	r.segs = r.segs[1:]
 	for i, attr := range attrs {
-		md := r.attrs[attr]
+		c := r.attrs[attr]
+		md, key := c.attr, c.col.Key
+		if seg < c.col.Since {
+			data, err := r.db.Get(id+rowsKey, r.dds[i])
+			if err != nil {
+				return nil, err
+			}
+			fill := md
+			fill.Default = c.col.Fill
+			if bat.Vecs[i], err = engine.DefaultVector(fill, int(encoding.DecodeInt64(data))); err != nil {
+				return nil, err
+			}
+			bat.Vecs[i].Or = true
+			bat.Vecs[i].Ref = cs[i]
+			continue
+		}
 		if md.Alg == compress.None {
-			data, err := r.db.Get(id+"."+attr, r.dds[i])
+			data, err := r.db.Get(id+"."+key, r.dds[i])
 			if err != nil {
 				return nil, err
 			}
@@ -52,7 +68,7 @@ func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
 			bat.Vecs[i].Or = true
 			bat.Vecs[i].Ref = cs[i]
 		} else {
-			data, err := r.db.Get(id+"."+attr, r.cds[i])
+			data, err := r.db.Get(id+"."+key, r.cds[i])
 			if err != nil {
 				return nil, err
 			}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/meta"
 
 	"github.com/pierrec/lz4"
 )
@@ -42,18 +44,24 @@ func (r *relation) TableDefs() []engine.TableDef {
 		defs[j] = &index
 		j++
 	}
+	if r.md.Stats != nil {
+		defs = append(defs, r.md.Stats)
+	}
+	if len(r.md.View) > 0 {
+		defs = append(defs, &engine.ViewDef{Sql: r.md.View, Definer: r.md.Definer})
+	}
 	return defs
 }
 
 func (r *relation) NewReader(n int) []engine.Reader {
-	segs := make([]string, r.md.Segs)
+	segs := make([]int64, r.md.Segs)
 	for i := range segs {
-		segs[i] = sKey(i, r.id)
+		segs[i] = int64(i)
 	}
-	attrs := make(map[string]engine.Attribute)
+	attrs := make(map[string]column)
 	{
 		for i, attr := range r.md.Attrs {
-			attrs[attr.Name] = r.md.Attrs[i]
+			attrs[attr.Name] = column{attr: r.md.Attrs[i], col: r.md.Cols[i]}
 		}
 	}
 	rs := make([]engine.Reader, n)
@@ -62,12 +70,14 @@ func (r *relation) NewReader(n int) []engine.Reader {
 		for i := 0; i < n; i++ {
 			if i == n-1 {
 				rs[i] = &reader{
+					id:    r.id,
 					db:    r.db,
 					attrs: attrs,
 					segs:  segs[i*step:],
 				}
 			} else {
 				rs[i] = &reader{
+					id:    r.id,
 					db:    r.db,
 					attrs: attrs,
 					segs:  segs[i*step : (i+1)*step],
@@ -77,6 +87,7 @@ func (r *relation) NewReader(n int) []engine.Reader {
 	} else {
 		for i := range segs {
 			rs[i] = &reader{
+				id:    r.id,
 				db:    r.db,
 				attrs: attrs,
 				segs:  segs[i : i+1],
@@ -90,13 +101,20 @@ func (r *relation) NewReader(n int) []engine.Reader {
 }
 
 func (r *relation) Write(_ uint64, bat *batch.Batch) error {
+	if len(r.md.View) > 0 {
+		return fmt.Errorf("'%s' is a view", r.id)
+	}
 	key := sKey(int(r.md.Segs), r.id)
 	for i, attr := range bat.Attrs {
+		j := r.attribute(attr)
+		if j < 0 {
+			return fmt.Errorf("unknown column '%s'", attr)
+		}
 		v, err := bat.Vecs[i].Show()
 		if err != nil {
 			return err
 		}
-		if r.md.Attrs[i].Alg == compress.Lz4 {
+		if r.md.Attrs[j].Alg == compress.Lz4 {
 			data := make([]byte, lz4.CompressBlockBound(len(v)))
 			if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
 				return err
@@ -104,17 +122,17 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 			data = append(data, encoding.EncodeInt32(int32(len(v)))...)
 			v = data
 		}
-		if err := r.db.Set(key+"."+attr, v); err != nil {
+		if err := r.db.Set(key+"."+r.md.Cols[j].Key, v); err != nil {
 			return err
 		}
 	}
+	if err := r.db.Set(key+rowsKey, encoding.EncodeInt64(int64(vector.Length(bat.Vecs[0])))); err != nil {
+		return err
+	}
 	{
 		r.md.Segs++
-		data, err := encoding.Encode(r.md)
-		if err != nil {
-			return err
-		}
-		if err := r.db.Set(r.id, data); err != nil {
+		r.md.Rows += int64(vector.Length(bat.Vecs[0]))
+		if err := r.save(); err != nil {
 			return err
 		}
 	}
@@ -129,14 +147,84 @@ func (r *relation) DropIndex(epoch uint64, name string) error{
 	return nil
 }
 
-func (r *relation) AddTableDef(_ uint64, _ engine.TableDef) error {
-	return nil
+func (r *relation) AddTableDef(_ uint64, def engine.TableDef) error {
+	switch d := def.(type) {
+	case *engine.StatisticsDef:
+		r.md.Stats = d
+	case *engine.AttributeDef:
+		if r.attribute(d.Attr.Name) >= 0 {
+			return fmt.Errorf("Duplicate column name '%s'", d.Attr.Name)
+		}
+		r.md.Attrs = append(r.md.Attrs, d.Attr)
+		r.md.Cols = append(r.md.Cols, meta.Column{
+			Key:   fmt.Sprintf("%s#%v", d.Attr.Name, r.md.Version),
+			Since: r.md.Segs,
+			Fill:  d.Attr.Default,
+		})
+		r.md.Version++
+	case *engine.RenameAttributeDef:
+		i := r.attribute(d.Name)
+		if i < 0 {
+			return fmt.Errorf("unknown column '%s'", d.Name)
+		}
+		if r.attribute(d.NewName) >= 0 {
+			return fmt.Errorf("Duplicate column name '%s'", d.NewName)
+		}
+		r.md.Attrs[i].Name = d.NewName
+		for j := range r.md.Index {
+			for k, name := range r.md.Index[j].ColNames {
+				if name == d.Name {
+					r.md.Index[j].ColNames[k] = d.NewName
+				}
+			}
+		}
+	case *engine.DefaultDef:
+		i := r.attribute(d.Name)
+		if i < 0 {
+			return fmt.Errorf("unknown column '%s'", d.Name)
+		}
+		r.md.Attrs[i].Default = d.Default
+	default:
+		return nil
+	}
+	return r.save()
 }
 
-func (r *relation) DelTableDef(_ uint64, _ engine.TableDef) error {
+func (r *relation) DelTableDef(_ uint64, def engine.TableDef) error {
+	if d, ok := def.(*engine.AttributeDef); ok {
+		i := r.attribute(d.Attr.Name)
+		if i < 0 {
+			return fmt.Errorf("unknown column '%s'", d.Attr.Name)
+		}
+		r.md.Attrs = append(r.md.Attrs[:i], r.md.Attrs[i+1:]...)
+		r.md.Cols = append(r.md.Cols[:i], r.md.Cols[i+1:]...)
+		return r.save()
+	}
 	return nil
 }
 
+func (r *relation) attribute(name string) int {
+	for i, attr := range r.md.Attrs {
+		if attr.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *relation) save() error {
+	data, err := encoding.Encode(r.md)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(r.id, data)
+}
+
 func sKey(num int, id string) string {
 	return fmt.Sprintf("%v.%v", id, num)
 }
+
+// rowsKey is the key of the number of rows of a segment, the segments
+// have no column to count the rows when all the columns read are added
+// after them.
+const rowsKey = "#rows"
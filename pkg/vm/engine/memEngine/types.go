@@ -26,10 +26,16 @@ type relation struct {
 }
 
 type reader struct {
+	id    string
 	zs    []int64
 	db    *kv.KV
-	segs  []string
+	segs  []int64
 	cds   []*bytes.Buffer
 	dds   []*bytes.Buffer
-	attrs map[string]engine.Attribute
+	attrs map[string]column
+}
+
+type column struct {
+	attr engine.Attribute
+	col  meta.Column
 }
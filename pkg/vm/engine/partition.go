@@ -0,0 +1,537 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+)
+
+// A partitioned relation is split by the value of the column Fields[0], the
+// bounds of the partitions are value extends holding a vector of one row.
+// The i-th RANGE partition holds the values less than To and not less than
+// the To of the (i-1)-th one, it has no To if it is VALUES LESS THAN MAXVALUE.
+// NULL is less than any value, so it is held by the first RANGE partition.
+// A LIST partition holds the values of its Extends.
+
+// Len returns the number of partitions.
+func (def *PartitionByDef) Len() int {
+	return len(def.List) + len(def.Range)
+}
+
+// Names returns the names of the partitions in order.
+func (def *PartitionByDef) Names() []string {
+	names := make([]string, 0, def.Len())
+	for _, p := range def.List {
+		names = append(names, p.Name)
+	}
+	for _, p := range def.Range {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// Check checks that the names of the partitions are unique, the To of the
+// RANGE partitions are strictly increasing with MAXVALUE only in the last one,
+// and no value is held by two LIST partitions.
+func (def *PartitionByDef) Check() error {
+	if len(def.Fields) != 1 {
+		return fmt.Errorf("partition by %d columns is not supported", len(def.Fields))
+	}
+	if len(def.List) > 0 && len(def.Range) > 0 {
+		return fmt.Errorf("table can't have both RANGE and LIST partitions")
+	}
+	names := make(map[string]struct{}, def.Len())
+	for _, name := range def.Names() {
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("duplicate partition name %s", name)
+		}
+		names[name] = struct{}{}
+	}
+	var vs []interface{}
+	for _, p := range def.List {
+		for _, e := range p.Extends {
+			x := extendValue(e)
+			for _, v := range vs {
+				if v == nil || x == nil {
+					if v == nil && x == nil {
+						return fmt.Errorf("multiple definition of same constant in list partitioning")
+					}
+					continue
+				}
+				if r, ok := compareValue(v, x); ok && r == 0 {
+					return fmt.Errorf("multiple definition of same constant in list partitioning")
+				}
+			}
+			vs = append(vs, x)
+		}
+	}
+	for i := 1; i < len(def.Range); i++ {
+		lo, hi := def.upper(i-1), def.upper(i)
+		if lo == nil {
+			return fmt.Errorf("MAXVALUE can only be used in last partition definition")
+		}
+		if hi == nil {
+			continue
+		}
+		if r, ok := compareValue(lo, hi); !ok || r >= 0 {
+			return fmt.Errorf("VALUES LESS THAN value must be strictly increasing for each partition")
+		}
+	}
+	return nil
+}
+
+// Locate returns the partition holding the value v, nil is NULL.
+func (def *PartitionByDef) Locate(v interface{}) (int, bool) {
+	for i, p := range def.List {
+		for _, e := range p.Extends {
+			x := extendValue(e)
+			if v == nil || x == nil {
+				if v == nil && x == nil && isValueExtend(e) {
+					return i, true
+				}
+				continue
+			}
+			if r, ok := compareValue(v, x); ok && r == 0 {
+				return i, true
+			}
+		}
+	}
+	if len(def.Range) > 0 && v == nil {
+		return 0, true
+	}
+	for i := range def.Range {
+		hi := def.upper(i)
+		if hi == nil {
+			return i, true
+		}
+		if r, ok := compareValue(v, hi); ok && r < 0 {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Split splits the rows of the batch by the partitions holding them, the i-th
+// batch returned holds the rows of the i-th partition and it is nil if there
+// are none. The batch itself is returned if all the rows are in a partition.
+func (def *PartitionByDef) Split(bat *batch.Batch) ([]*batch.Batch, error) {
+	vec := batch.GetVector(bat, def.Fields[0])
+	if vec == nil {
+		return nil, fmt.Errorf("partition column '%s' not found", def.Fields[0])
+	}
+	n := vector.Length(vec)
+	sels := make([][]int64, def.Len())
+	for row := 0; row < n; row++ {
+		v := vectorValue(vec, row)
+		i, ok := def.Locate(v)
+		if !ok {
+			return nil, fmt.Errorf("table has no partition for value %s", formatValue(v))
+		}
+		sels[i] = append(sels[i], int64(row))
+	}
+	bats := make([]*batch.Batch, def.Len())
+	for i, sel := range sels {
+		if len(sel) == n {
+			bats[i] = bat
+			return bats, nil
+		}
+	}
+	for i, sel := range sels {
+		if len(sel) == 0 {
+			continue
+		}
+		pbat := batch.New(true, bat.Attrs)
+		for j, vec := range bat.Vecs {
+			pvec, err := selectVector(vec, sel)
+			if err != nil {
+				return nil, err
+			}
+			pbat.Vecs[j] = pvec
+		}
+		bats[i] = pbat
+	}
+	return bats, nil
+}
+
+// Prune returns which partitions may hold the rows matching the predicate,
+// the i-th one is false only if no row of the i-th partition can match it.
+func (def *PartitionByDef) Prune(p *Predicate) []bool {
+	mask := make([]bool, def.Len())
+	switch {
+	case p != nil && p.Op == PredAnd:
+		for i := range mask {
+			mask[i] = true
+		}
+		for _, arg := range p.Args {
+			for i, ok := range def.Prune(arg) {
+				mask[i] = mask[i] && ok
+			}
+		}
+	case p != nil && p.Op == PredOr:
+		for _, arg := range p.Args {
+			for i, ok := range def.Prune(arg) {
+				mask[i] = mask[i] || ok
+			}
+		}
+	case p == nil || p.Attr != def.Fields[0]:
+		for i := range mask {
+			mask[i] = true
+		}
+	default:
+		for i, e := range def.List {
+			mask[i] = listMatch(e, p)
+		}
+		for i := range def.Range {
+			mask[len(def.List)+i] = rangeMatch(def.lower(i), def.upper(i), p)
+		}
+	}
+	return mask
+}
+
+// lower returns the least value of the i-th RANGE partition, it is nil if
+// the partition holds the values less than its To.
+func (def *PartitionByDef) lower(i int) interface{} {
+	if i == 0 {
+		return nil
+	}
+	return def.upper(i - 1)
+}
+
+// upper returns the To of the i-th RANGE partition, it is nil for MAXVALUE.
+func (def *PartitionByDef) upper(i int) interface{} {
+	if len(def.Range[i].To) == 0 {
+		return nil
+	}
+	return extendValue(def.Range[i].To[0])
+}
+
+// rangeMatch checks if a value in [lo, hi) may match the predicate, a nil
+// bound is unbounded.
+func rangeMatch(lo, hi interface{}, p *Predicate) bool {
+	geLo := func(v interface{}) bool { // v >= lo
+		r, ok := compareValue(v, lo)
+		return lo == nil || !ok || r >= 0
+	}
+	ltHi := func(v interface{}) bool { // v < hi
+		r, ok := compareValue(v, hi)
+		return hi == nil || !ok || r < 0
+	}
+	switch p.Op {
+	case PredEq:
+		return geLo(p.Vals[0]) && ltHi(p.Vals[0])
+	case PredIn:
+		for _, v := range p.Vals {
+			if geLo(v) && ltHi(v) {
+				return true
+			}
+		}
+		return false
+	case PredLt:
+		r, ok := compareValue(p.Vals[0], lo)
+		return lo == nil || !ok || r > 0
+	case PredLe:
+		return geLo(p.Vals[0])
+	case PredGt, PredGe:
+		return ltHi(p.Vals[0])
+	case PredBtw:
+		return ltHi(p.Vals[0]) && geLo(p.Vals[1])
+	}
+	return true
+}
+
+// listMatch checks if a value of the LIST partition may match the predicate.
+func listMatch(def ListPartition, p *Predicate) bool {
+	for _, e := range def.Extends {
+		x := extendValue(e)
+		if x == nil {
+			if !isValueExtend(e) {
+				return true
+			}
+			continue
+		}
+		if valueMatch(x, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func valueMatch(x interface{}, p *Predicate) bool {
+	switch p.Op {
+	case PredEq:
+		return compareMatch(x, p.Vals[0], func(r int) bool { return r == 0 })
+	case PredIn:
+		for _, v := range p.Vals {
+			if compareMatch(x, v, func(r int) bool { return r == 0 }) {
+				return true
+			}
+		}
+		return false
+	case PredLt:
+		return compareMatch(x, p.Vals[0], func(r int) bool { return r < 0 })
+	case PredLe:
+		return compareMatch(x, p.Vals[0], func(r int) bool { return r <= 0 })
+	case PredGt:
+		return compareMatch(x, p.Vals[0], func(r int) bool { return r > 0 })
+	case PredGe:
+		return compareMatch(x, p.Vals[0], func(r int) bool { return r >= 0 })
+	case PredBtw:
+		return compareMatch(x, p.Vals[0], func(r int) bool { return r >= 0 }) &&
+			compareMatch(x, p.Vals[1], func(r int) bool { return r <= 0 })
+	}
+	return true
+}
+
+// compareMatch checks the result of comparing x with v, it is true if they
+// are not comparable as the predicate cannot be used then.
+func compareMatch(x, v interface{}, f func(int) bool) bool {
+	r, ok := compareValue(x, v)
+	return !ok || f(r)
+}
+
+func isValueExtend(e extend.Extend) bool {
+	_, ok := e.(*extend.ValueExtend)
+	return ok
+}
+
+// extendValue returns the value of a value extend, it is nil for NULL and for
+// the other extends.
+func extendValue(e extend.Extend) interface{} {
+	if v, ok := e.(*extend.ValueExtend); ok && vector.Length(v.V) > 0 {
+		return vectorValue(v.V, 0)
+	}
+	return nil
+}
+
+// vectorValue returns the value of a row of the vector, it is nil for NULL.
+func vectorValue(v *vector.Vector, row int) interface{} {
+	if nulls.Contains(v.Nsp, uint64(row)) {
+		return nil
+	}
+	switch col := v.Col.(type) {
+	case []int8:
+		return col[row]
+	case []int16:
+		return col[row]
+	case []int32:
+		return col[row]
+	case []int64:
+		return col[row]
+	case []uint8:
+		return col[row]
+	case []uint16:
+		return col[row]
+	case []uint32:
+		return col[row]
+	case []uint64:
+		return col[row]
+	case []float32:
+		return col[row]
+	case []float64:
+		return col[row]
+	case []types.Date:
+		return col[row]
+	case []types.Datetime:
+		return col[row]
+	case *types.Bytes:
+		return col.Get(int64(row))
+	}
+	return nil
+}
+
+// selectVector returns a new vector holding the rows sels of the vector.
+func selectVector(v *vector.Vector, sels []int64) (*vector.Vector, error) {
+	w := vector.New(v.Typ)
+	var vs interface{}
+	switch col := v.Col.(type) {
+	case []int8:
+		ws := make([]int8, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []int16:
+		ws := make([]int16, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []int32:
+		ws := make([]int32, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []int64:
+		ws := make([]int64, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []uint8:
+		ws := make([]uint8, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []uint16:
+		ws := make([]uint16, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []uint32:
+		ws := make([]uint32, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []uint64:
+		ws := make([]uint64, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []float32:
+		ws := make([]float32, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []float64:
+		ws := make([]float64, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []types.Date:
+		ws := make([]types.Date, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case []types.Datetime:
+		ws := make([]types.Datetime, len(sels))
+		for i, sel := range sels {
+			ws[i] = col[sel]
+		}
+		vs = ws
+	case *types.Bytes:
+		ws := make([][]byte, len(sels))
+		for i, sel := range sels {
+			ws[i] = col.Get(sel)
+		}
+		vs = ws
+	default:
+		return nil, fmt.Errorf("unsupport type %s", v.Typ)
+	}
+	if err := vector.Append(w, vs); err != nil {
+		return nil, err
+	}
+	for i, sel := range sels {
+		if nulls.Contains(v.Nsp, uint64(sel)) {
+			nulls.Add(w.Nsp, uint64(i))
+		}
+	}
+	return w, nil
+}
+
+// compareValue compares two values of a column, it is false if they are not
+// comparable.
+func compareValue(a, b interface{}) (int, bool) {
+	switch x := a.(type) {
+	case []byte:
+		y, ok := b.([]byte)
+		if !ok {
+			return 0, false
+		}
+		return bytes.Compare(x, y), true
+	case float32, float64:
+		fx, _ := toFloat64(x)
+		fy, ok := toFloat64(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fx < fy:
+			return -1, true
+		case fx > fy:
+			return 1, true
+		}
+		return 0, true
+	case uint8, uint16, uint32, uint64:
+		ux, _ := toUint64(x)
+		uy, ok := toUint64(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case ux < uy:
+			return -1, true
+		case ux > uy:
+			return 1, true
+		}
+		return 0, true
+	}
+	if _, ok := b.([]byte); ok {
+		return 0, false
+	}
+	ix, ok := toInt64(a)
+	if !ok {
+		return 0, false
+	}
+	iy, ok := toInt64(b)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case ix < iy:
+		return -1, true
+	case ix > iy:
+		return 1, true
+	}
+	return 0, true
+}
+
+func toUint64(v interface{}) (uint64, bool) {
+	switch v := v.(type) {
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	}
+	return 0, false
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		return string(v)
+	}
+	return fmt.Sprintf("%v", v)
+}
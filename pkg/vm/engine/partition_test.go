@@ -0,0 +1,163 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	dateType  = types.Type{Oid: types.T_date, Size: 4}
+	int32Type = types.Type{Oid: types.T_int32, Size: 4, Width: 4}
+)
+
+func valueExtend(t *testing.T, typ types.Type, v interface{}) extend.Extend {
+	vec, err := DefaultVector(Attribute{Type: typ, Default: MakeDefaultExpr(true, v, false)}, 1)
+	require.NoError(t, err)
+	return &extend.ValueExtend{V: vec}
+}
+
+// monthPartitions returns a RANGE partition for each month of 2021 and one of
+// the later dates.
+func monthPartitions(t *testing.T) *PartitionByDef {
+	def := &PartitionByDef{Fields: []string{"d"}}
+	var from []extend.Extend
+	for m := 1; m <= 12; m++ {
+		to := []extend.Extend{valueExtend(t, dateType, types.FromCalendar(2021, uint8(m%12+1), 1))}
+		if m == 12 {
+			to = []extend.Extend{valueExtend(t, dateType, types.FromCalendar(2022, 1, 1))}
+		}
+		def.Range = append(def.Range, RangePartition{Name: fmt.Sprintf("p%d", m), From: from, To: to})
+		from = to
+	}
+	def.Range = append(def.Range, RangePartition{Name: "pmax", From: from})
+	return def
+}
+
+func listPartitions(t *testing.T) *PartitionByDef {
+	return &PartitionByDef{
+		Fields: []string{"a"},
+		List: []ListPartition{
+			{Name: "odd", Extends: []extend.Extend{valueExtend(t, int32Type, int32(1)), valueExtend(t, int32Type, int32(3))}},
+			{Name: "even", Extends: []extend.Extend{valueExtend(t, int32Type, int32(2)), valueExtend(t, int32Type, int32(4))}},
+		},
+	}
+}
+
+func TestPartitionPrune(t *testing.T) {
+	def := monthPartitions(t)
+	require.Equal(t, 13, def.Len())
+	mar, apr := types.FromCalendar(2021, 3, 1), types.FromCalendar(2021, 4, 1)
+	mask := def.Prune(&Predicate{
+		Op: PredAnd,
+		Args: []*Predicate{
+			{Op: PredGe, Attr: "d", Vals: []interface{}{mar}},
+			{Op: PredLt, Attr: "d", Vals: []interface{}{apr}},
+		},
+	})
+	for i, ok := range mask {
+		require.Equal(t, i == 2, ok, "partition %s", def.Range[i].Name)
+	}
+	mask = def.Prune(&Predicate{Op: PredBtw, Attr: "d", Vals: []interface{}{mar, apr}})
+	for i, ok := range mask {
+		require.Equal(t, i == 2 || i == 3, ok, "partition %s", def.Range[i].Name)
+	}
+	mask = def.Prune(&Predicate{Op: PredGe, Attr: "d", Vals: []interface{}{types.FromCalendar(2023, 1, 1)}})
+	for i, ok := range mask {
+		require.Equal(t, i == 12, ok, "partition %s", def.Range[i].Name)
+	}
+	mask = def.Prune(&Predicate{Op: PredEq, Attr: "x", Vals: []interface{}{mar}})
+	for _, ok := range mask {
+		require.True(t, ok)
+	}
+
+	def = listPartitions(t)
+	require.Equal(t, []bool{false, true}, def.Prune(&Predicate{Op: PredEq, Attr: "a", Vals: []interface{}{int32(2)}}))
+	require.Equal(t, []bool{true, true}, def.Prune(&Predicate{Op: PredIn, Attr: "a", Vals: []interface{}{int32(2), int32(3)}}))
+	require.Equal(t, []bool{true, false}, def.Prune(&Predicate{Op: PredLt, Attr: "a", Vals: []interface{}{int32(2)}}))
+	require.Equal(t, []bool{false, false}, def.Prune(&Predicate{Op: PredGt, Attr: "a", Vals: []interface{}{int32(4)}}))
+	require.Equal(t, []bool{true, true}, def.Prune(&Predicate{
+		Op: PredOr,
+		Args: []*Predicate{
+			{Op: PredEq, Attr: "a", Vals: []interface{}{int32(1)}},
+			{Op: PredEq, Attr: "a", Vals: []interface{}{int32(4)}},
+		},
+	}))
+}
+
+func TestPartitionSplit(t *testing.T) {
+	def := monthPartitions(t)
+	bat := batch.New(true, []string{"d", "v"})
+	bat.Vecs[0] = vector.New(dateType)
+	require.NoError(t, vector.Append(bat.Vecs[0], []types.Date{
+		types.FromCalendar(2021, 1, 5),
+		types.FromCalendar(2021, 3, 9),
+		0,
+		types.FromCalendar(2025, 6, 1),
+		types.FromCalendar(2021, 3, 31),
+	}))
+	nulls.Add(bat.Vecs[0].Nsp, 2)
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(bat.Vecs[1], [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")}))
+	bats, err := def.Split(bat)
+	require.NoError(t, err)
+	require.Equal(t, 13, len(bats))
+	// NULL is held by the first partition
+	require.Equal(t, 2, vector.Length(bats[0].Vecs[0]))
+	require.True(t, nulls.Contains(bats[0].Vecs[0].Nsp, 1))
+	require.Equal(t, []byte("c"), bats[0].Vecs[1].Col.(*types.Bytes).Get(1))
+	require.Equal(t, 2, vector.Length(bats[2].Vecs[0]))
+	require.Equal(t, []byte("e"), bats[2].Vecs[1].Col.(*types.Bytes).Get(1))
+	require.Equal(t, 1, vector.Length(bats[12].Vecs[1]))
+	require.Nil(t, bats[1])
+
+	def = listPartitions(t)
+	bat = batch.New(true, []string{"a"})
+	bat.Vecs[0] = vector.New(int32Type)
+	require.NoError(t, vector.Append(bat.Vecs[0], []int32{2, 4}))
+	bats, err = def.Split(bat)
+	require.NoError(t, err)
+	require.Nil(t, bats[0])
+	require.Equal(t, bat, bats[1])
+	require.NoError(t, vector.Append(bat.Vecs[0], []int32{5}))
+	_, err = def.Split(bat)
+	require.EqualError(t, err, "table has no partition for value 5")
+}
+
+func TestPartitionCheck(t *testing.T) {
+	require.NoError(t, monthPartitions(t).Check())
+	require.NoError(t, listPartitions(t).Check())
+
+	def := monthPartitions(t)
+	def.Range[3].Name = "p1"
+	require.EqualError(t, def.Check(), "duplicate partition name p1")
+	def = monthPartitions(t)
+	def.Range[3].To = def.Range[2].To
+	require.EqualError(t, def.Check(), "VALUES LESS THAN value must be strictly increasing for each partition")
+	def = monthPartitions(t)
+	def.Range[3].To = nil
+	require.EqualError(t, def.Check(), "MAXVALUE can only be used in last partition definition")
+	def = listPartitions(t)
+	def.List[1].Extends = append(def.List[1].Extends, valueExtend(t, int32Type, int32(3)))
+	require.EqualError(t, def.Check(), "multiple definition of same constant in list partitioning")
+}
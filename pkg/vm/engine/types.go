@@ -15,6 +15,8 @@
 package engine
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -119,6 +121,65 @@ type CommentDef struct {
 	Comment string
 }
 
+// StatisticsDef is the statistics of a table collected by ANALYZE TABLE
+type StatisticsDef struct {
+	Rows    int64
+	Columns []ColumnStatistics
+}
+
+// ColumnStatistics is the statistics of a column, the values of the column are
+// mapped to float64 so that the statistics of all types can be compared.
+type ColumnStatistics struct {
+	Name  string
+	NDV   int64 // number of distinct values
+	Nulls int64 // number of null values
+	Min   float64
+	Max   float64
+	// Buckets is the equi-depth histogram of the not null values
+	Buckets []Bucket
+}
+
+// Bucket holds the values which are less than or equal to Upper and greater than
+// the Upper of the previous bucket, Count is the number of them.
+type Bucket struct {
+	Upper float64
+	Count int64
+}
+
+// RenameAttributeDef renames the attribute Name to NewName when it is added
+// to a relation by AddTableDef.
+type RenameAttributeDef struct {
+	Name    string
+	NewName string
+}
+
+// DefaultDef changes the default value of the attribute Name when it is added
+// to a relation by AddTableDef, the rows written before are not changed.
+type DefaultDef struct {
+	Name    string
+	Default DefaultExpr
+}
+
+// PartitionDef is a partition of a relation by its name, the partition and its
+// rows are dropped when it is deleted from a relation by DelTableDef.
+type PartitionDef struct {
+	Name string
+}
+
+// TruncatePartitionDef removes all the rows of the partition Name when it is
+// added to a relation by AddTableDef.
+type TruncatePartitionDef struct {
+	Name string
+}
+
+// ViewDef makes the relation created with it a view, a view stores no rows,
+// they are computed by the query Sql when the view is read. The objects read
+// by the query are checked against the privileges of the Definer.
+type ViewDef struct {
+	Sql     string
+	Definer string
+}
+
 type TableDef interface {
 	tableDef()
 }
@@ -128,6 +189,14 @@ func (*AttributeDef) tableDef()   {}
 func (*IndexTableDef) tableDef()  {}
 func (*PartitionByDef) tableDef() {}
 func (*PropertiesDef) tableDef()  {}
+func (*StatisticsDef) tableDef()  {}
+
+func (*RenameAttributeDef) tableDef() {}
+func (*DefaultDef) tableDef()         {}
+func (*ViewDef) tableDef()            {}
+
+func (*PartitionDef) tableDef()         {}
+func (*TruncatePartitionDef) tableDef() {}
 
 type Relation interface {
 	Statistics
@@ -143,12 +212,77 @@ type Relation interface {
 
 	Write(uint64, *batch.Batch) error
 
+	// AddTableDef adds a column by an AttributeDef, renames a column by a
+	// RenameAttributeDef and changes the default value by a DefaultDef.
+	// A PartitionByDef appends its partitions to the partitions of the
+	// relation and a TruncatePartitionDef empties a partition.
 	AddTableDef(uint64, TableDef) error
+	// DelTableDef drops the column of an AttributeDef or the partition of a
+	// PartitionDef.
 	DelTableDef(uint64, TableDef) error
 
 	NewReader(int) []Reader // first argument is the number of reader
 }
 
+// Checker is implemented by the relations whose stored data can be verified
+// by ADMIN CHECK TABLE, Check returns a message for every corrupt part found.
+type Checker interface {
+	Check() ([]string, error)
+}
+
+// Compactor is implemented by the relations whose stored data can be merged
+// by ADMIN COMPACT TABLE, Compact returns a message for every merge done.
+type Compactor interface {
+	Compact() ([]string, error)
+}
+
+// CompactionLister is implemented by the engines which merge the stored data
+// in the background, Compactions returns the merges running on this node and
+// the latest finished ones, the oldest first.
+type CompactionLister interface {
+	Compactions() []Compaction
+}
+
+// Compaction is a merge of the segments of a table
+type Compaction struct {
+	Database string
+	Table    string
+	Segments []uint64
+	// Manual is true if the merge is started by ADMIN COMPACT TABLE
+	Manual bool
+	Score  float64
+	// Size is the bytes of the segments merged and Written is the bytes of
+	// the merged segment
+	Size     int64
+	Written  int64
+	Started  time.Time
+	Finished time.Time // zero while the merge runs
+	Err      error
+}
+
+// KeyRotator is implemented by the engines which encrypt the stored data,
+// RotateKey switches the storage of this node to a new master key by ADMIN
+// ROTATE KEY and returns the id of the key.
+type KeyRotator interface {
+	RotateKey() (uint32, error)
+}
+
+// Snapshotter is implemented by the relations able to pin the rows they read,
+// once Snapshot is called the readers of the relation only read the rows
+// written before it. BACKUP DATABASE pins all the tables before it reads
+// any of them.
+type Snapshotter interface {
+	Snapshot() error
+}
+
+// Deleter is implemented by the relations able to delete rows, it is used by
+// DELETE. Delete removes the rows satisfying the predicate, all rows if it is
+// nil, and returns how many rows were removed. Unlike the predicates used to
+// skip blocks, the predicate of Delete is exact.
+type Deleter interface {
+	Delete(uint64, *Predicate) (uint64, error)
+}
+
 type Reader interface {
 	NewFilter() Filter
 	NewSummarizer() Summarizer
@@ -183,6 +317,33 @@ type SparseFilter interface {
 	Gt(string, interface{}) (Reader, error)
 	Ge(string, interface{}) (Reader, error)
 	Btw(string, interface{}, interface{}) (Reader, error)
+	Eval(*Predicate) (Reader, error)
+}
+
+const (
+	PredEq = iota
+	PredNe
+	PredLt
+	PredLe
+	PredGt
+	PredGe
+	PredBtw
+	PredIn
+	PredPrefix
+	PredAnd
+	PredOr
+)
+
+// Predicate is a filter tree pushed down to the storage engine, a leaf
+// compares the attribute with Vals, And and Or combine the Args. The values
+// have the go type of the attribute, and strings are passed as []byte.
+// The storage engine only uses it to skip blocks, so it is free to keep
+// blocks that it cannot prove to be useless.
+type Predicate struct {
+	Op   int
+	Attr string
+	Vals []interface{}
+	Args []*Predicate
 }
 
 type Database interface {
@@ -191,6 +352,7 @@ type Database interface {
 
 	Delete(uint64, string) error
 	Create(uint64, string, []TableDef) error // Create Table - (name, table define)
+	Rename(uint64, string, string) error     // Rename Table - (name, new name)
 }
 
 type Engine interface {
@@ -203,6 +365,13 @@ type Engine interface {
 	Node(string) *NodeInfo
 }
 
+// EncryptedCreator is implemented by the engines able to encrypt the data
+// of the tables of a database at rest, it is used by CREATE DATABASE with
+// ENCRYPTION='Y'.
+type EncryptedCreator interface {
+	CreateEncrypted(uint64, string, int) error // Create Database - (name, engine type)
+}
+
 // MakeDefaultExpr returns a new DefaultExpr
 func MakeDefaultExpr(exist bool, value interface{}, isNull bool) DefaultExpr {
 	return DefaultExpr{
@@ -222,3 +391,13 @@ func (node Attribute) HasDefaultExpr() bool {
 func (node Attribute) GetDefaultExpr() (interface{}, bool) {
 	return node.Default.Value, node.Default.IsNull
 }
+
+// GetViewDef returns the view definition in defs, nil if defs define a table.
+func GetViewDef(defs []TableDef) *ViewDef {
+	for _, def := range defs {
+		if v, ok := def.(*ViewDef); ok {
+			return v
+		}
+	}
+	return nil
+}
@@ -21,16 +21,20 @@ func HostSize(m *Mheap) int64 {
 }
 
 func Free(m *Mheap, data []byte) {
-	//m.Gm.Free(int64(cap(data)))
+	if m.Gm.Accounted() {
+		m.Gm.Free(int64(cap(data)))
+	}
 }
 
+// Alloc allocates size bytes, the memory is accounted only if the heap
+// belongs to a statement with a memory quota.
 func Alloc(m *Mheap, size int64) ([]byte, error) {
 	data := mempool.Alloc(m.Mp, int(size))
-	/*
+	if m.Gm.Accounted() {
 		if err := m.Gm.Alloc(int64(cap(data))); err != nil {
 			return nil, err
 		}
-	*/
+	}
 	return data[:size], nil
 }
 
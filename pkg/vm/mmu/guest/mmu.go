@@ -15,6 +15,7 @@
 package guest
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 )
@@ -34,8 +35,21 @@ func (m *Mmu) HostSize() int64 {
 	return m.Mmu.Size()
 }
 
+// Accounted returns true if the memory of the mmu is accounted, that is
+// it takes its memory from a quota mmu of a statement.
+func (m *Mmu) Accounted() bool {
+	return m != nil && m.Mmu != nil && m.Mmu.IsQuota()
+}
+
+// Free gives size bytes back, at most the size charged to the mmu is given
+// back. The batches sent by a connector are freed by the heap of the receiving
+// scope, which has never charged them.
 func (m *Mmu) Free(size int64) {
-	if size == 0 {
+	if size > m.size {
+		logutil.Debugf("guest mmu: free %v bytes while only %v bytes are charged", size, m.size)
+		size = m.size
+	}
+	if size <= 0 {
 		return
 	}
 	m.size -= size
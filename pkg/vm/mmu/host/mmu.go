@@ -15,8 +15,11 @@
 package host
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"math"
 	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
 )
 
 func New(limit int64) *Mmu {
@@ -25,19 +28,98 @@ func New(limit int64) *Mmu {
 	}
 }
 
+// NewQuota returns a quota mmu which takes its memory from parent and holds
+// limit bytes at most, limit <= 0 means that only parent limits it.
+// A quota mmu accounts the memory of one statement, Release must be called
+// when the statement is done.
+func NewQuota(limit int64, parent *Mmu) *Mmu {
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	return &Mmu{
+		limit:  limit,
+		parent: parent,
+	}
+}
+
 func (m *Mmu) Size() int64 {
 	return atomic.LoadInt64(&m.size)
 }
 
+// Peak returns the maximum size of the mmu
+func (m *Mmu) Peak() int64 {
+	return atomic.LoadInt64(&m.peak)
+}
+
+// ResetPeak starts the peak of the mmu over from its current size
+func (m *Mmu) ResetPeak() {
+	atomic.StoreInt64(&m.peak, atomic.LoadInt64(&m.size))
+}
+
+// IsQuota returns true if the mmu is a quota mmu
+func (m *Mmu) IsQuota() bool {
+	return m.parent != nil
+}
+
+// Free gives size bytes back to the mmu, at most the size held by the mmu is
+// given back, so a memory freed twice or freed after Release can not take the
+// size of the mmu and of its parent below zero.
 func (m *Mmu) Free(size int64) {
-	atomic.AddInt64(&m.size, size*-1)
+	var n int64
+	for {
+		old := atomic.LoadInt64(&m.size)
+		if n = size; n > old {
+			n = old
+		}
+		if atomic.CompareAndSwapInt64(&m.size, old, old-n) {
+			break
+		}
+	}
+	if n < size {
+		logutil.Warnf("mmu: free %v bytes while only %v bytes are held", size, n)
+	}
+	if m.parent != nil && n > 0 {
+		m.parent.Free(n)
+	}
 }
 
+// Alloc takes size bytes from the mmu, the size is reserved by a
+// compare and swap so that concurrent allocations can't exceed the limit.
 func (m *Mmu) Alloc(size int64) error {
-	if atomic.LoadInt64(&m.size)+size > m.limit {
-		return mmu.OutOfMemory
+	var v int64
+	for {
+		old := atomic.LoadInt64(&m.size)
+		if old > m.limit-size {
+			if m.parent != nil {
+				return mmu.ExceedQuota
+			}
+			return mmu.OutOfMemory
+		}
+		v = old + size
+		if atomic.CompareAndSwapInt64(&m.size, old, v) {
+			break
+		}
 	}
-	for v := atomic.LoadInt64(&m.size); !atomic.CompareAndSwapInt64(&m.size, v, v+size); v = atomic.LoadInt64(&m.size) {
+	if m.parent != nil {
+		if err := m.parent.Alloc(size); err != nil {
+			atomic.AddInt64(&m.size, size*-1)
+			return err
+		}
+	}
+	for p := atomic.LoadInt64(&m.peak); v > p; p = atomic.LoadInt64(&m.peak) {
+		if atomic.CompareAndSwapInt64(&m.peak, p, v) {
+			break
+		}
 	}
 	return nil
 }
+
+// Release gives the memory which is still held by a quota mmu back to its parent
+func (m *Mmu) Release() {
+	if m.parent == nil {
+		return
+	}
+	if size := atomic.SwapInt64(&m.size, 0); size > 0 {
+		m.parent.Free(size)
+	}
+}
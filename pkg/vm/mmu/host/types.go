@@ -17,4 +17,8 @@ package host
 type Mmu struct {
 	size  int64
 	limit int64
+	// peak, the maximum size since the mmu was created
+	peak int64
+	// parent, the mmu which the memory of a quota mmu is taken from
+	parent *Mmu
 }
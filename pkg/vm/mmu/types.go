@@ -20,4 +20,6 @@ import (
 
 var (
 	OutOfMemory = errors.New("out of memory")
+	// ExceedQuota is returned when a quota mmu has no more memory for the statement
+	ExceedQuota = errors.New("memory quota exceeded")
 )
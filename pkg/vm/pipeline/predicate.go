@@ -0,0 +1,343 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// newReaderWithPredicate pushes the filter down to the storage engine so that
+// the blocks which cannot satisfy it are never read, the filter is still
+// evaluated by the restrict operator on the rows of the remaining blocks.
+func newReaderWithPredicate(r engine.Reader, e extend.Extend) engine.Reader {
+	p := buildPredicate(e, false)
+	if p == nil {
+		return r
+	}
+	filter := r.NewSparseFilter()
+	if filter == nil {
+		return r
+	}
+	if nr, err := filter.Eval(p); err == nil {
+		return nr
+	}
+	return r
+}
+
+// buildPredicate converts the extend into a predicate, not means that the
+// extend is negated. It returns nil if the extend cannot be pushed down, a
+// conjunction simply drops the arguments that cannot be pushed down.
+func buildPredicate(e extend.Extend, not bool) *engine.Predicate {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return buildPredicate(v.E, not)
+	case *extend.UnaryExtend:
+		if v.Op == overload.Not {
+			return buildPredicate(v.E, !not)
+		}
+	case *extend.BinaryExtend:
+		switch v.Op {
+		case overload.And, overload.Or:
+			left, right := buildPredicate(v.Left, not), buildPredicate(v.Right, not)
+			if (v.Op == overload.And) != not {
+				return andPredicate(left, right)
+			}
+			return orPredicate(left, right)
+		case overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE:
+			return buildComparison(v, not)
+		case overload.Like:
+			if !not {
+				return buildLike(v)
+			}
+		}
+	}
+	return nil
+}
+
+// ExactPredicate converts the filter of a DELETE into a predicate evaluated
+// by the storage engine. Unlike the predicates pushed down to skip blocks,
+// the whole filter must be converted, it returns false otherwise. The null
+// values never satisfy a comparison, so the negations are pushed down to the
+// comparisons.
+func ExactPredicate(e extend.Extend) (*engine.Predicate, bool) {
+	p := buildExactPredicate(e, false)
+	return p, p != nil
+}
+
+func buildExactPredicate(e extend.Extend, not bool) *engine.Predicate {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return buildExactPredicate(v.E, not)
+	case *extend.UnaryExtend:
+		if v.Op == overload.Not {
+			return buildExactPredicate(v.E, !not)
+		}
+	case *extend.BinaryExtend:
+		switch v.Op {
+		case overload.And, overload.Or:
+			left, right := buildExactPredicate(v.Left, not), buildExactPredicate(v.Right, not)
+			if left == nil || right == nil {
+				return nil
+			}
+			if (v.Op == overload.And) != not {
+				return andPredicate(left, right)
+			}
+			return orPredicate(left, right)
+		case overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE:
+			return buildComparison(v, not)
+		}
+	}
+	return nil
+}
+
+func buildComparison(e *extend.BinaryExtend, not bool) *engine.Predicate {
+	op := e.Op
+	attr, ok := e.Left.(*extend.Attribute)
+	val, vok := e.Right.(*extend.ValueExtend)
+	if !ok || !vok {
+		if attr, ok = e.Right.(*extend.Attribute); !ok {
+			return nil
+		}
+		if val, ok = e.Left.(*extend.ValueExtend); !ok {
+			return nil
+		}
+		op = reverseOps[op]
+	}
+	if not {
+		op = negateOps[op]
+	}
+	v, ok := predicateValue(val.V, attr.Type)
+	if !ok {
+		return nil
+	}
+	return &engine.Predicate{Op: predicateOps[op], Attr: attr.Name, Vals: []interface{}{v}}
+}
+
+// buildLike converts the like with a constant prefix into a range of values,
+// a pattern without wildcards is an equality. The escaped characters of the
+// pattern are literals, it returns nil if the pattern ends with the escape.
+func buildLike(e *extend.BinaryExtend) *engine.Predicate {
+	attr, ok := e.Left.(*extend.Attribute)
+	if !ok || (attr.Type != types.T_char && attr.Type != types.T_varchar) {
+		return nil
+	}
+	val, ok := e.Right.(*extend.ValueExtend)
+	if !ok {
+		return nil
+	}
+	v, ok := predicateValue(val.V, attr.Type)
+	if !ok {
+		return nil
+	}
+	pattern := v.([]byte)
+	prefix := make([]byte, 0, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '\\':
+			if i++; i == len(pattern) {
+				return nil
+			}
+			prefix = append(prefix, pattern[i])
+		case '%', '_':
+			if len(prefix) == 0 {
+				return nil
+			}
+			return &engine.Predicate{Op: engine.PredPrefix, Attr: attr.Name, Vals: []interface{}{prefix}}
+		default:
+			prefix = append(prefix, c)
+		}
+	}
+	return &engine.Predicate{Op: engine.PredEq, Attr: attr.Name, Vals: []interface{}{prefix}}
+}
+
+func andPredicate(left, right *engine.Predicate) *engine.Predicate {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	}
+	return &engine.Predicate{Op: engine.PredAnd, Args: append(predicateArgs(left, engine.PredAnd), predicateArgs(right, engine.PredAnd)...)}
+}
+
+// orPredicate merges the equalities on the same attribute into an in-list.
+func orPredicate(left, right *engine.Predicate) *engine.Predicate {
+	if left == nil || right == nil {
+		return nil
+	}
+	if isInList(left) && isInList(right) && left.Attr == right.Attr {
+		vals := make([]interface{}, 0, len(left.Vals)+len(right.Vals))
+		vals = append(append(vals, left.Vals...), right.Vals...)
+		return &engine.Predicate{Op: engine.PredIn, Attr: left.Attr, Vals: vals}
+	}
+	return &engine.Predicate{Op: engine.PredOr, Args: append(predicateArgs(left, engine.PredOr), predicateArgs(right, engine.PredOr)...)}
+}
+
+func predicateArgs(p *engine.Predicate, op int) []*engine.Predicate {
+	if p.Op == op {
+		return p.Args
+	}
+	return []*engine.Predicate{p}
+}
+
+func isInList(p *engine.Predicate) bool {
+	return p.Op == engine.PredEq || p.Op == engine.PredIn
+}
+
+// predicateValue returns the constant as a value of the attribute type, it
+// fails if the constant is null or cannot be converted exactly.
+func predicateValue(vec *vector.Vector, typ types.T) (interface{}, bool) {
+	if nulls.Any(vec.Nsp) {
+		return nil, false
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return intValue(int64(vec.Col.([]int8)[0]), typ)
+	case types.T_int16:
+		return intValue(int64(vec.Col.([]int16)[0]), typ)
+	case types.T_int32:
+		return intValue(int64(vec.Col.([]int32)[0]), typ)
+	case types.T_int64:
+		return intValue(vec.Col.([]int64)[0], typ)
+	case types.T_uint8:
+		return uintValue(uint64(vec.Col.([]uint8)[0]), typ)
+	case types.T_uint16:
+		return uintValue(uint64(vec.Col.([]uint16)[0]), typ)
+	case types.T_uint32:
+		return uintValue(uint64(vec.Col.([]uint32)[0]), typ)
+	case types.T_uint64:
+		return uintValue(vec.Col.([]uint64)[0], typ)
+	case types.T_float32:
+		return floatValue(float64(vec.Col.([]float32)[0]), typ)
+	case types.T_float64:
+		return floatValue(vec.Col.([]float64)[0], typ)
+	case types.T_char, types.T_varchar:
+		if typ == types.T_char || typ == types.T_varchar {
+			return vec.Col.(*types.Bytes).Get(0), true
+		}
+	case types.T_date:
+		if typ == types.T_date {
+			return vec.Col.([]types.Date)[0], true
+		}
+	case types.T_datetime:
+		if typ == types.T_datetime {
+			return vec.Col.([]types.Datetime)[0], true
+		}
+	}
+	return nil, false
+}
+
+func intValue(v int64, typ types.T) (interface{}, bool) {
+	switch typ {
+	case types.T_int8:
+		return int8(v), v >= math.MinInt8 && v <= math.MaxInt8
+	case types.T_int16:
+		return int16(v), v >= math.MinInt16 && v <= math.MaxInt16
+	case types.T_int32:
+		return int32(v), v >= math.MinInt32 && v <= math.MaxInt32
+	case types.T_int64:
+		return v, true
+	case types.T_float32, types.T_float64:
+		if v > maxExactFloat || v < -maxExactFloat {
+			return nil, false
+		}
+		return floatValue(float64(v), typ)
+	}
+	if v < 0 {
+		return nil, false
+	}
+	return uintValue(uint64(v), typ)
+}
+
+func uintValue(v uint64, typ types.T) (interface{}, bool) {
+	switch typ {
+	case types.T_uint8:
+		return uint8(v), v <= math.MaxUint8
+	case types.T_uint16:
+		return uint16(v), v <= math.MaxUint16
+	case types.T_uint32:
+		return uint32(v), v <= math.MaxUint32
+	case types.T_uint64:
+		return v, true
+	case types.T_float32, types.T_float64:
+		if v > maxExactFloat {
+			return nil, false
+		}
+		return floatValue(float64(v), typ)
+	}
+	if v > math.MaxInt64 {
+		return nil, false
+	}
+	return intValue(int64(v), typ)
+}
+
+func floatValue(v float64, typ types.T) (interface{}, bool) {
+	switch typ {
+	case types.T_float32:
+		return float32(v), float64(float32(v)) == v
+	case types.T_float64:
+		return v, true
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return nil, false
+		}
+		return intValue(int64(v), typ)
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		if v != math.Trunc(v) || v < 0 || v >= math.MaxUint64 {
+			return nil, false
+		}
+		return uintValue(uint64(v), typ)
+	}
+	return nil, false
+}
+
+// maxExactFloat is the largest integer below which all integers are exactly
+// representable as float64
+const maxExactFloat = 1 << 53
+
+var predicateOps = map[int]int{
+	overload.EQ: engine.PredEq,
+	overload.NE: engine.PredNe,
+	overload.LT: engine.PredLt,
+	overload.LE: engine.PredLe,
+	overload.GT: engine.PredGt,
+	overload.GE: engine.PredGe,
+}
+
+// reverseOps swaps the sides of a comparison, 1 < a is a > 1
+var reverseOps = map[int]int{
+	overload.EQ: overload.EQ,
+	overload.NE: overload.NE,
+	overload.LT: overload.GT,
+	overload.LE: overload.GE,
+	overload.GT: overload.LT,
+	overload.GE: overload.LE,
+}
+
+var negateOps = map[int]int{
+	overload.EQ: overload.NE,
+	overload.NE: overload.EQ,
+	overload.LT: overload.GE,
+	overload.LE: overload.GT,
+	overload.GT: overload.LE,
+	overload.GE: overload.LT,
+}
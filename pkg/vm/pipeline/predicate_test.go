@@ -0,0 +1,162 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPredicate(t *testing.T) {
+	uid := &extend.Attribute{Name: "uid", Type: types.T_int32}
+	name := &extend.Attribute{Name: "name", Type: types.T_varchar}
+	{ // uid = 1 or uid = 2 or 3 = uid
+		e := or(or(cmp(overload.EQ, uid, intConst(1)), cmp(overload.EQ, uid, intConst(2))), cmp(overload.EQ, intConst(3), uid))
+		require.Equal(t, &engine.Predicate{Op: engine.PredIn, Attr: "uid", Vals: []interface{}{int32(1), int32(2), int32(3)}}, buildPredicate(e, false))
+	}
+	{ // 10 > uid and name like 'ab%'
+		e := and(cmp(overload.GT, intConst(10), uid), cmp(overload.Like, name, stringConst("ab%")))
+		require.Equal(t, &engine.Predicate{Op: engine.PredAnd, Args: []*engine.Predicate{
+			{Op: engine.PredLt, Attr: "uid", Vals: []interface{}{int32(10)}},
+			{Op: engine.PredPrefix, Attr: "name", Vals: []interface{}{[]byte("ab")}},
+		}}, buildPredicate(e, false))
+	}
+	{ // not (uid < 1 or uid > 5)
+		e := &extend.UnaryExtend{Op: overload.Not, E: &extend.ParenExtend{E: or(cmp(overload.LT, uid, intConst(1)), cmp(overload.GT, uid, intConst(5)))}}
+		require.Equal(t, &engine.Predicate{Op: engine.PredAnd, Args: []*engine.Predicate{
+			{Op: engine.PredGe, Attr: "uid", Vals: []interface{}{int32(1)}},
+			{Op: engine.PredLe, Attr: "uid", Vals: []interface{}{int32(5)}},
+		}}, buildPredicate(e, false))
+	}
+	{ // the conjunction keeps the side which can be pushed down
+		e := and(cmp(overload.EQ, uid, intConst(1)), cmp(overload.EQ, uid, name))
+		require.Equal(t, &engine.Predicate{Op: engine.PredEq, Attr: "uid", Vals: []interface{}{int32(1)}}, buildPredicate(e, false))
+	}
+	{ // the disjunction cannot be pushed down if one of its sides cannot
+		e := or(cmp(overload.EQ, uid, intConst(1)), cmp(overload.Like, name, stringConst("%ab")))
+		require.Nil(t, buildPredicate(e, false))
+	}
+	{ // the constant is out of the range of the attribute
+		require.Nil(t, buildPredicate(cmp(overload.EQ, uid, intConst(1<<40)), false))
+	}
+	{ // like without wildcards
+		require.Equal(t, &engine.Predicate{Op: engine.PredEq, Attr: "name", Vals: []interface{}{[]byte("ab")}}, buildPredicate(cmp(overload.Like, name, stringConst("ab")), false))
+	}
+	{ // the escaped wildcards are literals of the prefix
+		require.Equal(t, &engine.Predicate{Op: engine.PredPrefix, Attr: "name", Vals: []interface{}{[]byte("a%b")}}, buildPredicate(cmp(overload.Like, name, stringConst(`a\%b%`)), false))
+		require.Equal(t, &engine.Predicate{Op: engine.PredPrefix, Attr: "name", Vals: []interface{}{[]byte("a_")}}, buildPredicate(cmp(overload.Like, name, stringConst(`a\__`)), false))
+	}
+	{ // the escaped escape is a literal
+		require.Equal(t, &engine.Predicate{Op: engine.PredEq, Attr: "name", Vals: []interface{}{[]byte(`ab\c`)}}, buildPredicate(cmp(overload.Like, name, stringConst(`ab\\c`)), false))
+	}
+	{ // the pattern ending with the escape cannot be analysed
+		require.Nil(t, buildPredicate(cmp(overload.Like, name, stringConst(`ab\`)), false))
+	}
+	{ // the escaped wildcard at the beginning is not a wildcard
+		require.Equal(t, &engine.Predicate{Op: engine.PredPrefix, Attr: "name", Vals: []interface{}{[]byte("%a")}}, buildPredicate(cmp(overload.Like, name, stringConst(`\%a%`)), false))
+	}
+}
+
+func TestLikeEscape(t *testing.T) {
+	name := &extend.Attribute{Name: "name", Type: types.T_varchar}
+	kases := []struct {
+		pattern string
+		rows    []string
+		want    []int64
+	}{
+		{`a\%b%`, []string{"a%bc", `a\bc`, "axbc"}, []int64{0}},
+		{`ab\\c`, []string{`ab\c`, `ab\\c`}, []int64{0}},
+		{`a\.%`, []string{"a.b", "axb"}, []int64{0}},
+		{`a.%`, []string{"a.b", "axb"}, []int64{0}},
+	}
+	for _, kase := range kases {
+		e := cmp(overload.Like, name, stringConst(kase.pattern))
+		p := buildPredicate(e, false)
+		require.NotNil(t, p, kase.pattern)
+		vs := &types.Bytes{}
+		for _, row := range kase.rows {
+			vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+			vs.Lengths = append(vs.Lengths, uint32(len(row)))
+			vs.Data = append(vs.Data, row...)
+		}
+		rs, err := like.SliceLikePure(vs, []byte(kase.pattern), make([]int64, len(kase.rows)))
+		require.NoError(t, err)
+		require.Equal(t, kase.want, rs, kase.pattern)
+		// every row matched by the pattern satisfies the predicate
+		for _, i := range rs {
+			row := vs.Get(i)
+			switch p.Op {
+			case engine.PredEq:
+				require.Equal(t, p.Vals[0], row, kase.pattern)
+			case engine.PredPrefix:
+				require.True(t, bytes.HasPrefix(row, p.Vals[0].([]byte)), kase.pattern)
+			}
+		}
+	}
+}
+
+func TestExactPredicate(t *testing.T) {
+	uid := &extend.Attribute{Name: "uid", Type: types.T_int32}
+	name := &extend.Attribute{Name: "name", Type: types.T_varchar}
+	{ // not (uid = 1 and name = 'ab')
+		e := &extend.UnaryExtend{Op: overload.Not, E: and(cmp(overload.EQ, uid, intConst(1)), cmp(overload.EQ, name, stringConst("ab")))}
+		p, ok := ExactPredicate(e)
+		require.True(t, ok)
+		require.Equal(t, &engine.Predicate{Op: engine.PredOr, Args: []*engine.Predicate{
+			{Op: engine.PredNe, Attr: "uid", Vals: []interface{}{int32(1)}},
+			{Op: engine.PredNe, Attr: "name", Vals: []interface{}{[]byte("ab")}},
+		}}, p)
+	}
+	{ // every side of a conjunction must be converted
+		_, ok := ExactPredicate(and(cmp(overload.EQ, uid, intConst(1)), cmp(overload.EQ, uid, name)))
+		require.False(t, ok)
+	}
+	{ // like only skips blocks
+		_, ok := ExactPredicate(cmp(overload.Like, name, stringConst("ab")))
+		require.False(t, ok)
+	}
+}
+
+func cmp(op int, left, right extend.Extend) extend.Extend {
+	return &extend.BinaryExtend{Op: op, Left: left, Right: right}
+}
+
+func and(left, right extend.Extend) extend.Extend {
+	return &extend.BinaryExtend{Op: overload.And, Left: left, Right: right}
+}
+
+func or(left, right extend.Extend) extend.Extend {
+	return &extend.BinaryExtend{Op: overload.Or, Left: left, Right: right}
+}
+
+func intConst(v int64) extend.Extend {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = []int64{v}
+	return &extend.ValueExtend{V: vec}
+}
+
+func stringConst(v string) extend.Extend {
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	vec.Col = &types.Bytes{Data: []byte(v), Offsets: []uint32{0}, Lengths: []uint32{uint32(len(v))}}
+	return &extend.ValueExtend{V: vec}
+}
@@ -15,6 +15,9 @@
 package process
 
 import (
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -75,3 +78,26 @@ func FreeRegisters(proc *Process) {
 	}
 	proc.Reg.Vecs = proc.Reg.Vecs[:0]
 }
+
+// Receive returns the next batch sent to the register, nil is returned when
+// the sender has finished or the pipeline of the register has been cancelled.
+func Receive(reg *WaitRegister) *batch.Batch {
+	if reg.Ctx == nil {
+		return <-reg.Ch
+	}
+	select {
+	case <-reg.Ctx.Done():
+		return nil
+	case bat := <-reg.Ch:
+		return bat
+	}
+}
+
+// Scanned adds the rows and the bytes read from the storage engine to the statistic
+func (s *Statistic) Scanned(rows, size int64) {
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.ScanRows, rows)
+	atomic.AddInt64(&s.ScanBytes, size)
+}
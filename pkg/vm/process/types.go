@@ -52,6 +52,19 @@ type Limitation struct {
 	BatchSize int64
 	// PartitionRows, max rows for partition.
 	PartitionRows int64
+	// SpillDir, directory of the temporary files, empty for the default one.
+	SpillDir string
+	// SpillCompress, compress the temporary files with lz4.
+	SpillCompress bool
+}
+
+// Statistic counts the work done by the processes of a query,
+// it is shared by all the processes of the query.
+type Statistic struct {
+	// ScanRows, rows read from the storage engine.
+	ScanRows int64
+	// ScanBytes, bytes read from the storage engine.
+	ScanBytes int64
 }
 
 // Process contains context used in query execution
@@ -63,6 +76,11 @@ type Process struct {
 	Reg Register
 	Lim Limitation
 	Mp  *mheap.Mheap
+	// Stat, statistic of the query, nil if the query is not counted.
+	Stat *Statistic
 
 	Cancel context.CancelFunc
+	// Ctx, context of the query, it is done when the query is cancelled.
+	// nil if the query cannot be cancelled.
+	Ctx context.Context
 }